@@ -124,9 +124,15 @@ import (
 	lockupkeeper "github.com/osmosis-labs/osmosis/v15/x/lockup/keeper"
 	lockuptypes "github.com/osmosis-labs/osmosis/v15/x/lockup/types"
 
+	concentratedliquidity "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity"
+	concentratedliquiditykeeper "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/keeper"
+	concentratedliquiditytypes "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm"
 	gammkeeper "github.com/osmosis-labs/osmosis/v15/x/gamm/keeper"
 	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook"
+	orderbookkeeper "github.com/osmosis-labs/osmosis/v15/x/orderbook/keeper"
+	orderbooktypes "github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
 
 	"github.com/osmosis-labs/osmosis/v15/x/incentives"
 	incentiveskeeper "github.com/osmosis-labs/osmosis/v15/x/incentives/keeper"
@@ -139,6 +145,10 @@ import (
 	"github.com/osmosis-labs/osmosis/v15/x/txfees"
 	txfeeskeeper "github.com/osmosis-labs/osmosis/v15/x/txfees/keeper"
 	txfeestypes "github.com/osmosis-labs/osmosis/v15/x/txfees/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/twap"
+	twapkeeper "github.com/osmosis-labs/osmosis/v15/x/twap/keeper"
+	twaptypes "github.com/osmosis-labs/osmosis/v15/x/twap/types"
 )
 
 var (
@@ -197,12 +207,15 @@ type App struct {
 	FeeMarketKeeper feemarketkeeper.Keeper
 
 	// Osmosis keepers
-	GAMMKeeper        *gammkeeper.Keeper
-	PoolManagerKeeper *poolmanagerkeeper.Keeper
-	LockupKeeper      *lockupkeeper.Keeper
-	EpochsKeeper      *epochskeeper.Keeper
-	IncentivesKeeper  *incentiveskeeper.Keeper
-	TxFeesKeeper      *txfeeskeeper.Keeper
+	GAMMKeeper                  *gammkeeper.Keeper
+	PoolManagerKeeper           *poolmanagerkeeper.Keeper
+	ConcentratedLiquidityKeeper *concentratedliquiditykeeper.Keeper
+	OrderbookKeeper             *orderbookkeeper.Keeper
+	LockupKeeper                *lockupkeeper.Keeper
+	EpochsKeeper                *epochskeeper.Keeper
+	IncentivesKeeper            *incentiveskeeper.Keeper
+	TxFeesKeeper                *txfeeskeeper.Keeper
+	TwapKeeper                  *twapkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -247,8 +260,11 @@ var (
 		epochs.AppModuleBasic{},
 		gamm.AppModuleBasic{},
 		poolmanager.AppModuleBasic{},
+		concentratedliquidity.AppModuleBasic{},
+		orderbook.AppModuleBasic{},
 		incentives.AppModuleBasic{},
 		txfees.AppModuleBasic{},
+		twap.AppModuleBasic{},
 	)
 
 	maccPerms = map[string][]string{
@@ -303,11 +319,14 @@ func New(
 		epochstypes.StoreKey,
 		gammtypes.StoreKey,
 		poolmanagertypes.StoreKey,
+		concentratedliquiditytypes.StoreKey,
+		orderbooktypes.StoreKey,
 		incentivestypes.StoreKey,
 		txfeestypes.StoreKey,
+		twaptypes.StoreKey,
 	)
 
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey, twaptypes.TransientStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &App{
@@ -456,15 +475,43 @@ func New(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.DistrKeeper,
+		authorityAddr,
 	)
 	app.GAMMKeeper = &gammKeeper
 
+	concentratedLiquidityKeeper := concentratedliquiditykeeper.NewKeeper(
+		appCodec, keys[concentratedliquiditytypes.StoreKey],
+		app.GetSubspace(concentratedliquiditytypes.ModuleName),
+		app.BankKeeper,
+		app.DistrKeeper,
+	)
+	app.ConcentratedLiquidityKeeper = &concentratedLiquidityKeeper
+
+	orderbookKeeper := orderbookkeeper.NewKeeper(
+		appCodec, keys[orderbooktypes.StoreKey],
+		app.GetSubspace(orderbooktypes.ModuleName),
+		app.BankKeeper,
+		app.DistrKeeper,
+	)
+	app.OrderbookKeeper = &orderbookKeeper
+
 	app.PoolManagerKeeper = poolmanagerkeeper.NewKeeper(
 		keys[poolmanagertypes.StoreKey],
 		app.GAMMKeeper,
+		app.ConcentratedLiquidityKeeper,
+		app.OrderbookKeeper,
 		app.BankKeeper,
 		app.AccountKeeper,
+		app.GAMMKeeper,
+		app.MsgServiceRouter(),
+	)
+
+	twapKeeper := twapkeeper.NewKeeper(
+		appCodec, keys[twaptypes.StoreKey], tkeys[twaptypes.TransientStoreKey],
+		app.GetSubspace(twaptypes.ModuleName),
+		app.GAMMKeeper,
 	)
+	app.TwapKeeper = &twapKeeper
 
 	txfeeskeeper := txfeeskeeper.NewKeeper(
 		app.keys[txfeestypes.StoreKey],
@@ -475,10 +522,17 @@ func New(
 		app.PoolManagerKeeper,
 		app.GAMMKeeper,
 		app.DistrKeeper,
+		app.TwapKeeper,
+		authorityAddr,
 	)
 	app.TxFeesKeeper = &txfeeskeeper
 	app.GAMMKeeper.SetPoolManager(app.PoolManagerKeeper)
+	app.ConcentratedLiquidityKeeper.SetPoolManager(app.PoolManagerKeeper)
+	app.OrderbookKeeper.SetPoolManager(app.PoolManagerKeeper)
 	app.GAMMKeeper.SetTxFees(app.TxFeesKeeper)
+	app.GAMMKeeper.SetLockup(app.LockupKeeper)
+	app.GAMMKeeper.SetConcentratedLiquidity(app.ConcentratedLiquidityKeeper)
+	app.GAMMKeeper.SetEpochs(app.EpochsKeeper)
 
 	app.IncentivesKeeper = incentiveskeeper.NewKeeper(
 		app.keys[incentivestypes.StoreKey],
@@ -489,11 +543,13 @@ func New(
 		app.DistrKeeper,
 		app.TxFeesKeeper,
 	)
+	app.GAMMKeeper.SetIncentives(app.IncentivesKeeper)
 
 	app.GAMMKeeper.SetHooks(
 		gammtypes.NewMultiGammHooks(
 			// insert gamm hooks receivers here
 			app.TxFeesKeeper.Hooks(),
+			app.TwapKeeper.Hooks(),
 		),
 	)
 
@@ -508,6 +564,8 @@ func New(
 			// insert epochs hooks receivers here
 			app.IncentivesKeeper.Hooks(),
 			app.TxFeesKeeper.Hooks(),
+			app.TwapKeeper.Hooks(),
+			app.GAMMKeeper.EpochHooks(),
 		),
 	)
 
@@ -562,8 +620,11 @@ func New(
 		epochs.NewAppModule(*app.EpochsKeeper),
 		gamm.NewAppModule(appCodec, *app.GAMMKeeper, app.AccountKeeper, app.BankKeeper),
 		poolmanager.NewAppModule(*app.PoolManagerKeeper, app.GAMMKeeper),
+		concentratedliquidity.NewAppModule(appCodec, *app.ConcentratedLiquidityKeeper),
+		orderbook.NewAppModule(appCodec, *app.OrderbookKeeper),
 		incentives.NewAppModule(*app.IncentivesKeeper, app.AccountKeeper, app.BankKeeper, app.EpochsKeeper),
 		txfees.NewAppModule(*app.TxFeesKeeper),
+		twap.NewAppModule(appCodec, *app.TwapKeeper),
 	)
 
 	app.mm.SetOrderBeginBlockers(
@@ -591,8 +652,11 @@ func New(
 		lockuptypes.ModuleName,
 		gammtypes.ModuleName,
 		poolmanagertypes.ModuleName,
+		concentratedliquiditytypes.ModuleName,
+		orderbooktypes.ModuleName,
 		incentivestypes.ModuleName,
 		txfeestypes.ModuleName,
+		twaptypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
@@ -620,8 +684,11 @@ func New(
 		lockuptypes.ModuleName,
 		gammtypes.ModuleName,
 		poolmanagertypes.ModuleName,
+		concentratedliquiditytypes.ModuleName,
+		orderbooktypes.ModuleName,
 		incentivestypes.ModuleName,
 		txfeestypes.ModuleName,
+		twaptypes.ModuleName,
 	)
 
 	app.mm.SetOrderInitGenesis(
@@ -647,10 +714,14 @@ func New(
 
 		epochstypes.ModuleName,
 		lockuptypes.ModuleName,
+		// concentrated liquidity pools must exist before the gamm migration records linking to them are validated.
+		concentratedliquiditytypes.ModuleName,
 		gammtypes.ModuleName,
 		poolmanagertypes.ModuleName,
+		orderbooktypes.ModuleName,
 		incentivestypes.ModuleName,
 		txfeestypes.ModuleName,
+		twaptypes.ModuleName,
 		crisistypes.ModuleName,
 	)
 
@@ -792,6 +863,13 @@ func (app *App) GetSubspace(moduleName string) paramstypes.Subspace {
 	return subspace
 }
 
+// GetKey returns the KVStoreKey of the given store.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *App) GetKey(storeKey string) *storetypes.KVStoreKey {
+	return app.keys[storeKey]
+}
+
 // ModuleAccountAddrs returns all the app's module account addresses.
 func (app *App) ModuleAccountAddrs() map[string]bool {
 	modAccAddrs := make(map[string]bool)
@@ -826,8 +904,11 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(lockuptypes.ModuleName)
 	paramsKeeper.Subspace(epochstypes.ModuleName)
 	paramsKeeper.Subspace(gammtypes.ModuleName)
+	paramsKeeper.Subspace(concentratedliquiditytypes.ModuleName)
+	paramsKeeper.Subspace(orderbooktypes.ModuleName)
 	paramsKeeper.Subspace(incentivestypes.ModuleName)
 	paramsKeeper.Subspace(txfeestypes.ModuleName)
+	paramsKeeper.Subspace(twaptypes.ModuleName)
 
 	return paramsKeeper
 }
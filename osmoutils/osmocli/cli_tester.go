@@ -100,6 +100,21 @@ func resetCommandFlagValues(cmd *cobra.Command) error {
 	var retErr error = nil
 	cmd.Flags().VisitAll(func(f *pflag.Flag) {
 		if f.Changed {
+			// setting a slice flag appends to it, its default is restored by replacing it instead.
+			if sliceValue, ok := f.Value.(pflag.SliceValue); ok {
+				defaults := strings.Trim(f.DefValue, "[]")
+				var err error
+				if defaults == "" {
+					err = sliceValue.Replace([]string{})
+				} else {
+					err = sliceValue.Replace(strings.Split(defaults, ","))
+				}
+				if err != nil {
+					retErr = err
+				}
+				f.Changed = false
+				return
+			}
 			err := f.Value.Set(f.DefValue)
 			if err != nil {
 				retErr = err
@@ -70,7 +70,8 @@ func prepareDescriptor[reqP proto.Message](desc *QueryDescriptor) {
 		desc.FormatLong(desc.ModuleName)
 	}
 
-	desc.numArgs = ParseNumFields[reqP]() - len(desc.CustomFlagOverrides)
+	// custom field parsers are expected to read their field from flags, like in tx commands.
+	desc.numArgs = ParseNumFields[reqP]() - len(desc.CustomFlagOverrides) - len(desc.CustomFieldParsers)
 	if desc.HasPagination {
 		desc.numArgs = desc.numArgs - 1
 	}
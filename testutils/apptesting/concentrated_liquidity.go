@@ -0,0 +1,65 @@
+package apptesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clkeeper "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/keeper"
+	clmath "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/math"
+	clmodel "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
+	cltypes "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+var (
+	ETH                       = "eth"
+	USDC                      = "usdc"
+	DefaultTickSpacing        = uint64(1)
+	DefaultExponentAtPriceOne = sdk.NewInt(-4)
+)
+
+// PrepareConcentratedPool returns a concentrated liquidity pool with eth and usdc denoms.
+func (s *KeeperTestHelper) PrepareConcentratedPool() cltypes.ConcentratedPoolExtension {
+	return s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, sdk.ZeroDec())
+}
+
+// PrepareCustomConcentratedPool returns a custom concentrated liquidity pool with the given parameters.
+// The pool holds no liquidity until a position is created.
+func (s *KeeperTestHelper) PrepareCustomConcentratedPool(owner sdk.AccAddress, denom0, denom1 string, tickSpacing uint64, exponentAtPriceOne sdk.Int, swapFee sdk.Dec) cltypes.ConcentratedPoolExtension {
+	poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, clmodel.NewMsgCreateConcentratedPool(owner, denom0, denom1, tickSpacing, exponentAtPriceOne, swapFee))
+	s.Require().NoError(err)
+
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	return pool
+}
+
+// PrepareConcentratedPoolWithCoinsAndFullRangePosition returns a concentrated liquidity pool
+// holding the given coins in a single full range position owned by the first test account.
+func (s *KeeperTestHelper) PrepareConcentratedPoolWithCoinsAndFullRangePosition(denom0, denom1 string, swapFee sdk.Dec, coins sdk.Coins) cltypes.ConcentratedPoolExtension {
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], denom0, denom1, DefaultTickSpacing, DefaultExponentAtPriceOne, swapFee)
+	s.CreateFullRangePosition(pool, s.TestAccs[0], coins)
+
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	return pool
+}
+
+// CreateFullRangePosition funds the owner with the given coins and deposits them
+// into a position spanning the entire tick range of the pool.
+func (s *KeeperTestHelper) CreateFullRangePosition(pool cltypes.ConcentratedPoolExtension, owner sdk.AccAddress, coins sdk.Coins) sdk.Dec {
+	s.FundAcc(owner, coins)
+
+	minTick, maxTick := clmath.GetMinAndMaxTicksFromExponentAtPriceOne(pool.GetPrecisionFactorAtPriceOne())
+	msgServer := clkeeper.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+	res, err := msgServer.CreatePosition(sdk.WrapSDKContext(s.Ctx), &cltypes.MsgCreatePosition{
+		PoolId:          pool.GetId(),
+		Sender:          owner.String(),
+		LowerTick:       minTick,
+		UpperTick:       maxTick,
+		TokenDesired0:   sdk.NewCoin(pool.GetToken0(), coins.AmountOf(pool.GetToken0())),
+		TokenDesired1:   sdk.NewCoin(pool.GetToken1(), coins.AmountOf(pool.GetToken1())),
+		TokenMinAmount0: sdk.ZeroInt(),
+		TokenMinAmount1: sdk.ZeroInt(),
+	})
+	s.Require().NoError(err)
+	return res.LiquidityCreated
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	gammkeeper "github.com/osmosis-labs/osmosis/v15/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/stableswap"
 	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
@@ -135,6 +136,32 @@ func (s *KeeperTestHelper) PrepareCustomBalancerPoolFromCoins(coins sdk.Coins, p
 	return s.PrepareCustomBalancerPool(poolAssets, params)
 }
 
+// PrepareBasicStableswapPool returns a stableswap pool's pool-ID consisted of
+// the default pool assets, with no fees and a scaling factor of 1 for each asset.
+func (s *KeeperTestHelper) PrepareBasicStableswapPool() uint64 {
+	coins := sdk.NewCoins()
+	for _, asset := range DefaultPoolAssets {
+		coins = coins.Add(asset.Token)
+	}
+	return s.PrepareCustomStableswapPool(coins, stableswap.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}, []uint64{1, 1, 1, 1}, 100)
+}
+
+// PrepareCustomStableswapPool sets up a stableswap pool with the given liquidity, parameters,
+// scaling factors and amplification.
+func (s *KeeperTestHelper) PrepareCustomStableswapPool(coins sdk.Coins, params stableswap.PoolParams, scalingFactors []uint64, amplification uint64) uint64 {
+	// Add coins for pool creation fee + coins needed to mint balances
+	fundCoins := sdk.NewCoins(sdk.NewCoin("adym", sdk.NewInt(10000000000))).Add(coins...)
+	s.FundAcc(s.TestAccs[0], fundCoins)
+
+	msg := stableswap.NewMsgCreateStableswapPool(s.TestAccs[0], params, coins, scalingFactors, amplification, "")
+	poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, msg)
+	s.NoError(err)
+	return poolId
+}
+
 // Modify spotprice of a pool to target spotprice
 func (s *KeeperTestHelper) ModifySpotPrice(poolID uint64, targetSpotPrice sdk.Dec, baseDenom string) {
 	var quoteDenom string
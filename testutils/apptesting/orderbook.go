@@ -0,0 +1,40 @@
+package apptesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	obmodel "github.com/osmosis-labs/osmosis/v15/x/orderbook/model"
+	obtypes "github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+)
+
+// DefaultTickSize makes the price of a tick of the default orderbook pool equal to the tick.
+var DefaultTickSize = sdk.OneDec()
+
+// PrepareOrderbookPool returns an orderbook pool trading eth against usdc, with an empty book.
+func (s *KeeperTestHelper) PrepareOrderbookPool() obtypes.OrderbookPoolExtension {
+	return s.PrepareCustomOrderbookPool(s.TestAccs[0], ETH, USDC, DefaultTickSize, sdk.ZeroDec())
+}
+
+// PrepareCustomOrderbookPool returns a custom orderbook pool with the given parameters.
+// The pool has no price until a limit order is placed.
+func (s *KeeperTestHelper) PrepareCustomOrderbookPool(owner sdk.AccAddress, baseDenom, quoteDenom string, tickSize, swapFee sdk.Dec) obtypes.OrderbookPoolExtension {
+	poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, obmodel.NewMsgCreateOrderbookPool(owner, baseDenom, quoteDenom, tickSize, swapFee))
+	s.Require().NoError(err)
+
+	pool, err := s.App.OrderbookKeeper.GetOrderbookPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	return pool
+}
+
+// PlaceLimitOrder funds the owner with the escrow of a limit order and places it on the book of the pool.
+func (s *KeeperTestHelper) PlaceLimitOrder(pool obtypes.OrderbookPoolExtension, owner sdk.AccAddress, side obtypes.OrderSide, tick int64, quantity sdk.Int) uint64 {
+	escrow := sdk.NewCoin(pool.GetBaseDenom(), quantity)
+	if side == obtypes.Bid {
+		escrow = sdk.NewCoin(pool.GetQuoteDenom(), pool.TickToPrice(tick).MulInt(quantity).Ceil().TruncateInt())
+	}
+	s.FundAcc(owner, sdk.NewCoins(escrow))
+
+	orderId, err := s.App.OrderbookKeeper.PlaceLimitOrder(s.Ctx, owner, pool.GetId(), side, tick, quantity)
+	s.Require().NoError(err)
+	return orderId
+}
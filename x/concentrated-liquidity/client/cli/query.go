@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPools)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdUserPositions)
+	cmd.AddCommand(
+		osmocli.GetParams[*types.QueryParamsRequest](
+			types.ModuleName, types.NewQueryClient),
+	)
+
+	return cmd
+}
+
+func GetCmdPools() (*osmocli.QueryDescriptor, *types.QueryPoolsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pools",
+		Short: "Query concentrated liquidity pools",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pools`}, &types.QueryPoolsRequest{}
+}
+
+func GetCmdUserPositions() (*osmocli.QueryDescriptor, *types.QueryUserPositionsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "user-positions [address] [pool-id]",
+		Short: "Query the positions of an address, in all pools if the pool id is 0",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} user-positions osmo1yl6hdjhmkf37639730gffanpzndzdpmhxy9ep3 1`}, &types.QueryUserPositionsRequest{}
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+func NewTxCmd() *cobra.Command {
+	txCmd := osmocli.TxIndexCmd(types.ModuleName)
+	osmocli.AddTxCmd(txCmd, NewCreateConcentratedPoolCmd)
+	osmocli.AddTxCmd(txCmd, NewCreatePositionCmd)
+	osmocli.AddTxCmd(txCmd, NewWithdrawPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewCollectFeesCmd)
+	return txCmd
+}
+
+func NewCreateConcentratedPoolCmd() (*osmocli.TxCliDesc, *model.MsgCreateConcentratedPool) {
+	return &osmocli.TxCliDesc{
+		Use:   "create-concentrated-pool [denom-0] [denom-1] [tick-spacing] [precision-factor-at-price-one] [swap-fee]",
+		Short: "create a concentrated liquidity pool",
+		Long: `{{.Short}}
+Negative numbers must be wrapped in square brackets.{{.ExampleHeader}}
+{{.CommandPrefix}} create-concentrated-pool uatom uosmo 1 [-4] 0.001`,
+	}, &model.MsgCreateConcentratedPool{}
+}
+
+func NewCreatePositionCmd() (*osmocli.TxCliDesc, *types.MsgCreatePosition) {
+	return &osmocli.TxCliDesc{
+		Use:   "create-position [pool-id] [lower-tick] [upper-tick] [token-0] [token-1] [token-0-min-amount] [token-1-min-amount]",
+		Short: "add liquidity to a position over a tick range of a concentrated liquidity pool",
+		Long: `{{.Short}}
+Negative ticks must be wrapped in square brackets.{{.ExampleHeader}}
+{{.CommandPrefix}} create-position 1 [-1000] 1000 1000uatom 1000uosmo 0 0`,
+	}, &types.MsgCreatePosition{}
+}
+
+func NewWithdrawPositionCmd() (*osmocli.TxCliDesc, *types.MsgWithdrawPosition) {
+	return &osmocli.TxCliDesc{
+		Use:   "withdraw-position [pool-id] [lower-tick] [upper-tick] [liquidity]",
+		Short: "withdraw liquidity from a position of a concentrated liquidity pool",
+		Long: `{{.Short}}
+Negative ticks must be wrapped in square brackets.{{.ExampleHeader}}
+{{.CommandPrefix}} withdraw-position 1 [-1000] 1000 100.5`,
+	}, &types.MsgWithdrawPosition{}
+}
+
+func NewCollectFeesCmd() (*osmocli.TxCliDesc, *types.MsgCollectFees) {
+	return &osmocli.TxCliDesc{
+		Use:   "collect-fees [pool-id] [lower-tick] [upper-tick]",
+		Short: "collect the swap fees earned by a position of a concentrated liquidity pool",
+		Long: `{{.Short}}
+Negative ticks must be wrapped in square brackets.{{.ExampleHeader}}
+{{.CommandPrefix}} collect-fees 1 [-1000] 1000`,
+	}, &types.MsgCollectFees{}
+}
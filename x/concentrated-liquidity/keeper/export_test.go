@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+func (k Keeper) GetTickInfo(ctx sdk.Context, poolId uint64, tickIndex int64) types.TickInfo {
+	return k.getTickInfo(ctx, poolId, tickIndex)
+}
+
+func (k Keeper) GetFeeAccumulator(ctx sdk.Context, poolId uint64) (types.FeeAccumulator, error) {
+	return k.getFeeAccumulator(ctx, poolId)
+}
+
+func (k Keeper) NextInitializedTick(ctx sdk.Context, poolId uint64, currentTick int64, zeroForOne bool) (int64, bool) {
+	return k.nextInitializedTick(ctx, poolId, currentTick, zeroForOne)
+}
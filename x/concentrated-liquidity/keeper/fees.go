@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+// Swap fees are accounted for with a global fee growth per unit of liquidity for each pool,
+// and a fee growth outside of each initialized tick, that is the fee growth on the other side
+// of the tick relative to the current tick. The fee growth inside of a position's range is
+// derived from those, and the fees owed to a position are its liquidity multiplied by the
+// growth of the fee growth inside of its range since the position was last updated.
+
+// getFeeAccumulator returns the fee accumulator of the given pool.
+func (k Keeper) getFeeAccumulator(ctx sdk.Context, poolId uint64) (types.FeeAccumulator, error) {
+	store := ctx.KVStore(k.storeKey)
+	feeAccumulator := types.FeeAccumulator{}
+	found, err := osmoutils.Get(store, types.KeyFeeAccumulator(poolId), &feeAccumulator)
+	if err != nil {
+		return types.FeeAccumulator{}, err
+	}
+	if !found {
+		return types.FeeAccumulator{}, types.PoolNotFoundError{PoolId: poolId}
+	}
+	return feeAccumulator, nil
+}
+
+func (k Keeper) setFeeAccumulator(ctx sdk.Context, feeAccumulator types.FeeAccumulator) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyFeeAccumulator(feeAccumulator.PoolId), &feeAccumulator)
+}
+
+// GetAllFeeAccumulators returns the fee accumulators of all the pools.
+func (k Keeper) GetAllFeeAccumulators(ctx sdk.Context) ([]types.FeeAccumulator, error) {
+	store := ctx.KVStore(k.storeKey)
+	return osmoutils.GatherValuesFromStorePrefix(store, types.KeyPrefixFeeAccumulators, func(value []byte) (types.FeeAccumulator, error) {
+		feeAccumulator := types.FeeAccumulator{}
+		err := feeAccumulator.Unmarshal(value)
+		return feeAccumulator, err
+	})
+}
+
+// getFeeGrowthInside returns the fee growth per unit of liquidity inside of the range [lowerTick, upperTick)
+// of the pool: the global fee growth minus the fee growth below the lower tick and above the upper tick.
+func (k Keeper) getFeeGrowthInside(ctx sdk.Context, pool types.ConcentratedPoolExtension, lowerTick, upperTick int64) (sdk.DecCoins, error) {
+	poolId := pool.GetId()
+	feeAccumulator, err := k.getFeeAccumulator(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	feeGrowthGlobal := feeAccumulator.FeeGrowthGlobal
+	currentTick := pool.GetCurrentTick().Int64()
+
+	lowerTickInfo := k.getTickInfo(ctx, poolId, lowerTick)
+	upperTickInfo := k.getTickInfo(ctx, poolId, upperTick)
+
+	feeGrowthBelow := lowerTickInfo.FeeGrowthOutside
+	if currentTick < lowerTick {
+		feeGrowthBelow, err = subFeeGrowth(feeGrowthGlobal, lowerTickInfo.FeeGrowthOutside)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	feeGrowthAbove := upperTickInfo.FeeGrowthOutside
+	if currentTick >= upperTick {
+		feeGrowthAbove, err = subFeeGrowth(feeGrowthGlobal, upperTickInfo.FeeGrowthOutside)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	feeGrowthInside, err := subFeeGrowth(feeGrowthGlobal, feeGrowthBelow)
+	if err != nil {
+		return nil, err
+	}
+	return subFeeGrowth(feeGrowthInside, feeGrowthAbove)
+}
+
+// accrueFees adds the fees earned by the position since its last update to its uncollected fees,
+// and checkpoints the fee growth inside of its range.
+func (k Keeper) accrueFees(ctx sdk.Context, pool types.ConcentratedPoolExtension, position *types.Position) error {
+	feeGrowthInside, err := k.getFeeGrowthInside(ctx, pool, position.LowerTick, position.UpperTick)
+	if err != nil {
+		return err
+	}
+
+	feeGrowthDelta, err := subFeeGrowth(feeGrowthInside, position.FeeGrowthInsideLast)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrNegativeUncollectedFee, "position in pool %d over range [%d, %d): %s",
+			position.PoolId, position.LowerTick, position.UpperTick, err)
+	}
+
+	if position.Liquidity.IsPositive() {
+		position.UncollectedFees = position.UncollectedFees.Add(feeGrowthDelta.MulDecTruncate(position.Liquidity)...)
+	}
+	position.FeeGrowthInsideLast = feeGrowthInside
+	return nil
+}
+
+// collectFees sends the whole part of the fees earned by the position to its owner.
+// The decimal remainder stays in the pool. The position is deleted if it no longer has liquidity.
+func (k Keeper) collectFees(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) (sdk.Coins, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	position, err := k.getPosition(ctx, poolId, owner, lowerTick, upperTick)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.accrueFees(ctx, pool, &position); err != nil {
+		return nil, err
+	}
+
+	collectedFees, _ := position.UncollectedFees.TruncateDecimal()
+	position.UncollectedFees = sdk.DecCoins{}
+
+	if position.Liquidity.IsZero() {
+		k.deletePosition(ctx, poolId, owner, lowerTick, upperTick)
+	} else {
+		k.setPosition(ctx, position)
+	}
+
+	if !collectedFees.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), owner, collectedFees); err != nil {
+			return nil, err
+		}
+	}
+
+	return collectedFees, nil
+}
+
+// subFeeGrowth returns a - b, erroring if the result has a negative amount.
+func subFeeGrowth(a, b sdk.DecCoins) (sdk.DecCoins, error) {
+	diff, hasNeg := a.SafeSub(b)
+	if hasNeg {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "fee growth (%s) is lower than (%s)", a, b)
+	}
+	return diff, nil
+}
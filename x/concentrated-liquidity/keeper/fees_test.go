@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestCollectFees() {
+	swapFee := sdk.NewDecWithPrec(1, 2)
+	swapAmount := sdk.NewInt(10000)
+	// the fee is charged on top of the amount swapped, splitting 1% of the total amount in.
+	expectedTotalFee := sdk.NewDecFromInt(swapAmount).Mul(swapFee)
+
+	tests := map[string]struct {
+		lowerTick int64
+		upperTick int64
+		// expectedShare is the share of the fees earned by the position, in percent.
+		expectedShare int64
+	}{
+		"full range position with half of the liquidity": {
+			lowerTick:     -1080000,
+			upperTick:     1800000,
+			expectedShare: 50,
+		},
+		"position out of range earns nothing": {
+			lowerTick:     DefaultUpperTick,
+			upperTick:     DefaultUpperTick + 1000,
+			expectedShare: 0,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			owner := s.TestAccs[1]
+
+			pool := s.prepareDefaultPool(swapFee)
+			_, err := s.createPosition(pool.GetId(), owner, DefaultAmt0, DefaultAmt1, tc.lowerTick, tc.upperTick)
+			s.Require().NoError(err)
+
+			// swap eth in, charging the fee in eth.
+			sender := s.TestAccs[2]
+			tokenIn := sdk.NewCoin(ETH, swapAmount)
+			s.FundAcc(sender, sdk.NewCoins(tokenIn))
+			poolI, err := clKeeper.GetPool(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			_, err = clKeeper.SwapExactAmountIn(s.Ctx, sender, poolI, tokenIn, USDC, sdk.OneInt(), swapFee)
+			s.Require().NoError(err)
+
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			res, err := s.msgServer.CollectFees(sdk.WrapSDKContext(s.Ctx), &types.MsgCollectFees{
+				PoolId:    pool.GetId(),
+				Sender:    owner.String(),
+				LowerTick: tc.lowerTick,
+				UpperTick: tc.upperTick,
+			})
+			s.Require().NoError(err)
+
+			expectedFee := expectedTotalFee.MulInt64(tc.expectedShare).QuoInt64(100).TruncateInt()
+			collected := res.CollectedFees.AmountOf(ETH)
+			s.Require().True(collected.LTE(expectedFee), "collected %s, expected at most %s", collected, expectedFee)
+			s.Require().True(expectedFee.Sub(collected).LTE(sdk.OneInt()), "collected %s, expected %s", collected, expectedFee)
+			s.Require().True(res.CollectedFees.AmountOf(USDC).IsZero())
+			s.Require().Equal(balanceBefore.Add(res.CollectedFees...).String(), s.App.BankKeeper.GetAllBalances(s.Ctx, owner).String())
+
+			// the fees are only collected once.
+			res, err = s.msgServer.CollectFees(sdk.WrapSDKContext(s.Ctx), &types.MsgCollectFees{
+				PoolId:    pool.GetId(),
+				Sender:    owner.String(),
+				LowerTick: tc.lowerTick,
+				UpperTick: tc.upperTick,
+			})
+			s.Require().NoError(err)
+			s.Require().True(res.CollectedFees.IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCollectFeesPositionNotFound() {
+	pool := s.prepareDefaultPool(sdk.ZeroDec())
+
+	_, err := s.msgServer.CollectFees(sdk.WrapSDKContext(s.Ctx), &types.MsgCollectFees{
+		PoolId:    pool.GetId(),
+		Sender:    s.TestAccs[1].String(),
+		LowerTick: DefaultLowerTick,
+		UpperTick: DefaultUpperTick,
+	})
+	s.Require().ErrorIs(err, types.PositionNotFoundError{PoolId: pool.GetId(), LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick})
+}
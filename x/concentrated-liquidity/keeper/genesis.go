@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+// InitGenesis initializes the x/concentrated-liquidity module's state from a provided genesis
+// state, which includes the pools, their initialized ticks and fee accumulators, and the positions.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpacker codectypes.AnyUnpacker) {
+	k.SetParams(ctx, genState.Params)
+
+	for _, any := range genState.Pools {
+		var pool types.ConcentratedPoolExtension
+		err := unpacker.UnpackAny(any, &pool)
+		if err != nil {
+			panic(err)
+		}
+		err = k.setPool(ctx, pool)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, tick := range genState.Ticks {
+		k.setTickInfo(ctx, tick.PoolId, tick.TickIndex, tick.Info)
+	}
+
+	for _, position := range genState.Positions {
+		k.setPosition(ctx, position)
+	}
+
+	for _, feeAccumulator := range genState.FeeAccumulators {
+		k.setFeeAccumulator(ctx, feeAccumulator)
+	}
+}
+
+// ExportGenesis returns the x/concentrated-liquidity module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	pools, err := k.GetPools(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	poolAnys := []*codectypes.Any{}
+	ticks := []types.FullTick{}
+	for _, pool := range pools {
+		any, err := codectypes.NewAnyWithValue(pool)
+		if err != nil {
+			panic(err)
+		}
+		poolAnys = append(poolAnys, any)
+
+		poolTicks, err := k.GetAllInitializedTicksForPool(ctx, pool.GetId())
+		if err != nil {
+			panic(err)
+		}
+		ticks = append(ticks, poolTicks...)
+	}
+
+	positions, err := k.GetAllPositions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	feeAccumulators, err := k.GetAllFeeAccumulators(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Params:          k.GetParams(ctx),
+		Pools:           poolAnys,
+		Ticks:           ticks,
+		Positions:       positions,
+		FeeAccumulators: feeAccumulators,
+	}
+}
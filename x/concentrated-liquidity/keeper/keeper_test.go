@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v15/testutils/apptesting"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+var (
+	ETH  = apptesting.ETH
+	USDC = apptesting.USDC
+
+	// DefaultAmt0 and DefaultAmt1 set the price of the default pool to 5000 usdc per eth.
+	DefaultAmt0 = sdk.NewInt(1000000)
+	DefaultAmt1 = sdk.NewInt(5000000000)
+
+	// DefaultLowerTick and DefaultUpperTick are the ticks of prices 4545 and 5500.
+	DefaultLowerTick = int64(305450)
+	DefaultUpperTick = int64(315000)
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	msgServer types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+	s.msgServer = keeper.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+}
+
+// createPosition funds the owner with the desired amounts and creates a position with them.
+func (s *KeeperTestSuite) createPosition(poolId uint64, owner sdk.AccAddress, amount0, amount1 sdk.Int, lowerTick, upperTick int64) (*types.MsgCreatePositionResponse, error) {
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1)))
+	return s.msgServer.CreatePosition(sdk.WrapSDKContext(s.Ctx), &types.MsgCreatePosition{
+		PoolId:          poolId,
+		Sender:          owner.String(),
+		LowerTick:       lowerTick,
+		UpperTick:       upperTick,
+		TokenDesired0:   sdk.NewCoin(ETH, amount0),
+		TokenDesired1:   sdk.NewCoin(USDC, amount1),
+		TokenMinAmount0: sdk.ZeroInt(),
+		TokenMinAmount1: sdk.ZeroInt(),
+	})
+}
+
+// prepareDefaultPool creates an eth/usdc pool with the given swap fee and a full range
+// position of the default amounts owned by the first test account.
+func (s *KeeperTestSuite) prepareDefaultPool(swapFee sdk.Dec) types.ConcentratedPoolExtension {
+	return s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC, swapFee, sdk.NewCoins(sdk.NewCoin(ETH, DefaultAmt0), sdk.NewCoin(USDC, DefaultAmt1)))
+}
+
+func (s *KeeperTestSuite) TestInitializePool() {
+	tests := map[string]struct {
+		tickSpacing   uint64
+		expectedError error
+	}{
+		"authorized tick spacing": {
+			tickSpacing: 100,
+		},
+		"unauthorized tick spacing": {
+			tickSpacing:   3,
+			expectedError: types.UnauthorizedTickSpacingError{ProvidedTickSpacing: 3, AuthorizedTickSpacings: types.DefaultAuthorizedTickSpacing},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+
+			poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, model.NewMsgCreateConcentratedPool(s.TestAccs[0], ETH, USDC, tc.tickSpacing, apptesting.DefaultExponentAtPriceOne, sdk.ZeroDec()))
+			if tc.expectedError != nil {
+				s.Require().ErrorContains(err, tc.expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			clPool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.tickSpacing, clPool.GetTickSpacing())
+			s.Require().True(clPool.GetLiquidity().IsZero())
+
+			feeAccumulator, err := s.App.ConcentratedLiquidityKeeper.GetFeeAccumulator(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().True(feeAccumulator.FeeGrowthGlobal.IsZero())
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+// createPosition adds liquidity to the position of the owner in the given pool over the range [lowerTick, upperTick),
+// creating the position if it does not exist. The liquidity added is the maximum that the desired amounts allow
+// at the current price. The first position of a pool must provide both tokens: their ratio sets the initial price.
+// Returns the amounts of token0 and token1 transferred from the owner to the pool and the liquidity added.
+func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, amount0Desired, amount1Desired, amount0Min, amount1Min sdk.Int, lowerTick, upperTick int64) (amount0, amount1 sdk.Int, liquidityCreated sdk.Dec, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	if err := validateTickRange(pool, lowerTick, upperTick); err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	if !pool.GetCurrentSqrtPrice().IsPositive() {
+		if err := initializeInitialPrice(pool, amount0Desired, amount1Desired); err != nil {
+			return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+		}
+	}
+
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := sqrtPricesOfRange(pool, lowerTick, upperTick)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	liquidityCreated = math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0Desired, amount1Desired)
+	if !liquidityCreated.IsPositive() {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.ErrZeroLiquidity
+	}
+
+	actualAmount0, actualAmount1, err := k.updatePosition(ctx, pool, owner, lowerTick, upperTick, liquidityCreated)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	amount0 = actualAmount0.Ceil().TruncateInt()
+	amount1 = actualAmount1.Ceil().TruncateInt()
+
+	if amount0.GT(amount0Desired) || amount1.GT(amount1Desired) {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrAmountExceedsDesired,
+			"actual amounts (%s, %s), desired amounts (%s, %s)", amount0, amount1, amount0Desired, amount1Desired)
+	}
+	if amount0.LT(amount0Min) || amount1.LT(amount1Min) {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrLimitMinAmount,
+			"actual amounts (%s, %s), min amounts (%s, %s)", amount0, amount1, amount0Min, amount1Min)
+	}
+
+	tokensIn := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1))
+	if err := k.bankKeeper.SendCoins(ctx, owner, pool.GetAddress(), tokensIn); err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	emitPositionEvent(ctx, types.TypeEvtCreatePosition, owner, poolId, lowerTick, upperTick, liquidityCreated, amount0, amount1)
+
+	return amount0, amount1, liquidityCreated, nil
+}
+
+// CreateFullRangePosition adds liquidity to the position of the owner spanning the widest tick range of the pool,
+// taking as desired amounts the amounts of the pool's tokens in the given coins, as createPosition does.
+// Returns the amounts of token0 and token1 transferred from the owner to the pool and the liquidity added.
+func (k Keeper) CreateFullRangePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, coins sdk.Coins) (amount0, amount1 sdk.Int, liquidityCreated sdk.Dec, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	lowerTick, upperTick := fullRangeTicks(pool)
+	return k.createPosition(ctx, poolId, owner, coins.AmountOf(pool.GetToken0()), coins.AmountOf(pool.GetToken1()), sdk.ZeroInt(), sdk.ZeroInt(), lowerTick, upperTick)
+}
+
+// withdrawPosition removes the requested liquidity from the position of the owner in the given pool
+// over the range [lowerTick, upperTick). The fees earned by the position stay in it until collected.
+// Returns the amounts of token0 and token1 transferred from the pool to the owner.
+func (k Keeper) withdrawPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, liquidityAmount sdk.Dec) (amount0, amount1 sdk.Int, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	if !liquidityAmount.IsPositive() {
+		return sdk.Int{}, sdk.Int{}, types.ErrZeroLiquidity
+	}
+
+	position, err := k.getPosition(ctx, poolId, owner, lowerTick, upperTick)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	if liquidityAmount.GT(position.Liquidity) {
+		return sdk.Int{}, sdk.Int{}, types.InsufficientLiquidityError{Actual: liquidityAmount, Available: position.Liquidity}
+	}
+
+	actualAmount0, actualAmount1, err := k.updatePosition(ctx, pool, owner, lowerTick, upperTick, liquidityAmount.Neg())
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	amount0 = actualAmount0.Neg().TruncateInt()
+	amount1 = actualAmount1.Neg().TruncateInt()
+
+	tokensOut := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1))
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), owner, tokensOut); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	emitPositionEvent(ctx, types.TypeEvtWithdrawPosition, owner, poolId, lowerTick, upperTick, liquidityAmount, amount0, amount1)
+
+	return amount0, amount1, nil
+}
+
+// updatePosition applies the liquidity delta to the position of the owner and to the ticks of its range,
+// accrues the fees earned by the position and updates the pool's liquidity if the position is active.
+// Returns the amounts of token0 and token1 corresponding to the liquidity delta, with its sign.
+func (k Keeper) updatePosition(ctx sdk.Context, pool types.ConcentratedPoolExtension, owner sdk.AccAddress, lowerTick, upperTick int64, liquidityDelta sdk.Dec) (sdk.Dec, sdk.Dec, error) {
+	poolId := pool.GetId()
+
+	if err := k.initOrUpdateTick(ctx, pool, lowerTick, liquidityDelta, false); err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	if err := k.initOrUpdateTick(ctx, pool, upperTick, liquidityDelta, true); err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	position, err := k.getOrInitPosition(ctx, poolId, owner, lowerTick, upperTick)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	// the fees are accrued with the ticks initialized, and before they are removed.
+	if err := k.accrueFees(ctx, pool, &position); err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	position.Liquidity = position.Liquidity.Add(liquidityDelta)
+	if position.Liquidity.IsNegative() {
+		return sdk.Dec{}, sdk.Dec{}, types.InsufficientLiquidityError{Actual: liquidityDelta.Neg(), Available: position.Liquidity.Sub(liquidityDelta)}
+	}
+	if position.Liquidity.IsZero() && position.UncollectedFees.IsZero() {
+		k.deletePosition(ctx, poolId, owner, lowerTick, upperTick)
+	} else {
+		k.setPosition(ctx, position)
+	}
+
+	k.removeTickIfEmpty(ctx, poolId, lowerTick)
+	k.removeTickIfEmpty(ctx, poolId, upperTick)
+
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := sqrtPricesOfRange(pool, lowerTick, upperTick)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	actualAmount0, actualAmount1 := pool.CalcActualAmounts(ctx, lowerTick, upperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, liquidityDelta)
+
+	pool.UpdateLiquidityIfActivePosition(ctx, lowerTick, upperTick, liquidityDelta)
+	if err := k.setPool(ctx, pool); err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	return actualAmount0, actualAmount1, nil
+}
+
+// initializeInitialPrice sets the price of a pool without liquidity to the ratio of the amounts
+// provided by its first position.
+func initializeInitialPrice(pool types.ConcentratedPoolExtension, amount0, amount1 sdk.Int) error {
+	if !amount0.IsPositive() || !amount1.IsPositive() {
+		return types.ErrPoolHasNoPrice
+	}
+
+	initialPrice := sdk.NewDecFromInt(amount1).Quo(sdk.NewDecFromInt(amount0))
+	if initialPrice.LT(types.MinSpotPrice) || initialPrice.GT(types.MaxSpotPrice) {
+		return types.PriceBoundError{ProvidedPrice: initialPrice}
+	}
+
+	initialSqrtPrice, err := osmomath.MonotonicSqrt(initialPrice)
+	if err != nil {
+		return err
+	}
+	initialTick, err := math.SqrtPriceToTick(initialSqrtPrice, pool.GetPrecisionFactorAtPriceOne())
+	if err != nil {
+		return err
+	}
+
+	pool.SetCurrentSqrtPrice(initialSqrtPrice)
+	pool.SetCurrentTick(initialTick)
+	return nil
+}
+
+// fullRangeTicks returns the lowest and highest ticks of the pool that are multiples of its tick spacing.
+func fullRangeTicks(pool types.ConcentratedPoolExtension) (lowerTick, upperTick int64) {
+	tickSpacing := int64(pool.GetTickSpacing())
+	minTick, maxTick := math.GetMinAndMaxTicksFromExponentAtPriceOne(pool.GetPrecisionFactorAtPriceOne())
+	// the remainder of a negative tick is negative, so both ticks are rounded towards zero.
+	return minTick - minTick%tickSpacing, maxTick - maxTick%tickSpacing
+}
+
+// sqrtPricesOfRange returns the square root prices of the lower and upper ticks of a range.
+func sqrtPricesOfRange(pool types.ConcentratedPoolExtension, lowerTick, upperTick int64) (sdk.Dec, sdk.Dec, error) {
+	exponentAtPriceOne := pool.GetPrecisionFactorAtPriceOne()
+	sqrtPriceLowerTick, err := math.TickToSqrtPrice(sdk.NewInt(lowerTick), exponentAtPriceOne)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	sqrtPriceUpperTick, err := math.TickToSqrtPrice(sdk.NewInt(upperTick), exponentAtPriceOne)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	return sqrtPriceLowerTick, sqrtPriceUpperTick, nil
+}
+
+func emitPositionEvent(ctx sdk.Context, eventType string, owner sdk.AccAddress, poolId uint64, lowerTick, upperTick int64, liquidity sdk.Dec, amount0, amount1 sdk.Int) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyLowerTick, strconv.FormatInt(lowerTick, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpperTick, strconv.FormatInt(upperTick, 10)),
+			sdk.NewAttribute(types.AttributeKeyLiquidity, liquidity.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount0, amount0.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount1, amount1.String()),
+		),
+	})
+}
@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestCreatePosition() {
+	tests := map[string]struct {
+		emptyPool        bool
+		lowerTick        int64
+		upperTick        int64
+		amount0Desired   sdk.Int
+		amount1Desired   sdk.Int
+		amount0Min       sdk.Int
+		expectActive     bool
+		expectedErrorStr string
+	}{
+		"range around the current price": {
+			lowerTick:      DefaultLowerTick,
+			upperTick:      DefaultUpperTick,
+			amount0Desired: DefaultAmt0,
+			amount1Desired: DefaultAmt1,
+			expectActive:   true,
+		},
+		"range above the current price only takes token0": {
+			lowerTick:      DefaultUpperTick,
+			upperTick:      DefaultUpperTick + 1000,
+			amount0Desired: DefaultAmt0,
+			amount1Desired: DefaultAmt1,
+		},
+		"range below the current price only takes token1": {
+			lowerTick:      DefaultLowerTick - 1000,
+			upperTick:      DefaultLowerTick,
+			amount0Desired: DefaultAmt0,
+			amount1Desired: DefaultAmt1,
+		},
+		"first position of a pool sets its price": {
+			emptyPool:      true,
+			lowerTick:      DefaultLowerTick,
+			upperTick:      DefaultUpperTick,
+			amount0Desired: DefaultAmt0,
+			amount1Desired: DefaultAmt1,
+			expectActive:   true,
+		},
+		"error: first position of a pool with a single token": {
+			emptyPool:        true,
+			lowerTick:        DefaultLowerTick,
+			upperTick:        DefaultUpperTick,
+			amount0Desired:   DefaultAmt0,
+			amount1Desired:   sdk.ZeroInt(),
+			expectedErrorStr: types.ErrPoolHasNoPrice.Error(),
+		},
+		"error: lower tick above upper tick": {
+			lowerTick:        DefaultUpperTick,
+			upperTick:        DefaultLowerTick,
+			amount0Desired:   DefaultAmt0,
+			amount1Desired:   DefaultAmt1,
+			expectedErrorStr: types.InvalidLowerUpperTickError{LowerTick: DefaultUpperTick, UpperTick: DefaultLowerTick}.Error(),
+		},
+		"error: upper tick above max tick": {
+			lowerTick:        DefaultLowerTick,
+			upperTick:        1800001,
+			amount0Desired:   DefaultAmt0,
+			amount1Desired:   DefaultAmt1,
+			expectedErrorStr: types.InvalidTickError{Tick: 1800001, IsLower: false, MinTick: -1080000, MaxTick: 1800000}.Error(),
+		},
+		"error: range above the current price with no token0": {
+			lowerTick:        DefaultUpperTick,
+			upperTick:        DefaultUpperTick + 1000,
+			amount0Desired:   sdk.ZeroInt(),
+			amount1Desired:   DefaultAmt1,
+			expectedErrorStr: types.ErrZeroLiquidity.Error(),
+		},
+		"error: amount0 below min amount": {
+			lowerTick:        DefaultLowerTick,
+			upperTick:        DefaultUpperTick,
+			amount0Desired:   DefaultAmt0,
+			amount1Desired:   sdk.OneInt(),
+			amount0Min:       DefaultAmt0,
+			expectedErrorStr: types.ErrLimitMinAmount.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			owner := s.TestAccs[1]
+
+			var pool types.ConcentratedPoolExtension
+			if tc.emptyPool {
+				pool = s.PrepareConcentratedPool()
+			} else {
+				pool = s.prepareDefaultPool(sdk.ZeroDec())
+			}
+			liquidityBefore := pool.GetLiquidity()
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, tc.amount0Desired), sdk.NewCoin(USDC, tc.amount1Desired)))
+			amount0Min := tc.amount0Min
+			if amount0Min.IsNil() {
+				amount0Min = sdk.ZeroInt()
+			}
+			res, err := s.msgServer.CreatePosition(sdk.WrapSDKContext(s.Ctx), &types.MsgCreatePosition{
+				PoolId:          pool.GetId(),
+				Sender:          owner.String(),
+				LowerTick:       tc.lowerTick,
+				UpperTick:       tc.upperTick,
+				TokenDesired0:   sdk.NewCoin(ETH, tc.amount0Desired),
+				TokenDesired1:   sdk.NewCoin(USDC, tc.amount1Desired),
+				TokenMinAmount0: amount0Min,
+				TokenMinAmount1: sdk.ZeroInt(),
+			})
+			if tc.expectedErrorStr != "" {
+				s.Require().ErrorContains(err, tc.expectedErrorStr)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the amounts deposited never exceed the desired amounts and are transferred to the pool.
+			s.Require().True(res.Amount0.LTE(tc.amount0Desired))
+			s.Require().True(res.Amount1.LTE(tc.amount1Desired))
+			s.Require().True(res.LiquidityCreated.IsPositive())
+			s.Require().Equal(balanceBefore.AmountOf(ETH).Add(tc.amount0Desired).Sub(res.Amount0).String(), s.App.BankKeeper.GetBalance(s.Ctx, owner, ETH).Amount.String())
+			s.Require().Equal(balanceBefore.AmountOf(USDC).Add(tc.amount1Desired).Sub(res.Amount1).String(), s.App.BankKeeper.GetBalance(s.Ctx, owner, USDC).Amount.String())
+
+			positions, err := clKeeper.GetUserPositions(s.Ctx, owner, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Len(positions, 1)
+			s.Require().Equal(res.LiquidityCreated, positions[0].Liquidity)
+
+			lowerTickInfo := clKeeper.GetTickInfo(s.Ctx, pool.GetId(), tc.lowerTick)
+			upperTickInfo := clKeeper.GetTickInfo(s.Ctx, pool.GetId(), tc.upperTick)
+			s.Require().Equal(res.LiquidityCreated, lowerTickInfo.LiquidityGross)
+			s.Require().Equal(res.LiquidityCreated, lowerTickInfo.LiquidityNet)
+			s.Require().Equal(res.LiquidityCreated, upperTickInfo.LiquidityGross)
+			s.Require().Equal(res.LiquidityCreated.Neg(), upperTickInfo.LiquidityNet)
+
+			pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			if tc.expectActive {
+				s.Require().Equal(liquidityBefore.Add(res.LiquidityCreated), pool.GetLiquidity())
+			} else {
+				s.Require().Equal(liquidityBefore, pool.GetLiquidity())
+				s.Require().True(res.Amount0.IsZero() || res.Amount1.IsZero())
+			}
+
+			// the price of a new pool is set by the ratio of the amounts desired.
+			if tc.emptyPool {
+				spotPrice, err := pool.SpotPrice(s.Ctx, USDC, ETH)
+				s.Require().NoError(err)
+				errTolerance := osmomath.ErrTolerance{AdditiveTolerance: sdk.OneDec()}
+				s.Require().Equal(0, errTolerance.Compare(sdk.NewInt(5000), spotPrice.TruncateInt()))
+			}
+		})
+	}
+}
+
+// TestCreateFullRangePosition tests that full range positions span the widest ticks of the pool
+// that are multiples of its tick spacing.
+func (s *KeeperTestSuite) TestCreateFullRangePosition() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	owner := s.TestAccs[0]
+	// ticks range from -1080 to 1800 at this precision factor, which a spacing of 1000 rounds to -1000 and 1000.
+	pool := s.PrepareCustomConcentratedPool(owner, ETH, USDC, 1000, sdk.NewInt(-1), sdk.ZeroDec())
+	coins := sdk.NewCoins(sdk.NewCoin(ETH, DefaultAmt0), sdk.NewCoin(USDC, DefaultAmt1), sdk.NewInt64Coin("foo", 1000))
+	s.FundAcc(owner, coins)
+
+	amount0, amount1, liquidityCreated, err := clKeeper.CreateFullRangePosition(s.Ctx, pool.GetId(), owner, coins)
+	s.Require().NoError(err)
+	s.Require().True(liquidityCreated.IsPositive())
+	s.Require().True(amount0.LTE(DefaultAmt0))
+	s.Require().True(amount1.LTE(DefaultAmt1))
+
+	positions, err := clKeeper.GetUserPositions(s.Ctx, owner, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Len(positions, 1)
+	s.Require().Equal(int64(-1000), positions[0].LowerTick)
+	s.Require().Equal(int64(1000), positions[0].UpperTick)
+	s.Require().Equal(liquidityCreated, positions[0].Liquidity)
+
+	// the coins that are not tokens of the pool are left to the owner.
+	s.Require().Equal(sdk.NewInt(1000), s.App.BankKeeper.GetBalance(s.Ctx, owner, "foo").Amount)
+}
+
+func (s *KeeperTestSuite) TestWithdrawPosition() {
+	tests := map[string]struct {
+		liquidityFraction sdk.Dec
+		expectedErrorStr  string
+	}{
+		"withdraw all liquidity": {
+			liquidityFraction: sdk.OneDec(),
+		},
+		"withdraw half of the liquidity": {
+			liquidityFraction: sdk.NewDecWithPrec(5, 1),
+		},
+		"error: withdraw more than the liquidity": {
+			liquidityFraction: sdk.NewDec(2),
+			expectedErrorStr:  "insufficient liquidity",
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			owner := s.TestAccs[1]
+
+			pool := s.prepareDefaultPool(sdk.ZeroDec())
+			createRes, err := s.createPosition(pool.GetId(), owner, DefaultAmt0, DefaultAmt1, DefaultLowerTick, DefaultUpperTick)
+			s.Require().NoError(err)
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			liquidityAmount := createRes.LiquidityCreated.Mul(tc.liquidityFraction)
+			res, err := s.msgServer.WithdrawPosition(sdk.WrapSDKContext(s.Ctx), &types.MsgWithdrawPosition{
+				PoolId:          pool.GetId(),
+				Sender:          owner.String(),
+				LowerTick:       DefaultLowerTick,
+				UpperTick:       DefaultUpperTick,
+				LiquidityAmount: liquidityAmount,
+			})
+			if tc.expectedErrorStr != "" {
+				s.Require().ErrorContains(err, tc.expectedErrorStr)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the amounts withdrawn never exceed the amounts deposited.
+			expectedAmount0 := sdk.NewDecFromInt(createRes.Amount0).Mul(tc.liquidityFraction).TruncateInt()
+			expectedAmount1 := sdk.NewDecFromInt(createRes.Amount1).Mul(tc.liquidityFraction).TruncateInt()
+			s.Require().True(res.Amount0.LTE(expectedAmount0))
+			s.Require().True(res.Amount1.LTE(expectedAmount1))
+			s.Require().True(expectedAmount0.Sub(res.Amount0).LTE(sdk.OneInt()))
+			s.Require().True(expectedAmount1.Sub(res.Amount1).LTE(sdk.OneInt()))
+
+			balanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			s.Require().Equal(balanceBefore.Add(sdk.NewCoin(ETH, res.Amount0), sdk.NewCoin(USDC, res.Amount1)), balanceAfter)
+
+			positions, err := clKeeper.GetUserPositions(s.Ctx, owner, pool.GetId())
+			s.Require().NoError(err)
+			if tc.liquidityFraction.Equal(sdk.OneDec()) {
+				// the position and its ticks are removed once empty.
+				s.Require().Len(positions, 0)
+				s.Require().True(clKeeper.GetTickInfo(s.Ctx, pool.GetId(), DefaultLowerTick).LiquidityGross.IsZero())
+				s.Require().True(clKeeper.GetTickInfo(s.Ctx, pool.GetId(), DefaultUpperTick).LiquidityGross.IsZero())
+			} else {
+				s.Require().Len(positions, 1)
+				s.Require().Equal(createRes.LiquidityCreated.Sub(liquidityAmount), positions[0].Liquidity)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+type msgServer struct {
+	keeper *Keeper
+}
+
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+func NewMsgCreatorServerImpl(keeper *Keeper) model.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var (
+	_ types.MsgServer = msgServer{}
+	_ model.MsgServer = msgServer{}
+)
+
+// CreateConcentratedPool creates a concentrated liquidity pool through the pool manager.
+// The pool creation fee is used to fund the community pool.
+func (server msgServer) CreateConcentratedPool(goCtx context.Context, msg *model.MsgCreateConcentratedPool) (*model.MsgCreateConcentratedPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Send pool creation fee to community pool
+	params := server.keeper.GetParams(ctx)
+	sender := msg.PoolCreator()
+	if err := server.keeper.communityPoolKeeper.FundCommunityPool(ctx, params.PoolCreationFee, sender); err != nil {
+		return nil, err
+	}
+
+	poolId, err := server.keeper.poolManager.CreatePool(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			gammtypes.TypeEvtPoolCreated,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &model.MsgCreateConcentratedPoolResponse{PoolID: poolId}, nil
+}
+
+// CreatePosition adds liquidity to a position of the sender over a tick range of a pool.
+func (server msgServer) CreatePosition(goCtx context.Context, msg *types.MsgCreatePosition) (*types.MsgCreatePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := server.keeper.getPoolById(ctx, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the desired tokens may be given in any order.
+	tokensDesired := sdk.NewCoins(msg.TokenDesired0, msg.TokenDesired1)
+	for _, token := range []sdk.Coin{msg.TokenDesired0, msg.TokenDesired1} {
+		if token.Denom != pool.GetToken0() && token.Denom != pool.GetToken1() {
+			return nil, types.DenomNotInPoolError{PoolId: msg.PoolId, Denom: token.Denom}
+		}
+	}
+	amount0Desired := tokensDesired.AmountOf(pool.GetToken0())
+	amount1Desired := tokensDesired.AmountOf(pool.GetToken1())
+	amount0Min, amount1Min := msg.TokenMinAmount0, msg.TokenMinAmount1
+	if msg.TokenDesired0.Denom != pool.GetToken0() {
+		amount0Min, amount1Min = amount1Min, amount0Min
+	}
+
+	actualAmount0, actualAmount1, liquidityCreated, err := server.keeper.createPosition(ctx, msg.PoolId, sender, amount0Desired, amount1Desired, amount0Min, amount1Min, msg.LowerTick, msg.UpperTick)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgCreatePositionResponse{Amount0: actualAmount0, Amount1: actualAmount1, LiquidityCreated: liquidityCreated}, nil
+}
+
+// WithdrawPosition removes liquidity from a position of the sender.
+func (server msgServer) WithdrawPosition(goCtx context.Context, msg *types.MsgWithdrawPosition) (*types.MsgWithdrawPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	amount0, amount1, err := server.keeper.withdrawPosition(ctx, msg.PoolId, sender, msg.LowerTick, msg.UpperTick, msg.LiquidityAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgWithdrawPositionResponse{Amount0: amount0, Amount1: amount1}, nil
+}
+
+// CollectFees sends the swap fees earned by a position of the sender to the sender.
+func (server msgServer) CollectFees(goCtx context.Context, msg *types.MsgCollectFees) (*types.MsgCollectFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	collectedFees, err := server.keeper.collectFees(ctx, msg.PoolId, sender, msg.LowerTick, msg.UpperTick)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCollectFees,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyLowerTick, strconv.FormatInt(msg.LowerTick, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpperTick, strconv.FormatInt(msg.UpperTick, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, collectedFees.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgCollectFeesResponse{CollectedFees: collectedFees}, nil
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// InitializePool initializes the state of a concentrated liquidity pool created by the pool manager.
+// It validates the tick spacing against the authorized ones, stores the pool and its empty fee accumulator.
+// The pool has no liquidity and no price until its first position is created.
+func (k Keeper) InitializePool(ctx sdk.Context, pool poolmanagertypes.PoolI, _ sdk.AccAddress) error {
+	concentratedPool, err := asConcentrated(pool)
+	if err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	tickSpacing := concentratedPool.GetTickSpacing()
+	if !params.IsAuthorizedTickSpacing(tickSpacing) {
+		return types.UnauthorizedTickSpacingError{ProvidedTickSpacing: tickSpacing, AuthorizedTickSpacings: params.AuthorizedTickSpacing}
+	}
+
+	if err := k.setPool(ctx, concentratedPool); err != nil {
+		return err
+	}
+	k.setFeeAccumulator(ctx, types.FeeAccumulator{PoolId: concentratedPool.GetId(), FeeGrowthGlobal: sdk.DecCoins{}})
+
+	return nil
+}
+
+// GetPool returns the concentrated liquidity pool with the given id as a PoolI.
+func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error) {
+	return k.getPoolById(ctx, poolId)
+}
+
+// GetConcentratedPoolById returns the concentrated liquidity pool with the given id.
+func (k Keeper) GetConcentratedPoolById(ctx sdk.Context, poolId uint64) (types.ConcentratedPoolExtension, error) {
+	return k.getPoolById(ctx, poolId)
+}
+
+func (k Keeper) getPoolById(ctx sdk.Context, poolId uint64) (types.ConcentratedPoolExtension, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPool(poolId))
+	if bz == nil {
+		return nil, types.PoolNotFoundError{PoolId: poolId}
+	}
+
+	return k.unmarshalPool(bz)
+}
+
+// GetPools returns all the concentrated liquidity pools.
+func (k Keeper) GetPools(ctx sdk.Context) ([]types.ConcentratedPoolExtension, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixPools)
+	defer iter.Close() //nolint:errcheck
+
+	pools := []types.ConcentratedPoolExtension{}
+	for ; iter.Valid(); iter.Next() {
+		pool, err := k.unmarshalPool(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, pool)
+	}
+
+	return pools, nil
+}
+
+// GetTotalPoolLiquidity returns the coins held by the pool's account on behalf of all the positions.
+func (k Keeper) GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := k.bankKeeper.GetAllBalances(ctx, pool.GetAddress())
+	return sdk.NewCoins(
+		sdk.NewCoin(pool.GetToken0(), balances.AmountOf(pool.GetToken0())),
+		sdk.NewCoin(pool.GetToken1(), balances.AmountOf(pool.GetToken1())),
+	), nil
+}
+
+func (k Keeper) setPool(ctx sdk.Context, pool types.ConcentratedPoolExtension) error {
+	bz, err := k.cdc.MarshalInterface(pool)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPool(pool.GetId()), bz)
+	return nil
+}
+
+func (k Keeper) unmarshalPool(bz []byte) (types.ConcentratedPoolExtension, error) {
+	var pool types.ConcentratedPoolExtension
+	return pool, k.cdc.UnmarshalInterface(bz, &pool)
+}
+
+// asConcentrated converts a PoolI to a ConcentratedPoolExtension.
+func asConcentrated(pool poolmanagertypes.PoolI) (types.ConcentratedPoolExtension, error) {
+	concentratedPool, ok := pool.(types.ConcentratedPoolExtension)
+	if !ok {
+		return nil, fmt.Errorf("%w: pool %d of type %s", types.ErrNotConcentratedPool, pool.GetId(), pool.GetType())
+	}
+	return concentratedPool, nil
+}
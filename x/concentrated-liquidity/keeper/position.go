@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+// getPosition returns the position of the owner in the given pool over the range [lowerTick, upperTick).
+func (k Keeper) getPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) (types.Position, error) {
+	store := ctx.KVStore(k.storeKey)
+	position := types.Position{}
+	found, err := osmoutils.Get(store, types.KeyPosition(owner, poolId, lowerTick, upperTick), &position)
+	if err != nil {
+		return types.Position{}, err
+	}
+	if !found {
+		return types.Position{}, types.PositionNotFoundError{PoolId: poolId, LowerTick: lowerTick, UpperTick: upperTick}
+	}
+	return position, nil
+}
+
+// getOrInitPosition returns the position of the owner in the given pool over the range [lowerTick, upperTick),
+// or an empty position if it does not exist yet.
+func (k Keeper) getOrInitPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) (types.Position, error) {
+	position, err := k.getPosition(ctx, poolId, owner, lowerTick, upperTick)
+	if err == nil {
+		return position, nil
+	}
+	if _, ok := err.(types.PositionNotFoundError); !ok {
+		return types.Position{}, err
+	}
+
+	return types.Position{
+		Address:             owner.String(),
+		PoolId:              poolId,
+		LowerTick:           lowerTick,
+		UpperTick:           upperTick,
+		Liquidity:           sdk.ZeroDec(),
+		FeeGrowthInsideLast: sdk.DecCoins{},
+		UncollectedFees:     sdk.DecCoins{},
+	}, nil
+}
+
+func (k Keeper) setPosition(ctx sdk.Context, position types.Position) {
+	owner := sdk.MustAccAddressFromBech32(position.Address)
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyPosition(owner, position.PoolId, position.LowerTick, position.UpperTick), &position)
+}
+
+func (k Keeper) deletePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPosition(owner, poolId, lowerTick, upperTick))
+}
+
+// GetUserPositions returns the positions of the given address. If poolId is zero,
+// the positions in all the pools are returned.
+func (k Keeper) GetUserPositions(ctx sdk.Context, addr sdk.AccAddress, poolId uint64) ([]types.Position, error) {
+	prefix := types.KeyUserPositions(addr)
+	if poolId != 0 {
+		prefix = types.KeyUserPositionsByPoolId(addr, poolId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	return osmoutils.GatherValuesFromStorePrefix(store, prefix, parsePosition)
+}
+
+// GetAllPositions returns the positions of all the users.
+func (k Keeper) GetAllPositions(ctx sdk.Context) ([]types.Position, error) {
+	store := ctx.KVStore(k.storeKey)
+	return osmoutils.GatherValuesFromStorePrefix(store, types.KeyPrefixPositions, parsePosition)
+}
+
+func parsePosition(value []byte) (types.Position, error) {
+	position := types.Position{}
+	err := position.Unmarshal(value)
+	return position, err
+}
@@ -0,0 +1,428 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/events"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// swapState tracks the state of a swap while it steps through the ticks of a pool.
+type swapState struct {
+	// amountSpecifiedRemaining is the amount of the specified token (token in for an exact amount in swap,
+	// token out for an exact amount out swap) that remains to be swapped.
+	amountSpecifiedRemaining sdk.Dec
+	// amountCalculated is the amount of the other token computed so far.
+	amountCalculated sdk.Dec
+	sqrtPrice        sdk.Dec
+	tick             int64
+	liquidity        sdk.Dec
+	feeGrowthGlobal  sdk.DecCoins
+}
+
+// swapResult is the state of the pool after a swap, along with the amounts swapped.
+type swapResult struct {
+	tokenIn      sdk.Coin
+	tokenOut     sdk.Coin
+	newTick      sdk.Int
+	newLiquidity sdk.Dec
+	newSqrtPrice sdk.Dec
+}
+
+// SwapExactAmountIn swaps an exact amount of tokenIn for as much tokenOutDenom as the pool's liquidity gives,
+// using the provided swapFee. Returns an error if the amount out is lower than tokenOutMinAmount.
+func (k Keeper) SwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
+) (tokenOutAmount sdk.Int, err error) {
+	if tokenIn.Denom == tokenOutDenom {
+		return sdk.Int{}, types.ErrSameDenomSwap
+	}
+	poolSwapFee := poolI.GetSwapFee(ctx)
+	if swapFee.LT(poolSwapFee.QuoInt64(2)) {
+		return sdk.Int{}, fmt.Errorf("given swap fee (%s) must be greater than or equal to half of the pool's swap fee (%s)", swapFee, poolSwapFee)
+	}
+
+	pool, err := asConcentrated(poolI)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	result, err := k.computeOutAmtGivenIn(ctx, pool, tokenIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenOutAmount = result.tokenOut.Amount
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s token is lesser than min amount", tokenOutDenom)
+	}
+
+	if err := k.updatePoolForSwap(ctx, pool, sender, result, swapFee); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return tokenOutAmount, nil
+}
+
+// SwapExactAmountOut swaps as little tokenInDenom as the pool's liquidity requires for an exact amount of tokenOut,
+// using the provided swapFee. Returns an error if the amount in is greater than tokenInMaxAmount.
+func (k Keeper) SwapExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolI poolmanagertypes.PoolI,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
+) (tokenInAmount sdk.Int, err error) {
+	if tokenInDenom == tokenOut.Denom {
+		return sdk.Int{}, types.ErrSameDenomSwap
+	}
+	poolSwapFee := poolI.GetSwapFee(ctx)
+	if swapFee.LT(poolSwapFee.QuoInt64(2)) {
+		return sdk.Int{}, fmt.Errorf("given swap fee (%s) must be greater than or equal to half of the pool's swap fee (%s)", swapFee, poolSwapFee)
+	}
+
+	pool, err := asConcentrated(poolI)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	result, err := k.computeInAmtGivenOut(ctx, pool, tokenOut, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenInAmount = result.tokenIn.Amount
+	if tokenInAmount.GT(tokenInMaxAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "Swap requires %s, which is greater than the amount %s", result.tokenIn, tokenInMaxAmount)
+	}
+
+	if err := k.updatePoolForSwap(ctx, pool, sender, result, swapFee); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return tokenInAmount, nil
+}
+
+// CalcOutAmtGivenIn calculates the amount of tokenOut given tokenIn and the pool's current state.
+// The state is left untouched.
+func (k Keeper) CalcOutAmtGivenIn(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+) (tokenOut sdk.Coin, err error) {
+	pool, err := asConcentrated(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	result, err := k.computeOutAmtGivenIn(cacheCtx, pool, tokenIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return result.tokenOut, nil
+}
+
+// CalcInAmtGivenOut calculates the amount of tokenIn given tokenOut and the pool's current state.
+// The state is left untouched.
+func (k Keeper) CalcInAmtGivenOut(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (tokenIn sdk.Coin, err error) {
+	pool, err := asConcentrated(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	result, err := k.computeInAmtGivenOut(cacheCtx, pool, tokenOut, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return result.tokenIn, nil
+}
+
+// SimulateSwapExactAmountIn calculates the amount of tokenOut given tokenIn and returns the pool
+// with its price, tick and liquidity moved by the swap. The state is left untouched.
+func (k Keeper) SimulateSwapExactAmountIn(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+) (tokenOut sdk.Coin, poolAfterSwap poolmanagertypes.PoolI, err error) {
+	pool, err := asConcentrated(poolI)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	result, err := k.computeOutAmtGivenIn(cacheCtx, pool, tokenIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	if err := pool.ApplySwap(result.newLiquidity, result.newTick, result.newSqrtPrice); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	return result.tokenOut, pool, nil
+}
+
+// SimulateSwapExactAmountOut calculates the amount of tokenIn given tokenOut and returns the pool
+// with its price, tick and liquidity moved by the swap. The state is left untouched.
+func (k Keeper) SimulateSwapExactAmountOut(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (tokenIn sdk.Coin, poolAfterSwap poolmanagertypes.PoolI, err error) {
+	pool, err := asConcentrated(poolI)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	result, err := k.computeInAmtGivenOut(cacheCtx, pool, tokenOut, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	if err := pool.ApplySwap(result.newLiquidity, result.newTick, result.newSqrtPrice); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	return result.tokenIn, pool, nil
+}
+
+// computeOutAmtGivenIn steps the swap of tokenIn through the ticks of the pool until it is fully consumed.
+// It updates the ticks crossed and the pool's fee accumulator, but not the pool itself.
+func (k Keeper) computeOutAmtGivenIn(ctx sdk.Context, pool types.ConcentratedPoolExtension, tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec) (swapResult, error) {
+	zeroForOne, err := validateSwapDenoms(pool, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return swapResult{}, err
+	}
+	if !tokenIn.Amount.IsPositive() {
+		return swapResult{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "token in (%s) must be positive", tokenIn)
+	}
+
+	state, err := k.swapStep(ctx, pool, tokenIn.Denom, sdk.NewDecFromInt(tokenIn.Amount), swapFee, zeroForOne, true)
+	if err != nil {
+		return swapResult{}, err
+	}
+
+	tokenOutAmount := state.amountCalculated.TruncateInt()
+	if !tokenOutAmount.IsPositive() {
+		return swapResult{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+
+	return swapResult{
+		tokenIn:      tokenIn,
+		tokenOut:     sdk.NewCoin(tokenOutDenom, tokenOutAmount),
+		newTick:      sdk.NewInt(state.tick),
+		newLiquidity: state.liquidity,
+		newSqrtPrice: state.sqrtPrice,
+	}, nil
+}
+
+// computeInAmtGivenOut steps the swap of tokenOut through the ticks of the pool until it is fully filled.
+// It updates the ticks crossed and the pool's fee accumulator, but not the pool itself.
+func (k Keeper) computeInAmtGivenOut(ctx sdk.Context, pool types.ConcentratedPoolExtension, tokenOut sdk.Coin, tokenInDenom string, swapFee sdk.Dec) (swapResult, error) {
+	zeroForOne, err := validateSwapDenoms(pool, tokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return swapResult{}, err
+	}
+	if !tokenOut.Amount.IsPositive() {
+		return swapResult{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "token out (%s) must be positive", tokenOut)
+	}
+
+	state, err := k.swapStep(ctx, pool, tokenInDenom, sdk.NewDecFromInt(tokenOut.Amount), swapFee, zeroForOne, false)
+	if err != nil {
+		return swapResult{}, err
+	}
+
+	tokenInAmount := state.amountCalculated.Ceil().TruncateInt()
+	if !tokenInAmount.IsPositive() {
+		return swapResult{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+
+	return swapResult{
+		tokenIn:      sdk.NewCoin(tokenInDenom, tokenInAmount),
+		tokenOut:     tokenOut,
+		newTick:      sdk.NewInt(state.tick),
+		newLiquidity: state.liquidity,
+		newSqrtPrice: state.sqrtPrice,
+	}, nil
+}
+
+// swapStep swaps amountSpecified through the ticks of the pool, one range of constant liquidity at a time.
+// amountSpecified is the amount in if exactIn is true, the amount out otherwise. The swap fee is charged on
+// the token in and added to the fee growth of the pool. Returns an error if the liquidity of the pool is
+// exhausted before the amount specified is fully swapped.
+func (k Keeper) swapStep(ctx sdk.Context, pool types.ConcentratedPoolExtension, tokenInDenom string, amountSpecified, swapFee sdk.Dec, zeroForOne, exactIn bool) (swapState, error) {
+	if !pool.GetCurrentSqrtPrice().IsPositive() {
+		return swapState{}, types.ErrPoolHasNoPrice
+	}
+
+	poolId := pool.GetId()
+	feeAccumulator, err := k.getFeeAccumulator(ctx, poolId)
+	if err != nil {
+		return swapState{}, err
+	}
+
+	exponentAtPriceOne := pool.GetPrecisionFactorAtPriceOne()
+	minTick, maxTick := math.GetMinAndMaxTicksFromExponentAtPriceOne(exponentAtPriceOne)
+
+	state := swapState{
+		amountSpecifiedRemaining: amountSpecified,
+		amountCalculated:         sdk.ZeroDec(),
+		sqrtPrice:                pool.GetCurrentSqrtPrice(),
+		tick:                     pool.GetCurrentTick().Int64(),
+		liquidity:                pool.GetLiquidity(),
+		feeGrowthGlobal:          feeAccumulator.FeeGrowthGlobal,
+	}
+
+	for state.amountSpecifiedRemaining.IsPositive() {
+		nextTick, found := k.nextInitializedTick(ctx, poolId, state.tick, zeroForOne)
+		if !found {
+			// there is no liquidity beyond, the swap may only move the price up to the bound of the pool.
+			nextTick = maxTick
+			if zeroForOne {
+				nextTick = minTick
+			}
+		}
+
+		sqrtPriceTarget, err := math.TickToSqrtPrice(sdk.NewInt(nextTick), exponentAtPriceOne)
+		if err != nil {
+			return swapState{}, err
+		}
+
+		var sqrtPriceNext, amountIn, amountOut, feeCharged sdk.Dec
+		if exactIn {
+			sqrtPriceNext, amountIn, amountOut, feeCharged = math.ComputeSwapStepOutGivenIn(
+				state.sqrtPrice, sqrtPriceTarget, state.liquidity, state.amountSpecifiedRemaining, swapFee, zeroForOne)
+			state.amountSpecifiedRemaining = state.amountSpecifiedRemaining.Sub(amountIn.Add(feeCharged))
+			state.amountCalculated = state.amountCalculated.Add(amountOut)
+		} else {
+			sqrtPriceNext, amountIn, amountOut, feeCharged = math.ComputeSwapStepInGivenOut(
+				state.sqrtPrice, sqrtPriceTarget, state.liquidity, state.amountSpecifiedRemaining, swapFee, zeroForOne)
+			state.amountSpecifiedRemaining = state.amountSpecifiedRemaining.Sub(amountOut)
+			state.amountCalculated = state.amountCalculated.Add(amountIn.Add(feeCharged))
+		}
+
+		if state.liquidity.IsPositive() && feeCharged.IsPositive() {
+			feeGrowth := sdk.NewDecCoinFromDec(tokenInDenom, feeCharged.QuoTruncate(state.liquidity))
+			state.feeGrowthGlobal = state.feeGrowthGlobal.Add(feeGrowth)
+		}
+
+		state.sqrtPrice = sqrtPriceNext
+		if !sqrtPriceNext.Equal(sqrtPriceTarget) {
+			state.tick, err = nextTickFromSqrtPrice(sqrtPriceNext, exponentAtPriceOne, minTick, maxTick)
+			if err != nil {
+				return swapState{}, err
+			}
+			continue
+		}
+
+		if !found {
+			// the bound of the pool is reached.
+			state.tick = nextTick
+			break
+		}
+
+		liquidityNet, err := k.crossTick(ctx, poolId, nextTick, state.feeGrowthGlobal)
+		if err != nil {
+			return swapState{}, err
+		}
+		if zeroForOne {
+			state.liquidity = state.liquidity.Sub(liquidityNet)
+			state.tick = nextTick - 1
+		} else {
+			state.liquidity = state.liquidity.Add(liquidityNet)
+			state.tick = nextTick
+		}
+	}
+
+	if state.amountSpecifiedRemaining.IsPositive() {
+		return swapState{}, sdkerrors.Wrapf(types.ErrNotEnoughLiquidity, "pool %d, amount remaining %s", poolId, state.amountSpecifiedRemaining)
+	}
+
+	feeAccumulator.FeeGrowthGlobal = state.feeGrowthGlobal
+	k.setFeeAccumulator(ctx, feeAccumulator)
+
+	return state, nil
+}
+
+// updatePoolForSwap applies the result of a swap to the pool, and settles the balances
+// between the sender and the pool.
+func (k Keeper) updatePoolForSwap(ctx sdk.Context, pool types.ConcentratedPoolExtension, sender sdk.AccAddress, result swapResult, swapFee sdk.Dec) error {
+	if err := pool.ApplySwap(result.newLiquidity, result.newTick, result.newSqrtPrice); err != nil {
+		return err
+	}
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), sdk.Coins{result.tokenIn}); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, sdk.Coins{result.tokenOut}); err != nil {
+		return err
+	}
+
+	spotPrice, err := pool.SpotPrice(ctx, result.tokenIn.Denom, result.tokenOut.Denom)
+	if err != nil {
+		return err
+	}
+
+	// the taker fee is charged by the entrypoint of the swap, not by the pool.
+	events.EmitSwapEvent(ctx, sender, pool.GetId(), sdk.Coins{result.tokenIn}, sdk.Coins{result.tokenOut}, spotPrice, sdk.ZeroDec(), swapFee)
+	return nil
+}
+
+// validateSwapDenoms checks that both denoms are the tokens of the pool and returns
+// true if token0 is swapped in, i.e. if the price moves down.
+func validateSwapDenoms(pool types.ConcentratedPoolExtension, tokenInDenom, tokenOutDenom string) (zeroForOne bool, err error) {
+	if tokenInDenom == tokenOutDenom {
+		return false, types.ErrSameDenomSwap
+	}
+	for _, denom := range []string{tokenInDenom, tokenOutDenom} {
+		if denom != pool.GetToken0() && denom != pool.GetToken1() {
+			return false, types.DenomNotInPoolError{PoolId: pool.GetId(), Denom: denom}
+		}
+	}
+	return tokenInDenom == pool.GetToken0(), nil
+}
+
+// nextTickFromSqrtPrice returns the tick of a square root price reached within a range of constant liquidity.
+func nextTickFromSqrtPrice(sqrtPrice sdk.Dec, exponentAtPriceOne sdk.Int, minTick, maxTick int64) (int64, error) {
+	tick, err := math.SqrtPriceToTick(sqrtPrice, exponentAtPriceOne)
+	if err != nil {
+		var priceBoundErr types.PriceBoundError
+		if !errors.As(err, &priceBoundErr) {
+			return 0, err
+		}
+		// the price rounding may fall slightly out of the bounds of the pool.
+		if priceBoundErr.ProvidedPrice.LT(types.MinSpotPrice) {
+			return minTick, nil
+		}
+		return maxTick, nil
+	}
+	return tick.Int64(), nil
+}
@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+// prepareDefaultPoolWithNarrowPosition returns the default pool with an additional position
+// over [DefaultLowerTick, DefaultUpperTick) owned by the second test account.
+func (s *KeeperTestSuite) prepareDefaultPoolWithNarrowPosition(swapFee sdk.Dec) (pool types.ConcentratedPoolExtension, fullRangeLiquidity sdk.Dec) {
+	pool = s.prepareDefaultPool(swapFee)
+	fullRangeLiquidity = pool.GetLiquidity()
+
+	_, err := s.createPosition(pool.GetId(), s.TestAccs[1], DefaultAmt0, DefaultAmt1, DefaultLowerTick, DefaultUpperTick)
+	s.Require().NoError(err)
+
+	pool, err = s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	return pool, fullRangeLiquidity
+}
+
+func (s *KeeperTestSuite) TestSwapExactAmountIn() {
+	tests := map[string]struct {
+		tokenIn           sdk.Coin
+		tokenOutDenom     string
+		tokenOutMinAmount sdk.Int
+		swapFee           sdk.Dec
+
+		expectTickCrossed bool
+		expectedErrorStr  string
+	}{
+		"eth in within the narrow range": {
+			tokenIn:           sdk.NewCoin(ETH, sdk.NewInt(10000)),
+			tokenOutDenom:     USDC,
+			tokenOutMinAmount: sdk.OneInt(),
+			swapFee:           sdk.ZeroDec(),
+		},
+		"usdc in within the narrow range": {
+			tokenIn:           sdk.NewCoin(USDC, sdk.NewInt(50000000)),
+			tokenOutDenom:     ETH,
+			tokenOutMinAmount: sdk.OneInt(),
+			swapFee:           sdk.ZeroDec(),
+		},
+		"eth in with swap fee": {
+			tokenIn:           sdk.NewCoin(ETH, sdk.NewInt(10000)),
+			tokenOutDenom:     USDC,
+			tokenOutMinAmount: sdk.OneInt(),
+			swapFee:           sdk.NewDecWithPrec(1, 2),
+		},
+		"eth in crossing the lower tick of the narrow range": {
+			tokenIn:           sdk.NewCoin(ETH, sdk.NewInt(2000000)),
+			tokenOutDenom:     USDC,
+			tokenOutMinAmount: sdk.OneInt(),
+			swapFee:           sdk.ZeroDec(),
+			expectTickCrossed: true,
+		},
+		"usdc in crossing the upper tick of the narrow range": {
+			tokenIn:           sdk.NewCoin(USDC, sdk.NewInt(10000000000)),
+			tokenOutDenom:     ETH,
+			tokenOutMinAmount: sdk.OneInt(),
+			swapFee:           sdk.ZeroDec(),
+			expectTickCrossed: true,
+		},
+		"error: amount out below min amount": {
+			tokenIn:           sdk.NewCoin(ETH, sdk.NewInt(10000)),
+			tokenOutDenom:     USDC,
+			tokenOutMinAmount: sdk.NewInt(100000000),
+			swapFee:           sdk.ZeroDec(),
+			expectedErrorStr:  types.ErrLimitMinAmount.Error(),
+		},
+		"error: same denom": {
+			tokenIn:           sdk.NewCoin(ETH, sdk.NewInt(10000)),
+			tokenOutDenom:     ETH,
+			tokenOutMinAmount: sdk.OneInt(),
+			swapFee:           sdk.ZeroDec(),
+			expectedErrorStr:  types.ErrSameDenomSwap.Error(),
+		},
+		"error: denom not in pool": {
+			tokenIn:           sdk.NewCoin("foo", sdk.NewInt(10000)),
+			tokenOutDenom:     USDC,
+			tokenOutMinAmount: sdk.OneInt(),
+			swapFee:           sdk.ZeroDec(),
+			expectedErrorStr:  "foo",
+		},
+		"error: not enough liquidity": {
+			tokenIn:           sdk.NewCoin(ETH, sdk.NewInt(1000000000000000)),
+			tokenOutDenom:     USDC,
+			tokenOutMinAmount: sdk.OneInt(),
+			swapFee:           sdk.ZeroDec(),
+			expectedErrorStr:  types.ErrNotEnoughLiquidity.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			sender := s.TestAccs[2]
+
+			pool, fullRangeLiquidity := s.prepareDefaultPoolWithNarrowPosition(tc.swapFee)
+			s.FundAcc(sender, sdk.NewCoins(tc.tokenIn))
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+			poolBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+			sqrtPriceBefore, liquidityBefore := pool.GetCurrentSqrtPrice(), pool.GetLiquidity()
+
+			expectedTokenOut, calcErr := clKeeper.CalcOutAmtGivenIn(s.Ctx, pool, tc.tokenIn, tc.tokenOutDenom, tc.swapFee)
+
+			tokenOutAmount, err := clKeeper.SwapExactAmountIn(s.Ctx, sender, pool, tc.tokenIn, tc.tokenOutDenom, tc.tokenOutMinAmount, tc.swapFee)
+			if tc.expectedErrorStr != "" {
+				s.Require().ErrorContains(err, tc.expectedErrorStr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NoError(calcErr)
+
+			// the estimate matches the swap and the tokens are exchanged with the pool.
+			s.Require().Equal(expectedTokenOut.Amount.String(), tokenOutAmount.String())
+			tokenOut := sdk.NewCoin(tc.tokenOutDenom, tokenOutAmount)
+			s.Require().Equal(balanceBefore.Sub(tc.tokenIn).Add(tokenOut).String(), s.App.BankKeeper.GetAllBalances(s.Ctx, sender).String())
+			s.Require().Equal(poolBalanceBefore.Add(tc.tokenIn).Sub(tokenOut).String(), s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress()).String())
+
+			poolAfter, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			if tc.tokenIn.Denom == pool.GetToken0() {
+				s.Require().True(poolAfter.GetCurrentSqrtPrice().LT(sqrtPriceBefore))
+			} else {
+				s.Require().True(poolAfter.GetCurrentSqrtPrice().GT(sqrtPriceBefore))
+			}
+
+			// once out of the narrow range, only the full range position remains active.
+			currentTick := poolAfter.GetCurrentTick().Int64()
+			if tc.expectTickCrossed {
+				s.Require().True(currentTick < DefaultLowerTick || currentTick >= DefaultUpperTick)
+				s.Require().Equal(fullRangeLiquidity.String(), poolAfter.GetLiquidity().String())
+			} else {
+				s.Require().True(currentTick >= DefaultLowerTick && currentTick < DefaultUpperTick)
+				s.Require().Equal(liquidityBefore.String(), poolAfter.GetLiquidity().String())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSwapExactAmountOut() {
+	tests := map[string]struct {
+		tokenOut         sdk.Coin
+		tokenInDenom     string
+		tokenInMaxAmount sdk.Int
+		swapFee          sdk.Dec
+
+		expectTickCrossed bool
+		expectedErrorStr  string
+	}{
+		"usdc out within the narrow range": {
+			tokenOut:         sdk.NewCoin(USDC, sdk.NewInt(50000000)),
+			tokenInDenom:     ETH,
+			tokenInMaxAmount: sdk.NewInt(1000000000),
+			swapFee:          sdk.ZeroDec(),
+		},
+		"eth out within the narrow range with swap fee": {
+			tokenOut:         sdk.NewCoin(ETH, sdk.NewInt(10000)),
+			tokenInDenom:     USDC,
+			tokenInMaxAmount: sdk.NewInt(1000000000),
+			swapFee:          sdk.NewDecWithPrec(1, 2),
+		},
+		"usdc out crossing the lower tick of the narrow range": {
+			tokenOut:          sdk.NewCoin(USDC, sdk.NewInt(6000000000)),
+			tokenInDenom:      ETH,
+			tokenInMaxAmount:  sdk.NewInt(1000000000),
+			swapFee:           sdk.ZeroDec(),
+			expectTickCrossed: true,
+		},
+		"error: amount in above max amount": {
+			tokenOut:         sdk.NewCoin(USDC, sdk.NewInt(50000000)),
+			tokenInDenom:     ETH,
+			tokenInMaxAmount: sdk.OneInt(),
+			swapFee:          sdk.ZeroDec(),
+			expectedErrorStr: types.ErrLimitMaxAmount.Error(),
+		},
+		"error: amount out above pool balance": {
+			tokenOut:         sdk.NewCoin(USDC, sdk.NewInt(20000000000)),
+			tokenInDenom:     ETH,
+			tokenInMaxAmount: sdk.NewInt(1000000000000000),
+			swapFee:          sdk.ZeroDec(),
+			expectedErrorStr: types.ErrNotEnoughLiquidity.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			sender := s.TestAccs[2]
+
+			pool, fullRangeLiquidity := s.prepareDefaultPoolWithNarrowPosition(tc.swapFee)
+			s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(tc.tokenInDenom, tc.tokenInMaxAmount)))
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+
+			expectedTokenIn, calcErr := clKeeper.CalcInAmtGivenOut(s.Ctx, pool, tc.tokenOut, tc.tokenInDenom, tc.swapFee)
+
+			tokenInAmount, err := clKeeper.SwapExactAmountOut(s.Ctx, sender, pool, tc.tokenInDenom, tc.tokenInMaxAmount, tc.tokenOut, tc.swapFee)
+			if tc.expectedErrorStr != "" {
+				s.Require().ErrorContains(err, tc.expectedErrorStr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NoError(calcErr)
+
+			s.Require().Equal(expectedTokenIn.Amount.String(), tokenInAmount.String())
+			tokenIn := sdk.NewCoin(tc.tokenInDenom, tokenInAmount)
+			s.Require().Equal(balanceBefore.Sub(tokenIn).Add(tc.tokenOut).String(), s.App.BankKeeper.GetAllBalances(s.Ctx, sender).String())
+
+			poolAfter, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			currentTick := poolAfter.GetCurrentTick().Int64()
+			if tc.expectTickCrossed {
+				s.Require().True(currentTick < DefaultLowerTick || currentTick >= DefaultUpperTick)
+				s.Require().Equal(fullRangeLiquidity.String(), poolAfter.GetLiquidity().String())
+			} else {
+				s.Require().True(currentTick >= DefaultLowerTick && currentTick < DefaultUpperTick)
+			}
+		})
+	}
+}
+
+// TestSwapRoundTrip tests that swapping in and back out never gives the sender more than it started with.
+func (s *KeeperTestSuite) TestSwapRoundTrip() {
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	sender := s.TestAccs[2]
+
+	pool, _ := s.prepareDefaultPoolWithNarrowPosition(sdk.ZeroDec())
+	tokenIn := sdk.NewCoin(ETH, sdk.NewInt(2000000))
+	s.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+	usdcOut, err := clKeeper.SwapExactAmountIn(s.Ctx, sender, pool, tokenIn, USDC, sdk.OneInt(), sdk.ZeroDec())
+	s.Require().NoError(err)
+
+	poolI, err := clKeeper.GetPool(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	ethOut, err := clKeeper.SwapExactAmountIn(s.Ctx, sender, poolI, sdk.NewCoin(USDC, usdcOut), ETH, sdk.OneInt(), sdk.ZeroDec())
+	s.Require().NoError(err)
+
+	s.Require().True(ethOut.LTE(tokenIn.Amount))
+	s.Require().True(tokenIn.Amount.Sub(ethOut).LTE(sdk.NewInt(2)))
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+// initOrUpdateTick adds the liquidity delta of a position to the given tick of the pool,
+// initializing the tick if it did not hold any liquidity yet. upper is true if the tick is the
+// upper tick of the position, in which case the liquidity delta is subtracted from the net liquidity.
+//
+// When a tick is initialized at or below the current tick, all the fees collected so far are assumed
+// to have been collected below it. Ticks left without any liquidity are not deleted here, see removeTickIfEmpty.
+func (k Keeper) initOrUpdateTick(ctx sdk.Context, pool types.ConcentratedPoolExtension, tickIndex int64, liquidityDelta sdk.Dec, upper bool) error {
+	poolId := pool.GetId()
+	tickInfo := k.getTickInfo(ctx, poolId, tickIndex)
+
+	liquidityBefore := tickInfo.LiquidityGross
+	liquidityAfter := liquidityBefore.Add(liquidityDelta)
+	if liquidityAfter.IsNegative() {
+		return types.InsufficientLiquidityError{Actual: liquidityDelta.Neg(), Available: liquidityBefore}
+	}
+
+	if liquidityBefore.IsZero() && tickIndex <= pool.GetCurrentTick().Int64() {
+		feeAccumulator, err := k.getFeeAccumulator(ctx, poolId)
+		if err != nil {
+			return err
+		}
+		tickInfo.FeeGrowthOutside = feeAccumulator.FeeGrowthGlobal
+	}
+
+	tickInfo.LiquidityGross = liquidityAfter
+	if upper {
+		tickInfo.LiquidityNet = tickInfo.LiquidityNet.Sub(liquidityDelta)
+	} else {
+		tickInfo.LiquidityNet = tickInfo.LiquidityNet.Add(liquidityDelta)
+	}
+
+	k.setTickInfo(ctx, poolId, tickIndex, tickInfo)
+	return nil
+}
+
+// removeTickIfEmpty deletes the given tick of the pool if no position references it anymore.
+func (k Keeper) removeTickIfEmpty(ctx sdk.Context, poolId uint64, tickIndex int64) {
+	tickInfo := k.getTickInfo(ctx, poolId, tickIndex)
+	if tickInfo.LiquidityGross.IsZero() {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.KeyTick(poolId, tickIndex))
+	}
+}
+
+// crossTick flips the fee growth outside of the given tick as the price moves across it,
+// and returns the net liquidity of the tick.
+func (k Keeper) crossTick(ctx sdk.Context, poolId uint64, tickIndex int64, feeGrowthGlobal sdk.DecCoins) (liquidityNet sdk.Dec, err error) {
+	tickInfo := k.getTickInfo(ctx, poolId, tickIndex)
+
+	feeGrowthOutside, hasNeg := feeGrowthGlobal.SafeSub(tickInfo.FeeGrowthOutside)
+	if hasNeg {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "fee growth outside of tick %d (%s) exceeds the global fee growth (%s)",
+			tickIndex, tickInfo.FeeGrowthOutside, feeGrowthGlobal)
+	}
+	tickInfo.FeeGrowthOutside = feeGrowthOutside
+
+	k.setTickInfo(ctx, poolId, tickIndex, tickInfo)
+	return tickInfo.LiquidityNet, nil
+}
+
+// nextInitializedTick returns the next initialized tick of the pool in the direction of the swap.
+// When zeroForOne is true, the price moves down and it returns the largest initialized tick lower than
+// or equal to the current tick. Otherwise, it returns the smallest initialized tick greater than the current tick.
+// found is false if there is no initialized tick in that direction.
+func (k Keeper) nextInitializedTick(ctx sdk.Context, poolId uint64, currentTick int64, zeroForOne bool) (nextTick int64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeyTickPrefixByPoolId(poolId)
+	boundKey := types.KeyTick(poolId, currentTick+1)
+
+	var iter sdk.Iterator
+	if zeroForOne {
+		iter = store.ReverseIterator(prefix, boundKey)
+	} else {
+		iter = store.Iterator(boundKey, sdk.PrefixEndBytes(prefix))
+	}
+	defer iter.Close() //nolint:errcheck
+
+	if !iter.Valid() {
+		return 0, false
+	}
+
+	tickIndex, err := types.TickIndexFromBytes(iter.Key()[len(prefix):])
+	if err != nil {
+		panic(err)
+	}
+	return tickIndex, true
+}
+
+// validateTickRange validates that the ticks of a position are multiples of the pool's tick spacing,
+// are within the bounds of the pool and that the lower tick is lower than the upper tick.
+func validateTickRange(pool types.ConcentratedPoolExtension, lowerTick, upperTick int64) error {
+	tickSpacing := int64(pool.GetTickSpacing())
+	if lowerTick%tickSpacing != 0 || upperTick%tickSpacing != 0 {
+		return types.TickSpacingError{TickSpacing: pool.GetTickSpacing(), LowerTick: lowerTick, UpperTick: upperTick}
+	}
+
+	minTick, maxTick := math.GetMinAndMaxTicksFromExponentAtPriceOne(pool.GetPrecisionFactorAtPriceOne())
+	if lowerTick < minTick || lowerTick >= maxTick {
+		return types.InvalidTickError{Tick: lowerTick, IsLower: true, MinTick: minTick, MaxTick: maxTick}
+	}
+	if upperTick <= minTick || upperTick > maxTick {
+		return types.InvalidTickError{Tick: upperTick, IsLower: false, MinTick: minTick, MaxTick: maxTick}
+	}
+
+	if lowerTick >= upperTick {
+		return types.InvalidLowerUpperTickError{LowerTick: lowerTick, UpperTick: upperTick}
+	}
+
+	return nil
+}
+
+// getTickInfo returns the state of the given tick of the pool.
+// Uninitialized ticks are returned with zero liquidity and no fee growth.
+func (k Keeper) getTickInfo(ctx sdk.Context, poolId uint64, tickIndex int64) types.TickInfo {
+	store := ctx.KVStore(k.storeKey)
+	tickInfo := types.TickInfo{}
+	found, err := osmoutils.Get(store, types.KeyTick(poolId, tickIndex), &tickInfo)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return types.TickInfo{LiquidityGross: sdk.ZeroDec(), LiquidityNet: sdk.ZeroDec(), FeeGrowthOutside: sdk.DecCoins{}}
+	}
+	return tickInfo
+}
+
+func (k Keeper) setTickInfo(ctx sdk.Context, poolId uint64, tickIndex int64, tickInfo types.TickInfo) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyTick(poolId, tickIndex), &tickInfo)
+}
+
+// GetAllInitializedTicksForPool returns all the initialized ticks of the pool, in increasing order.
+func (k Keeper) GetAllInitializedTicksForPool(ctx sdk.Context, poolId uint64) ([]types.FullTick, error) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeyTickPrefixByPoolId(poolId)
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(store, prefix, func(key []byte, value []byte) (types.FullTick, error) {
+		tickIndex, err := types.TickIndexFromBytes(key[len(prefix):])
+		if err != nil {
+			return types.FullTick{}, err
+		}
+		tickInfo := types.TickInfo{}
+		if err := tickInfo.Unmarshal(value); err != nil {
+			return types.FullTick{}, err
+		}
+		return types.FullTick{PoolId: poolId, TickIndex: tickIndex, Info: tickInfo}, nil
+	})
+}
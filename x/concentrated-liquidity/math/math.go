@@ -0,0 +1,151 @@
+package math
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// The functions below implement the relations between liquidity, square root prices and token amounts
+// of a position over the range [sqrtPriceA, sqrtPriceB):
+//
+//	amount0 = liquidity * (sqrtPriceB - sqrtPriceA) / (sqrtPriceA * sqrtPriceB)
+//	amount1 = liquidity * (sqrtPriceB - sqrtPriceA)
+//
+// Intermediate results are computed with osmomath.BigDec, whose 36 decimals hold the product of
+// two sdk.Dec exactly, so that the loss of precision is limited to the final division and rounding.
+
+// Liquidity0 returns the liquidity provided by amount0 of token0 over the range [sqrtPriceA, sqrtPriceB).
+func Liquidity0(amount sdk.Int, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	sqrtPriceA, sqrtPriceB = sortSqrtPrices(sqrtPriceA, sqrtPriceB)
+
+	amountBig := osmomath.NewDecFromBigInt(amount.BigInt())
+	sqrtPriceABig := osmomath.BigDecFromSDKDec(sqrtPriceA)
+	sqrtPriceBBig := osmomath.BigDecFromSDKDec(sqrtPriceB)
+
+	product := sqrtPriceABig.Mul(sqrtPriceBBig)
+	diff := sqrtPriceBBig.Sub(sqrtPriceABig)
+	return amountBig.Mul(product).QuoTruncate(diff).SDKDec()
+}
+
+// Liquidity1 returns the liquidity provided by amount1 of token1 over the range [sqrtPriceA, sqrtPriceB).
+func Liquidity1(amount sdk.Int, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	sqrtPriceA, sqrtPriceB = sortSqrtPrices(sqrtPriceA, sqrtPriceB)
+
+	amountBig := osmomath.NewDecFromBigInt(amount.BigInt())
+	diff := osmomath.BigDecFromSDKDec(sqrtPriceB.Sub(sqrtPriceA))
+	return amountBig.QuoTruncate(diff).SDKDec()
+}
+
+// GetLiquidityFromAmounts returns the maximum liquidity that can be provided with the given amounts
+// over the range [sqrtPriceA, sqrtPriceB), given the current square root price of the pool.
+func GetLiquidityFromAmounts(sqrtPrice, sqrtPriceA, sqrtPriceB sdk.Dec, amount0, amount1 sdk.Int) sdk.Dec {
+	sqrtPriceA, sqrtPriceB = sortSqrtPrices(sqrtPriceA, sqrtPriceB)
+
+	switch {
+	case sqrtPrice.LTE(sqrtPriceA):
+		// the position is entirely made of token0.
+		return Liquidity0(amount0, sqrtPriceA, sqrtPriceB)
+	case sqrtPrice.LT(sqrtPriceB):
+		// the position is active, the liquidity is bounded by the scarcer of the two tokens.
+		liquidity0 := Liquidity0(amount0, sqrtPrice, sqrtPriceB)
+		liquidity1 := Liquidity1(amount1, sqrtPriceA, sqrtPrice)
+		return sdk.MinDec(liquidity0, liquidity1)
+	default:
+		// the position is entirely made of token1.
+		return Liquidity1(amount1, sqrtPriceA, sqrtPriceB)
+	}
+}
+
+// CalcAmount0Delta returns the amount of token0 corresponding to the given liquidity
+// over the range [sqrtPriceA, sqrtPriceB). The result is rounded up if roundUp is true.
+func CalcAmount0Delta(liquidity, sqrtPriceA, sqrtPriceB sdk.Dec, roundUp bool) sdk.Dec {
+	sqrtPriceA, sqrtPriceB = sortSqrtPrices(sqrtPriceA, sqrtPriceB)
+
+	liquidityBig := osmomath.BigDecFromSDKDec(liquidity)
+	sqrtPriceABig := osmomath.BigDecFromSDKDec(sqrtPriceA)
+	sqrtPriceBBig := osmomath.BigDecFromSDKDec(sqrtPriceB)
+	diff := sqrtPriceBBig.Sub(sqrtPriceABig)
+
+	// the numerator and the denominator are exact, so that the result is rounded only once.
+	numerator := liquidityBig.Mul(diff)
+	denominator := sqrtPriceABig.Mul(sqrtPriceBBig)
+	if roundUp {
+		return numerator.QuoRoundUp(denominator).SDKDecRoundUp()
+	}
+	return numerator.QuoTruncate(denominator).SDKDec()
+}
+
+// CalcAmount1Delta returns the amount of token1 corresponding to the given liquidity
+// over the range [sqrtPriceA, sqrtPriceB). The result is rounded up if roundUp is true.
+func CalcAmount1Delta(liquidity, sqrtPriceA, sqrtPriceB sdk.Dec, roundUp bool) sdk.Dec {
+	sqrtPriceA, sqrtPriceB = sortSqrtPrices(sqrtPriceA, sqrtPriceB)
+
+	liquidityBig := osmomath.BigDecFromSDKDec(liquidity)
+	diff := osmomath.BigDecFromSDKDec(sqrtPriceB.Sub(sqrtPriceA))
+
+	// the product of two sdk.Dec is exact with the precision of osmomath.BigDec.
+	if roundUp {
+		return liquidityBig.Mul(diff).SDKDecRoundUp()
+	}
+	return liquidityBig.Mul(diff).SDKDec()
+}
+
+// GetNextSqrtPriceFromAmount0InRoundingUp returns the square root price after amountIn of token0
+// is swapped into the given liquidity: liquidity * sqrtPrice / (liquidity + amountIn * sqrtPrice).
+// The result is rounded up so that the price moves down by no more than the input allows.
+func GetNextSqrtPriceFromAmount0InRoundingUp(sqrtPrice, liquidity, amountIn sdk.Dec) sdk.Dec {
+	if amountIn.IsZero() {
+		return sqrtPrice
+	}
+
+	liquidityBig := osmomath.BigDecFromSDKDec(liquidity)
+	sqrtPriceBig := osmomath.BigDecFromSDKDec(sqrtPrice)
+	amountInBig := osmomath.BigDecFromSDKDec(amountIn)
+
+	denominator := liquidityBig.Add(amountInBig.Mul(sqrtPriceBig))
+	return liquidityBig.Mul(sqrtPriceBig).QuoRoundUp(denominator).SDKDecRoundUp()
+}
+
+// GetNextSqrtPriceFromAmount1InRoundingDown returns the square root price after amountIn of token1
+// is swapped into the given liquidity: sqrtPrice + amountIn / liquidity.
+// The result is rounded down so that the price moves up by no more than the input allows.
+func GetNextSqrtPriceFromAmount1InRoundingDown(sqrtPrice, liquidity, amountIn sdk.Dec) sdk.Dec {
+	liquidityBig := osmomath.BigDecFromSDKDec(liquidity)
+	amountInBig := osmomath.BigDecFromSDKDec(amountIn)
+
+	return sqrtPrice.Add(amountInBig.QuoTruncate(liquidityBig).SDKDec())
+}
+
+// GetNextSqrtPriceFromAmount0OutRoundingUp returns the square root price after amountOut of token0
+// is swapped out of the given liquidity: liquidity * sqrtPrice / (liquidity - amountOut * sqrtPrice).
+// The result is rounded up so that the price moves up by at least what the output requires.
+func GetNextSqrtPriceFromAmount0OutRoundingUp(sqrtPrice, liquidity, amountOut sdk.Dec) sdk.Dec {
+	if amountOut.IsZero() {
+		return sqrtPrice
+	}
+
+	liquidityBig := osmomath.BigDecFromSDKDec(liquidity)
+	sqrtPriceBig := osmomath.BigDecFromSDKDec(sqrtPrice)
+	amountOutBig := osmomath.BigDecFromSDKDec(amountOut)
+
+	denominator := liquidityBig.Sub(amountOutBig.Mul(sqrtPriceBig))
+	return liquidityBig.Mul(sqrtPriceBig).QuoRoundUp(denominator).SDKDecRoundUp()
+}
+
+// GetNextSqrtPriceFromAmount1OutRoundingDown returns the square root price after amountOut of token1
+// is swapped out of the given liquidity: sqrtPrice - amountOut / liquidity.
+// The result is rounded down so that the price moves down by at least what the output requires.
+func GetNextSqrtPriceFromAmount1OutRoundingDown(sqrtPrice, liquidity, amountOut sdk.Dec) sdk.Dec {
+	liquidityBig := osmomath.BigDecFromSDKDec(liquidity)
+	amountOutBig := osmomath.BigDecFromSDKDec(amountOut)
+
+	return sqrtPrice.Sub(amountOutBig.QuoRoundUp(liquidityBig).SDKDecRoundUp())
+}
+
+func sortSqrtPrices(sqrtPriceA, sqrtPriceB sdk.Dec) (sdk.Dec, sdk.Dec) {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		return sqrtPriceB, sqrtPriceA
+	}
+	return sqrtPriceA, sqrtPriceB
+}
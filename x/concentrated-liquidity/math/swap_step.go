@@ -0,0 +1,107 @@
+package math
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ComputeSwapStepOutGivenIn computes the result of swapping amountRemainingIn within a single range
+// of constant liquidity, from sqrtPriceCurrent towards sqrtPriceTarget. zeroForOne is true when token0
+// is swapped in, in which case the price moves down.
+//
+// It returns the square root price reached, the amount of token in consumed (excluding the swap fee),
+// the amount of token out and the swap fee charged. The target is reached if and only if the returned
+// square root price equals sqrtPriceTarget.
+func ComputeSwapStepOutGivenIn(sqrtPriceCurrent, sqrtPriceTarget, liquidity, amountRemainingIn, swapFee sdk.Dec, zeroForOne bool) (sqrtPriceNext, amountIn, amountOut, feeCharged sdk.Dec) {
+	amountRemainingLessFee := amountRemainingIn.MulTruncate(sdk.OneDec().Sub(swapFee))
+
+	if zeroForOne {
+		amountIn = CalcAmount0Delta(liquidity, sqrtPriceTarget, sqrtPriceCurrent, true)
+		if amountRemainingLessFee.GTE(amountIn) {
+			sqrtPriceNext = sqrtPriceTarget
+		} else {
+			sqrtPriceNext = GetNextSqrtPriceFromAmount0InRoundingUp(sqrtPriceCurrent, liquidity, amountRemainingLessFee)
+		}
+	} else {
+		amountIn = CalcAmount1Delta(liquidity, sqrtPriceTarget, sqrtPriceCurrent, true)
+		if amountRemainingLessFee.GTE(amountIn) {
+			sqrtPriceNext = sqrtPriceTarget
+		} else {
+			sqrtPriceNext = GetNextSqrtPriceFromAmount1InRoundingDown(sqrtPriceCurrent, liquidity, amountRemainingLessFee)
+		}
+	}
+
+	hasReachedTarget := sqrtPriceNext.Equal(sqrtPriceTarget)
+
+	if zeroForOne {
+		if !hasReachedTarget {
+			amountIn = CalcAmount0Delta(liquidity, sqrtPriceNext, sqrtPriceCurrent, true)
+		}
+		amountOut = CalcAmount1Delta(liquidity, sqrtPriceNext, sqrtPriceCurrent, false)
+	} else {
+		if !hasReachedTarget {
+			amountIn = CalcAmount1Delta(liquidity, sqrtPriceNext, sqrtPriceCurrent, true)
+		}
+		amountOut = CalcAmount0Delta(liquidity, sqrtPriceNext, sqrtPriceCurrent, false)
+	}
+
+	if !hasReachedTarget {
+		// the whole remaining amount is consumed by this step, what is not swapped is the fee.
+		amountIn = sdk.MinDec(amountIn, amountRemainingLessFee)
+		feeCharged = amountRemainingIn.Sub(amountIn)
+	} else {
+		feeCharged = computeFeeChargePerSwapStep(amountIn, swapFee)
+	}
+
+	return sqrtPriceNext, amountIn, amountOut, feeCharged
+}
+
+// ComputeSwapStepInGivenOut computes the amount of token in required to swap amountRemainingOut out of
+// a single range of constant liquidity, from sqrtPriceCurrent towards sqrtPriceTarget. zeroForOne is true
+// when token0 is swapped in, in which case the price moves down.
+//
+// It returns the square root price reached, the amount of token in required (excluding the swap fee),
+// the amount of token out and the swap fee charged. The target is reached if and only if the returned
+// square root price equals sqrtPriceTarget.
+func ComputeSwapStepInGivenOut(sqrtPriceCurrent, sqrtPriceTarget, liquidity, amountRemainingOut, swapFee sdk.Dec, zeroForOne bool) (sqrtPriceNext, amountIn, amountOut, feeCharged sdk.Dec) {
+	if zeroForOne {
+		amountOut = CalcAmount1Delta(liquidity, sqrtPriceTarget, sqrtPriceCurrent, false)
+		if amountRemainingOut.GTE(amountOut) {
+			sqrtPriceNext = sqrtPriceTarget
+		} else {
+			sqrtPriceNext = GetNextSqrtPriceFromAmount1OutRoundingDown(sqrtPriceCurrent, liquidity, amountRemainingOut)
+		}
+	} else {
+		amountOut = CalcAmount0Delta(liquidity, sqrtPriceTarget, sqrtPriceCurrent, false)
+		if amountRemainingOut.GTE(amountOut) {
+			sqrtPriceNext = sqrtPriceTarget
+		} else {
+			sqrtPriceNext = GetNextSqrtPriceFromAmount0OutRoundingUp(sqrtPriceCurrent, liquidity, amountRemainingOut)
+		}
+	}
+
+	hasReachedTarget := sqrtPriceNext.Equal(sqrtPriceTarget)
+
+	if zeroForOne {
+		amountIn = CalcAmount0Delta(liquidity, sqrtPriceNext, sqrtPriceCurrent, true)
+	} else {
+		amountIn = CalcAmount1Delta(liquidity, sqrtPriceNext, sqrtPriceCurrent, true)
+	}
+
+	if !hasReachedTarget {
+		// the price is moved by at least what the output requires, so the whole remaining amount is filled.
+		amountOut = amountRemainingOut
+	}
+
+	feeCharged = computeFeeChargePerSwapStep(amountIn, swapFee)
+
+	return sqrtPriceNext, amountIn, amountOut, feeCharged
+}
+
+// computeFeeChargePerSwapStep returns the fee charged on top of amountIn so that the fee
+// is the swapFee fraction of the total amount paid: amountIn * swapFee / (1 - swapFee), rounded up.
+func computeFeeChargePerSwapStep(amountIn, swapFee sdk.Dec) sdk.Dec {
+	if swapFee.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return amountIn.MulRoundUp(swapFee).QuoRoundUp(sdk.OneDec().Sub(swapFee))
+}
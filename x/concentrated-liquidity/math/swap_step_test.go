@@ -0,0 +1,182 @@
+package math_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/math"
+)
+
+var (
+	// liquidity of 1000 units of token0 and 1000 units of token1 deposited at price one
+	// over the price range [0.25, 4].
+	defaultLiquidity   = sdk.NewDec(2000)
+	sqrtPriceOne       = sdk.OneDec()
+	sqrtPriceTwo       = sdk.NewDec(2)
+	sqrtPriceHalf      = sdk.MustNewDecFromStr("0.5")
+	defaultSwapFeeStep = sdk.MustNewDecFromStr("0.01")
+)
+
+func TestComputeSwapStepOutGivenIn(t *testing.T) {
+	testCases := map[string]struct {
+		sqrtPriceCurrent  sdk.Dec
+		sqrtPriceTarget   sdk.Dec
+		amountRemainingIn sdk.Dec
+		swapFee           sdk.Dec
+		zeroForOne        bool
+
+		expectedSqrtPriceNext sdk.Dec
+		expectedAmountIn      sdk.Dec
+		expectedAmountOut     sdk.Dec
+		expectedFeeCharged    sdk.Dec
+	}{
+		"zero for one, target reached": {
+			sqrtPriceCurrent:  sqrtPriceOne,
+			sqrtPriceTarget:   sqrtPriceHalf,
+			amountRemainingIn: sdk.NewDec(5000),
+			swapFee:           sdk.ZeroDec(),
+			zeroForOne:        true,
+
+			// L * (1/0.5 - 1/1) = 2000 in, L * (1 - 0.5) = 1000 out.
+			expectedSqrtPriceNext: sqrtPriceHalf,
+			expectedAmountIn:      sdk.NewDec(2000),
+			expectedAmountOut:     sdk.NewDec(1000),
+			expectedFeeCharged:    sdk.ZeroDec(),
+		},
+		"zero for one, target not reached": {
+			sqrtPriceCurrent:  sqrtPriceOne,
+			sqrtPriceTarget:   sqrtPriceHalf,
+			amountRemainingIn: sdk.NewDec(1000),
+			swapFee:           sdk.ZeroDec(),
+			zeroForOne:        true,
+
+			// sqrt price moves to L / (L / 1 + 1000), rounded up, out is L * (1 - 2/3).
+			// The rounding dust left from the amount remaining is charged as fee.
+			expectedSqrtPriceNext: sdk.MustNewDecFromStr("0.666666666666666667"),
+			expectedAmountIn:      sdk.MustNewDecFromStr("999.999999999999998501"),
+			expectedAmountOut:     sdk.MustNewDecFromStr("666.666666666666666000"),
+			expectedFeeCharged:    sdk.MustNewDecFromStr("0.000000000000001499"),
+		},
+		"one for zero, target not reached": {
+			sqrtPriceCurrent:  sqrtPriceOne,
+			sqrtPriceTarget:   sqrtPriceTwo,
+			amountRemainingIn: sdk.NewDec(1000),
+			swapFee:           sdk.ZeroDec(),
+			zeroForOne:        false,
+
+			// sqrt price moves by 1000 / L = 0.5, out is L * (1/1 - 1/1.5).
+			expectedSqrtPriceNext: sdk.MustNewDecFromStr("1.5"),
+			expectedAmountIn:      sdk.NewDec(1000),
+			expectedAmountOut:     sdk.MustNewDecFromStr("666.666666666666666666"),
+			expectedFeeCharged:    sdk.ZeroDec(),
+		},
+		"one for zero, target reached with fee": {
+			sqrtPriceCurrent:  sqrtPriceOne,
+			sqrtPriceTarget:   sqrtPriceTwo,
+			amountRemainingIn: sdk.NewDec(3000),
+			swapFee:           defaultSwapFeeStep,
+			zeroForOne:        false,
+
+			// L * (2 - 1) = 2000 in, L * (1/1 - 1/2) = 1000 out, fee is 2000 * 0.01 / 0.99.
+			expectedSqrtPriceNext: sqrtPriceTwo,
+			expectedAmountIn:      sdk.NewDec(2000),
+			expectedAmountOut:     sdk.NewDec(1000),
+			expectedFeeCharged:    sdk.MustNewDecFromStr("20.202020202020202021"),
+		},
+		"one for zero, target not reached with fee": {
+			sqrtPriceCurrent:  sqrtPriceOne,
+			sqrtPriceTarget:   sqrtPriceTwo,
+			amountRemainingIn: sdk.NewDec(1000),
+			swapFee:           defaultSwapFeeStep,
+			zeroForOne:        false,
+
+			// 990 is swapped, moving the sqrt price by 990 / L = 0.495.
+			expectedSqrtPriceNext: sdk.MustNewDecFromStr("1.495"),
+			expectedAmountIn:      sdk.NewDec(990),
+			expectedAmountOut:     sdk.MustNewDecFromStr("662.207357859531772575"),
+			expectedFeeCharged:    sdk.NewDec(10),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			sqrtPriceNext, amountIn, amountOut, feeCharged := math.ComputeSwapStepOutGivenIn(tc.sqrtPriceCurrent, tc.sqrtPriceTarget, defaultLiquidity, tc.amountRemainingIn, tc.swapFee, tc.zeroForOne)
+
+			require.Equal(t, tc.expectedSqrtPriceNext.String(), sqrtPriceNext.String())
+			require.Equal(t, tc.expectedAmountIn.String(), amountIn.String())
+			require.Equal(t, tc.expectedAmountOut.String(), amountOut.String())
+			require.Equal(t, tc.expectedFeeCharged.String(), feeCharged.String())
+			// the amount consumed never exceeds the amount remaining.
+			require.True(t, amountIn.Add(feeCharged).LTE(tc.amountRemainingIn))
+		})
+	}
+}
+
+func TestComputeSwapStepInGivenOut(t *testing.T) {
+	testCases := map[string]struct {
+		sqrtPriceCurrent   sdk.Dec
+		sqrtPriceTarget    sdk.Dec
+		amountRemainingOut sdk.Dec
+		swapFee            sdk.Dec
+		zeroForOne         bool
+
+		expectedSqrtPriceNext sdk.Dec
+		expectedAmountIn      sdk.Dec
+		expectedAmountOut     sdk.Dec
+		expectedFeeCharged    sdk.Dec
+	}{
+		"zero for one, target reached": {
+			sqrtPriceCurrent:   sqrtPriceOne,
+			sqrtPriceTarget:    sqrtPriceHalf,
+			amountRemainingOut: sdk.NewDec(5000),
+			swapFee:            sdk.ZeroDec(),
+			zeroForOne:         true,
+
+			expectedSqrtPriceNext: sqrtPriceHalf,
+			expectedAmountIn:      sdk.NewDec(2000),
+			expectedAmountOut:     sdk.NewDec(1000),
+			expectedFeeCharged:    sdk.ZeroDec(),
+		},
+		"zero for one, target not reached": {
+			sqrtPriceCurrent:   sqrtPriceOne,
+			sqrtPriceTarget:    sqrtPriceHalf,
+			amountRemainingOut: sdk.NewDec(500),
+			swapFee:            sdk.ZeroDec(),
+			zeroForOne:         true,
+
+			// sqrt price moves by 500 / L = 0.25, in is L * (1/0.75 - 1/1).
+			expectedSqrtPriceNext: sdk.MustNewDecFromStr("0.75"),
+			expectedAmountIn:      sdk.MustNewDecFromStr("666.666666666666666667"),
+			expectedAmountOut:     sdk.NewDec(500),
+			expectedFeeCharged:    sdk.ZeroDec(),
+		},
+		"one for zero, target not reached with fee": {
+			sqrtPriceCurrent:   sqrtPriceOne,
+			sqrtPriceTarget:    sqrtPriceTwo,
+			amountRemainingOut: sdk.NewDec(500),
+			swapFee:            defaultSwapFeeStep,
+			zeroForOne:         false,
+
+			// 1/sqrt price moves by 500 / L = 0.25, in is L * (4/3 - 1), fee is in * 0.01 / 0.99.
+			expectedSqrtPriceNext: sdk.MustNewDecFromStr("1.333333333333333334"),
+			expectedAmountIn:      sdk.MustNewDecFromStr("666.666666666666668000"),
+			expectedAmountOut:     sdk.NewDec(500),
+			expectedFeeCharged:    sdk.MustNewDecFromStr("6.734006734006734021"),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			sqrtPriceNext, amountIn, amountOut, feeCharged := math.ComputeSwapStepInGivenOut(tc.sqrtPriceCurrent, tc.sqrtPriceTarget, defaultLiquidity, tc.amountRemainingOut, tc.swapFee, tc.zeroForOne)
+
+			require.Equal(t, tc.expectedSqrtPriceNext.String(), sqrtPriceNext.String())
+			require.Equal(t, tc.expectedAmountIn.String(), amountIn.String())
+			require.Equal(t, tc.expectedAmountOut.String(), amountOut.String())
+			require.Equal(t, tc.expectedFeeCharged.String(), feeCharged.String())
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package math
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+// Ticks are laid out with an additive model: every power of ten of the price is split
+// into 9 * 10^(-exponentAtPriceOne) ticks of equal size. Starting from price one at tick zero,
+// the price increases by 10^exponentAtPriceOne per tick until it reaches ten, then by
+// 10^(exponentAtPriceOne+1) until it reaches one hundred, and so on. Symmetrically, below
+// price one the price decreases by 10^(exponentAtPriceOne-1) per tick down to one tenth.
+//
+// For example, with an exponent at price one of -4, tick 1 is price 1.0001, tick 90000 is
+// price 10, tick 90001 is price 10.001 and tick -1 is price 0.99999.
+
+// ticksPerDecade returns the number of ticks between two consecutive powers of ten.
+func ticksPerDecade(exponentAtPriceOne sdk.Int) int64 {
+	return 9 * powTen(-exponentAtPriceOne.Int64()).Int64()
+}
+
+// GetMinAndMaxTicksFromExponentAtPriceOne returns the minimum and maximum ticks
+// of a pool with the given exponent at price one. They correspond to MinSpotPrice
+// and MaxSpotPrice respectively.
+func GetMinAndMaxTicksFromExponentAtPriceOne(exponentAtPriceOne sdk.Int) (minTick, maxTick int64) {
+	dist := ticksPerDecade(exponentAtPriceOne)
+	return types.MinSpotPriceExponent * dist, types.MaxSpotPriceExponent * dist
+}
+
+// TickToPrice returns the price at the given tick.
+func TickToPrice(tickIndex, exponentAtPriceOne sdk.Int) (sdk.Dec, error) {
+	if exponentAtPriceOne.LT(types.ExponentAtPriceOneMin) || exponentAtPriceOne.GT(types.ExponentAtPriceOneMax) {
+		return sdk.Dec{}, types.ExponentAtPriceOneError{ProvidedExponentAtPriceOne: exponentAtPriceOne}
+	}
+
+	minTick, maxTick := GetMinAndMaxTicksFromExponentAtPriceOne(exponentAtPriceOne)
+	if tickIndex.LT(sdk.NewInt(minTick)) || tickIndex.GT(sdk.NewInt(maxTick)) {
+		return sdk.Dec{}, types.InvalidTickError{Tick: tickIndex.Int64(), IsLower: false, MinTick: minTick, MaxTick: maxTick}
+	}
+
+	tick := tickIndex.Int64()
+	exponent := exponentAtPriceOne.Int64()
+	dist := ticksPerDecade(exponentAtPriceOne)
+
+	// the power of ten right below or at the price, using floor division.
+	decade := tick / dist
+	if tick%dist != 0 && tick < 0 {
+		decade--
+	}
+	ticksIntoDecade := tick - decade*dist
+
+	// price * 10^precision = 10^(decade+precision) + ticksIntoDecade * 10^(exponent+decade+precision).
+	// Both exponents are non-negative given the bounds on the exponent at price one and on the price.
+	price := powTen(decade + sdk.Precision)
+	increment := new(big.Int).Mul(big.NewInt(ticksIntoDecade), powTen(exponent+decade+sdk.Precision))
+	price.Add(price, increment)
+
+	return sdk.NewDecFromBigIntWithPrec(price, sdk.Precision), nil
+}
+
+// TickToSqrtPrice returns the square root of the price at the given tick.
+// The square root is rounded up so that it maps back to the same tick.
+func TickToSqrtPrice(tickIndex, exponentAtPriceOne sdk.Int) (sdk.Dec, error) {
+	price, err := TickToPrice(tickIndex, exponentAtPriceOne)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return osmomath.MonotonicSqrt(price)
+}
+
+// PriceToTick returns the largest tick whose price is lower than or equal to the given price.
+func PriceToTick(price sdk.Dec, exponentAtPriceOne sdk.Int) (sdk.Int, error) {
+	if price.LT(types.MinSpotPrice) || price.GT(types.MaxSpotPrice) {
+		return sdk.Int{}, types.PriceBoundError{ProvidedPrice: price}
+	}
+	if exponentAtPriceOne.LT(types.ExponentAtPriceOneMin) || exponentAtPriceOne.GT(types.ExponentAtPriceOneMax) {
+		return sdk.Int{}, types.ExponentAtPriceOneError{ProvidedExponentAtPriceOne: exponentAtPriceOne}
+	}
+	return sdk.NewInt(scaledPriceToTick(price.BigInt(), sdk.Precision, exponentAtPriceOne)), nil
+}
+
+// SqrtPriceToTick returns the largest tick whose price is lower than or equal to
+// the square of the given square root price. The square is computed exactly.
+func SqrtPriceToTick(sqrtPrice sdk.Dec, exponentAtPriceOne sdk.Int) (sdk.Int, error) {
+	if exponentAtPriceOne.LT(types.ExponentAtPriceOneMin) || exponentAtPriceOne.GT(types.ExponentAtPriceOneMax) {
+		return sdk.Int{}, types.ExponentAtPriceOneError{ProvidedExponentAtPriceOne: exponentAtPriceOne}
+	}
+
+	bigSqrtPrice := osmomath.BigDecFromSDKDec(sqrtPrice)
+	price := bigSqrtPrice.Mul(bigSqrtPrice)
+
+	minPrice := osmomath.BigDecFromSDKDec(types.MinSpotPrice)
+	maxPrice := osmomath.BigDecFromSDKDec(types.MaxSpotPrice)
+	if price.LT(minPrice) || price.GT(maxPrice) {
+		return sdk.Int{}, types.PriceBoundError{ProvidedPrice: price.SDKDec()}
+	}
+
+	return sdk.NewInt(scaledPriceToTick(price.BigInt(), osmomath.Precision, exponentAtPriceOne)), nil
+}
+
+// scaledPriceToTick returns the tick of a price given as an integer scaled by 10^precision.
+// The price must be within the spot price bounds.
+func scaledPriceToTick(scaledPrice *big.Int, precision int64, exponentAtPriceOne sdk.Int) int64 {
+	dist := ticksPerDecade(exponentAtPriceOne)
+
+	// the power of ten right below or at the price.
+	decade := int64(len(scaledPrice.String())) - 1 - precision
+
+	// ticksIntoDecade = floor((price - 10^decade) / 10^(exponent+decade)).
+	remainder := new(big.Int).Sub(scaledPrice, powTen(decade+precision))
+	ticksIntoDecade := remainder.Quo(remainder, powTen(exponentAtPriceOne.Int64()+decade+precision))
+
+	return decade*dist + ticksIntoDecade.Int64()
+}
+
+// powTen returns 10^exponent. The exponent must be non-negative.
+func powTen(exponent int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(exponent), nil)
+}
@@ -0,0 +1,215 @@
+package math_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+var defaultExponentAtPriceOne = sdk.NewInt(-4)
+
+func TestGetMinAndMaxTicksFromExponentAtPriceOne(t *testing.T) {
+	testCases := map[string]struct {
+		exponentAtPriceOne sdk.Int
+		expectedMinTick    int64
+		expectedMaxTick    int64
+	}{
+		"exponent -1": {
+			exponentAtPriceOne: sdk.NewInt(-1),
+			expectedMinTick:    -1080,
+			expectedMaxTick:    1800,
+		},
+		"exponent -4": {
+			exponentAtPriceOne: sdk.NewInt(-4),
+			expectedMinTick:    -1080000,
+			expectedMaxTick:    1800000,
+		},
+		"exponent -5": {
+			exponentAtPriceOne: sdk.NewInt(-5),
+			expectedMinTick:    -10800000,
+			expectedMaxTick:    18000000,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			minTick, maxTick := math.GetMinAndMaxTicksFromExponentAtPriceOne(tc.exponentAtPriceOne)
+			require.Equal(t, tc.expectedMinTick, minTick)
+			require.Equal(t, tc.expectedMaxTick, maxTick)
+		})
+	}
+}
+
+func TestTickToPrice(t *testing.T) {
+	testCases := map[string]struct {
+		tickIndex          int64
+		exponentAtPriceOne sdk.Int
+		expectedPrice      sdk.Dec
+		expectedError      error
+	}{
+		"tick zero is price one": {
+			tickIndex:          0,
+			exponentAtPriceOne: defaultExponentAtPriceOne,
+			expectedPrice:      sdk.OneDec(),
+		},
+		"first tick above one": {
+			tickIndex:          1,
+			exponentAtPriceOne: defaultExponentAtPriceOne,
+			expectedPrice:      sdk.MustNewDecFromStr("1.0001"),
+		},
+		"first tick below one": {
+			tickIndex:          -1,
+			exponentAtPriceOne: defaultExponentAtPriceOne,
+			expectedPrice:      sdk.MustNewDecFromStr("0.99999"),
+		},
+		"price ten": {
+			tickIndex:          90000,
+			exponentAtPriceOne: defaultExponentAtPriceOne,
+			expectedPrice:      sdk.NewDec(10),
+		},
+		"first tick above ten": {
+			tickIndex:          90001,
+			exponentAtPriceOne: defaultExponentAtPriceOne,
+			expectedPrice:      sdk.MustNewDecFromStr("10.001"),
+		},
+		"price one tenth": {
+			tickIndex:          -90000,
+			exponentAtPriceOne: defaultExponentAtPriceOne,
+			expectedPrice:      sdk.MustNewDecFromStr("0.1"),
+		},
+		"first tick above one tenth": {
+			tickIndex:          -89999,
+			exponentAtPriceOne: defaultExponentAtPriceOne,
+			expectedPrice:      sdk.MustNewDecFromStr("0.10001"),
+		},
+		"max tick": {
+			tickIndex:          1800000,
+			exponentAtPriceOne: defaultExponentAtPriceOne,
+			expectedPrice:      types.MaxSpotPrice,
+		},
+		"min tick": {
+			tickIndex:          -1080000,
+			exponentAtPriceOne: defaultExponentAtPriceOne,
+			expectedPrice:      types.MinSpotPrice,
+		},
+		"min tick with smallest exponent": {
+			tickIndex:          -10800000,
+			exponentAtPriceOne: sdk.NewInt(-5),
+			expectedPrice:      types.MinSpotPrice,
+		},
+		"error: tick above max tick": {
+			tickIndex:          1800001,
+			exponentAtPriceOne: defaultExponentAtPriceOne,
+			expectedError:      types.InvalidTickError{Tick: 1800001, IsLower: false, MinTick: -1080000, MaxTick: 1800000},
+		},
+		"error: tick below min tick": {
+			tickIndex:          -1080001,
+			exponentAtPriceOne: defaultExponentAtPriceOne,
+			expectedError:      types.InvalidTickError{Tick: -1080001, IsLower: false, MinTick: -1080000, MaxTick: 1800000},
+		},
+		"error: exponent too small": {
+			tickIndex:          1,
+			exponentAtPriceOne: sdk.NewInt(-6),
+			expectedError:      types.ExponentAtPriceOneError{ProvidedExponentAtPriceOne: sdk.NewInt(-6)},
+		},
+		"error: exponent too large": {
+			tickIndex:          1,
+			exponentAtPriceOne: sdk.ZeroInt(),
+			expectedError:      types.ExponentAtPriceOneError{ProvidedExponentAtPriceOne: sdk.ZeroInt()},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			price, err := math.TickToPrice(sdk.NewInt(tc.tickIndex), tc.exponentAtPriceOne)
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedError.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPrice.String(), price.String())
+		})
+	}
+}
+
+func TestPriceToTick(t *testing.T) {
+	testCases := map[string]struct {
+		price         sdk.Dec
+		expectedTick  int64
+		expectedError error
+	}{
+		"price one": {
+			price:        sdk.OneDec(),
+			expectedTick: 0,
+		},
+		"exact tick price": {
+			price:        sdk.MustNewDecFromStr("10.001"),
+			expectedTick: 90001,
+		},
+		"price between two ticks rounds down": {
+			price:        sdk.MustNewDecFromStr("1.00015"),
+			expectedTick: 1,
+		},
+		"price slightly below one": {
+			price:        sdk.MustNewDecFromStr("0.999999"),
+			expectedTick: -1,
+		},
+		"max spot price": {
+			price:        types.MaxSpotPrice,
+			expectedTick: 1800000,
+		},
+		"min spot price": {
+			price:        types.MinSpotPrice,
+			expectedTick: -1080000,
+		},
+		"error: price above max spot price": {
+			price:         types.MaxSpotPrice.Add(sdk.OneDec()),
+			expectedError: types.PriceBoundError{ProvidedPrice: types.MaxSpotPrice.Add(sdk.OneDec())},
+		},
+		"error: price below min spot price": {
+			price:         sdk.SmallestDec(),
+			expectedError: types.PriceBoundError{ProvidedPrice: sdk.SmallestDec()},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tick, err := math.PriceToTick(tc.price, defaultExponentAtPriceOne)
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedError.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTick, tick.Int64())
+		})
+	}
+}
+
+// TestTickToSqrtPriceRoundTrip tests that the square root price of a tick maps back to the same tick.
+func TestTickToSqrtPriceRoundTrip(t *testing.T) {
+	for _, exponentAtPriceOne := range []sdk.Int{sdk.NewInt(-1), sdk.NewInt(-4), sdk.NewInt(-5)} {
+		minTick, maxTick := math.GetMinAndMaxTicksFromExponentAtPriceOne(exponentAtPriceOne)
+		ticks := []int64{minTick, minTick + 1, -90001, -1, 0, 1, 89999, 90000, maxTick - 1, maxTick}
+		for _, tick := range ticks {
+			if tick < minTick || tick > maxTick {
+				continue
+			}
+
+			sqrtPrice, err := math.TickToSqrtPrice(sdk.NewInt(tick), exponentAtPriceOne)
+			require.NoError(t, err)
+
+			tickFromSqrtPrice, err := math.SqrtPriceToTick(sqrtPrice, exponentAtPriceOne)
+			require.NoError(t, err)
+			require.Equal(t, tick, tickFromSqrtPrice.Int64(), "exponent %s", exponentAtPriceOne)
+		}
+	}
+}
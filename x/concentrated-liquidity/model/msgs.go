@@ -0,0 +1,118 @@
+package model
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+const (
+	TypeMsgCreateConcentratedPool = "create_concentrated_pool"
+)
+
+var (
+	_ sdk.Msg                        = &MsgCreateConcentratedPool{}
+	_ poolmanagertypes.CreatePoolMsg = &MsgCreateConcentratedPool{}
+)
+
+func NewMsgCreateConcentratedPool(
+	sender sdk.AccAddress,
+	denom0 string,
+	denom1 string,
+	tickSpacing uint64,
+	exponentAtPriceOne sdk.Int,
+	swapFee sdk.Dec,
+) MsgCreateConcentratedPool {
+	return MsgCreateConcentratedPool{
+		Sender:                    sender.String(),
+		Denom0:                    denom0,
+		Denom1:                    denom1,
+		TickSpacing:               tickSpacing,
+		PrecisionFactorAtPriceOne: exponentAtPriceOne,
+		SwapFee:                   swapFee,
+	}
+}
+
+func (msg MsgCreateConcentratedPool) Route() string { return types.RouterKey }
+func (msg MsgCreateConcentratedPool) Type() string  { return TypeMsgCreateConcentratedPool }
+func (msg MsgCreateConcentratedPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom0); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidPoolDenoms, err.Error())
+	}
+	if err := sdk.ValidateDenom(msg.Denom1); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidPoolDenoms, err.Error())
+	}
+	if msg.Denom0 == msg.Denom1 {
+		return types.ErrInvalidPoolDenoms
+	}
+
+	if msg.TickSpacing == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidPool, "tick spacing must be positive")
+	}
+
+	if msg.PrecisionFactorAtPriceOne.IsNil() ||
+		msg.PrecisionFactorAtPriceOne.LT(types.ExponentAtPriceOneMin) ||
+		msg.PrecisionFactorAtPriceOne.GT(types.ExponentAtPriceOneMax) {
+		return types.ExponentAtPriceOneError{ProvidedExponentAtPriceOne: msg.PrecisionFactorAtPriceOne}
+	}
+
+	if msg.SwapFee.IsNil() || msg.SwapFee.IsNegative() {
+		return types.ErrNegativeSwapFee
+	}
+	if msg.SwapFee.GTE(sdk.OneDec()) {
+		return types.ErrTooMuchSwapFee
+	}
+
+	return nil
+}
+
+func (msg MsgCreateConcentratedPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateConcentratedPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+/// Implement the CreatePoolMsg interface
+
+func (msg MsgCreateConcentratedPool) PoolCreator() sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return sender
+}
+
+func (msg MsgCreateConcentratedPool) Validate(ctx sdk.Context) error {
+	return msg.ValidateBasic()
+}
+
+// InitialLiquidity returns empty coins, liquidity is provided by creating positions.
+func (msg MsgCreateConcentratedPool) InitialLiquidity() sdk.Coins {
+	return sdk.Coins{}
+}
+
+func (msg MsgCreateConcentratedPool) CreatePool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error) {
+	pool, err := NewConcentratedLiquidityPool(poolId, msg.Denom0, msg.Denom1, msg.TickSpacing, msg.PrecisionFactorAtPriceOne, msg.SwapFee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pool, nil
+}
+
+func (msg MsgCreateConcentratedPool) GetPoolType() poolmanagertypes.PoolType {
+	return poolmanagertypes.Concentrated
+}
@@ -0,0 +1,221 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+var (
+	_ poolmanagertypes.PoolI          = &Pool{}
+	_ types.ConcentratedPoolExtension = &Pool{}
+)
+
+// NewConcentratedLiquidityPool creates a new concentrated liquidity pool with the given parameters.
+// The pool has no price and no liquidity until its first position is created.
+// The denoms are sorted so that token0 is the lexicographically smaller one.
+func NewConcentratedLiquidityPool(poolId uint64, denom0, denom1 string, tickSpacing uint64, exponentAtPriceOne sdk.Int, swapFee sdk.Dec) (Pool, error) {
+	if denom0 == denom1 {
+		return Pool{}, types.ErrInvalidPoolDenoms
+	}
+	if denom0 > denom1 {
+		denom0, denom1 = denom1, denom0
+	}
+
+	if exponentAtPriceOne.LT(types.ExponentAtPriceOneMin) || exponentAtPriceOne.GT(types.ExponentAtPriceOneMax) {
+		return Pool{}, types.ExponentAtPriceOneError{ProvidedExponentAtPriceOne: exponentAtPriceOne}
+	}
+
+	if swapFee.IsNegative() {
+		return Pool{}, types.ErrNegativeSwapFee
+	}
+	if swapFee.GTE(sdk.OneDec()) {
+		return Pool{}, types.ErrTooMuchSwapFee
+	}
+
+	return Pool{
+		Address:                   gammtypes.NewPoolAddress(poolId).String(),
+		Id:                        poolId,
+		CurrentTickLiquidity:      sdk.ZeroDec(),
+		Token0:                    denom0,
+		Token1:                    denom1,
+		CurrentSqrtPrice:          sdk.ZeroDec(),
+		CurrentTick:               sdk.ZeroInt(),
+		TickSpacing:               tickSpacing,
+		PrecisionFactorAtPriceOne: exponentAtPriceOne,
+		SwapFee:                   swapFee,
+	}, nil
+}
+
+// GetAddress returns the address of the concentrated liquidity pool.
+func (p Pool) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode address of pool with id: %d", p.GetId()))
+	}
+	return addr
+}
+
+func (p Pool) String() string {
+	out, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+func (p Pool) GetId() uint64 {
+	return p.Id
+}
+
+func (p Pool) GetSwapFee(_ sdk.Context) sdk.Dec {
+	return p.SwapFee
+}
+
+// GetExitFee returns zero, positions are withdrawn without an exit fee.
+func (p Pool) GetExitFee(_ sdk.Context) sdk.Dec {
+	return sdk.ZeroDec()
+}
+
+func (p Pool) IsActive(_ sdk.Context) bool {
+	return true
+}
+
+// GetTotalShares returns zero, concentrated liquidity pools do not issue LP shares.
+func (p Pool) GetTotalShares() sdk.Int {
+	return sdk.ZeroInt()
+}
+
+// GetTotalPoolLiquidity returns empty coins. The pool does not track its balances,
+// they are held by the pool's account and can be queried from the bank keeper.
+func (p Pool) GetTotalPoolLiquidity(_ sdk.Context) sdk.Coins {
+	return sdk.Coins{}
+}
+
+func (p Pool) GetType() poolmanagertypes.PoolType {
+	return poolmanagertypes.Concentrated
+}
+
+// SpotPrice returns the spot price of the base asset in terms of the quote asset.
+// The price of token0 in terms of token1 is the square of the current square root price.
+func (p Pool) SpotPrice(_ sdk.Context, quoteAssetDenom string, baseAssetDenom string) (sdk.Dec, error) {
+	if p.CurrentSqrtPrice.IsNil() || !p.CurrentSqrtPrice.IsPositive() {
+		return sdk.Dec{}, types.ErrPoolHasNoPrice
+	}
+
+	price := p.CurrentSqrtPrice.Mul(p.CurrentSqrtPrice)
+	switch {
+	case baseAssetDenom == p.Token0 && quoteAssetDenom == p.Token1:
+		return price, nil
+	case baseAssetDenom == p.Token1 && quoteAssetDenom == p.Token0:
+		return sdk.OneDec().Quo(price), nil
+	default:
+		return sdk.Dec{}, fmt.Errorf("base (%s) and quote (%s) assets must be the denoms of pool %d (%s, %s)",
+			baseAssetDenom, quoteAssetDenom, p.Id, p.Token0, p.Token1)
+	}
+}
+
+func (p Pool) GetToken0() string {
+	return p.Token0
+}
+
+func (p Pool) GetToken1() string {
+	return p.Token1
+}
+
+func (p Pool) GetCurrentSqrtPrice() sdk.Dec {
+	return p.CurrentSqrtPrice
+}
+
+func (p Pool) GetCurrentTick() sdk.Int {
+	return p.CurrentTick
+}
+
+func (p Pool) GetPrecisionFactorAtPriceOne() sdk.Int {
+	return p.PrecisionFactorAtPriceOne
+}
+
+func (p Pool) GetTickSpacing() uint64 {
+	return p.TickSpacing
+}
+
+// GetLiquidity returns the liquidity of the positions in range of the current tick.
+func (p Pool) GetLiquidity() sdk.Dec {
+	return p.CurrentTickLiquidity
+}
+
+func (p *Pool) SetCurrentSqrtPrice(newSqrtPrice sdk.Dec) {
+	p.CurrentSqrtPrice = newSqrtPrice
+}
+
+func (p *Pool) SetCurrentTick(newTick sdk.Int) {
+	p.CurrentTick = newTick
+}
+
+func (p *Pool) UpdateLiquidity(newLiquidity sdk.Dec) {
+	p.CurrentTickLiquidity = p.CurrentTickLiquidity.Add(newLiquidity)
+}
+
+// ApplySwap updates the liquidity, current tick and current sqrt price of the pool to the state after a swap.
+// Returns an error if the new liquidity is negative or if the new sqrt price is not positive.
+func (p *Pool) ApplySwap(newLiquidity sdk.Dec, newCurrentTick sdk.Int, newCurrentSqrtPrice sdk.Dec) error {
+	if newLiquidity.IsNegative() {
+		return sdkerrors.Wrapf(types.ErrInvalidMathApprox, "liquidity after swap (%s) is negative", newLiquidity)
+	}
+	if !newCurrentSqrtPrice.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidMathApprox, "sqrt price after swap (%s) is not positive", newCurrentSqrtPrice)
+	}
+
+	p.CurrentTickLiquidity = newLiquidity
+	p.CurrentTick = newCurrentTick
+	p.CurrentSqrtPrice = newCurrentSqrtPrice
+	return nil
+}
+
+// isCurrentTickInRange returns true if the current tick is within [lowerTick, upperTick).
+func (p Pool) isCurrentTickInRange(lowerTick, upperTick int64) bool {
+	currentTick := p.CurrentTick.Int64()
+	return currentTick >= lowerTick && currentTick < upperTick
+}
+
+// CalcActualAmounts returns the amounts of token0 and token1 that correspond to
+// the given liquidity delta over the range [lowerTick, upperTick), given the pool's current price.
+// The amounts are rounded up when liquidity is added and rounded down when it is removed,
+// and carry the sign of the liquidity delta.
+func (p Pool) CalcActualAmounts(_ sdk.Context, lowerTick, upperTick int64, sqrtRatioLowerTick, sqrtRatioUpperTick, liquidityDelta sdk.Dec) (actualAmountDenom0 sdk.Dec, actualAmountDenom1 sdk.Dec) {
+	roundUp := liquidityDelta.IsPositive()
+	liquidity := liquidityDelta.Abs()
+
+	actualAmountDenom0, actualAmountDenom1 = sdk.ZeroDec(), sdk.ZeroDec()
+	switch {
+	case p.isCurrentTickInRange(lowerTick, upperTick):
+		actualAmountDenom0 = math.CalcAmount0Delta(liquidity, p.CurrentSqrtPrice, sqrtRatioUpperTick, roundUp)
+		actualAmountDenom1 = math.CalcAmount1Delta(liquidity, sqrtRatioLowerTick, p.CurrentSqrtPrice, roundUp)
+	case p.CurrentTick.Int64() < lowerTick:
+		actualAmountDenom0 = math.CalcAmount0Delta(liquidity, sqrtRatioLowerTick, sqrtRatioUpperTick, roundUp)
+	default:
+		actualAmountDenom1 = math.CalcAmount1Delta(liquidity, sqrtRatioLowerTick, sqrtRatioUpperTick, roundUp)
+	}
+
+	if liquidityDelta.IsNegative() {
+		return actualAmountDenom0.Neg(), actualAmountDenom1.Neg()
+	}
+	return actualAmountDenom0, actualAmountDenom1
+}
+
+// UpdateLiquidityIfActivePosition adds the liquidity delta to the pool's current liquidity
+// if the current tick is within [lowerTick, upperTick). Returns true if the liquidity was updated.
+func (p *Pool) UpdateLiquidityIfActivePosition(_ sdk.Context, lowerTick, upperTick int64, liquidityDelta sdk.Dec) bool {
+	if !p.isCurrentTickInRange(lowerTick, upperTick) {
+		return false
+	}
+	p.UpdateLiquidity(liquidityDelta)
+	return true
+}
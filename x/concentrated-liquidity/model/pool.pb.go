@@ -0,0 +1,730 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/concentratedliquidity/v1beta1/pool.proto
+
+package model
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Pool is the concentrated liquidity Pool struct. Liquidity is provided over
+// tick ranges, and only the liquidity of the positions whose range contains
+// the current tick is used for swaps.
+type Pool struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// current_tick_liquidity is the sum of the liquidity of all positions
+	// whose range contains the current tick.
+	CurrentTickLiquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=current_tick_liquidity,json=currentTickLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_tick_liquidity" yaml:"current_tick_liquidity"`
+	// token0 and token1 are the denoms of the pool. The pool's price is the
+	// amount of token1 per unit of token0.
+	Token0 string `protobuf:"bytes,4,opt,name=token0,proto3" json:"token0,omitempty"`
+	Token1 string `protobuf:"bytes,5,opt,name=token1,proto3" json:"token1,omitempty"`
+	// current_sqrt_price is the square root of the current price. It is zero
+	// until the first position is created in the pool.
+	CurrentSqrtPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=current_sqrt_price,json=currentSqrtPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_sqrt_price" yaml:"current_sqrt_price"`
+	CurrentTick      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=current_tick,json=currentTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"current_tick" yaml:"current_tick"`
+	// tick_spacing must be one of the authorized_tick_spacing values set in the
+	// concentrated-liquidity parameters. Position ticks must be a multiple of
+	// it.
+	TickSpacing uint64 `protobuf:"varint,8,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty" yaml:"tick_spacing"`
+	// precision_factor_at_price_one is the exponent of the additive price
+	// increment between two consecutive ticks around a price of one. E.g. -4
+	// means that prices move by 0.0001 per tick between 1 and 10.
+	PrecisionFactorAtPriceOne github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=precision_factor_at_price_one,json=precisionFactorAtPriceOne,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"precision_factor_at_price_one" yaml:"precision_factor_at_price_one"`
+	SwapFee                   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+}
+
+func (m *Pool) Reset()      { *m = Pool{} }
+func (*Pool) ProtoMessage() {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99bb540bdcd927a, []int{0}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Pool)(nil), "dymensionxyz.dymension.concentratedliquidity.v1beta1.Pool")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/concentratedliquidity/v1beta1/pool.proto", fileDescriptor_a99bb540bdcd927a)
+}
+
+var fileDescriptor_a99bb540bdcd927a = []byte{
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0xb5, 0x43, 0x48, 0xda, 0x6b, 0x55, 0xd0, 0x51, 0x15, 0xa7, 0x52, 0xed, 0xca, 0x02, 0xd4,
+	0x81, 0xd8, 0x44, 0xc0, 0x92, 0x05, 0x35, 0xd0, 0x4a, 0x08, 0xa4, 0x56, 0x2e, 0x62, 0x40, 0x95,
+	0x2c, 0xe7, 0x7c, 0x4d, 0x4f, 0x71, 0x7c, 0x8e, 0xef, 0x52, 0x62, 0x76, 0x24, 0x46, 0xc6, 0x8e,
+	0xfd, 0x11, 0xac, 0xec, 0x15, 0x53, 0x47, 0xc4, 0x10, 0xa1, 0xe4, 0x1f, 0xe4, 0x17, 0x20, 0xdf,
+	0x39, 0x8e, 0x2b, 0xaa, 0x4a, 0x99, 0x7c, 0xef, 0x7d, 0xf6, 0x7b, 0xef, 0xbe, 0xf3, 0x77, 0xe0,
+	0x95, 0x9f, 0xf4, 0x70, 0xc8, 0x08, 0x0d, 0x87, 0xc9, 0x17, 0x3b, 0x07, 0x36, 0xa2, 0x21, 0xc2,
+	0x21, 0x8f, 0x3d, 0x8e, 0xfd, 0x80, 0xf4, 0x07, 0xc4, 0x27, 0x3c, 0xb1, 0xcf, 0x1a, 0x6d, 0xcc,
+	0xbd, 0x86, 0x1d, 0x51, 0x1a, 0x58, 0x51, 0x4c, 0x39, 0x85, 0x2f, 0x8a, 0x02, 0x56, 0x0e, 0xac,
+	0x1b, 0x05, 0xac, 0x4c, 0x60, 0xb3, 0x86, 0x28, 0xeb, 0x51, 0xe6, 0x0a, 0x0d, 0x5b, 0x02, 0x29,
+	0xb8, 0xb9, 0xde, 0xa1, 0x1d, 0x2a, 0xf9, 0x74, 0x25, 0x59, 0xf3, 0x67, 0x05, 0x94, 0x0f, 0x29,
+	0x0d, 0xe0, 0x53, 0x50, 0xf5, 0x7c, 0x3f, 0xc6, 0x8c, 0x69, 0xea, 0xb6, 0xba, 0xb3, 0xdc, 0x82,
+	0xd3, 0x91, 0xb1, 0x96, 0x78, 0xbd, 0xa0, 0x69, 0x66, 0x05, 0xd3, 0x99, 0xbd, 0x02, 0xd7, 0x40,
+	0x89, 0xf8, 0x5a, 0x69, 0x5b, 0xdd, 0x29, 0x3b, 0x25, 0xe2, 0xc3, 0xaf, 0x2a, 0xd8, 0x40, 0x83,
+	0x38, 0xc6, 0x21, 0x77, 0x39, 0x41, 0x5d, 0x37, 0x8f, 0xa6, 0xdd, 0x11, 0x6a, 0x07, 0x97, 0x23,
+	0x43, 0xf9, 0x33, 0x32, 0x9e, 0x74, 0x08, 0x3f, 0x1d, 0xb4, 0x2d, 0x44, 0x7b, 0x59, 0xbc, 0xec,
+	0x51, 0x67, 0x7e, 0xd7, 0xe6, 0x49, 0x84, 0x99, 0xf5, 0x06, 0xa3, 0xe9, 0xc8, 0xd8, 0x92, 0xde,
+	0x37, 0xab, 0x9a, 0xce, 0x7a, 0x56, 0xf8, 0x40, 0x50, 0xf7, 0xfd, 0x8c, 0x86, 0x1b, 0xa0, 0xc2,
+	0x69, 0x17, 0x87, 0xcf, 0xb4, 0x72, 0x6a, 0xeb, 0x64, 0x28, 0xe7, 0x1b, 0xda, 0xdd, 0x02, 0xdf,
+	0x80, 0x09, 0x80, 0x33, 0x03, 0xd6, 0x8f, 0xb9, 0x1b, 0xc5, 0x04, 0x61, 0xad, 0x22, 0x22, 0xbf,
+	0x5b, 0x38, 0x72, 0xed, 0x7a, 0xe4, 0xb9, 0xa2, 0xe9, 0xdc, 0xcf, 0xc8, 0xa3, 0x7e, 0xcc, 0x0f,
+	0x53, 0x0a, 0x9e, 0x82, 0xd5, 0xe2, 0xde, 0xb4, 0xaa, 0x30, 0xdd, 0x5b, 0xc0, 0xf4, 0x6d, 0xc8,
+	0xa7, 0x23, 0xe3, 0xc1, 0xff, 0x7d, 0x32, 0x9d, 0x95, 0x42, 0x77, 0x60, 0x13, 0xac, 0x8a, 0xee,
+	0xb1, 0xc8, 0x43, 0x24, 0xec, 0x68, 0x4b, 0xe9, 0xb1, 0xb5, 0x1e, 0xce, 0xbf, 0x2d, 0x56, 0x4d,
+	0x67, 0x25, 0x85, 0x47, 0x12, 0xc1, 0x73, 0x15, 0x6c, 0x45, 0x31, 0x46, 0x24, 0xfd, 0xf9, 0xdc,
+	0x13, 0x0f, 0x71, 0x1a, 0xbb, 0x5e, 0xb6, 0x2d, 0x97, 0x86, 0x58, 0x5b, 0x16, 0xb9, 0x3f, 0x2e,
+	0x9c, 0xfb, 0x91, 0xf4, 0xbe, 0x55, 0xdc, 0x74, 0x6a, 0x79, 0x7d, 0x5f, 0x94, 0x77, 0x65, 0xf7,
+	0x0e, 0x42, 0x0c, 0x8f, 0xc1, 0x12, 0xfb, 0xec, 0x45, 0xee, 0x09, 0xc6, 0x1a, 0x10, 0x21, 0x76,
+	0x17, 0x3e, 0xb1, 0x7b, 0x32, 0xc4, 0x4c, 0xc7, 0x74, 0xaa, 0xe9, 0x72, 0x1f, 0xe3, 0xe6, 0xe3,
+	0x6f, 0x17, 0x86, 0x72, 0x7e, 0x61, 0x28, 0xbf, 0x7e, 0xd4, 0x6b, 0xaf, 0x0b, 0x43, 0x97, 0x4e,
+	0xcb, 0xde, 0x90, 0xcb, 0x71, 0x6c, 0x1d, 0x5f, 0x8e, 0x75, 0xf5, 0x6a, 0xac, 0xab, 0x7f, 0xc7,
+	0xba, 0xfa, 0x7d, 0xa2, 0x2b, 0x57, 0x13, 0x5d, 0xf9, 0x3d, 0xd1, 0x95, 0x4f, 0xad, 0x42, 0x08,
+	0x61, 0x4e, 0x58, 0x3d, 0xf0, 0xda, 0x6c, 0x06, 0xec, 0xb3, 0xc6, 0x4b, 0x7b, 0x78, 0xed, 0x42,
+	0xa8, 0xcf, 0x6f, 0x84, 0x1e, 0xf5, 0x71, 0xd0, 0xae, 0x88, 0x21, 0x7d, 0xfe, 0x6f, 0x00, 0x0d,
+	0x88, 0x1c, 0xc3, 0x4e, 0x04, 0x00, 0x00,
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.PrecisionFactorAtPriceOne.Size()
+		i -= size
+		if _, err := m.PrecisionFactorAtPriceOne.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.TickSpacing != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.CurrentTick.Size()
+		i -= size
+		if _, err := m.CurrentTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.CurrentSqrtPrice.Size()
+		i -= size
+		if _, err := m.CurrentSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Token1) > 0 {
+		i -= len(m.Token1)
+		copy(dAtA[i:], m.Token1)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Token1)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Token0) > 0 {
+		i -= len(m.Token0)
+		copy(dAtA[i:], m.Token0)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Token0)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.CurrentTickLiquidity.Size()
+		i -= size
+		if _, err := m.CurrentTickLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovPool(uint64(m.Id))
+	}
+	l = m.CurrentTickLiquidity.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = len(m.Token0)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Token1)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = m.CurrentSqrtPrice.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.CurrentTick.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.TickSpacing != 0 {
+		n += 1 + sovPool(uint64(m.TickSpacing))
+	}
+	l = m.PrecisionFactorAtPriceOne.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.SwapFee.Size()
+	n += 1 + l + sovPool(uint64(l))
+	return n
+}
+
+func sovPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPool(x uint64) (n int) {
+	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTickLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentTickLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
+			}
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecisionFactorAtPriceOne", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrecisionFactorAtPriceOne.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPool = fmt.Errorf("proto: unexpected end of group")
+)
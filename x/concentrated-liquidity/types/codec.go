@@ -0,0 +1,55 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/concentrated-liquidity interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterInterface((*ConcentratedPoolExtension)(nil), nil)
+	cdc.RegisterConcrete(&MsgCreatePosition{}, "dymensionxyz/dymension/concentratedliquidity/CreatePosition", nil)
+	cdc.RegisterConcrete(&MsgWithdrawPosition{}, "dymensionxyz/dymension/concentratedliquidity/WithdrawPosition", nil)
+	cdc.RegisterConcrete(&MsgCollectFees{}, "dymensionxyz/dymension/concentratedliquidity/CollectFees", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterInterface(
+		"dymensionxyz.dymension.concentratedliquidity.v1beta1.ConcentratedPoolExtension",
+		(*ConcentratedPoolExtension)(nil),
+	)
+
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreatePosition{},
+		&MsgWithdrawPosition{},
+		&MsgCollectFees{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/concentrated-liquidity module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/concentrated-liquidity and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	// Register all Amino interfaces and concrete types on the authz Amino codec so that this can later be
+	// used to properly serialize MsgGrant and MsgExec instances
+	sdk.RegisterLegacyAminoCodec(amino)
+	RegisterLegacyAminoCodec(authzcodec.Amino)
+
+	amino.Seal()
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxSpotPriceExponent is the power of ten of the maximum spot price supported by concentrated liquidity pools.
+	MaxSpotPriceExponent = 20
+	// MinSpotPriceExponent is the power of ten of the minimum spot price supported by concentrated liquidity pools.
+	// Together with ExponentAtPriceOneMin, it is chosen so that the price increment between two ticks is always
+	// representable with the 18 decimals of an sdk.Dec.
+	MinSpotPriceExponent = -12
+)
+
+var (
+	MaxSpotPrice = sdk.NewDec(10).Power(MaxSpotPriceExponent)
+	MinSpotPrice = sdk.NewDecWithPrec(1, -MinSpotPriceExponent)
+
+	// ExponentAtPriceOneMax and ExponentAtPriceOneMin bound the precision factor at price one of a pool.
+	ExponentAtPriceOneMax = sdk.NewInt(-1)
+	ExponentAtPriceOneMin = sdk.NewInt(-5)
+
+	DefaultAuthorizedTickSpacing = []uint64{1, 10, 100, 1000}
+)
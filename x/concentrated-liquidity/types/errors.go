@@ -0,0 +1,121 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type PoolNotFoundError struct {
+	PoolId uint64
+}
+
+func (e PoolNotFoundError) Error() string {
+	return fmt.Sprintf("concentrated liquidity pool with ID %d does not exist", e.PoolId)
+}
+
+type PositionNotFoundError struct {
+	PoolId    uint64
+	LowerTick int64
+	UpperTick int64
+}
+
+func (e PositionNotFoundError) Error() string {
+	return fmt.Sprintf("position in pool %d over range [%d, %d) does not exist", e.PoolId, e.LowerTick, e.UpperTick)
+}
+
+type InvalidTickError struct {
+	Tick    int64
+	IsLower bool
+	MinTick int64
+	MaxTick int64
+}
+
+func (e InvalidTickError) Error() string {
+	tickStr := "upper"
+	if e.IsLower {
+		tickStr = "lower"
+	}
+	return fmt.Sprintf("%s tick (%d) is invalid, must be between %d and %d", tickStr, e.Tick, e.MinTick, e.MaxTick)
+}
+
+type InvalidLowerUpperTickError struct {
+	LowerTick int64
+	UpperTick int64
+}
+
+func (e InvalidLowerUpperTickError) Error() string {
+	return fmt.Sprintf("lower tick (%d) must be less than upper tick (%d)", e.LowerTick, e.UpperTick)
+}
+
+type TickSpacingError struct {
+	TickSpacing uint64
+	LowerTick   int64
+	UpperTick   int64
+}
+
+func (e TickSpacingError) Error() string {
+	return fmt.Sprintf("lower tick (%d) and upper tick (%d) must be divisible by the pool's tick spacing (%d)", e.LowerTick, e.UpperTick, e.TickSpacing)
+}
+
+type UnauthorizedTickSpacingError struct {
+	ProvidedTickSpacing    uint64
+	AuthorizedTickSpacings []uint64
+}
+
+func (e UnauthorizedTickSpacingError) Error() string {
+	return fmt.Sprintf("tick spacing (%d) is not authorized, must be one of %v", e.ProvidedTickSpacing, e.AuthorizedTickSpacings)
+}
+
+type ExponentAtPriceOneError struct {
+	ProvidedExponentAtPriceOne sdk.Int
+}
+
+func (e ExponentAtPriceOneError) Error() string {
+	return fmt.Sprintf("precision factor at price one (%s) must be between %s and %s", e.ProvidedExponentAtPriceOne, ExponentAtPriceOneMin, ExponentAtPriceOneMax)
+}
+
+type PriceBoundError struct {
+	ProvidedPrice sdk.Dec
+}
+
+func (e PriceBoundError) Error() string {
+	return fmt.Sprintf("price (%s) must be between %s and %s", e.ProvidedPrice, MinSpotPrice, MaxSpotPrice)
+}
+
+type DenomNotInPoolError struct {
+	PoolId uint64
+	Denom  string
+}
+
+func (e DenomNotInPoolError) Error() string {
+	return fmt.Sprintf("denom (%s) does not exist in pool %d", e.Denom, e.PoolId)
+}
+
+type InsufficientLiquidityError struct {
+	Actual    sdk.Dec
+	Available sdk.Dec
+}
+
+func (e InsufficientLiquidityError) Error() string {
+	return fmt.Sprintf("insufficient liquidity requested to withdraw. requested (%s), available (%s)", e.Actual, e.Available)
+}
+
+// x/concentrated-liquidity module sentinel errors.
+var (
+	ErrPoolHasNoPrice         = sdkerrors.Register(ModuleName, 1, "pool has no price yet, the first position must provide both tokens")
+	ErrNotEnoughLiquidity     = sdkerrors.Register(ModuleName, 2, "not enough liquidity in the pool to fill the swap")
+	ErrLimitMinAmount         = sdkerrors.Register(ModuleName, 3, "calculated amount is lesser than min amount")
+	ErrLimitMaxAmount         = sdkerrors.Register(ModuleName, 4, "calculated amount is larger than max amount")
+	ErrInvalidMathApprox      = sdkerrors.Register(ModuleName, 5, "invalid calculated result")
+	ErrZeroLiquidity          = sdkerrors.Register(ModuleName, 6, "liquidity must be positive")
+	ErrNegativeSwapFee        = sdkerrors.Register(ModuleName, 7, "swap fee is negative")
+	ErrTooMuchSwapFee         = sdkerrors.Register(ModuleName, 8, "swap fee should be lesser than 1 (100%)")
+	ErrInvalidPool            = sdkerrors.Register(ModuleName, 9, "attempting to create an invalid pool")
+	ErrInvalidPoolDenoms      = sdkerrors.Register(ModuleName, 10, "pool denoms must be valid and distinct")
+	ErrAmountExceedsDesired   = sdkerrors.Register(ModuleName, 11, "calculated amount is larger than the desired amount")
+	ErrNotConcentratedPool    = sdkerrors.Register(ModuleName, 12, "pool is not a concentrated liquidity pool")
+	ErrSameDenomSwap          = sdkerrors.Register(ModuleName, 13, "cannot trade same denomination in and out")
+	ErrNegativeUncollectedFee = sdkerrors.Register(ModuleName, 14, "uncollected fees of a position must not be negative")
+)
@@ -0,0 +1,16 @@
+package types
+
+const (
+	TypeEvtCreatePosition   = "create_position"
+	TypeEvtWithdrawPosition = "withdraw_position"
+	TypeEvtCollectFees      = "collect_fees"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyPoolId     = "pool_id"
+	AttributeKeyLowerTick  = "lower_tick"
+	AttributeKeyUpperTick  = "upper_tick"
+	AttributeKeyLiquidity  = "liquidity"
+	AttributeKeyAmount0    = "amount0"
+	AttributeKeyAmount1    = "amount1"
+	AttributeKeyTokensOut  = "tokens_out"
+)
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis creates a default GenesisState object.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Pools:  []*codectypes.Any{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, position := range gs.Positions {
+		if _, err := sdk.AccAddressFromBech32(position.Address); err != nil {
+			return fmt.Errorf("invalid position address (%s): %w", position.Address, err)
+		}
+		if position.LowerTick >= position.UpperTick {
+			return InvalidLowerUpperTickError{LowerTick: position.LowerTick, UpperTick: position.UpperTick}
+		}
+		if position.Liquidity.IsNil() || position.Liquidity.IsNegative() {
+			return fmt.Errorf("position liquidity must not be negative, got %s", position.Liquidity)
+		}
+	}
+
+	for _, tick := range gs.Ticks {
+		if tick.Info.LiquidityGross.IsNil() || !tick.Info.LiquidityGross.IsPositive() {
+			return fmt.Errorf("tick %d of pool %d must have a positive gross liquidity", tick.TickIndex, tick.PoolId)
+		}
+	}
+
+	return nil
+}
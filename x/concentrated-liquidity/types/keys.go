@@ -0,0 +1,85 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	ModuleName = "concentratedliquidity"
+
+	StoreKey = ModuleName
+
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+var (
+	// KeyPrefixPools defines prefix to store pools.
+	KeyPrefixPools = []byte{0x01}
+	// KeyPrefixTicks defines prefix to store the initialized ticks of each pool.
+	KeyPrefixTicks = []byte{0x02}
+	// KeyPrefixPositions defines prefix to store positions.
+	KeyPrefixPositions = []byte{0x03}
+	// KeyPrefixFeeAccumulators defines prefix to store the fee growth of each pool.
+	KeyPrefixFeeAccumulators = []byte{0x04}
+)
+
+// KeyPool returns the key of the pool with the given id.
+func KeyPool(poolId uint64) []byte {
+	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyTickPrefixByPoolId returns the prefix of all the ticks of the given pool.
+func KeyTickPrefixByPoolId(poolId uint64) []byte {
+	return append(KeyPrefixTicks, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyTick returns the key of the given tick of a pool. Ticks are stored so that
+// the lexicographic order of the keys matches the numerical order of the ticks.
+func KeyTick(poolId uint64, tickIndex int64) []byte {
+	return append(KeyTickPrefixByPoolId(poolId), TickIndexToBytes(tickIndex)...)
+}
+
+// TickIndexToBytes converts a tick index to its sortable byte representation.
+func TickIndexToBytes(tickIndex int64) []byte {
+	bz := make([]byte, 8)
+	// flipping the sign bit makes negative ticks sort before positive ones
+	binary.BigEndian.PutUint64(bz, uint64(tickIndex)^(1<<63))
+	return bz
+}
+
+// TickIndexFromBytes converts the sortable byte representation of a tick index
+// back to the tick index.
+func TickIndexFromBytes(bz []byte) (int64, error) {
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid tick index byte length: %d", len(bz))
+	}
+	return int64(binary.BigEndian.Uint64(bz) ^ (1 << 63)), nil
+}
+
+// KeyUserPositions returns the prefix of all the positions of the given address.
+func KeyUserPositions(addr sdk.AccAddress) []byte {
+	return append(KeyPrefixPositions, address.MustLengthPrefix(addr)...)
+}
+
+// KeyUserPositionsByPoolId returns the prefix of the positions of the given address in a pool.
+func KeyUserPositionsByPoolId(addr sdk.AccAddress, poolId uint64) []byte {
+	return append(KeyUserPositions(addr), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyPosition returns the key of the position of the given address in a pool over a tick range.
+func KeyPosition(addr sdk.AccAddress, poolId uint64, lowerTick, upperTick int64) []byte {
+	key := KeyUserPositionsByPoolId(addr, poolId)
+	key = append(key, TickIndexToBytes(lowerTick)...)
+	return append(key, TickIndexToBytes(upperTick)...)
+}
+
+// KeyFeeAccumulator returns the key of the fee accumulator of the given pool.
+func KeyFeeAccumulator(poolId uint64) []byte {
+	return append(KeyPrefixFeeAccumulators, sdk.Uint64ToBigEndian(poolId)...)
+}
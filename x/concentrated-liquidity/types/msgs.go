@@ -0,0 +1,121 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants.
+const (
+	TypeMsgCreatePosition   = "create_position"
+	TypeMsgWithdrawPosition = "withdraw_position"
+	TypeMsgCollectFees      = "collect_fees"
+)
+
+var (
+	_ sdk.Msg = &MsgCreatePosition{}
+	_ sdk.Msg = &MsgWithdrawPosition{}
+	_ sdk.Msg = &MsgCollectFees{}
+)
+
+func (msg MsgCreatePosition) Route() string { return RouterKey }
+func (msg MsgCreatePosition) Type() string  { return TypeMsgCreatePosition }
+func (msg MsgCreatePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return InvalidLowerUpperTickError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	}
+
+	if !msg.TokenDesired0.IsValid() || !msg.TokenDesired1.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "desired tokens (%s, %s) must be valid", msg.TokenDesired0, msg.TokenDesired1)
+	}
+	if msg.TokenDesired0.Denom == msg.TokenDesired1.Denom {
+		return ErrInvalidPoolDenoms
+	}
+	if msg.TokenDesired0.IsZero() && msg.TokenDesired1.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "at least one of the desired tokens must be positive")
+	}
+
+	if msg.TokenMinAmount0.IsNil() || msg.TokenMinAmount0.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "token min amount0 (%s) must not be negative", msg.TokenMinAmount0)
+	}
+	if msg.TokenMinAmount1.IsNil() || msg.TokenMinAmount1.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "token min amount1 (%s) must not be negative", msg.TokenMinAmount1)
+	}
+
+	return nil
+}
+
+func (msg MsgCreatePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreatePosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg MsgWithdrawPosition) Route() string { return RouterKey }
+func (msg MsgWithdrawPosition) Type() string  { return TypeMsgWithdrawPosition }
+func (msg MsgWithdrawPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return InvalidLowerUpperTickError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	}
+
+	if msg.LiquidityAmount.IsNil() || !msg.LiquidityAmount.IsPositive() {
+		return ErrZeroLiquidity
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg MsgCollectFees) Route() string { return RouterKey }
+func (msg MsgCollectFees) Type() string  { return TypeMsgCollectFees }
+func (msg MsgCollectFees) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return InvalidLowerUpperTickError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	}
+
+	return nil
+}
+
+func (msg MsgCollectFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCollectFees) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
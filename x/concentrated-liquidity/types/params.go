@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyAuthorizedTickSpacing = []byte("AuthorizedTickSpacing")
+	KeyPoolCreationFee       = []byte("PoolCreationFee")
+)
+
+// ParamTable for concentrated-liquidity module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(authorizedTickSpacing []uint64, poolCreationFee sdk.Coins) Params {
+	return Params{
+		AuthorizedTickSpacing: authorizedTickSpacing,
+		PoolCreationFee:       poolCreationFee,
+	}
+}
+
+// default concentrated-liquidity module parameters.
+func DefaultParams() Params {
+	return Params{
+		AuthorizedTickSpacing: DefaultAuthorizedTickSpacing,
+		PoolCreationFee:       sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+	}
+}
+
+// validate params.
+func (p Params) Validate() error {
+	if err := validateTickSpacing(p.AuthorizedTickSpacing); err != nil {
+		return err
+	}
+	if err := validatePoolCreationFee(p.PoolCreationFee); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAuthorizedTickSpacing, &p.AuthorizedTickSpacing, validateTickSpacing),
+		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+	}
+}
+
+// IsAuthorizedTickSpacing returns true if pools can be created with the given tick spacing.
+func (p Params) IsAuthorizedTickSpacing(tickSpacing uint64) bool {
+	for _, authorized := range p.AuthorizedTickSpacing {
+		if authorized == tickSpacing {
+			return true
+		}
+	}
+	return false
+}
+
+func validateTickSpacing(i interface{}) error {
+	v, ok := i.([]uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, tickSpacing := range v {
+		if tickSpacing == 0 {
+			return fmt.Errorf("authorized tick spacing must be positive")
+		}
+	}
+
+	return nil
+}
+
+func validatePoolCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.Validate() != nil {
+		return fmt.Errorf("invalid pool creation fee: %+v", i)
+	}
+
+	return nil
+}
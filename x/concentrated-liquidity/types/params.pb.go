@@ -0,0 +1,454 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/concentratedliquidity/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Params struct {
+	// authorized_tick_spacing is an array of uint64s that represents the tick
+	// spacing values concentrated-liquidity pools can be created with.
+	AuthorizedTickSpacing []uint64                                 `protobuf:"varint,1,rep,packed,name=authorized_tick_spacing,json=authorizedTickSpacing,proto3" json:"authorized_tick_spacing,omitempty" yaml:"authorized_tick_spacing"`
+	PoolCreationFee       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91d2f55ce55f8e37, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAuthorizedTickSpacing() []uint64 {
+	if m != nil {
+		return m.AuthorizedTickSpacing
+	}
+	return nil
+}
+
+func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PoolCreationFee
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.concentratedliquidity.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/concentratedliquidity/v1beta1/params.proto", fileDescriptor_91d2f55ce55f8e37)
+}
+
+var fileDescriptor_91d2f55ce55f8e37 = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbd, 0x4e, 0xeb, 0x30,
+	0x14, 0xc7, 0x93, 0xdb, 0xab, 0x0e, 0xb9, 0xc3, 0xd5, 0xad, 0x2e, 0xa2, 0x74, 0x70, 0xaa, 0x4c,
+	0x5d, 0x6a, 0xab, 0x7c, 0x2c, 0x6c, 0xa4, 0x12, 0x13, 0x03, 0x2a, 0x4c, 0x15, 0x52, 0xe4, 0x38,
+	0x26, 0xb5, 0x9a, 0xf8, 0x84, 0xd8, 0xad, 0x9a, 0x3e, 0x05, 0x12, 0x6f, 0xc1, 0x93, 0x74, 0xec,
+	0xc8, 0x54, 0x50, 0xfb, 0x06, 0x1d, 0x99, 0x50, 0x93, 0x7e, 0x21, 0x60, 0xb2, 0xff, 0x3e, 0x3e,
+	0xbf, 0xff, 0xf9, 0xb0, 0x2e, 0x82, 0x2c, 0xe6, 0x52, 0x09, 0x90, 0xa3, 0x6c, 0x4c, 0xb6, 0x82,
+	0x30, 0x90, 0x8c, 0x4b, 0x9d, 0x52, 0xcd, 0x83, 0x48, 0x3c, 0x0c, 0x44, 0x20, 0x74, 0x46, 0x86,
+	0x2d, 0x9f, 0x6b, 0xda, 0x22, 0x09, 0x4d, 0x69, 0xac, 0x70, 0x92, 0x82, 0x86, 0xca, 0xe9, 0x3e,
+	0x02, 0x6f, 0x05, 0xfe, 0x16, 0x81, 0xd7, 0x88, 0xda, 0xff, 0x10, 0x42, 0xc8, 0x01, 0x64, 0x75,
+	0x2b, 0x58, 0x35, 0xc4, 0x40, 0xc5, 0xa0, 0x88, 0x4f, 0x15, 0xdf, 0xba, 0x31, 0x10, 0xb2, 0x88,
+	0x3b, 0xef, 0xa6, 0x55, 0xbe, 0xce, 0xcd, 0x2b, 0x5d, 0xeb, 0x90, 0x0e, 0x74, 0x0f, 0x52, 0x31,
+	0xe6, 0x81, 0xa7, 0x05, 0xeb, 0x7b, 0x2a, 0xa1, 0x4c, 0xc8, 0xb0, 0x6a, 0xd6, 0x4b, 0x8d, 0xdf,
+	0xae, 0xb3, 0x9c, 0xd9, 0x28, 0xa3, 0x71, 0x74, 0xee, 0xfc, 0xf0, 0xd1, 0xe9, 0x1c, 0xec, 0x22,
+	0xb7, 0x82, 0xf5, 0x6f, 0x8a, 0xf7, 0xca, 0x93, 0x69, 0xfd, 0x4b, 0x00, 0x22, 0x8f, 0xa5, 0x9c,
+	0x6a, 0x01, 0xd2, 0xbb, 0xe7, 0xbc, 0xfa, 0xab, 0x5e, 0x6a, 0xfc, 0x39, 0x3e, 0xc2, 0x45, 0x8d,
+	0x78, 0x55, 0xe3, 0xa6, 0x1d, 0xdc, 0x06, 0x21, 0xdd, 0xab, 0xc9, 0xcc, 0x36, 0x96, 0x33, 0xbb,
+	0x5a, 0xb8, 0x7e, 0x21, 0x38, 0xcf, 0xaf, 0x76, 0x23, 0x14, 0xba, 0x37, 0xf0, 0x31, 0x83, 0x98,
+	0xac, 0x9b, 0x2d, 0x8e, 0xa6, 0x0a, 0xfa, 0x44, 0x67, 0x09, 0x57, 0x39, 0x4c, 0x75, 0xfe, 0xae,
+	0xf2, 0xdb, 0xeb, 0xf4, 0x4b, 0xce, 0xdd, 0xbb, 0xc9, 0x1c, 0x99, 0xd3, 0x39, 0x32, 0xdf, 0xe6,
+	0xc8, 0x7c, 0x5c, 0x20, 0x63, 0xba, 0x40, 0xc6, 0xcb, 0x02, 0x19, 0x5d, 0x77, 0x0f, 0x9a, 0xc3,
+	0x84, 0x6a, 0x46, 0xd4, 0x57, 0x1b, 0x41, 0x86, 0xad, 0x33, 0x32, 0xfa, 0xb4, 0xd4, 0xe6, 0x6e,
+	0xab, 0xb9, 0xa9, 0x5f, 0xce, 0x27, 0x7c, 0xf2, 0x31, 0x00, 0xfd, 0xf4, 0x6b, 0x57, 0x12, 0x02,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolCreationFee) > 0 {
+		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AuthorizedTickSpacing) > 0 {
+		dAtA2 := make([]byte, len(m.AuthorizedTickSpacing)*10)
+		var j1 int
+		for _, num := range m.AuthorizedTickSpacing {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AuthorizedTickSpacing) > 0 {
+		l = 0
+		for _, e := range m.AuthorizedTickSpacing {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AuthorizedTickSpacing = append(m.AuthorizedTickSpacing, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AuthorizedTickSpacing) == 0 {
+					m.AuthorizedTickSpacing = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AuthorizedTickSpacing = append(m.AuthorizedTickSpacing, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedTickSpacing", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
+			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
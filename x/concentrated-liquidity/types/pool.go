@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// ConcentratedPoolExtension is the interface of concentrated liquidity pools.
+// On top of the generic PoolI methods, it exposes the state the keeper
+// needs to step swaps through the ticks of the pool.
+type ConcentratedPoolExtension interface {
+	poolmanagertypes.PoolI
+
+	GetToken0() string
+	GetToken1() string
+	GetCurrentSqrtPrice() sdk.Dec
+	GetCurrentTick() sdk.Int
+	GetPrecisionFactorAtPriceOne() sdk.Int
+	GetTickSpacing() uint64
+	GetLiquidity() sdk.Dec
+	SetCurrentSqrtPrice(newSqrtPrice sdk.Dec)
+	SetCurrentTick(newTick sdk.Int)
+	UpdateLiquidity(newLiquidity sdk.Dec)
+	// ApplySwap updates the liquidity, current tick and current sqrt price of the pool to the state after a swap.
+	ApplySwap(newLiquidity sdk.Dec, newCurrentTick sdk.Int, newCurrentSqrtPrice sdk.Dec) error
+	// CalcActualAmounts returns the amounts of token0 and token1 that correspond to
+	// the given liquidity delta over the range [lowerTick, upperTick), given the pool's current price.
+	CalcActualAmounts(ctx sdk.Context, lowerTick, upperTick int64, sqrtRatioLowerTick, sqrtRatioUpperTick, liquidityDelta sdk.Dec) (actualAmountDenom0 sdk.Dec, actualAmountDenom1 sdk.Dec)
+	// UpdateLiquidityIfActivePosition adds the liquidity delta to the pool's current liquidity
+	// if the current tick is within [lowerTick, upperTick). Returns true if the liquidity was updated.
+	UpdateLiquidityIfActivePosition(ctx sdk.Context, lowerTick, upperTick int64, liquidityDelta sdk.Dec) bool
+}
@@ -0,0 +1,622 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/concentratedliquidity/v1beta1/position.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Position is the liquidity provided by an address to a pool over the
+// [lower_tick, upper_tick) range.
+type Position struct {
+	Address   string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	PoolId    uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	LowerTick int64                                  `protobuf:"varint,3,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64                                  `protobuf:"varint,4,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	Liquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+	// fee_growth_inside_last is the fee growth per unit of liquidity inside the
+	// position's range as of the last time the position was updated.
+	FeeGrowthInsideLast github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=fee_growth_inside_last,json=feeGrowthInsideLast,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fee_growth_inside_last" yaml:"fee_growth_inside_last"`
+	// uncollected_fees are the fees accrued by the position up until the last
+	// time it was updated, that have not been collected yet.
+	UncollectedFees github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,7,rep,name=uncollected_fees,json=uncollectedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"uncollected_fees" yaml:"uncollected_fees"`
+}
+
+func (m *Position) Reset()         { *m = Position{} }
+func (m *Position) String() string { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()    {}
+func (*Position) Descriptor() ([]byte, []int) {
+	return fileDescriptor_178b7c2c8b2cf4e4, []int{0}
+}
+func (m *Position) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Position) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Position.Merge(m, src)
+}
+func (m *Position) XXX_Size() int {
+	return m.Size()
+}
+func (m *Position) XXX_DiscardUnknown() {
+	xxx_messageInfo_Position.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Position proto.InternalMessageInfo
+
+func (m *Position) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Position) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *Position) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *Position) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *Position) GetFeeGrowthInsideLast() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FeeGrowthInsideLast
+	}
+	return nil
+}
+
+func (m *Position) GetUncollectedFees() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.UncollectedFees
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Position)(nil), "dymensionxyz.dymension.concentratedliquidity.v1beta1.Position")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/concentratedliquidity/v1beta1/position.proto", fileDescriptor_178b7c2c8b2cf4e4)
+}
+
+var fileDescriptor_178b7c2c8b2cf4e4 = []byte{
+	// 495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x63, 0x12, 0x12, 0x62, 0x24, 0x28, 0xe6, 0x9f, 0x55, 0x81, 0x1d, 0xf9, 0x80, 0x22,
+	0x95, 0x78, 0x15, 0x28, 0x17, 0x8e, 0x2e, 0x02, 0x55, 0x42, 0x08, 0x59, 0x3d, 0x21, 0x24, 0x63,
+	0xef, 0x4e, 0xd2, 0x55, 0x1c, 0xaf, 0xf1, 0x6e, 0xda, 0x9a, 0xa7, 0xe0, 0xc6, 0x23, 0x20, 0xf1,
+	0x24, 0x3d, 0xf6, 0x88, 0x38, 0x18, 0x94, 0xbc, 0x41, 0x2e, 0x5c, 0x91, 0xd7, 0xeb, 0x24, 0x54,
+	0x3d, 0xb4, 0x27, 0xef, 0xa7, 0x99, 0xf9, 0x7d, 0x9f, 0xc7, 0x5e, 0x7d, 0x8f, 0xe4, 0x53, 0x48,
+	0x38, 0x65, 0xc9, 0x49, 0xfe, 0x05, 0xad, 0x04, 0xc2, 0x2c, 0xc1, 0x90, 0x88, 0x2c, 0x14, 0x40,
+	0x62, 0xfa, 0x79, 0x46, 0x09, 0x15, 0x39, 0x3a, 0x1a, 0x46, 0x20, 0xc2, 0x21, 0x4a, 0x19, 0xa7,
+	0x82, 0xb2, 0xc4, 0x4d, 0x33, 0x26, 0x98, 0xb1, 0xbb, 0x09, 0x71, 0x57, 0xc2, 0xbd, 0x10, 0xe2,
+	0x2a, 0xc8, 0xf6, 0xbd, 0x31, 0x1b, 0x33, 0x09, 0x40, 0xe5, 0xa9, 0x62, 0x6d, 0x5b, 0x98, 0xf1,
+	0x29, 0xe3, 0x28, 0x0a, 0x39, 0xac, 0xfc, 0x30, 0xa3, 0xca, 0xcb, 0xf9, 0xdb, 0xd2, 0x6f, 0xbc,
+	0x57, 0xf6, 0xc6, 0x53, 0xbd, 0x13, 0x12, 0x92, 0x01, 0xe7, 0xa6, 0xd6, 0xd3, 0xfa, 0x5d, 0xcf,
+	0x58, 0x16, 0xf6, 0xad, 0x3c, 0x9c, 0xc6, 0x2f, 0x1d, 0x55, 0x70, 0xfc, 0xba, 0xc5, 0xd8, 0xd1,
+	0x3b, 0x29, 0x63, 0x71, 0x40, 0x89, 0x79, 0xad, 0xa7, 0xf5, 0x5b, 0x9b, 0xdd, 0xaa, 0xe0, 0xf8,
+	0xed, 0xf2, 0xb4, 0x4f, 0x8c, 0x5d, 0x5d, 0x8f, 0xd9, 0x31, 0x64, 0x81, 0xa0, 0x78, 0x62, 0x36,
+	0x7b, 0x5a, 0xbf, 0xe9, 0xdd, 0x5f, 0x16, 0xf6, 0x9d, 0xaa, 0x7f, 0x5d, 0x73, 0xfc, 0xae, 0x14,
+	0x07, 0x14, 0x4f, 0xca, 0xa9, 0x59, 0x9a, 0xd6, 0x53, 0xad, 0xf3, 0x53, 0xeb, 0x9a, 0xe3, 0x77,
+	0xa5, 0x90, 0x53, 0x9f, 0xf4, 0xee, 0x6a, 0x3d, 0xe6, 0x75, 0xf9, 0x22, 0xde, 0x69, 0x61, 0x37,
+	0x7e, 0x15, 0xf6, 0x93, 0x31, 0x15, 0x87, 0xb3, 0xc8, 0xc5, 0x6c, 0x8a, 0xd4, 0x66, 0xaa, 0xc7,
+	0x80, 0x93, 0x09, 0x12, 0x79, 0x0a, 0xdc, 0x7d, 0x05, 0x78, 0x59, 0xd8, 0x5b, 0x2a, 0x58, 0x0d,
+	0x2a, 0x73, 0xd5, 0x67, 0xe3, 0xbb, 0xa6, 0x3f, 0x18, 0x01, 0x04, 0xe3, 0x8c, 0x1d, 0x8b, 0xc3,
+	0x80, 0x26, 0x9c, 0x12, 0x08, 0xe2, 0x90, 0x0b, 0xb3, 0xdd, 0x6b, 0xf6, 0x6f, 0x3e, 0x7b, 0xe4,
+	0x56, 0x58, 0xb7, 0xdc, 0x7b, 0xfd, 0x89, 0x4a, 0xf2, 0x1e, 0xa3, 0x89, 0x77, 0x50, 0xa6, 0x59,
+	0x16, 0xf6, 0xe3, 0xca, 0xe3, 0x62, 0x92, 0xf3, 0xe3, 0xb7, 0xbd, 0x73, 0xb9, 0xb8, 0x25, 0x94,
+	0xfb, 0x77, 0x47, 0x00, 0x6f, 0x24, 0x66, 0x5f, 0x52, 0xde, 0x86, 0x5c, 0x18, 0xdf, 0x34, 0x7d,
+	0x6b, 0x96, 0x60, 0x16, 0xc7, 0x80, 0x05, 0x90, 0x60, 0x04, 0xc0, 0xcd, 0xce, 0x25, 0x32, 0xbe,
+	0x53, 0x19, 0x1f, 0xaa, 0x55, 0x9f, 0x63, 0x5c, 0x39, 0xdd, 0xed, 0x0d, 0xc2, 0x6b, 0x00, 0xee,
+	0x7d, 0x3c, 0x9d, 0x5b, 0xda, 0xd9, 0xdc, 0xd2, 0xfe, 0xcc, 0x2d, 0xed, 0xeb, 0xc2, 0x6a, 0x9c,
+	0x2d, 0xac, 0xc6, 0xcf, 0x85, 0xd5, 0xf8, 0xe0, 0x6d, 0x70, 0x25, 0x8f, 0xf2, 0x41, 0x1c, 0x46,
+	0xbc, 0x16, 0xe8, 0x68, 0xf8, 0x02, 0x9d, 0xfc, 0x77, 0xa7, 0x06, 0xeb, 0x4b, 0x25, 0x7d, 0xa3,
+	0xb6, 0xfc, 0xbd, 0x9f, 0xff, 0x1b, 0x00, 0x63, 0x87, 0x9e, 0x5c, 0x91, 0x03, 0x00, 0x00,
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UncollectedFees) > 0 {
+		for iNdEx := len(m.UncollectedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UncollectedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPosition(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FeeGrowthInsideLast) > 0 {
+		for iNdEx := len(m.FeeGrowthInsideLast) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeGrowthInsideLast[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPosition(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UpperTick != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPosition(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPosition(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPosition(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPosition(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovPosition(uint64(m.PoolId))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovPosition(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovPosition(uint64(m.UpperTick))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovPosition(uint64(l))
+	if len(m.FeeGrowthInsideLast) > 0 {
+		for _, e := range m.FeeGrowthInsideLast {
+			l = e.Size()
+			n += 1 + l + sovPosition(uint64(l))
+		}
+	}
+	if len(m.UncollectedFees) > 0 {
+		for _, e := range m.UncollectedFees {
+			l = e.Size()
+			n += 1 + l + sovPosition(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPosition(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPosition(x uint64) (n int) {
+	return sovPosition(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPosition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthInsideLast", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGrowthInsideLast = append(m.FeeGrowthInsideLast, types.DecCoin{})
+			if err := m.FeeGrowthInsideLast[len(m.FeeGrowthInsideLast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncollectedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UncollectedFees = append(m.UncollectedFees, types.DecCoin{})
+			if err := m.UncollectedFees[len(m.UncollectedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPosition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPosition(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPosition
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPosition
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPosition
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPosition
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPosition        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPosition          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPosition = fmt.Errorf("proto: unexpected end of group")
+)
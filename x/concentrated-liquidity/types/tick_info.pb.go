@@ -0,0 +1,920 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/concentratedliquidity/v1beta1/tick_info.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TickInfo is the state of an initialized tick, i.e. a tick that is the lower
+// or upper bound of at least one position.
+type TickInfo struct {
+	// liquidity_gross is the total liquidity of the positions referencing this
+	// tick. The tick is removed once it drops to zero.
+	LiquidityGross github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=liquidity_gross,json=liquidityGross,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_gross" yaml:"liquidity_gross"`
+	// liquidity_net is the liquidity added to the current tick liquidity when
+	// the tick is crossed from left to right, and subtracted when it is crossed
+	// from right to left.
+	LiquidityNet github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net" yaml:"liquidity_net"`
+	// fee_growth_outside is the fee growth per unit of liquidity on the other
+	// side of this tick, relative to the current tick. It only has a relative
+	// meaning, as it depends on when the tick was initialized, so individual
+	// amounts may be negative.
+	FeeGrowthOutside github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=fee_growth_outside,json=feeGrowthOutside,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fee_growth_outside" yaml:"fee_growth_outside"`
+}
+
+func (m *TickInfo) Reset()         { *m = TickInfo{} }
+func (m *TickInfo) String() string { return proto.CompactTextString(m) }
+func (*TickInfo) ProtoMessage()    {}
+func (*TickInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_278f8b9ece0f0b4d, []int{0}
+}
+func (m *TickInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickInfo.Merge(m, src)
+}
+func (m *TickInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickInfo proto.InternalMessageInfo
+
+func (m *TickInfo) GetFeeGrowthOutside() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FeeGrowthOutside
+	}
+	return nil
+}
+
+// FullTick is a tick with its pool and index, as stored in genesis.
+type FullTick struct {
+	PoolId    uint64   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TickIndex int64    `protobuf:"varint,2,opt,name=tick_index,json=tickIndex,proto3" json:"tick_index,omitempty" yaml:"tick_index"`
+	Info      TickInfo `protobuf:"bytes,3,opt,name=info,proto3" json:"info" yaml:"info"`
+}
+
+func (m *FullTick) Reset()         { *m = FullTick{} }
+func (m *FullTick) String() string { return proto.CompactTextString(m) }
+func (*FullTick) ProtoMessage()    {}
+func (*FullTick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_278f8b9ece0f0b4d, []int{1}
+}
+func (m *FullTick) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FullTick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FullTick.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FullTick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FullTick.Merge(m, src)
+}
+func (m *FullTick) XXX_Size() int {
+	return m.Size()
+}
+func (m *FullTick) XXX_DiscardUnknown() {
+	xxx_messageInfo_FullTick.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FullTick proto.InternalMessageInfo
+
+func (m *FullTick) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *FullTick) GetTickIndex() int64 {
+	if m != nil {
+		return m.TickIndex
+	}
+	return 0
+}
+
+func (m *FullTick) GetInfo() TickInfo {
+	if m != nil {
+		return m.Info
+	}
+	return TickInfo{}
+}
+
+// FeeAccumulator is the total fee growth per unit of liquidity of a pool,
+// since its creation.
+type FeeAccumulator struct {
+	PoolId          uint64                                      `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	FeeGrowthGlobal github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=fee_growth_global,json=feeGrowthGlobal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fee_growth_global" yaml:"fee_growth_global"`
+}
+
+func (m *FeeAccumulator) Reset()         { *m = FeeAccumulator{} }
+func (m *FeeAccumulator) String() string { return proto.CompactTextString(m) }
+func (*FeeAccumulator) ProtoMessage()    {}
+func (*FeeAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_278f8b9ece0f0b4d, []int{2}
+}
+func (m *FeeAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeAccumulator.Merge(m, src)
+}
+func (m *FeeAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeAccumulator proto.InternalMessageInfo
+
+func (m *FeeAccumulator) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *FeeAccumulator) GetFeeGrowthGlobal() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FeeGrowthGlobal
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TickInfo)(nil), "dymensionxyz.dymension.concentratedliquidity.v1beta1.TickInfo")
+	proto.RegisterType((*FullTick)(nil), "dymensionxyz.dymension.concentratedliquidity.v1beta1.FullTick")
+	proto.RegisterType((*FeeAccumulator)(nil), "dymensionxyz.dymension.concentratedliquidity.v1beta1.FeeAccumulator")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/concentratedliquidity/v1beta1/tick_info.proto", fileDescriptor_278f8b9ece0f0b4d)
+}
+
+var fileDescriptor_278f8b9ece0f0b4d = []byte{
+	// 543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6f, 0xd3, 0x3c,
+	0x18, 0xc7, 0x9b, 0x75, 0xda, 0xbb, 0xb9, 0x2f, 0x1d, 0x0b, 0x03, 0x85, 0x09, 0x25, 0x55, 0x0e,
+	0xa8, 0xd2, 0xd4, 0x58, 0x1d, 0xe3, 0xc2, 0x01, 0x89, 0x30, 0xb5, 0xec, 0xc2, 0x50, 0xc4, 0x09,
+	0x21, 0x55, 0x89, 0xe3, 0x66, 0x56, 0x53, 0xbb, 0xab, 0x9d, 0xd1, 0xf2, 0x0d, 0xb8, 0x71, 0x45,
+	0xe2, 0x13, 0xf0, 0x49, 0x76, 0xdc, 0x09, 0x01, 0x87, 0x80, 0xda, 0x6f, 0xd0, 0x4f, 0x80, 0xec,
+	0xa4, 0x69, 0xd9, 0x38, 0xac, 0x9c, 0xe2, 0xc7, 0x8f, 0xf5, 0xfb, 0x3f, 0x79, 0xfe, 0x8f, 0x0d,
+	0x8e, 0xc2, 0x71, 0x1f, 0x53, 0x4e, 0x18, 0x1d, 0x8d, 0xdf, 0xc3, 0x22, 0x80, 0x88, 0x51, 0x84,
+	0xa9, 0x18, 0xfa, 0x02, 0x87, 0x31, 0x39, 0x4b, 0x48, 0x48, 0xc4, 0x18, 0x9e, 0x37, 0x03, 0x2c,
+	0xfc, 0x26, 0x14, 0x04, 0xf5, 0x3a, 0x84, 0x76, 0x99, 0x33, 0x18, 0x32, 0xc1, 0xf4, 0xc3, 0x65,
+	0x8a, 0x53, 0x04, 0xce, 0x5f, 0x29, 0x4e, 0x4e, 0xd9, 0xdb, 0x8d, 0x58, 0xc4, 0x14, 0x00, 0xca,
+	0x55, 0xc6, 0xda, 0x33, 0x11, 0xe3, 0x7d, 0xc6, 0x61, 0xe0, 0x73, 0x5c, 0x08, 0x22, 0x46, 0x68,
+	0x96, 0xb7, 0x3f, 0x94, 0xc1, 0xe6, 0x6b, 0x82, 0x7a, 0xc7, 0xb4, 0xcb, 0xf4, 0x33, 0xb0, 0x5d,
+	0x70, 0x3b, 0xd1, 0x90, 0x71, 0x6e, 0x68, 0x35, 0xad, 0xbe, 0xe5, 0xbe, 0xb8, 0x48, 0xad, 0xd2,
+	0x8f, 0xd4, 0x7a, 0x18, 0x11, 0x71, 0x9a, 0x04, 0x0e, 0x62, 0x7d, 0x98, 0x83, 0xb3, 0x4f, 0x83,
+	0x87, 0x3d, 0x28, 0xc6, 0x03, 0xcc, 0x9d, 0x23, 0x8c, 0x66, 0xa9, 0x75, 0x6f, 0xec, 0xf7, 0xe3,
+	0x27, 0xf6, 0x15, 0x9c, 0xed, 0x55, 0x8b, 0x9d, 0xb6, 0xdc, 0xd0, 0x7b, 0xe0, 0xd6, 0xe2, 0x0c,
+	0xc5, 0xc2, 0x58, 0x53, 0x82, 0xad, 0x95, 0x05, 0x77, 0xaf, 0x0a, 0x52, 0x2c, 0x6c, 0xef, 0xff,
+	0x22, 0x7e, 0x89, 0x85, 0xfe, 0x59, 0x03, 0x7a, 0x17, 0x63, 0x59, 0xcb, 0x3b, 0x71, 0xda, 0x61,
+	0x89, 0xe0, 0x24, 0xc4, 0x46, 0xb9, 0x56, 0xae, 0x57, 0x0e, 0x1e, 0x38, 0x19, 0xd9, 0x91, 0xad,
+	0x9a, 0x77, 0x55, 0xc2, 0x9f, 0x33, 0x42, 0xdd, 0x57, 0xb2, 0xa0, 0x59, 0x6a, 0xdd, 0xcf, 0x64,
+	0xae, 0x53, 0xec, 0x2f, 0x3f, 0xad, 0xfd, 0x9b, 0x55, 0x2b, 0x81, 0xdc, 0xbb, 0xdd, 0xc5, 0xb8,
+	0xad, 0x10, 0x27, 0x39, 0xe1, 0xab, 0x06, 0x36, 0x5b, 0x49, 0x1c, 0x4b, 0x3f, 0xf4, 0x7d, 0xf0,
+	0xdf, 0x80, 0xb1, 0xb8, 0x43, 0x42, 0xe5, 0xc1, 0xba, 0xab, 0xcf, 0x52, 0xab, 0x9a, 0xa9, 0xe7,
+	0x09, 0xdb, 0xdb, 0x90, 0xab, 0xe3, 0x50, 0x3f, 0x04, 0x20, 0x1f, 0xa2, 0x10, 0x8f, 0x54, 0x0b,
+	0xcb, 0xee, 0xdd, 0x59, 0x6a, 0xed, 0x64, 0xe7, 0x17, 0x39, 0xdb, 0xdb, 0x12, 0xca, 0xed, 0x10,
+	0x8f, 0xf4, 0x08, 0xac, 0xcb, 0xa9, 0x33, 0xca, 0x35, 0xad, 0x5e, 0x39, 0x78, 0xea, 0xfc, 0xcb,
+	0xd8, 0x39, 0xf3, 0xe1, 0x71, 0xef, 0xe4, 0x1d, 0xaa, 0x64, 0x9a, 0x92, 0x6c, 0x7b, 0x4a, 0xc0,
+	0xfe, 0xae, 0x81, 0x6a, 0x0b, 0xe3, 0x67, 0x08, 0x25, 0xfd, 0x24, 0xf6, 0x05, 0x1b, 0xae, 0xf6,
+	0x7b, 0x9f, 0x34, 0xb0, 0xb3, 0xd4, 0xf1, 0x28, 0x66, 0x81, 0x1f, 0x1b, 0x6b, 0x37, 0xb0, 0xed,
+	0x24, 0x2f, 0xca, 0xb8, 0x66, 0x5b, 0x06, 0x59, 0xd9, 0xb5, 0xed, 0xc2, 0xb5, 0xb6, 0x02, 0xb8,
+	0x6f, 0x2f, 0x26, 0xa6, 0x76, 0x39, 0x31, 0xb5, 0x5f, 0x13, 0x53, 0xfb, 0x38, 0x35, 0x4b, 0x97,
+	0x53, 0xb3, 0xf4, 0x6d, 0x6a, 0x96, 0xde, 0xb8, 0x4b, 0x5c, 0xc5, 0x23, 0xbc, 0x11, 0xfb, 0x01,
+	0x9f, 0x07, 0xf0, 0xbc, 0xf9, 0x18, 0x8e, 0xfe, 0x78, 0x1b, 0x1a, 0x8b, 0xc7, 0x41, 0xe9, 0x06,
+	0x1b, 0xea, 0x96, 0x3e, 0xfa, 0x3d, 0x00, 0xcf, 0xc5, 0xb2, 0xc8, 0x59, 0x04, 0x00, 0x00,
+}
+
+func (m *TickInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeGrowthOutside) > 0 {
+		for iNdEx := len(m.FeeGrowthOutside) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeGrowthOutside[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTickInfo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTickInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LiquidityGross.Size()
+		i -= size
+		if _, err := m.LiquidityGross.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTickInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FullTick) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FullTick) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FullTick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTickInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TickIndex != 0 {
+		i = encodeVarintTickInfo(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTickInfo(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeGrowthGlobal) > 0 {
+		for iNdEx := len(m.FeeGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTickInfo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTickInfo(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTickInfo(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTickInfo(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TickInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityGross.Size()
+	n += 1 + l + sovTickInfo(uint64(l))
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovTickInfo(uint64(l))
+	if len(m.FeeGrowthOutside) > 0 {
+		for _, e := range m.FeeGrowthOutside {
+			l = e.Size()
+			n += 1 + l + sovTickInfo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FullTick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTickInfo(uint64(m.PoolId))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovTickInfo(uint64(m.TickIndex))
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovTickInfo(uint64(l))
+	return n
+}
+
+func (m *FeeAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTickInfo(uint64(m.PoolId))
+	}
+	if len(m.FeeGrowthGlobal) > 0 {
+		for _, e := range m.FeeGrowthGlobal {
+			l = e.Size()
+			n += 1 + l + sovTickInfo(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTickInfo(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTickInfo(x uint64) (n int) {
+	return sovTickInfo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TickInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTickInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityGross", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityGross.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutside", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGrowthOutside = append(m.FeeGrowthOutside, types.DecCoin{})
+			if err := m.FeeGrowthOutside[len(m.FeeGrowthOutside)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTickInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FullTick) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTickInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FullTick: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FullTick: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTickInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTickInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGrowthGlobal = append(m.FeeGrowthGlobal, types.DecCoin{})
+			if err := m.FeeGrowthGlobal[len(m.FeeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTickInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTickInfo(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTickInfo
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTickInfo
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTickInfo
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTickInfo
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTickInfo        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTickInfo          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTickInfo = fmt.Errorf("proto: unexpected end of group")
+)
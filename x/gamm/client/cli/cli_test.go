@@ -3,6 +3,7 @@ package cli_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v15/testutils/apptesting"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/client/cli"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 
@@ -147,6 +149,41 @@ func TestNewCreatePoolCmd(t *testing.T) {
 			),
 			true,
 		},
+		"dynamic swap fee params": {
+			fmt.Sprintf(`
+					{
+						"%s": "1node0token,3stake",
+						"%s": "100node0token,100stake",
+						"%s": "0.001",
+						"%s": "0.001",
+						"%s": {
+							"%s": "0.002",
+							"%s": "0.02",
+							"%s": "0.5"
+						}
+					}
+					`, cli.PoolFileWeights, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee,
+				cli.PoolFileDynamicSwapFeeParams, cli.PoolFileBaseFee, cli.PoolFileMaxFee, cli.PoolFileSensitivity,
+			),
+			false,
+		},
+		"dynamic swap fee params missing sensitivity": {
+			fmt.Sprintf(`
+					{
+						"%s": "1node0token,3stake",
+						"%s": "100node0token,100stake",
+						"%s": "0.001",
+						"%s": "0.001",
+						"%s": {
+							"%s": "0.002",
+							"%s": "0.02"
+						}
+					}
+					`, cli.PoolFileWeights, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee,
+				cli.PoolFileDynamicSwapFeeParams, cli.PoolFileBaseFee, cli.PoolFileMaxFee,
+			),
+			true,
+		},
 		"unknown fields in json": {
 			fmt.Sprintf(`
 				{
@@ -177,6 +214,252 @@ func TestNewCreatePoolCmd(t *testing.T) {
 	}
 }
 
+func TestNewCreateStableswapPoolCmd(t *testing.T) {
+	testCases := map[string]struct {
+		json      string
+		expectErr bool
+	}{
+		"two tokens pair pool": {
+			fmt.Sprintf(`
+			{
+			  "%s": "100node0token,100stake",
+			  "%s": "0.001",
+			  "%s": "0.001",
+			  "%s": "100"
+			}
+			`, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee, cli.PoolFileAmplification),
+			false,
+		},
+		"scaling factors and controller": {
+			fmt.Sprintf(`
+			{
+			  "%s": "100node0token,100000stake",
+			  "%s": "0.001",
+			  "%s": "0.001",
+			  "%s": "1,1000",
+			  "%s": "%s",
+			  "%s": "100"
+			}
+			`, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee, cli.PoolFileScalingFactors,
+				cli.PoolFileScalingFactorController, testAddresses[1].String(), cli.PoolFileAmplification),
+			false,
+		},
+		"missing amplification": {
+			fmt.Sprintf(`
+			{
+			  "%s": "100node0token,100stake",
+			  "%s": "0.001",
+			  "%s": "0.001"
+			}
+			`, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee),
+			true,
+		},
+		"balancer weights in stableswap json": {
+			fmt.Sprintf(`
+			{
+			  "%s": "1node0token,3stake",
+			  "%s": "100node0token,100stake",
+			  "%s": "0.001",
+			  "%s": "0.001",
+			  "%s": "100"
+			}
+			`, cli.PoolFileWeights, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee, cli.PoolFileAmplification),
+			true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			desc := cli.NewCreatePoolCmd()
+			jsonFile := testutil.WriteToNewTempFile(tt, tc.json)
+			Cmd := fmt.Sprintf("--pool-file=%s --pool-type=stableswap --from=%s", jsonFile.Name(), testAddresses[0].String())
+
+			txTc := osmocli.TxCliTestCase[*stableswap.MsgCreateStableswapPool]{
+				Cmd:                    Cmd,
+				ExpectedErr:            tc.expectErr,
+				OnlyCheckValidateBasic: true,
+			}
+			osmocli.RunTxTestCase(tt, desc, txTc)
+		})
+	}
+}
+
+func TestNewCreateLiquidityBootstrappingPoolCmd(t *testing.T) {
+	testCases := map[string]struct {
+		json      string
+		expectErr bool
+	}{
+		"equalize at the end of the sale": {
+			fmt.Sprintf(`
+			{
+			  "%s": "9node0token,1stake",
+			  "%s": "100node0token,100stake",
+			  "%s": "0.001",
+			  "%s": "0.001",
+			  "%s": {
+			    "%s": "72h",
+			    "%s": "1node0token,1stake"
+			  },
+			  "%s": "equalize"
+			}
+			`, cli.PoolFileWeights, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee,
+				cli.PoolFileSmoothWeightChangeParams, cli.PoolFileDuration, cli.PoolFileTargetPoolWeights, cli.PoolFileLbpEndBehavior),
+			false,
+		},
+		"end behavior defaults to freeze": {
+			fmt.Sprintf(`
+			{
+			  "%s": "9node0token,1stake",
+			  "%s": "100node0token,100stake",
+			  "%s": "0.001",
+			  "%s": "0.001",
+			  "%s": {
+			    "%s": "72h",
+			    "%s": "1node0token,1stake"
+			  }
+			}
+			`, cli.PoolFileWeights, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee,
+				cli.PoolFileSmoothWeightChangeParams, cli.PoolFileDuration, cli.PoolFileTargetPoolWeights),
+			false,
+		},
+		"missing lbp params": {
+			fmt.Sprintf(`
+			{
+			  "%s": "9node0token,1stake",
+			  "%s": "100node0token,100stake",
+			  "%s": "0.001",
+			  "%s": "0.001"
+			}
+			`, cli.PoolFileWeights, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee),
+			true,
+		},
+		"unknown end behavior": {
+			fmt.Sprintf(`
+			{
+			  "%s": "9node0token,1stake",
+			  "%s": "100node0token,100stake",
+			  "%s": "0.001",
+			  "%s": "0.001",
+			  "%s": {
+			    "%s": "72h",
+			    "%s": "1node0token,1stake"
+			  },
+			  "%s": "burn"
+			}
+			`, cli.PoolFileWeights, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee,
+				cli.PoolFileSmoothWeightChangeParams, cli.PoolFileDuration, cli.PoolFileTargetPoolWeights, cli.PoolFileLbpEndBehavior),
+			true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			desc := cli.NewCreatePoolCmd()
+			jsonFile := testutil.WriteToNewTempFile(tt, tc.json)
+			Cmd := fmt.Sprintf("--pool-file=%s --pool-type=lbp --from=%s", jsonFile.Name(), testAddresses[0].String())
+
+			txTc := osmocli.TxCliTestCase[*balancer.MsgCreateLiquidityBootstrappingPool]{
+				Cmd:                    Cmd,
+				ExpectedErr:            tc.expectErr,
+				OnlyCheckValidateBasic: true,
+			}
+			osmocli.RunTxTestCase(tt, desc, txTc)
+		})
+	}
+}
+
+func TestNewStableSwapAdjustScalingFactorsCmd(t *testing.T) {
+	desc := cli.NewStableSwapAdjustScalingFactorsCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*stableswap.MsgStableSwapAdjustScalingFactors]{
+		"adjust scaling factors": {
+			Cmd: "--pool-id=1 --scaling-factors=1,1000 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &stableswap.MsgStableSwapAdjustScalingFactors{
+				Sender:         testAddresses[0].String(),
+				PoolID:         1,
+				ScalingFactors: []uint64{1, 1000},
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewScheduleWeightChangeCmd(t *testing.T) {
+	desc := cli.NewScheduleWeightChangeCmd()
+	targetPoolWeights := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("node0token", 0)},
+		{Weight: sdk.NewInt(3), Token: sdk.NewInt64Coin("stake", 0)},
+	}
+	tcs := map[string]osmocli.TxCliTestCase[*balancer.MsgScheduleWeightChange]{
+		"schedule weight change": {
+			Cmd: "1node0token,3stake 48h --pool-id=1 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &balancer.MsgScheduleWeightChange{
+				Sender: testAddresses[0].String(),
+				PoolID: 1,
+				SmoothWeightChangeParams: balancer.SmoothWeightChangeParams{
+					Duration:          48 * time.Hour,
+					TargetPoolWeights: targetPoolWeights,
+				},
+			},
+		},
+		"schedule weight change with start time": {
+			Cmd: "1node0token,3stake 48h --pool-id=1 --start-time=2024-01-01T00:00:00Z --from=" + testAddresses[0].String(),
+			ExpectedMsg: &balancer.MsgScheduleWeightChange{
+				Sender: testAddresses[0].String(),
+				PoolID: 1,
+				SmoothWeightChangeParams: balancer.SmoothWeightChangeParams{
+					StartTime:         time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					Duration:          48 * time.Hour,
+					TargetPoolWeights: targetPoolWeights,
+				},
+			},
+		},
+		"invalid duration": {
+			Cmd:         "1node0token,3stake 2days --pool-id=1 --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewSetPoolFeesCmd(t *testing.T) {
+	desc, _ := cli.NewSetPoolFeesCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*balancer.MsgSetPoolFees]{
+		"set pool fees": {
+			Cmd: "0.003 0.001 --pool-id=1 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &balancer.MsgSetPoolFees{
+				Sender:  testAddresses[0].String(),
+				PoolID:  1,
+				SwapFee: sdk.MustNewDecFromStr("0.003"),
+				ExitFee: sdk.MustNewDecFromStr("0.001"),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewSetSwapsPausedCmd(t *testing.T) {
+	desc, _ := cli.NewSetSwapsPausedCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*balancer.MsgSetSwapsPaused]{
+		"pause swaps": {
+			Cmd: "true --pool-id=1 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &balancer.MsgSetSwapsPaused{
+				Sender: testAddresses[0].String(),
+				PoolID: 1,
+				Paused: true,
+			},
+		},
+		"unpause swaps": {
+			Cmd: "false --pool-id=1 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &balancer.MsgSetSwapsPaused{
+				Sender: testAddresses[0].String(),
+				PoolID: 1,
+				Paused: false,
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestNewJoinPoolCmd(t *testing.T) {
 	desc, _ := cli.NewJoinPoolCmd()
 	tcs := map[string]osmocli.TxCliTestCase[*types.MsgJoinPool]{
@@ -189,6 +472,25 @@ func TestNewJoinPoolCmd(t *testing.T) {
 				TokenInMaxs:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
 			},
 		},
+		"join pool with spot price bounds": {
+			Cmd: "--pool-id=1 --max-amounts-in=100stake --share-amount-out=100 --spot-price-base-denom=stake --spot-price-quote-denom=adym --min-spot-price=0.5 --max-spot-price=2 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgJoinPool{
+				Sender:         testAddresses[0].String(),
+				PoolId:         1,
+				ShareOutAmount: sdk.NewIntFromUint64(100),
+				TokenInMaxs:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+				SpotPriceBounds: &types.SpotPriceBounds{
+					BaseAssetDenom:  "stake",
+					QuoteAssetDenom: "adym",
+					MinSpotPrice:    sdk.MustNewDecFromStr("0.5"),
+					MaxSpotPrice:    sdk.NewDec(2),
+				},
+			},
+		},
+		"spot price bounds without denoms": {
+			Cmd:         "--pool-id=1 --max-amounts-in=100stake --share-amount-out=100 --min-spot-price=0.5 --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
 	}
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
@@ -205,6 +507,25 @@ func TestNewExitPoolCmd(t *testing.T) {
 				TokenOutMins:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
 			},
 		},
+		"exit pool with max spot price": {
+			Cmd: "--min-amounts-out=100stake --pool-id=1 --share-amount-in=10 --spot-price-base-denom=stake --spot-price-quote-denom=adym --max-spot-price=2 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgExitPool{
+				Sender:        testAddresses[0].String(),
+				PoolId:        1,
+				ShareInAmount: sdk.NewIntFromUint64(10),
+				TokenOutMins:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+				SpotPriceBounds: &types.SpotPriceBounds{
+					BaseAssetDenom:  "stake",
+					QuoteAssetDenom: "adym",
+					MinSpotPrice:    sdk.ZeroDec(),
+					MaxSpotPrice:    sdk.NewDec(2),
+				},
+			},
+		},
+		"spot price denoms without bounds": {
+			Cmd:         "--min-amounts-out=100stake --pool-id=1 --share-amount-in=10 --spot-price-base-denom=stake --spot-price-quote-denom=adym --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
 	}
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
@@ -253,6 +574,21 @@ func TestNewJoinSwapExternAmountInCmd(t *testing.T) {
 				ShareOutMinAmount: sdk.NewIntFromUint64(1),
 			},
 		},
+		"swap exact amount in with min spot price": {
+			Cmd: "10stake 1 --pool-id=1 --spot-price-base-denom=stake --spot-price-quote-denom=adym --min-spot-price=0.5 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgJoinSwapExternAmountIn{
+				Sender:            testAddresses[0].String(),
+				PoolId:            1,
+				TokenIn:           sdk.NewInt64Coin("stake", 10),
+				ShareOutMinAmount: sdk.NewIntFromUint64(1),
+				SpotPriceBounds: &types.SpotPriceBounds{
+					BaseAssetDenom:  "stake",
+					QuoteAssetDenom: "adym",
+					MinSpotPrice:    sdk.MustNewDecFromStr("0.5"),
+					MaxSpotPrice:    sdk.ZeroDec(),
+				},
+			},
+		},
 	}
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
@@ -303,6 +639,84 @@ func TestNewExitSwapShareAmountInCmd(t *testing.T) {
 				TokenOutMinAmount: sdk.NewIntFromUint64(1),
 			},
 		},
+		"swap exact amount in with spot price bounds": {
+			Cmd: "stake 10 1 --pool-id=1 --spot-price-base-denom=stake --spot-price-quote-denom=adym --min-spot-price=0.5 --max-spot-price=2 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgExitSwapShareAmountIn{
+				Sender:            testAddresses[0].String(),
+				PoolId:            1,
+				TokenOutDenom:     "stake",
+				ShareInAmount:     sdk.NewIntFromUint64(10),
+				TokenOutMinAmount: sdk.NewIntFromUint64(1),
+				SpotPriceBounds: &types.SpotPriceBounds{
+					BaseAssetDenom:  "stake",
+					QuoteAssetDenom: "adym",
+					MinSpotPrice:    sdk.MustNewDecFromStr("0.5"),
+					MaxSpotPrice:    sdk.NewDec(2),
+				},
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewZapInCmd(t *testing.T) {
+	desc, _ := cli.NewZapInCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgZapIn]{
+		"zap in": {
+			Cmd: "10stake 1 --pool-id=2 --swap-route-pool-ids=1 --swap-route-denoms=node0token --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgZapIn{
+				Sender:            testAddresses[0].String(),
+				PoolId:            2,
+				TokenIn:           sdk.NewInt64Coin("stake", 10),
+				Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "node0token"}},
+				ShareOutMinAmount: sdk.NewIntFromUint64(1),
+			},
+		},
+		"zap in and lock": {
+			Cmd: "10stake 1 --pool-id=2 --swap-route-pool-ids=1 --swap-route-denoms=node0token --lock-duration=336h --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgZapIn{
+				Sender:            testAddresses[0].String(),
+				PoolId:            2,
+				TokenIn:           sdk.NewInt64Coin("stake", 10),
+				Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "node0token"}},
+				ShareOutMinAmount: sdk.NewIntFromUint64(1),
+				LockDuration:      336 * time.Hour,
+			},
+		},
+		"invalid lock duration": {
+			Cmd:         "10stake 1 --pool-id=2 --swap-route-pool-ids=1 --swap-route-denoms=node0token --lock-duration=2weeks --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewMigrateSharesCmd(t *testing.T) {
+	desc, _ := cli.NewMigrateSharesCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgMigrateShares]{
+		"migrate shares": {
+			Cmd: "1000gamm/pool/1 10 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgMigrateShares{
+				Sender:            testAddresses[0].String(),
+				SharesToMigrate:   sdk.NewInt64Coin("gamm/pool/1", 1000),
+				ShareOutMinAmount: sdk.NewIntFromUint64(10),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewMigrateLockedSharesCmd(t *testing.T) {
+	desc, _ := cli.NewMigrateLockedSharesCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgMigrateLockedShares]{
+		"migrate locked shares": {
+			Cmd: "5 10 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgMigrateLockedShares{
+				Sender:            testAddresses[0].String(),
+				LockId:            5,
+				ShareOutMinAmount: sdk.NewIntFromUint64(10),
+			},
+		},
 	}
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
@@ -331,6 +745,106 @@ func TestGetCmdPool(t *testing.T) {
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
 
+func TestGetCmdStableSwapAmplification(t *testing.T) {
+	desc, _ := cli.GetCmdStableSwapAmplification()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryStableSwapAmplificationRequest]{
+		"basic test": {
+			Cmd:           "1",
+			ExpectedQuery: &types.QueryStableSwapAmplificationRequest{PoolId: 1},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdLiquidityBootstrappingPool(t *testing.T) {
+	desc, _ := cli.GetCmdLiquidityBootstrappingPool()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryLiquidityBootstrappingPoolRequest]{
+		"basic test": {
+			Cmd:           "1",
+			ExpectedQuery: &types.QueryLiquidityBootstrappingPoolRequest{PoolId: 1},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdLiquidityBootstrappingPriceCurve(t *testing.T) {
+	desc, _ := cli.GetCmdLiquidityBootstrappingPriceCurve()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryLiquidityBootstrappingPriceCurveRequest]{
+		"basic test": {
+			Cmd: "1 node0token adym 10",
+			ExpectedQuery: &types.QueryLiquidityBootstrappingPriceCurveRequest{
+				PoolId:          1,
+				BaseAssetDenom:  "node0token",
+				QuoteAssetDenom: "adym",
+				NumPoints:       10,
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdCanonicalPool(t *testing.T) {
+	desc, _ := cli.GetCmdCanonicalPool()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryCanonicalPoolRequest]{
+		"basic test": {
+			Cmd:           "node0token adym",
+			ExpectedQuery: &types.QueryCanonicalPoolRequest{DenomA: "node0token", DenomB: "adym"},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdPoolsByDenom(t *testing.T) {
+	desc, _ := cli.GetCmdPoolsByDenom()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryPoolsByDenomRequest]{
+		"basic test": {
+			Cmd: "adym --limit=10",
+			ExpectedQuery: &types.QueryPoolsByDenomRequest{
+				Denom:      "adym",
+				Pagination: &query.PageRequest{Key: []uint8{}, Limit: 10},
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdPoolsByPair(t *testing.T) {
+	desc, _ := cli.GetCmdPoolsByPair()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryPoolsByPairRequest]{
+		"basic test": {
+			Cmd: "node0token adym",
+			ExpectedQuery: &types.QueryPoolsByPairRequest{
+				DenomA:     "node0token",
+				DenomB:     "adym",
+				Pagination: &query.PageRequest{Key: []uint8{}, Limit: 100},
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdPoolStatsHistory(t *testing.T) {
+	desc, _ := cli.GetCmdPoolStatsHistory()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryPoolStatsHistoryRequest]{
+		"basic test": {
+			Cmd:           "1 7",
+			ExpectedQuery: &types.QueryPoolStatsHistoryRequest{PoolId: 1, NumEpochs: 7},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdPoolAPR(t *testing.T) {
+	desc, _ := cli.GetCmdPoolAPR()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryPoolAPRRequest]{
+		"basic test": {
+			Cmd:           "1",
+			ExpectedQuery: &types.QueryPoolAPRRequest{PoolId: 1},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
 func TestGetCmdSpotPrice(t *testing.T) {
 	desc, _ := cli.GetCmdSpotPrice()
 	tcs := map[string]osmocli.QueryCliTestCase[*types.QuerySpotPriceRequest]{
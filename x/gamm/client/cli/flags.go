@@ -16,10 +16,20 @@ const (
 	PoolFileExitFee        = "exit-fee"
 	PoolFileFutureGovernor = "future-governor"
 
+	PoolFileScalingFactors          = "scaling-factors"
+	PoolFileScalingFactorController = "scaling-factor-controller"
+	PoolFileAmplification           = "amplification"
+
 	PoolFileSmoothWeightChangeParams = "lbp-params"
 	PoolFileStartTime                = "start-time"
 	PoolFileDuration                 = "duration"
 	PoolFileTargetPoolWeights        = "target-pool-weights"
+	PoolFileLbpEndBehavior           = "lbp-end-behavior"
+
+	PoolFileDynamicSwapFeeParams = "dynamic-swap-fee"
+	PoolFileBaseFee              = "base-fee"
+	PoolFileMaxFee               = "max-fee"
+	PoolFileSensitivity          = "sensitivity"
 
 	FlagPoolId = "pool-id"
 	// Will be parsed to sdk.Int.
@@ -40,6 +50,17 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// FlagScalingFactors represents the flag name for the scaling factors.
 	FlagScalingFactors = "scaling-factors"
+	// FlagStartTime represents the flag name for the start time of a weight change.
+	FlagStartTime = "start-time"
+
+	// Will be parsed to string.
+	FlagSpotPriceBaseDenom  = "spot-price-base-denom"
+	FlagSpotPriceQuoteDenom = "spot-price-quote-denom"
+	// Will be parsed to sdk.Dec.
+	FlagMinSpotPrice = "min-spot-price"
+	FlagMaxSpotPrice = "max-spot-price"
+	// Will be parsed to time.Duration.
+	FlagLockDuration = "lock-duration"
 )
 
 type createBalancerPoolInputs struct {
@@ -49,6 +70,18 @@ type createBalancerPoolInputs struct {
 	ExitFee                  string                         `json:"exit-fee"`
 	FutureGovernor           string                         `json:"future-governor"`
 	SmoothWeightChangeParams smoothWeightChangeParamsInputs `json:"lbp-params"`
+	DynamicSwapFeeParams     dynamicSwapFeeParamsInputs     `json:"dynamic-swap-fee"`
+	LbpEndBehavior           string                         `json:"lbp-end-behavior"`
+}
+
+type createStableswapPoolInputs struct {
+	InitialDeposit          string `json:"initial-deposit"`
+	SwapFee                 string `json:"swap-fee"`
+	ExitFee                 string `json:"exit-fee"`
+	FutureGovernor          string `json:"future-governor"`
+	ScalingFactors          string `json:"scaling-factors"`
+	ScalingFactorController string `json:"scaling-factor-controller"`
+	Amplification           string `json:"amplification"`
 }
 
 type smoothWeightChangeParamsInputs struct {
@@ -57,6 +90,12 @@ type smoothWeightChangeParamsInputs struct {
 	TargetPoolWeights string `json:"target-pool-weights"`
 }
 
+type dynamicSwapFeeParamsInputs struct {
+	BaseFee     string `json:"base-fee"`
+	MaxFee      string `json:"max-fee"`
+	Sensitivity string `json:"sensitivity"`
+}
+
 func FlagSetMultihopSwapRoutes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.String(FlagSwapRoutePoolIds, "", "swap route pool id")
@@ -72,7 +111,7 @@ func FlagSetCreatePoolFile() *flag.FlagSet {
 
 func FlagSetCreatePoolType() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
-	fs.String(FlagPoolType, "uniswap", "Pool type (either \"balancer\", \"uniswap\", or \"stableswap\"")
+	fs.String(FlagPoolType, "uniswap", "Pool type (either \"balancer\", \"uniswap\", \"stableswap\", or \"lbp\"")
 	return fs
 }
 
@@ -105,3 +144,24 @@ func FlagSetAdjustScalingFactors() *flag.FlagSet {
 	fs.String(FlagScalingFactors, "", "The scaling factors")
 	return fs
 }
+
+func FlagSetScheduleWeightChange() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagStartTime, "", "The start time of the weight change, in RFC3339 format (defaults to the block time)")
+	return fs
+}
+
+func FlagSetSpotPriceBounds() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagSpotPriceBaseDenom, "", "The base asset denom of the spot price bounds")
+	fs.String(FlagSpotPriceQuoteDenom, "", "The quote asset denom of the spot price bounds")
+	fs.String(FlagMinSpotPrice, "", "Minimum spot price of the pool, in quote asset per base asset, to execute the message at")
+	fs.String(FlagMaxSpotPrice, "", "Maximum spot price of the pool, in quote asset per base asset, to execute the message at")
+	return fs
+}
+
+func FlagSetLockDuration() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagLockDuration, "", "The duration to lock the pool shares for, e.g. 336h (the shares are not locked if unset)")
+	return fs
+}
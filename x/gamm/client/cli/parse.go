@@ -52,3 +52,39 @@ func parseCreateBalancerPoolFlags(fs *pflag.FlagSet) (*createBalancerPoolInputs,
 
 	return pool, nil
 }
+
+// UnmarshalJSON should error if there are fields unexpected.
+func (release *createStableswapPoolInputs) UnmarshalJSON(data []byte) error {
+	type inputs createStableswapPoolInputs
+	var createPool inputs
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields() // Force
+
+	if err := dec.Decode(&createPool); err != nil {
+		return err
+	}
+
+	*release = createStableswapPoolInputs(createPool)
+	return nil
+}
+
+func parseCreateStableswapPoolFlags(fs *pflag.FlagSet) (*createStableswapPoolInputs, error) {
+	pool := &createStableswapPoolInputs{}
+	poolFile, _ := fs.GetString(FlagPoolFile)
+
+	if poolFile == "" {
+		return nil, fmt.Errorf("must pass in a pool json using the --%s flag", FlagPoolFile)
+	}
+
+	contents, err := os.ReadFile(poolFile) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+
+	err = pool.UnmarshalJSON(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
@@ -25,6 +25,15 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdSpotPrice)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPool)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdStableSwapAmplification)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdLiquidityBootstrappingPool)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdLiquidityBootstrappingPriceCurve)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdCanonicalPool)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPoolsByDenom)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPoolsByPair)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPoolStats)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPoolStatsHistory)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPoolAPR)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPools)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
@@ -64,6 +73,78 @@ func GetCmdPool() (*osmocli.QueryDescriptor, *types.QueryPoolRequest) {
 {{.CommandPrefix}} pool 1`}, &types.QueryPoolRequest{}
 }
 
+func GetCmdStableSwapAmplification() (*osmocli.QueryDescriptor, *types.QueryStableSwapAmplificationRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "stableswap-amplification [poolID]",
+		Short: "Query the effective amplification of a stableswap pool, and its amplification ramp if any",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} stableswap-amplification 1`}, &types.QueryStableSwapAmplificationRequest{}
+}
+
+func GetCmdLiquidityBootstrappingPool() (*osmocli.QueryDescriptor, *types.QueryLiquidityBootstrappingPoolRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "liquidity-bootstrapping-pool [poolID]",
+		Short: "Query the sale of a liquidity bootstrapping pool, and its current effective weights",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} liquidity-bootstrapping-pool 1`}, &types.QueryLiquidityBootstrappingPoolRequest{}
+}
+
+func GetCmdLiquidityBootstrappingPriceCurve() (*osmocli.QueryDescriptor, *types.QueryLiquidityBootstrappingPriceCurveRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "liquidity-bootstrapping-price-curve [poolID] [base-asset-denom] [quote-asset-denom] [num-points]",
+		Short: "Query the spot prices a liquidity bootstrapping pool is projected to have over the rest of its sale, assuming its balances do not change",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} liquidity-bootstrapping-price-curve 1 urollapp adym 10`}, &types.QueryLiquidityBootstrappingPriceCurveRequest{}
+}
+
+func GetCmdCanonicalPool() (*osmocli.QueryDescriptor, *types.QueryCanonicalPoolRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "canonical-pool [denom-a] [denom-b]",
+		Short: "Query the canonical pool of a denom pair, which routing queries use by default",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} canonical-pool urollapp adym`}, &types.QueryCanonicalPoolRequest{}
+}
+
+func GetCmdPoolsByDenom() (*osmocli.QueryDescriptor, *types.QueryPoolsByDenomRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pools-by-denom [denom]",
+		Short: "Query the pools holding a denom, with their liquidity and the spot prices of the denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pools-by-denom urollapp`}, &types.QueryPoolsByDenomRequest{}
+}
+
+func GetCmdPoolsByPair() (*osmocli.QueryDescriptor, *types.QueryPoolsByPairRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pools-by-pair [denom-a] [denom-b]",
+		Short: "Query the pools holding a denom pair, with their liquidity and the spot price of denom-a in denom-b",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pools-by-pair urollapp adym`}, &types.QueryPoolsByPairRequest{}
+}
+
+func GetCmdPoolStats() (*osmocli.QueryDescriptor, *types.QueryPoolStatsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-stats [poolID]",
+		Short: "Query the swap volume and fee revenue of a pool since it was created, and over the current epoch",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-stats 1`}, &types.QueryPoolStatsRequest{}
+}
+
+func GetCmdPoolStatsHistory() (*osmocli.QueryDescriptor, *types.QueryPoolStatsHistoryRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-stats-history [poolID] [num-epochs]",
+		Short: "Query the swap volume and fee revenue of a pool over the last finished epochs",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-stats-history 1 7`}, &types.QueryPoolStatsHistoryRequest{}
+}
+
+func GetCmdPoolAPR() (*osmocli.QueryDescriptor, *types.QueryPoolAPRRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-apr [poolID]",
+		Short: "Query the APR of the liquidity of a pool, from its swap fee revenue and the rewards of its gauges",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-apr 1`}, &types.QueryPoolAPRRequest{}
+}
+
 // TODO: Push this to the SDK.
 func writeOutputBoilerplate(ctx client.Context, out []byte) error {
 	writer := ctx.Output
@@ -10,13 +10,16 @@ import (
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 	"github.com/osmosis-labs/osmosis/v15/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
 func NewTxCmd() *cobra.Command {
@@ -29,8 +32,15 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewJoinSwapShareAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapExternAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapShareAmountIn)
+	osmocli.AddTxCmd(txCmd, NewSetPoolFeesCmd)
+	osmocli.AddTxCmd(txCmd, NewSetSwapsPausedCmd)
+	osmocli.AddTxCmd(txCmd, NewMigrateSharesCmd)
+	osmocli.AddTxCmd(txCmd, NewMigrateLockedSharesCmd)
+	osmocli.AddTxCmd(txCmd, NewZapInCmd)
 	txCmd.AddCommand(
 		NewCreatePoolCmd().BuildCommandCustomFn(),
+		NewStableSwapAdjustScalingFactorsCmd().BuildCommandCustomFn(),
+		NewScheduleWeightChangeCmd().BuildCommandCustomFn(),
 	)
 	return txCmd
 }
@@ -52,6 +62,31 @@ Sample pool JSON file contents for balancer:
 	"exit-fee": "0.01",
 	"future-governor": "168h"
 }
+
+For stableswap (demonstrating need for a 1:1000 scaling factor, see doc)
+{
+	"initial-deposit": "1000miliusdc,1000000uusdc",
+	"swap-fee": "0.001",
+	"exit-fee": "0.0",
+	"future-governor": "168h",
+	"scaling-factors": "1,1000",
+	"amplification": "100"
+}
+
+For lbp (a liquidity bootstrapping pool, only joinable by its creator during
+its sale, whose weights are frozen or equalized once its sale ends)
+{
+	"weights": "90urollapp,10adym",
+	"initial-deposit": "1000000urollapp,100000adym",
+	"swap-fee": "0.01",
+	"exit-fee": "0.0",
+	"lbp-params": {
+		"start-time": "2024-01-01T00:00:00Z",
+		"duration": "72h",
+		"target-pool-weights": "50urollapp,50adym"
+	},
+	"lbp-end-behavior": "equalize"
+}
 `,
 		NumArgs:          0,
 		ParseAndBuildMsg: BuildCreatePoolCmd,
@@ -71,10 +106,14 @@ func NewJoinPoolCmd() (*osmocli.TxCliDesc, *types.MsgJoinPool) {
 			"poolid": FlagPoolId,
 		},
 		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
-			"TokenInMaxs":    osmocli.FlagOnlyParser(maxAmountsInParser),
-			"ShareOutAmount": osmocli.FlagOnlyParser(shareAmountOutParser),
+			"TokenInMaxs":     osmocli.FlagOnlyParser(maxAmountsInParser),
+			"ShareOutAmount":  osmocli.FlagOnlyParser(shareAmountOutParser),
+			"SpotPriceBounds": osmocli.FlagOnlyParser(spotPriceBoundsParser),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetJoinPool()},
+			OptionalFlags: []*flag.FlagSet{FlagSetSpotPriceBounds()},
 		},
-		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJoinPool()}},
 	}, &types.MsgJoinPool{}
 }
 
@@ -86,10 +125,14 @@ func NewExitPoolCmd() (*osmocli.TxCliDesc, *types.MsgExitPool) {
 			"poolid": FlagPoolId,
 		},
 		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
-			"TokenOutMins":  osmocli.FlagOnlyParser(minAmountsOutParser),
-			"ShareInAmount": osmocli.FlagOnlyParser(shareAmountInParser),
+			"TokenOutMins":    osmocli.FlagOnlyParser(minAmountsOutParser),
+			"ShareInAmount":   osmocli.FlagOnlyParser(shareAmountInParser),
+			"SpotPriceBounds": osmocli.FlagOnlyParser(spotPriceBoundsParser),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetExitPool()},
+			OptionalFlags: []*flag.FlagSet{FlagSetSpotPriceBounds()},
 		},
-		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetExitPool()}},
 	}, &types.MsgExitPool{}
 }
 
@@ -120,7 +163,13 @@ func NewJoinSwapExternAmountIn() (*osmocli.TxCliDesc, *types.MsgJoinSwapExternAm
 		Use:                 "join-swap-extern-amount-in [token-in] [share-out-min-amount]",
 		Short:               "join swap extern amount in",
 		CustomFlagOverrides: poolIdFlagOverride,
-		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"SpotPriceBounds": osmocli.FlagOnlyParser(spotPriceBoundsParser),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()},
+			OptionalFlags: []*flag.FlagSet{FlagSetSpotPriceBounds()},
+		},
 	}, &types.MsgJoinSwapExternAmountIn{}
 }
 
@@ -147,10 +196,52 @@ func NewExitSwapShareAmountIn() (*osmocli.TxCliDesc, *types.MsgExitSwapShareAmou
 		Use:                 "exit-swap-share-amount-in [token-out-denom] [share-in-amount] [token-out-min-amount]",
 		Short:               "exit swap share amount in",
 		CustomFlagOverrides: poolIdFlagOverride,
-		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"SpotPriceBounds": osmocli.FlagOnlyParser(spotPriceBoundsParser),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()},
+			OptionalFlags: []*flag.FlagSet{FlagSetSpotPriceBounds()},
+		},
 	}, &types.MsgExitSwapShareAmountIn{}
 }
 
+func NewMigrateSharesCmd() (*osmocli.TxCliDesc, *types.MsgMigrateShares) {
+	return &osmocli.TxCliDesc{
+		Use:     "migrate-shares [shares-to-migrate] [share-out-min-amount]",
+		Short:   "migrate pool shares to the pool they are linked to by the migration records",
+		Example: fmt.Sprintf("%s tx gamm migrate-shares 1000000gamm/pool/1 1", version.AppName),
+	}, &types.MsgMigrateShares{}
+}
+
+func NewMigrateLockedSharesCmd() (*osmocli.TxCliDesc, *types.MsgMigrateLockedShares) {
+	return &osmocli.TxCliDesc{
+		Use:     "migrate-locked-shares [lock-id] [share-out-min-amount]",
+		Short:   "migrate the pool shares of a lock in place to the pool they are linked to by the migration records",
+		Example: fmt.Sprintf("%s tx gamm migrate-locked-shares 5 1", version.AppName),
+	}, &types.MsgMigrateLockedShares{}
+}
+
+func NewZapInCmd() (*osmocli.TxCliDesc, *types.MsgZapIn) {
+	return &osmocli.TxCliDesc{
+		Use:   "zap-in [token-in] [share-out-min-amount]",
+		Short: "join a pool with any token, swapping it to an asset of the pool through the swap routes",
+		Long: `Swap the token in through the swap routes, which must end with an asset of the pool, and join the pool
+with the proceeds for at least the min amount of shares, in a single step. If --lock-duration is set, the shares
+are locked for it.`,
+		Example:             fmt.Sprintf("%s tx gamm zap-in 1000000uatom 1 --pool-id=2 --swap-route-pool-ids=1 --swap-route-denoms=adym --lock-duration=336h", version.AppName),
+		CustomFlagOverrides: poolIdFlagOverride,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes":       osmocli.FlagOnlyParser(swapAmountInRoutes),
+			"LockDuration": osmocli.FlagOnlyParser(lockDurationParser),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId(), FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetLockDuration()},
+		},
+	}, &types.MsgZapIn{}
+}
+
 func BuildCreatePoolCmd(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
 	poolType, err := fs.GetString(FlagPoolType)
 	if err != nil {
@@ -159,14 +250,19 @@ func BuildCreatePoolCmd(clientCtx client.Context, args []string, fs *flag.FlagSe
 	poolType = strings.ToLower(poolType)
 
 	var msg sdk.Msg
-	if poolType == "balancer" || poolType == "uniswap" {
+	switch poolType {
+	case "balancer", "uniswap":
 		msg, err = NewBuildCreateBalancerPoolMsg(clientCtx, fs)
-		if err != nil {
-			return nil, err
-		}
+	case "stableswap":
+		msg, err = NewBuildCreateStableswapPoolMsg(clientCtx, fs)
+	case "lbp":
+		msg, err = NewBuildCreateLiquidityBootstrappingPoolMsg(clientCtx, fs)
+	default:
+		return nil, fmt.Errorf("unknown pool type %s", poolType)
+	}
+	if err != nil {
+		return nil, err
 	}
-
-	//TODO: validate poolType
 
 	return msg, nil
 }
@@ -176,7 +272,54 @@ func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, fs *flag.FlagSet) (
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse pool: %w", err)
 	}
+	if pool.LbpEndBehavior != "" {
+		return nil, fmt.Errorf("%s is only used by lbp pools", PoolFileLbpEndBehavior)
+	}
+
+	return buildCreateBalancerPoolMsg(clientCtx, pool)
+}
+
+func NewBuildCreateLiquidityBootstrappingPoolMsg(clientCtx client.Context, fs *flag.FlagSet) (sdk.Msg, error) {
+	pool, err := parseCreateBalancerPoolFlags(fs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pool: %w", err)
+	}
+	if (pool.SmoothWeightChangeParams == smoothWeightChangeParamsInputs{}) {
+		return nil, fmt.Errorf("lbp pools must set %s", PoolFileSmoothWeightChangeParams)
+	}
+	if (pool.DynamicSwapFeeParams != dynamicSwapFeeParamsInputs{}) {
+		return nil, fmt.Errorf("lbp pools can not set %s", PoolFileDynamicSwapFeeParams)
+	}
+
+	var endBehavior balancer.LiquidityBootstrappingEndBehavior
+	switch strings.ToLower(pool.LbpEndBehavior) {
+	case "", "freeze":
+		endBehavior = balancer.LBP_END_FREEZE_WEIGHTS
+	case "equalize":
+		endBehavior = balancer.LBP_END_EQUALIZE_WEIGHTS
+	default:
+		return nil, fmt.Errorf("unknown %s %s, expected \"freeze\" or \"equalize\"", PoolFileLbpEndBehavior, pool.LbpEndBehavior)
+	}
+
+	msg, err := buildCreateBalancerPoolMsg(clientCtx, pool)
+	if err != nil {
+		return nil, err
+	}
+	balancerMsg := msg.(*balancer.MsgCreateBalancerPool)
+
+	lbpMsg := balancer.NewMsgCreateLiquidityBootstrappingPool(
+		clientCtx.GetFromAddress(),
+		balancerMsg.PoolParams.SwapFee,
+		balancerMsg.PoolParams.ExitFee,
+		balancerMsg.PoolAssets,
+		*balancerMsg.PoolParams.SmoothWeightChangeParams,
+		endBehavior,
+		balancerMsg.FuturePoolGovernor,
+	)
+	return &lbpMsg, nil
+}
 
+func buildCreateBalancerPoolMsg(clientCtx client.Context, pool *createBalancerPoolInputs) (sdk.Msg, error) {
 	deposit, err := sdk.ParseCoinsNormalized(pool.InitialDeposit)
 	if err != nil {
 		return nil, err
@@ -271,9 +414,188 @@ func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, fs *flag.FlagSet) (
 		msg.PoolParams.SmoothWeightChangeParams = &smoothWeightParams
 	}
 
+	if (pool.DynamicSwapFeeParams != dynamicSwapFeeParamsInputs{}) {
+		baseFee, err := sdk.NewDecFromStr(pool.DynamicSwapFeeParams.BaseFee)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse base fee: %w", err)
+		}
+
+		maxFee, err := sdk.NewDecFromStr(pool.DynamicSwapFeeParams.MaxFee)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse max fee: %w", err)
+		}
+
+		sensitivity, err := sdk.NewDecFromStr(pool.DynamicSwapFeeParams.Sensitivity)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse sensitivity: %w", err)
+		}
+
+		msg.PoolParams.DynamicSwapFeeParams = &balancer.DynamicSwapFeeParams{
+			BaseFee:     baseFee,
+			MaxFee:      maxFee,
+			Sensitivity: sensitivity,
+		}
+	}
+
+	return msg, nil
+}
+
+func NewBuildCreateStableswapPoolMsg(clientCtx client.Context, fs *flag.FlagSet) (sdk.Msg, error) {
+	pool, err := parseCreateStableswapPoolFlags(fs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pool: %w", err)
+	}
+
+	deposit, err := ParseCoinsNoSort(pool.InitialDeposit)
+	if err != nil {
+		return nil, err
+	}
+
+	swapFee, err := sdk.NewDecFromStr(pool.SwapFee)
+	if err != nil {
+		return nil, err
+	}
+
+	exitFee, err := sdk.NewDecFromStr(pool.ExitFee)
+	if err != nil {
+		return nil, err
+	}
+
+	var scalingFactors []uint64
+	if pool.ScalingFactors != "" {
+		scalingFactors, err = osmoutils.ParseUint64SliceFromString(pool.ScalingFactors, ",")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	amplification, err := strconv.ParseUint(pool.Amplification, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse amplification: %w", err)
+	}
+
+	msg := &stableswap.MsgCreateStableswapPool{
+		Sender: clientCtx.GetFromAddress().String(),
+		PoolParams: &stableswap.PoolParams{
+			SwapFee: swapFee,
+			ExitFee: exitFee,
+		},
+		InitialPoolLiquidity:    deposit,
+		ScalingFactors:          scalingFactors,
+		FuturePoolGovernor:      pool.FutureGovernor,
+		ScalingFactorController: pool.ScalingFactorController,
+		Amplification:           amplification,
+	}
+
 	return msg, nil
 }
 
+func NewStableSwapAdjustScalingFactorsCmd() *osmocli.TxCliDesc {
+	return &osmocli.TxCliDesc{
+		Use:              "adjust-scaling-factors",
+		Short:            "adjust scaling factors of a stableswap pool",
+		Long:             "Adjust the scaling factors of a stableswap pool. The sender must be the pool's scaling factor controller.",
+		Example:          fmt.Sprintf("%s tx gamm adjust-scaling-factors --pool-id=1 --scaling-factors=1,1000", version.AppName),
+		NumArgs:          0,
+		ParseAndBuildMsg: NewBuildStableSwapAdjustScalingFactorsMsg,
+		Flags:            osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetAdjustScalingFactors()}},
+	}
+}
+
+func NewBuildStableSwapAdjustScalingFactorsMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := fs.GetUint64(FlagPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	scalingFactorsStr, err := fs.GetString(FlagScalingFactors)
+	if err != nil {
+		return nil, err
+	}
+
+	scalingFactors, err := osmoutils.ParseUint64SliceFromString(scalingFactorsStr, ",")
+	if err != nil {
+		return nil, err
+	}
+
+	msg := stableswap.NewMsgStableSwapAdjustScalingFactors(clientCtx.GetFromAddress().String(), poolID, scalingFactors)
+	return &msg, nil
+}
+
+func NewScheduleWeightChangeCmd() *osmocli.TxCliDesc {
+	return &osmocli.TxCliDesc{
+		Use:              "schedule-weight-change [target-pool-weights] [duration]",
+		Short:            "schedule a smooth weight change of a balancer pool",
+		Long:             "Schedule a smooth change of the weights of a balancer pool, from their current values to the target weights, replacing any weight change in progress. The sender must be the pool's governor.",
+		Example:          fmt.Sprintf("%s tx gamm schedule-weight-change 1uatom,3adym 48h --pool-id=1 --start-time=2024-01-01T00:00:00Z", version.AppName),
+		NumArgs:          2,
+		ParseAndBuildMsg: NewBuildScheduleWeightChangeMsg,
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()},
+			OptionalFlags: []*flag.FlagSet{FlagSetScheduleWeightChange()},
+		},
+	}
+}
+
+func NewBuildScheduleWeightChangeMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := fs.GetUint64(FlagPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	targetPoolWeights, err := sdk.ParseDecCoins(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := time.ParseDuration(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse duration: %w", err)
+	}
+
+	params := balancer.SmoothWeightChangeParams{Duration: duration}
+	for _, weight := range targetPoolWeights {
+		params.TargetPoolWeights = append(params.TargetPoolWeights, balancer.PoolAsset{
+			Weight: weight.Amount.RoundInt(),
+			Token:  sdk.NewCoin(weight.Denom, sdk.ZeroInt()),
+		})
+	}
+
+	startTimeStr, err := fs.GetString(FlagStartTime)
+	if err != nil {
+		return nil, err
+	}
+	if startTimeStr != "" {
+		params.StartTime, err = time.Parse(time.RFC3339, startTimeStr)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse time: %w", err)
+		}
+	}
+
+	msg := balancer.NewMsgScheduleWeightChange(clientCtx.GetFromAddress().String(), poolID, params)
+	return &msg, nil
+}
+
+func NewSetPoolFeesCmd() (*osmocli.TxCliDesc, *balancer.MsgSetPoolFees) {
+	return &osmocli.TxCliDesc{
+		Use:                 "set-pool-fees [swap-fee] [exit-fee]",
+		Short:               "set the swap and exit fees of a balancer pool",
+		Long:                "Set the swap and exit fees of a balancer pool, within the governor fee bounds of the module params. The sender must be the pool's governor.",
+		CustomFlagOverrides: poolIdFlagOverride,
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+	}, &balancer.MsgSetPoolFees{}
+}
+
+func NewSetSwapsPausedCmd() (*osmocli.TxCliDesc, *balancer.MsgSetSwapsPaused) {
+	return &osmocli.TxCliDesc{
+		Use:                 "set-swaps-paused [paused]",
+		Short:               "pause or unpause swaps against a balancer pool",
+		Long:                "Pause (true) or unpause (false) swaps against a balancer pool. The sender must be the pool's governor.",
+		CustomFlagOverrides: poolIdFlagOverride,
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+	}, &balancer.MsgSetSwapsPaused{}
+}
+
 func shareAmountInParser(fs *flag.FlagSet) (sdk.Int, error) {
 	return sdkIntParser(FlagShareAmountIn, fs)
 }
@@ -295,6 +617,65 @@ func sdkIntParser(flagName string, fs *flag.FlagSet) (sdk.Int, error) {
 	return res, nil
 }
 
+// spotPriceBoundsParser parses the spot price bounds flags, returning nil bounds if neither
+// the min nor the max spot price is set.
+func spotPriceBoundsParser(fs *flag.FlagSet) (*types.SpotPriceBounds, error) {
+	baseDenom, err := fs.GetString(FlagSpotPriceBaseDenom)
+	if err != nil {
+		return nil, err
+	}
+	quoteDenom, err := fs.GetString(FlagSpotPriceQuoteDenom)
+	if err != nil {
+		return nil, err
+	}
+	minSpotPrice, err := optionalSdkDecParser(FlagMinSpotPrice, fs)
+	if err != nil {
+		return nil, err
+	}
+	maxSpotPrice, err := optionalSdkDecParser(FlagMaxSpotPrice, fs)
+	if err != nil {
+		return nil, err
+	}
+
+	if minSpotPrice.IsZero() && maxSpotPrice.IsZero() {
+		if baseDenom != "" || quoteDenom != "" {
+			return nil, fmt.Errorf("%s or %s must be set with the spot price denoms", FlagMinSpotPrice, FlagMaxSpotPrice)
+		}
+		return nil, nil
+	}
+	if baseDenom == "" || quoteDenom == "" {
+		return nil, fmt.Errorf("%s and %s must be set with the spot price bounds", FlagSpotPriceBaseDenom, FlagSpotPriceQuoteDenom)
+	}
+	return &types.SpotPriceBounds{
+		BaseAssetDenom:  baseDenom,
+		QuoteAssetDenom: quoteDenom,
+		MinSpotPrice:    minSpotPrice,
+		MaxSpotPrice:    maxSpotPrice,
+	}, nil
+}
+
+func optionalSdkDecParser(flagName string, fs *flag.FlagSet) (sdk.Dec, error) {
+	decStr, err := fs.GetString(flagName)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if decStr == "" {
+		return sdk.ZeroDec(), nil
+	}
+	return sdk.NewDecFromStr(decStr)
+}
+
+func lockDurationParser(fs *flag.FlagSet) (time.Duration, error) {
+	durationStr, err := fs.GetString(FlagLockDuration)
+	if err != nil {
+		return 0, err
+	}
+	if durationStr == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(durationStr)
+}
+
 func maxAmountsInParser(fs *flag.FlagSet) (sdk.Coins, error) {
 	return stringArrayCoinsParser(FlagMaxAmountsIn, fs)
 }
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+// GetCanonicalPoolId returns the canonical pool of the pair of the given denoms, in any order,
+// and false if the pair has none.
+func (k Keeper) GetCanonicalPoolId(ctx sdk.Context, denomA, denomB string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	canonicalPool := types.CanonicalPool{}
+	found, err := osmoutils.Get(store, types.GetKeyCanonicalPool(denomA, denomB), &canonicalPool)
+	if err != nil {
+		panic(err)
+	}
+	return canonicalPool.PoolId, found
+}
+
+// GetAllCanonicalPools returns the canonical pools of all the denom pairs.
+func (k Keeper) GetAllCanonicalPools(ctx sdk.Context) []types.CanonicalPool {
+	store := ctx.KVStore(k.storeKey)
+	canonicalPools, err := osmoutils.GatherValuesFromStorePrefix(store, types.KeyPrefixCanonicalPools, func(bz []byte) (types.CanonicalPool, error) {
+		canonicalPool := types.CanonicalPool{}
+		err := k.cdc.Unmarshal(bz, &canonicalPool)
+		return canonicalPool, err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return canonicalPools
+}
+
+// SetCanonicalPool makes the given pool the canonical pool of the pair of the given denoms.
+// The pool must hold both denoms.
+func (k Keeper) SetCanonicalPool(ctx sdk.Context, poolId uint64, denomA, denomB string) error {
+	canonicalPool := types.NewCanonicalPool(denomA, denomB, poolId)
+	if err := canonicalPool.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.GetKeyDenomPairPool(denomA, denomB, poolId)) {
+		return sdkerrors.Wrapf(types.ErrInvalidCanonicalPool, "pool %d does not hold %s and %s", poolId, canonicalPool.DenomA, canonicalPool.DenomB)
+	}
+
+	k.setCanonicalPool(ctx, canonicalPool)
+	return nil
+}
+
+func (k Keeper) setCanonicalPool(ctx sdk.Context, canonicalPool types.CanonicalPool) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.GetKeyCanonicalPool(canonicalPool.DenomA, canonicalPool.DenomB), &canonicalPool)
+}
+
+// getPoolsWithDenoms returns the pools holding exactly the given denoms, found through the
+// pools indexed under the pair of their first two denoms.
+func (k Keeper) getPoolsWithDenoms(ctx sdk.Context, denoms []string) ([]types.CFMMPoolI, error) {
+	if len(denoms) < 2 {
+		return nil, nil
+	}
+	sortedDenoms := make([]string, len(denoms))
+	copy(sortedDenoms, denoms)
+	sort.Strings(sortedDenoms)
+
+	pools := []types.CFMMPoolI{}
+	for _, poolId := range k.GetDenomPairPoolIds(ctx, sortedDenoms[0], sortedDenoms[1]) {
+		pool, err := k.GetPoolAndPoke(ctx, poolId)
+		if err != nil {
+			return nil, err
+		}
+
+		poolDenoms := osmoutils.CoinsDenoms(pool.GetTotalPoolLiquidity(ctx))
+		sort.Strings(poolDenoms)
+		if slices.Equal(poolDenoms, sortedDenoms) {
+			pools = append(pools, pool)
+		}
+	}
+	return pools, nil
+}
+
+// validatePoolFeeTier validates that the swap fee of a pool holding the given denoms is distinct
+// from the swap fees of the other pools holding the same assets, and that it is one of the fee
+// tiers if there are such pools. The pool with the given id, if any, is left out of the check.
+func (k Keeper) validatePoolFeeTier(ctx sdk.Context, poolId uint64, denoms []string, swapFee sdk.Dec) error {
+	sameAssetsPools, err := k.getPoolsWithDenoms(ctx, denoms)
+	if err != nil {
+		return err
+	}
+	otherPoolIds := []uint64{}
+	for _, pool := range sameAssetsPools {
+		if pool.GetId() == poolId {
+			continue
+		}
+		if poolFeeTier(ctx, pool).Equal(swapFee) {
+			return sdkerrors.Wrapf(types.ErrPoolAlreadyExists, "pool %d has the same swap fee (%s)", pool.GetId(), swapFee)
+		}
+		otherPoolIds = append(otherPoolIds, pool.GetId())
+	}
+	if len(otherPoolIds) == 0 {
+		return nil
+	}
+	if params := k.GetParams(ctx); !params.IsFeeTier(swapFee) {
+		return sdkerrors.Wrapf(types.ErrInvalidFeeTier, "swap fee (%s) of a pool with the same assets as pool %d must be one of %v", swapFee, otherPoolIds[0], params.FeeTiers)
+	}
+	return nil
+}
+
+// poolFeeTier returns the swap fee the pool was created with, which balancer pools
+// charging a dynamic swap fee keep in their params.
+func poolFeeTier(ctx sdk.Context, pool types.CFMMPoolI) sdk.Dec {
+	if balancerPool, ok := pool.(*balancer.Pool); ok {
+		return balancerPool.PoolParams.SwapFee
+	}
+	return pool.GetSwapFee(ctx)
+}
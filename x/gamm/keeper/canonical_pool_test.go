@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+// TestPoolFeeTiers tests that pools of the same assets can only be created with distinct swap fees
+// taken from the fee tiers.
+func (suite *KeeperTestSuite) TestPoolFeeTiers() {
+	tests := map[string]struct {
+		swapFee     sdk.Dec
+		poolAssets  []balancer.PoolAsset
+		expectedErr error
+	}{
+		"same assets, same swap fee": {
+			swapFee:     defaultSwapFee,
+			poolAssets:  defaultPoolAssets,
+			expectedErr: types.ErrPoolAlreadyExists,
+		},
+		"same assets, swap fee not a fee tier": {
+			swapFee:     sdk.MustNewDecFromStr("0.002"),
+			poolAssets:  defaultPoolAssets,
+			expectedErr: types.ErrInvalidFeeTier,
+		},
+		"same assets, fee tier": {
+			swapFee:    sdk.MustNewDecFromStr("0.003"),
+			poolAssets: defaultPoolAssets,
+		},
+		"more assets, swap fee not a fee tier": {
+			swapFee: sdk.MustNewDecFromStr("0.002"),
+			poolAssets: append([]balancer.PoolAsset{{
+				Weight: sdk.NewInt(100),
+				Token:  sdk.NewCoin("baz", sdk.NewInt(10000)),
+			}}, defaultPoolAssets...),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.FundAcc(suite.TestAccs[0], defaultAcctFunds)
+			msgServer := keeper.NewBalancerMsgServerImpl(suite.App.GAMMKeeper)
+
+			// the first pool of the assets can have any swap fee.
+			msg := balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], defaultPoolParams, defaultPoolAssets, defaultFutureGovernor)
+			_, err := msgServer.CreateBalancerPool(suite.Ctx, &msg)
+			suite.Require().NoError(err)
+
+			poolParams := balancer.PoolParams{SwapFee: tc.swapFee, ExitFee: defaultExitFee}
+			msg = balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], poolParams, tc.poolAssets, defaultFutureGovernor)
+			_, err = msgServer.CreateBalancerPool(suite.Ctx, &msg)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCanonicalPools() {
+	suite.SetupTest()
+	gammKeeper := suite.App.GAMMKeeper
+	suite.FundAcc(suite.TestAccs[0], defaultAcctFunds)
+	msgServer := keeper.NewBalancerMsgServerImpl(gammKeeper)
+	querier := keeper.NewQuerier(*gammKeeper)
+
+	// pool 1 holds adym and bar, pool 2 adym and bar at another fee tier and pool 3 adym and baz.
+	msg := balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], defaultPoolParams, defaultPoolAssets, defaultFutureGovernor)
+	_, err := msgServer.CreateBalancerPool(suite.Ctx, &msg)
+	suite.Require().NoError(err)
+	msg.PoolParams.SwapFee = sdk.MustNewDecFromStr("0.01")
+	_, err = msgServer.CreateBalancerPool(suite.Ctx, &msg)
+	suite.Require().NoError(err)
+	msg.PoolAssets = []balancer.PoolAsset{defaultDymAsset, {Weight: sdk.NewInt(100), Token: sdk.NewCoin("baz", sdk.NewInt(10000))}}
+	_, err = msgServer.CreateBalancerPool(suite.Ctx, &msg)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]uint64{1, 2}, gammKeeper.GetDenomPairPoolIds(suite.Ctx, sdk.DefaultBondDenom, "bar"))
+	suite.Require().Equal([]uint64{1, 2}, gammKeeper.GetDenomPairPoolIds(suite.Ctx, "bar", sdk.DefaultBondDenom))
+	suite.Require().Equal([]uint64{3}, gammKeeper.GetDenomPairPoolIds(suite.Ctx, sdk.DefaultBondDenom, "baz"))
+	suite.Require().Empty(gammKeeper.GetDenomPairPoolIds(suite.Ctx, "bar", "baz"))
+
+	// the first pool of a pair is its canonical pool.
+	res, err := querier.CanonicalPool(sdk.WrapSDKContext(suite.Ctx), &types.QueryCanonicalPoolRequest{DenomA: "bar", DenomB: sdk.DefaultBondDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.PoolId)
+	_, err = querier.CanonicalPool(sdk.WrapSDKContext(suite.Ctx), &types.QueryCanonicalPoolRequest{DenomA: "bar", DenomB: "baz"})
+	suite.Require().Error(err)
+
+	// only the authority can set the canonical pool of a pair.
+	setMsg := types.MsgSetCanonicalPool{Authority: suite.TestAccs[0].String(), PoolId: 2, DenomA: sdk.DefaultBondDenom, DenomB: "bar"}
+	_, err = keeper.NewMsgServerImpl(gammKeeper).SetCanonicalPool(sdk.WrapSDKContext(suite.Ctx), &setMsg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the canonical pool must hold both denoms of the pair.
+	setMsg.Authority = gammKeeper.GetAuthority()
+	setMsg.PoolId = 3
+	_, err = keeper.NewMsgServerImpl(gammKeeper).SetCanonicalPool(sdk.WrapSDKContext(suite.Ctx), &setMsg)
+	suite.Require().ErrorIs(err, types.ErrInvalidCanonicalPool)
+
+	setMsg.PoolId = 2
+	_, err = keeper.NewMsgServerImpl(gammKeeper).SetCanonicalPool(sdk.WrapSDKContext(suite.Ctx), &setMsg)
+	suite.Require().NoError(err)
+	poolId, found := gammKeeper.GetCanonicalPoolId(suite.Ctx, "bar", sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), poolId)
+
+	suite.Require().Equal([]types.CanonicalPool{
+		{DenomA: sdk.DefaultBondDenom, DenomB: "bar", PoolId: 2},
+		{DenomA: sdk.DefaultBondDenom, DenomB: "baz", PoolId: 3},
+	}, gammKeeper.GetAllCanonicalPools(suite.Ctx))
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/osmosis-labs/osmosis/v15/x/epochs/types"
+)
+
+// EpochHooks is the wrapper struct for the epoch hooks of the gamm keeper.
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks returns the epoch hooks wrapper struct.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// BeforeEpochStart is the epoch start hook.
+func (h EpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// AfterEpochEnd is the epoch end hook. At the end of each epoch of the stats epoch identifier,
+// the stats of the pools over the epoch are moved to their history.
+func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier == h.k.GetParams(ctx).StatsEpochIdentifier {
+		h.k.endStatsEpoch(ctx, epochNumber)
+	}
+	return nil
+}
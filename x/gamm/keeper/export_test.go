@@ -20,3 +20,20 @@ func (k Keeper) UnmarshalPoolLegacy(bz []byte) (poolmanagertypes.PoolI, error) {
 	var acc poolmanagertypes.PoolI
 	return acc, k.cdc.UnmarshalInterface(bz, &acc)
 }
+
+// ClearPoolIndexes removes the denom indexes and the canonical pools of all pools, as the store
+// was before they were added.
+func (k Keeper) ClearPoolIndexes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, keyPrefix := range [][]byte{types.KeyPrefixDenomPools, types.KeyPrefixDenomPairPools, types.KeyPrefixCanonicalPools} {
+		iter := sdk.KVStorePrefixIterator(store, keyPrefix)
+		keys := [][]byte{}
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close() //nolint:errcheck
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
@@ -26,6 +26,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 		if err != nil {
 			panic(err)
 		}
+		k.indexPool(ctx, pool)
 
 		poolAssets := pool.GetTotalPoolLiquidity(ctx)
 		for _, asset := range poolAssets {
@@ -34,6 +35,28 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 	}
 
 	k.setTotalLiquidity(ctx, liquidity)
+
+	if genState.MigrationRecords != nil {
+		if err := k.ReplaceMigrationRecords(ctx, genState.MigrationRecords.PoolMigrationLinks); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, canonicalPool := range genState.CanonicalPools {
+		if err := k.SetCanonicalPool(ctx, canonicalPool.PoolId, canonicalPool.DenomA, canonicalPool.DenomB); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, stats := range genState.PoolStats {
+		k.setPoolStats(ctx, types.GetKeyPoolStats(stats.PoolId), stats)
+	}
+	for _, stats := range genState.CurrentEpochPoolStats {
+		k.setPoolStats(ctx, types.GetKeyCurrentEpochPoolStats(stats.PoolId), stats)
+	}
+	for _, stats := range genState.EpochPoolStats {
+		k.setPoolStats(ctx, types.GetKeyEpochPoolStats(stats.EpochNumber, stats.PoolId), stats)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -42,6 +65,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err != nil {
 		panic(err)
 	}
+	migrationRecords, err := k.GetMigrationRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
 	poolAnys := []*codectypes.Any{}
 	for _, poolI := range pools {
 		any, err := codectypes.NewAnyWithValue(poolI)
@@ -51,8 +78,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		poolAnys = append(poolAnys, any)
 	}
 	return &types.GenesisState{
-		NextPoolNumber: k.GetNextPoolId(ctx),
-		Pools:          poolAnys,
-		Params:         k.GetParams(ctx),
+		NextPoolNumber:   k.GetNextPoolId(ctx),
+		Pools:            poolAnys,
+		Params:           k.GetParams(ctx),
+		MigrationRecords: &migrationRecords,
+		CanonicalPools:   k.GetAllCanonicalPools(ctx),
+
+		PoolStats:             k.getAllPoolStats(ctx, types.KeyPrefixPoolStats),
+		CurrentEpochPoolStats: k.getAllPoolStats(ctx, types.KeyPrefixCurrentEpochPoolStats),
+		EpochPoolStats:        k.getAllPoolStats(ctx, types.KeyPrefixEpochPoolStats),
 	}
 }
@@ -54,8 +54,16 @@ func TestGammInitGenesis(t *testing.T) {
 				SwapFee: sdk.ZeroDec(),
 				ExitFee: sdk.ZeroDec(),
 			},
-			TakerFee: sdk.ZeroDec(),
+			TakerFee:                     sdk.ZeroDec(),
+			GovernorFeeBounds:            types.DefaultParams().GovernorFeeBounds,
+			LiquidityBootstrappingBounds: types.DefaultParams().LiquidityBootstrappingBounds,
+			FeeTiers:                     types.DefaultParams().FeeTiers,
+			StatsEpochIdentifier:         "day",
+			StatsHistoryLength:           30,
 		},
+		PoolStats:             []types.PoolStats{{PoolId: 1, Volume: sdk.NewCoins(sdk.NewInt64Coin("nodetoken", 10))}},
+		CurrentEpochPoolStats: []types.PoolStats{{PoolId: 1, SwapFees: sdk.NewCoins(sdk.NewInt64Coin("nodetoken", 1))}},
+		EpochPoolStats:        []types.PoolStats{{PoolId: 1, EpochNumber: 3, TakerFees: sdk.NewCoins(sdk.NewInt64Coin("nodetoken", 2))}},
 	}, app.AppCodec())
 
 	require.Equal(t, app.PoolManagerKeeper.GetNextPoolId(ctx), uint64(1))
@@ -76,6 +84,18 @@ func TestGammInitGenesis(t *testing.T) {
 	liquidity := app.GAMMKeeper.GetTotalLiquidity(ctx)
 	expectedValue := sdk.Coins{sdk.NewInt64Coin("nodetoken", 10), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)}
 	require.Equal(t, liquidity, expectedValue.Sort())
+
+	// genesis pools are indexed by denom pair.
+	require.Equal(t, []uint64{1}, app.GAMMKeeper.GetDenomPairPoolIds(ctx, "nodetoken", sdk.DefaultBondDenom))
+	canonicalPoolId, found := app.GAMMKeeper.GetCanonicalPoolId(ctx, sdk.DefaultBondDenom, "nodetoken")
+	require.True(t, found)
+	require.Equal(t, uint64(1), canonicalPoolId)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("nodetoken", 10)), app.GAMMKeeper.GetPoolStats(ctx, 1).Volume)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("nodetoken", 1)), app.GAMMKeeper.GetCurrentEpochPoolStats(ctx, 1).SwapFees)
+	epochStats, found := app.GAMMKeeper.GetEpochPoolStats(ctx, 3, 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("nodetoken", 2)), epochStats.TakerFees)
 }
 
 func TestGammExportGenesis(t *testing.T) {
@@ -116,6 +136,18 @@ func TestGammExportGenesis(t *testing.T) {
 	_, err = app.PoolManagerKeeper.CreatePool(ctx, msg)
 	require.NoError(t, err)
 
+	migrationLinks := []types.PoolMigrationLink{{OldPoolId: 1, NewPoolId: 2}}
+	err = app.GAMMKeeper.ReplaceMigrationRecords(ctx, migrationLinks)
+	require.NoError(t, err)
+
+	err = app.GAMMKeeper.SetCanonicalPool(ctx, 2, "foo", "bar")
+	require.NoError(t, err)
+
+	pool, err := app.GAMMKeeper.GetPoolAndPoke(ctx, 2)
+	require.NoError(t, err)
+	_, err = app.GAMMKeeper.SwapExactAmountIn(ctx, acc1, pool, sdk.NewInt64Coin("foo", 1000), "bar", sdk.OneInt(), pool.GetSwapFee(ctx))
+	require.NoError(t, err)
+
 	genesis := app.GAMMKeeper.ExportGenesis(ctx)
 	// Note: the next pool number index has been migrated to
 	// poolmanager.
@@ -124,6 +156,12 @@ func TestGammExportGenesis(t *testing.T) {
 	// in a subsequent upgrade.
 	require.Equal(t, genesis.NextPoolNumber, uint64(1))
 	require.Len(t, genesis.Pools, 2)
+	require.Equal(t, migrationLinks, genesis.MigrationRecords.PoolMigrationLinks)
+	require.Equal(t, []types.CanonicalPool{{DenomA: "bar", DenomB: "foo", PoolId: 2}}, genesis.CanonicalPools)
+	require.Len(t, genesis.PoolStats, 1)
+	require.Equal(t, uint64(2), genesis.PoolStats[0].PoolId)
+	require.Equal(t, genesis.PoolStats[0].Volume, genesis.CurrentEpochPoolStats[0].Volume)
+	require.Empty(t, genesis.EpochPoolStats)
 }
 
 func TestMarshalUnmarshalGenesis(t *testing.T) {
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v15/x/lockup/types"
+)
+
+// getBalancerPoolForGovernor returns the balancer pool with the given id,
+// checking that the sender is its governor.
+func (k Keeper) getBalancerPoolForGovernor(ctx sdk.Context, poolId uint64, sender string) (*balancer.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNotBalancerPool, "pool id %d is not of type balancer pool", poolId)
+	}
+
+	if err := k.validatePoolGovernor(ctx, balancerPool, sender); err != nil {
+		return nil, err
+	}
+	return balancerPool, nil
+}
+
+// validatePoolGovernor checks that the sender governs the pool, according to its future governor:
+//   - a pool without a governor is not governed by anyone,
+//   - a pool governed by an address is governed by the sender if it is that address,
+//   - a pool governed by a lockup is governed by the sender if it holds a strict majority of the governing
+//     tokens locked for the governor's duration or longer, i.e. if it carries the vote of the locked tokens.
+//     The governing tokens are the shares of the pool, unless the governor specifies a denom.
+func (k Keeper) validatePoolGovernor(ctx sdk.Context, pool *balancer.Pool, sender string) error {
+	if pool.FuturePoolGovernor == "" {
+		return sdkerrors.Wrapf(types.ErrNotPoolGovernor, "pool %d has no governor", pool.GetId())
+	}
+
+	governor, err := types.ParseFutureGovernor(pool.FuturePoolGovernor)
+	if err != nil {
+		return err
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+
+	if !governor.IsLockup() {
+		if !governor.Address.Equals(senderAddr) {
+			return sdkerrors.Wrapf(types.ErrNotPoolGovernor, "%s does not govern pool %d", sender, pool.GetId())
+		}
+		return nil
+	}
+
+	lockDenom := governor.LockDenom
+	if lockDenom == "" {
+		lockDenom = types.GetPoolShareDenom(pool.GetId())
+	}
+	totalLocked := k.lockupKeeper.GetPeriodLocksAccumulation(ctx, lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         lockDenom,
+		Duration:      governor.LockDuration,
+	})
+	senderLocked := k.getLockedLongerDuration(ctx, senderAddr, lockDenom, governor.LockDuration)
+	if senderLocked.MulRaw(2).LTE(totalLocked) {
+		return sdkerrors.Wrapf(types.ErrNotPoolGovernor,
+			"%s holds %s of the %s%s locked for %s or longer governing pool %d, not a majority",
+			sender, senderLocked, totalLocked, lockDenom, governor.LockDuration, pool.GetId())
+	}
+	return nil
+}
+
+// getLockedLongerDuration returns the amount of denom the account has locked for duration or longer.
+func (k Keeper) getLockedLongerDuration(ctx sdk.Context, addr sdk.AccAddress, denom string, duration time.Duration) sdk.Int {
+	locked := sdk.ZeroInt()
+	for _, lock := range k.lockupKeeper.GetAccountLockedLongerDurationDenom(ctx, addr, denom, duration) {
+		locked = locked.Add(lock.Coins.AmountOf(denom))
+	}
+	return locked
+}
+
+// scheduleBalancerWeightChange schedules a smooth weight change of the balancer pool with the given id,
+// from its current weights. The sender must be the pool's governor.
+// It returns the resulting weight change params.
+func (k Keeper) scheduleBalancerWeightChange(ctx sdk.Context, poolId uint64, params balancer.SmoothWeightChangeParams, sender string) (balancer.SmoothWeightChangeParams, error) {
+	pool, err := k.getBalancerPoolForGovernor(ctx, poolId, sender)
+	if err != nil {
+		return balancer.SmoothWeightChangeParams{}, err
+	}
+
+	if err := pool.ScheduleWeightChange(params, ctx.BlockTime()); err != nil {
+		return balancer.SmoothWeightChangeParams{}, err
+	}
+
+	return *pool.PoolParams.SmoothWeightChangeParams, k.setPool(ctx, pool)
+}
+
+// setBalancerPoolFees sets the swap and exit fees of the balancer pool with the given id.
+// The sender must be the pool's governor, and the fees must be within the governor fee bounds.
+// As at pool creation, the swap fee must be distinct from the swap fees of the other pools holding
+// the same assets, and one of the fee tiers if there are such pools.
+func (k Keeper) setBalancerPoolFees(ctx sdk.Context, poolId uint64, swapFee, exitFee sdk.Dec, sender string) error {
+	if err := k.GetParams(ctx).GovernorFeeBounds.ValidateFees(swapFee, exitFee); err != nil {
+		return err
+	}
+
+	pool, err := k.getBalancerPoolForGovernor(ctx, poolId, sender)
+	if err != nil {
+		return err
+	}
+
+	if err := k.validatePoolFeeTier(ctx, poolId, osmoutils.CoinsDenoms(pool.GetTotalPoolLiquidity(ctx)), swapFee); err != nil {
+		return err
+	}
+
+	if err := pool.SetFees(swapFee, exitFee); err != nil {
+		return err
+	}
+
+	return k.setPool(ctx, pool)
+}
+
+// setBalancerSwapsPaused pauses or unpauses swaps against the balancer pool with the given id.
+// The sender must be the pool's governor.
+func (k Keeper) setBalancerSwapsPaused(ctx sdk.Context, poolId uint64, paused bool, sender string) error {
+	pool, err := k.getBalancerPoolForGovernor(ctx, poolId, sender)
+	if err != nil {
+		return err
+	}
+
+	pool.SetSwapsPaused(paused)
+	return k.setPool(ctx, pool)
+}
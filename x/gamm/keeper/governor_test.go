@@ -0,0 +1,291 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// prepareGovernedBalancerPool creates a balancer pool with the default assets and the given future governor.
+func (suite *KeeperTestSuite) prepareGovernedBalancerPool(futureGovernor string) uint64 {
+	suite.FundAcc(suite.TestAccs[0], defaultAcctFunds)
+	msg := balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], defaultPoolParams, defaultPoolAssets, futureGovernor)
+	res, err := keeper.NewBalancerMsgServerImpl(suite.App.GAMMKeeper).CreateBalancerPool(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+	return res.PoolID
+}
+
+func (suite *KeeperTestSuite) TestPoolGovernorAuthorization() {
+	type lock struct {
+		acc      int
+		amount   int64
+		duration time.Duration
+	}
+
+	tests := map[string]struct {
+		// addressGovernor makes the first test account govern the pool, instead of futureGovernor.
+		addressGovernor bool
+		futureGovernor  string
+		// lockDenom is the denom of the locks, the shares of the pool if empty.
+		lockDenom   string
+		locks       []lock
+		sender      int
+		expectedErr error
+	}{
+		"no governor": {
+			futureGovernor: "",
+			sender:         0,
+			expectedErr:    types.ErrNotPoolGovernor,
+		},
+		"address governor": {
+			addressGovernor: true,
+			sender:          0,
+		},
+		"address governor, sender is not the governor": {
+			addressGovernor: true,
+			sender:          1,
+			expectedErr:     types.ErrNotPoolGovernor,
+		},
+		"lockup governor, sender holds a majority of the locked shares": {
+			futureGovernor: "24h",
+			locks:          []lock{{acc: 1, amount: 60, duration: 24 * time.Hour}, {acc: 2, amount: 40, duration: 48 * time.Hour}},
+			sender:         1,
+		},
+		"lockup governor, sender holds half of the locked shares": {
+			futureGovernor: "24h",
+			locks:          []lock{{acc: 1, amount: 50, duration: 24 * time.Hour}, {acc: 2, amount: 50, duration: 48 * time.Hour}},
+			sender:         1,
+			expectedErr:    types.ErrNotPoolGovernor,
+		},
+		"lockup governor, shorter locks do not vote": {
+			futureGovernor: "24h",
+			locks:          []lock{{acc: 1, amount: 60, duration: time.Hour}, {acc: 2, amount: 40, duration: 24 * time.Hour}},
+			sender:         1,
+			expectedErr:    types.ErrNotPoolGovernor,
+		},
+		"lockup governor, sender holds all the locked shares voting": {
+			futureGovernor: "24h",
+			locks:          []lock{{acc: 1, amount: 60, duration: time.Hour}, {acc: 2, amount: 40, duration: 24 * time.Hour}},
+			sender:         2,
+		},
+		"lockup governor, no locks": {
+			futureGovernor: "24h",
+			sender:         1,
+			expectedErr:    types.ErrNotPoolGovernor,
+		},
+		"lockup governor with a denom": {
+			futureGovernor: "foo,24h",
+			lockDenom:      "foo",
+			locks:          []lock{{acc: 1, amount: 60, duration: 24 * time.Hour}, {acc: 2, amount: 40, duration: 24 * time.Hour}},
+			sender:         1,
+		},
+		"lockup governor with a denom, shares do not vote": {
+			futureGovernor: "foo,24h",
+			locks:          []lock{{acc: 1, amount: 60, duration: 24 * time.Hour}},
+			sender:         1,
+			expectedErr:    types.ErrNotPoolGovernor,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			futureGovernor := tc.futureGovernor
+			if tc.addressGovernor {
+				futureGovernor = suite.TestAccs[0].String()
+			}
+			poolId := suite.prepareGovernedBalancerPool(futureGovernor)
+
+			lockDenom := tc.lockDenom
+			if lockDenom == "" {
+				lockDenom = types.GetPoolShareDenom(poolId)
+			}
+			for _, l := range tc.locks {
+				suite.LockTokens(suite.TestAccs[l.acc], sdk.NewCoins(sdk.NewInt64Coin(lockDenom, l.amount)), l.duration)
+			}
+
+			msg := balancer.NewMsgSetSwapsPaused(suite.TestAccs[tc.sender].String(), poolId, true)
+			_, err := keeper.NewBalancerMsgServerImpl(suite.App.GAMMKeeper).SetSwapsPaused(sdk.WrapSDKContext(suite.Ctx), &msg)
+
+			pool, poolErr := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(poolErr)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				suite.Require().True(pool.IsActive(suite.Ctx))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().False(pool.IsActive(suite.Ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestScheduleWeightChange() {
+	suite.SetupTest()
+	gammKeeper := suite.App.GAMMKeeper
+	msgServer := keeper.NewBalancerMsgServerImpl(gammKeeper)
+	governor := suite.TestAccs[0]
+	poolId := suite.prepareGovernedBalancerPool(governor.String())
+
+	startTime := suite.Ctx.BlockTime().Add(time.Hour)
+	params := balancer.SmoothWeightChangeParams{
+		StartTime: startTime,
+		Duration:  48 * time.Hour,
+		TargetPoolWeights: []balancer.PoolAsset{
+			{Weight: sdk.NewInt(300), Token: sdk.NewCoin(defaultBarAsset.Token.Denom, sdk.ZeroInt())},
+			{Weight: sdk.NewInt(100), Token: sdk.NewCoin(defaultDymAsset.Token.Denom, sdk.ZeroInt())},
+		},
+	}
+
+	// only the governor can schedule a weight change
+	msg := balancer.NewMsgScheduleWeightChange(suite.TestAccs[1].String(), poolId, params)
+	_, err := msgServer.ScheduleWeightChange(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().ErrorIs(err, types.ErrNotPoolGovernor)
+
+	msg.Sender = governor.String()
+	_, err = msgServer.ScheduleWeightChange(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, types.TypeEvtWeightChange, 1)
+
+	// the weights change once the pool is poked past the end of the change
+	ctx := suite.Ctx.WithBlockTime(startTime.Add(49 * time.Hour))
+	pool, err := gammKeeper.GetPoolAndPoke(ctx, poolId)
+	suite.Require().NoError(err)
+	weight, err := pool.(*balancer.Pool).GetTokenWeight(defaultBarAsset.Token.Denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(300).MulRaw(balancer.GuaranteedWeightPrecision), weight)
+	weight, err = pool.(*balancer.Pool).GetTokenWeight(defaultDymAsset.Token.Denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100).MulRaw(balancer.GuaranteedWeightPrecision), weight)
+
+	// stableswap pools have no weights
+	stableswapPoolId := suite.PrepareBasicStableswapPool()
+	msg.PoolID = stableswapPoolId
+	_, err = msgServer.ScheduleWeightChange(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().ErrorIs(err, types.ErrNotBalancerPool)
+}
+
+func (suite *KeeperTestSuite) TestSetPoolFees() {
+	tests := map[string]struct {
+		swapFee     sdk.Dec
+		exitFee     sdk.Dec
+		expectedErr error
+	}{
+		"fees within bounds": {
+			swapFee: sdk.MustNewDecFromStr("0.003"),
+			exitFee: sdk.MustNewDecFromStr("0.001"),
+		},
+		"fees at the bounds": {
+			swapFee: sdk.MustNewDecFromStr("0.05"),
+			exitFee: sdk.MustNewDecFromStr("0.01"),
+		},
+		"swap fee above bounds": {
+			swapFee:     sdk.MustNewDecFromStr("0.051"),
+			exitFee:     sdk.ZeroDec(),
+			expectedErr: types.ErrFeeOutOfGovernorBounds,
+		},
+		"exit fee above bounds": {
+			swapFee:     sdk.MustNewDecFromStr("0.003"),
+			exitFee:     sdk.MustNewDecFromStr("0.02"),
+			expectedErr: types.ErrFeeOutOfGovernorBounds,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			governor := suite.TestAccs[0]
+			poolId := suite.prepareGovernedBalancerPool(governor.String())
+
+			msg := balancer.NewMsgSetPoolFees(governor.String(), poolId, tc.swapFee, tc.exitFee)
+			_, err := keeper.NewBalancerMsgServerImpl(suite.App.GAMMKeeper).SetPoolFees(sdk.WrapSDKContext(suite.Ctx), &msg)
+
+			pool, poolErr := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(poolErr)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				suite.Require().Equal(defaultSwapFee, pool.GetSwapFee(suite.Ctx))
+				suite.Require().Equal(defaultExitFee, pool.GetExitFee(suite.Ctx))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.swapFee, pool.GetSwapFee(suite.Ctx))
+			suite.Require().Equal(tc.exitFee, pool.GetExitFee(suite.Ctx))
+		})
+	}
+}
+
+// TestSetPoolFeesFeeTiers tests that a governor cannot move a pool onto the swap fee of another
+// pool of the same assets, nor off the fee tiers.
+func (suite *KeeperTestSuite) TestSetPoolFeesFeeTiers() {
+	suite.SetupTest()
+	governor := suite.TestAccs[0]
+	msgServer := keeper.NewBalancerMsgServerImpl(suite.App.GAMMKeeper)
+
+	// pool 1 has the default swap fee, and pool 2 holds the same assets at the 0.003 fee tier.
+	firstPoolId := suite.prepareGovernedBalancerPool(governor.String())
+	createMsg := balancer.NewMsgCreateBalancerPool(governor, balancer.PoolParams{SwapFee: sdk.MustNewDecFromStr("0.003"), ExitFee: defaultExitFee}, defaultPoolAssets, governor.String())
+	res, err := msgServer.CreateBalancerPool(sdk.WrapSDKContext(suite.Ctx), &createMsg)
+	suite.Require().NoError(err)
+	secondPoolId := res.PoolID
+
+	msg := balancer.NewMsgSetPoolFees(governor.String(), secondPoolId, defaultSwapFee, sdk.ZeroDec())
+	_, err = msgServer.SetPoolFees(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().ErrorIs(err, types.ErrPoolAlreadyExists)
+
+	msg.SwapFee = sdk.MustNewDecFromStr("0.002")
+	_, err = msgServer.SetPoolFees(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidFeeTier)
+
+	msg = balancer.NewMsgSetPoolFees(governor.String(), firstPoolId, sdk.MustNewDecFromStr("0.002"), sdk.ZeroDec())
+	_, err = msgServer.SetPoolFees(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidFeeTier)
+
+	// the pool itself is left out of the check, and it can move to a free fee tier.
+	msg = balancer.NewMsgSetPoolFees(governor.String(), secondPoolId, sdk.MustNewDecFromStr("0.003"), sdk.ZeroDec())
+	_, err = msgServer.SetPoolFees(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+	msg.SwapFee = sdk.MustNewDecFromStr("0.01")
+	_, err = msgServer.SetPoolFees(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, secondPoolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.01"), pool.GetSwapFee(suite.Ctx))
+}
+
+// TestSetSwapsPaused tests that swaps, including single asset joins, fail against a pool whose swaps are paused.
+func (suite *KeeperTestSuite) TestSetSwapsPaused() {
+	suite.SetupTest()
+	msgServer := keeper.NewBalancerMsgServerImpl(suite.App.GAMMKeeper)
+	governor := suite.TestAccs[0]
+	poolId := suite.prepareGovernedBalancerPool(governor.String())
+	routes := []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: defaultBarAsset.Token.Denom}}
+	tokenIn := sdk.NewCoin(defaultDymAsset.Token.Denom, sdk.NewInt(100))
+	sender := suite.TestAccs[1]
+	suite.FundAcc(sender, defaultAcctFunds)
+
+	msg := balancer.NewMsgSetSwapsPaused(governor.String(), poolId, true)
+	_, err := msgServer.SetSwapsPaused(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSwapsPausedSet, 1)
+
+	_, err = suite.App.PoolManagerKeeper.RouteExactAmountIn(suite.Ctx, sender, routes, tokenIn, sdk.OneInt())
+	suite.Require().Error(err)
+	_, err = suite.App.GAMMKeeper.JoinSwapExactAmountIn(suite.Ctx, sender, poolId, sdk.NewCoins(tokenIn), sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolLocked)
+	shares := suite.App.BankKeeper.GetBalance(suite.Ctx, governor, types.GetPoolShareDenom(poolId))
+	_, err = suite.App.GAMMKeeper.ExitSwapShareAmountIn(suite.Ctx, governor, poolId, defaultBarAsset.Token.Denom, shares.Amount.QuoRaw(10), sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolLocked)
+
+	msg.Paused = false
+	_, err = msgServer.SetSwapsPaused(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+
+	_, err = suite.App.PoolManagerKeeper.RouteExactAmountIn(suite.Ctx, sender, routes, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+}
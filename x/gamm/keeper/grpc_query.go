@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/v2types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
@@ -295,12 +296,282 @@ func (q Querier) PoolParams(ctx context.Context, req *types.QueryPoolParamsReque
 			Params: any,
 		}, nil
 
+	case *stableswap.Pool:
+		any, err := codectypes.NewAnyWithValue(&pool.PoolParams)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.QueryPoolParamsResponse{
+			Params: any,
+		}, nil
+
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s pool type: %T", types.ModuleName, pool)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnpackAny, errMsg)
 	}
 }
 
+// StableSwapAmplification returns the effective amplification of a stableswap pool at the current block time,
+// along with the amplification ramp in progress, if any.
+func (q Querier) StableSwapAmplification(ctx context.Context, req *types.QueryStableSwapAmplificationRequest) (*types.QueryStableSwapAmplificationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, sdkerrors.Wrapf(types.ErrNotStableSwapPool, "pool id %d is not of type stableswap pool", req.PoolId).Error())
+	}
+
+	res := &types.QueryStableSwapAmplificationResponse{
+		Amplification: stableswapPool.GetAmplification(),
+	}
+	if rampParams := stableswapPool.GetAmplificationRampParams(); rampParams != nil {
+		res.Ramping = true
+		res.InitialAmplification = rampParams.InitialAmplification
+		res.TargetAmplification = rampParams.TargetAmplification
+		res.RampStartTime = rampParams.StartTime
+		res.RampEndTime = rampParams.EndTime
+	}
+
+	return res, nil
+}
+
+// LiquidityBootstrappingPool returns the sale of a liquidity bootstrapping pool,
+// along with its effective weights at the current block time.
+func (q Querier) LiquidityBootstrappingPool(ctx context.Context, req *types.QueryLiquidityBootstrappingPoolRequest) (*types.QueryLiquidityBootstrappingPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.getLiquidityBootstrappingPool(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	lbpParams := pool.LiquidityBootstrappingParams
+	weights := make([]types.TokenWeight, 0, len(pool.PoolAssets))
+	for _, asset := range pool.PoolAssets {
+		weights = append(weights, types.TokenWeight{
+			Denom:            asset.Token.Denom,
+			Weight:           asset.Weight,
+			NormalizedWeight: sdk.NewDecFromInt(asset.Weight).QuoInt(pool.TotalWeight),
+		})
+	}
+
+	return &types.QueryLiquidityBootstrappingPoolResponse{
+		Creator:     lbpParams.Creator,
+		StartTime:   lbpParams.StartTime,
+		EndTime:     lbpParams.EndTime,
+		EndBehavior: lbpParams.EndBehavior.String(),
+		SaleEnded:   !pool.IsSaleActive(sdkCtx.BlockTime()),
+		Weights:     weights,
+	}, nil
+}
+
+// maxPriceCurvePoints is the maximum number of points of the price curve of a liquidity bootstrapping pool.
+const maxPriceCurvePoints = 100
+
+// LiquidityBootstrappingPriceCurve returns the spot prices a liquidity bootstrapping pool is projected
+// to have over the rest of its sale, assuming its balances do not change.
+func (q Querier) LiquidityBootstrappingPriceCurve(ctx context.Context, req *types.QueryLiquidityBootstrappingPriceCurveRequest) (*types.QueryLiquidityBootstrappingPriceCurveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.BaseAssetDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid base asset denom")
+	}
+
+	if req.QuoteAssetDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid quote asset denom")
+	}
+
+	if req.NumPoints < 2 || req.NumPoints > maxPriceCurvePoints {
+		return nil, status.Errorf(codes.InvalidArgument, "number of points must be between 2 and %d", maxPriceCurvePoints)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.getLiquidityBootstrappingPool(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := pool.PriceCurve(sdkCtx, req.QuoteAssetDenom, req.BaseAssetDenom, int(req.NumPoints))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryLiquidityBootstrappingPriceCurveResponse{Points: points}, nil
+}
+
+// getLiquidityBootstrappingPool returns the liquidity bootstrapping pool with the given id, poked to the block time.
+func (q Querier) getLiquidityBootstrappingPool(ctx sdk.Context, poolId uint64) (*balancer.Pool, error) {
+	pool, err := q.Keeper.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok || !balancerPool.IsLiquidityBootstrapping() {
+		return nil, status.Error(codes.InvalidArgument, sdkerrors.Wrapf(types.ErrNotLiquidityBootstrappingPool, "pool id %d is not a liquidity bootstrapping pool", poolId).Error())
+	}
+	return balancerPool, nil
+}
+
+// CanonicalPool returns the canonical pool of a denom pair.
+func (q Querier) CanonicalPool(ctx context.Context, req *types.QueryCanonicalPoolRequest) (*types.QueryCanonicalPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.DenomA == "" || req.DenomB == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	poolId, found := q.Keeper.GetCanonicalPoolId(sdkCtx, req.DenomA, req.DenomB)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no canonical pool for %s and %s", req.DenomA, req.DenomB)
+	}
+
+	return &types.QueryCanonicalPoolResponse{PoolId: poolId}, nil
+}
+
+// PoolsByDenom returns the pools holding the given denom, found through the denom index.
+func (q Querier) PoolsByDenom(ctx context.Context, req *types.QueryPoolsByDenomRequest) (*types.QueryPoolsByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pools, pageRes, err := q.paginatePoolSummaries(sdkCtx, types.GetKeyPrefixDenomPools(req.Denom), req.Pagination, req.Denom, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolsByDenomResponse{
+		Pools:      pools,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PoolsByPair returns the pools holding both denoms of the given pair, found through the denom pair index.
+func (q Querier) PoolsByPair(ctx context.Context, req *types.QueryPoolsByPairRequest) (*types.QueryPoolsByPairResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.DenomA); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := sdk.ValidateDenom(req.DenomB); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.DenomA == req.DenomB {
+		return nil, status.Error(codes.InvalidArgument, "denoms of the pair must differ")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pools, pageRes, err := q.paginatePoolSummaries(sdkCtx, types.GetKeyPrefixDenomPairPools(req.DenomA, req.DenomB), req.Pagination, req.DenomA, []string{req.DenomB})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolsByPairResponse{
+		Pools:      pools,
+		Pagination: pageRes,
+	}, nil
+}
+
+// paginatePoolSummaries returns a page of the summaries of the pools indexed under the given prefix.
+func (q Querier) paginatePoolSummaries(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest, baseAssetDenom string, quoteAssetDenoms []string) ([]types.PoolSummary, *query.PageResponse, error) {
+	indexStore := prefix.NewStore(ctx.KVStore(q.Keeper.storeKey), indexPrefix)
+
+	pools := []types.PoolSummary{}
+	pageRes, err := query.Paginate(indexStore, pageReq, func(key, _ []byte) error {
+		pool, err := q.Keeper.getPoolSummary(ctx, sdk.BigEndianToUint64(key), baseAssetDenom, quoteAssetDenoms)
+		if err != nil {
+			return err
+		}
+
+		pools = append(pools, pool)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return pools, pageRes, nil
+}
+
+// PoolStats returns the swap volume and fee revenue of a pool since it was created, and over the current epoch.
+func (q Querier) PoolStats(ctx context.Context, req *types.QueryPoolStatsRequest) (*types.QueryPoolStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryPoolStatsResponse{
+		Stats:             q.Keeper.GetPoolStats(sdkCtx, req.PoolId),
+		CurrentEpochStats: q.Keeper.GetCurrentEpochPoolStats(sdkCtx, req.PoolId),
+	}, nil
+}
+
+// PoolStatsHistory returns the swap volume and fee revenue of a pool over the last finished epochs.
+func (q Querier) PoolStatsHistory(ctx context.Context, req *types.QueryPoolStatsHistoryRequest) (*types.QueryPoolStatsHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryPoolStatsHistoryResponse{
+		Stats: q.Keeper.GetLastEpochsPoolStats(sdkCtx, req.PoolId, req.NumEpochs),
+	}, nil
+}
+
+// PoolAPR returns the APR of the liquidity of a pool, from its swap fee revenue over the last
+// finished epochs and the rewards of the active gauges of its shares.
+func (q Querier) PoolAPR(ctx context.Context, req *types.QueryPoolAPRRequest) (*types.QueryPoolAPRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	res, err := q.Keeper.getPoolAPR(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &res, nil
+}
+
 // TotalPoolLiquidity returns total liquidity in pool.
 func (q Querier) TotalPoolLiquidity(ctx context.Context, req *types.QueryTotalPoolLiquidityRequest) (*types.QueryTotalPoolLiquidityResponse, error) {
 	if req == nil {
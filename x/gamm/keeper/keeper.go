@@ -36,6 +36,13 @@ type Keeper struct {
 	poolManager         types.PoolManager
 	txfeeKeeper         types.TxFeeKeeper
 	rollappKeeper       types.RollappKeeper
+	lockupKeeper        types.LockupKeeper
+	clKeeper            types.ConcentratedLiquidityKeeper
+	epochKeeper         types.EpochKeeper
+	incentivesKeeper    types.IncentivesKeeper
+
+	// the address capable of executing governance gated messages, usually the gov module account
+	authority string
 }
 
 func NewKeeper(
@@ -45,6 +52,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	communityPoolKeeper types.CommunityPoolKeeper,
+	authority string,
 ) Keeper {
 	// Ensure that the module account are set.
 	moduleAddr, perms := accountKeeper.GetModuleAddressAndPermissions(types.ModuleName)
@@ -79,9 +87,15 @@ func NewKeeper(
 		accountKeeper:       accountKeeper,
 		bankKeeper:          bankKeeper,
 		communityPoolKeeper: communityPoolKeeper,
+		authority:           authority,
 	}
 }
 
+// GetAuthority returns the address capable of executing governance gated messages.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetHooks sets the gamm hooks.
 func (k *Keeper) SetHooks(gh types.GammHooks) *Keeper {
 	if k.hooks != nil {
@@ -111,6 +125,31 @@ func (k *Keeper) SetRollapp(rollapp types.RollappKeeper) {
 	k.rollappKeeper = rollapp
 }
 
+// SetLockup sets the lockup keeper, used to resolve the lockup-based governors of pools.
+// must be called when initializing the keeper.
+func (k *Keeper) SetLockup(lockup types.LockupKeeper) {
+	k.lockupKeeper = lockup
+}
+
+// SetConcentratedLiquidity sets the concentrated liquidity keeper, used to migrate pool shares to
+// concentrated liquidity pools.
+// must be called when initializing the keeper.
+func (k *Keeper) SetConcentratedLiquidity(clKeeper types.ConcentratedLiquidityKeeper) {
+	k.clKeeper = clKeeper
+}
+
+// SetEpochs sets the epochs keeper, used to bucket the pool stats per epoch.
+// must be called when initializing the keeper.
+func (k *Keeper) SetEpochs(epochs types.EpochKeeper) {
+	k.epochKeeper = epochs
+}
+
+// SetIncentives sets the incentives keeper, used to estimate the rewards of the pool shares.
+// must be called when initializing the keeper.
+func (k *Keeper) SetIncentives(incentives types.IncentivesKeeper) {
+	k.incentivesKeeper = incentives
+}
+
 // GetParams returns the total set params.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+// newLiquidityBootstrappingPoolMsg returns a message creating a liquidity bootstrapping pool of the first test account,
+// selling foo for the bond denom over 48 hours, from a 9:1 to a 1:1 weight ratio.
+func (suite *KeeperTestSuite) newLiquidityBootstrappingPoolMsg(endBehavior balancer.LiquidityBootstrappingEndBehavior) balancer.MsgCreateLiquidityBootstrappingPool {
+	poolAssets := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(9), Token: sdk.NewCoin("foo", sdk.NewInt(1000000))},
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000))},
+	}
+	weightChange := balancer.SmoothWeightChangeParams{
+		Duration: 48 * time.Hour,
+		TargetPoolWeights: []balancer.PoolAsset{
+			{Weight: sdk.NewInt(1), Token: sdk.NewCoin("foo", sdk.ZeroInt())},
+			{Weight: sdk.NewInt(1), Token: sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
+		},
+	}
+	return balancer.NewMsgCreateLiquidityBootstrappingPool(suite.TestAccs[0], defaultSwapFee, defaultExitFee, poolAssets, weightChange, endBehavior, defaultFutureGovernor)
+}
+
+func (suite *KeeperTestSuite) TestCreateLiquidityBootstrappingPool() {
+	tests := map[string]struct {
+		modifyMsg   func(msg *balancer.MsgCreateLiquidityBootstrappingPool)
+		expectedErr error
+	}{
+		"valid sale": {
+			modifyMsg: func(msg *balancer.MsgCreateLiquidityBootstrappingPool) {},
+		},
+		"starting weight ratio at the bound": {
+			modifyMsg: func(msg *balancer.MsgCreateLiquidityBootstrappingPool) {
+				msg.PoolAssets[0].Weight = sdk.NewInt(99)
+			},
+		},
+		"starting weight ratio above the bound": {
+			modifyMsg: func(msg *balancer.MsgCreateLiquidityBootstrappingPool) {
+				msg.PoolAssets[0].Weight = sdk.NewInt(100)
+			},
+			expectedErr: types.ErrInvalidLiquidityBootstrapping,
+		},
+		"sale shorter than the min duration": {
+			modifyMsg: func(msg *balancer.MsgCreateLiquidityBootstrappingPool) {
+				msg.SmoothWeightChangeParams.Duration = 23 * time.Hour
+			},
+			expectedErr: types.ErrInvalidLiquidityBootstrapping,
+		},
+		"no whitelisted asset": {
+			modifyMsg: func(msg *balancer.MsgCreateLiquidityBootstrappingPool) {
+				msg.PoolAssets[1].Token.Denom = "bar"
+				msg.SmoothWeightChangeParams.TargetPoolWeights[1].Token.Denom = "bar"
+			},
+			expectedErr: types.ErrPoolAssetNotAllowed,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Truncate(time.Second))
+			suite.FundAcc(suite.TestAccs[0], defaultAcctFunds)
+
+			msg := suite.newLiquidityBootstrappingPoolMsg(balancer.LBP_END_EQUALIZE_WEIGHTS)
+			tc.modifyMsg(&msg)
+			res, err := keeper.NewBalancerMsgServerImpl(suite.App.GAMMKeeper).CreateLiquidityBootstrappingPool(sdk.WrapSDKContext(suite.Ctx), &msg)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, res.PoolID)
+			suite.Require().NoError(err)
+			lbpParams := pool.(*balancer.Pool).LiquidityBootstrappingParams
+			suite.Require().NotNil(lbpParams)
+			suite.Require().Equal(suite.TestAccs[0].String(), lbpParams.Creator)
+			suite.Require().Equal(suite.Ctx.BlockTime().Add(48*time.Hour), lbpParams.EndTime)
+			suite.Require().Equal(balancer.LBP_END_EQUALIZE_WEIGHTS, lbpParams.EndBehavior)
+		})
+	}
+}
+
+// TestLiquidityBootstrappingPoolJoins tests that only the creator of a liquidity bootstrapping pool can join it
+// during its sale, while anyone can swap against it and LPs can exit it.
+func (suite *KeeperTestSuite) TestLiquidityBootstrappingPoolJoins() {
+	suite.SetupTest()
+	gammKeeper := suite.App.GAMMKeeper
+	creator, joiner := suite.TestAccs[0], suite.TestAccs[1]
+	suite.FundAcc(creator, defaultAcctFunds)
+	suite.FundAcc(joiner, defaultAcctFunds)
+
+	msg := suite.newLiquidityBootstrappingPoolMsg(balancer.LBP_END_FREEZE_WEIGHTS)
+	res, err := keeper.NewBalancerMsgServerImpl(gammKeeper).CreateLiquidityBootstrappingPool(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+	poolId := res.PoolID
+
+	joinAll := func(ctx sdk.Context, sender sdk.AccAddress) []error {
+		tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+		_, _, errNoSwap := gammKeeper.JoinPoolNoSwap(ctx, sender, poolId, sdk.NewInt(1e14), nil)
+		_, errExactIn := gammKeeper.JoinSwapExactAmountIn(ctx, sender, poolId, sdk.NewCoins(tokenIn), sdk.OneInt())
+		_, errShareOut := gammKeeper.JoinSwapShareAmountOut(ctx, sender, poolId, tokenIn.Denom, sdk.NewInt(1e14), tokenIn.Amount)
+		return []error{errNoSwap, errExactIn, errShareOut}
+	}
+
+	// during the sale, only the creator can join.
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	for _, err := range joinAll(ctx, creator) {
+		suite.Require().NoError(err)
+	}
+	for _, err := range joinAll(ctx, joiner) {
+		suite.Require().ErrorIs(err, types.ErrJoinRestricted)
+	}
+
+	// anyone can swap.
+	pool, err := gammKeeper.GetPoolAndPoke(ctx, poolId)
+	suite.Require().NoError(err)
+	_, err = gammKeeper.SwapExactAmountIn(ctx, joiner, pool, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)), "foo", sdk.OneInt(), defaultSwapFee)
+	suite.Require().NoError(err)
+
+	// LPs can exit during the sale, including through a single asset exit.
+	shares := sdk.NewCoin(types.GetPoolShareDenom(poolId), sdk.NewInt(1e16))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(ctx, creator, joiner, sdk.NewCoins(shares)))
+	_, err = gammKeeper.ExitSwapExactAmountOut(ctx, joiner, poolId, sdk.NewCoin("foo", sdk.NewInt(100)), shares.Amount)
+	suite.Require().NoError(err)
+	_, err = gammKeeper.ExitPool(ctx, creator, poolId, sdk.NewInt(1e16), sdk.NewCoins())
+	suite.Require().NoError(err)
+
+	// once the sale ended, anyone can join.
+	ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(48 * time.Hour))
+	for _, err := range joinAll(ctx, joiner) {
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *KeeperTestSuite) TestLiquidityBootstrappingPoolQueries() {
+	suite.SetupTest()
+	// pools start their weight change at a whole second.
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Truncate(time.Second))
+	gammKeeper := suite.App.GAMMKeeper
+	suite.FundAcc(suite.TestAccs[0], defaultAcctFunds)
+	querier := keeper.NewQuerier(*gammKeeper)
+	startTime := suite.Ctx.BlockTime()
+
+	msg := suite.newLiquidityBootstrappingPoolMsg(balancer.LBP_END_EQUALIZE_WEIGHTS)
+	res, err := keeper.NewBalancerMsgServerImpl(gammKeeper).CreateLiquidityBootstrappingPool(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+	poolId := res.PoolID
+
+	// halfway through the sale, the weights moved from 9:1 to 5:1.
+	ctx := suite.Ctx.WithBlockTime(startTime.Add(24 * time.Hour))
+	poolRes, err := querier.LiquidityBootstrappingPool(sdk.WrapSDKContext(ctx), &types.QueryLiquidityBootstrappingPoolRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.TestAccs[0].String(), poolRes.Creator)
+	suite.Require().Equal(startTime, poolRes.StartTime)
+	suite.Require().Equal(startTime.Add(48*time.Hour), poolRes.EndTime)
+	suite.Require().Equal(balancer.LBP_END_EQUALIZE_WEIGHTS.String(), poolRes.EndBehavior)
+	suite.Require().False(poolRes.SaleEnded)
+	suite.Require().Equal([]types.TokenWeight{
+		{Denom: sdk.DefaultBondDenom, Weight: sdk.NewInt(balancer.GuaranteedWeightPrecision), NormalizedWeight: sdk.OneDec().QuoInt64(6)},
+		{Denom: "foo", Weight: sdk.NewInt(5 * balancer.GuaranteedWeightPrecision), NormalizedWeight: sdk.NewDec(5).QuoInt64(6)},
+	}, poolRes.Weights)
+
+	// the price of foo falls from 5 to 1 over the rest of the sale, the balances being equal.
+	curveRes, err := querier.LiquidityBootstrappingPriceCurve(sdk.WrapSDKContext(ctx), &types.QueryLiquidityBootstrappingPriceCurveRequest{
+		PoolId:          poolId,
+		BaseAssetDenom:  "foo",
+		QuoteAssetDenom: sdk.DefaultBondDenom,
+		NumPoints:       3,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PricePoint{
+		{Time: startTime.Add(24 * time.Hour), SpotPrice: sdk.NewDec(5)},
+		{Time: startTime.Add(36 * time.Hour), SpotPrice: sdk.NewDec(3)},
+		{Time: startTime.Add(48 * time.Hour), SpotPrice: sdk.NewDec(1)},
+	}, curveRes.Points)
+
+	_, err = querier.LiquidityBootstrappingPriceCurve(sdk.WrapSDKContext(ctx), &types.QueryLiquidityBootstrappingPriceCurveRequest{
+		PoolId:          poolId,
+		BaseAssetDenom:  "foo",
+		QuoteAssetDenom: sdk.DefaultBondDenom,
+		NumPoints:       101,
+	})
+	suite.Require().Error(err)
+
+	// once the sale ended, the pool equalized its weights and is a normal pool.
+	ctx = suite.Ctx.WithBlockTime(startTime.Add(48 * time.Hour))
+	_, err = querier.LiquidityBootstrappingPool(sdk.WrapSDKContext(ctx), &types.QueryLiquidityBootstrappingPoolRequest{PoolId: poolId})
+	suite.Require().Error(err)
+
+	// a pool freezing its weights remains a liquidity bootstrapping pool.
+	msg = suite.newLiquidityBootstrappingPoolMsg(balancer.LBP_END_FREEZE_WEIGHTS)
+	msg.PoolAssets[0].Token.Denom, msg.SmoothWeightChangeParams.TargetPoolWeights[0].Token.Denom = "baz", "baz"
+	res, err = keeper.NewBalancerMsgServerImpl(gammKeeper).CreateLiquidityBootstrappingPool(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+	poolRes, err = querier.LiquidityBootstrappingPool(sdk.WrapSDKContext(ctx), &types.QueryLiquidityBootstrappingPoolRequest{PoolId: res.PoolID})
+	suite.Require().NoError(err)
+	suite.Require().True(poolRes.SaleEnded)
+	suite.Require().Equal(poolRes.Weights[0].Weight, poolRes.Weights[1].Weight)
+}
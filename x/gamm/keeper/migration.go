@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+// GetMigrationRecords returns the links between the gamm pools liquidity can be migrated from,
+// and the gamm or concentrated liquidity pools it is migrated to.
+func (k Keeper) GetMigrationRecords(ctx sdk.Context) (types.MigrationRecords, error) {
+	store := ctx.KVStore(k.storeKey)
+	records := types.MigrationRecords{}
+	_, err := osmoutils.Get(store, types.KeyMigrationInfo, &records)
+	return records, err
+}
+
+func (k Keeper) setMigrationRecords(ctx sdk.Context, records types.MigrationRecords) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyMigrationInfo, &records)
+}
+
+// ReplaceMigrationRecords replaces all the migration records with the given links.
+func (k Keeper) ReplaceMigrationRecords(ctx sdk.Context, links []types.PoolMigrationLink) error {
+	records := types.MigrationRecords{PoolMigrationLinks: links}
+	if err := k.validateMigrationRecords(ctx, records); err != nil {
+		return err
+	}
+
+	k.setMigrationRecords(ctx, records)
+	return nil
+}
+
+// UpdateMigrationRecords adds, modifies or removes the links of the old pools of the given links,
+// leaving the other migration records unchanged. A link with a new pool id of 0 removes the link of its old pool.
+func (k Keeper) UpdateMigrationRecords(ctx sdk.Context, links []types.PoolMigrationLink) error {
+	records, err := k.GetMigrationRecords(ctx)
+	if err != nil {
+		return err
+	}
+
+	records = records.Update(links)
+	if err := k.validateMigrationRecords(ctx, records); err != nil {
+		return err
+	}
+
+	k.setMigrationRecords(ctx, records)
+	return nil
+}
+
+// validateMigrationRecords checks that the records are valid, and that every link maps
+// a gamm pool to a gamm or concentrated liquidity pool holding the same assets.
+func (k Keeper) validateMigrationRecords(ctx sdk.Context, records types.MigrationRecords) error {
+	if err := records.Validate(); err != nil {
+		return err
+	}
+
+	for _, link := range records.PoolMigrationLinks {
+		oldPool, err := k.GetPoolAndPoke(ctx, link.OldPoolId)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidMigrationRecords, "old pool: %s", err)
+		}
+		newDenoms, _, err := k.getNewPoolDenoms(ctx, link.NewPoolId)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidMigrationRecords, "new pool: %s", err)
+		}
+
+		oldDenoms := osmoutils.CoinsDenoms(oldPool.GetTotalPoolLiquidity(ctx))
+		if !slices.Equal(oldDenoms, newDenoms) {
+			return sdkerrors.Wrapf(types.ErrInvalidMigrationRecords, "pool %d holds %v, but pool %d holds %v",
+				link.OldPoolId, oldDenoms, link.NewPoolId, newDenoms)
+		}
+	}
+	return nil
+}
+
+// getNewPoolDenoms returns the sorted denoms of the gamm or concentrated liquidity pool with the given id,
+// and whether it is a concentrated liquidity pool.
+func (k Keeper) getNewPoolDenoms(ctx sdk.Context, poolId uint64) (denoms []string, isConcentrated bool, err error) {
+	if ctx.KVStore(k.storeKey).Has(types.GetKeyPrefixPools(poolId)) {
+		pool, err := k.GetPoolAndPoke(ctx, poolId)
+		if err != nil {
+			return nil, false, err
+		}
+		return osmoutils.CoinsDenoms(pool.GetTotalPoolLiquidity(ctx)), false, nil
+	}
+
+	clPool, err := k.clKeeper.GetConcentratedPoolById(ctx, poolId)
+	if err != nil {
+		return nil, false, err
+	}
+	denoms = []string{clPool.GetToken0(), clPool.GetToken1()}
+	sort.Strings(denoms)
+	return denoms, true, nil
+}
+
+// getMigrationPoolIds returns the pool the given shares belong to, and the pool they are migrated to.
+func (k Keeper) getMigrationPoolIds(ctx sdk.Context, sharesDenom string) (poolIdLeaving, poolIdEntering uint64, err error) {
+	if err := types.ValidatePoolShareDenom(sharesDenom); err != nil {
+		return 0, 0, fmt.Errorf("%s is not a pool share denom", sharesDenom)
+	}
+	poolIdLeaving = types.MustGetPoolIdFromShareDenom(sharesDenom)
+	if types.GetPoolShareDenom(poolIdLeaving) != sharesDenom {
+		return 0, 0, fmt.Errorf("%s is not a pool share denom", sharesDenom)
+	}
+
+	records, err := k.GetMigrationRecords(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	poolIdEntering, found := records.GetNewPoolId(poolIdLeaving)
+	if !found {
+		return 0, 0, sdkerrors.Wrapf(types.ErrNoMigrationRecord, "pool id %d", poolIdLeaving)
+	}
+	return poolIdLeaving, poolIdEntering, nil
+}
+
+// MigrateShares migrates sharesToMigrate of a gamm pool held by the sender to the pool it is linked to by
+// the migration records. The shares are burnt, and the liquidity they account for is exited from the old pool
+// without charging its exit fee. It is then joined into the new pool without swapping if it is a gamm pool,
+// or deposited into a full range position of the sender if it is a concentrated liquidity pool, in which case
+// shareOutMinAmount bounds the liquidity created. The tokens the new pool can not use, as the ratios of the
+// pools may differ, are left to the sender.
+// Returns the id of the new pool, the amount of its shares minted to the sender if it is a gamm pool,
+// and the liquidity added to the position of the sender if it is a concentrated liquidity pool.
+func (k Keeper) MigrateShares(ctx sdk.Context, sender sdk.AccAddress, sharesToMigrate sdk.Coin, shareOutMinAmount sdk.Int) (poolIdEntering uint64, sharesOut sdk.Int, liquidityCreated sdk.Dec, err error) {
+	poolIdLeaving, poolIdEntering, err := k.getMigrationPoolIds(ctx, sharesToMigrate.Denom)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	oldPool, err := k.GetPoolAndPoke(ctx, poolIdLeaving)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Dec{}, err
+	}
+	if sharesToMigrate.Amount.GTE(oldPool.GetTotalShares()) {
+		return 0, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "Trying to exit >= the number of shares contained in the pool.")
+	}
+	exitCoins, err := oldPool.ExitPool(ctx, sharesToMigrate.Amount, sdk.ZeroDec())
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Dec{}, err
+	}
+	err = k.applyExitPoolStateChange(ctx, oldPool, sender, sharesToMigrate.Amount, exitCoins)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	_, isConcentrated, err := k.getNewPoolDenoms(ctx, poolIdEntering)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Dec{}, err
+	}
+	if isConcentrated {
+		liquidityCreated, err = k.migrateToConcentratedPool(ctx, sender, poolIdEntering, exitCoins, shareOutMinAmount)
+		return poolIdEntering, sdk.ZeroInt(), liquidityCreated, err
+	}
+
+	newPool, err := k.GetPoolAndPoke(ctx, poolIdEntering)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Dec{}, err
+	}
+	if err := k.validateJoin(ctx, newPool, sender); err != nil {
+		return 0, sdk.Int{}, sdk.Dec{}, err
+	}
+	swapFee := newPool.GetSwapFee(ctx)
+	sharesOut, tokensJoined, err := newPool.CalcJoinPoolNoSwapShares(ctx, exitCoins, swapFee)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Dec{}, err
+	}
+	if sharesOut.LT(shareOutMinAmount) {
+		return 0, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrLimitMinAmount,
+			"Migration returned %s shares, minimum shares out specified as %s", sharesOut, shareOutMinAmount)
+	}
+	if _, err := newPool.JoinPoolNoSwap(ctx, exitCoins, swapFee); err != nil {
+		return 0, sdk.Int{}, sdk.Dec{}, err
+	}
+	err = k.applyJoinPoolStateChange(ctx, newPool, sender, sharesOut, tokensJoined)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	return poolIdEntering, sharesOut, sdk.ZeroDec(), nil
+}
+
+// migrateToConcentratedPool deposits the given coins exited from the old pool into a full range position
+// of the sender in the concentrated liquidity pool with the given id.
+// Returns the liquidity added to the position.
+func (k Keeper) migrateToConcentratedPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, exitCoins sdk.Coins, liquidityMinAmount sdk.Int) (sdk.Dec, error) {
+	_, _, liquidityCreated, err := k.clKeeper.CreateFullRangePosition(ctx, poolId, sender, exitCoins)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if liquidityCreated.TruncateInt().LT(liquidityMinAmount) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrLimitMinAmount,
+			"Migration created %s liquidity, minimum liquidity specified as %s", liquidityCreated, liquidityMinAmount)
+	}
+	return liquidityCreated, nil
+}
+
+// MigrateLockedShares migrates the gamm pool shares of the given lock to the pool they are linked to by the
+// migration records, as MigrateShares does. The lock keeps its ID, duration and end time, and holds the new
+// pool shares afterwards. As concentrated liquidity positions can not be locked, the shares must be linked
+// to a gamm pool.
+// Returns the id of the new pool, and the amount of its shares locked.
+func (k Keeper) MigrateLockedShares(ctx sdk.Context, sender sdk.AccAddress, lockID uint64, shareOutMinAmount sdk.Int) (poolIdEntering uint64, sharesOut sdk.Int, err error) {
+	_, err = k.lockupKeeper.ConvertLockTokens(ctx, lockID, sender, func(lockedCoins sdk.Coins) (sdk.Coins, error) {
+		if len(lockedCoins) != 1 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lock %d must hold the shares of a single pool, got %s", lockID, lockedCoins)
+		}
+
+		_, newPoolId, err := k.getMigrationPoolIds(ctx, lockedCoins[0].Denom)
+		if err != nil {
+			return nil, err
+		}
+		if _, isConcentrated, err := k.getNewPoolDenoms(ctx, newPoolId); err != nil {
+			return nil, err
+		} else if isConcentrated {
+			return nil, sdkerrors.Wrapf(types.ErrLockedMigrationToConcentrated, "pool %d", newPoolId)
+		}
+
+		poolIdEntering, sharesOut, _, err = k.MigrateShares(ctx, sender, lockedCoins[0], shareOutMinAmount)
+		if err != nil {
+			return nil, err
+		}
+		return sdk.NewCoins(sdk.NewCoin(types.GetPoolShareDenom(poolIdEntering), sharesOut)), nil
+	})
+	if err != nil {
+		return 0, sdk.Int{}, err
+	}
+
+	return poolIdEntering, sharesOut, nil
+}
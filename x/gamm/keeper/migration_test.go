@@ -0,0 +1,289 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/testutils/apptesting"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v15/x/lockup/types"
+)
+
+// prepareMigrationPools creates a balancer pool and a stableswap pool holding the same assets,
+// and links the balancer pool to the stableswap pool.
+func (suite *KeeperTestSuite) prepareMigrationPools() (oldPoolId, newPoolId uint64) {
+	oldPoolId = suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.NewDecWithPrec(1, 2),
+	})
+	newPoolId = suite.PrepareBasicStableswapPool()
+	err := suite.App.GAMMKeeper.ReplaceMigrationRecords(suite.Ctx, []types.PoolMigrationLink{{OldPoolId: oldPoolId, NewPoolId: newPoolId}})
+	suite.Require().NoError(err)
+	return oldPoolId, newPoolId
+}
+
+func (suite *KeeperTestSuite) TestReplaceMigrationRecords() {
+	tests := map[string]struct {
+		links       func(balancerPoolId, stableswapPoolId, otherPoolId uint64) []types.PoolMigrationLink
+		expectedErr error
+	}{
+		"balancer pool to stableswap pool": {
+			links: func(balancerPoolId, stableswapPoolId, _ uint64) []types.PoolMigrationLink {
+				return []types.PoolMigrationLink{{OldPoolId: balancerPoolId, NewPoolId: stableswapPoolId}}
+			},
+		},
+		"no links": {
+			links: func(_, _, _ uint64) []types.PoolMigrationLink { return nil },
+		},
+		"pools hold different assets": {
+			links: func(balancerPoolId, _, otherPoolId uint64) []types.PoolMigrationLink {
+				return []types.PoolMigrationLink{{OldPoolId: balancerPoolId, NewPoolId: otherPoolId}}
+			},
+			expectedErr: types.ErrInvalidMigrationRecords,
+		},
+		"new pool does not exist": {
+			links: func(balancerPoolId, _, _ uint64) []types.PoolMigrationLink {
+				return []types.PoolMigrationLink{{OldPoolId: balancerPoolId, NewPoolId: 100}}
+			},
+			expectedErr: types.ErrInvalidMigrationRecords,
+		},
+		"pool migrated to twice": {
+			links: func(balancerPoolId, stableswapPoolId, otherPoolId uint64) []types.PoolMigrationLink {
+				return []types.PoolMigrationLink{{OldPoolId: balancerPoolId, NewPoolId: stableswapPoolId}, {OldPoolId: otherPoolId, NewPoolId: stableswapPoolId}}
+			},
+			expectedErr: types.ErrInvalidMigrationRecords,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			balancerPoolId := suite.PrepareBalancerPool()
+			stableswapPoolId := suite.PrepareBasicStableswapPool()
+			otherPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 100), sdk.NewInt64Coin("bar", 100))
+			links := tc.links(balancerPoolId, stableswapPoolId, otherPoolId)
+
+			msg := types.MsgReplaceMigrationRecords{Authority: suite.App.GAMMKeeper.GetAuthority(), Records: links}
+			_, err := keeper.NewMsgServerImpl(suite.App.GAMMKeeper).ReplaceMigrationRecords(sdk.WrapSDKContext(suite.Ctx), &msg)
+
+			records, recordsErr := suite.App.GAMMKeeper.GetMigrationRecords(suite.Ctx)
+			suite.Require().NoError(recordsErr)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				suite.Require().Empty(records.PoolMigrationLinks)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(links, records.PoolMigrationLinks)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateMigrationRecords() {
+	suite.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(suite.App.GAMMKeeper)
+	oldPoolId, newPoolId := suite.prepareMigrationPools()
+	otherPoolId := suite.PrepareBalancerPool()
+
+	// only the authority can update the migration records
+	msg := types.MsgUpdateMigrationRecords{
+		Authority: suite.TestAccs[0].String(),
+		Records:   []types.PoolMigrationLink{{OldPoolId: otherPoolId, NewPoolId: oldPoolId}},
+	}
+	_, err := msgServer.UpdateMigrationRecords(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	msg.Authority = suite.App.GAMMKeeper.GetAuthority()
+	_, err = msgServer.UpdateMigrationRecords(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+	records, err := suite.App.GAMMKeeper.GetMigrationRecords(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PoolMigrationLink{
+		{OldPoolId: oldPoolId, NewPoolId: newPoolId},
+		{OldPoolId: otherPoolId, NewPoolId: oldPoolId},
+	}, records.PoolMigrationLinks)
+
+	// a link to pool 0 removes the link of its old pool
+	msg.Records = []types.PoolMigrationLink{{OldPoolId: oldPoolId, NewPoolId: 0}}
+	_, err = msgServer.UpdateMigrationRecords(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+	records, err = suite.App.GAMMKeeper.GetMigrationRecords(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PoolMigrationLink{{OldPoolId: otherPoolId, NewPoolId: oldPoolId}}, records.PoolMigrationLinks)
+}
+
+func (suite *KeeperTestSuite) TestMigrateShares() {
+	tests := map[string]struct {
+		noRecord          bool
+		shareOutMinAmount sdk.Int
+		expectedErr       error
+	}{
+		"migrate shares": {
+			shareOutMinAmount: sdk.OneInt(),
+		},
+		"no migration record": {
+			noRecord:          true,
+			shareOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrNoMigrationRecord,
+		},
+		"fewer shares out than the minimum": {
+			shareOutMinAmount: sdk.NewIntFromBigInt(types.InitPoolSharesSupply.BigInt()),
+			expectedErr:       types.ErrLimitMinAmount,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			sender := suite.TestAccs[0]
+			oldPoolId, newPoolId := suite.prepareMigrationPools()
+			if tc.noRecord {
+				err := suite.App.GAMMKeeper.ReplaceMigrationRecords(suite.Ctx, nil)
+				suite.Require().NoError(err)
+			}
+
+			oldShareDenom := types.GetPoolShareDenom(oldPoolId)
+			oldShares := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, oldShareDenom)
+			sharesToMigrate := sdk.NewCoin(oldShareDenom, oldShares.Amount.QuoRaw(2))
+			oldPool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, oldPoolId)
+			suite.Require().NoError(err)
+			// the exit fee of the old pool is not charged
+			exitCoins, err := oldPool.CalcExitPoolCoinsFromShares(suite.Ctx, sharesToMigrate.Amount, sdk.ZeroDec())
+			suite.Require().NoError(err)
+			newPoolLiquidityBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.getPoolAddress(newPoolId))
+			senderBalancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+
+			msg := types.MsgMigrateShares{Sender: sender.String(), SharesToMigrate: sharesToMigrate, ShareOutMinAmount: tc.shareOutMinAmount}
+			res, err := keeper.NewMsgServerImpl(suite.App.GAMMKeeper).MigrateShares(sdk.WrapSDKContext(suite.Ctx), &msg)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtMigrateShares, 1)
+			suite.Require().Equal(newPoolId, res.PoolIdEntering)
+			suite.Require().True(res.ShareOutAmount.IsPositive())
+
+			senderBalances := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Require().Equal(oldShares.Amount.Sub(sharesToMigrate.Amount), senderBalances.AmountOf(oldShareDenom))
+			newShareDenom := types.GetPoolShareDenom(newPoolId)
+			suite.Require().Equal(senderBalancesBefore.AmountOf(newShareDenom).Add(res.ShareOutAmount), senderBalances.AmountOf(newShareDenom))
+
+			// the exited tokens are either joined into the new pool, or left to the sender
+			newPoolLiquidity := suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.getPoolAddress(newPoolId))
+			for _, coin := range exitCoins {
+				joined := newPoolLiquidity.AmountOf(coin.Denom).Sub(newPoolLiquidityBefore.AmountOf(coin.Denom))
+				refunded := senderBalances.AmountOf(coin.Denom).Sub(senderBalancesBefore.AmountOf(coin.Denom))
+				suite.Require().Equal(coin.Amount, joined.Add(refunded))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrateLockedShares() {
+	for _, unlocking := range []bool{false, true} {
+		suite.SetupTest()
+		msgServer := keeper.NewMsgServerImpl(suite.App.GAMMKeeper)
+		sender := suite.TestAccs[0]
+		oldPoolId, newPoolId := suite.prepareMigrationPools()
+
+		oldShareDenom := types.GetPoolShareDenom(oldPoolId)
+		oldShares := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, oldShareDenom)
+		newShares := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, types.GetPoolShareDenom(newPoolId))
+		lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, sender, sdk.NewCoins(sdk.NewCoin(oldShareDenom, oldShares.Amount.QuoRaw(2))), 24*time.Hour)
+		suite.Require().NoError(err)
+		if unlocking {
+			_, err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+			suite.Require().NoError(err)
+		}
+		lockBefore, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+		suite.Require().NoError(err)
+
+		// only the owner of the lock can migrate it
+		msg := types.MsgMigrateLockedShares{Sender: suite.TestAccs[1].String(), LockId: lock.ID, ShareOutMinAmount: sdk.OneInt()}
+		_, err = msgServer.MigrateLockedShares(sdk.WrapSDKContext(suite.Ctx), &msg)
+		suite.Require().ErrorIs(err, lockuptypes.ErrNotLockOwner)
+
+		msg.Sender = sender.String()
+		res, err := msgServer.MigrateLockedShares(sdk.WrapSDKContext(suite.Ctx), &msg)
+		suite.Require().NoError(err)
+		suite.Require().Equal(newPoolId, res.PoolIdEntering)
+
+		// the lock is migrated in place
+		lockAfter, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+		suite.Require().NoError(err)
+		suite.Require().Equal(lockBefore.Duration, lockAfter.Duration)
+		suite.Require().Equal(lockBefore.EndTime, lockAfter.EndTime)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(types.GetPoolShareDenom(newPoolId), res.ShareOutAmount)), lockAfter.Coins)
+
+		// the shares held by the sender are left untouched
+		suite.Require().Equal(oldShares.Amount.Sub(lockBefore.Coins.AmountOf(oldShareDenom)), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, oldShareDenom).Amount)
+		suite.Require().Equal(newShares, suite.App.BankKeeper.GetBalance(suite.Ctx, sender, types.GetPoolShareDenom(newPoolId)))
+	}
+}
+
+// TestMigrateSharesToConcentratedPool tests that shares linked to a concentrated liquidity pool are
+// migrated into a full range position of the sender, and that locked shares can not be linked to one.
+func (suite *KeeperTestSuite) TestMigrateSharesToConcentratedPool() {
+	suite.SetupTest()
+	gammKeeper := suite.App.GAMMKeeper
+	msgServer := keeper.NewMsgServerImpl(gammKeeper)
+	sender := suite.TestAccs[0]
+	coins := sdk.NewCoins(sdk.NewInt64Coin(apptesting.ETH, 1000000), sdk.NewInt64Coin(apptesting.USDC, 5000000000))
+	oldPoolId := suite.PrepareBalancerPoolWithCoins(coins...)
+	clPool := suite.PrepareConcentratedPoolWithCoinsAndFullRangePosition(apptesting.ETH, apptesting.USDC, sdk.ZeroDec(), coins)
+	otherClPool := suite.PrepareCustomConcentratedPool(sender, apptesting.ETH, "foo", apptesting.DefaultTickSpacing, apptesting.DefaultExponentAtPriceOne, sdk.ZeroDec())
+
+	// the concentrated liquidity pool must hold the same assets.
+	err := gammKeeper.ReplaceMigrationRecords(suite.Ctx, []types.PoolMigrationLink{{OldPoolId: oldPoolId, NewPoolId: otherClPool.GetId()}})
+	suite.Require().ErrorIs(err, types.ErrInvalidMigrationRecords)
+	err = gammKeeper.ReplaceMigrationRecords(suite.Ctx, []types.PoolMigrationLink{{OldPoolId: oldPoolId, NewPoolId: clPool.GetId()}})
+	suite.Require().NoError(err)
+
+	oldShareDenom := types.GetPoolShareDenom(oldPoolId)
+	oldShares := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, oldShareDenom)
+	sharesToMigrate := sdk.NewCoin(oldShareDenom, oldShares.Amount.QuoRaw(4))
+	positionsBefore, err := suite.App.ConcentratedLiquidityKeeper.GetUserPositions(suite.Ctx, sender, clPool.GetId())
+	suite.Require().NoError(err)
+	suite.Require().Len(positionsBefore, 1)
+
+	// the minimum bounds the liquidity created.
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	msg := types.MsgMigrateShares{Sender: sender.String(), SharesToMigrate: sharesToMigrate, ShareOutMinAmount: sdk.NewInt(1e12)}
+	_, err = msgServer.MigrateShares(sdk.WrapSDKContext(cacheCtx), &msg)
+	suite.Require().ErrorIs(err, types.ErrLimitMinAmount)
+
+	msg.ShareOutMinAmount = sdk.OneInt()
+	res, err := msgServer.MigrateShares(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, types.TypeEvtMigrateShares, 1)
+	suite.Require().Equal(clPool.GetId(), res.PoolIdEntering)
+	suite.Require().True(res.ShareOutAmount.IsZero())
+	suite.Require().True(res.LiquidityCreated.IsPositive())
+	suite.Require().Equal(oldShares.Amount.Sub(sharesToMigrate.Amount), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, oldShareDenom).Amount)
+
+	// the liquidity is added to the full range position of the sender.
+	positions, err := suite.App.ConcentratedLiquidityKeeper.GetUserPositions(suite.Ctx, sender, clPool.GetId())
+	suite.Require().NoError(err)
+	suite.Require().Len(positions, 1)
+	suite.Require().Equal(positionsBefore[0].LowerTick, positions[0].LowerTick)
+	suite.Require().Equal(positionsBefore[0].UpperTick, positions[0].UpperTick)
+	suite.Require().Equal(positionsBefore[0].Liquidity.Add(res.LiquidityCreated), positions[0].Liquidity)
+
+	// locked shares can not be migrated to a concentrated liquidity pool.
+	lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, sender, sdk.NewCoins(sharesToMigrate), 24*time.Hour)
+	suite.Require().NoError(err)
+	lockedMsg := types.MsgMigrateLockedShares{Sender: sender.String(), LockId: lock.ID, ShareOutMinAmount: sdk.OneInt()}
+	_, err = msgServer.MigrateLockedShares(sdk.WrapSDKContext(suite.Ctx), &lockedMsg)
+	suite.Require().ErrorIs(err, types.ErrLockedMigrationToConcentrated)
+}
+
+func (suite *KeeperTestSuite) getPoolAddress(poolId uint64) sdk.AccAddress {
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	return pool.GetAddress()
+}
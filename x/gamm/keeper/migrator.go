@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from version 1 to 2, indexing the existing pools by the denoms
+// and the denom pairs they hold. Pools are indexed in pool id order, so that the oldest pool of
+// each denom pair becomes its canonical pool. The params added since version 1 are set to their
+// defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaultParams := types.DefaultParams()
+	m.keeper.paramSpace.Set(ctx, types.KeyGovernorFeeBounds, defaultParams.GovernorFeeBounds)
+	m.keeper.paramSpace.Set(ctx, types.KeyLiquidityBootstrappingBounds, defaultParams.LiquidityBootstrappingBounds)
+	m.keeper.paramSpace.Set(ctx, types.KeyFeeTiers, defaultParams.FeeTiers)
+	m.keeper.paramSpace.Set(ctx, types.KeyStatsEpochIdentifier, defaultParams.StatsEpochIdentifier)
+	m.keeper.paramSpace.Set(ctx, types.KeyStatsHistoryLength, defaultParams.StatsHistoryLength)
+
+	pools, err := m.keeper.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		m.keeper.indexPool(ctx, pool)
+	}
+	return nil
+}
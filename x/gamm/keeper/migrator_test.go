@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2Params() {
+	suite.SetupTest()
+
+	// Drop the params added after version 1, as on a chain that has not been migrated yet.
+	paramStore := prefix.NewStore(suite.Ctx.KVStore(suite.App.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramStore.Delete(types.KeyGovernorFeeBounds)
+	paramStore.Delete(types.KeyLiquidityBootstrappingBounds)
+	paramStore.Delete(types.KeyFeeTiers)
+	paramStore.Delete(types.KeyStatsEpochIdentifier)
+	paramStore.Delete(types.KeyStatsHistoryLength)
+	suite.Require().Panics(func() { suite.App.GAMMKeeper.GetParams(suite.Ctx) })
+
+	err := keeper.NewMigrator(*suite.App.GAMMKeeper).Migrate1to2(suite.Ctx)
+	suite.Require().NoError(err)
+
+	params := suite.App.GAMMKeeper.GetParams(suite.Ctx)
+	defaultParams := types.DefaultParams()
+	suite.Require().Equal(defaultParams.GovernorFeeBounds, params.GovernorFeeBounds)
+	suite.Require().Equal(defaultParams.LiquidityBootstrappingBounds, params.LiquidityBootstrappingBounds)
+	suite.Require().Equal(defaultParams.FeeTiers, params.FeeTiers)
+	suite.Require().Equal(defaultParams.StatsEpochIdentifier, params.StatsEpochIdentifier)
+	suite.Require().Equal(defaultParams.StatsHistoryLength, params.StatsHistoryLength)
+}
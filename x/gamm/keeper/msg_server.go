@@ -2,13 +2,15 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
-	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
 
 type msgServer struct {
@@ -27,9 +29,16 @@ func NewBalancerMsgServerImpl(keeper *Keeper) balancer.MsgServer {
 	}
 }
 
+func NewStableswapMsgServerImpl(keeper *Keeper) stableswap.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
 var (
-	_ types.MsgServer    = msgServer{}
-	_ balancer.MsgServer = msgServer{}
+	_ types.MsgServer      = msgServer{}
+	_ balancer.MsgServer   = msgServer{}
+	_ stableswap.MsgServer = msgServer{}
 )
 
 // CreateBalancerPool is a create balancer pool message.
@@ -37,66 +46,215 @@ func (server msgServer) CreateBalancerPool(goCtx context.Context, msg *balancer.
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	params := server.keeper.GetParams(ctx)
 
-	// validate the pool contains asset which is whitelisted
-	found := false
+	// set global fees
+	if params.EnableGlobalPoolFees {
+		msg.PoolParams.SwapFee = params.GlobalFees.SwapFee
+		msg.PoolParams.ExitFee = params.GlobalFees.ExitFee
+	}
+
+	denoms := make([]string, 0, len(msg.PoolAssets))
 	for _, asset := range msg.PoolAssets {
-		if ok, _ := params.PoolCreationFee.Find(asset.Token.Denom); ok {
-			found = true
-			break
-		}
+		denoms = append(denoms, asset.Token.Denom)
 	}
-	if !found {
-		return nil, types.ErrPoolAssetNotAllowed
+	if err := server.keeper.validatePoolCreationDenoms(ctx, denoms, msg.PoolParams.SwapFee); err != nil {
+		return nil, err
 	}
 
+	poolId, err := server.keeper.CreatePool(goCtx, msg)
+	return &balancer.MsgCreateBalancerPoolResponse{PoolID: poolId}, err
+}
+
+// CreateStableswapPool is a create stableswap pool message.
+func (server msgServer) CreateStableswapPool(goCtx context.Context, msg *stableswap.MsgCreateStableswapPool) (*stableswap.MsgCreateStableswapPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := server.keeper.GetParams(ctx)
+
 	// set global fees
 	if params.EnableGlobalPoolFees {
 		msg.PoolParams.SwapFee = params.GlobalFees.SwapFee
 		msg.PoolParams.ExitFee = params.GlobalFees.ExitFee
 	}
 
-	// validate uniqueness of pool assets
-	poolAlreadyExists := false
-	iter := server.keeper.iterator(ctx, types.KeyPrefixPools)
-	defer iter.Close() //nolint:errcheck
+	if err := server.keeper.validatePoolCreationDenoms(ctx, osmoutils.CoinsDenoms(msg.InitialPoolLiquidity), msg.PoolParams.SwapFee); err != nil {
+		return nil, err
+	}
 
-	for ; iter.Valid(); iter.Next() {
-		pool, err := server.keeper.UnmarshalPool(iter.Value())
-		if err != nil {
-			return nil, err
-		}
+	poolId, err := server.keeper.CreatePool(goCtx, msg)
+	return &stableswap.MsgCreateStableswapPoolResponse{PoolID: poolId}, err
+}
 
-		existingPoolDenoms := osmoutils.CoinsDenoms(pool.GetTotalPoolLiquidity(ctx))
-		sameAssets := true
-		for _, asset := range msg.PoolAssets {
-			if contains(existingPoolDenoms, asset.Token.Denom) {
-				continue
-			}
-			sameAssets = false
-			break
-		}
-		if sameAssets {
-			poolAlreadyExists = true
-			break
-		}
+// StableSwapAdjustScalingFactors adjusts the scaling factors of a stableswap pool.
+// The sender must be the pool's scaling factor controller.
+func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, msg *stableswap.MsgStableSwapAdjustScalingFactors) (*stableswap.MsgStableSwapAdjustScalingFactorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.setStableSwapScalingFactors(ctx, msg.PoolID, msg.ScalingFactors, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
+}
+
+// StableSwapRampAmplification schedules a change of the amplification of a stableswap pool.
+func (server msgServer) StableSwapRampAmplification(goCtx context.Context, msg *stableswap.MsgStableSwapRampAmplification) (*stableswap.MsgStableSwapRampAmplificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if server.keeper.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", server.keeper.authority, msg.Authority)
 	}
 
-	if poolAlreadyExists {
-		return nil, types.ErrPoolAlreadyExists
+	rampParams, err := server.keeper.rampStableSwapAmplification(ctx, msg.PoolID, msg.TargetAmplification, msg.StartTime, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtAmplificationRamp,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolID, 10)),
+			sdk.NewAttribute(types.AttributeKeyTargetAmplification, strconv.FormatUint(rampParams.TargetAmplification, 10)),
+			sdk.NewAttribute(types.AttributeKeyRampStartTime, rampParams.StartTime.String()),
+			sdk.NewAttribute(types.AttributeKeyRampEndTime, rampParams.EndTime.String()),
+		),
+	})
+
+	return &stableswap.MsgStableSwapRampAmplificationResponse{}, nil
+}
+
+// ScheduleWeightChange schedules a smooth change of the weights of a balancer pool.
+// The sender must be the pool's governor.
+func (server msgServer) ScheduleWeightChange(goCtx context.Context, msg *balancer.MsgScheduleWeightChange) (*balancer.MsgScheduleWeightChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := server.keeper.scheduleBalancerWeightChange(ctx, msg.PoolID, msg.SmoothWeightChangeParams, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtWeightChange,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolID, 10)),
+			sdk.NewAttribute(types.AttributeKeyWeightChangeStartTime, params.StartTime.String()),
+			sdk.NewAttribute(types.AttributeKeyWeightChangeDuration, params.Duration.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &balancer.MsgScheduleWeightChangeResponse{}, nil
+}
+
+// SetPoolFees sets the swap and exit fees of a balancer pool, within the governor fee bounds.
+// The sender must be the pool's governor.
+func (server msgServer) SetPoolFees(goCtx context.Context, msg *balancer.MsgSetPoolFees) (*balancer.MsgSetPoolFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.setBalancerPoolFees(ctx, msg.PoolID, msg.SwapFee, msg.ExitFee, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolFeesSet,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolID, 10)),
+			sdk.NewAttribute(types.AttributeKeySwapFee, msg.SwapFee.String()),
+			sdk.NewAttribute(types.AttributeKeyExitFee, msg.ExitFee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &balancer.MsgSetPoolFeesResponse{}, nil
+}
+
+// SetSwapsPaused pauses or unpauses swaps against a balancer pool.
+// The sender must be the pool's governor.
+func (server msgServer) SetSwapsPaused(goCtx context.Context, msg *balancer.MsgSetSwapsPaused) (*balancer.MsgSetSwapsPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.setBalancerSwapsPaused(ctx, msg.PoolID, msg.Paused, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSwapsPausedSet,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolID, 10)),
+			sdk.NewAttribute(types.AttributeKeySwapsPaused, strconv.FormatBool(msg.Paused)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &balancer.MsgSetSwapsPausedResponse{}, nil
+}
+
+// CreateLiquidityBootstrappingPool creates a two-asset balancer pool whose weights change smoothly over its sale,
+// during which only the sender can join it. The sale must be within the liquidity bootstrapping bounds.
+func (server msgServer) CreateLiquidityBootstrappingPool(goCtx context.Context, msg *balancer.MsgCreateLiquidityBootstrappingPool) (*balancer.MsgCreateLiquidityBootstrappingPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := server.keeper.GetParams(ctx)
+
+	denoms := make([]string, 0, len(msg.PoolAssets))
+	startWeights := make([]sdk.Int, 0, len(msg.PoolAssets))
+	for _, asset := range msg.PoolAssets {
+		denoms = append(denoms, asset.Token.Denom)
+		startWeights = append(startWeights, asset.Weight)
+	}
+	// set global fees
+	if params.EnableGlobalPoolFees {
+		msg.SwapFee = params.GlobalFees.SwapFee
+		msg.ExitFee = params.GlobalFees.ExitFee
+	}
+
+	if err := server.keeper.validatePoolCreationDenoms(ctx, denoms, msg.SwapFee); err != nil {
+		return nil, err
+	}
+	if err := params.LiquidityBootstrappingBounds.ValidateSale(startWeights, msg.SmoothWeightChangeParams.Duration); err != nil {
+		return nil, err
 	}
 
 	poolId, err := server.keeper.CreatePool(goCtx, msg)
-	return &balancer.MsgCreateBalancerPoolResponse{PoolID: poolId}, err
+	return &balancer.MsgCreateLiquidityBootstrappingPoolResponse{PoolID: poolId}, err
 }
 
-// Function to check if a slice contains a string
-func contains(slice []string, str string) bool {
-	for _, v := range slice {
-		if v == str {
-			return true
+// validatePoolCreationDenoms validates that the pool contains at least one whitelisted asset.
+// Pools holding the same assets must have distinct swap fees, and a pool holding the same assets
+// as an existing pool must have a swap fee from the fee tiers.
+func (k Keeper) validatePoolCreationDenoms(ctx sdk.Context, denoms []string, swapFee sdk.Dec) error {
+	params := k.GetParams(ctx)
+
+	// validate the pool contains asset which is whitelisted
+	found := false
+	for _, denom := range denoms {
+		if ok, _ := params.PoolCreationFee.Find(denom); ok {
+			found = true
+			break
 		}
 	}
-	return false
+	if !found {
+		return types.ErrPoolAssetNotAllowed
+	}
+
+	return k.validatePoolFeeTier(ctx, 0, denoms, swapFee)
 }
 
 // JoinPool routes `JoinPoolNoSwap` where we do an abstract calculation on needed lp liquidity coins to get the designated
@@ -119,6 +277,10 @@ func (server msgServer) JoinPool(goCtx context.Context, msg *types.MsgJoinPool)
 		return nil, err
 	}
 
+	if err := server.keeper.CheckSpotPriceBounds(ctx, msg.PoolId, msg.SpotPriceBounds); err != nil {
+		return nil, err
+	}
+
 	neededLp, sharesOut, err := server.keeper.JoinPoolNoSwap(ctx, sender, msg.PoolId, msg.ShareOutAmount, msg.TokenInMaxs)
 	if err != nil {
 		return nil, err
@@ -146,6 +308,10 @@ func (server msgServer) ExitPool(goCtx context.Context, msg *types.MsgExitPool)
 		return nil, err
 	}
 
+	if err := server.keeper.CheckSpotPriceBounds(ctx, msg.PoolId, msg.SpotPriceBounds); err != nil {
+		return nil, err
+	}
+
 	exitCoins, err := server.keeper.ExitPool(ctx, sender, msg.PoolId, msg.ShareInAmount, msg.TokenOutMins)
 	if err != nil {
 		return nil, err
@@ -172,25 +338,8 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 		return nil, err
 	}
 
-	takerFee := server.keeper.GetParams(ctx).TakerFee
-	tokenInAfterSubTakerFee, takerFeesCoins := server.keeper.SubTakerFee(msg.TokenIn, takerFee)
-
-	tokenOutAmount, err := server.keeper.poolManager.RouteExactAmountIn(ctx, sender, msg.Routes, tokenInAfterSubTakerFee, msg.TokenOutMinAmount)
-	if err != nil {
-		return nil, err
-	}
-
-	// first pool for taker fee swaps if needed
-	takerFeeRoute := msg.Routes[0]
-
-	// If the IN denom is a RollApp, we reward the IN RollApp owner.
-	// Otherwise, if the OUT denom is a RollApp, we reward the OUT RollApp owner.
-	// OUT denom is the last route's token out denom.
-	outDenom := msg.Routes[len(msg.Routes)-1].TokenOutDenom
-
-	beneficiary := server.keeper.getTakerFeeBeneficiary(ctx, msg.TokenIn.Denom, outDenom)
-
-	err = server.keeper.chargeTakerFee(ctx, takerFeesCoins, sender, takerFeeRoute, beneficiary)
+	// Routing and taker fee charging are handled by the pool manager.
+	tokenOutAmount, err := server.keeper.poolManager.SwapExactAmountInWithTakerFee(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount, sdk.Dec{})
 	if err != nil {
 		return nil, err
 	}
@@ -211,47 +360,14 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 
 func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.MsgSwapExactAmountOut) (*types.MsgSwapExactAmountOutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	takerFee := server.keeper.GetParams(ctx).TakerFee
 
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	route := types.SwapAmountOutRoutes(msg.Routes)
-	if err := route.Validate(); err != nil {
-		return nil, err
-	}
-
-	// limit the TokenInMaxAmount to have enough for taker fee
-	maxTokenIn := sdk.NewCoin(msg.Routes[0].TokenInDenom, msg.TokenInMaxAmount)
-	tokenInAfterSubTakerFee, _ := server.keeper.SubTakerFee(maxTokenIn, takerFee)
-
-	tokenInAmount, err := server.keeper.poolManager.RouteExactAmountOut(ctx, sender, msg.Routes, tokenInAfterSubTakerFee.Amount, msg.TokenOut)
-	if err != nil {
-		return nil, err
-	}
-
-	tokenInCoin := sdk.NewCoin(msg.Routes[0].TokenInDenom, tokenInAmount)
-	tokenInAmountWithTakerFee, takerFeeCoin := server.keeper.AddTakerFee(tokenInCoin, takerFee)
-
-	// first pool for taker fee swaps if needed
-	takerFeeRoute := poolmanagertypes.SwapAmountInRoute{}
-	takerFeeRoute.PoolId = msg.Routes[0].PoolId
-	if len(msg.Routes) > 1 {
-		takerFeeRoute.TokenOutDenom = msg.Routes[1].TokenInDenom
-	} else {
-		takerFeeRoute.TokenOutDenom = msg.TokenOutDenom()
-	}
-
-	// If the IN denom is a RollApp, we reward the IN RollApp owner.
-	// Otherwise, if the OUT denom is a RollApp, we reward the OUT RollApp owner.
-	// IN denom is the first route's token in denom.
-	inDenom := msg.Routes[0].TokenInDenom
-
-	beneficiary := server.keeper.getTakerFeeBeneficiary(ctx, inDenom, msg.TokenOut.Denom)
-
-	err = server.keeper.chargeTakerFee(ctx, takerFeeCoin, sender, takerFeeRoute, beneficiary)
+	// Routing and taker fee charging are handled by the pool manager.
+	tokenInAmount, err := server.keeper.poolManager.SwapExactAmountOutWithTakerFee(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut, sdk.Dec{})
 	if err != nil {
 		return nil, err
 	}
@@ -262,12 +378,12 @@ func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.Msg
 			types.TypeEvtSwapExactAmountOut,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
-			sdk.NewAttribute(types.AttributeKeyTokensIn, tokenInCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, sdk.NewCoin(msg.TokenInDenom(), tokenInAmount).String()),
 			sdk.NewAttribute(types.AttributeKeyTokensOut, msg.TokenOut.String()),
 		),
 	})
 
-	return &types.MsgSwapExactAmountOutResponse{TokenInAmount: tokenInAmountWithTakerFee.Amount}, nil
+	return &types.MsgSwapExactAmountOutResponse{TokenInAmount: tokenInAmount}, nil
 }
 
 // JoinSwapExactAmountIn is an LP transaction, that will LP all of the provided tokensIn coins.
@@ -282,6 +398,10 @@ func (server msgServer) JoinSwapExternAmountIn(goCtx context.Context, msg *types
 		return nil, err
 	}
 
+	if err := server.keeper.CheckSpotPriceBounds(ctx, msg.PoolId, msg.SpotPriceBounds); err != nil {
+		return nil, err
+	}
+
 	tokensIn := sdk.Coins{msg.TokenIn}
 	shareOutAmount, err := server.keeper.JoinSwapExactAmountIn(ctx, sender, msg.PoolId, tokensIn, msg.ShareOutMinAmount)
 	if err != nil {
@@ -358,6 +478,10 @@ func (server msgServer) ExitSwapShareAmountIn(goCtx context.Context, msg *types.
 		return nil, err
 	}
 
+	if err := server.keeper.CheckSpotPriceBounds(ctx, msg.PoolId, msg.SpotPriceBounds); err != nil {
+		return nil, err
+	}
+
 	tokenOutAmount, err := server.keeper.ExitSwapShareAmountIn(ctx, sender, msg.PoolId, msg.TokenOutDenom, msg.ShareInAmount, msg.TokenOutMinAmount)
 	if err != nil {
 		return nil, err
@@ -374,3 +498,150 @@ func (server msgServer) ExitSwapShareAmountIn(goCtx context.Context, msg *types.
 
 	return &types.MsgExitSwapShareAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
 }
+
+// ReplaceMigrationRecords replaces all the migration records with the given links.
+func (server msgServer) ReplaceMigrationRecords(goCtx context.Context, msg *types.MsgReplaceMigrationRecords) (*types.MsgReplaceMigrationRecordsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if server.keeper.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", server.keeper.authority, msg.Authority)
+	}
+
+	if err := server.keeper.ReplaceMigrationRecords(ctx, msg.Records); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReplaceMigrationRecordsResponse{}, nil
+}
+
+// UpdateMigrationRecords adds, modifies or removes the migration records of the given old pools.
+func (server msgServer) UpdateMigrationRecords(goCtx context.Context, msg *types.MsgUpdateMigrationRecords) (*types.MsgUpdateMigrationRecordsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if server.keeper.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", server.keeper.authority, msg.Authority)
+	}
+
+	if err := server.keeper.UpdateMigrationRecords(ctx, msg.Records); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateMigrationRecordsResponse{}, nil
+}
+
+// SetCanonicalPool sets the canonical pool of a denom pair.
+func (server msgServer) SetCanonicalPool(goCtx context.Context, msg *types.MsgSetCanonicalPool) (*types.MsgSetCanonicalPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if server.keeper.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", server.keeper.authority, msg.Authority)
+	}
+
+	if err := server.keeper.SetCanonicalPool(ctx, msg.PoolId, msg.DenomA, msg.DenomB); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetCanonicalPoolResponse{}, nil
+}
+
+// MigrateShares migrates gamm pool shares to the pool they are linked to by the migration records.
+func (server msgServer) MigrateShares(goCtx context.Context, msg *types.MsgMigrateShares) (*types.MsgMigrateSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIdEntering, sharesOut, liquidityCreated, err := server.keeper.MigrateShares(ctx, sender, msg.SharesToMigrate, msg.ShareOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtMigrateShares,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyPoolIdLeaving, strconv.FormatUint(types.MustGetPoolIdFromShareDenom(msg.SharesToMigrate.Denom), 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolIdEntering, strconv.FormatUint(poolIdEntering, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, msg.SharesToMigrate.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, sdk.NewCoin(types.GetPoolShareDenom(poolIdEntering), sharesOut).String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidity, liquidityCreated.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgMigrateSharesResponse{PoolIdEntering: poolIdEntering, ShareOutAmount: sharesOut, LiquidityCreated: liquidityCreated}, nil
+}
+
+// MigrateLockedShares migrates the gamm pool shares of a lock to the pool they are linked to by the
+// migration records, keeping the lock's ID, duration and end time.
+func (server msgServer) MigrateLockedShares(goCtx context.Context, msg *types.MsgMigrateLockedShares) (*types.MsgMigrateLockedSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIdEntering, sharesOut, err := server.keeper.MigrateLockedShares(ctx, sender, msg.LockId, msg.ShareOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtMigrateShares,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyLockId, strconv.FormatUint(msg.LockId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolIdEntering, strconv.FormatUint(poolIdEntering, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, sdk.NewCoin(types.GetPoolShareDenom(poolIdEntering), sharesOut).String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgMigrateLockedSharesResponse{PoolIdEntering: poolIdEntering, ShareOutAmount: sharesOut}, nil
+}
+
+// ZapIn joins a pool with a token it does not hold, swapping it through the routes to an asset of the
+// pool first, and optionally locks the shares.
+func (server msgServer) ZapIn(goCtx context.Context, msg *types.MsgZapIn) (*types.MsgZapInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	sharesOut, lockId, err := server.keeper.ZapIn(ctx, sender, msg.PoolId, msg.TokenIn, msg.Routes, msg.ShareOutMinAmount, msg.LockDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap and LP events are handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtZapIn,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, msg.TokenIn.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, sdk.NewCoin(types.GetPoolShareDenom(msg.PoolId), sharesOut).String()),
+			sdk.NewAttribute(types.AttributeKeyLockId, strconv.FormatUint(lockId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgZapInResponse{ShareOutAmount: sharesOut, LockId: lockId}, nil
+}
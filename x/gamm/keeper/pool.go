@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
@@ -30,6 +32,7 @@ func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI,
 
 // GetPoolAndPoke returns a PoolI based on it's identifier if one exists. If poolId corresponds
 // to a pool with weights (e.g. balancer), the weights of the pool are updated via PokePool prior to returning.
+// Likewise, the amplification of a pool with an amplification ramp (e.g. stableswap) is updated.
 // TODO: Consider rename to GetPool due to downstream API confusion.
 func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.CFMMPoolI, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -45,13 +48,21 @@ func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.CFMMPoolI,
 		return nil, err
 	}
 
-	if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
-		pokePool.PokePool(ctx.BlockTime())
-	}
+	pokePool(ctx, pool)
 
 	return pool, nil
 }
 
+// pokePool updates the time dependent parameters of the pool to their values at the current block time.
+func pokePool(ctx sdk.Context, pool types.CFMMPoolI) {
+	switch pool := pool.(type) {
+	case types.WeightedPoolExtension:
+		pool.PokePool(ctx.BlockTime())
+	case types.AmplifiedPoolExtension:
+		pool.PokePool(ctx.BlockTime())
+	}
+}
+
 // Get pool and check if the pool is active, i.e. allowed to be swapped against.
 func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.CFMMPoolI, error) {
 	pool, err := k.GetPoolAndPoke(ctx, poolId)
@@ -65,6 +76,16 @@ func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.CFMMPoolI,
 	return pool, nil
 }
 
+// validateJoin checks that the sender can join the pool. Only the creator of a liquidity
+// bootstrapping pool can join it during its sale.
+func (k Keeper) validateJoin(ctx sdk.Context, pool types.CFMMPoolI, sender sdk.AccAddress) error {
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return nil
+	}
+	return balancerPool.ValidateJoin(sender, ctx.BlockTime())
+}
+
 func (k Keeper) iterator(ctx sdk.Context, prefix []byte) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
 	return sdk.KVStorePrefixIterator(store, prefix)
@@ -82,9 +103,7 @@ func (k Keeper) GetPoolsAndPoke(ctx sdk.Context) (res []types.CFMMPoolI, err err
 			return nil, err
 		}
 
-		if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
-			pokePool.PokePool(ctx.BlockTime())
-		}
+		pokePool(ctx, pool)
 		res = append(res, pool)
 	}
 
@@ -164,12 +183,55 @@ func (k Keeper) GetPoolType(ctx sdk.Context, poolId uint64) (poolmanagertypes.Po
 	switch pool := pool.(type) {
 	case *balancer.Pool:
 		return poolmanagertypes.Balancer, nil
+	case *stableswap.Pool:
+		return poolmanagertypes.Stableswap, nil
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s pool type: %T", types.ModuleName, pool)
 		return -1, sdkerrors.Wrap(sdkerrors.ErrUnpackAny, errMsg)
 	}
 }
 
+// setStableSwapScalingFactors sets the scaling factors of the stableswap pool with the given id.
+// The sender must be the pool's scaling factor controller.
+func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scalingFactors []uint64, sender string) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrNotStableSwapPool, "pool id %d is not of type stableswap pool", poolId)
+	}
+
+	if err := stableswapPool.SetScalingFactors(ctx, scalingFactors, sender); err != nil {
+		return err
+	}
+
+	return k.setPool(ctx, stableswapPool)
+}
+
+// rampStableSwapAmplification schedules a change of the amplification of the stableswap pool with the given id,
+// from its current value to targetAmplification, between startTime and endTime.
+// It returns the resulting ramp schedule.
+func (k Keeper) rampStableSwapAmplification(ctx sdk.Context, poolId uint64, targetAmplification uint64, startTime, endTime time.Time) (stableswap.AmplificationRampParams, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return stableswap.AmplificationRampParams{}, err
+	}
+
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return stableswap.AmplificationRampParams{}, sdkerrors.Wrapf(types.ErrNotStableSwapPool, "pool id %d is not of type stableswap pool", poolId)
+	}
+
+	if err := stableswapPool.SetAmplificationRamp(ctx.BlockTime(), targetAmplification, startTime, endTime); err != nil {
+		return stableswap.AmplificationRampParams{}, err
+	}
+
+	return *stableswapPool.GetAmplificationRampParams(), k.setPool(ctx, stableswapPool)
+}
+
 // convertToCFMMPool converts PoolI to CFMMPoolI by casting the input.
 // Returns the pool of the CFMMPoolI or error if the given pool does not implement
 // CFMMPoolI.
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+// year is the period the revenue of the pools is annualized over.
+const year = 365 * 24 * time.Hour
+
+// getPoolAPR returns the APR of the liquidity of the given pool, from its swap fee revenue over
+// the last finished epochs and the rewards of the active gauges of its shares, valued in the
+// base denom.
+func (k Keeper) getPoolAPR(ctx sdk.Context, poolId uint64) (types.QueryPoolAPRResponse, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return types.QueryPoolAPRResponse{}, err
+	}
+	baseDenom, err := k.txfeeKeeper.GetBaseDenom(ctx)
+	if err != nil {
+		return types.QueryPoolAPRResponse{}, err
+	}
+
+	liquidityValue, err := k.valueInBaseDenom(ctx, pool, baseDenom, sdk.NewDecCoinsFromCoins(pool.GetTotalPoolLiquidity(ctx)...))
+	if err != nil {
+		return types.QueryPoolAPRResponse{}, err
+	}
+	swapFeeValue, err := k.valueInBaseDenom(ctx, pool, baseDenom, k.annualSwapFees(ctx, poolId))
+	if err != nil {
+		return types.QueryPoolAPRResponse{}, err
+	}
+	incentivesValue, err := k.valueInBaseDenom(ctx, pool, baseDenom, k.annualIncentives(ctx, poolId))
+	if err != nil {
+		return types.QueryPoolAPRResponse{}, err
+	}
+
+	swapFeeAPR, incentivesAPR := sdk.ZeroDec(), sdk.ZeroDec()
+	if liquidityValue.IsPositive() {
+		swapFeeAPR = swapFeeValue.Quo(liquidityValue)
+		incentivesAPR = incentivesValue.Quo(liquidityValue)
+	}
+
+	return types.QueryPoolAPRResponse{
+		Denom:          baseDenom,
+		LiquidityValue: liquidityValue,
+		SwapFeeApr:     swapFeeAPR,
+		IncentivesApr:  incentivesAPR,
+		Apr:            swapFeeAPR.Add(incentivesAPR),
+	}, nil
+}
+
+// annualSwapFees returns the swap fee revenue of the given pool over the last finished epochs
+// kept, annualized. Epochs the pool was not swapped in over count as no revenue.
+func (k Keeper) annualSwapFees(ctx sdk.Context, poolId uint64) sdk.DecCoins {
+	params := k.GetParams(ctx)
+	epochInfo := k.epochKeeper.GetEpochInfo(ctx, params.StatsEpochIdentifier)
+	numEpochs := epochInfo.CurrentEpoch - 1
+	if numEpochs > int64(params.StatsHistoryLength) {
+		numEpochs = int64(params.StatsHistoryLength)
+	}
+	if numEpochs <= 0 || epochInfo.Duration <= 0 {
+		return sdk.DecCoins{}
+	}
+
+	swapFees := sdk.Coins{}
+	for _, stats := range k.GetLastEpochsPoolStats(ctx, poolId, uint64(numEpochs)) {
+		swapFees = swapFees.Add(stats.SwapFees...)
+	}
+	return annualize(sdk.NewDecCoinsFromCoins(swapFees...), time.Duration(numEpochs)*epochInfo.Duration)
+}
+
+// annualIncentives returns the rewards the active gauges of the shares of the given pool
+// distribute per epoch of the incentives, annualized. Perpetual gauges distribute all their
+// remaining coins at each epoch, and other gauges evenly over their remaining epochs.
+func (k Keeper) annualIncentives(ctx sdk.Context, poolId uint64) sdk.DecCoins {
+	if k.incentivesKeeper == nil {
+		return sdk.DecCoins{}
+	}
+	epochDuration := k.incentivesKeeper.GetEpochInfo(ctx).Duration
+	if epochDuration <= 0 {
+		return sdk.DecCoins{}
+	}
+
+	shareDenom := types.GetPoolShareDenom(poolId)
+	rewards := sdk.DecCoins{}
+	for _, gauge := range k.incentivesKeeper.GetActiveGauges(ctx) {
+		if gauge.DistributeTo.Denom != shareDenom {
+			continue
+		}
+		remainingCoins := sdk.NewDecCoinsFromCoins(gauge.Coins.Sub(gauge.DistributedCoins...)...)
+		if !gauge.IsPerpetual {
+			remainingEpochs := gauge.NumEpochsPaidOver - gauge.FilledEpochs
+			if remainingEpochs == 0 {
+				continue
+			}
+			remainingCoins = remainingCoins.QuoDec(sdk.NewDec(int64(remainingEpochs)))
+		}
+		rewards = rewards.Add(remainingCoins...)
+	}
+	return annualize(rewards, epochDuration)
+}
+
+// annualize returns the given coins earned over the given period, scaled to a year.
+func annualize(coins sdk.DecCoins, period time.Duration) sdk.DecCoins {
+	return coins.MulDec(sdk.NewDec(int64(year)).QuoInt64(int64(period)))
+}
+
+// valueInBaseDenom returns the value of the given coins in the base denom. Coins are valued at
+// the spot price of the given pool if it holds both their denom and the base denom, and at the
+// price of the fee tokens otherwise.
+func (k Keeper) valueInBaseDenom(ctx sdk.Context, pool types.CFMMPoolI, baseDenom string, coins sdk.DecCoins) (sdk.Dec, error) {
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+	value := sdk.ZeroDec()
+	for _, coin := range coins {
+		switch {
+		case coin.Denom == baseDenom:
+			value = value.Add(coin.Amount)
+		case liquidity.AmountOf(baseDenom).IsPositive() && liquidity.AmountOf(coin.Denom).IsPositive():
+			spotPrice, err := pool.SpotPrice(ctx, baseDenom, coin.Denom)
+			if err != nil {
+				return sdk.Dec{}, err
+			}
+			value = value.Add(coin.Amount.Mul(spotPrice))
+		default:
+			// the conversion rounds to an integer amount of the base denom, a large amount is
+			// converted for the rounding to be negligible.
+			scaledValue, err := k.txfeeKeeper.ConvertToBaseToken(ctx, sdk.NewCoin(coin.Denom, valuationScale))
+			if err != nil {
+				return sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoValuation, "%s is neither held by pool %d with %s nor a fee token: %s", coin.Denom, pool.GetId(), baseDenom, err)
+			}
+			value = value.Add(coin.Amount.MulInt(scaledValue.Amount).QuoInt(valuationScale))
+		}
+	}
+	return value, nil
+}
+
+// valuationScale is the amount of the coins converted to the base denom through the fee tokens.
+var valuationScale = sdk.NewInt(1_000_000_000_000_000_000)
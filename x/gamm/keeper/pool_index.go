@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// indexPool indexes the pool under each denom and each pair of denoms it holds, and makes it
+// the canonical pool of the pairs that do not have one yet. The denoms of a pool never change,
+// so pools are only indexed when they are created.
+func (k Keeper) indexPool(ctx sdk.Context, pool poolmanagertypes.PoolI) {
+	store := ctx.KVStore(k.storeKey)
+	poolId := pool.GetId()
+	denoms := osmoutils.CoinsDenoms(pool.GetTotalPoolLiquidity(ctx))
+	for i, denomA := range denoms {
+		store.Set(types.GetKeyDenomPool(denomA, poolId), []byte{})
+
+		for _, denomB := range denoms[i+1:] {
+			store.Set(types.GetKeyDenomPairPool(denomA, denomB, poolId), []byte{})
+			if _, found := k.GetCanonicalPoolId(ctx, denomA, denomB); !found {
+				k.setCanonicalPool(ctx, types.NewCanonicalPool(denomA, denomB, poolId))
+			}
+		}
+	}
+}
+
+// GetDenomPoolIds returns the ids of the pools holding the given denom, in increasing order.
+func (k Keeper) GetDenomPoolIds(ctx sdk.Context, denom string) []uint64 {
+	return k.getIndexedPoolIds(ctx, types.GetKeyPrefixDenomPools(denom))
+}
+
+// GetDenomPairPoolIds returns the ids of the pools holding both given denoms, in increasing order.
+func (k Keeper) GetDenomPairPoolIds(ctx sdk.Context, denomA, denomB string) []uint64 {
+	return k.getIndexedPoolIds(ctx, types.GetKeyPrefixDenomPairPools(denomA, denomB))
+}
+
+func (k Keeper) getIndexedPoolIds(ctx sdk.Context, indexPrefix []byte) []uint64 {
+	iter := k.iterator(ctx, indexPrefix)
+	defer iter.Close() //nolint:errcheck
+
+	poolIds := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		poolIds = append(poolIds, sdk.BigEndianToUint64(key[len(key)-8:]))
+	}
+	return poolIds
+}
+
+// getPoolSummary returns the liquidity of the given pool and the spot prices of the base asset
+// in each of the quote assets, or in each other asset of the pool if no quote asset is given.
+func (k Keeper) getPoolSummary(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenoms []string) (types.PoolSummary, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return types.PoolSummary{}, err
+	}
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+
+	if len(quoteAssetDenoms) == 0 {
+		for _, denom := range osmoutils.CoinsDenoms(liquidity) {
+			if denom != baseAssetDenom {
+				quoteAssetDenoms = append(quoteAssetDenoms, denom)
+			}
+		}
+	}
+
+	spotPrices := make([]types.DenomSpotPrice, 0, len(quoteAssetDenoms))
+	for _, quoteAssetDenom := range quoteAssetDenoms {
+		spotPrice, err := k.CalculateSpotPrice(ctx, poolId, quoteAssetDenom, baseAssetDenom)
+		if err != nil {
+			return types.PoolSummary{}, err
+		}
+		spotPrices = append(spotPrices, types.DenomSpotPrice{QuoteAssetDenom: quoteAssetDenom, SpotPrice: spotPrice})
+	}
+
+	return types.PoolSummary{
+		PoolId:     poolId,
+		Liquidity:  liquidity,
+		SpotPrices: spotPrices,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+// preparePoolIndexPools creates pool 1 of adym and bar, pool 2 of adym, bar and baz and pool 3 of foo and baz.
+func (suite *KeeperTestSuite) preparePoolIndexPools() {
+	suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000), sdk.NewInt64Coin("bar", 2000000))
+	suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000), sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("baz", 4000000))
+	suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 1000000), sdk.NewInt64Coin("baz", 1000000))
+}
+
+func (suite *KeeperTestSuite) TestPoolIndexes() {
+	suite.SetupTest()
+	suite.preparePoolIndexPools()
+	gammKeeper := suite.App.GAMMKeeper
+
+	suite.Require().Equal([]uint64{1, 2}, gammKeeper.GetDenomPoolIds(suite.Ctx, sdk.DefaultBondDenom))
+	suite.Require().Equal([]uint64{2, 3}, gammKeeper.GetDenomPoolIds(suite.Ctx, "baz"))
+	suite.Require().Empty(gammKeeper.GetDenomPoolIds(suite.Ctx, "ba"))
+	suite.Require().Equal([]uint64{1, 2}, gammKeeper.GetDenomPairPoolIds(suite.Ctx, "bar", sdk.DefaultBondDenom))
+	suite.Require().Equal([]uint64{2}, gammKeeper.GetDenomPairPoolIds(suite.Ctx, "bar", "baz"))
+
+	// joins, exits and swaps keep the indexes.
+	suite.FundAcc(suite.TestAccs[0], defaultAcctFunds)
+	_, _, err := gammKeeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[0], 2, sdk.NewInt(1e17), nil)
+	suite.Require().NoError(err)
+	_, err = gammKeeper.ExitPool(suite.Ctx, suite.TestAccs[0], 2, sdk.NewInt(5e16), sdk.NewCoins())
+	suite.Require().NoError(err)
+	pool, err := gammKeeper.GetPoolAndPoke(suite.Ctx, 2)
+	suite.Require().NoError(err)
+	_, err = gammKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], pool, sdk.NewInt64Coin("baz", 1000), "bar", sdk.OneInt(), pool.GetSwapFee(suite.Ctx))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]uint64{2, 3}, gammKeeper.GetDenomPoolIds(suite.Ctx, "baz"))
+	suite.Require().Equal([]uint64{2}, gammKeeper.GetDenomPairPoolIds(suite.Ctx, "bar", "baz"))
+}
+
+func (suite *KeeperTestSuite) TestPoolsByDenomAndPairQueries() {
+	suite.SetupTest()
+	suite.preparePoolIndexPools()
+	querier := keeper.NewQuerier(*suite.App.GAMMKeeper)
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+
+	// pools are equally weighted, so the spot prices are the ratios of their balances.
+	byDenomRes, err := querier.PoolsByDenom(ctx, &types.QueryPoolsByDenomRequest{Denom: "bar"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PoolSummary{
+		{
+			PoolId:     1,
+			Liquidity:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000), sdk.NewInt64Coin("bar", 2000000)),
+			SpotPrices: []types.DenomSpotPrice{{QuoteAssetDenom: sdk.DefaultBondDenom, SpotPrice: sdk.MustNewDecFromStr("0.5")}},
+		},
+		{
+			PoolId:    2,
+			Liquidity: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000), sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("baz", 4000000)),
+			SpotPrices: []types.DenomSpotPrice{
+				{QuoteAssetDenom: sdk.DefaultBondDenom, SpotPrice: sdk.OneDec()},
+				{QuoteAssetDenom: "baz", SpotPrice: sdk.NewDec(4)},
+			},
+		},
+	}, byDenomRes.Pools)
+	suite.Require().Equal(uint64(2), byDenomRes.Pagination.Total)
+
+	byPairRes, err := querier.PoolsByPair(ctx, &types.QueryPoolsByPairRequest{DenomA: "baz", DenomB: "bar", Pagination: &query.PageRequest{Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PoolSummary{{
+		PoolId:     2,
+		Liquidity:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000), sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("baz", 4000000)),
+		SpotPrices: []types.DenomSpotPrice{{QuoteAssetDenom: "bar", SpotPrice: sdk.MustNewDecFromStr("0.25")}},
+	}}, byPairRes.Pools)
+	suite.Require().Nil(byPairRes.Pagination.NextKey)
+
+	// pages follow each other in pool id order.
+	byDenomRes, err = querier.PoolsByDenom(ctx, &types.QueryPoolsByDenomRequest{Denom: sdk.DefaultBondDenom, Pagination: &query.PageRequest{Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), byDenomRes.Pools[0].PoolId)
+	byDenomRes, err = querier.PoolsByDenom(ctx, &types.QueryPoolsByDenomRequest{Denom: sdk.DefaultBondDenom, Pagination: &query.PageRequest{Key: byDenomRes.Pagination.NextKey}})
+	suite.Require().NoError(err)
+	suite.Require().Len(byDenomRes.Pools, 1)
+	suite.Require().Equal(uint64(2), byDenomRes.Pools[0].PoolId)
+
+	_, err = querier.PoolsByDenom(ctx, &types.QueryPoolsByDenomRequest{Denom: ""})
+	suite.Require().Error(err)
+	_, err = querier.PoolsByPair(ctx, &types.QueryPoolsByPairRequest{DenomA: "bar", DenomB: "bar"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	suite.SetupTest()
+	suite.preparePoolIndexPools()
+	gammKeeper := suite.App.GAMMKeeper
+	gammKeeper.ClearPoolIndexes(suite.Ctx)
+	suite.Require().Empty(gammKeeper.GetDenomPoolIds(suite.Ctx, sdk.DefaultBondDenom))
+
+	err := keeper.NewMigrator(*gammKeeper).Migrate1to2(suite.Ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]uint64{1, 2}, gammKeeper.GetDenomPoolIds(suite.Ctx, sdk.DefaultBondDenom))
+	suite.Require().Equal([]uint64{2, 3}, gammKeeper.GetDenomPoolIds(suite.Ctx, "baz"))
+	suite.Require().Equal([]uint64{1, 2}, gammKeeper.GetDenomPairPoolIds(suite.Ctx, "bar", sdk.DefaultBondDenom))
+	suite.Require().ElementsMatch([]types.CanonicalPool{
+		{DenomA: sdk.DefaultBondDenom, DenomB: "bar", PoolId: 1},
+		{DenomA: sdk.DefaultBondDenom, DenomB: "baz", PoolId: 2},
+		{DenomA: "bar", DenomB: "baz", PoolId: 2},
+		{DenomA: "baz", DenomB: "foo", PoolId: 3},
+	}, gammKeeper.GetAllCanonicalPools(suite.Ctx))
+}
@@ -92,6 +92,30 @@ func (k Keeper) CalculateSpotPrice(
 	return spotPrice, err
 }
 
+// CheckSpotPriceBounds returns an error if the spot price of pool #{poolId}, in quote asset per
+// base asset of the given bounds, is out of the bounds. Nil bounds do not bound the spot price.
+// It is checked before joining or exiting the pool, so that a join or exit is not executed at a
+// spot price moved just before it.
+func (k Keeper) CheckSpotPriceBounds(ctx sdk.Context, poolId uint64, bounds *types.SpotPriceBounds) error {
+	if bounds == nil {
+		return nil
+	}
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	spotPrice, err := pool.SpotPrice(ctx, bounds.QuoteAssetDenom, bounds.BaseAssetDenom)
+	if err != nil {
+		return err
+	}
+	if !bounds.Contains(spotPrice) {
+		return sdkerrors.Wrapf(types.ErrSpotPriceOutOfBounds, "spot price of pool %d is %s %s per %s, bounds: min %s, max %s",
+			poolId, spotPrice, bounds.QuoteAssetDenom, bounds.BaseAssetDenom, bounds.MinSpotPrice, bounds.MaxSpotPrice)
+	}
+	return nil
+}
+
 // This function:
 // - saves the pool to state
 // - Mints LP shares to the pool creator
@@ -122,6 +146,7 @@ func (k Keeper) InitializePool(ctx sdk.Context, pool poolmanagertypes.PoolI, sen
 	if err := k.setPool(ctx, pool); err != nil {
 		return err
 	}
+	k.indexPool(ctx, pool)
 
 	k.hooks.AfterPoolCreated(ctx, sender, pool.GetId())
 	k.RecordTotalLiquidityIncrease(ctx, pool.GetTotalPoolLiquidity(ctx))
@@ -155,6 +180,9 @@ func (k Keeper) JoinPoolNoSwap(
 	if err != nil {
 		return nil, sdk.ZeroInt(), err
 	}
+	if err := k.validateJoin(ctx, pool, sender); err != nil {
+		return nil, sdk.ZeroInt(), err
+	}
 
 	// we do an abstract calculation on the lp liquidity coins needed to have
 	// the designated amount of given shares of the pool without performing swap
@@ -247,6 +275,9 @@ func (k Keeper) JoinSwapExactAmountIn(
 	if err != nil {
 		return sdk.Int{}, err
 	}
+	if err := k.validateJoin(ctx, pool, sender); err != nil {
+		return sdk.Int{}, err
+	}
 
 	sharesOut, err = pool.JoinPool(ctx, tokensIn, pool.GetSwapFee(ctx))
 	switch {
@@ -291,6 +322,9 @@ func (k Keeper) JoinSwapShareAmountOut(
 	if err != nil {
 		return sdk.Int{}, err
 	}
+	if err := k.validateJoin(ctx, pool, sender); err != nil {
+		return sdk.Int{}, err
+	}
 
 	extendedPool, ok := pool.(types.PoolAmountOutExtension)
 	if !ok {
@@ -370,7 +404,7 @@ func (k Keeper) ExitSwapShareAmountIn(
 		return sdk.Int{}, err
 	}
 
-	pool, err := k.GetPool(ctx, poolId)
+	pool, err := k.getPoolForSwap(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -13,6 +13,7 @@ import (
 	keeper "github.com/osmosis-labs/osmosis/v15/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
 	balancertypes "github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
@@ -126,6 +127,152 @@ func (suite *KeeperTestSuite) TestGlobalFeeParams() {
 	suite.Require().NotEqual(defaultPoolParams.SwapFee, pool.GetSwapFee(suite.Ctx))
 }
 
+func (suite *KeeperTestSuite) TestCreateStableswapPool() {
+	suite.SetupTest()
+	gammKeeper := suite.App.GAMMKeeper
+	stableswapPoolParams := stableswap.PoolParams{
+		SwapFee: defaultSwapFee,
+		ExitFee: defaultExitFee,
+	}
+
+	msg := stableswap.NewMsgCreateStableswapPool(suite.TestAccs[0], stableswapPoolParams, defaultStableSwapPoolAssets, defaultScalingFactor, 100, defaultFutureGovernor)
+	suite.FundAcc(suite.TestAccs[0], defaultAcctFunds)
+
+	msgserver := keeper.NewStableswapMsgServerImpl(gammKeeper)
+	resp, err := msgserver.CreateStableswapPool(suite.Ctx, &msg)
+	suite.Require().NoError(err)
+
+	pool, err := gammKeeper.GetPoolAndPoke(suite.Ctx, resp.PoolID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(defaultStableSwapPoolAssets.String(), pool.GetTotalPoolLiquidity(suite.Ctx).String())
+	suite.Require().Equal(types.InitPoolSharesSupply.String(), pool.GetTotalShares().String())
+
+	poolType, err := gammKeeper.GetPoolType(suite.Ctx, resp.PoolID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(poolmanagertypes.Stableswap, poolType)
+
+	// pool assets must be unique across pool types
+	_, err = msgserver.CreateStableswapPool(suite.Ctx, &msg)
+	suite.Require().ErrorIs(err, types.ErrPoolAlreadyExists)
+
+	// pool must contain a whitelisted asset
+	msg.InitialPoolLiquidity = sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(10000)), sdk.NewCoin("baz", sdk.NewInt(10000)))
+	_, err = msgserver.CreateStableswapPool(suite.Ctx, &msg)
+	suite.Require().ErrorIs(err, types.ErrPoolAssetNotAllowed)
+}
+
+func (suite *KeeperTestSuite) TestStableSwapAdjustScalingFactors() {
+	suite.SetupTest()
+	gammKeeper := suite.App.GAMMKeeper
+	controller := suite.TestAccs[1].String()
+
+	msg := stableswap.NewMsgCreateStableswapPool(suite.TestAccs[0], stableswap.PoolParams{
+		SwapFee: defaultSwapFee,
+		ExitFee: defaultExitFee,
+	}, defaultStableSwapPoolAssets, defaultScalingFactor, 100, defaultFutureGovernor)
+	msg.ScalingFactorController = controller
+	suite.FundAcc(suite.TestAccs[0], defaultAcctFunds)
+
+	msgserver := keeper.NewStableswapMsgServerImpl(gammKeeper)
+	resp, err := msgserver.CreateStableswapPool(suite.Ctx, &msg)
+	suite.Require().NoError(err)
+
+	// only the controller can adjust the scaling factors
+	adjustMsg := stableswap.NewMsgStableSwapAdjustScalingFactors(suite.TestAccs[0].String(), resp.PoolID, []uint64{1, 2})
+	_, err = msgserver.StableSwapAdjustScalingFactors(suite.Ctx, &adjustMsg)
+	suite.Require().ErrorIs(err, types.ErrNotScalingFactorGovernor)
+
+	adjustMsg.Sender = controller
+	_, err = msgserver.StableSwapAdjustScalingFactors(suite.Ctx, &adjustMsg)
+	suite.Require().NoError(err)
+
+	pool, err := gammKeeper.GetPoolAndPoke(suite.Ctx, resp.PoolID)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{1, 2}, pool.(*stableswap.Pool).GetScalingFactors())
+
+	// balancer pools have no scaling factors
+	balancerPoolId := suite.PrepareBalancerPool()
+	adjustMsg.PoolID = balancerPoolId
+	_, err = msgserver.StableSwapAdjustScalingFactors(suite.Ctx, &adjustMsg)
+	suite.Require().ErrorIs(err, types.ErrNotStableSwapPool)
+}
+
+func (suite *KeeperTestSuite) TestStableSwapRampAmplification() {
+	suite.SetupTest()
+	gammKeeper := suite.App.GAMMKeeper
+	authority := gammKeeper.GetAuthority()
+
+	msg := stableswap.NewMsgCreateStableswapPool(suite.TestAccs[0], stableswap.PoolParams{
+		SwapFee: defaultSwapFee,
+		ExitFee: defaultExitFee,
+	}, defaultStableSwapPoolAssets, defaultScalingFactor, 100, defaultFutureGovernor)
+	suite.FundAcc(suite.TestAccs[0], defaultAcctFunds)
+
+	msgserver := keeper.NewStableswapMsgServerImpl(gammKeeper)
+	resp, err := msgserver.CreateStableswapPool(suite.Ctx, &msg)
+	suite.Require().NoError(err)
+	poolId := resp.PoolID
+
+	startTime := suite.Ctx.BlockTime().Add(time.Hour)
+	endTime := startTime.Add(100 * time.Hour)
+	rampMsg := stableswap.NewMsgStableSwapRampAmplification(suite.TestAccs[0].String(), poolId, 300, startTime, endTime)
+
+	// only the authority can ramp the amplification
+	_, err = msgserver.StableSwapRampAmplification(suite.Ctx, &rampMsg)
+	suite.Require().Error(err)
+
+	rampMsg.Authority = authority
+	_, err = msgserver.StableSwapRampAmplification(suite.Ctx, &rampMsg)
+	suite.Require().NoError(err)
+
+	querier := keeper.NewQuerier(*gammKeeper)
+	queryAt := func(blockTime time.Time) *types.QueryStableSwapAmplificationResponse {
+		ctx := suite.Ctx.WithBlockTime(blockTime)
+		res, err := querier.StableSwapAmplification(sdk.WrapSDKContext(ctx), &types.QueryStableSwapAmplificationRequest{PoolId: poolId})
+		suite.Require().NoError(err)
+		return res
+	}
+
+	// before the ramp starts
+	res := queryAt(startTime)
+	suite.Require().Equal(uint64(100), res.Amplification)
+	suite.Require().True(res.Ramping)
+	suite.Require().Equal(uint64(100), res.InitialAmplification)
+	suite.Require().Equal(uint64(300), res.TargetAmplification)
+	suite.Require().Equal(startTime, res.RampStartTime)
+	suite.Require().Equal(endTime, res.RampEndTime)
+
+	// halfway through the ramp, the amplification is interpolated when the pool is loaded
+	halfway := startTime.Add(50 * time.Hour)
+	res = queryAt(halfway)
+	suite.Require().Equal(uint64(200), res.Amplification)
+	pool, err := gammKeeper.GetPoolAndPoke(suite.Ctx.WithBlockTime(halfway), poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(200), pool.(*stableswap.Pool).GetAmplification())
+
+	// after the ramp ends
+	res = queryAt(endTime.Add(time.Hour))
+	suite.Require().Equal(uint64(300), res.Amplification)
+	suite.Require().False(res.Ramping)
+
+	// a new ramp starts from the effective amplification, replacing the ramp in progress
+	ctx := suite.Ctx.WithBlockTime(halfway)
+	rampMsg = stableswap.NewMsgStableSwapRampAmplification(authority, poolId, 100, time.Time{}, halfway.Add(48*time.Hour))
+	_, err = msgserver.StableSwapRampAmplification(sdk.WrapSDKContext(ctx), &rampMsg)
+	suite.Require().NoError(err)
+	res = queryAt(halfway.Add(24 * time.Hour))
+	suite.Require().Equal(uint64(200), res.InitialAmplification)
+	suite.Require().Equal(uint64(150), res.Amplification)
+
+	// balancer pools have no amplification
+	balancerPoolId := suite.PrepareBalancerPool()
+	rampMsg.PoolID = balancerPoolId
+	_, err = msgserver.StableSwapRampAmplification(sdk.WrapSDKContext(ctx), &rampMsg)
+	suite.Require().ErrorIs(err, types.ErrNotStableSwapPool)
+	_, err = querier.StableSwapAmplification(sdk.WrapSDKContext(ctx), &types.QueryStableSwapAmplificationRequest{PoolId: balancerPoolId})
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestCreateBalancerPool() {
 	params := suite.App.GAMMKeeper.GetParams(suite.Ctx)
 	testAccount := suite.TestAccs[0]
@@ -476,6 +623,109 @@ func (suite *KeeperTestSuite) TestSpotPriceOverflow() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCheckSpotPriceBounds() {
+	// the spot price of foo is 2 bar.
+	bounds := func(min, max string) *types.SpotPriceBounds {
+		return &types.SpotPriceBounds{
+			BaseAssetDenom:  "foo",
+			QuoteAssetDenom: "bar",
+			MinSpotPrice:    sdk.MustNewDecFromStr(min),
+			MaxSpotPrice:    sdk.MustNewDecFromStr(max),
+		}
+	}
+	tests := map[string]struct {
+		bounds    *types.SpotPriceBounds
+		expectErr error
+	}{
+		"no bounds": {
+			bounds: nil,
+		},
+		"within bounds": {
+			bounds: bounds("1.5", "2.5"),
+		},
+		"at bounds": {
+			bounds: bounds("2", "2"),
+		},
+		"only min spot price": {
+			bounds: bounds("1.5", "0"),
+		},
+		"only max spot price": {
+			bounds: bounds("0", "2.5"),
+		},
+		"below min spot price": {
+			bounds:    bounds("2.1", "0"),
+			expectErr: types.ErrSpotPriceOutOfBounds,
+		},
+		"above max spot price": {
+			bounds:    bounds("0", "1.9"),
+			expectErr: types.ErrSpotPriceOutOfBounds,
+		},
+		"inverted denoms": {
+			bounds: &types.SpotPriceBounds{
+				BaseAssetDenom:  "bar",
+				QuoteAssetDenom: "foo",
+				MinSpotPrice:    sdk.MustNewDecFromStr("1.5"),
+			},
+			expectErr: types.ErrSpotPriceOutOfBounds,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 2_000_000))
+
+			err := suite.App.GAMMKeeper.CheckSpotPriceBounds(suite.Ctx, poolId, tc.bounds)
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestJoinExitPoolSpotPriceBounds() {
+	suite.SetupTest()
+	poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 2_000_000))
+	msgServer := keeper.NewMsgServerImpl(suite.App.GAMMKeeper)
+	goCtx := sdk.WrapSDKContext(suite.Ctx)
+	sender := suite.TestAccs[0]
+	suite.FundAcc(sender, sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 2_000_000)))
+
+	inBounds := &types.SpotPriceBounds{BaseAssetDenom: "foo", QuoteAssetDenom: "bar", MinSpotPrice: sdk.NewDec(1), MaxSpotPrice: sdk.NewDec(3)}
+	outOfBounds := &types.SpotPriceBounds{BaseAssetDenom: "foo", QuoteAssetDenom: "bar", MinSpotPrice: sdk.NewDec(3)}
+	shareAmount := sdk.NewIntFromBigInt(types.OneShare.MulRaw(10).BigInt())
+	tokenIn := sdk.NewInt64Coin("foo", 100_000)
+
+	// out of bounds joins and exits fail before changing the pool.
+	poolLiquidity := func() sdk.Coins {
+		pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+		suite.Require().NoError(err)
+		return pool.GetTotalPoolLiquidity(suite.Ctx)
+	}
+	liquidity := poolLiquidity()
+	_, err := msgServer.JoinPool(goCtx, &types.MsgJoinPool{Sender: sender.String(), PoolId: poolId, ShareOutAmount: shareAmount, SpotPriceBounds: outOfBounds})
+	suite.Require().ErrorIs(err, types.ErrSpotPriceOutOfBounds)
+	_, err = msgServer.ExitPool(goCtx, &types.MsgExitPool{Sender: sender.String(), PoolId: poolId, ShareInAmount: shareAmount, SpotPriceBounds: outOfBounds})
+	suite.Require().ErrorIs(err, types.ErrSpotPriceOutOfBounds)
+	_, err = msgServer.JoinSwapExternAmountIn(goCtx, &types.MsgJoinSwapExternAmountIn{Sender: sender.String(), PoolId: poolId, TokenIn: tokenIn, ShareOutMinAmount: sdk.OneInt(), SpotPriceBounds: outOfBounds})
+	suite.Require().ErrorIs(err, types.ErrSpotPriceOutOfBounds)
+	_, err = msgServer.ExitSwapShareAmountIn(goCtx, &types.MsgExitSwapShareAmountIn{Sender: sender.String(), PoolId: poolId, TokenOutDenom: "foo", ShareInAmount: shareAmount, TokenOutMinAmount: sdk.OneInt(), SpotPriceBounds: outOfBounds})
+	suite.Require().ErrorIs(err, types.ErrSpotPriceOutOfBounds)
+	suite.Require().Equal(liquidity, poolLiquidity())
+
+	// joins and exits within bounds succeed.
+	_, err = msgServer.JoinPool(goCtx, &types.MsgJoinPool{Sender: sender.String(), PoolId: poolId, ShareOutAmount: shareAmount, SpotPriceBounds: inBounds})
+	suite.Require().NoError(err)
+	_, err = msgServer.ExitPool(goCtx, &types.MsgExitPool{Sender: sender.String(), PoolId: poolId, ShareInAmount: shareAmount, SpotPriceBounds: inBounds})
+	suite.Require().NoError(err)
+	_, err = msgServer.JoinSwapExternAmountIn(goCtx, &types.MsgJoinSwapExternAmountIn{Sender: sender.String(), PoolId: poolId, TokenIn: tokenIn, ShareOutMinAmount: sdk.OneInt(), SpotPriceBounds: inBounds})
+	suite.Require().NoError(err)
+	_, err = msgServer.ExitSwapShareAmountIn(goCtx, &types.MsgExitSwapShareAmountIn{Sender: sender.String(), PoolId: poolId, TokenOutDenom: "foo", ShareInAmount: shareAmount, TokenOutMinAmount: sdk.OneInt(), SpotPriceBounds: inBounds})
+	suite.Require().NoError(err)
+}
+
 // TODO: Add more edge cases around TokenInMaxs not containing every token in pool.
 func (suite *KeeperTestSuite) TestJoinPoolNoSwap() {
 	fiveKFooAndBar := sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(5000)), sdk.NewCoin("adym", sdk.NewInt(5000)))
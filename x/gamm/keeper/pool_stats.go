@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+// GetPoolStats returns the stats of the given pool since it was created.
+func (k Keeper) GetPoolStats(ctx sdk.Context, poolId uint64) types.PoolStats {
+	stats, _ := k.getPoolStats(ctx, types.GetKeyPoolStats(poolId), poolId, 0)
+	return stats
+}
+
+// GetCurrentEpochPoolStats returns the stats of the given pool over the current epoch.
+// The epoch number of the stats is the one of the current epoch of the stats epoch identifier.
+func (k Keeper) GetCurrentEpochPoolStats(ctx sdk.Context, poolId uint64) types.PoolStats {
+	stats, _ := k.getPoolStats(ctx, types.GetKeyCurrentEpochPoolStats(poolId), poolId, 0)
+	stats.EpochNumber = k.epochKeeper.GetEpochInfo(ctx, k.GetParams(ctx).StatsEpochIdentifier).CurrentEpoch
+	return stats
+}
+
+// GetEpochPoolStats returns the stats of the given pool over the given finished epoch,
+// and false if the pool was not swapped in over the epoch, or the epoch is no longer kept.
+func (k Keeper) GetEpochPoolStats(ctx sdk.Context, epochNumber int64, poolId uint64) (types.PoolStats, bool) {
+	return k.getPoolStats(ctx, types.GetKeyEpochPoolStats(epochNumber, poolId), poolId, epochNumber)
+}
+
+// GetLastEpochsPoolStats returns the stats of the given pool over the last finished epochs,
+// at most the given number of them and the stats history length, from the latest.
+// Epochs the pool was not swapped in over are skipped.
+func (k Keeper) GetLastEpochsPoolStats(ctx sdk.Context, poolId uint64, numEpochs uint64) []types.PoolStats {
+	params := k.GetParams(ctx)
+	if numEpochs == 0 || numEpochs > params.StatsHistoryLength {
+		numEpochs = params.StatsHistoryLength
+	}
+
+	stats := []types.PoolStats{}
+	lastEpoch := k.epochKeeper.GetEpochInfo(ctx, params.StatsEpochIdentifier).CurrentEpoch - 1
+	for epochNumber := lastEpoch; epochNumber > 0 && epochNumber > lastEpoch-int64(numEpochs); epochNumber-- {
+		if epochStats, found := k.GetEpochPoolStats(ctx, epochNumber, poolId); found {
+			stats = append(stats, epochStats)
+		}
+	}
+	return stats
+}
+
+func (k Keeper) getPoolStats(ctx sdk.Context, key []byte, poolId uint64, epochNumber int64) (types.PoolStats, bool) {
+	store := ctx.KVStore(k.storeKey)
+	stats := types.NewPoolStats(poolId, epochNumber)
+	found, err := osmoutils.Get(store, key, &stats)
+	if err != nil {
+		panic(err)
+	}
+	return stats, found
+}
+
+// recordSwapStats adds a swap to the stats of the given pool, since it was created and over
+// the current epoch. The whole of tokenIn and tokenOut count towards the volume, and the
+// swap fee is charged on tokenIn.
+func (k Keeper) recordSwapStats(ctx sdk.Context, poolId uint64, tokenIn, tokenOut sdk.Coin, swapFee sdk.Dec) {
+	volume := sdk.NewCoins(tokenIn, tokenOut)
+	swapFees := sdk.NewCoins(sdk.NewCoin(tokenIn.Denom, swapFee.MulInt(tokenIn.Amount).TruncateInt()))
+	k.updatePoolStats(ctx, poolId, func(stats *types.PoolStats) {
+		stats.Volume = stats.Volume.Add(volume...)
+		stats.SwapFees = stats.SwapFees.Add(swapFees...)
+	})
+}
+
+// recordTakerFeeStats adds the taker fee of a swap routed through the given pool first to the
+// stats of the pool, since it was created and over the current epoch. Taker fees of swaps
+// routed through pools of other modules are not recorded.
+func (k Keeper) recordTakerFeeStats(ctx sdk.Context, poolId uint64, takerFee sdk.Coin) {
+	if !ctx.KVStore(k.storeKey).Has(types.GetKeyPrefixPools(poolId)) {
+		return
+	}
+	k.updatePoolStats(ctx, poolId, func(stats *types.PoolStats) {
+		stats.TakerFees = stats.TakerFees.Add(takerFee)
+	})
+}
+
+func (k Keeper) updatePoolStats(ctx sdk.Context, poolId uint64, update func(stats *types.PoolStats)) {
+	for _, key := range [][]byte{types.GetKeyPoolStats(poolId), types.GetKeyCurrentEpochPoolStats(poolId)} {
+		stats, _ := k.getPoolStats(ctx, key, poolId, 0)
+		update(&stats)
+		k.setPoolStats(ctx, key, stats)
+	}
+}
+
+// endStatsEpoch moves the stats of the pools over the current epoch to the stats over the
+// given finished epoch, and deletes the stats over the epochs no longer kept.
+func (k Keeper) endStatsEpoch(ctx sdk.Context, epochNumber int64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, stats := range k.getAllPoolStats(ctx, types.KeyPrefixCurrentEpochPoolStats) {
+		store.Delete(types.GetKeyCurrentEpochPoolStats(stats.PoolId))
+		stats.EpochNumber = epochNumber
+		k.setPoolStats(ctx, types.GetKeyEpochPoolStats(epochNumber, stats.PoolId), stats)
+	}
+
+	lastPrunedEpoch := epochNumber - int64(k.GetParams(ctx).StatsHistoryLength)
+	if lastPrunedEpoch < 1 {
+		return
+	}
+	iter := store.Iterator(types.KeyPrefixEpochPoolStats, types.GetKeyPrefixEpochPoolStats(lastPrunedEpoch+1))
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) getAllPoolStats(ctx sdk.Context, prefix []byte) []types.PoolStats {
+	store := ctx.KVStore(k.storeKey)
+	stats, err := osmoutils.GatherValuesFromStorePrefix(store, prefix, func(bz []byte) (types.PoolStats, error) {
+		stats := types.PoolStats{}
+		err := k.cdc.Unmarshal(bz, &stats)
+		return stats, err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return stats
+}
+
+func (k Keeper) setPoolStats(ctx sdk.Context, key []byte, stats types.PoolStats) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, key, &stats)
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/osmosis-labs/osmosis/v15/x/epochs/types"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v15/x/lockup/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// setStatsEpoch makes the given epoch the current epoch of the stats epoch identifier.
+func (suite *KeeperTestSuite) setStatsEpoch(epochNumber int64) {
+	identifier := suite.App.GAMMKeeper.GetParams(suite.Ctx).StatsEpochIdentifier
+	suite.App.EpochsKeeper.DeleteEpochInfo(suite.Ctx, identifier)
+	err := suite.App.EpochsKeeper.AddEpochInfo(suite.Ctx, epochstypes.EpochInfo{
+		Identifier:            identifier,
+		Duration:              24 * time.Hour,
+		CurrentEpoch:          epochNumber,
+		EpochCountingStarted:  true,
+		CurrentEpochStartTime: suite.Ctx.BlockTime(),
+	})
+	suite.Require().NoError(err)
+}
+
+// endStatsEpoch ends the given epoch of the stats epoch identifier, and starts the next one.
+func (suite *KeeperTestSuite) endStatsEpoch(epochNumber int64) {
+	identifier := suite.App.GAMMKeeper.GetParams(suite.Ctx).StatsEpochIdentifier
+	err := suite.App.GAMMKeeper.EpochHooks().AfterEpochEnd(suite.Ctx, identifier, epochNumber)
+	suite.Require().NoError(err)
+	suite.setStatsEpoch(epochNumber + 1)
+}
+
+// swapBaseDenomIn swaps the given amount of the base denom for foo in the given pool, charging the taker fee.
+func (suite *KeeperTestSuite) swapBaseDenomIn(poolId uint64, amount sdk.Int) {
+	tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	suite.FundAcc(suite.TestAccs[1], sdk.NewCoins(tokenIn))
+	routes := []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "foo"}}
+	_, err := suite.App.PoolManagerKeeper.SwapExactAmountInWithTakerFee(suite.Ctx, suite.TestAccs[1], routes, tokenIn, sdk.OneInt(), sdk.Dec{})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestPoolStats() {
+	suite.SetupTest()
+	gammKeeper := suite.App.GAMMKeeper
+	params := gammKeeper.GetParams(suite.Ctx)
+	params.StatsHistoryLength = 2
+	gammKeeper.SetParams(suite.Ctx, params)
+	suite.setStatsEpoch(1)
+
+	swapFee := sdk.MustNewDecFromStr("0.01")
+	poolId := suite.PrepareCustomBalancerPoolFromCoins(sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1_000_000_000)),
+		sdk.NewCoin("foo", sdk.NewInt(1_000_000_000)),
+	), balancer.PoolParams{SwapFee: swapFee, ExitFee: sdk.ZeroDec()})
+	suite.swapBaseDenomIn(poolId, sdk.NewInt(1_000_000))
+
+	// the taker fee is taken from the amount in, and the swap fee from what is left of it.
+	tokenInAfterTakerFee, takerFee := gammKeeper.SubTakerFee(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1_000_000)), gammKeeper.GetTakerFee(suite.Ctx))
+	stats := gammKeeper.GetPoolStats(suite.Ctx, poolId)
+	suite.Require().Equal(tokenInAfterTakerFee.Amount, stats.Volume.AmountOf(sdk.DefaultBondDenom))
+	suite.Require().True(stats.Volume.AmountOf("foo").IsPositive())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, swapFee.MulInt(tokenInAfterTakerFee.Amount).TruncateInt())), stats.SwapFees)
+	suite.Require().Equal(sdk.NewCoins(takerFee), stats.TakerFees)
+
+	currentEpochStats := gammKeeper.GetCurrentEpochPoolStats(suite.Ctx, poolId)
+	suite.Require().Equal(int64(1), currentEpochStats.EpochNumber)
+	suite.Require().Equal(stats.Volume, currentEpochStats.Volume)
+
+	// at the end of an epoch, the stats over it move to the history.
+	suite.endStatsEpoch(1)
+	suite.Require().True(gammKeeper.GetCurrentEpochPoolStats(suite.Ctx, poolId).Volume.IsZero())
+	suite.Require().Equal(stats, gammKeeper.GetPoolStats(suite.Ctx, poolId))
+	epochStats, found := gammKeeper.GetEpochPoolStats(suite.Ctx, 1, poolId)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(1), epochStats.EpochNumber)
+	suite.Require().Equal(stats.Volume, epochStats.Volume)
+
+	// epochs without swaps are skipped, and epochs past the history length are deleted.
+	suite.swapBaseDenomIn(poolId, sdk.NewInt(2_000_000))
+	suite.endStatsEpoch(2)
+	suite.endStatsEpoch(3)
+	_, found = gammKeeper.GetEpochPoolStats(suite.Ctx, 1, poolId)
+	suite.Require().False(found)
+	lastEpochsStats := gammKeeper.GetLastEpochsPoolStats(suite.Ctx, poolId, 0)
+	suite.Require().Len(lastEpochsStats, 1)
+	suite.Require().Equal(int64(2), lastEpochsStats[0].EpochNumber)
+	suite.Require().Equal(gammKeeper.GetPoolStats(suite.Ctx, poolId).Volume, stats.Volume.Add(lastEpochsStats[0].Volume...))
+
+	res, err := suite.queryClient.PoolStats(suite.Ctx.Context(), &types.QueryPoolStatsRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(gammKeeper.GetPoolStats(suite.Ctx, poolId), res.Stats)
+	suite.Require().Equal(int64(4), res.CurrentEpochStats.EpochNumber)
+
+	historyRes, err := suite.queryClient.PoolStatsHistory(suite.Ctx.Context(), &types.QueryPoolStatsHistoryRequest{PoolId: poolId, NumEpochs: 1})
+	suite.Require().NoError(err)
+	suite.Require().Empty(historyRes.Stats)
+	historyRes, err = suite.queryClient.PoolStatsHistory(suite.Ctx.Context(), &types.QueryPoolStatsHistoryRequest{PoolId: poolId, NumEpochs: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(lastEpochsStats, historyRes.Stats)
+
+	_, err = suite.queryClient.PoolStats(suite.Ctx.Context(), &types.QueryPoolStatsRequest{PoolId: poolId + 1})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestPoolAPR() {
+	suite.SetupTest()
+	gammKeeper := suite.App.GAMMKeeper
+	suite.setStatsEpoch(1)
+
+	poolId := suite.PrepareCustomBalancerPoolFromCoins(sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1_000_000_000)),
+		sdk.NewCoin("foo", sdk.NewInt(1_000_000_000)),
+	), balancer.PoolParams{SwapFee: sdk.MustNewDecFromStr("0.01"), ExitFee: sdk.ZeroDec()})
+
+	// without revenue over finished epochs nor gauges, the APR is zero.
+	res, err := suite.queryClient.PoolAPR(suite.Ctx.Context(), &types.QueryPoolAPRRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.DefaultBondDenom, res.Denom)
+	suite.Require().True(res.LiquidityValue.IsPositive())
+	suite.Require().True(res.Apr.IsZero())
+
+	// the swap fees of the last finished epoch are annualized over the daily epochs.
+	suite.swapBaseDenomIn(poolId, sdk.NewInt(1_000_000))
+	suite.endStatsEpoch(1)
+
+	// the gauge rewards are paid evenly over the remaining weekly epochs of the incentives.
+	incentivesKeeper := suite.App.IncentivesKeeper
+	gaugeCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10_000_000)))
+	suite.FundAcc(suite.TestAccs[0], gaugeCoins)
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         types.GetPoolShareDenom(poolId),
+		Duration:      incentivesKeeper.GetLockableDurations(suite.Ctx)[0],
+	}
+	gaugeId, err := incentivesKeeper.CreateGauge(suite.Ctx, false, suite.TestAccs[0], gaugeCoins, distrTo, suite.Ctx.BlockTime(), 10)
+	suite.Require().NoError(err)
+	err = incentivesKeeper.AfterEpochEnd(suite.Ctx, incentivesKeeper.GetParams(suite.Ctx).DistrEpochIdentifier, 1)
+	suite.Require().NoError(err)
+	gauge, err := incentivesKeeper.GetGaugeByID(suite.Ctx, gaugeId)
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.PoolAPR(suite.Ctx.Context(), &types.QueryPoolAPRRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+
+	swapFees := gammKeeper.GetPoolStats(suite.Ctx, poolId).SwapFees.AmountOf(sdk.DefaultBondDenom)
+	expectedSwapFeeAPR := sdk.NewDecFromInt(swapFees).MulInt64(365).Quo(res.LiquidityValue)
+	suite.Require().Equal(expectedSwapFeeAPR, res.SwapFeeApr)
+
+	epochRewards := sdk.NewDecFromInt(gauge.Coins.Sub(gauge.DistributedCoins...).AmountOf(sdk.DefaultBondDenom)).QuoInt64(int64(gauge.NumEpochsPaidOver - gauge.FilledEpochs))
+	weeksPerYear := sdk.NewDec(365).QuoInt64(7)
+	expectedIncentivesAPR := epochRewards.Mul(weeksPerYear).Quo(res.LiquidityValue)
+	suite.Require().True(expectedIncentivesAPR.Sub(res.IncentivesApr).Abs().LTE(sdk.NewDecWithPrec(1, 15)))
+	suite.Require().Equal(res.SwapFeeApr.Add(res.IncentivesApr), res.Apr)
+}
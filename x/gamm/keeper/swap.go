@@ -161,6 +161,46 @@ func (k Keeper) CalcInAmtGivenOut(
 	return cfmmPool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), tokenInDenom, swapFee)
 }
 
+// SimulateSwapExactAmountIn calculates the amount of tokenOut given tokenIn and returns the pool
+// with its liquidity updated for the swap. The state is left untouched.
+func (k Keeper) SimulateSwapExactAmountIn(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+) (tokenOut sdk.Coin, poolAfterSwap poolmanagertypes.PoolI, err error) {
+	cfmmPool, err := convertToCFMMPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	tokenOut, err = cfmmPool.SwapOutAmtGivenIn(ctx, sdk.NewCoins(tokenIn), tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	return tokenOut, cfmmPool, nil
+}
+
+// SimulateSwapExactAmountOut calculates the amount of tokenIn given tokenOut and returns the pool
+// with its liquidity updated for the swap. The state is left untouched.
+func (k Keeper) SimulateSwapExactAmountOut(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (tokenIn sdk.Coin, poolAfterSwap poolmanagertypes.PoolI, err error) {
+	cfmmPool, err := convertToCFMMPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	tokenIn, err = cfmmPool.SwapInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	return tokenIn, cfmmPool, nil
+}
+
 // updatePoolForSwap takes a pool, sender, and tokenIn, tokenOut amounts
 // It then updates the pool's balances to the new reserve amounts, and
 // sends the in tokens from the sender to the pool, and the out tokens from the pool to the sender.
@@ -174,6 +214,14 @@ func (k Keeper) updatePoolForSwap(
 	tokensIn := sdk.Coins{tokenIn}
 	tokensOut := sdk.Coins{tokenOut}
 
+	// the swap fee of a pool may depend on the price move of the swap, it is read before it is tracked.
+	swapFee := pool.GetSwapFee(ctx)
+	if dynamicSwapFeePool, ok := pool.(types.DynamicSwapFeePoolExtension); ok {
+		if err := dynamicSwapFeePool.TrackPriceMovement(ctx); err != nil {
+			return err
+		}
+	}
+
 	err := k.setPool(ctx, pool)
 	if err != nil {
 		return err
@@ -199,12 +247,12 @@ func (k Keeper) updatePoolForSwap(
 	}
 
 	takerFee := k.GetParams(ctx).TakerFee
-	swapFee := pool.GetSwapFee(ctx)
 
 	events.EmitSwapEvent(ctx, sender, pool.GetId(), tokensIn, tokensOut, spotPrice, takerFee, swapFee)
 	k.hooks.AfterSwap(ctx, sender, pool.GetId(), tokensIn, tokensOut)
 	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
 	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
+	k.recordSwapStats(ctx, pool.GetId(), tokenIn, tokenOut, swapFee)
 
 	return err
 }
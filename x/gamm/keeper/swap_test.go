@@ -437,6 +437,44 @@ func (suite *KeeperTestSuite) TestActiveBalancerPoolSwap() {
 	}
 }
 
+// TestDynamicSwapFeePoolSwap tests that the swaps of a pool with dynamic swap fee params
+// are tracked, raising its swap fee until the volatility decays.
+func (suite *KeeperTestSuite) TestDynamicSwapFeePoolSwap() {
+	suite.SetupTest()
+	dynamicSwapFeeParams := &balancer.DynamicSwapFeeParams{
+		BaseFee:     sdk.NewDecWithPrec(2, 3),
+		MaxFee:      sdk.NewDecWithPrec(5, 2),
+		Sensitivity: sdk.NewDecWithPrec(5, 1),
+	}
+	poolId := suite.PrepareCustomBalancerPoolFromCoins(
+		sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(1000000)), sdk.NewCoin("foo", sdk.NewInt(1000000))),
+		balancer.PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec(), DynamicSwapFeeParams: dynamicSwapFeeParams},
+	)
+
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	swapFee := pool.GetSwapFee(suite.Ctx)
+	suite.Require().Equal(dynamicSwapFeeParams.BaseFee, swapFee)
+
+	tokenIn := sdk.NewCoin("foo", sdk.NewInt(20000))
+	suite.FundAcc(suite.TestAccs[1], sdk.NewCoins(tokenIn))
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[1], pool, tokenIn, "bar", sdk.OneInt(), swapFee)
+	suite.Require().NoError(err)
+
+	// the price move of the swap is stored with the pool.
+	pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	tracker := pool.(*balancer.Pool).VolatilityTracker
+	suite.Require().NotNil(tracker)
+	suite.Require().True(tracker.Volatility.IsPositive())
+	expectedSwapFee := dynamicSwapFeeParams.BaseFee.Add(dynamicSwapFeeParams.Sensitivity.Mul(tracker.Volatility))
+	suite.Require().Equal(expectedSwapFee, pool.GetSwapFee(suite.Ctx))
+
+	// the swap fee is back to the base fee once the volatility decayed.
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(balancer.DynamicSwapFeeDecayPeriod))
+	suite.Require().Equal(dynamicSwapFeeParams.BaseFee, pool.GetSwapFee(suite.Ctx))
+}
+
 // Test two pools -- one is active and should have swaps allowed,
 // while the other is inactive and should have swaps frozen.
 // As shown in the following test, we can mock a pool by calling
@@ -8,7 +8,25 @@ import (
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
 
-// ChargeTakerFee charges the taker fee to the sender
+// GetTakerFee returns the taker fee charged on swaps.
+func (k Keeper) GetTakerFee(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).TakerFee
+}
+
+// ChargeTakerFee charges the taker fee of a swap from inDenom to outDenom to the sender,
+// rewarding the RollApp owner involved in the swap, if any.
+func (k Keeper) ChargeTakerFee(
+	ctx sdk.Context,
+	takerFeeCoin sdk.Coin,
+	sender sdk.AccAddress,
+	route poolmanagertypes.SwapAmountInRoute,
+	inDenom, outDenom string,
+) error {
+	beneficiary := k.getTakerFeeBeneficiary(ctx, inDenom, outDenom)
+	return k.chargeTakerFee(ctx, takerFeeCoin, sender, route, beneficiary)
+}
+
+// chargeTakerFee charges the taker fee to the sender
 // If the taker fee coin is the base denom, send it to the txfees module
 // If the taker fee coin is a registered fee token, send it to the txfees module
 // If the taker fee coin is not supported, swap it to the base denom on the first pool, then send it to the txfees module
@@ -23,6 +41,7 @@ func (k Keeper) chargeTakerFee(
 	if takerFeeCoin.IsZero() {
 		return nil
 	}
+	k.recordTakerFeeStats(ctx, route.PoolId, takerFeeCoin)
 
 	// Check if the taker fee coin is the base denom
 	denom, err := k.txfeeKeeper.GetBaseDenom(ctx)
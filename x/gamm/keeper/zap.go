@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// ZapIn joins pool #{poolId} with tokenIn, which the pool does not need to hold. tokenIn is swapped
+// through the routes, charging the taker fee, to the asset of the pool the last route swaps to, and
+// the pool is joined with the proceeds as by JoinSwapExactAmountIn, for at least shareOutMinAmount shares.
+// If lockDuration is positive, the shares are locked for it.
+// Returns the amount of shares minted to the sender, and the id of their lock, zero if they are not locked.
+// The caller is responsible for reverting the swap if a later step fails, as the message execution does.
+func (k Keeper) ZapIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	routes []poolmanagertypes.SwapAmountInRoute,
+	shareOutMinAmount sdk.Int,
+	lockDuration time.Duration,
+) (sharesOut sdk.Int, lockId uint64, err error) {
+	if err := types.SwapAmountInRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, 0, err
+	}
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, 0, err
+	}
+	joinDenom := routes[len(routes)-1].TokenOutDenom
+	if !pool.GetTotalPoolLiquidity(ctx).AmountOf(joinDenom).IsPositive() {
+		return sdk.Int{}, 0, sdkerrors.Wrapf(types.ErrDenomNotFoundInPool, "routes swap to %s, which pool %d does not hold", joinDenom, poolId)
+	}
+
+	// the min amount of shares out bounds the whole zap-in, the swap only needs to output a positive amount.
+	tokenOutAmount, err := k.poolManager.SwapExactAmountInWithTakerFee(ctx, sender, routes, tokenIn, sdk.OneInt(), sdk.Dec{})
+	if err != nil {
+		return sdk.Int{}, 0, err
+	}
+	sharesOut, err = k.JoinSwapExactAmountIn(ctx, sender, poolId, sdk.NewCoins(sdk.NewCoin(joinDenom, tokenOutAmount)), shareOutMinAmount)
+	if err != nil {
+		return sdk.Int{}, 0, err
+	}
+
+	if lockDuration <= 0 {
+		return sharesOut, 0, nil
+	}
+	lock, err := k.lockupKeeper.CreateLock(ctx, sender, sdk.NewCoins(sdk.NewCoin(types.GetPoolShareDenom(poolId), sharesOut)), lockDuration)
+	if err != nil {
+		return sdk.Int{}, 0, err
+	}
+	return sharesOut, lock.ID, nil
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+func (suite *KeeperTestSuite) TestZapIn() {
+	tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1_000_000))
+	tests := map[string]struct {
+		routes            []poolmanagertypes.SwapAmountInRoute
+		targetPool        uint64
+		shareOutMinAmount sdk.Int
+		lockDuration      time.Duration
+		expectErr         error
+	}{
+		"zap in": {
+			routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "foo"}},
+			targetPool:        2,
+			shareOutMinAmount: sdk.OneInt(),
+		},
+		"zap in and lock": {
+			routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "foo"}},
+			targetPool:        2,
+			shareOutMinAmount: sdk.OneInt(),
+			lockDuration:      time.Hour,
+		},
+		"multihop zap in": {
+			routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "foo"}, {PoolId: 2, TokenOutDenom: "bar"}},
+			targetPool:        2,
+			shareOutMinAmount: sdk.OneInt(),
+		},
+		"routes not ending with an asset of the pool": {
+			routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "foo"}},
+			targetPool:        3,
+			shareOutMinAmount: sdk.OneInt(),
+			expectErr:         types.ErrDenomNotFoundInPool,
+		},
+		"too few shares out": {
+			routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "foo"}},
+			targetPool:        2,
+			shareOutMinAmount: sdk.NewIntFromBigInt(types.InitPoolSharesSupply.BigInt()),
+			expectErr:         types.ErrLimitMinAmount,
+		},
+		"unknown pool": {
+			routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "foo"}},
+			targetPool:        4,
+			shareOutMinAmount: sdk.OneInt(),
+			expectErr:         types.PoolDoesNotExistError{PoolId: 4},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000), sdk.NewInt64Coin("foo", 1_000_000_000))
+			suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 1_000_000_000), sdk.NewInt64Coin("bar", 1_000_000_000))
+			suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("bar", 1_000_000_000), sdk.NewInt64Coin("baz", 1_000_000_000))
+			sender := suite.TestAccs[1]
+			suite.FundAcc(sender, sdk.NewCoins(tokenIn))
+			shareDenom := types.GetPoolShareDenom(tc.targetPool)
+
+			msg := &types.MsgZapIn{
+				Sender:            sender.String(),
+				PoolId:            tc.targetPool,
+				TokenIn:           tokenIn,
+				Routes:            tc.routes,
+				ShareOutMinAmount: tc.shareOutMinAmount,
+				LockDuration:      tc.lockDuration,
+			}
+			res, err := keeper.NewMsgServerImpl(suite.App.GAMMKeeper).ZapIn(sdk.WrapSDKContext(suite.Ctx), msg)
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(res.ShareOutAmount.GTE(tc.shareOutMinAmount))
+			suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, sender, sdk.DefaultBondDenom).IsZero())
+
+			if tc.lockDuration == 0 {
+				suite.Require().Zero(res.LockId)
+				suite.Require().Equal(res.ShareOutAmount, suite.App.BankKeeper.GetBalance(suite.Ctx, sender, shareDenom).Amount)
+				return
+			}
+			suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, sender, shareDenom).IsZero())
+			lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, res.LockId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sender.String(), lock.Owner)
+			suite.Require().Equal(tc.lockDuration, lock.Duration)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(shareDenom, res.ShareOutAmount)), lock.Coins)
+		})
+	}
+}
@@ -37,6 +37,36 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// LiquidityBootstrappingEndBehavior is what happens to the weights of a
+// liquidity bootstrapping pool once its sale ends.
+type LiquidityBootstrappingEndBehavior int32
+
+const (
+	// The weights stay at the target weights of the sale, and can not be
+	// changed anymore.
+	LBP_END_FREEZE_WEIGHTS LiquidityBootstrappingEndBehavior = 0
+	// The weights are set equal, turning the pool into a normal 50/50 pool.
+	LBP_END_EQUALIZE_WEIGHTS LiquidityBootstrappingEndBehavior = 1
+)
+
+var LiquidityBootstrappingEndBehavior_name = map[int32]string{
+	0: "LBP_END_FREEZE_WEIGHTS",
+	1: "LBP_END_EQUALIZE_WEIGHTS",
+}
+
+var LiquidityBootstrappingEndBehavior_value = map[string]int32{
+	"LBP_END_FREEZE_WEIGHTS":   0,
+	"LBP_END_EQUALIZE_WEIGHTS": 1,
+}
+
+func (x LiquidityBootstrappingEndBehavior) String() string {
+	return proto.EnumName(LiquidityBootstrappingEndBehavior_name, int32(x))
+}
+
+func (LiquidityBootstrappingEndBehavior) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8d4bbe639fdbfe9e, []int{0}
+}
+
 // Parameters for changing the weights in a balancer pool smoothly from
 // a start weight and end weight over a period of time.
 // Currently, the only smooth change supported is linear changing between
@@ -130,6 +160,181 @@ func (m *SmoothWeightChangeParams) GetTargetPoolWeights() []PoolAsset {
 	return nil
 }
 
+// DynamicSwapFeeParams make the swap fee of a balancer pool rise with the
+// recent movement of its spot price. The swap fee of the pool is:
+//
+//	swap_fee = min(base_fee + sensitivity * volatility, max_fee)
+//
+// where volatility is the sum of the relative moves of the spot price caused
+// by the swaps of the pool, each decaying linearly to zero over an hour.
+type DynamicSwapFeeParams struct {
+	// The swap fee of the pool when its price did not move recently.
+	BaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee" yaml:"base_fee"`
+	// The swap fee of the pool cannot exceed max_fee.
+	MaxFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_fee,json=maxFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_fee" yaml:"max_fee"`
+	// The increase of the swap fee per unit of volatility.
+	Sensitivity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=sensitivity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sensitivity" yaml:"sensitivity"`
+}
+
+func (m *DynamicSwapFeeParams) Reset()         { *m = DynamicSwapFeeParams{} }
+func (m *DynamicSwapFeeParams) String() string { return proto.CompactTextString(m) }
+func (*DynamicSwapFeeParams) ProtoMessage()    {}
+func (*DynamicSwapFeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d4bbe639fdbfe9e, []int{1}
+}
+func (m *DynamicSwapFeeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSwapFeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSwapFeeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSwapFeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSwapFeeParams.Merge(m, src)
+}
+func (m *DynamicSwapFeeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSwapFeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSwapFeeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSwapFeeParams proto.InternalMessageInfo
+
+// VolatilityTracker tracks the recent movement of the spot price of a balancer
+// pool with a dynamic swap fee. The spot price tracked is the one of its first
+// asset in terms of its second asset, by denom.
+type VolatilityTracker struct {
+	// The spot price of the pool after the last swap.
+	LastSpotPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=last_spot_price,json=lastSpotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_spot_price" yaml:"last_spot_price"`
+	// The volatility of the pool at last_update_time.
+	Volatility github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=volatility,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volatility" yaml:"volatility"`
+	// The block time of the last swap.
+	LastUpdateTime time.Time `protobuf:"bytes,3,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time" yaml:"last_update_time"`
+}
+
+func (m *VolatilityTracker) Reset()         { *m = VolatilityTracker{} }
+func (m *VolatilityTracker) String() string { return proto.CompactTextString(m) }
+func (*VolatilityTracker) ProtoMessage()    {}
+func (*VolatilityTracker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d4bbe639fdbfe9e, []int{2}
+}
+func (m *VolatilityTracker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolatilityTracker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolatilityTracker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VolatilityTracker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolatilityTracker.Merge(m, src)
+}
+func (m *VolatilityTracker) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolatilityTracker) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolatilityTracker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolatilityTracker proto.InternalMessageInfo
+
+func (m *VolatilityTracker) GetLastUpdateTime() time.Time {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return time.Time{}
+}
+
+// LiquidityBootstrappingParams are set for the balancer pools created as
+// liquidity bootstrapping pools. During the sale, from start_time to
+// end_time, the weights of the pool change smoothly, and only the creator can
+// join the pool.
+type LiquidityBootstrappingParams struct {
+	// The creator of the pool, the only account allowed to join it during the
+	// sale.
+	Creator     string                            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	StartTime   time.Time                         `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime     time.Time                         `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	EndBehavior LiquidityBootstrappingEndBehavior `protobuf:"varint,4,opt,name=end_behavior,json=endBehavior,proto3,enum=dymensionxyz.dymension.gamm.v1beta1.LiquidityBootstrappingEndBehavior" json:"end_behavior,omitempty" yaml:"end_behavior"`
+}
+
+func (m *LiquidityBootstrappingParams) Reset()         { *m = LiquidityBootstrappingParams{} }
+func (m *LiquidityBootstrappingParams) String() string { return proto.CompactTextString(m) }
+func (*LiquidityBootstrappingParams) ProtoMessage()    {}
+func (*LiquidityBootstrappingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d4bbe639fdbfe9e, []int{3}
+}
+func (m *LiquidityBootstrappingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityBootstrappingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityBootstrappingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityBootstrappingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityBootstrappingParams.Merge(m, src)
+}
+func (m *LiquidityBootstrappingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityBootstrappingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityBootstrappingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityBootstrappingParams proto.InternalMessageInfo
+
+func (m *LiquidityBootstrappingParams) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *LiquidityBootstrappingParams) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *LiquidityBootstrappingParams) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *LiquidityBootstrappingParams) GetEndBehavior() LiquidityBootstrappingEndBehavior {
+	if m != nil {
+		return m.EndBehavior
+	}
+	return LBP_END_FREEZE_WEIGHTS
+}
+
 // PoolParams defined the parameters that will be managed by the pool
 // governance in the future. This params are not managed by the chain
 // governance. Instead they will be managed by the token holders of the pool.
@@ -138,13 +343,16 @@ type PoolParams struct {
 	SwapFee                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
 	ExitFee                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
 	SmoothWeightChangeParams *SmoothWeightChangeParams              `protobuf:"bytes,3,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params,omitempty" yaml:"smooth_weight_change_params"`
+	// When set, the swap fee of the pool is computed from these params and
+	// swap_fee is not used.
+	DynamicSwapFeeParams *DynamicSwapFeeParams `protobuf:"bytes,4,opt,name=dynamic_swap_fee_params,json=dynamicSwapFeeParams,proto3" json:"dynamic_swap_fee_params,omitempty" yaml:"dynamic_swap_fee_params"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
 func (m *PoolParams) String() string { return proto.CompactTextString(m) }
 func (*PoolParams) ProtoMessage()    {}
 func (*PoolParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d4bbe639fdbfe9e, []int{1}
+	return fileDescriptor_8d4bbe639fdbfe9e, []int{4}
 }
 func (m *PoolParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,6 +388,13 @@ func (m *PoolParams) GetSmoothWeightChangeParams() *SmoothWeightChangeParams {
 	return nil
 }
 
+func (m *PoolParams) GetDynamicSwapFeeParams() *DynamicSwapFeeParams {
+	if m != nil {
+		return m.DynamicSwapFeeParams
+	}
+	return nil
+}
+
 // Pool asset is an internal struct that combines the amount of the
 // token in the pool, and its balancer weight.
 // This is an awkward packaging of data,
@@ -196,7 +411,7 @@ func (m *PoolAsset) Reset()         { *m = PoolAsset{} }
 func (m *PoolAsset) String() string { return proto.CompactTextString(m) }
 func (*PoolAsset) ProtoMessage()    {}
 func (*PoolAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d4bbe639fdbfe9e, []int{2}
+	return fileDescriptor_8d4bbe639fdbfe9e, []int{5}
 }
 func (m *PoolAsset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -253,12 +468,20 @@ type Pool struct {
 	PoolAssets []PoolAsset `protobuf:"bytes,6,rep,name=pool_assets,json=poolAssets,proto3" json:"pool_assets" yaml:"pool_assets"`
 	// sum of all non-normalized pool weights
 	TotalWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_weight" yaml:"total_weight"`
+	// Set for the pools with dynamic swap fee params.
+	VolatilityTracker *VolatilityTracker `protobuf:"bytes,8,opt,name=volatility_tracker,json=volatilityTracker,proto3" json:"volatility_tracker,omitempty" yaml:"volatility_tracker"`
+	// swaps_paused is set by the pool governor to disable swaps against the
+	// pool. A pool with paused swaps is not active.
+	SwapsPaused bool `protobuf:"varint,9,opt,name=swaps_paused,json=swapsPaused,proto3" json:"swaps_paused,omitempty" yaml:"swaps_paused"`
+	// Set for the pools created as liquidity bootstrapping pools. Cleared when
+	// the sale of a pool equalizing its weights ends.
+	LiquidityBootstrappingParams *LiquidityBootstrappingParams `protobuf:"bytes,10,opt,name=liquidity_bootstrapping_params,json=liquidityBootstrappingParams,proto3" json:"liquidity_bootstrapping_params,omitempty" yaml:"liquidity_bootstrapping_params"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
 func (*Pool) ProtoMessage() {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d4bbe639fdbfe9e, []int{3}
+	return fileDescriptor_8d4bbe639fdbfe9e, []int{6}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,7 +511,11 @@ func (m *Pool) XXX_DiscardUnknown() {
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.gamm.v1beta1.LiquidityBootstrappingEndBehavior", LiquidityBootstrappingEndBehavior_name, LiquidityBootstrappingEndBehavior_value)
 	proto.RegisterType((*SmoothWeightChangeParams)(nil), "dymensionxyz.dymension.gamm.v1beta1.SmoothWeightChangeParams")
+	proto.RegisterType((*DynamicSwapFeeParams)(nil), "dymensionxyz.dymension.gamm.v1beta1.DynamicSwapFeeParams")
+	proto.RegisterType((*VolatilityTracker)(nil), "dymensionxyz.dymension.gamm.v1beta1.VolatilityTracker")
+	proto.RegisterType((*LiquidityBootstrappingParams)(nil), "dymensionxyz.dymension.gamm.v1beta1.LiquidityBootstrappingParams")
 	proto.RegisterType((*PoolParams)(nil), "dymensionxyz.dymension.gamm.v1beta1.PoolParams")
 	proto.RegisterType((*PoolAsset)(nil), "dymensionxyz.dymension.gamm.v1beta1.PoolAsset")
 	proto.RegisterType((*Pool)(nil), "dymensionxyz.dymension.gamm.v1beta1.Pool")
@@ -299,61 +526,91 @@ func init() {
 }
 
 var fileDescriptor_8d4bbe639fdbfe9e = []byte{
-	// 855 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0xb4, 0xd9, 0x4e, 0x96, 0x45, 0x9d, 0xcd, 0xc1, 0x4d, 0x45, 0xbc, 0x9a, 0x95,
-	0xd0, 0x0a, 0x6d, 0x6c, 0x65, 0x11, 0x1c, 0x56, 0x42, 0xab, 0xcd, 0xee, 0x82, 0xf6, 0x56, 0x5c,
-	0xa4, 0x52, 0x84, 0x64, 0x4d, 0xe2, 0xa9, 0x6d, 0xd5, 0xf6, 0x58, 0x9e, 0x49, 0xdb, 0x70, 0x44,
-	0x42, 0xe2, 0xd8, 0x63, 0x8f, 0x3d, 0x22, 0x71, 0xe5, 0x8f, 0xa8, 0x38, 0x55, 0xe2, 0x82, 0x38,
-	0x18, 0xd4, 0xde, 0xe0, 0x16, 0xfe, 0x01, 0x34, 0x3f, 0xec, 0xa4, 0xa5, 0x15, 0x6d, 0x39, 0xd9,
-	0xef, 0xcd, 0x7b, 0xdf, 0xfb, 0xde, 0x7b, 0xdf, 0xd8, 0xe0, 0x63, 0x7f, 0x9a, 0x90, 0x94, 0x45,
-	0x34, 0x3d, 0x98, 0x7e, 0xe3, 0x54, 0x86, 0x13, 0xe0, 0x24, 0x71, 0xf6, 0x06, 0x23, 0xc2, 0xf1,
-	0xc0, 0x19, 0xe1, 0x18, 0xa7, 0x63, 0x92, 0x6f, 0x50, 0x1a, 0xdb, 0x59, 0x4e, 0x39, 0x85, 0x8f,
-	0x17, 0xf3, 0xec, 0xca, 0xb0, 0x45, 0x9e, 0xad, 0xf3, 0xba, 0x6b, 0x63, 0xca, 0x12, 0xca, 0x3c,
-	0x99, 0xe2, 0x28, 0x43, 0xe5, 0x77, 0x3b, 0x01, 0x0d, 0xa8, 0xf2, 0x8b, 0x37, 0xed, 0xed, 0x05,
-	0x94, 0x06, 0x31, 0x71, 0xa4, 0x35, 0x9a, 0xec, 0x38, 0xfe, 0x24, 0xc7, 0x5c, 0xe0, 0xaa, 0x73,
-	0xeb, 0xf2, 0x39, 0x8f, 0x12, 0xc2, 0x38, 0x4e, 0xb2, 0x12, 0x40, 0x15, 0x71, 0xf0, 0x84, 0x87,
-	0x15, 0x7d, 0x61, 0x5c, 0x3a, 0x1f, 0x61, 0x46, 0xaa, 0xf3, 0x31, 0x8d, 0x74, 0x01, 0xf4, 0x57,
-	0x03, 0x98, 0x9b, 0x09, 0xa5, 0x3c, 0xdc, 0x22, 0x51, 0x10, 0xf2, 0x57, 0x21, 0x4e, 0x03, 0xb2,
-	0x81, 0x73, 0x9c, 0x30, 0xf8, 0x25, 0x00, 0x8c, 0xe3, 0x9c, 0x7b, 0xa2, 0xaa, 0x69, 0x3c, 0x32,
-	0x9e, 0xb4, 0x9f, 0x75, 0x6d, 0x45, 0xc9, 0x2e, 0x29, 0xd9, 0x5f, 0x94, 0x94, 0x86, 0xef, 0x9d,
-	0x14, 0x56, 0x6d, 0x56, 0x58, 0xab, 0x53, 0x9c, 0xc4, 0xcf, 0xd1, 0x3c, 0x17, 0x1d, 0xfe, 0x6e,
-	0x19, 0xee, 0x8a, 0x74, 0x88, 0x70, 0x18, 0x82, 0x7b, 0x65, 0xa7, 0x66, 0x5d, 0xe2, 0xae, 0xfd,
-	0x0b, 0xf7, 0xb5, 0x0e, 0x18, 0x0e, 0x04, 0xec, 0x9f, 0x85, 0x05, 0xcb, 0x94, 0xa7, 0x34, 0x89,
-	0x38, 0x49, 0x32, 0x3e, 0x9d, 0x15, 0xd6, 0xbb, 0xaa, 0x58, 0x79, 0x86, 0x8e, 0x44, 0xa9, 0x0a,
-	0x1d, 0x7e, 0x67, 0x80, 0x4e, 0x94, 0x46, 0x3c, 0xc2, 0xb1, 0x97, 0x51, 0x1a, 0x7b, 0xfb, 0xb2,
-	0x4f, 0x66, 0x36, 0x1e, 0x35, 0x9e, 0xb4, 0x9f, 0xd9, 0xf6, 0x0d, 0xf6, 0x6a, 0x0b, 0x1d, 0xbc,
-	0x64, 0x8c, 0xf0, 0xe1, 0x63, 0xdd, 0xe2, 0xba, 0xaa, 0x7a, 0x15, 0x32, 0x72, 0xa1, 0x76, 0x8b,
-	0x34, 0x35, 0x56, 0x06, 0xbf, 0x35, 0xc0, 0x43, 0x8e, 0xf3, 0x80, 0xf0, 0x8b, 0x34, 0x9a, 0x77,
-	0xa2, 0x81, 0x34, 0x8d, 0xae, 0xa2, 0x71, 0x05, 0x30, 0x72, 0x57, 0x95, 0x77, 0x81, 0x04, 0xfa,
-	0xbb, 0x0e, 0x80, 0xb0, 0xf5, 0x7e, 0xbf, 0x06, 0xf7, 0xd8, 0x3e, 0xce, 0xbc, 0x1d, 0xa2, 0xb6,
-	0xbb, 0x32, 0x7c, 0x29, 0x70, 0x7f, 0x2b, 0xac, 0xf7, 0x83, 0x88, 0x87, 0x93, 0x91, 0x3d, 0xa6,
-	0x89, 0x96, 0xb1, 0x7e, 0xf4, 0x99, 0xbf, 0xeb, 0xf0, 0x69, 0x46, 0x98, 0xfd, 0x9a, 0x8c, 0xe7,
-	0xe3, 0x2f, 0x71, 0x90, 0xdb, 0x12, 0xaf, 0x9f, 0x12, 0x22, 0xd0, 0xc9, 0x41, 0xc4, 0x25, 0x7a,
-	0xfd, 0xff, 0xa1, 0x97, 0x38, 0xc8, 0x6d, 0x89, 0x57, 0x81, 0xfe, 0x83, 0x01, 0xd6, 0x99, 0x14,
-	0xae, 0xee, 0xd8, 0x1b, 0x4b, 0xe9, 0x7a, 0x99, 0xec, 0xcd, 0x6c, 0x48, 0x55, 0x7d, 0x72, 0xa3,
-	0xb9, 0x5e, 0x77, 0x01, 0x86, 0x1f, 0x9c, 0x14, 0x96, 0x31, 0x2b, 0x2c, 0xa4, 0x9b, 0xbc, 0xbe,
-	0x1e, 0x72, 0x4d, 0x76, 0x0d, 0x0a, 0xfa, 0xd1, 0x00, 0x2b, 0xd5, 0xea, 0xe0, 0x1b, 0xb0, 0xc4,
-	0xe9, 0x2e, 0x49, 0xf5, 0x7d, 0x5a, 0xb3, 0xf5, 0x67, 0x42, 0xdc, 0xd0, 0x8a, 0xd1, 0x2b, 0x1a,
-	0xa5, 0xc3, 0x8e, 0x5e, 0xf2, 0x7d, 0xbd, 0x64, 0x91, 0x85, 0x5c, 0x95, 0x0d, 0xb7, 0xc0, 0xb2,
-	0xe2, 0xa1, 0x67, 0xfb, 0xe2, 0x16, 0xb3, 0x7d, 0x9b, 0xf2, 0x59, 0x61, 0xbd, 0xa3, 0x60, 0x15,
-	0x0a, 0x72, 0x35, 0x1c, 0xfa, 0xa5, 0x09, 0x9a, 0x82, 0x2d, 0x7c, 0x0a, 0x5a, 0xd8, 0xf7, 0x73,
-	0xc2, 0x98, 0x16, 0x07, 0x9c, 0x15, 0xd6, 0x03, 0x95, 0xa4, 0x0f, 0x90, 0x5b, 0x86, 0xc0, 0x07,
-	0xa0, 0x1e, 0xf9, 0x92, 0x4b, 0xd3, 0xad, 0x47, 0x3e, 0x9c, 0x80, 0xb6, 0x94, 0xe3, 0x85, 0x75,
-	0x38, 0x37, 0x96, 0xb9, 0x5e, 0xc0, 0xa5, 0xeb, 0x56, 0x7e, 0x97, 0xbd, 0x05, 0x68, 0xe4, 0x82,
-	0x6c, 0x2e, 0xe9, 0xcf, 0x41, 0x67, 0x67, 0xc2, 0x27, 0x39, 0x51, 0x21, 0x01, 0xdd, 0x23, 0x79,
-	0x4a, 0x73, 0xb3, 0x29, 0x3b, 0xb0, 0xe6, 0x50, 0x57, 0x45, 0x21, 0x17, 0x2a, 0xb7, 0x60, 0xf0,
-	0x99, 0x76, 0xc2, 0x6d, 0x70, 0x9f, 0x53, 0x8e, 0x63, 0x8f, 0x85, 0x38, 0x27, 0xcc, 0x5c, 0xfa,
-	0xaf, 0xbd, 0xad, 0x6b, 0xd2, 0x0f, 0xcb, 0xbd, 0xcd, 0x93, 0x91, 0xdb, 0x96, 0xe6, 0xa6, 0xb4,
-	0xe0, 0xae, 0x1e, 0x12, 0x16, 0xca, 0x60, 0xe6, 0xf2, 0x9d, 0xbe, 0x05, 0x5d, 0x5d, 0x0e, 0xaa,
-	0x72, 0x0b, 0x80, 0x7a, 0x34, 0x32, 0x8c, 0xc1, 0xb0, 0xec, 0x43, 0xeb, 0xa6, 0x25, 0x47, 0xf2,
-	0xe6, 0xd6, 0xba, 0xb9, 0xd0, 0x56, 0xa9, 0x1e, 0xd5, 0x96, 0x12, 0xff, 0xf3, 0xd5, 0xef, 0x8f,
-	0xad, 0xda, 0xd1, 0xb1, 0x55, 0xfb, 0xf9, 0xa7, 0xfe, 0x92, 0x20, 0xfa, 0x76, 0xb8, 0x7d, 0x72,
-	0xd6, 0x33, 0x4e, 0xcf, 0x7a, 0xc6, 0x1f, 0x67, 0x3d, 0xe3, 0xf0, 0xbc, 0x57, 0x3b, 0x3d, 0xef,
-	0xd5, 0x7e, 0x3d, 0xef, 0xd5, 0xbe, 0x7a, 0xb1, 0x50, 0x58, 0x16, 0x8c, 0x58, 0x3f, 0xc6, 0x23,
-	0x56, 0x1a, 0xce, 0xde, 0xe0, 0x23, 0xe7, 0x40, 0xfd, 0x9f, 0x45, 0x37, 0xfd, 0x84, 0xfa, 0x24,
-	0x66, 0xd5, 0x3f, 0x7a, 0xb4, 0x2c, 0xff, 0x18, 0x1f, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x26,
-	0x39, 0xa7, 0x54, 0xda, 0x07, 0x00, 0x00,
+	// 1343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xf7, 0x3a, 0x21, 0x4e, 0x26, 0x21, 0x90, 0x25, 0x02, 0xc7, 0xf0, 0xf5, 0x86, 0x41, 0x5f,
+	0x14, 0x21, 0x62, 0x2b, 0x54, 0x45, 0x2a, 0x52, 0x45, 0xb3, 0xc4, 0x81, 0x48, 0xa8, 0x0a, 0x1b,
+	0x28, 0x05, 0x55, 0x5a, 0x8d, 0xbd, 0x13, 0x7b, 0x94, 0xdd, 0x9d, 0xed, 0xce, 0xd8, 0xc4, 0x3d,
+	0x22, 0x55, 0xe2, 0x54, 0x71, 0xe4, 0x88, 0xd4, 0x4b, 0xa5, 0x5e, 0xab, 0xf6, 0x5f, 0x40, 0x3d,
+	0x21, 0x55, 0x95, 0xaa, 0x1e, 0xdc, 0x0a, 0x6e, 0xed, 0x2d, 0xff, 0x40, 0xab, 0xf9, 0x65, 0x2f,
+	0x89, 0x01, 0x87, 0x72, 0xf2, 0xbe, 0x79, 0xf3, 0x3e, 0xef, 0xf3, 0xde, 0xbc, 0x79, 0x6f, 0x0c,
+	0x2e, 0x07, 0xdd, 0x08, 0xc7, 0x8c, 0xd0, 0x78, 0xb7, 0xfb, 0x55, 0xb5, 0x2f, 0x54, 0x9b, 0x28,
+	0x8a, 0xaa, 0x9d, 0x95, 0x3a, 0xe6, 0x68, 0xa5, 0x5a, 0x47, 0x21, 0x8a, 0x1b, 0x38, 0xdd, 0xa4,
+	0x34, 0xac, 0x24, 0x29, 0xe5, 0xd4, 0x3e, 0x97, 0xb5, 0xab, 0xf4, 0x85, 0x8a, 0xb0, 0xab, 0x68,
+	0xbb, 0xd2, 0x42, 0x83, 0xb2, 0x88, 0x32, 0x5f, 0x9a, 0x54, 0x95, 0xa0, 0xec, 0x4b, 0xf3, 0x4d,
+	0xda, 0xa4, 0x6a, 0x5d, 0x7c, 0xe9, 0xd5, 0x72, 0x93, 0xd2, 0x66, 0x88, 0xab, 0x52, 0xaa, 0xb7,
+	0xb7, 0xab, 0x41, 0x3b, 0x45, 0x5c, 0xe0, 0x2a, 0xbd, 0xb3, 0x5f, 0xcf, 0x49, 0x84, 0x19, 0x47,
+	0x51, 0x62, 0x00, 0x94, 0x93, 0x2a, 0x6a, 0xf3, 0x56, 0x9f, 0xbe, 0x10, 0xf6, 0xe9, 0xeb, 0x88,
+	0xe1, 0xbe, 0xbe, 0x41, 0x89, 0x76, 0x00, 0xff, 0x1e, 0x03, 0xc5, 0xad, 0x88, 0x52, 0xde, 0xba,
+	0x8b, 0x49, 0xb3, 0xc5, 0xaf, 0xb5, 0x50, 0xdc, 0xc4, 0x9b, 0x28, 0x45, 0x11, 0xb3, 0x3f, 0x07,
+	0x80, 0x71, 0x94, 0x72, 0x5f, 0x78, 0x2d, 0x5a, 0x8b, 0xd6, 0xd2, 0xf4, 0xa5, 0x52, 0x45, 0x51,
+	0xaa, 0x18, 0x4a, 0x95, 0xdb, 0x86, 0x92, 0xfb, 0xbf, 0x67, 0x3d, 0x27, 0xb7, 0xd7, 0x73, 0xe6,
+	0xba, 0x28, 0x0a, 0xaf, 0xc0, 0x81, 0x2d, 0x7c, 0xfc, 0x87, 0x63, 0x79, 0x53, 0x72, 0x41, 0x6c,
+	0xb7, 0x5b, 0x60, 0xd2, 0x44, 0x5a, 0xcc, 0x4b, 0xdc, 0x85, 0x03, 0xb8, 0x6b, 0x7a, 0x83, 0xbb,
+	0x22, 0x60, 0xff, 0xea, 0x39, 0xb6, 0x31, 0xb9, 0x48, 0x23, 0xc2, 0x71, 0x94, 0xf0, 0xee, 0x5e,
+	0xcf, 0x39, 0xa6, 0x9c, 0x19, 0x1d, 0x7c, 0x22, 0x5c, 0xf5, 0xd1, 0xed, 0xaf, 0x2d, 0x30, 0x4f,
+	0x62, 0xc2, 0x09, 0x0a, 0xfd, 0x84, 0xd2, 0xd0, 0x7f, 0x20, 0xe3, 0x64, 0xc5, 0xb1, 0xc5, 0xb1,
+	0xa5, 0xe9, 0x4b, 0x95, 0xca, 0x08, 0xe7, 0x5a, 0x11, 0x75, 0xb0, 0xca, 0x18, 0xe6, 0xee, 0x39,
+	0x1d, 0xe2, 0x69, 0xe5, 0x75, 0x18, 0x32, 0xf4, 0x6c, 0xbd, 0x2c, 0xcc, 0x54, 0x5a, 0x99, 0xfd,
+	0xd0, 0x02, 0x27, 0x38, 0x4a, 0x9b, 0x98, 0xbf, 0x4a, 0x63, 0xfc, 0x9d, 0x68, 0x40, 0x4d, 0xa3,
+	0xa4, 0x68, 0x0c, 0x01, 0x86, 0xde, 0x9c, 0x5a, 0xcd, 0x90, 0x80, 0x3f, 0xe5, 0xc1, 0xfc, 0x5a,
+	0x37, 0x46, 0x11, 0x69, 0x6c, 0x3d, 0x40, 0xc9, 0x3a, 0x36, 0x27, 0xfd, 0x05, 0x98, 0x14, 0x15,
+	0xe2, 0x6f, 0x63, 0x75, 0xce, 0x53, 0xee, 0xaa, 0xf0, 0xf0, 0x7b, 0xcf, 0x39, 0xdf, 0x24, 0xbc,
+	0xd5, 0xae, 0x57, 0x1a, 0x34, 0xd2, 0x05, 0xad, 0x7f, 0x96, 0x59, 0xb0, 0x53, 0xe5, 0xdd, 0x04,
+	0xb3, 0xca, 0x1a, 0x6e, 0x0c, 0x0e, 0xc2, 0xe0, 0x40, 0xaf, 0x20, 0x3e, 0xd7, 0x31, 0xb6, 0xef,
+	0x81, 0x42, 0x84, 0x76, 0x25, 0x78, 0x5e, 0x82, 0x7f, 0x72, 0x68, 0xf0, 0x59, 0x05, 0xae, 0x61,
+	0xa0, 0x37, 0x11, 0xa1, 0x5d, 0x01, 0xbd, 0x0d, 0xa6, 0x99, 0x48, 0x15, 0x27, 0x1d, 0xc2, 0xbb,
+	0xc5, 0x31, 0x09, 0xbf, 0x76, 0x68, 0x78, 0x5b, 0x57, 0xec, 0x00, 0x0a, 0x7a, 0x59, 0x60, 0xf8,
+	0x6b, 0x1e, 0xcc, 0x7d, 0x46, 0x43, 0xc4, 0x49, 0x48, 0x78, 0xf7, 0x76, 0x8a, 0x1a, 0x3b, 0x38,
+	0xb5, 0x13, 0x70, 0x2c, 0x44, 0x8c, 0xfb, 0x2c, 0xa1, 0xdc, 0x4f, 0x52, 0xd2, 0x30, 0xd9, 0xbb,
+	0x71, 0x68, 0x06, 0x27, 0x15, 0x83, 0x7d, 0x70, 0xd0, 0x3b, 0x2a, 0x56, 0xb6, 0x12, 0xca, 0x37,
+	0x85, 0x6c, 0x37, 0x00, 0xe8, 0xf4, 0x69, 0xe8, 0x6c, 0x5e, 0x3b, 0xb4, 0x33, 0x7d, 0x41, 0x07,
+	0x48, 0xd0, 0xcb, 0xc0, 0xda, 0x04, 0x1c, 0x97, 0x3c, 0xda, 0x49, 0x80, 0x38, 0x56, 0xb7, 0x7f,
+	0xec, 0xad, 0xb7, 0xdf, 0x5c, 0x8d, 0x53, 0x99, 0x48, 0x32, 0x08, 0xaa, 0x07, 0xcc, 0x8a, 0xe5,
+	0x3b, 0x72, 0x55, 0x58, 0xc2, 0x7f, 0xf2, 0xe0, 0xcc, 0x4d, 0xf2, 0x65, 0x9b, 0x04, 0x84, 0x77,
+	0x5d, 0x4a, 0x39, 0xe3, 0x29, 0x4a, 0x12, 0x12, 0x37, 0x75, 0x65, 0x5e, 0x04, 0x85, 0x46, 0x8a,
+	0x11, 0xa7, 0xa9, 0x4e, 0xad, 0x3d, 0xa8, 0x06, 0xad, 0x80, 0x9e, 0xd9, 0xb2, 0xaf, 0x63, 0xe5,
+	0xdf, 0x63, 0xc7, 0xf2, 0xc0, 0x24, 0x8e, 0x83, 0x51, 0x73, 0x71, 0x5a, 0xe3, 0xea, 0x3b, 0x61,
+	0x2c, 0x15, 0x6a, 0x01, 0xc7, 0x81, 0xc4, 0x7c, 0x68, 0x81, 0x19, 0xa1, 0xaa, 0xe3, 0x16, 0xea,
+	0x10, 0x9a, 0x16, 0xc7, 0x17, 0xad, 0xa5, 0xd9, 0x4b, 0xeb, 0x23, 0x35, 0x83, 0xe1, 0x59, 0xab,
+	0xc5, 0x81, 0xab, 0xd1, 0xdc, 0x53, 0x7b, 0x3d, 0xe7, 0xc4, 0x80, 0x80, 0xf1, 0x02, 0xbd, 0x69,
+	0x3c, 0xd8, 0x05, 0xbf, 0x19, 0x07, 0x40, 0xf4, 0x88, 0x41, 0x27, 0x60, 0x0f, 0x50, 0xf2, 0x3e,
+	0x3a, 0x81, 0xc1, 0x81, 0x5e, 0x81, 0xa9, 0x6e, 0x23, 0xd0, 0xf1, 0x2e, 0xe1, 0x99, 0x56, 0xf0,
+	0xce, 0xe8, 0x06, 0x07, 0x7a, 0x05, 0xf1, 0x29, 0xd0, 0xbf, 0xb3, 0xc0, 0x69, 0x26, 0x87, 0x99,
+	0xee, 0x82, 0x7e, 0x43, 0x8e, 0x33, 0x3f, 0x91, 0xb1, 0xe9, 0x73, 0xfb, 0x78, 0xa4, 0xf4, 0xbe,
+	0x6e, 0x28, 0xba, 0x17, 0x9e, 0xf5, 0x1c, 0x6b, 0xaf, 0xe7, 0x40, 0x1d, 0xe4, 0xeb, 0xfd, 0x41,
+	0xaf, 0xc8, 0x5e, 0x37, 0x5a, 0x9f, 0x58, 0xe0, 0x54, 0xa0, 0x3a, 0xb1, 0x6f, 0xf2, 0x64, 0x68,
+	0x8e, 0x4b, 0x9a, 0x1f, 0x8d, 0x44, 0x73, 0x58, 0x37, 0x77, 0xcf, 0x6b, 0x8a, 0x65, 0x3d, 0x1a,
+	0x87, 0xfb, 0x81, 0xde, 0x7c, 0x30, 0xc4, 0x1a, 0x7e, 0x6f, 0x81, 0xa9, 0xfe, 0xa4, 0xb1, 0x6b,
+	0xe0, 0x08, 0xa7, 0x3b, 0x38, 0xd6, 0xe3, 0x7f, 0xa1, 0xa2, 0x5f, 0x35, 0xa2, 0xb7, 0xf7, 0x59,
+	0x5c, 0xa3, 0x24, 0x76, 0xe7, 0x75, 0xcd, 0xcf, 0x28, 0xaf, 0xd2, 0x0a, 0x7a, 0xca, 0xda, 0xbe,
+	0x0b, 0x26, 0x54, 0x8a, 0xf4, 0xb1, 0x5f, 0x3d, 0xc4, 0xb1, 0x6f, 0xc4, 0x7c, 0xaf, 0xe7, 0x1c,
+	0x55, 0xb0, 0x0a, 0x05, 0x7a, 0x1a, 0x0e, 0xfe, 0x52, 0x00, 0xe3, 0x82, 0xad, 0x68, 0x14, 0x28,
+	0x08, 0x52, 0xcc, 0xd8, 0xc1, 0x46, 0xa1, 0x15, 0xd0, 0x33, 0x5b, 0xec, 0x59, 0x90, 0x27, 0x81,
+	0xe4, 0x32, 0xee, 0xe5, 0x49, 0x60, 0xb7, 0xc1, 0xb4, 0x9c, 0x9e, 0xaf, 0x54, 0x4a, 0x75, 0xe4,
+	0xa9, 0xac, 0x13, 0xbf, 0xef, 0x75, 0x60, 0x9e, 0x91, 0x7e, 0x06, 0x1a, 0x7a, 0x20, 0x19, 0xdc,
+	0xb6, 0x5b, 0x60, 0x7e, 0xbb, 0xcd, 0xdb, 0x29, 0x56, 0x5b, 0x9a, 0xb4, 0x83, 0xd3, 0x58, 0x37,
+	0x82, 0x29, 0xd7, 0x19, 0x40, 0x0d, 0xdb, 0x05, 0x3d, 0x5b, 0x2d, 0x0b, 0x06, 0xd7, 0xf5, 0xa2,
+	0x7d, 0x0f, 0xcc, 0x70, 0xca, 0x51, 0xe8, 0xb3, 0x16, 0x4a, 0x31, 0x2b, 0x1e, 0x79, 0xdb, 0xb9,
+	0x99, 0x5e, 0x75, 0xc2, 0x9c, 0xdb, 0xc0, 0x18, 0x7a, 0xd3, 0x52, 0xdc, 0x92, 0x92, 0xbd, 0xa3,
+	0x93, 0x84, 0x44, 0x65, 0xb0, 0xe2, 0xc4, 0x3b, 0x3d, 0x5d, 0x4a, 0xda, 0x9d, 0x1e, 0xb9, 0x19,
+	0x40, 0x9d, 0x1a, 0xb9, 0x8d, 0xd9, 0x2d, 0x13, 0x87, 0xae, 0x9b, 0x82, 0x4c, 0x49, 0xed, 0xd0,
+	0x75, 0xf3, 0x4a, 0x58, 0xa6, 0x7a, 0x54, 0x58, 0xea, 0x5e, 0xda, 0x8f, 0x2c, 0x60, 0x0f, 0xa6,
+	0x9f, 0xcf, 0xd5, 0x70, 0x2f, 0x4e, 0xca, 0xc4, 0x5d, 0x1e, 0x29, 0xbc, 0x03, 0x4f, 0x03, 0xf7,
+	0xac, 0xbe, 0x83, 0x0b, 0xfb, 0x47, 0xad, 0xc1, 0x87, 0xde, 0x5c, 0xe7, 0xc0, 0x83, 0xe2, 0x0a,
+	0x98, 0x11, 0xb7, 0x94, 0xf9, 0x09, 0x6a, 0x33, 0x1c, 0x14, 0xa7, 0x16, 0xad, 0xa5, 0xc9, 0x6c,
+	0x23, 0xcf, 0x6a, 0xc5, 0x13, 0x45, 0x88, 0x9b, 0x52, 0xb2, 0x7f, 0xb4, 0x40, 0x39, 0x34, 0x43,
+	0xc1, 0xaf, 0x67, 0xa7, 0x82, 0x29, 0x6b, 0x20, 0x43, 0x5a, 0xfd, 0x0f, 0xf3, 0x45, 0x17, 0xfa,
+	0xb2, 0x8e, 0xee, 0xff, 0x7a, 0xd6, 0xbf, 0xd1, 0x2d, 0xf4, 0xce, 0x84, 0x6f, 0x00, 0xbb, 0x32,
+	0xf7, 0xe8, 0xa9, 0x93, 0x7b, 0xf2, 0xd4, 0xc9, 0xfd, 0xfc, 0xc3, 0xf2, 0x11, 0x51, 0x28, 0x1b,
+	0x17, 0x1a, 0xe0, 0xec, 0x5b, 0xe7, 0x9b, 0x5d, 0x02, 0x27, 0x6f, 0xba, 0x9b, 0x7e, 0xed, 0xd3,
+	0x35, 0x7f, 0xdd, 0xab, 0xd5, 0xee, 0xd7, 0xfc, 0xbb, 0xb5, 0x8d, 0xeb, 0x37, 0x6e, 0x6f, 0x1d,
+	0xcf, 0xd9, 0x67, 0x40, 0xd1, 0xe8, 0x6a, 0xb7, 0xee, 0xac, 0xde, 0xdc, 0xc8, 0x68, 0xad, 0xd2,
+	0xf8, 0xa3, 0x6f, 0xcb, 0x39, 0xf7, 0xde, 0xb3, 0x17, 0x65, 0xeb, 0xf9, 0x8b, 0xb2, 0xf5, 0xe7,
+	0x8b, 0xb2, 0xf5, 0xf8, 0x65, 0x39, 0xf7, 0xfc, 0x65, 0x39, 0xf7, 0xdb, 0xcb, 0x72, 0xee, 0xfe,
+	0xd5, 0x4c, 0x75, 0xc9, 0xaa, 0x22, 0x6c, 0x39, 0x44, 0x75, 0x66, 0x84, 0x6a, 0x67, 0xe5, 0xc3,
+	0xea, 0xae, 0xfa, 0xcf, 0x28, 0x4a, 0x76, 0x39, 0xa2, 0x01, 0x0e, 0x59, 0xff, 0x7f, 0x63, 0x7d,
+	0x42, 0xbe, 0x09, 0x3e, 0xf8, 0x77, 0x00, 0xc6, 0x34, 0x96, 0x19, 0x6e, 0x0e, 0x00, 0x00,
 }
 
 func (m *SmoothWeightChangeParams) Marshal() (dAtA []byte, err error) {
@@ -423,6 +680,161 @@ func (m *SmoothWeightChangeParams) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *DynamicSwapFeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSwapFeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSwapFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Sensitivity.Size()
+		i -= size
+		if _, err := m.Sensitivity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VolatilityTracker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolatilityTracker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolatilityTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUpdateTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintBalancerPool(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Volatility.Size()
+		i -= size
+		if _, err := m.Volatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LastSpotPrice.Size()
+		i -= size
+		if _, err := m.LastSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityBootstrappingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityBootstrappingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityBootstrappingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndBehavior != 0 {
+		i = encodeVarintBalancerPool(dAtA, i, uint64(m.EndBehavior))
+		i--
+		dAtA[i] = 0x20
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintBalancerPool(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintBalancerPool(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintBalancerPool(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -443,6 +855,18 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DynamicSwapFeeParams != nil {
+		{
+			size, err := m.DynamicSwapFeeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.SmoothWeightChangeParams != nil {
 		{
 			size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
@@ -541,21 +965,55 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.TotalWeight.Size()
-		i -= size
-		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.LiquidityBootstrappingParams != nil {
+		{
+			size, err := m.LiquidityBootstrappingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBalancerPool(dAtA, i, uint64(size))
 		}
-		i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x52
 	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.PoolAssets) > 0 {
-		for iNdEx := len(m.PoolAssets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
+	if m.SwapsPaused {
+		i--
+		if m.SwapsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.VolatilityTracker != nil {
+		{
+			size, err := m.VolatilityTracker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.TotalWeight.Size()
+		i -= size
+		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.PoolAssets) > 0 {
+		for iNdEx := len(m.PoolAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
 					return 0, err
 				}
 				i -= size
@@ -640,78 +1098,630 @@ func (m *SmoothWeightChangeParams) Size() (n int) {
 			n += 1 + l + sovBalancerPool(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *PoolParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.SwapFee.Size()
-	n += 1 + l + sovBalancerPool(uint64(l))
-	l = m.ExitFee.Size()
-	n += 1 + l + sovBalancerPool(uint64(l))
-	if m.SmoothWeightChangeParams != nil {
-		l = m.SmoothWeightChangeParams.Size()
-		n += 1 + l + sovBalancerPool(uint64(l))
-	}
-	return n
-}
-
-func (m *PoolAsset) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Token.Size()
-	n += 1 + l + sovBalancerPool(uint64(l))
-	l = m.Weight.Size()
-	n += 1 + l + sovBalancerPool(uint64(l))
-	return n
-}
+	return n
+}
+
+func (m *DynamicSwapFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovBalancerPool(uint64(l))
+	l = m.MaxFee.Size()
+	n += 1 + l + sovBalancerPool(uint64(l))
+	l = m.Sensitivity.Size()
+	n += 1 + l + sovBalancerPool(uint64(l))
+	return n
+}
+
+func (m *VolatilityTracker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LastSpotPrice.Size()
+	n += 1 + l + sovBalancerPool(uint64(l))
+	l = m.Volatility.Size()
+	n += 1 + l + sovBalancerPool(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUpdateTime)
+	n += 1 + l + sovBalancerPool(uint64(l))
+	return n
+}
+
+func (m *LiquidityBootstrappingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovBalancerPool(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovBalancerPool(uint64(l))
+	if m.EndBehavior != 0 {
+		n += 1 + sovBalancerPool(uint64(m.EndBehavior))
+	}
+	return n
+}
+
+func (m *PoolParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapFee.Size()
+	n += 1 + l + sovBalancerPool(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovBalancerPool(uint64(l))
+	if m.SmoothWeightChangeParams != nil {
+		l = m.SmoothWeightChangeParams.Size()
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
+	if m.DynamicSwapFeeParams != nil {
+		l = m.DynamicSwapFeeParams.Size()
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Token.Size()
+	n += 1 + l + sovBalancerPool(uint64(l))
+	l = m.Weight.Size()
+	n += 1 + l + sovBalancerPool(uint64(l))
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovBalancerPool(uint64(m.Id))
+	}
+	l = m.PoolParams.Size()
+	n += 1 + l + sovBalancerPool(uint64(l))
+	l = len(m.FuturePoolGovernor)
+	if l > 0 {
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovBalancerPool(uint64(l))
+	if len(m.PoolAssets) > 0 {
+		for _, e := range m.PoolAssets {
+			l = e.Size()
+			n += 1 + l + sovBalancerPool(uint64(l))
+		}
+	}
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovBalancerPool(uint64(l))
+	if m.VolatilityTracker != nil {
+		l = m.VolatilityTracker.Size()
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
+	if m.SwapsPaused {
+		n += 2
+	}
+	if m.LiquidityBootstrappingParams != nil {
+		l = m.LiquidityBootstrappingParams.Size()
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
+	return n
+}
+
+func sovBalancerPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBalancerPool(x uint64) (n int) {
+	return sovBalancerPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SmoothWeightChangeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBalancerPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SmoothWeightChangeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SmoothWeightChangeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialPoolWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialPoolWeights = append(m.InitialPoolWeights, PoolAsset{})
+			if err := m.InitialPoolWeights[len(m.InitialPoolWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPoolWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetPoolWeights = append(m.TargetPoolWeights, PoolAsset{})
+			if err := m.TargetPoolWeights[len(m.TargetPoolWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBalancerPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicSwapFeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBalancerPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSwapFeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSwapFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sensitivity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sensitivity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBalancerPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolatilityTracker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBalancerPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolatilityTracker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolatilityTracker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBalancerPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBalancerPool(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovBalancerPool(uint64(m.Id))
-	}
-	l = m.PoolParams.Size()
-	n += 1 + l + sovBalancerPool(uint64(l))
-	l = len(m.FuturePoolGovernor)
-	if l > 0 {
-		n += 1 + l + sovBalancerPool(uint64(l))
-	}
-	l = m.TotalShares.Size()
-	n += 1 + l + sovBalancerPool(uint64(l))
-	if len(m.PoolAssets) > 0 {
-		for _, e := range m.PoolAssets {
-			l = e.Size()
-			n += 1 + l + sovBalancerPool(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.TotalWeight.Size()
-	n += 1 + l + sovBalancerPool(uint64(l))
-	return n
-}
-
-func sovBalancerPool(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBalancerPool(x uint64) (n int) {
-	return sovBalancerPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SmoothWeightChangeParams) Unmarshal(dAtA []byte) error {
+func (m *LiquidityBootstrappingParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -734,17 +1744,17 @@ func (m *SmoothWeightChangeParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SmoothWeightChangeParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityBootstrappingParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SmoothWeightChangeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityBootstrappingParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBalancerPool
@@ -754,28 +1764,27 @@ func (m *SmoothWeightChangeParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBalancerPool
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBalancerPool
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -802,13 +1811,13 @@ func (m *SmoothWeightChangeParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialPoolWeights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -835,16 +1844,15 @@ func (m *SmoothWeightChangeParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialPoolWeights = append(m.InitialPoolWeights, PoolAsset{})
-			if err := m.InitialPoolWeights[len(m.InitialPoolWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetPoolWeights", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBehavior", wireType)
 			}
-			var msglen int
+			m.EndBehavior = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBalancerPool
@@ -854,26 +1862,11 @@ func (m *SmoothWeightChangeParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EndBehavior |= LiquidityBootstrappingEndBehavior(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBalancerPool
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBalancerPool
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetPoolWeights = append(m.TargetPoolWeights, PoolAsset{})
-			if err := m.TargetPoolWeights[len(m.TargetPoolWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
@@ -1028,6 +2021,42 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicSwapFeeParams == nil {
+				m.DynamicSwapFeeParams = &DynamicSwapFeeParams{}
+			}
+			if err := m.DynamicSwapFeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
@@ -1412,6 +2441,98 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VolatilityTracker == nil {
+				m.VolatilityTracker = &VolatilityTracker{}
+			}
+			if err := m.VolatilityTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapsPaused = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityBootstrappingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LiquidityBootstrappingParams == nil {
+				m.LiquidityBootstrappingParams = &LiquidityBootstrappingParams{}
+			}
+			if err := m.LiquidityBootstrappingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
@@ -15,6 +15,10 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Pool{}, "dymensionxyz/dymension/gamm/BalancerPool", nil)
 	cdc.RegisterConcrete(&MsgCreateBalancerPool{}, "dymensionxyz/dymension/gamm/CreateBalancerPool", nil)
+	cdc.RegisterConcrete(&MsgScheduleWeightChange{}, "dymensionxyz/dymension/gamm/ScheduleWeightChange", nil)
+	cdc.RegisterConcrete(&MsgSetPoolFees{}, "dymensionxyz/dymension/gamm/SetPoolFees", nil)
+	cdc.RegisterConcrete(&MsgSetSwapsPaused{}, "dymensionxyz/dymension/gamm/SetSwapsPaused", nil)
+	cdc.RegisterConcrete(&MsgCreateLiquidityBootstrappingPool{}, "dymensionxyz/dymension/gamm/CreateLiquidityBootstrappingPool", nil)
 	cdc.RegisterConcrete(&PoolParams{}, "dymensionxyz/dymension/gamm/BalancerPoolParams", nil)
 }
 
@@ -1,6 +1,8 @@
 package balancer
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -19,4 +21,8 @@ var (
 	GuaranteedWeightPrecision int64 = 1 << 30
 
 	PoolTypeName string = "Balancer"
+
+	// The relative move of the spot price caused by a swap adds to the volatility of a pool
+	// with a dynamic swap fee, decaying linearly to zero over DynamicSwapFeeDecayPeriod.
+	DynamicSwapFeeDecayPeriod = time.Hour
 )
@@ -0,0 +1,80 @@
+package balancer
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TrackPriceMovement adds the relative move of the spot price of the pool since the last swap
+// to its volatility. It is a no-op for pools without dynamic swap fee params.
+func (p *Pool) TrackPriceMovement(ctx sdk.Context) error {
+	if p.PoolParams.DynamicSwapFeeParams == nil {
+		return nil
+	}
+	if p.VolatilityTracker == nil {
+		return p.initVolatilityTracker(ctx.BlockTime())
+	}
+
+	spotPrice, err := p.trackedSpotPrice()
+	if err != nil {
+		return err
+	}
+
+	tracker := p.VolatilityTracker
+	priceMove := spotPrice.Sub(tracker.LastSpotPrice).Abs().Quo(tracker.LastSpotPrice)
+	p.VolatilityTracker = &VolatilityTracker{
+		LastSpotPrice:  spotPrice,
+		Volatility:     p.volatilityAt(ctx.BlockTime()).Add(priceMove),
+		LastUpdateTime: ctx.BlockTime(),
+	}
+	return nil
+}
+
+// initVolatilityTracker starts tracking the spot price of the pool with no volatility.
+func (p *Pool) initVolatilityTracker(blockTime time.Time) error {
+	spotPrice, err := p.trackedSpotPrice()
+	if err != nil {
+		return err
+	}
+
+	p.VolatilityTracker = &VolatilityTracker{
+		LastSpotPrice:  spotPrice,
+		Volatility:     sdk.ZeroDec(),
+		LastUpdateTime: blockTime,
+	}
+	return nil
+}
+
+// dynamicSwapFee returns min(base_fee + sensitivity * volatility, max_fee) at the given block time.
+func (p Pool) dynamicSwapFee(blockTime time.Time) sdk.Dec {
+	params := p.PoolParams.DynamicSwapFeeParams
+	swapFee := params.BaseFee.Add(params.Sensitivity.Mul(p.volatilityAt(blockTime)))
+	return sdk.MinDec(swapFee, params.MaxFee)
+}
+
+// volatilityAt returns the volatility of the pool at the given block time,
+// decayed linearly over DynamicSwapFeeDecayPeriod since the last swap.
+func (p Pool) volatilityAt(blockTime time.Time) sdk.Dec {
+	tracker := p.VolatilityTracker
+	if tracker == nil {
+		return sdk.ZeroDec()
+	}
+
+	elapsed := blockTime.Sub(tracker.LastUpdateTime)
+	if elapsed <= 0 {
+		return tracker.Volatility
+	}
+	if elapsed >= DynamicSwapFeeDecayPeriod {
+		return sdk.ZeroDec()
+	}
+
+	remaining := sdk.NewDec(int64(DynamicSwapFeeDecayPeriod - elapsed)).QuoInt64(int64(DynamicSwapFeeDecayPeriod))
+	return tracker.Volatility.Mul(remaining)
+}
+
+// trackedSpotPrice returns the spot price of the first asset of the pool in terms of its second asset.
+func (p Pool) trackedSpotPrice() (sdk.Dec, error) {
+	// the pool assets are sorted by denom, and a pool has at least two assets.
+	return p.SpotPrice(sdk.Context{}, p.PoolAssets[1].Token.Denom, p.PoolAssets[0].Token.Denom)
+}
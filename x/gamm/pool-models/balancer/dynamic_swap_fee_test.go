@@ -0,0 +1,75 @@
+package balancer_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+)
+
+var defaultDynamicSwapFeeParams = balancer.DynamicSwapFeeParams{
+	BaseFee:     sdk.MustNewDecFromStr("0.002"),
+	MaxFee:      sdk.MustNewDecFromStr("0.02"),
+	Sensitivity: sdk.MustNewDecFromStr("0.5"),
+}
+
+// TestDynamicSwapFee tests that the swap fee of a pool with dynamic swap fee params rises
+// with the tracked moves of its spot price, and decays back to the base fee.
+func TestDynamicSwapFee(t *testing.T) {
+	poolAssets := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset1", sdk.NewInt(1000000))},
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset2", sdk.NewInt(1000000))},
+	}
+	params := defaultDynamicSwapFeeParams
+	pool, err := balancer.NewBalancerPool(defaultPoolId, balancer.PoolParams{
+		SwapFee:              defaultSwapFee,
+		ExitFee:              defaultExitFee,
+		DynamicSwapFeeParams: &params,
+	}, poolAssets, defaultFutureGovernor, defaultCurBlockTime)
+	require.NoError(t, err)
+
+	ctxAt := func(elapsed time.Duration) sdk.Context {
+		return sdk.Context{}.WithBlockTime(defaultCurBlockTime.Add(elapsed))
+	}
+	// moveSpotPrice sets the balance of asset2, the spot price of asset1 being the ratio of the balances.
+	moveSpotPrice := func(asset2Balance int64, elapsed time.Duration) {
+		require.NoError(t, pool.UpdatePoolAssetBalance(sdk.NewCoin("asset2", sdk.NewInt(asset2Balance))))
+		require.NoError(t, pool.TrackPriceMovement(ctxAt(elapsed)))
+	}
+
+	// the swap fee param is not used.
+	require.Equal(t, params.BaseFee, pool.GetSwapFee(ctxAt(0)))
+
+	// a 2% move adds 0.5 * 0.02 to the base fee.
+	moveSpotPrice(1020000, 0)
+	require.Equal(t, sdk.MustNewDecFromStr("0.012"), pool.GetSwapFee(ctxAt(0)))
+
+	// the volatility decays linearly over an hour.
+	require.Equal(t, sdk.MustNewDecFromStr("0.007"), pool.GetSwapFee(ctxAt(30*time.Minute)))
+	require.Equal(t, params.BaseFee, pool.GetSwapFee(ctxAt(time.Hour)))
+
+	// a move back to the initial price adds to the decayed volatility, and the swap fee is capped.
+	moveSpotPrice(1000000, 30*time.Minute)
+	expectedVolatility := sdk.MustNewDecFromStr("0.01").Add(sdk.MustNewDecFromStr("0.02").Quo(sdk.MustNewDecFromStr("1.02")))
+	require.Equal(t, expectedVolatility, pool.VolatilityTracker.Volatility)
+	moveSpotPrice(1500000, 30*time.Minute)
+	require.Equal(t, params.MaxFee, pool.GetSwapFee(ctxAt(30*time.Minute)))
+}
+
+// TestTrackPriceMovementStaticSwapFee tests that the price of a pool without dynamic swap fee params is not tracked.
+func TestTrackPriceMovementStaticSwapFee(t *testing.T) {
+	poolAssets := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset1", sdk.NewInt(1000000))},
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset2", sdk.NewInt(1000000))},
+	}
+	pool, err := balancer.NewBalancerPool(defaultPoolId, defaultBalancerPoolParams, poolAssets, defaultFutureGovernor, defaultCurBlockTime)
+	require.NoError(t, err)
+
+	require.NoError(t, pool.UpdatePoolAssetBalance(sdk.NewCoin("asset2", sdk.NewInt(2000000))))
+	require.NoError(t, pool.TrackPriceMovement(sdk.Context{}.WithBlockTime(defaultCurBlockTime)))
+	require.Nil(t, pool.VolatilityTracker)
+	require.Equal(t, defaultSwapFee, pool.GetSwapFee(sdk.Context{}))
+}
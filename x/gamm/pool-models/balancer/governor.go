@@ -0,0 +1,56 @@
+package balancer
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+// ScheduleWeightChange schedules a smooth change of the pool weights from their values at blockTime
+// to the target weights of params, replacing any weight change in progress.
+// The start time defaults to blockTime, and must not be before it.
+// The weights of liquidity bootstrapping pools can not be changed, during their sale and once frozen.
+func (p *Pool) ScheduleWeightChange(params SmoothWeightChangeParams, blockTime time.Time) error {
+	if p.IsLiquidityBootstrapping() {
+		return sdkerrors.Wrapf(types.ErrInvalidWeightChange, "weights of liquidity bootstrapping pool %d can not be changed", p.Id)
+	}
+	if params.StartTime.Unix() > 0 && params.StartTime.Before(blockTime) {
+		return sdkerrors.Wrapf(types.ErrInvalidWeightChange, "start time (%s) must not be before the block time (%s)", params.StartTime, blockTime)
+	}
+
+	// the target weights are sorted and scaled in place, so they are copied to leave the caller's untouched.
+	params.TargetPoolWeights = append([]PoolAsset(nil), params.TargetPoolWeights...)
+	poolParams := p.PoolParams
+	poolParams.SmoothWeightChangeParams = &params
+	if err := poolParams.Validate(p.PoolAssets); err != nil {
+		return err
+	}
+
+	// the pool weights are expected to be poked to blockTime.
+	return p.setSmoothWeightChangeParams(&params, p.PoolAssets, blockTime)
+}
+
+// SetFees sets the swap and exit fees of the pool.
+// The swap fee is not used by pools with dynamic swap fee params.
+func (p *Pool) SetFees(swapFee, exitFee sdk.Dec) error {
+	params := p.PoolParams
+	params.SwapFee = swapFee
+	params.ExitFee = exitFee
+	// the smooth weight change params are stored scaled, so they are not validated again.
+	params.SmoothWeightChangeParams = nil
+	if err := params.Validate(p.PoolAssets); err != nil {
+		return err
+	}
+
+	p.PoolParams.SwapFee = swapFee
+	p.PoolParams.ExitFee = exitFee
+	return nil
+}
+
+// SetSwapsPaused pauses or unpauses swaps against the pool.
+func (p *Pool) SetSwapsPaused(paused bool) {
+	p.SwapsPaused = paused
+}
@@ -0,0 +1,96 @@
+package balancer_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+// TestScheduleWeightChange tests that a weight change scheduled on an existing pool starts from
+// the current weights of the pool, and replaces the weight change in progress.
+func TestScheduleWeightChange(t *testing.T) {
+	poolAssets := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset1", sdk.NewInt(1000000))},
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset2", sdk.NewInt(1000000))},
+	}
+	pool, err := balancer.NewBalancerPool(defaultPoolId, defaultBalancerPoolParams, poolAssets, defaultFutureGovernor, defaultCurBlockTime)
+	require.NoError(t, err)
+
+	targetWeights := func(asset1Weight, asset2Weight int64) []balancer.PoolAsset {
+		// the target weights are given out of order.
+		return []balancer.PoolAsset{
+			{Weight: sdk.NewInt(asset2Weight), Token: sdk.NewCoin("asset2", sdk.ZeroInt())},
+			{Weight: sdk.NewInt(asset1Weight), Token: sdk.NewCoin("asset1", sdk.ZeroInt())},
+		}
+	}
+	requireWeights := func(asset1Weight, asset2Weight int64) {
+		require.Equal(t, sdk.NewInt(asset1Weight).MulRaw(balancer.GuaranteedWeightPrecision), pool.PoolAssets[0].Weight)
+		require.Equal(t, sdk.NewInt(asset2Weight).MulRaw(balancer.GuaranteedWeightPrecision), pool.PoolAssets[1].Weight)
+	}
+
+	// the start time defaults to the block time.
+	weights := targetWeights(1, 3)
+	err = pool.ScheduleWeightChange(balancer.SmoothWeightChangeParams{Duration: 2 * time.Hour, TargetPoolWeights: weights}, defaultCurBlockTime)
+	require.NoError(t, err)
+	require.Equal(t, defaultCurBlockTime, pool.PoolParams.SmoothWeightChangeParams.StartTime)
+	// the weights of the message are left untouched.
+	require.Equal(t, targetWeights(1, 3), weights)
+
+	pool.PokePool(defaultCurBlockTime.Add(time.Hour))
+	requireWeights(1, 2)
+
+	// a new weight change starts from the current weights, replacing the one in progress.
+	startTime := defaultCurBlockTime.Add(2 * time.Hour)
+	err = pool.ScheduleWeightChange(balancer.SmoothWeightChangeParams{StartTime: startTime, Duration: time.Hour, TargetPoolWeights: targetWeights(2, 1)}, defaultCurBlockTime.Add(time.Hour))
+	require.NoError(t, err)
+	pool.PokePool(startTime)
+	requireWeights(1, 2)
+	pool.PokePool(startTime.Add(2 * time.Hour))
+	requireWeights(2, 1)
+	require.Nil(t, pool.PoolParams.SmoothWeightChangeParams)
+
+	// the weight change must not start in the past.
+	err = pool.ScheduleWeightChange(balancer.SmoothWeightChangeParams{StartTime: startTime, Duration: time.Hour, TargetPoolWeights: targetWeights(1, 1)}, startTime.Add(time.Second))
+	require.ErrorIs(t, err, types.ErrInvalidWeightChange)
+
+	// the target weights must match the pool assets.
+	weights = []balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset1", sdk.ZeroInt())},
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset3", sdk.ZeroInt())},
+	}
+	err = pool.ScheduleWeightChange(balancer.SmoothWeightChangeParams{Duration: time.Hour, TargetPoolWeights: weights}, startTime)
+	require.ErrorIs(t, err, types.ErrPoolParamsInvalidDenom)
+	require.Nil(t, pool.PoolParams.SmoothWeightChangeParams)
+}
+
+// TestSetFees tests that the fees of a pool can be set while its weights are changing.
+func TestSetFees(t *testing.T) {
+	poolAssets := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset1", sdk.NewInt(1000000))},
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset2", sdk.NewInt(1000000))},
+	}
+	params := defaultBalancerPoolParams
+	params.SmoothWeightChangeParams = &balancer.SmoothWeightChangeParams{
+		Duration: time.Hour,
+		TargetPoolWeights: []balancer.PoolAsset{
+			{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset1", sdk.ZeroInt())},
+			{Weight: sdk.NewInt(3), Token: sdk.NewCoin("asset2", sdk.ZeroInt())},
+		},
+	}
+	pool, err := balancer.NewBalancerPool(defaultPoolId, params, poolAssets, defaultFutureGovernor, defaultCurBlockTime)
+	require.NoError(t, err)
+
+	swapFee, exitFee := sdk.MustNewDecFromStr("0.003"), sdk.ZeroDec()
+	require.NoError(t, pool.SetFees(swapFee, exitFee))
+	require.Equal(t, swapFee, pool.GetSwapFee(sdk.Context{}))
+	require.Equal(t, exitFee, pool.GetExitFee(sdk.Context{}))
+	require.NotNil(t, pool.PoolParams.SmoothWeightChangeParams)
+
+	require.ErrorIs(t, pool.SetFees(sdk.OneDec(), exitFee), types.ErrTooMuchSwapFee)
+	require.Equal(t, swapFee, pool.GetSwapFee(sdk.Context{}))
+}
@@ -0,0 +1,127 @@
+package balancer
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+// NewLiquidityBootstrappingPool returns a two-asset balancer pool whose weights change smoothly from the weights
+// of assets to the target weights of weightChange over its sale, during which only the creator can join it.
+// Once the sale ends, the weights are frozen or equalized according to endBehavior.
+// The start time of the sale defaults to blockTime, and must not be before it.
+func NewLiquidityBootstrappingPool(poolId uint64, swapFee, exitFee sdk.Dec, assets []PoolAsset, weightChange SmoothWeightChangeParams,
+	endBehavior LiquidityBootstrappingEndBehavior, creator string, futureGovernor string, blockTime time.Time,
+) (Pool, error) {
+	if len(assets) != 2 {
+		return Pool{}, sdkerrors.Wrapf(types.ErrInvalidLiquidityBootstrapping, "pool must hold 2 assets, got %d", len(assets))
+	}
+	if _, ok := LiquidityBootstrappingEndBehavior_name[int32(endBehavior)]; !ok {
+		return Pool{}, sdkerrors.Wrapf(types.ErrInvalidLiquidityBootstrapping, "unknown end behavior %d", endBehavior)
+	}
+	if weightChange.StartTime.Unix() > 0 && weightChange.StartTime.Before(blockTime) {
+		return Pool{}, sdkerrors.Wrapf(types.ErrInvalidLiquidityBootstrapping, "start time (%s) must not be before the block time (%s)", weightChange.StartTime, blockTime)
+	}
+
+	// the target weights are sorted and scaled in place, so they are copied to leave the caller's untouched.
+	weightChange.TargetPoolWeights = append([]PoolAsset(nil), weightChange.TargetPoolWeights...)
+	params := NewPoolParams(swapFee, exitFee, &weightChange)
+	pool, err := NewBalancerPool(poolId, params, assets, futureGovernor, blockTime)
+	if err != nil {
+		return Pool{}, err
+	}
+
+	startTime := pool.PoolParams.SmoothWeightChangeParams.StartTime
+	pool.LiquidityBootstrappingParams = &LiquidityBootstrappingParams{
+		Creator:     creator,
+		StartTime:   startTime,
+		EndTime:     startTime.Add(weightChange.Duration),
+		EndBehavior: endBehavior,
+	}
+	return pool, nil
+}
+
+// IsLiquidityBootstrapping returns true if the pool was created as a liquidity bootstrapping pool,
+// and still is: the pools equalizing their weights become normal pools once their sale ends.
+func (p Pool) IsLiquidityBootstrapping() bool {
+	return p.LiquidityBootstrappingParams != nil
+}
+
+// IsSaleActive returns true if the pool is a liquidity bootstrapping pool whose sale did not end at blockTime.
+func (p Pool) IsSaleActive(blockTime time.Time) bool {
+	return p.IsLiquidityBootstrapping() && blockTime.Before(p.LiquidityBootstrappingParams.EndTime)
+}
+
+// ValidateJoin checks that the sender can join the pool at blockTime:
+// only the creator of a liquidity bootstrapping pool can join it during its sale.
+func (p Pool) ValidateJoin(sender sdk.AccAddress, blockTime time.Time) error {
+	if !p.IsSaleActive(blockTime) || p.LiquidityBootstrappingParams.Creator == sender.String() {
+		return nil
+	}
+	return sdkerrors.Wrapf(types.ErrJoinRestricted, "pool %d sale ends at %s", p.Id, p.LiquidityBootstrappingParams.EndTime)
+}
+
+// pokeLiquidityBootstrapping ends the sale of a liquidity bootstrapping pool once blockTime reaches its end time.
+// The weights, which reached their target by then, are either kept and frozen, or equalized,
+// in which case the pool becomes a normal pool.
+func (p *Pool) pokeLiquidityBootstrapping(blockTime time.Time) {
+	if !p.IsLiquidityBootstrapping() || p.IsSaleActive(blockTime) {
+		return
+	}
+
+	// the weight change of the sale may not be cleared yet if the sale ends exactly at blockTime.
+	p.PoolParams.SmoothWeightChangeParams = nil
+
+	if p.LiquidityBootstrappingParams.EndBehavior == LBP_END_EQUALIZE_WEIGHTS {
+		equalWeights := make([]PoolAsset, len(p.PoolAssets))
+		for i, asset := range p.PoolAssets {
+			equalWeights[i] = PoolAsset{Token: asset.Token, Weight: sdk.NewInt(GuaranteedWeightPrecision)}
+		}
+		p.updateAllWeights(equalWeights)
+		p.LiquidityBootstrappingParams = nil
+	}
+}
+
+// PriceCurve returns the spot prices of baseAsset in terms of quoteAsset the liquidity bootstrapping pool
+// is projected to have at numPoints evenly spaced times, from the start of its sale or the block time if later,
+// to the end of its sale, assuming its balances do not change.
+func (p Pool) PriceCurve(ctx sdk.Context, quoteAsset, baseAsset string, numPoints int) ([]types.PricePoint, error) {
+	if !p.IsLiquidityBootstrapping() {
+		return nil, sdkerrors.Wrapf(types.ErrNotLiquidityBootstrappingPool, "pool id %d", p.Id)
+	}
+	if !p.IsSaleActive(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidLiquidityBootstrapping, "sale of pool %d ended", p.Id)
+	}
+	if numPoints < 2 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidLiquidityBootstrapping, "price curve must have at least 2 points, got %d", numPoints)
+	}
+
+	// the weights of the pool are poked to the block time, so the curve can not start before it.
+	startTime, endTime := p.LiquidityBootstrappingParams.StartTime, p.LiquidityBootstrappingParams.EndTime
+	if startTime.Before(ctx.BlockTime()) {
+		startTime = ctx.BlockTime()
+	}
+	step := endTime.Sub(startTime) / time.Duration(numPoints-1)
+
+	points := make([]types.PricePoint, numPoints)
+	for i := range points {
+		t := startTime.Add(step * time.Duration(i))
+		if i == numPoints-1 {
+			t = endTime
+		}
+
+		// the weights are updated in place when poking, so the pool assets are copied.
+		projected := p
+		projected.PoolAssets = p.GetAllPoolAssets()
+		projected.PokePool(t)
+		spotPrice, err := projected.SpotPrice(ctx, quoteAsset, baseAsset)
+		if err != nil {
+			return nil, err
+		}
+		points[i] = types.PricePoint{Time: t, SpotPrice: spotPrice}
+	}
+	return points, nil
+}
@@ -0,0 +1,228 @@
+package balancer_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+var (
+	lbpCreator = sdk.AccAddress([]byte("addr1---------------"))
+	lbpJoiner  = sdk.AccAddress([]byte("addr2---------------"))
+)
+
+func lbpPoolAssets(asset1Weight, asset2Weight int64) []balancer.PoolAsset {
+	return []balancer.PoolAsset{
+		{Weight: sdk.NewInt(asset1Weight), Token: sdk.NewCoin("asset1", sdk.NewInt(1000000))},
+		{Weight: sdk.NewInt(asset2Weight), Token: sdk.NewCoin("asset2", sdk.NewInt(1000000))},
+	}
+}
+
+func lbpWeightChange(startTime time.Time, duration time.Duration, asset1Weight, asset2Weight int64) balancer.SmoothWeightChangeParams {
+	return balancer.SmoothWeightChangeParams{
+		StartTime: startTime,
+		Duration:  duration,
+		TargetPoolWeights: []balancer.PoolAsset{
+			{Weight: sdk.NewInt(asset1Weight), Token: sdk.NewCoin("asset1", sdk.ZeroInt())},
+			{Weight: sdk.NewInt(asset2Weight), Token: sdk.NewCoin("asset2", sdk.ZeroInt())},
+		},
+	}
+}
+
+func newLiquidityBootstrappingPool(t *testing.T, endBehavior balancer.LiquidityBootstrappingEndBehavior) balancer.Pool {
+	pool, err := balancer.NewLiquidityBootstrappingPool(defaultPoolId, sdk.ZeroDec(), sdk.ZeroDec(), lbpPoolAssets(9, 1),
+		lbpWeightChange(time.Time{}, 2*time.Hour, 1, 1), endBehavior, lbpCreator.String(), defaultFutureGovernor, defaultCurBlockTime)
+	require.NoError(t, err)
+	return pool
+}
+
+func TestNewLiquidityBootstrappingPool(t *testing.T) {
+	tests := map[string]struct {
+		poolAssets   []balancer.PoolAsset
+		weightChange balancer.SmoothWeightChangeParams
+		endBehavior  balancer.LiquidityBootstrappingEndBehavior
+		expectedErr  error
+	}{
+		"start time defaults to the block time": {
+			poolAssets:   lbpPoolAssets(9, 1),
+			weightChange: lbpWeightChange(time.Time{}, time.Hour, 1, 1),
+			endBehavior:  balancer.LBP_END_FREEZE_WEIGHTS,
+		},
+		"start time in the future": {
+			poolAssets:   lbpPoolAssets(9, 1),
+			weightChange: lbpWeightChange(defaultCurBlockTime.Add(time.Hour), time.Hour, 1, 1),
+			endBehavior:  balancer.LBP_END_EQUALIZE_WEIGHTS,
+		},
+		"start time in the past": {
+			poolAssets:   lbpPoolAssets(9, 1),
+			weightChange: lbpWeightChange(defaultCurBlockTime.Add(-time.Second), time.Hour, 1, 1),
+			endBehavior:  balancer.LBP_END_FREEZE_WEIGHTS,
+			expectedErr:  types.ErrInvalidLiquidityBootstrapping,
+		},
+		"more than two assets": {
+			poolAssets: append(lbpPoolAssets(9, 1),
+				balancer.PoolAsset{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset3", sdk.NewInt(1000000))}),
+			weightChange: lbpWeightChange(time.Time{}, time.Hour, 1, 1),
+			endBehavior:  balancer.LBP_END_FREEZE_WEIGHTS,
+			expectedErr:  types.ErrInvalidLiquidityBootstrapping,
+		},
+		"unknown end behavior": {
+			poolAssets:   lbpPoolAssets(9, 1),
+			weightChange: lbpWeightChange(time.Time{}, time.Hour, 1, 1),
+			endBehavior:  balancer.LiquidityBootstrappingEndBehavior(2),
+			expectedErr:  types.ErrInvalidLiquidityBootstrapping,
+		},
+		"target weights not matching the pool assets": {
+			poolAssets: lbpPoolAssets(9, 1),
+			weightChange: balancer.SmoothWeightChangeParams{
+				Duration: time.Hour,
+				TargetPoolWeights: []balancer.PoolAsset{
+					{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset1", sdk.ZeroInt())},
+					{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset3", sdk.ZeroInt())},
+				},
+			},
+			endBehavior: balancer.LBP_END_FREEZE_WEIGHTS,
+			expectedErr: types.ErrPoolParamsInvalidDenom,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool, err := balancer.NewLiquidityBootstrappingPool(defaultPoolId, sdk.ZeroDec(), sdk.ZeroDec(), tc.poolAssets,
+				tc.weightChange, tc.endBehavior, lbpCreator.String(), defaultFutureGovernor, defaultCurBlockTime)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			startTime := tc.weightChange.StartTime
+			if startTime.IsZero() {
+				startTime = defaultCurBlockTime
+			}
+			require.True(t, pool.IsLiquidityBootstrapping())
+			require.Equal(t, balancer.LiquidityBootstrappingParams{
+				Creator:     lbpCreator.String(),
+				StartTime:   startTime,
+				EndTime:     startTime.Add(tc.weightChange.Duration),
+				EndBehavior: tc.endBehavior,
+			}, *pool.LiquidityBootstrappingParams)
+			require.Equal(t, startTime, pool.PoolParams.SmoothWeightChangeParams.StartTime)
+		})
+	}
+}
+
+// TestLiquidityBootstrappingPoolSale tests that only the creator of a liquidity bootstrapping pool can join it
+// during its sale, and that its weights are frozen or equalized once its sale ends.
+func TestLiquidityBootstrappingPoolSale(t *testing.T) {
+	requireWeights := func(pool balancer.Pool, asset1Weight, asset2Weight int64) {
+		require.Equal(t, sdk.NewInt(asset1Weight).MulRaw(balancer.GuaranteedWeightPrecision), pool.PoolAssets[0].Weight)
+		require.Equal(t, sdk.NewInt(asset2Weight).MulRaw(balancer.GuaranteedWeightPrecision), pool.PoolAssets[1].Weight)
+	}
+	endTime := defaultCurBlockTime.Add(2 * time.Hour)
+
+	for _, endBehavior := range []balancer.LiquidityBootstrappingEndBehavior{balancer.LBP_END_FREEZE_WEIGHTS, balancer.LBP_END_EQUALIZE_WEIGHTS} {
+		t.Run(endBehavior.String(), func(t *testing.T) {
+			pool := newLiquidityBootstrappingPool(t, endBehavior)
+
+			// during the sale, the weights change and only the creator can join.
+			pool.PokePool(defaultCurBlockTime.Add(time.Hour))
+			requireWeights(pool, 5, 1)
+			require.True(t, pool.IsSaleActive(defaultCurBlockTime.Add(time.Hour)))
+			require.NoError(t, pool.ValidateJoin(lbpCreator, defaultCurBlockTime.Add(time.Hour)))
+			require.ErrorIs(t, pool.ValidateJoin(lbpJoiner, defaultCurBlockTime.Add(time.Hour)), types.ErrJoinRestricted)
+
+			// the weights can not be changed by the governor during the sale.
+			err := pool.ScheduleWeightChange(lbpWeightChange(time.Time{}, time.Hour, 1, 9), defaultCurBlockTime.Add(time.Hour))
+			require.ErrorIs(t, err, types.ErrInvalidWeightChange)
+
+			// the sale ends at its end time, when anyone can join.
+			pool.PokePool(endTime)
+			require.False(t, pool.IsSaleActive(endTime))
+			require.NoError(t, pool.ValidateJoin(lbpJoiner, endTime))
+			require.Nil(t, pool.PoolParams.SmoothWeightChangeParams)
+
+			switch endBehavior {
+			case balancer.LBP_END_FREEZE_WEIGHTS:
+				requireWeights(pool, 1, 1)
+				require.True(t, pool.IsLiquidityBootstrapping())
+				err := pool.ScheduleWeightChange(lbpWeightChange(time.Time{}, time.Hour, 1, 9), endTime)
+				require.ErrorIs(t, err, types.ErrInvalidWeightChange)
+			case balancer.LBP_END_EQUALIZE_WEIGHTS:
+				requireWeights(pool, 1, 1)
+				require.False(t, pool.IsLiquidityBootstrapping())
+				// the pool became a normal pool.
+				err := pool.ScheduleWeightChange(lbpWeightChange(time.Time{}, time.Hour, 1, 9), endTime)
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestLiquidityBootstrappingPoolEqualizeWeights tests that the weights of a pool equalizing its weights
+// are equal once its sale ends, whatever its target weights are, and stay so.
+func TestLiquidityBootstrappingPoolEqualizeWeights(t *testing.T) {
+	pool, err := balancer.NewLiquidityBootstrappingPool(defaultPoolId, sdk.ZeroDec(), sdk.ZeroDec(), lbpPoolAssets(9, 1),
+		lbpWeightChange(time.Time{}, time.Hour, 1, 3), balancer.LBP_END_EQUALIZE_WEIGHTS, lbpCreator.String(), defaultFutureGovernor, defaultCurBlockTime)
+	require.NoError(t, err)
+
+	for _, blockTime := range []time.Time{defaultCurBlockTime.Add(time.Hour), defaultCurBlockTime.Add(2 * time.Hour)} {
+		pool.PokePool(blockTime)
+		require.Equal(t, pool.PoolAssets[0].Weight, pool.PoolAssets[1].Weight)
+		require.Equal(t, pool.PoolAssets[0].Weight.MulRaw(2), pool.TotalWeight)
+	}
+}
+
+func TestLiquidityBootstrappingPriceCurve(t *testing.T) {
+	ctxAt := func(blockTime time.Time) sdk.Context {
+		return sdk.Context{}.WithBlockTime(blockTime)
+	}
+	requirePoints := func(expected, actual []types.PricePoint) {
+		require.Len(t, actual, len(expected))
+		for i := range expected {
+			require.True(t, expected[i].Time.Equal(actual[i].Time), "point %d: expected time %s, got %s", i, expected[i].Time, actual[i].Time)
+			require.Equal(t, expected[i].SpotPrice, actual[i].SpotPrice, "point %d", i)
+		}
+	}
+	pool := newLiquidityBootstrappingPool(t, balancer.LBP_END_FREEZE_WEIGHTS)
+
+	// the price of asset1 falls from 9 to 1 as its weight falls from 9 to 1, the balances being equal.
+	points, err := pool.PriceCurve(ctxAt(defaultCurBlockTime), "asset2", "asset1", 3)
+	require.NoError(t, err)
+	requirePoints([]types.PricePoint{
+		{Time: defaultCurBlockTime, SpotPrice: sdk.NewDec(9)},
+		{Time: defaultCurBlockTime.Add(time.Hour), SpotPrice: sdk.NewDec(5)},
+		{Time: defaultCurBlockTime.Add(2 * time.Hour), SpotPrice: sdk.NewDec(1)},
+	}, points)
+	// the pool is left untouched.
+	require.Equal(t, sdk.NewInt(9).MulRaw(balancer.GuaranteedWeightPrecision), pool.PoolAssets[0].Weight)
+
+	// the curve starts at the block time once the sale started.
+	blockTime := defaultCurBlockTime.Add(time.Hour)
+	pool.PokePool(blockTime)
+	points, err = pool.PriceCurve(ctxAt(blockTime), "asset2", "asset1", 2)
+	require.NoError(t, err)
+	requirePoints([]types.PricePoint{
+		{Time: blockTime, SpotPrice: sdk.NewDec(5)},
+		{Time: defaultCurBlockTime.Add(2 * time.Hour), SpotPrice: sdk.NewDec(1)},
+	}, points)
+
+	_, err = pool.PriceCurve(ctxAt(blockTime), "asset2", "asset1", 1)
+	require.ErrorIs(t, err, types.ErrInvalidLiquidityBootstrapping)
+	_, err = pool.PriceCurve(ctxAt(blockTime), "asset3", "asset1", 2)
+	require.Error(t, err)
+
+	// there is no curve once the sale ended.
+	_, err = pool.PriceCurve(ctxAt(defaultCurBlockTime.Add(2*time.Hour)), "asset2", "asset1", 2)
+	require.ErrorIs(t, err, types.ErrInvalidLiquidityBootstrapping)
+
+	normalPool, err := balancer.NewBalancerPool(defaultPoolId, defaultBalancerPoolParams, lbpPoolAssets(1, 1), defaultFutureGovernor, defaultCurBlockTime)
+	require.NoError(t, err)
+	_, err = normalPool.PriceCurve(ctxAt(defaultCurBlockTime), "asset2", "asset1", 2)
+	require.ErrorIs(t, err, types.ErrNotLiquidityBootstrappingPool)
+}
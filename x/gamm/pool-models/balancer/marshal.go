@@ -7,13 +7,15 @@ import (
 )
 
 type balancerPoolPretty struct {
-	Address            sdk.AccAddress `json:"address" yaml:"address"`
-	Id                 uint64         `json:"id" yaml:"id"`
-	PoolParams         PoolParams     `json:"pool_params" yaml:"pool_params"`
-	FuturePoolGovernor string         `json:"future_pool_governor" yaml:"future_pool_governor"`
-	TotalWeight        sdk.Dec        `json:"total_weight" yaml:"total_weight"`
-	TotalShares        sdk.Coin       `json:"total_shares" yaml:"total_shares"`
-	PoolAssets         []PoolAsset    `json:"pool_assets" yaml:"pool_assets"`
+	Address            sdk.AccAddress     `json:"address" yaml:"address"`
+	Id                 uint64             `json:"id" yaml:"id"`
+	PoolParams         PoolParams         `json:"pool_params" yaml:"pool_params"`
+	FuturePoolGovernor string             `json:"future_pool_governor" yaml:"future_pool_governor"`
+	TotalWeight        sdk.Dec            `json:"total_weight" yaml:"total_weight"`
+	TotalShares        sdk.Coin           `json:"total_shares" yaml:"total_shares"`
+	PoolAssets         []PoolAsset        `json:"pool_assets" yaml:"pool_assets"`
+	VolatilityTracker  *VolatilityTracker `json:"volatility_tracker,omitempty" yaml:"volatility_tracker,omitempty"`
+	SwapsPaused        bool               `json:"swaps_paused,omitempty" yaml:"swaps_paused,omitempty"`
 }
 
 func (p Pool) String() string {
@@ -41,6 +43,8 @@ func (p Pool) MarshalJSON() ([]byte, error) {
 		TotalWeight:        decTotalWeight,
 		TotalShares:        p.TotalShares,
 		PoolAssets:         p.PoolAssets,
+		VolatilityTracker:  p.VolatilityTracker,
+		SwapsPaused:        p.SwapsPaused,
 	})
 }
 
@@ -58,6 +62,8 @@ func (p *Pool) UnmarshalJSON(bz []byte) error {
 	p.TotalWeight = alias.TotalWeight.RoundInt()
 	p.TotalShares = alias.TotalShares
 	p.PoolAssets = alias.PoolAssets
+	p.VolatilityTracker = alias.VolatilityTracker
+	p.SwapsPaused = alias.SwapsPaused
 
 	return nil
 }
@@ -9,13 +9,23 @@ import (
 )
 
 const (
-	TypeMsgCreateBalancerPool = "create_balancer_pool"
-	TypeMsgMigrateShares      = "migrate_shares"
+	TypeMsgCreateBalancerPool   = "create_balancer_pool"
+	TypeMsgMigrateShares        = "migrate_shares"
+	TypeMsgScheduleWeightChange = "schedule_weight_change"
+	TypeMsgSetPoolFees          = "set_pool_fees"
+	TypeMsgSetSwapsPaused       = "set_swaps_paused"
+
+	TypeMsgCreateLiquidityBootstrappingPool = "create_liquidity_bootstrapping_pool"
 )
 
 var (
 	_ sdk.Msg                        = &MsgCreateBalancerPool{}
 	_ poolmanagertypes.CreatePoolMsg = &MsgCreateBalancerPool{}
+	_ sdk.Msg                        = &MsgScheduleWeightChange{}
+	_ sdk.Msg                        = &MsgSetPoolFees{}
+	_ sdk.Msg                        = &MsgSetSwapsPaused{}
+	_ sdk.Msg                        = &MsgCreateLiquidityBootstrappingPool{}
+	_ poolmanagertypes.CreatePoolMsg = &MsgCreateLiquidityBootstrappingPool{}
 )
 
 func NewMsgCreateBalancerPool(
@@ -104,3 +114,221 @@ func (msg MsgCreateBalancerPool) CreatePool(ctx sdk.Context, poolID uint64) (poo
 func (msg MsgCreateBalancerPool) GetPoolType() poolmanagertypes.PoolType {
 	return poolmanagertypes.Balancer
 }
+
+func NewMsgScheduleWeightChange(
+	sender string,
+	poolID uint64,
+	params SmoothWeightChangeParams,
+) MsgScheduleWeightChange {
+	return MsgScheduleWeightChange{
+		Sender:                   sender,
+		PoolID:                   poolID,
+		SmoothWeightChangeParams: params,
+	}
+}
+
+func (msg MsgScheduleWeightChange) Route() string { return types.RouterKey }
+func (msg MsgScheduleWeightChange) Type() string  { return TypeMsgScheduleWeightChange }
+func (msg MsgScheduleWeightChange) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	// the target weights are matched against the pool assets when the message is executed.
+	params := msg.SmoothWeightChangeParams
+	for _, v := range params.TargetPoolWeights {
+		if err := ValidateUserSpecifiedWeight(v.Weight); err != nil {
+			return err
+		}
+		if err := sdk.ValidateDenom(v.Token.Denom); err != nil {
+			return err
+		}
+	}
+	if params.Duration <= 0 {
+		return sdkerrors.Wrap(types.ErrInvalidWeightChange, "duration must be positive")
+	}
+
+	return nil
+}
+
+func (msg MsgScheduleWeightChange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgScheduleWeightChange) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func NewMsgSetPoolFees(
+	sender string,
+	poolID uint64,
+	swapFee sdk.Dec,
+	exitFee sdk.Dec,
+) MsgSetPoolFees {
+	return MsgSetPoolFees{
+		Sender:  sender,
+		PoolID:  poolID,
+		SwapFee: swapFee,
+		ExitFee: exitFee,
+	}
+}
+
+func (msg MsgSetPoolFees) Route() string { return types.RouterKey }
+func (msg MsgSetPoolFees) Type() string  { return TypeMsgSetPoolFees }
+func (msg MsgSetPoolFees) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.SwapFee.IsNil() || msg.SwapFee.IsNegative() {
+		return types.ErrNegativeSwapFee
+	}
+	if msg.SwapFee.GTE(sdk.OneDec()) {
+		return types.ErrTooMuchSwapFee
+	}
+	if msg.ExitFee.IsNil() || msg.ExitFee.IsNegative() {
+		return types.ErrNegativeExitFee
+	}
+	if msg.ExitFee.GTE(sdk.OneDec()) {
+		return types.ErrTooMuchExitFee
+	}
+
+	return nil
+}
+
+func (msg MsgSetPoolFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPoolFees) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func NewMsgSetSwapsPaused(
+	sender string,
+	poolID uint64,
+	paused bool,
+) MsgSetSwapsPaused {
+	return MsgSetSwapsPaused{
+		Sender: sender,
+		PoolID: poolID,
+		Paused: paused,
+	}
+}
+
+func (msg MsgSetSwapsPaused) Route() string { return types.RouterKey }
+func (msg MsgSetSwapsPaused) Type() string  { return TypeMsgSetSwapsPaused }
+func (msg MsgSetSwapsPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgSetSwapsPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetSwapsPaused) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func NewMsgCreateLiquidityBootstrappingPool(
+	sender sdk.AccAddress,
+	swapFee, exitFee sdk.Dec,
+	poolAssets []PoolAsset,
+	weightChange SmoothWeightChangeParams,
+	endBehavior LiquidityBootstrappingEndBehavior,
+	futurePoolGovernor string,
+) MsgCreateLiquidityBootstrappingPool {
+	return MsgCreateLiquidityBootstrappingPool{
+		Sender:                   sender.String(),
+		SwapFee:                  swapFee,
+		ExitFee:                  exitFee,
+		PoolAssets:               poolAssets,
+		SmoothWeightChangeParams: weightChange,
+		EndBehavior:              endBehavior,
+		FuturePoolGovernor:       futurePoolGovernor,
+	}
+}
+
+func (msg MsgCreateLiquidityBootstrappingPool) Route() string { return types.RouterKey }
+func (msg MsgCreateLiquidityBootstrappingPool) Type() string {
+	return TypeMsgCreateLiquidityBootstrappingPool
+}
+
+func (msg MsgCreateLiquidityBootstrappingPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if len(msg.PoolAssets) != 2 {
+		return sdkerrors.Wrapf(types.ErrInvalidLiquidityBootstrapping, "pool must hold 2 assets, got %d", len(msg.PoolAssets))
+	}
+	if err := validateUserSpecifiedPoolAssets(msg.PoolAssets); err != nil {
+		return err
+	}
+
+	if msg.SwapFee.IsNil() || msg.ExitFee.IsNil() {
+		return sdkerrors.Wrap(types.ErrInvalidLiquidityBootstrapping, "swap and exit fees must be set")
+	}
+	weightChange := msg.SmoothWeightChangeParams
+	if err := NewPoolParams(msg.SwapFee, msg.ExitFee, &weightChange).Validate(msg.PoolAssets); err != nil {
+		return err
+	}
+
+	if _, ok := LiquidityBootstrappingEndBehavior_name[int32(msg.EndBehavior)]; !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidLiquidityBootstrapping, "unknown end behavior %d", msg.EndBehavior)
+	}
+
+	return types.ValidateFutureGovernor(msg.FuturePoolGovernor)
+}
+
+func (msg MsgCreateLiquidityBootstrappingPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateLiquidityBootstrappingPool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.PoolCreator()}
+}
+
+/// Implement the CreatePoolMsg interface
+
+func (msg MsgCreateLiquidityBootstrappingPool) PoolCreator() sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return sender
+}
+
+func (msg MsgCreateLiquidityBootstrappingPool) Validate(ctx sdk.Context) error {
+	return msg.ValidateBasic()
+}
+
+func (msg MsgCreateLiquidityBootstrappingPool) InitialLiquidity() sdk.Coins {
+	return poolAssetsCoins(msg.PoolAssets)
+}
+
+func (msg MsgCreateLiquidityBootstrappingPool) CreatePool(ctx sdk.Context, poolID uint64) (poolmanagertypes.PoolI, error) {
+	poolI, err := NewLiquidityBootstrappingPool(poolID, msg.SwapFee, msg.ExitFee, msg.PoolAssets, msg.SmoothWeightChangeParams,
+		msg.EndBehavior, msg.Sender, msg.FuturePoolGovernor, ctx.BlockTime())
+	return &poolI, err
+}
+
+func (msg MsgCreateLiquidityBootstrappingPool) GetPoolType() poolmanagertypes.PoolType {
+	return poolmanagertypes.Balancer
+}
@@ -2,6 +2,7 @@ package balancer_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -209,6 +210,54 @@ func TestMsgCreateBalancerPool_ValidateBasic(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "dynamic swap fee",
+			msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
+				msg.PoolParams.DynamicSwapFeeParams = &balancer.DynamicSwapFeeParams{
+					BaseFee:     sdk.NewDecWithPrec(2, 3),
+					MaxFee:      sdk.NewDecWithPrec(2, 2),
+					Sensitivity: sdk.NewDecWithPrec(5, 1),
+				}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "dynamic swap fee: max fee below base fee",
+			msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
+				msg.PoolParams.DynamicSwapFeeParams = &balancer.DynamicSwapFeeParams{
+					BaseFee:     sdk.NewDecWithPrec(2, 2),
+					MaxFee:      sdk.NewDecWithPrec(2, 3),
+					Sensitivity: sdk.NewDecWithPrec(5, 1),
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "dynamic swap fee: max fee of one",
+			msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
+				msg.PoolParams.DynamicSwapFeeParams = &balancer.DynamicSwapFeeParams{
+					BaseFee:     sdk.NewDecWithPrec(2, 3),
+					MaxFee:      sdk.OneDec(),
+					Sensitivity: sdk.NewDecWithPrec(5, 1),
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "dynamic swap fee: negative sensitivity",
+			msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
+				msg.PoolParams.DynamicSwapFeeParams = &balancer.DynamicSwapFeeParams{
+					BaseFee:     sdk.NewDecWithPrec(2, 3),
+					MaxFee:      sdk.NewDecWithPrec(2, 2),
+					Sensitivity: sdk.NewDec(-1),
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
 		// {
 		// 	name: "Create an LBP",
 		// 	msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
@@ -289,3 +338,217 @@ func (suite *KeeperTestSuite) TestMsgCreateBalancerPool() {
 		})
 	}
 }
+
+func TestMsgScheduleWeightChange_ValidateBasic(t *testing.T) {
+	apptesting.SetAddressPrefixes()
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	createParams := func(weight sdk.Int, duration time.Duration) balancer.SmoothWeightChangeParams {
+		return balancer.SmoothWeightChangeParams{
+			Duration: duration,
+			TargetPoolWeights: []balancer.PoolAsset{
+				{Weight: sdk.NewInt(100), Token: sdk.NewCoin("test", sdk.ZeroInt())},
+				{Weight: weight, Token: sdk.NewCoin("test2", sdk.ZeroInt())},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		msg        balancer.MsgScheduleWeightChange
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        balancer.NewMsgScheduleWeightChange(sender, 1, createParams(sdk.NewInt(300), 48*time.Hour)),
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        balancer.NewMsgScheduleWeightChange("invalid", 1, createParams(sdk.NewInt(300), 48*time.Hour)),
+			expectPass: false,
+		},
+		{
+			name:       "zero weight",
+			msg:        balancer.NewMsgScheduleWeightChange(sender, 1, createParams(sdk.ZeroInt(), 48*time.Hour)),
+			expectPass: false,
+		},
+		{
+			name:       "weight too large",
+			msg:        balancer.NewMsgScheduleWeightChange(sender, 1, createParams(sdk.NewInt(1<<20), 48*time.Hour)),
+			expectPass: false,
+		},
+		{
+			name:       "zero duration",
+			msg:        balancer.NewMsgScheduleWeightChange(sender, 1, createParams(sdk.NewInt(300), 0)),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSetPoolFees_ValidateBasic(t *testing.T) {
+	apptesting.SetAddressPrefixes()
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	fee := sdk.NewDecWithPrec(1, 2)
+
+	tests := []struct {
+		name       string
+		msg        balancer.MsgSetPoolFees
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        balancer.NewMsgSetPoolFees(sender, 1, fee, sdk.ZeroDec()),
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        balancer.NewMsgSetPoolFees("invalid", 1, fee, fee),
+			expectPass: false,
+		},
+		{
+			name:       "negative swap fee",
+			msg:        balancer.NewMsgSetPoolFees(sender, 1, fee.Neg(), fee),
+			expectPass: false,
+		},
+		{
+			name:       "swap fee of 100%",
+			msg:        balancer.NewMsgSetPoolFees(sender, 1, sdk.OneDec(), fee),
+			expectPass: false,
+		},
+		{
+			name:       "negative exit fee",
+			msg:        balancer.NewMsgSetPoolFees(sender, 1, fee, fee.Neg()),
+			expectPass: false,
+		},
+		{
+			name:       "unset exit fee",
+			msg:        balancer.NewMsgSetPoolFees(sender, 1, fee, sdk.Dec{}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCreateLiquidityBootstrappingPool_ValidateBasic(t *testing.T) {
+	apptesting.SetAddressPrefixes()
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	fee := sdk.NewDecWithPrec(1, 2)
+
+	poolAssets := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(9), Token: sdk.NewCoin("urollapp", sdk.NewInt(1000000))},
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("adym", sdk.NewInt(100000))},
+	}
+	weightChange := balancer.SmoothWeightChangeParams{
+		Duration: 72 * time.Hour,
+		TargetPoolWeights: []balancer.PoolAsset{
+			{Weight: sdk.NewInt(1), Token: sdk.NewCoin("urollapp", sdk.ZeroInt())},
+			{Weight: sdk.NewInt(1), Token: sdk.NewCoin("adym", sdk.ZeroInt())},
+		},
+	}
+	createMsg := func(after func(msg balancer.MsgCreateLiquidityBootstrappingPool) balancer.MsgCreateLiquidityBootstrappingPool) balancer.MsgCreateLiquidityBootstrappingPool {
+		msg := balancer.NewMsgCreateLiquidityBootstrappingPool(sender, fee, sdk.ZeroDec(), poolAssets, weightChange, balancer.LBP_END_EQUALIZE_WEIGHTS, "")
+		return after(msg)
+	}
+
+	tests := []struct {
+		name       string
+		msg        balancer.MsgCreateLiquidityBootstrappingPool
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg balancer.MsgCreateLiquidityBootstrappingPool) balancer.MsgCreateLiquidityBootstrappingPool {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg balancer.MsgCreateLiquidityBootstrappingPool) balancer.MsgCreateLiquidityBootstrappingPool {
+				msg.Sender = "invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "three assets",
+			msg: createMsg(func(msg balancer.MsgCreateLiquidityBootstrappingPool) balancer.MsgCreateLiquidityBootstrappingPool {
+				msg.PoolAssets = append(msg.PoolAssets, balancer.PoolAsset{Weight: sdk.NewInt(1), Token: sdk.NewCoin("uatom", sdk.NewInt(1000000))})
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "unset swap fee",
+			msg: createMsg(func(msg balancer.MsgCreateLiquidityBootstrappingPool) balancer.MsgCreateLiquidityBootstrappingPool {
+				msg.SwapFee = sdk.Dec{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "exit fee of 100%",
+			msg: createMsg(func(msg balancer.MsgCreateLiquidityBootstrappingPool) balancer.MsgCreateLiquidityBootstrappingPool {
+				msg.ExitFee = sdk.OneDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero duration",
+			msg: createMsg(func(msg balancer.MsgCreateLiquidityBootstrappingPool) balancer.MsgCreateLiquidityBootstrappingPool {
+				msg.SmoothWeightChangeParams.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "target weights not matching the pool assets",
+			msg: createMsg(func(msg balancer.MsgCreateLiquidityBootstrappingPool) balancer.MsgCreateLiquidityBootstrappingPool {
+				msg.SmoothWeightChangeParams.TargetPoolWeights = msg.SmoothWeightChangeParams.TargetPoolWeights[:1]
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "unknown end behavior",
+			msg: createMsg(func(msg balancer.MsgCreateLiquidityBootstrappingPool) balancer.MsgCreateLiquidityBootstrappingPool {
+				msg.EndBehavior = balancer.LiquidityBootstrappingEndBehavior(2)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid governor",
+			msg: createMsg(func(msg balancer.MsgCreateLiquidityBootstrappingPool) balancer.MsgCreateLiquidityBootstrappingPool {
+				msg.FuturePoolGovernor = "invalid_cosmos_address"
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -28,10 +28,11 @@ const (
 )
 
 var (
-	_ poolmanagertypes.PoolI       = &Pool{}
-	_ types.PoolAmountOutExtension = &Pool{}
-	_ types.WeightedPoolExtension  = &Pool{}
-	_ types.CFMMPoolI              = &Pool{}
+	_ poolmanagertypes.PoolI            = &Pool{}
+	_ types.PoolAmountOutExtension      = &Pool{}
+	_ types.WeightedPoolExtension       = &Pool{}
+	_ types.CFMMPoolI                   = &Pool{}
+	_ types.DynamicSwapFeePoolExtension = &Pool{}
 )
 
 // NewPool returns a weighted CPMM pool with the provided parameters, and initial assets.
@@ -88,8 +89,13 @@ func (p Pool) GetId() uint64 {
 	return p.Id
 }
 
-func (p Pool) GetSwapFee(_ sdk.Context) sdk.Dec {
-	return p.PoolParams.SwapFee
+// GetSwapFee returns the swap fee of the pool. For a pool with dynamic swap fee params,
+// it depends on the volatility of the pool at the block time.
+func (p Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
+	if p.PoolParams.DynamicSwapFeeParams == nil {
+		return p.PoolParams.SwapFee
+	}
+	return p.dynamicSwapFee(ctx.BlockTime())
 }
 
 func (p Pool) GetTotalPoolLiquidity(_ sdk.Context) sdk.Coins {
@@ -174,39 +180,54 @@ func (p *Pool) SetInitialPoolAssets(PoolAssets []PoolAsset) error {
 func (p *Pool) setInitialPoolParams(params PoolParams, sortedAssets []PoolAsset, curBlockTime time.Time) error {
 	p.PoolParams = params
 	if params.SmoothWeightChangeParams != nil {
-		// set initial assets
-		initialWeights := make([]PoolAsset, len(sortedAssets))
-		for i, v := range sortedAssets {
-			initialWeights[i] = PoolAsset{
-				Weight: v.Weight,
-				Token:  sdk.Coin{Denom: v.Token.Denom, Amount: sdk.ZeroInt()},
-			}
+		if err := p.setSmoothWeightChangeParams(params.SmoothWeightChangeParams, sortedAssets, curBlockTime); err != nil {
+			return err
 		}
-		params.SmoothWeightChangeParams.InitialPoolWeights = initialWeights
-
-		// sort target weights by denom
-		targetPoolWeights := params.SmoothWeightChangeParams.TargetPoolWeights
-		sortPoolAssetsByDenom(targetPoolWeights)
-
-		// scale target pool weights by GuaranteedWeightPrecision
-		for i, v := range targetPoolWeights {
-			err := ValidateUserSpecifiedWeight(v.Weight)
-			if err != nil {
-				return err
-			}
-			p.PoolParams.SmoothWeightChangeParams.TargetPoolWeights[i] = PoolAsset{
-				Weight: v.Weight.MulRaw(GuaranteedWeightPrecision),
-				Token:  v.Token,
-			}
+	}
+
+	if params.DynamicSwapFeeParams != nil {
+		return p.initVolatilityTracker(curBlockTime)
+	}
+
+	return nil
+}
+
+// setSmoothWeightChangeParams sets the smooth weight change params of the pool, starting from the weights of sortedAssets.
+// The target weights are sorted by denom and scaled by GuaranteedWeightPrecision, and the start time defaults to curBlockTime.
+func (p *Pool) setSmoothWeightChangeParams(params *SmoothWeightChangeParams, sortedAssets []PoolAsset, curBlockTime time.Time) error {
+	// set initial assets
+	initialWeights := make([]PoolAsset, len(sortedAssets))
+	for i, v := range sortedAssets {
+		initialWeights[i] = PoolAsset{
+			Weight: v.Weight,
+			Token:  sdk.Coin{Denom: v.Token.Denom, Amount: sdk.ZeroInt()},
 		}
+	}
+	params.InitialPoolWeights = initialWeights
+
+	// sort target weights by denom
+	targetPoolWeights := params.TargetPoolWeights
+	sortPoolAssetsByDenom(targetPoolWeights)
 
-		// Set start time if not present.
-		if params.SmoothWeightChangeParams.StartTime.Unix() <= 0 {
-			// Per https://golang.org/pkg/time/#Time.Unix, should be timezone independent
-			params.SmoothWeightChangeParams.StartTime = time.Unix(curBlockTime.Unix(), 0)
+	// scale target pool weights by GuaranteedWeightPrecision
+	for i, v := range targetPoolWeights {
+		err := ValidateUserSpecifiedWeight(v.Weight)
+		if err != nil {
+			return err
+		}
+		targetPoolWeights[i] = PoolAsset{
+			Weight: v.Weight.MulRaw(GuaranteedWeightPrecision),
+			Token:  v.Token,
 		}
 	}
 
+	// Set start time if not present.
+	if params.StartTime.Unix() <= 0 {
+		// Per https://golang.org/pkg/time/#Time.Unix, should be timezone independent
+		params.StartTime = time.Unix(curBlockTime.Unix(), 0)
+	}
+
+	p.PoolParams.SmoothWeightChangeParams = params
 	return nil
 }
 
@@ -396,8 +417,15 @@ func (p *Pool) updateAllWeights(newWeights []PoolAsset) {
 }
 
 // PokePool checks to see if the pool's token weights need to be updated, and
-// if so, does so. Currently doesn't do anything outside out LBPs.
+// if so, does so. It also ends the sale of a liquidity bootstrapping pool once
+// its end time is reached. Currently doesn't do anything outside out LBPs.
 func (p *Pool) PokePool(blockTime time.Time) {
+	p.pokeWeights(blockTime)
+	p.pokeLiquidityBootstrapping(blockTime)
+}
+
+// pokeWeights updates the pool's token weights according to its smooth weight change params, if any.
+func (p *Pool) pokeWeights(blockTime time.Time) {
 	// check if pool weights didn't change
 	poolWeightsChanging := p.PoolParams.SmoothWeightChangeParams != nil
 	if !poolWeightsChanging {
@@ -478,8 +506,9 @@ func (p Pool) NumAssets() int {
 	return len(p.PoolAssets)
 }
 
+// IsActive returns false if swaps against the pool are paused by its governor.
 func (p Pool) IsActive(ctx sdk.Context) bool {
-	return true
+	return !p.SwapsPaused
 }
 
 func (p Pool) GetType() poolmanagertypes.PoolType {
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 )
@@ -33,6 +34,12 @@ func (params PoolParams) Validate(poolWeights []PoolAsset) error {
 		return types.ErrTooMuchSwapFee
 	}
 
+	if params.DynamicSwapFeeParams != nil {
+		if err := params.DynamicSwapFeeParams.Validate(); err != nil {
+			return err
+		}
+	}
+
 	if params.SmoothWeightChangeParams != nil {
 		targetWeights := params.SmoothWeightChangeParams.TargetPoolWeights
 		// Ensure it has the right number of weights
@@ -69,6 +76,23 @@ func (params PoolParams) Validate(poolWeights []PoolAsset) error {
 	return nil
 }
 
+// Validate checks that 0 <= base fee <= max fee < 1, and that the sensitivity is not negative.
+func (params DynamicSwapFeeParams) Validate() error {
+	if params.BaseFee.IsNil() || params.BaseFee.IsNegative() {
+		return sdkerrors.Wrapf(types.ErrInvalidDynamicSwapFee, "base fee (%s) must not be negative", params.BaseFee)
+	}
+	if params.MaxFee.IsNil() || params.MaxFee.LT(params.BaseFee) {
+		return sdkerrors.Wrapf(types.ErrInvalidDynamicSwapFee, "max fee (%s) must be greater than or equal to the base fee (%s)", params.MaxFee, params.BaseFee)
+	}
+	if params.MaxFee.GTE(sdk.OneDec()) {
+		return types.ErrTooMuchSwapFee
+	}
+	if params.Sensitivity.IsNil() || params.Sensitivity.IsNegative() {
+		return sdkerrors.Wrapf(types.ErrInvalidDynamicSwapFee, "sensitivity (%s) must not be negative", params.Sensitivity)
+	}
+	return nil
+}
+
 func (params PoolParams) GetPoolSwapFee() sdk.Dec {
 	return params.SwapFee
 }
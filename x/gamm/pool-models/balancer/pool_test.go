@@ -1189,15 +1189,20 @@ func TestBalancerPoolPokeTokenWeights(t *testing.T) {
 	}
 }
 
-// This test (currently trivially) checks to make sure that `IsActive` returns true for balancer pools.
+// This test checks to make sure that `IsActive` returns true for balancer pools, unless their swaps are paused.
 // This is mainly to make sure that if IsActive is ever used as an emergency switch, it is not accidentally left off for any (or all) pools.
 func TestIsActive(t *testing.T) {
 	tests := map[string]struct {
+		swapsPaused      bool
 		expectedIsActive bool
 	}{
 		"IsActive is true": {
 			expectedIsActive: true,
 		},
+		"IsActive is false if swaps are paused": {
+			swapsPaused:      true,
+			expectedIsActive: false,
+		},
 	}
 
 	for name, tc := range tests {
@@ -1207,6 +1212,7 @@ func TestIsActive(t *testing.T) {
 			// Initialize a pool
 			pool, err := balancer.NewBalancerPool(defaultPoolId, defaultBalancerPoolParams, dummyPoolAssets, defaultFutureGovernor, defaultCurBlockTime)
 			require.NoError(t, err, "test %v", name)
+			pool.SetSwapsPaused(tc.swapsPaused)
 
 			isActive := pool.IsActive(ctx)
 			require.Equal(t, tc.expectedIsActive, isActive)
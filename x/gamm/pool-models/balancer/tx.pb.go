@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -143,9 +144,443 @@ func (m *MsgCreateBalancerPoolResponse) GetPoolID() uint64 {
 	return 0
 }
 
+// Sender must be the pool's future_pool_governor in order for the tx to
+// succeed. Schedules a smooth change of the pool weights from their current
+// values to the target weights. Replaces any weight change in progress.
+type MsgScheduleWeightChange struct {
+	Sender                   string                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID                   uint64                   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	SmoothWeightChangeParams SmoothWeightChangeParams `protobuf:"bytes,3,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params" yaml:"smooth_weight_change_params"`
+}
+
+func (m *MsgScheduleWeightChange) Reset()         { *m = MsgScheduleWeightChange{} }
+func (m *MsgScheduleWeightChange) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleWeightChange) ProtoMessage()    {}
+func (*MsgScheduleWeightChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6108fa96f1dd2b9a, []int{2}
+}
+func (m *MsgScheduleWeightChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleWeightChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleWeightChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleWeightChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleWeightChange.Merge(m, src)
+}
+func (m *MsgScheduleWeightChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleWeightChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleWeightChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleWeightChange proto.InternalMessageInfo
+
+func (m *MsgScheduleWeightChange) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgScheduleWeightChange) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgScheduleWeightChange) GetSmoothWeightChangeParams() SmoothWeightChangeParams {
+	if m != nil {
+		return m.SmoothWeightChangeParams
+	}
+	return SmoothWeightChangeParams{}
+}
+
+type MsgScheduleWeightChangeResponse struct {
+}
+
+func (m *MsgScheduleWeightChangeResponse) Reset()         { *m = MsgScheduleWeightChangeResponse{} }
+func (m *MsgScheduleWeightChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleWeightChangeResponse) ProtoMessage()    {}
+func (*MsgScheduleWeightChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6108fa96f1dd2b9a, []int{3}
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleWeightChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleWeightChangeResponse.Merge(m, src)
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleWeightChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleWeightChangeResponse proto.InternalMessageInfo
+
+// Sender must be the pool's future_pool_governor in order for the tx to
+// succeed. Sets the swap and exit fees of the pool, which must be within the
+// governor fee bounds of the module params.
+type MsgSetPoolFees struct {
+	Sender  string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID  uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+}
+
+func (m *MsgSetPoolFees) Reset()         { *m = MsgSetPoolFees{} }
+func (m *MsgSetPoolFees) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolFees) ProtoMessage()    {}
+func (*MsgSetPoolFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6108fa96f1dd2b9a, []int{4}
+}
+func (m *MsgSetPoolFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPoolFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolFees.Merge(m, src)
+}
+func (m *MsgSetPoolFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPoolFees proto.InternalMessageInfo
+
+func (m *MsgSetPoolFees) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetPoolFees) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+type MsgSetPoolFeesResponse struct {
+}
+
+func (m *MsgSetPoolFeesResponse) Reset()         { *m = MsgSetPoolFeesResponse{} }
+func (m *MsgSetPoolFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolFeesResponse) ProtoMessage()    {}
+func (*MsgSetPoolFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6108fa96f1dd2b9a, []int{5}
+}
+func (m *MsgSetPoolFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPoolFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolFeesResponse.Merge(m, src)
+}
+func (m *MsgSetPoolFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPoolFeesResponse proto.InternalMessageInfo
+
+// Sender must be the pool's future_pool_governor in order for the tx to
+// succeed. Pauses or unpauses swaps against the pool.
+type MsgSetSwapsPaused struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Paused bool   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+}
+
+func (m *MsgSetSwapsPaused) Reset()         { *m = MsgSetSwapsPaused{} }
+func (m *MsgSetSwapsPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSwapsPaused) ProtoMessage()    {}
+func (*MsgSetSwapsPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6108fa96f1dd2b9a, []int{6}
+}
+func (m *MsgSetSwapsPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSwapsPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSwapsPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSwapsPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSwapsPaused.Merge(m, src)
+}
+func (m *MsgSetSwapsPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSwapsPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSwapsPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSwapsPaused proto.InternalMessageInfo
+
+func (m *MsgSetSwapsPaused) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetSwapsPaused) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgSetSwapsPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+type MsgSetSwapsPausedResponse struct {
+}
+
+func (m *MsgSetSwapsPausedResponse) Reset()         { *m = MsgSetSwapsPausedResponse{} }
+func (m *MsgSetSwapsPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSwapsPausedResponse) ProtoMessage()    {}
+func (*MsgSetSwapsPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6108fa96f1dd2b9a, []int{7}
+}
+func (m *MsgSetSwapsPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSwapsPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSwapsPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSwapsPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSwapsPausedResponse.Merge(m, src)
+}
+func (m *MsgSetSwapsPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSwapsPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSwapsPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSwapsPausedResponse proto.InternalMessageInfo
+
+// ===================== MsgCreateLiquidityBootstrappingPool
+// Creates a two-asset balancer pool whose weights change smoothly from the
+// weights of pool_assets to the target weights of smooth_weight_change_params
+// over the sale. During the sale only the sender can join the pool. The
+// starting weight ratio and the duration of the sale must be within the
+// liquidity bootstrapping bounds of the module params.
+type MsgCreateLiquidityBootstrappingPool struct {
+	Sender  string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+	// The initial liquidity and the starting weights of the pool.
+	PoolAssets []PoolAsset `protobuf:"bytes,4,rep,name=pool_assets,json=poolAssets,proto3" json:"pool_assets"`
+	// The start time, duration and target weights of the sale. The initial
+	// weights are ignored.
+	SmoothWeightChangeParams SmoothWeightChangeParams          `protobuf:"bytes,5,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params" yaml:"smooth_weight_change_params"`
+	EndBehavior              LiquidityBootstrappingEndBehavior `protobuf:"varint,6,opt,name=end_behavior,json=endBehavior,proto3,enum=dymensionxyz.dymension.gamm.v1beta1.LiquidityBootstrappingEndBehavior" json:"end_behavior,omitempty" yaml:"end_behavior"`
+	FuturePoolGovernor       string                            `protobuf:"bytes,7,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
+}
+
+func (m *MsgCreateLiquidityBootstrappingPool) Reset()         { *m = MsgCreateLiquidityBootstrappingPool{} }
+func (m *MsgCreateLiquidityBootstrappingPool) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateLiquidityBootstrappingPool) ProtoMessage()    {}
+func (*MsgCreateLiquidityBootstrappingPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6108fa96f1dd2b9a, []int{8}
+}
+func (m *MsgCreateLiquidityBootstrappingPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateLiquidityBootstrappingPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateLiquidityBootstrappingPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateLiquidityBootstrappingPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLiquidityBootstrappingPool.Merge(m, src)
+}
+func (m *MsgCreateLiquidityBootstrappingPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateLiquidityBootstrappingPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLiquidityBootstrappingPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateLiquidityBootstrappingPool proto.InternalMessageInfo
+
+func (m *MsgCreateLiquidityBootstrappingPool) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCreateLiquidityBootstrappingPool) GetPoolAssets() []PoolAsset {
+	if m != nil {
+		return m.PoolAssets
+	}
+	return nil
+}
+
+func (m *MsgCreateLiquidityBootstrappingPool) GetSmoothWeightChangeParams() SmoothWeightChangeParams {
+	if m != nil {
+		return m.SmoothWeightChangeParams
+	}
+	return SmoothWeightChangeParams{}
+}
+
+func (m *MsgCreateLiquidityBootstrappingPool) GetEndBehavior() LiquidityBootstrappingEndBehavior {
+	if m != nil {
+		return m.EndBehavior
+	}
+	return LBP_END_FREEZE_WEIGHTS
+}
+
+func (m *MsgCreateLiquidityBootstrappingPool) GetFuturePoolGovernor() string {
+	if m != nil {
+		return m.FuturePoolGovernor
+	}
+	return ""
+}
+
+// Returns the poolID
+type MsgCreateLiquidityBootstrappingPoolResponse struct {
+	PoolID uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *MsgCreateLiquidityBootstrappingPoolResponse) Reset() {
+	*m = MsgCreateLiquidityBootstrappingPoolResponse{}
+}
+func (m *MsgCreateLiquidityBootstrappingPoolResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgCreateLiquidityBootstrappingPoolResponse) ProtoMessage() {}
+func (*MsgCreateLiquidityBootstrappingPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6108fa96f1dd2b9a, []int{9}
+}
+func (m *MsgCreateLiquidityBootstrappingPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateLiquidityBootstrappingPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateLiquidityBootstrappingPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateLiquidityBootstrappingPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLiquidityBootstrappingPoolResponse.Merge(m, src)
+}
+func (m *MsgCreateLiquidityBootstrappingPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateLiquidityBootstrappingPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLiquidityBootstrappingPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateLiquidityBootstrappingPoolResponse proto.InternalMessageInfo
+
+func (m *MsgCreateLiquidityBootstrappingPoolResponse) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateBalancerPool)(nil), "dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPool")
 	proto.RegisterType((*MsgCreateBalancerPoolResponse)(nil), "dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPoolResponse")
+	proto.RegisterType((*MsgScheduleWeightChange)(nil), "dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.MsgScheduleWeightChange")
+	proto.RegisterType((*MsgScheduleWeightChangeResponse)(nil), "dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.MsgScheduleWeightChangeResponse")
+	proto.RegisterType((*MsgSetPoolFees)(nil), "dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.MsgSetPoolFees")
+	proto.RegisterType((*MsgSetPoolFeesResponse)(nil), "dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.MsgSetPoolFeesResponse")
+	proto.RegisterType((*MsgSetSwapsPaused)(nil), "dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.MsgSetSwapsPaused")
+	proto.RegisterType((*MsgSetSwapsPausedResponse)(nil), "dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.MsgSetSwapsPausedResponse")
+	proto.RegisterType((*MsgCreateLiquidityBootstrappingPool)(nil), "dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.MsgCreateLiquidityBootstrappingPool")
+	proto.RegisterType((*MsgCreateLiquidityBootstrappingPoolResponse)(nil), "dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.MsgCreateLiquidityBootstrappingPoolResponse")
 }
 
 func init() {
@@ -153,36 +588,63 @@ func init() {
 }
 
 var fileDescriptor_6108fa96f1dd2b9a = []byte{
-	// 460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x9b, 0x6d, 0xa9, 0x38, 0xc5, 0x83, 0xc3, 0x2a, 0xa5, 0x62, 0x52, 0xe2, 0xa5, 0x1e,
-	0x76, 0x86, 0x56, 0x54, 0xf0, 0xa2, 0xc6, 0x05, 0xd9, 0xc3, 0xca, 0x1a, 0x50, 0xd0, 0xcb, 0x32,
-	0x69, 0xc6, 0x6c, 0x20, 0xc9, 0x0b, 0x79, 0x69, 0x69, 0xfd, 0x14, 0x7e, 0x20, 0x8f, 0x1e, 0xf6,
-	0xb8, 0x27, 0xf1, 0x14, 0xa4, 0xfd, 0x06, 0xfd, 0x04, 0x32, 0x93, 0xa4, 0x5b, 0x24, 0x14, 0x91,
-	0xbd, 0x65, 0xde, 0xbc, 0xf7, 0xfb, 0xff, 0xdf, 0xcb, 0x1b, 0xf2, 0xca, 0x5f, 0xc6, 0x32, 0xc1,
-	0x10, 0x92, 0xc5, 0xf2, 0x2b, 0xdf, 0x1e, 0x78, 0x20, 0xe2, 0x98, 0xa7, 0x00, 0x51, 0x0c, 0xbe,
-	0x8c, 0x90, 0x7b, 0x22, 0x12, 0xc9, 0x54, 0x66, 0x7c, 0x3e, 0xf6, 0x64, 0x2e, 0xc6, 0x3c, 0x5f,
-	0xb0, 0x34, 0x83, 0x1c, 0xe8, 0xf3, 0x5d, 0x02, 0xdb, 0x1e, 0x98, 0x22, 0xb0, 0x6b, 0x02, 0xab,
-	0x09, 0xac, 0x22, 0x0c, 0x0e, 0x03, 0x08, 0x40, 0x33, 0xb8, 0xfa, 0x2a, 0x71, 0x83, 0x67, 0xfb,
-	0x0c, 0xd5, 0xe2, 0x35, 0xeb, 0x0c, 0x20, 0xaa, 0xea, 0xcc, 0x29, 0x60, 0x0c, 0xca, 0x28, 0xca,
-	0x6d, 0xde, 0x14, 0xc2, 0xa4, 0xbc, 0xb7, 0x7f, 0x1e, 0x90, 0x7b, 0xa7, 0x18, 0xbc, 0xc9, 0xa4,
-	0xc8, 0xa5, 0xb3, 0x53, 0x4f, 0x1f, 0x93, 0x2e, 0xca, 0xc4, 0x97, 0x59, 0xdf, 0x18, 0x1a, 0xa3,
-	0xdb, 0xce, 0xdd, 0x4d, 0x61, 0xdd, 0x59, 0x8a, 0x38, 0x7a, 0x61, 0x97, 0x71, 0xdb, 0xad, 0x12,
-	0xe8, 0x05, 0xe9, 0xa9, 0x8e, 0xce, 0x53, 0x91, 0x89, 0x18, 0xfb, 0x07, 0x43, 0x63, 0xd4, 0x9b,
-	0x70, 0xb6, 0x6f, 0x02, 0x95, 0x15, 0xa6, 0xa4, 0xce, 0x74, 0x99, 0x73, 0x7f, 0x53, 0x58, 0xb4,
-	0x14, 0xd8, 0xa1, 0xd9, 0x2e, 0x49, 0xb7, 0x39, 0xf4, 0x43, 0xa5, 0x24, 0x10, 0x65, 0x8e, 0xfd,
-	0xf6, 0xb0, 0x3d, 0xea, 0x4d, 0xd8, 0x3f, 0x2b, 0xbd, 0x56, 0x65, 0x4e, 0xe7, 0xb2, 0xb0, 0x5a,
-	0x25, 0x56, 0x07, 0x90, 0xbe, 0x27, 0x87, 0x5f, 0x66, 0xf9, 0x2c, 0x93, 0xe7, 0x9a, 0x1e, 0xc0,
-	0x5c, 0x66, 0x09, 0x64, 0xfd, 0x8e, 0xee, 0xdc, 0xda, 0x14, 0xd6, 0x83, 0xd2, 0x58, 0x53, 0x96,
-	0xed, 0xd2, 0x32, 0xac, 0x14, 0xde, 0xd6, 0xc1, 0x63, 0xf2, 0xb0, 0x71, 0xae, 0xae, 0xc4, 0x14,
-	0x12, 0x94, 0xf4, 0x11, 0xb9, 0xa5, 0x31, 0xa1, 0xaf, 0x07, 0xdc, 0x71, 0xc8, 0xaa, 0xb0, 0xba,
-	0x2a, 0xe5, 0xe4, 0xd8, 0xed, 0xaa, 0xab, 0x13, 0x7f, 0xf2, 0xc3, 0x20, 0xed, 0x53, 0x0c, 0xe8,
-	0x77, 0x83, 0xd0, 0x86, 0x7f, 0xf4, 0x8e, 0xfd, 0xe7, 0x96, 0xb1, 0x46, 0x6f, 0x83, 0x8f, 0x37,
-	0xcb, 0xab, 0x7b, 0x75, 0x3e, 0x5d, 0xae, 0x4c, 0xe3, 0x6a, 0x65, 0x1a, 0xbf, 0x57, 0xa6, 0xf1,
-	0x6d, 0x6d, 0xb6, 0xae, 0xd6, 0x66, 0xeb, 0xd7, 0xda, 0x6c, 0x7d, 0x7e, 0x19, 0x84, 0xf9, 0xc5,
-	0xcc, 0x63, 0x53, 0x88, 0xb9, 0xde, 0xd4, 0x10, 0x8f, 0x22, 0xe1, 0x61, 0x7d, 0xe0, 0xf3, 0xf1,
-	0x53, 0xbe, 0xb8, 0x7e, 0x77, 0x47, 0x7f, 0x3d, 0x3c, 0xaf, 0xab, 0xf7, 0xf8, 0xc9, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x0b, 0xd2, 0x13, 0x1b, 0xb2, 0x03, 0x00, 0x00,
+	// 885 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcd, 0x8e, 0xdb, 0x54,
+	0x14, 0xc7, 0xc7, 0x49, 0x9a, 0xb4, 0x37, 0x30, 0xa8, 0x66, 0x68, 0x43, 0x46, 0xc4, 0xe1, 0x56,
+	0x42, 0x53, 0xd0, 0xd8, 0x9a, 0x20, 0x40, 0x42, 0x42, 0x50, 0x77, 0x48, 0x55, 0xc4, 0x40, 0xf0,
+	0x88, 0x4f, 0x55, 0x8a, 0x6e, 0xe2, 0x53, 0xc7, 0x22, 0xf6, 0x35, 0xbe, 0x4e, 0x26, 0x61, 0xc9,
+	0x03, 0x20, 0x16, 0x2c, 0xd9, 0xc3, 0x92, 0x07, 0x60, 0xc5, 0xaa, 0x3b, 0xba, 0x42, 0x88, 0x85,
+	0x85, 0x32, 0x52, 0x1f, 0x20, 0x4f, 0x80, 0xee, 0xf5, 0x47, 0xcc, 0x90, 0xb4, 0x99, 0xc6, 0x95,
+	0xba, 0x8a, 0x7d, 0x7d, 0xfc, 0xfb, 0x9f, 0xf3, 0x3f, 0xce, 0x3d, 0x36, 0x7a, 0xcf, 0x9c, 0x3a,
+	0xe0, 0x32, 0x9b, 0xba, 0x93, 0xe9, 0xb7, 0x5a, 0x7a, 0xa2, 0x59, 0xc4, 0x71, 0x34, 0x8f, 0xd2,
+	0xa1, 0x43, 0x4d, 0x18, 0x32, 0xad, 0x47, 0x86, 0xc4, 0xed, 0x83, 0xaf, 0x8d, 0x0f, 0x7a, 0x10,
+	0x90, 0x03, 0x2d, 0x98, 0xa8, 0x9e, 0x4f, 0x03, 0x2a, 0xbf, 0x95, 0x25, 0xa8, 0xe9, 0x89, 0xca,
+	0x09, 0xea, 0x82, 0xa0, 0x26, 0x04, 0x35, 0x26, 0xd4, 0x77, 0x2c, 0x6a, 0x51, 0xc1, 0xd0, 0xf8,
+	0x51, 0x84, 0xab, 0xbf, 0xf9, 0xb0, 0x84, 0x12, 0xf1, 0x84, 0xd5, 0xa1, 0x74, 0x18, 0xdf, 0xd7,
+	0xe8, 0x53, 0xe6, 0x50, 0x9e, 0x28, 0x83, 0x34, 0xae, 0x4f, 0x6d, 0x37, 0xba, 0x8e, 0xff, 0x2c,
+	0xa0, 0x17, 0x8e, 0x98, 0x75, 0xd3, 0x07, 0x12, 0x80, 0x9e, 0xb9, 0x5f, 0xbe, 0x8e, 0xca, 0x0c,
+	0x5c, 0x13, 0xfc, 0x9a, 0xd4, 0x94, 0xf6, 0x2e, 0xe9, 0x97, 0xe7, 0xa1, 0xf2, 0xec, 0x94, 0x38,
+	0xc3, 0xb7, 0x71, 0xb4, 0x8e, 0x8d, 0x38, 0x40, 0x1e, 0xa0, 0x2a, 0xaf, 0xa8, 0xeb, 0x11, 0x9f,
+	0x38, 0xac, 0x56, 0x68, 0x4a, 0x7b, 0xd5, 0x96, 0xa6, 0x3e, 0xcc, 0x81, 0x38, 0x15, 0x95, 0x4b,
+	0x75, 0xc4, 0x6d, 0xfa, 0x95, 0x79, 0xa8, 0xc8, 0x91, 0x40, 0x86, 0x86, 0x0d, 0xe4, 0xa5, 0x31,
+	0xf2, 0xa7, 0xb1, 0x12, 0x61, 0x0c, 0x02, 0x56, 0x2b, 0x36, 0x8b, 0x7b, 0xd5, 0x96, 0xba, 0xb6,
+	0xd2, 0x0d, 0x7e, 0x9b, 0x5e, 0xba, 0x17, 0x2a, 0x5b, 0x11, 0x56, 0x2c, 0x30, 0xf9, 0x13, 0xb4,
+	0x73, 0x77, 0x14, 0x8c, 0x7c, 0xe8, 0x0a, 0xba, 0x45, 0xc7, 0xe0, 0xbb, 0xd4, 0xaf, 0x95, 0x44,
+	0xe5, 0xca, 0x3c, 0x54, 0x76, 0xa3, 0xc4, 0x96, 0x45, 0x61, 0x43, 0x8e, 0x96, 0xb9, 0xc2, 0xad,
+	0x64, 0xf1, 0x10, 0xbd, 0xb4, 0xd4, 0x57, 0x03, 0x98, 0x47, 0x5d, 0x06, 0xf2, 0x35, 0x54, 0x11,
+	0x18, 0xdb, 0x14, 0x06, 0x97, 0x74, 0x34, 0x0b, 0x95, 0x32, 0x0f, 0xb9, 0x7d, 0x68, 0x94, 0xf9,
+	0xa5, 0xdb, 0x26, 0xfe, 0xb1, 0x80, 0xae, 0x1e, 0x31, 0xeb, 0xb8, 0x3f, 0x00, 0x73, 0x34, 0x84,
+	0xcf, 0xc1, 0xb6, 0x06, 0xc1, 0xcd, 0x01, 0x71, 0x2d, 0x38, 0x4f, 0x83, 0x32, 0x5a, 0x85, 0x55,
+	0x5a, 0xf2, 0x2f, 0x12, 0xda, 0x65, 0x0e, 0xa5, 0xc1, 0xa0, 0x7b, 0x22, 0x74, 0xba, 0x7d, 0x21,
+	0x94, 0xb4, 0xb5, 0x28, 0xda, 0xfa, 0xce, 0x5a, 0x66, 0x1f, 0x0b, 0x4e, 0x36, 0xdd, 0xb8, 0xc9,
+	0xaf, 0x72, 0xef, 0xe7, 0xa1, 0x82, 0xe3, 0x44, 0x57, 0xeb, 0x61, 0xa3, 0xc6, 0x56, 0x50, 0xf0,
+	0xcb, 0x48, 0x59, 0xe1, 0x4a, 0x62, 0x2f, 0xfe, 0xa9, 0x80, 0xb6, 0x79, 0x0c, 0x04, 0xbc, 0xcc,
+	0x36, 0x00, 0xcb, 0xdd, 0xb0, 0x3b, 0xe8, 0x22, 0x3b, 0x21, 0x5e, 0xf7, 0x2e, 0x80, 0x30, 0xe7,
+	0x92, 0x7e, 0x83, 0x57, 0xf7, 0x77, 0xa8, 0xbc, 0x62, 0xd9, 0xc1, 0x60, 0xd4, 0x53, 0xfb, 0xd4,
+	0xd1, 0xe2, 0x3f, 0x60, 0xf4, 0xb3, 0xcf, 0xcc, 0xaf, 0xb5, 0x60, 0xea, 0x01, 0x53, 0x0f, 0xa1,
+	0x3f, 0x0f, 0x95, 0xe7, 0x62, 0xfd, 0x98, 0x83, 0x8d, 0x0a, 0x3f, 0x6c, 0x03, 0x70, 0x3a, 0x4c,
+	0xec, 0x40, 0xd0, 0x4b, 0x9b, 0xd1, 0x13, 0x0e, 0x36, 0x2a, 0xfc, 0xb0, 0x0d, 0x80, 0x6b, 0xe8,
+	0xca, 0x7f, 0xdd, 0x49, 0x8d, 0xfb, 0x5e, 0x42, 0x97, 0xa3, 0x4b, 0xc7, 0x27, 0xc4, 0x63, 0x1d,
+	0x32, 0x62, 0x60, 0xe6, 0xee, 0xdd, 0x75, 0x54, 0xf6, 0x04, 0x59, 0x38, 0x77, 0x31, 0xcb, 0x8b,
+	0xd6, 0xb1, 0x11, 0x07, 0xe0, 0x5d, 0xf4, 0xe2, 0xff, 0xf2, 0x49, 0xb3, 0x7d, 0x70, 0x01, 0x5d,
+	0x4b, 0xff, 0x67, 0x1f, 0xda, 0xdf, 0x8c, 0x6c, 0xd3, 0x0e, 0xa6, 0x3a, 0xa5, 0x01, 0x0b, 0x7c,
+	0xe2, 0x79, 0xb6, 0x6b, 0x9d, 0x77, 0x37, 0xcb, 0xb6, 0xb5, 0xf0, 0x44, 0xdb, 0x5a, 0xcc, 0xbb,
+	0xad, 0x67, 0xf7, 0xc7, 0x52, 0x4e, 0xfb, 0xe3, 0xa3, 0xb6, 0x86, 0x0b, 0x4f, 0xcd, 0xd6, 0x20,
+	0x7f, 0x27, 0xa1, 0x67, 0xc0, 0x35, 0xbb, 0x3d, 0x18, 0x90, 0xb1, 0x4d, 0xfd, 0x5a, 0xb9, 0x29,
+	0xed, 0x6d, 0xb7, 0xda, 0x6b, 0xe5, 0xb6, 0xfc, 0x01, 0x7a, 0xdf, 0x35, 0xf5, 0x98, 0xa6, 0x5f,
+	0x9d, 0x87, 0xca, 0xf3, 0xb1, 0xfd, 0x19, 0x15, 0x6c, 0x54, 0x61, 0x11, 0xb5, 0x72, 0x9e, 0x54,
+	0x1e, 0x7f, 0x9e, 0x18, 0xe8, 0xb5, 0x35, 0x9e, 0xf3, 0x73, 0x4d, 0x97, 0xd6, 0x1f, 0x15, 0x54,
+	0x3c, 0x62, 0x96, 0xfc, 0x9b, 0x84, 0xe4, 0x25, 0x6f, 0x00, 0x1f, 0xa9, 0x8f, 0xf9, 0x0e, 0xa3,
+	0x2e, 0x9d, 0x7c, 0xf5, 0xcf, 0xf2, 0xe5, 0xa5, 0xb5, 0xfe, 0x2e, 0xa1, 0x9d, 0xa5, 0x13, 0xb2,
+	0xb3, 0x89, 0xe0, 0x32, 0x62, 0xfd, 0x8b, 0xbc, 0x89, 0x69, 0x11, 0x3f, 0x4b, 0xa8, 0x9a, 0x1d,
+	0x56, 0xb7, 0x36, 0x52, 0x5a, 0x80, 0xea, 0x1f, 0xe7, 0x04, 0x4a, 0x33, 0xfd, 0x55, 0x42, 0xdb,
+	0x67, 0xa6, 0xc3, 0x07, 0x1b, 0x6a, 0x64, 0x58, 0x75, 0x23, 0x3f, 0x56, 0x9a, 0xf2, 0x03, 0x09,
+	0x35, 0x1f, 0x39, 0x22, 0xee, 0x6c, 0xfe, 0x78, 0xae, 0xa6, 0xd7, 0xcd, 0x27, 0x49, 0x4f, 0x0a,
+	0xd5, 0xbf, 0xbc, 0x37, 0x6b, 0x48, 0xf7, 0x67, 0x0d, 0xe9, 0x9f, 0x59, 0x43, 0xfa, 0xe1, 0xb4,
+	0xb1, 0x75, 0xff, 0xb4, 0xb1, 0xf5, 0xd7, 0x69, 0x63, 0xeb, 0xab, 0x77, 0x33, 0xd3, 0x45, 0x4c,
+	0x15, 0x9b, 0xed, 0x0f, 0x49, 0x8f, 0x25, 0x27, 0xda, 0xf8, 0xe0, 0x0d, 0x6d, 0xb2, 0xf8, 0xc0,
+	0xd9, 0x3f, 0xf3, 0x85, 0xd3, 0x2b, 0x8b, 0x0f, 0x86, 0xd7, 0xff, 0x1d, 0x00, 0x42, 0x54, 0xeb,
+	0x3e, 0x1b, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -198,6 +660,10 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	CreateBalancerPool(ctx context.Context, in *MsgCreateBalancerPool, opts ...grpc.CallOption) (*MsgCreateBalancerPoolResponse, error)
+	ScheduleWeightChange(ctx context.Context, in *MsgScheduleWeightChange, opts ...grpc.CallOption) (*MsgScheduleWeightChangeResponse, error)
+	SetPoolFees(ctx context.Context, in *MsgSetPoolFees, opts ...grpc.CallOption) (*MsgSetPoolFeesResponse, error)
+	SetSwapsPaused(ctx context.Context, in *MsgSetSwapsPaused, opts ...grpc.CallOption) (*MsgSetSwapsPausedResponse, error)
+	CreateLiquidityBootstrappingPool(ctx context.Context, in *MsgCreateLiquidityBootstrappingPool, opts ...grpc.CallOption) (*MsgCreateLiquidityBootstrappingPoolResponse, error)
 }
 
 type msgClient struct {
@@ -217,18 +683,70 @@ func (c *msgClient) CreateBalancerPool(ctx context.Context, in *MsgCreateBalance
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	CreateBalancerPool(context.Context, *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error)
+func (c *msgClient) ScheduleWeightChange(ctx context.Context, in *MsgScheduleWeightChange, opts ...grpc.CallOption) (*MsgScheduleWeightChangeResponse, error) {
+	out := new(MsgScheduleWeightChangeResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.Msg/ScheduleWeightChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+func (c *msgClient) SetPoolFees(ctx context.Context, in *MsgSetPoolFees, opts ...grpc.CallOption) (*MsgSetPoolFeesResponse, error) {
+	out := new(MsgSetPoolFeesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.Msg/SetPoolFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSwapsPaused(ctx context.Context, in *MsgSetSwapsPaused, opts ...grpc.CallOption) (*MsgSetSwapsPausedResponse, error) {
+	out := new(MsgSetSwapsPausedResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.Msg/SetSwapsPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateLiquidityBootstrappingPool(ctx context.Context, in *MsgCreateLiquidityBootstrappingPool, opts ...grpc.CallOption) (*MsgCreateLiquidityBootstrappingPoolResponse, error) {
+	out := new(MsgCreateLiquidityBootstrappingPoolResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.Msg/CreateLiquidityBootstrappingPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateBalancerPool(context.Context, *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error)
+	ScheduleWeightChange(context.Context, *MsgScheduleWeightChange) (*MsgScheduleWeightChangeResponse, error)
+	SetPoolFees(context.Context, *MsgSetPoolFees) (*MsgSetPoolFeesResponse, error)
+	SetSwapsPaused(context.Context, *MsgSetSwapsPaused) (*MsgSetSwapsPausedResponse, error)
+	CreateLiquidityBootstrappingPool(context.Context, *MsgCreateLiquidityBootstrappingPool) (*MsgCreateLiquidityBootstrappingPoolResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
 
 func (*UnimplementedMsgServer) CreateBalancerPool(ctx context.Context, req *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBalancerPool not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleWeightChange(ctx context.Context, req *MsgScheduleWeightChange) (*MsgScheduleWeightChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleWeightChange not implemented")
+}
+func (*UnimplementedMsgServer) SetPoolFees(ctx context.Context, req *MsgSetPoolFees) (*MsgSetPoolFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPoolFees not implemented")
+}
+func (*UnimplementedMsgServer) SetSwapsPaused(ctx context.Context, req *MsgSetSwapsPaused) (*MsgSetSwapsPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSwapsPaused not implemented")
+}
+func (*UnimplementedMsgServer) CreateLiquidityBootstrappingPool(ctx context.Context, req *MsgCreateLiquidityBootstrappingPool) (*MsgCreateLiquidityBootstrappingPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLiquidityBootstrappingPool not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -252,6 +770,78 @@ func _Msg_CreateBalancerPool_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleWeightChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleWeightChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleWeightChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.Msg/ScheduleWeightChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleWeightChange(ctx, req.(*MsgScheduleWeightChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPoolFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPoolFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPoolFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.Msg/SetPoolFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPoolFees(ctx, req.(*MsgSetPoolFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSwapsPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSwapsPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSwapsPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.Msg/SetSwapsPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSwapsPaused(ctx, req.(*MsgSetSwapsPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateLiquidityBootstrappingPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateLiquidityBootstrappingPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateLiquidityBootstrappingPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.Msg/CreateLiquidityBootstrappingPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateLiquidityBootstrappingPool(ctx, req.(*MsgCreateLiquidityBootstrappingPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.gamm.poolmodels.balancer.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -260,6 +850,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBalancerPool",
 			Handler:    _Msg_CreateBalancerPool_Handler,
 		},
+		{
+			MethodName: "ScheduleWeightChange",
+			Handler:    _Msg_ScheduleWeightChange_Handler,
+		},
+		{
+			MethodName: "SetPoolFees",
+			Handler:    _Msg_SetPoolFees_Handler,
+		},
+		{
+			MethodName: "SetSwapsPaused",
+			Handler:    _Msg_SetSwapsPaused_Handler,
+		},
+		{
+			MethodName: "CreateLiquidityBootstrappingPool",
+			Handler:    _Msg_CreateLiquidityBootstrappingPool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/gamm/poolmodels/balancer/v1beta1/tx.proto",
@@ -299,120 +905,1403 @@ func (m *MsgCreateBalancerPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolParams != nil {
+		{
+			size, err := m.PoolParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBalancerPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBalancerPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBalancerPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleWeightChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleWeightChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleWeightChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleWeightChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleWeightChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleWeightChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPoolFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPoolFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPoolFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExitFee.Size()
+		i -= size
+		if _, err := m.ExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPoolFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPoolFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPoolFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSwapsPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSwapsPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSwapsPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSwapsPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSwapsPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSwapsPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLiquidityBootstrappingPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLiquidityBootstrappingPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLiquidityBootstrappingPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FuturePoolGovernor) > 0 {
+		i -= len(m.FuturePoolGovernor)
+		copy(dAtA[i:], m.FuturePoolGovernor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FuturePoolGovernor)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EndBehavior != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndBehavior))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PoolAssets) > 0 {
+		for iNdEx := len(m.PoolAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.ExitFee.Size()
+		i -= size
+		if _, err := m.ExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLiquidityBootstrappingPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLiquidityBootstrappingPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLiquidityBootstrappingPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateBalancerPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolParams != nil {
+		l = m.PoolParams.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PoolAssets) > 0 {
+		for _, e := range m.PoolAssets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.FuturePoolGovernor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateBalancerPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	return n
+}
+
+func (m *MsgScheduleWeightChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = m.SmoothWeightChangeParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleWeightChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPoolFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetPoolFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSwapsPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetSwapsPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateLiquidityBootstrappingPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.PoolAssets) > 0 {
+		for _, e := range m.PoolAssets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.SmoothWeightChangeParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.EndBehavior != 0 {
+		n += 1 + sovTx(uint64(m.EndBehavior))
+	}
+	l = len(m.FuturePoolGovernor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateLiquidityBootstrappingPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBalancerPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBalancerPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PoolParams == nil {
+				m.PoolParams = &PoolParams{}
+			}
+			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAssets = append(m.PoolAssets, PoolAsset{})
+			if err := m.PoolAssets[len(m.PoolAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuturePoolGovernor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FuturePoolGovernor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBalancerPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBalancerPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleWeightChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleWeightChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleWeightChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SmoothWeightChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleWeightChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleWeightChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleWeightChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPoolFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPoolFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPoolFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPoolFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPoolFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPoolFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSwapsPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSwapsPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSwapsPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.PoolParams != nil {
-		{
-			size, err := m.PoolParams.MarshalToSizedBuffer(dAtA[:i])
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCreateBalancerPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateBalancerPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateBalancerPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
-		i--
-		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgCreateBalancerPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolParams != nil {
-		l = m.PoolParams.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.PoolAssets) > 0 {
-		for _, e := range m.PoolAssets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetSwapsPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSwapsPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSwapsPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.FuturePoolGovernor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgCreateBalancerPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovTx(uint64(m.PoolID))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateLiquidityBootstrappingPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -435,10 +2324,10 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBalancerPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateLiquidityBootstrappingPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBalancerPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateLiquidityBootstrappingPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -475,9 +2364,9 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -487,29 +2376,61 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PoolParams == nil {
-				m.PoolParams = &PoolParams{}
-			}
-			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssets", wireType)
 			}
@@ -543,7 +2464,59 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SmoothWeightChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBehavior", wireType)
+			}
+			m.EndBehavior = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBehavior |= LiquidityBootstrappingEndBehavior(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FuturePoolGovernor", wireType)
 			}
@@ -596,7 +2569,7 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateLiquidityBootstrappingPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -619,10 +2592,10 @@ func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBalancerPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateLiquidityBootstrappingPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBalancerPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateLiquidityBootstrappingPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
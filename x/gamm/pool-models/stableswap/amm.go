@@ -0,0 +1,215 @@
+package stableswap
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+// newtonTolerance is the maximal difference between two consecutive Newton iterations
+// at which we consider the solution to have converged.
+var newtonTolerance = osmomath.NewDecWithPrec(1, 24)
+
+// calcAnn returns A * n^n, the amplification term used across the invariant equations.
+func calcAnn(amp uint64, n int) osmomath.BigDec {
+	ann := osmomath.NewBigDec(int64(amp))
+	for i := 0; i < n; i++ {
+		ann = ann.MulInt64(int64(n))
+	}
+	return ann
+}
+
+// calcDP returns D^(n+1) / (n^n * prod(x_i)), computed iteratively to keep intermediate values small.
+func calcDP(reserves []osmomath.BigDec, d osmomath.BigDec) osmomath.BigDec {
+	n := int64(len(reserves))
+	dP := d
+	for _, x := range reserves {
+		dP = dP.Mul(d).Quo(x.MulInt64(n))
+	}
+	return dP
+}
+
+// computeD solves the stableswap invariant for D given the scaled pool reserves,
+// using Newton's method:
+//
+//	D_{k+1} = (Ann * S + n * D_P) * D_k / ((Ann - 1) * D_k + (n + 1) * D_P)
+//
+// where S = sum(x_i), Ann = A * n^n and D_P = D_k^(n+1) / (n^n * prod(x_i)).
+func computeD(reserves []osmomath.BigDec, amp uint64) (osmomath.BigDec, error) {
+	n := len(reserves)
+	sum := osmomath.ZeroDec()
+	for _, x := range reserves {
+		if !x.IsPositive() {
+			return osmomath.BigDec{}, errors.New("stableswap reserves must be positive")
+		}
+		sum = sum.Add(x)
+	}
+
+	ann := calcAnn(amp, n)
+	d := sum
+	for i := 0; i < maxNewtonIterations; i++ {
+		dP := calcDP(reserves, d)
+		prev := d
+		numerator := ann.Mul(sum).Add(dP.MulInt64(int64(n))).Mul(d)
+		denominator := ann.Sub(osmomath.OneDec()).Mul(d).Add(dP.MulInt64(int64(n + 1)))
+		d = numerator.Quo(denominator)
+		if d.Sub(prev).Abs().LTE(newtonTolerance) {
+			return d, nil
+		}
+	}
+	return osmomath.BigDec{}, sdkerrors.Wrap(types.ErrInvalidMathApprox, "stableswap invariant did not converge")
+}
+
+// computeY solves the stableswap invariant for the reserve at index j, given D and all the other reserves,
+// using Newton's method:
+//
+//	y_{k+1} = (y_k^2 + c) / (2 * y_k + b - D)
+//
+// where c = D^(n+1) / (n^n * prod_{i != j}(x_i) * Ann) and b = sum_{i != j}(x_i) + D / Ann.
+func computeY(reserves []osmomath.BigDec, j int, amp uint64, d osmomath.BigDec) (osmomath.BigDec, error) {
+	n := len(reserves)
+	ann := calcAnn(amp, n)
+
+	c := d
+	sum := osmomath.ZeroDec()
+	for i, x := range reserves {
+		if i == j {
+			continue
+		}
+		if !x.IsPositive() {
+			return osmomath.BigDec{}, errors.New("stableswap reserves must be positive")
+		}
+		sum = sum.Add(x)
+		c = c.Mul(d).Quo(x.MulInt64(int64(n)))
+	}
+	c = c.Mul(d).Quo(ann.MulInt64(int64(n)))
+	b := sum.Add(d.Quo(ann))
+
+	y := d
+	for i := 0; i < maxNewtonIterations; i++ {
+		prev := y
+		denominator := y.MulInt64(2).Add(b).Sub(d)
+		if !denominator.IsPositive() {
+			return osmomath.BigDec{}, sdkerrors.Wrap(types.ErrInvalidMathApprox, "stableswap invariant has no positive solution")
+		}
+		y = y.Mul(y).Add(c).Quo(denominator)
+		if y.Sub(prev).Abs().LTE(newtonTolerance) {
+			return y, nil
+		}
+	}
+	return osmomath.BigDec{}, sdkerrors.Wrap(types.ErrInvalidMathApprox, "stableswap invariant did not converge")
+}
+
+// solveOutGivenIn returns the scaled amount of reserves[outIdx] that leaves the pool when
+// adding amountIn of reserves[inIdx], such that the invariant is preserved.
+func solveOutGivenIn(reserves []osmomath.BigDec, inIdx, outIdx int, amountIn osmomath.BigDec, amp uint64) (osmomath.BigDec, error) {
+	d, err := computeD(reserves, amp)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	updated := copyReserves(reserves)
+	updated[inIdx] = updated[inIdx].Add(amountIn)
+	y, err := computeY(updated, outIdx, amp, d)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	return reserves[outIdx].Sub(y), nil
+}
+
+// solveInGivenOut returns the scaled amount of reserves[inIdx] that must be added to the pool in order to
+// take amountOut of reserves[outIdx] out of it, such that the invariant is preserved.
+func solveInGivenOut(reserves []osmomath.BigDec, inIdx, outIdx int, amountOut osmomath.BigDec, amp uint64) (osmomath.BigDec, error) {
+	if amountOut.GTE(reserves[outIdx]) {
+		return osmomath.BigDec{}, types.ErrTooManyTokensOut
+	}
+
+	d, err := computeD(reserves, amp)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	updated := copyReserves(reserves)
+	updated[outIdx] = updated[outIdx].Sub(amountOut)
+	y, err := computeY(updated, inIdx, amp, d)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	return y.Sub(reserves[inIdx]), nil
+}
+
+// spotPrice returns the marginal price of reserves[baseIdx] in terms of reserves[quoteIdx], in scaled units.
+// It is obtained by implicit differentiation of the invariant F(x) = 0 while keeping D constant:
+//
+//	-dx_quote/dx_base = (Ann + D_P / x_base) / (Ann + D_P / x_quote)
+func spotPrice(reserves []osmomath.BigDec, quoteIdx, baseIdx int, amp uint64) (osmomath.BigDec, error) {
+	d, err := computeD(reserves, amp)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	ann := calcAnn(amp, len(reserves))
+	dP := calcDP(reserves, d)
+	numerator := ann.Add(dP.Quo(reserves[baseIdx]))
+	denominator := ann.Add(dP.Quo(reserves[quoteIdx]))
+	return numerator.Quo(denominator), nil
+}
+
+func copyReserves(reserves []osmomath.BigDec) []osmomath.BigDec {
+	res := make([]osmomath.BigDec, len(reserves))
+	for i, x := range reserves {
+		res[i] = x.Clone()
+	}
+	return res
+}
+
+// scaleAmount scales down a raw token amount by the asset's scaling factor.
+func scaleAmount(amount osmomath.BigDec, scalingFactor uint64) osmomath.BigDec {
+	return amount.MulInt64(types.ScalingFactorMultiplier).Quo(osmomath.NewDecFromBigInt(sdk.NewIntFromUint64(scalingFactor).BigInt()))
+}
+
+// descaleAmount scales up a scaled amount by the asset's scaling factor, back into raw token units.
+func descaleAmount(amount osmomath.BigDec, scalingFactor uint64) osmomath.BigDec {
+	return amount.Mul(osmomath.NewDecFromBigInt(sdk.NewIntFromUint64(scalingFactor).BigInt())).QuoInt64(types.ScalingFactorMultiplier)
+}
+
+// validateScaledAmounts ensures that the scaled pool reserves are within the supported bounds.
+func validateScaledAmounts(reserves []osmomath.BigDec) error {
+	maxScaled := osmomath.NewDecFromBigInt(types.StableswapMaxScaledAmtPerAsset.BigInt())
+	minScaled := osmomath.NewBigDec(types.StableswapMinScaledAmtPerAsset)
+	for _, x := range reserves {
+		if x.GT(maxScaled) {
+			return types.ErrHitMaxScaledAssets
+		}
+		if x.LT(minScaled) {
+			return types.ErrHitMinScaledAssets
+		}
+	}
+	return nil
+}
+
+// feeRatio returns the share of a single asset join that effectively gets swapped, and hence is charged the swap fee.
+// For a pool where the joined asset accounts for w of the scaled liquidity, this is 1 - swapFee * (1 - w).
+func feeRatio(reserves []osmomath.BigDec, idx int, swapFee sdk.Dec) sdk.Dec {
+	sum := osmomath.ZeroDec()
+	for _, x := range reserves {
+		sum = sum.Add(x)
+	}
+	weight := reserves[idx].Quo(sum).SDKDec()
+	return sdk.OneDec().Sub(swapFee.Mul(sdk.OneDec().Sub(weight)))
+}
+
+func bigDecFromInt(i sdk.Int) osmomath.BigDec {
+	return osmomath.NewDecFromBigInt(i.BigInt())
+}
+
+func formatDenomNotFound(denom string) string {
+	return fmt.Sprintf("denom %s does not exist in pool", denom)
+}
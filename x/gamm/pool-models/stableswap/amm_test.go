@@ -0,0 +1,198 @@
+package stableswap
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/internal/test_helpers"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+var (
+	defaultSwapFee        = sdk.NewDecWithPrec(1, 2)
+	defaultAmplification  = uint64(100)
+	twoEvenStablePoolLiq  = sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000_000), sdk.NewInt64Coin("foo", 1_000_000_000))
+	threeEvenStablePoolLq = sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 1_000_000_000),
+		sdk.NewInt64Coin("baz", 1_000_000_000),
+		sdk.NewInt64Coin("foo", 1_000_000_000),
+	)
+)
+
+func bigDecs(amounts ...int64) []osmomath.BigDec {
+	res := make([]osmomath.BigDec, len(amounts))
+	for i, amt := range amounts {
+		res[i] = osmomath.NewBigDec(amt)
+	}
+	return res
+}
+
+func TestComputeD(t *testing.T) {
+	tests := map[string]struct {
+		reserves []osmomath.BigDec
+		amp      uint64
+	}{
+		"balanced two asset pool":        {reserves: bigDecs(1000, 1000), amp: 100},
+		"balanced three asset pool":      {reserves: bigDecs(1000, 1000, 1000), amp: 100},
+		"imbalanced two asset pool":      {reserves: bigDecs(1000, 10), amp: 100},
+		"imbalanced pool, low amp":       {reserves: bigDecs(1_000_000, 1), amp: MinAmplification},
+		"imbalanced pool, max amp":       {reserves: bigDecs(1_000_000, 1000, 50), amp: MaxAmplification},
+		"large balanced four asset pool": {reserves: bigDecs(1e18, 1e18, 1e18, 1e18), amp: 1000},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			d, err := computeD(tc.reserves, tc.amp)
+			require.NoError(t, err)
+
+			sum := osmomath.ZeroDec()
+			for _, x := range tc.reserves {
+				sum = sum.Add(x)
+			}
+			// D is bounded above by the sum of the reserves, with equality for balanced pools.
+			require.True(t, d.LTE(sum.Add(newtonTolerance)), "D %s exceeds sum %s", d, sum)
+
+			// solving for any reserve given D must give back the reserve.
+			for j := range tc.reserves {
+				y, err := computeY(tc.reserves, j, tc.amp, d)
+				require.NoError(t, err)
+				errTolerance := osmomath.ErrTolerance{MultiplicativeTolerance: sdk.NewDecWithPrec(1, 12)}
+				require.Equal(t, 0, errTolerance.CompareBigDec(tc.reserves[j], y), "reserve %s, solved %s", tc.reserves[j], y)
+			}
+		})
+	}
+}
+
+func TestComputeD_BalancedPoolEqualsSum(t *testing.T) {
+	d, err := computeD(bigDecs(500, 500, 500), defaultAmplification)
+	require.NoError(t, err)
+	require.True(t, d.Sub(osmomath.NewBigDec(1500)).Abs().LTE(newtonTolerance))
+}
+
+func TestSolveOutGivenIn_AmplificationFlattensCurve(t *testing.T) {
+	reserves := bigDecs(1_000_000, 1_000_000)
+	amountIn := osmomath.NewBigDec(100_000)
+
+	var prevOut osmomath.BigDec
+	for i, amp := range []uint64{1, 10, 100, 1000} {
+		out, err := solveOutGivenIn(reserves, 0, 1, amountIn, amp)
+		require.NoError(t, err)
+		require.True(t, out.LT(amountIn))
+		if i > 0 {
+			require.True(t, out.GT(prevOut), "amp %d: out %s should exceed %s", amp, out, prevOut)
+		}
+		prevOut = out
+	}
+}
+
+func TestSolveInGivenOut(t *testing.T) {
+	reserves := bigDecs(1_000_000, 2_000_000)
+
+	_, err := solveInGivenOut(reserves, 0, 1, osmomath.NewBigDec(2_000_000), defaultAmplification)
+	require.ErrorIs(t, err, types.ErrTooManyTokensOut)
+
+	amountOut := osmomath.NewBigDec(1000)
+	in, err := solveInGivenOut(reserves, 0, 1, amountOut, defaultAmplification)
+	require.NoError(t, err)
+	out, err := solveOutGivenIn(reserves, 0, 1, in, defaultAmplification)
+	require.NoError(t, err)
+	require.True(t, out.Sub(amountOut).Abs().LT(osmomath.NewDecWithPrec(1, 12)))
+}
+
+func TestSpotPrice(t *testing.T) {
+	// balanced pool trades at one
+	sp, err := spotPrice(bigDecs(1000, 1000), 0, 1, defaultAmplification)
+	require.NoError(t, err)
+	require.True(t, sp.Sub(osmomath.OneDec()).Abs().LT(osmomath.NewDecWithPrec(1, 18)))
+
+	// the scarcer asset is more expensive
+	sp, err = spotPrice(bigDecs(2000, 1000), 0, 1, defaultAmplification)
+	require.NoError(t, err)
+	require.True(t, sp.GT(osmomath.OneDec()))
+
+	// spot price in both directions are reciprocal
+	spInverse, err := spotPrice(bigDecs(2000, 1000), 1, 0, defaultAmplification)
+	require.NoError(t, err)
+	require.True(t, sp.Mul(spInverse).Sub(osmomath.OneDec()).Abs().LT(osmomath.NewDecWithPrec(1, 18)))
+}
+
+func TestCalcOutAmtGivenIn_ScalingFactors(t *testing.T) {
+	// "bar" has 6 more decimals than "foo", so 10^6 bar is worth 1 foo.
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000_000_000_000), sdk.NewInt64Coin("foo", 1_000_000_000))
+	pool, err := NewStableswapPool(1, PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec()}, liquidity,
+		[]uint64{1_000_000, 1}, "", defaultAmplification, "")
+	require.NoError(t, err)
+
+	out, err := pool.CalcOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("foo", 1000)), "bar", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, "bar", out.Denom)
+	require.True(t, out.Amount.LTE(sdk.NewInt(1_000_000_000)))
+	require.True(t, out.Amount.GT(sdk.NewInt(999_000_000)))
+
+	sp, err := pool.SpotPrice(sdk.Context{}, "bar", "foo")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1_000_000).String(), sp.RoundInt().String())
+}
+
+func TestCalcOutAndIn_InverseRelationship(t *testing.T) {
+	tests := map[string]struct {
+		liquidity      sdk.Coins
+		scalingFactors []uint64
+		denomIn        string
+		denomOut       string
+	}{
+		"even two asset pool": {
+			liquidity:      twoEvenStablePoolLiq,
+			scalingFactors: []uint64{1, 1},
+			denomIn:        "foo",
+			denomOut:       "bar",
+		},
+		"even three asset pool": {
+			liquidity:      threeEvenStablePoolLq,
+			scalingFactors: []uint64{1, 1, 1},
+			denomIn:        "foo",
+			denomOut:       "baz",
+		},
+		"uneven two asset pool": {
+			liquidity:      sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000_000), sdk.NewInt64Coin("foo", 500_000_000)),
+			scalingFactors: []uint64{1, 1},
+			denomIn:        "bar",
+			denomOut:       "foo",
+		},
+		// the token in has the finer precision, so that rounding the token in up
+		// does not amount to a large relative change of the token out.
+		"pool with scaling factors": {
+			liquidity:      sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000_000_000), sdk.NewInt64Coin("foo", 1_000_000_000)),
+			scalingFactors: []uint64{1000, 1},
+			denomIn:        "bar",
+			denomOut:       "foo",
+		},
+	}
+
+	errTolerance := osmomath.ErrTolerance{AdditiveTolerance: sdk.OneDec(), MultiplicativeTolerance: sdk.NewDecWithPrec(1, 6)}
+	for name, tc := range tests {
+		for _, swapFee := range []sdk.Dec{sdk.ZeroDec(), defaultSwapFee} {
+			for _, initialCalcOut := range []int64{1, 100, 1_000_000} {
+				t.Run(name, func(t *testing.T) {
+					pool, err := NewStableswapPool(1, PoolParams{SwapFee: swapFee, ExitFee: sdk.ZeroDec()}, tc.liquidity,
+						tc.scalingFactors, "", defaultAmplification, "")
+					require.NoError(t, err)
+					test_helpers.TestCalculateAmountOutAndIn_InverseRelationship(t, sdk.Context{}, &pool, tc.denomIn, tc.denomOut, initialCalcOut, swapFee, errTolerance)
+				})
+			}
+		}
+	}
+}
+
+func TestSlippageRelationWithLiquidityIncrease(t *testing.T) {
+	createPool := func(ctx sdk.Context, liq sdk.Coins) types.CFMMPoolI {
+		pool, err := NewStableswapPool(1, PoolParams{SwapFee: defaultSwapFee, ExitFee: sdk.ZeroDec()}, liq,
+			nil, "", defaultAmplification, "")
+		require.NoError(t, err)
+		return &pool
+	}
+	test_helpers.TestSlippageRelationWithLiquidityIncrease("stableswap", t, sdk.Context{}, createPool, twoEvenStablePoolLiq)
+}
@@ -0,0 +1,119 @@
+package stableswap
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+var _ types.AmplifiedPoolExtension = &Pool{}
+
+// GetAmplificationRampParams returns the amplification ramp schedule of the pool,
+// or nil if the amplification is not changing.
+func (p Pool) GetAmplificationRampParams() *AmplificationRampParams {
+	return p.AmplificationRampParams
+}
+
+// PokePool updates the amplification coefficient of the pool to its value at blockTime,
+// according to the pool's amplification ramp schedule.
+func (p *Pool) PokePool(blockTime time.Time) {
+	if p.AmplificationRampParams == nil {
+		return
+	}
+
+	params := *p.AmplificationRampParams
+
+	// The amplification A(t) for the pool at time `t` is defined in one of three
+	// possible ways:
+	//
+	// 1. t <= start_time: A(t) = initial_amplification
+	//
+	// 2. start_time < t < end_time:
+	//     A(t) = initial_amplification + (t - start_time) *
+	//       (target_amplification - initial_amplification) / (end_time - start_time)
+	//
+	// 3. t >= end_time: A(t) = target_amplification
+	switch {
+	case !blockTime.After(params.StartTime):
+		// case 1: t <= start_time
+		p.Amplification = params.InitialAmplification
+
+	case !blockTime.Before(params.EndTime):
+		// case 3: t >= end_time
+		p.Amplification = params.TargetAmplification
+
+		// the ramp is over, so reset the schedule
+		p.AmplificationRampParams = nil
+
+	default:
+		// case 2: start_time < t < end_time
+		p.Amplification = params.amplificationAt(blockTime)
+	}
+}
+
+// SetAmplificationRamp schedules a linear change of the pool's amplification, from its
+// current value at blockTime to targetAmplification, between startTime and endTime.
+// If startTime is zero, the ramp starts at blockTime. Any ramp in progress is replaced.
+// It is expected that the pool has been poked at blockTime.
+func (p *Pool) SetAmplificationRamp(blockTime time.Time, targetAmplification uint64, startTime, endTime time.Time) error {
+	if startTime.IsZero() {
+		startTime = blockTime
+	}
+	if startTime.Before(blockTime) {
+		return sdkerrors.Wrapf(types.ErrInvalidAmplificationRamp, "start time %s is before block time %s", startTime, blockTime)
+	}
+
+	params := AmplificationRampParams{
+		StartTime:            startTime,
+		EndTime:              endTime,
+		InitialAmplification: p.Amplification,
+		TargetAmplification:  targetAmplification,
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	p.AmplificationRampParams = &params
+	return nil
+}
+
+// Validate ensures that the ramp lasts at least MinAmplificationRampDuration, that both ends of the ramp
+// are valid amplifications, and that the amplification changes at most by a factor of MaxAmplificationChange.
+func (params AmplificationRampParams) Validate() error {
+	if params.EndTime.Sub(params.StartTime) < MinAmplificationRampDuration {
+		return sdkerrors.Wrapf(types.ErrInvalidAmplificationRamp,
+			"ramp must last at least %s, got %s", MinAmplificationRampDuration, params.EndTime.Sub(params.StartTime))
+	}
+
+	if err := validateAmplification(params.InitialAmplification); err != nil {
+		return err
+	}
+	if err := validateAmplification(params.TargetAmplification); err != nil {
+		return err
+	}
+
+	initial, target := params.InitialAmplification, params.TargetAmplification
+	if target > initial*MaxAmplificationChange || initial > target*MaxAmplificationChange {
+		return sdkerrors.Wrap(types.ErrInvalidAmplificationRamp,
+			fmt.Sprintf("amplification can change by at most a factor of %d per ramp, got %d to %d", MaxAmplificationChange, initial, target))
+	}
+
+	return nil
+}
+
+// amplificationAt linearly interpolates the amplification between start and end time.
+// The result is truncated towards the initial amplification.
+func (params AmplificationRampParams) amplificationAt(blockTime time.Time) uint64 {
+	elapsed := sdk.NewInt(blockTime.Sub(params.StartTime).Milliseconds())
+	duration := sdk.NewInt(params.EndTime.Sub(params.StartTime).Milliseconds())
+
+	initial := sdk.NewIntFromUint64(params.InitialAmplification)
+	target := sdk.NewIntFromUint64(params.TargetAmplification)
+	delta := target.Sub(initial).Mul(elapsed).Quo(duration)
+
+	return initial.Add(delta).Uint64()
+}
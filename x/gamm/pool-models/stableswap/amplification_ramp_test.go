@@ -0,0 +1,191 @@
+package stableswap
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+func TestPokePool_AmplificationRamp(t *testing.T) {
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+	endTime := startTime.Add(100 * time.Hour)
+
+	tests := map[string]struct {
+		initial        uint64
+		target         uint64
+		blockTime      time.Time
+		expectedAmp    uint64
+		expectRampDone bool
+	}{
+		"before start": {
+			initial:     100,
+			target:      200,
+			blockTime:   startTime.Add(-time.Hour),
+			expectedAmp: 100,
+		},
+		"at start": {
+			initial:     100,
+			target:      200,
+			blockTime:   startTime,
+			expectedAmp: 100,
+		},
+		"ramp up, a quarter of the way": {
+			initial:     100,
+			target:      200,
+			blockTime:   startTime.Add(25 * time.Hour),
+			expectedAmp: 125,
+		},
+		"ramp down, halfway": {
+			initial:     1000,
+			target:      200,
+			blockTime:   startTime.Add(50 * time.Hour),
+			expectedAmp: 600,
+		},
+		"ramp up truncates towards initial": {
+			initial:     100,
+			target:      101,
+			blockTime:   endTime.Add(-time.Millisecond),
+			expectedAmp: 100,
+		},
+		"ramp down truncates towards initial": {
+			initial:     101,
+			target:      100,
+			blockTime:   endTime.Add(-time.Millisecond),
+			expectedAmp: 101,
+		},
+		"at end": {
+			initial:        100,
+			target:         200,
+			blockTime:      endTime,
+			expectedAmp:    200,
+			expectRampDone: true,
+		},
+		"after end": {
+			initial:        100,
+			target:         200,
+			blockTime:      endTime.Add(time.Hour),
+			expectedAmp:    200,
+			expectRampDone: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool, err := NewStableswapPool(1, defaultPoolParams, twoEvenStablePoolLiq, nil, "", tc.initial, "")
+			require.NoError(t, err)
+			err = pool.SetAmplificationRamp(startTime, tc.target, startTime, endTime)
+			require.NoError(t, err)
+
+			pool.PokePool(tc.blockTime)
+
+			require.Equal(t, tc.expectedAmp, pool.GetAmplification())
+			if tc.expectRampDone {
+				require.Nil(t, pool.GetAmplificationRampParams())
+			} else {
+				require.NotNil(t, pool.GetAmplificationRampParams())
+			}
+		})
+	}
+}
+
+func TestSetAmplificationRamp(t *testing.T) {
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+
+	tests := map[string]struct {
+		target    uint64
+		startTime time.Time
+		endTime   time.Time
+		expectErr error
+	}{
+		"valid ramp": {
+			target:    500,
+			startTime: blockTime.Add(time.Hour),
+			endTime:   blockTime.Add(48 * time.Hour),
+		},
+		"start time defaults to block time": {
+			target:  1000,
+			endTime: blockTime.Add(MinAmplificationRampDuration),
+		},
+		"start time before block time": {
+			target:    500,
+			startTime: blockTime.Add(-time.Second),
+			endTime:   blockTime.Add(48 * time.Hour),
+			expectErr: types.ErrInvalidAmplificationRamp,
+		},
+		"ramp too short": {
+			target:    500,
+			endTime:   blockTime.Add(MinAmplificationRampDuration - time.Second),
+			expectErr: types.ErrInvalidAmplificationRamp,
+		},
+		"end before start": {
+			target:    500,
+			startTime: blockTime.Add(48 * time.Hour),
+			endTime:   blockTime,
+			expectErr: types.ErrInvalidAmplificationRamp,
+		},
+		"increase too large": {
+			target:    1001,
+			endTime:   blockTime.Add(48 * time.Hour),
+			expectErr: types.ErrInvalidAmplificationRamp,
+		},
+		"decrease too large": {
+			target:    9,
+			endTime:   blockTime.Add(48 * time.Hour),
+			expectErr: types.ErrInvalidAmplificationRamp,
+		},
+		"target above max amplification": {
+			target:    MaxAmplification + 1,
+			endTime:   blockTime.Add(48 * time.Hour),
+			expectErr: types.ErrInvalidPool,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool, err := NewStableswapPool(1, defaultPoolParams, twoEvenStablePoolLiq, nil, "", defaultAmplification, "")
+			require.NoError(t, err)
+
+			err = pool.SetAmplificationRamp(blockTime, tc.target, tc.startTime, tc.endTime)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				require.Nil(t, pool.GetAmplificationRampParams())
+				return
+			}
+			require.NoError(t, err)
+
+			rampParams := pool.GetAmplificationRampParams()
+			require.NotNil(t, rampParams)
+			require.Equal(t, defaultAmplification, rampParams.InitialAmplification)
+			require.Equal(t, tc.target, rampParams.TargetAmplification)
+			if tc.startTime.IsZero() {
+				require.Equal(t, blockTime, rampParams.StartTime)
+			}
+		})
+	}
+}
+
+func TestAmplificationRamp_ChangesSwapOutput(t *testing.T) {
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000_000), sdk.NewInt64Coin("foo", 500_000_000))
+	pool, err := NewStableswapPool(1, defaultPoolParams, liquidity, nil, "", 10, "")
+	require.NoError(t, err)
+	tokenIn := sdk.NewCoins(sdk.NewInt64Coin("foo", 10_000_000))
+
+	outBefore, err := pool.CalcOutAmtGivenIn(sdk.Context{}, tokenIn, "bar", defaultSwapFee)
+	require.NoError(t, err)
+
+	err = pool.SetAmplificationRamp(startTime, 100, startTime, startTime.Add(48*time.Hour))
+	require.NoError(t, err)
+	pool.PokePool(startTime.Add(48 * time.Hour))
+	require.Equal(t, uint64(100), pool.GetAmplification())
+
+	// a flatter curve keeps the price of the scarce asset closer to 1:1,
+	// so selling it gives less of the abundant asset
+	outAfter, err := pool.CalcOutAmtGivenIn(sdk.Context{}, tokenIn, "bar", defaultSwapFee)
+	require.NoError(t, err)
+	require.True(t, outAfter.Amount.LT(outBefore.Amount), "before %s, after %s", outBefore, outAfter)
+}
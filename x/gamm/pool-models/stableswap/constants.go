@@ -0,0 +1,25 @@
+package stableswap
+
+import "time"
+
+var (
+	// MinAmplification is the minimal amplification coefficient a stableswap pool can be created with.
+	// An amplification of 1 makes the curve close to a constant product curve.
+	MinAmplification uint64 = 1
+	// MaxAmplification is the maximal amplification coefficient a stableswap pool can be created with.
+	// Higher values make the pool behave closer to a constant sum curve.
+	MaxAmplification uint64 = 1_000_000
+
+	// MinAmplificationRampDuration is the minimal duration of an amplification ramp,
+	// so that liquidity providers can react to the change of the curve.
+	MinAmplificationRampDuration = 24 * time.Hour
+	// MaxAmplificationChange is the maximal factor by which a single ramp can
+	// increase or decrease the amplification coefficient.
+	MaxAmplificationChange uint64 = 10
+
+	// maxNewtonIterations bounds the number of iterations done when solving the invariant,
+	// so that every swap has a bounded computational cost.
+	maxNewtonIterations = 255
+
+	PoolTypeName string = "Stableswap"
+)
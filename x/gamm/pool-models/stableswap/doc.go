@@ -0,0 +1,13 @@
+/*
+Package stableswap implements Curve-style stableswap AMMs, satisfying the AMM pool interface from
+x/gamm/types. The invariant is parameterized by an amplification coefficient, which controls how
+flat the curve is around the 1:1 price, and per-asset scaling factors, which normalize assets of
+different precisions before the invariant is applied.
+
+For n assets with scaled reserves x_i, the invariant D satisfies:
+
+	A * n^n * sum(x_i) + D = A * D * n^n + D^(n+1) / (n^n * prod(x_i))
+
+Please refer to the Curve whitepaper for further details on the mathematical equations.
+*/
+package stableswap
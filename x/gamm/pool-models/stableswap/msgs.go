@@ -0,0 +1,248 @@
+package stableswap
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+const (
+	TypeMsgCreateStableswapPool           = "create_stableswap_pool"
+	TypeMsgStableSwapAdjustScalingFactors = "stable_swap_adjust_scaling_factors"
+	TypeMsgStableSwapRampAmplification    = "stable_swap_ramp_amplification"
+)
+
+var (
+	_ sdk.Msg                        = &MsgCreateStableswapPool{}
+	_ poolmanagertypes.CreatePoolMsg = &MsgCreateStableswapPool{}
+	_ sdk.Msg                        = &MsgStableSwapAdjustScalingFactors{}
+)
+
+func NewMsgCreateStableswapPool(
+	sender sdk.AccAddress,
+	poolParams PoolParams,
+	initialLiquidity sdk.Coins,
+	scalingFactors []uint64,
+	amplification uint64,
+	futurePoolGovernor string,
+) MsgCreateStableswapPool {
+	return MsgCreateStableswapPool{
+		Sender:               sender.String(),
+		PoolParams:           &poolParams,
+		InitialPoolLiquidity: initialLiquidity,
+		ScalingFactors:       scalingFactors,
+		Amplification:        amplification,
+		FuturePoolGovernor:   futurePoolGovernor,
+	}
+}
+
+func (msg MsgCreateStableswapPool) Route() string { return types.RouterKey }
+func (msg MsgCreateStableswapPool) Type() string  { return TypeMsgCreateStableswapPool }
+func (msg MsgCreateStableswapPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolParams == nil {
+		return sdkerrors.Wrap(types.ErrInvalidPool, "pool params must be set")
+	}
+	err = msg.PoolParams.Validate()
+	if err != nil {
+		return err
+	}
+
+	// validation for pool initial liquidity
+	// The initial pool liquidity must be sorted, so that the scaling factors
+	// match the liquidity order.
+	if !isSortedByDenom(msg.InitialPoolLiquidity) {
+		return types.UnsortedPoolLiqError{ActualLiquidity: msg.InitialPoolLiquidity}
+	}
+	if err := msg.InitialPoolLiquidity.Validate(); err != nil {
+		return err
+	}
+	if len(msg.InitialPoolLiquidity) < types.MinNumOfAssetsInPool {
+		return types.ErrTooFewPoolAssets
+	} else if len(msg.InitialPoolLiquidity) > types.MaxNumOfAssetsInPool {
+		return types.ErrTooManyPoolAssets
+	}
+
+	// validation for scaling factors
+	// The message's scaling factors must be empty or a valid set of scaling factors
+	if len(msg.ScalingFactors) != 0 {
+		if err = validateScalingFactors(msg.ScalingFactors, len(msg.InitialPoolLiquidity)); err != nil {
+			return err
+		}
+	}
+
+	if err = validateAmplification(msg.Amplification); err != nil {
+		return err
+	}
+
+	// validation for future owner
+	if err = types.ValidateFutureGovernor(msg.FuturePoolGovernor); err != nil {
+		return err
+	}
+
+	// validation for scaling factor controller
+	if msg.ScalingFactorController != "" {
+		if _, err = sdk.AccAddressFromBech32(msg.ScalingFactorController); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid scaling factor controller address (%s)", err)
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgCreateStableswapPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateStableswapPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+/// Implement the CreatePoolMsg interface
+
+func (msg MsgCreateStableswapPool) PoolCreator() sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return sender
+}
+
+func (msg MsgCreateStableswapPool) Validate(ctx sdk.Context) error {
+	return msg.ValidateBasic()
+}
+
+func (msg MsgCreateStableswapPool) InitialLiquidity() sdk.Coins {
+	return msg.InitialPoolLiquidity
+}
+
+func (msg MsgCreateStableswapPool) CreatePool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error) {
+	stableswapPool, err := NewStableswapPool(poolId, *msg.PoolParams, msg.InitialPoolLiquidity,
+		msg.ScalingFactors, msg.ScalingFactorController, msg.Amplification, msg.FuturePoolGovernor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stableswapPool, nil
+}
+
+func (msg MsgCreateStableswapPool) GetPoolType() poolmanagertypes.PoolType {
+	return poolmanagertypes.Stableswap
+}
+
+func NewMsgStableSwapAdjustScalingFactors(
+	sender string,
+	poolID uint64,
+	scalingFactors []uint64,
+) MsgStableSwapAdjustScalingFactors {
+	return MsgStableSwapAdjustScalingFactors{
+		Sender:         sender,
+		PoolID:         poolID,
+		ScalingFactors: scalingFactors,
+	}
+}
+
+func (msg MsgStableSwapAdjustScalingFactors) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgStableSwapAdjustScalingFactors) Type() string {
+	return TypeMsgStableSwapAdjustScalingFactors
+}
+
+func (msg MsgStableSwapAdjustScalingFactors) ValidateBasic() error {
+	if msg.Sender == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender must be set")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	for _, scalingFactor := range msg.ScalingFactors {
+		if scalingFactor == 0 {
+			return types.ErrInvalidScalingFactors
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgStableSwapAdjustScalingFactors) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapAdjustScalingFactors) GetSigners() []sdk.AccAddress {
+	scalingFactorGovernor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{scalingFactorGovernor}
+}
+
+var _ sdk.Msg = &MsgStableSwapRampAmplification{}
+
+func NewMsgStableSwapRampAmplification(
+	authority string,
+	poolID uint64,
+	targetAmplification uint64,
+	startTime time.Time,
+	endTime time.Time,
+) MsgStableSwapRampAmplification {
+	return MsgStableSwapRampAmplification{
+		Authority:           authority,
+		PoolID:              poolID,
+		TargetAmplification: targetAmplification,
+		StartTime:           startTime,
+		EndTime:             endTime,
+	}
+}
+
+func (msg MsgStableSwapRampAmplification) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgStableSwapRampAmplification) Type() string {
+	return TypeMsgStableSwapRampAmplification
+}
+
+func (msg MsgStableSwapRampAmplification) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	if err := validateAmplification(msg.TargetAmplification); err != nil {
+		return err
+	}
+
+	// the start time defaults to the block time, so the duration can only be checked if it is set
+	if !msg.StartTime.IsZero() && msg.EndTime.Sub(msg.StartTime) < MinAmplificationRampDuration {
+		return sdkerrors.Wrapf(types.ErrInvalidAmplificationRamp,
+			"ramp must last at least %s, got %s", MinAmplificationRampDuration, msg.EndTime.Sub(msg.StartTime))
+	}
+
+	return nil
+}
+
+func (msg MsgStableSwapRampAmplification) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapRampAmplification) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
@@ -0,0 +1,279 @@
+package stableswap_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	apptesting "github.com/osmosis-labs/osmosis/v15/testutils/apptesting"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+func TestMsgCreateStableswapPool_ValidateBasic(t *testing.T) {
+	apptesting.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+		msg := &stableswap.MsgCreateStableswapPool{
+			Sender: addr1,
+			PoolParams: &stableswap.PoolParams{
+				SwapFee: sdk.NewDecWithPrec(1, 2),
+				ExitFee: sdk.NewDecWithPrec(1, 2),
+			},
+			InitialPoolLiquidity: sdk.Coins{sdk.NewInt64Coin("bar", 100), sdk.NewInt64Coin("foo", 100)},
+			ScalingFactors:       []uint64{1, 1},
+			FuturePoolGovernor:   "",
+			Amplification:        100,
+		}
+
+		return after(*msg)
+	}
+
+	default_msg := createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, default_msg.Route(), types.RouterKey)
+	require.Equal(t, default_msg.Type(), "create_stableswap_pool")
+	require.Equal(t, default_msg.GetPoolType(), poolmanagertypes.Stableswap)
+	signers := default_msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        stableswap.MsgCreateStableswapPool
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "nil pool params",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.PoolParams = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative swap fee",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.PoolParams = &stableswap.PoolParams{
+					SwapFee: sdk.NewDecWithPrec(-1, 2),
+					ExitFee: sdk.NewDecWithPrec(1, 2),
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "unsorted initial liquidity",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.InitialPoolLiquidity = sdk.Coins{sdk.NewInt64Coin("foo", 100), sdk.NewInt64Coin("bar", 100)}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "single asset",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.InitialPoolLiquidity = sdk.Coins{sdk.NewInt64Coin("foo", 100)}
+				msg.ScalingFactors = []uint64{1}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero liquidity",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.InitialPoolLiquidity = sdk.Coins{sdk.NewInt64Coin("bar", 0), sdk.NewInt64Coin("foo", 100)}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty scaling factors",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.ScalingFactors = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "scaling factors length mismatch",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.ScalingFactors = []uint64{1, 1, 1}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero scaling factor",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.ScalingFactors = []uint64{0, 1}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amplification",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.Amplification = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "amplification too large",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.Amplification = stableswap.MaxAmplification + 1
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "valid scaling factor controller",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.ScalingFactorController = addr1
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid scaling factor controller",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.ScalingFactorController = "invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "valid governor: lptoken and lock",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.FuturePoolGovernor = "lptoken,1000h"
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid governor",
+			msg: createMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.FuturePoolGovernor = "lptoken,1000h,invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgStableSwapAdjustScalingFactors_ValidateBasic(t *testing.T) {
+	apptesting.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	tests := []struct {
+		name       string
+		msg        stableswap.MsgStableSwapAdjustScalingFactors
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        stableswap.NewMsgStableSwapAdjustScalingFactors(addr1, 1, []uint64{1, 100}),
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        stableswap.NewMsgStableSwapAdjustScalingFactors("invalid", 1, []uint64{1, 100}),
+			expectPass: false,
+		},
+		{
+			name:       "zero scaling factor",
+			msg:        stableswap.NewMsgStableSwapAdjustScalingFactors(addr1, 1, []uint64{0, 100}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgStableSwapRampAmplification_ValidateBasic(t *testing.T) {
+	apptesting.SetAddressPrefixes()
+	authority := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+
+	tests := []struct {
+		name       string
+		msg        stableswap.MsgStableSwapRampAmplification
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        stableswap.NewMsgStableSwapRampAmplification(authority, 1, 200, startTime, startTime.Add(48*time.Hour)),
+			expectPass: true,
+		},
+		{
+			name:       "no start time",
+			msg:        stableswap.NewMsgStableSwapRampAmplification(authority, 1, 200, time.Time{}, startTime),
+			expectPass: true,
+		},
+		{
+			name:       "invalid authority",
+			msg:        stableswap.NewMsgStableSwapRampAmplification("invalid", 1, 200, startTime, startTime.Add(48*time.Hour)),
+			expectPass: false,
+		},
+		{
+			name:       "zero target amplification",
+			msg:        stableswap.NewMsgStableSwapRampAmplification(authority, 1, 0, startTime, startTime.Add(48*time.Hour)),
+			expectPass: false,
+		},
+		{
+			name:       "ramp too short",
+			msg:        stableswap.NewMsgStableSwapRampAmplification(authority, 1, 200, startTime, startTime.Add(time.Hour)),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -0,0 +1,585 @@
+package stableswap
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/internal/cfmm_common"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+var (
+	_ poolmanagertypes.PoolI       = &Pool{}
+	_ types.CFMMPoolI              = &Pool{}
+	_ types.PoolAmountOutExtension = &Pool{}
+)
+
+// NewStableswapPool returns a stableswap pool
+// Invariants that are assumed to be satisfied and not checked:
+// * 2 <= len(initialLiquidity)
+// * FutureGovernor is valid
+// * poolID doesn't already exist
+func NewStableswapPool(poolId uint64,
+	stableswapPoolParams PoolParams, initialLiquidity sdk.Coins,
+	scalingFactors []uint64, scalingFactorController string,
+	amplification uint64, futureGovernor string,
+) (Pool, error) {
+	if len(scalingFactors) == 0 {
+		scalingFactors = make([]uint64, len(initialLiquidity))
+		for i := range scalingFactors {
+			scalingFactors[i] = 1
+		}
+	}
+
+	if err := validateScalingFactors(scalingFactors, len(initialLiquidity)); err != nil {
+		return Pool{}, err
+	}
+
+	if err := validateAmplification(amplification); err != nil {
+		return Pool{}, err
+	}
+
+	if err := stableswapPoolParams.Validate(); err != nil {
+		return Pool{}, err
+	}
+
+	pool := Pool{
+		Address:                 types.NewPoolAddress(poolId).String(),
+		Id:                      poolId,
+		PoolParams:              stableswapPoolParams,
+		TotalShares:             sdk.NewCoin(types.GetPoolShareDenom(poolId), sdk.NewIntFromBigInt(types.InitPoolSharesSupply.BigInt())),
+		PoolLiquidity:           initialLiquidity,
+		ScalingFactors:          scalingFactors,
+		ScalingFactorController: scalingFactorController,
+		Amplification:           amplification,
+		FuturePoolGovernor:      futureGovernor,
+	}
+
+	if err := validateScaledAmounts(pool.scaledReserves()); err != nil {
+		return Pool{}, err
+	}
+
+	return pool, nil
+}
+
+func (p Pool) String() string {
+	out, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+// GetAddress returns the address of a pool.
+// If the pool address is not bech32 valid, it panics.
+func (p Pool) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode address of pool with id: %d", p.GetId()))
+	}
+	return addr
+}
+
+func (p Pool) GetId() uint64 {
+	return p.Id
+}
+
+func (p Pool) GetSwapFee(_ sdk.Context) sdk.Dec {
+	return p.PoolParams.SwapFee
+}
+
+func (p Pool) GetExitFee(_ sdk.Context) sdk.Dec {
+	return p.PoolParams.ExitFee
+}
+
+func (p Pool) GetPoolParams() PoolParams {
+	return p.PoolParams
+}
+
+func (p Pool) IsActive(_ sdk.Context) bool {
+	return true
+}
+
+// GetTotalPoolLiquidity returns the coins in the pool owned by all LPs
+func (p Pool) GetTotalPoolLiquidity(_ sdk.Context) sdk.Coins {
+	return p.PoolLiquidity
+}
+
+// GetTotalShares returns the total number of LP shares in the pool
+func (p Pool) GetTotalShares() sdk.Int {
+	return p.TotalShares.Amount
+}
+
+// GetScalingFactors returns the scaling factors of the pool, in the same order as the pool liquidity.
+func (p Pool) GetScalingFactors() []uint64 {
+	return p.ScalingFactors
+}
+
+// GetScalingFactorByDenom returns the scaling factor of the provided denom.
+func (p Pool) GetScalingFactorByDenom(denom string) (uint64, error) {
+	idx, err := p.getDenomIndex(denom)
+	if err != nil {
+		return 0, err
+	}
+	return p.ScalingFactors[idx], nil
+}
+
+// GetAmplification returns the amplification coefficient of the pool.
+// If the amplification is ramping, this is the value as of the last PokePool call.
+func (p Pool) GetAmplification() uint64 {
+	return p.Amplification
+}
+
+func (p Pool) GetType() poolmanagertypes.PoolType {
+	return poolmanagertypes.Stableswap
+}
+
+// Copy returns a deep copy of the pool, safe to mutate without altering the original.
+func (p Pool) Copy() Pool {
+	p2 := p
+	p2.PoolLiquidity = sdk.NewCoins(p.PoolLiquidity...)
+	p2.ScalingFactors = append([]uint64(nil), p.ScalingFactors...)
+	p2.TotalShares = sdk.NewCoin(p.TotalShares.Denom, p.TotalShares.Amount)
+	if p.AmplificationRampParams != nil {
+		rampParams := *p.AmplificationRampParams
+		p2.AmplificationRampParams = &rampParams
+	}
+	return p2
+}
+
+// getDenomIndex returns the index of the denom in the pool liquidity (and scaling factors).
+func (p Pool) getDenomIndex(denom string) (int, error) {
+	for i, coin := range p.PoolLiquidity {
+		if coin.Denom == denom {
+			return i, nil
+		}
+	}
+	return -1, sdkerrors.Wrap(types.ErrDenomNotFoundInPool, formatDenomNotFound(denom))
+}
+
+// scaledReserves returns the pool liquidity scaled down by the scaling factors.
+func (p Pool) scaledReserves() []osmomath.BigDec {
+	reserves := make([]osmomath.BigDec, len(p.PoolLiquidity))
+	for i, coin := range p.PoolLiquidity {
+		reserves[i] = scaleAmount(bigDecFromInt(coin.Amount), p.ScalingFactors[i])
+	}
+	return reserves
+}
+
+// CalcOutAmtGivenIn calculates tokens to be swapped out given the provided
+// amount and fee deducted, by solving the stableswap invariant.
+func (p Pool) CalcOutAmtGivenIn(ctx sdk.Context, tokensIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (sdk.Coin, error) {
+	if tokensIn.Len() != 1 {
+		return sdk.Coin{}, errors.New("stableswap CalcOutAmtGivenIn: tokensIn is of wrong length")
+	}
+	tokenIn := tokensIn[0]
+
+	inIdx, err := p.getDenomIndex(tokenIn.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	outIdx, err := p.getDenomIndex(tokenOutDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if inIdx == outIdx {
+		return sdk.Coin{}, errors.New("cannot trade same denomination in and out")
+	}
+
+	// deduct swapfee on the tokensIn
+	tokenInAfterFee := bigDecFromInt(tokenIn.Amount).Mul(osmomath.BigDecFromSDKDec(sdk.OneDec().Sub(swapFee)))
+	scaledIn := scaleAmount(tokenInAfterFee, p.ScalingFactors[inIdx])
+
+	scaledOut, err := solveOutGivenIn(p.scaledReserves(), inIdx, outIdx, scaledIn, p.Amplification)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// We round down the token amount out, so that the pool never gives out more than the invariant allows.
+	tokenAmountOut := descaleAmount(scaledOut, p.ScalingFactors[outIdx]).TruncateInt()
+	tokenAmountOutInt := sdk.NewIntFromBigInt(tokenAmountOut.BigInt())
+	if !tokenAmountOutInt.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+	if tokenAmountOutInt.GTE(p.PoolLiquidity[outIdx].Amount) {
+		return sdk.Coin{}, types.ErrTooManyTokensOut
+	}
+
+	return sdk.NewCoin(tokenOutDenom, tokenAmountOutInt), nil
+}
+
+// SwapOutAmtGivenIn is a mutative method for CalcOutAmtGivenIn, which includes the actual swap.
+func (p *Pool) SwapOutAmtGivenIn(ctx sdk.Context, tokensIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	tokenOut, err = p.CalcOutAmtGivenIn(ctx, tokensIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := p.applySwap(tokensIn, sdk.Coins{tokenOut}); err != nil {
+		return sdk.Coin{}, err
+	}
+	return tokenOut, nil
+}
+
+// CalcInAmtGivenOut calculates token to be provided, fee added,
+// given the swapped out amount, by solving the stableswap invariant.
+func (p Pool) CalcInAmtGivenOut(ctx sdk.Context, tokensOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	if tokensOut.Len() != 1 {
+		return sdk.Coin{}, errors.New("stableswap CalcInAmtGivenOut: tokensOut is of wrong length")
+	}
+	tokenOut := tokensOut[0]
+
+	outIdx, err := p.getDenomIndex(tokenOut.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	inIdx, err := p.getDenomIndex(tokenInDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if inIdx == outIdx {
+		return sdk.Coin{}, errors.New("cannot trade same denomination in and out")
+	}
+
+	scaledOut := scaleAmount(bigDecFromInt(tokenOut.Amount), p.ScalingFactors[outIdx])
+	scaledIn, err := solveInGivenOut(p.scaledReserves(), inIdx, outIdx, scaledOut, p.Amplification)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// We deduct a swap fee on the input asset, so the invariant input must be divided by (1 - swapfee).
+	// We round up tokenInAmt, as this is whats charged for the swap, for the precise amount out.
+	tokenAmountInBeforeFee := descaleAmount(scaledIn, p.ScalingFactors[inIdx]).Quo(osmomath.BigDecFromSDKDec(sdk.OneDec().Sub(swapFee)))
+	tokenInAmt := sdk.NewIntFromBigInt(tokenAmountInBeforeFee.Ceil().TruncateInt().BigInt())
+	if !tokenInAmt.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+
+	return sdk.NewCoin(tokenInDenom, tokenInAmt), nil
+}
+
+// SwapInAmtGivenOut is a mutative method for CalcInAmtGivenOut, which includes the actual swap.
+func (p *Pool) SwapInAmtGivenOut(ctx sdk.Context, tokensOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	tokenIn, err = p.CalcInAmtGivenOut(ctx, tokensOut, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := p.applySwap(sdk.Coins{tokenIn}, tokensOut); err != nil {
+		return sdk.Coin{}, err
+	}
+	return tokenIn, nil
+}
+
+// applySwap updates the pool liquidity with the swapped coins. It does not read or write state,
+// so that it can be used when simulating swaps over a copy of the pool.
+func (p *Pool) applySwap(tokensIn sdk.Coins, tokensOut sdk.Coins) error {
+	newLiquidity := p.PoolLiquidity.Add(tokensIn...)
+	newLiquidity, hasNeg := newLiquidity.SafeSub(tokensOut...)
+	if hasNeg {
+		return types.ErrTooManyTokensOut
+	}
+	if len(newLiquidity) != len(p.PoolLiquidity) {
+		return types.ErrTooManyTokensOut
+	}
+
+	p.PoolLiquidity = newLiquidity
+	return nil
+}
+
+// SpotPrice returns the spot price of the pool: the amount of quote asset needed to buy
+// an infinitesimal amount of base asset, given the current pool liquidity.
+// For a balanced pool with equal scaling factors this is 1.
+func (p Pool) SpotPrice(ctx sdk.Context, quoteAssetDenom, baseAssetDenom string) (sdk.Dec, error) {
+	quoteIdx, err := p.getDenomIndex(quoteAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	baseIdx, err := p.getDenomIndex(baseAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	scaledSpotPrice, err := spotPrice(p.scaledReserves(), quoteIdx, baseIdx, p.Amplification)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// scaled prices are converted back to raw token units:
+	// price = scaled price * scaling factor quote / scaling factor base
+	spot := scaledSpotPrice.
+		Mul(scaleFactorDec(p.ScalingFactors[quoteIdx])).
+		Quo(scaleFactorDec(p.ScalingFactors[baseIdx]))
+	return spot.SDKDec(), nil
+}
+
+func scaleFactorDec(scalingFactor uint64) osmomath.BigDec {
+	return bigDecFromInt(sdk.NewIntFromUint64(scalingFactor))
+}
+
+func (p *Pool) updatePoolForJoin(tokensIn sdk.Coins, newShares sdk.Int) {
+	p.PoolLiquidity = p.PoolLiquidity.Add(tokensIn...)
+	p.TotalShares.Amount = p.TotalShares.Amount.Add(newShares)
+}
+
+// IncreaseLiquidity increases the pool's liquidity by the specified sharesOut and coinsIn.
+func (p *Pool) IncreaseLiquidity(sharesOut sdk.Int, coinsIn sdk.Coins) {
+	p.updatePoolForJoin(coinsIn, sharesOut)
+}
+
+// calcSingleAssetJoinShares returns the number of LP shares minted by a single asset join of tokenIn.
+// It binary searches the number of shares such that exiting them and swapping everything back
+// to tokenIn.Denom yields tokenIn (minus the swap fee on the swapped portion).
+func (p *Pool) calcSingleAssetJoinShares(tokenIn sdk.Coin, swapFee sdk.Dec) (sdk.Int, error) {
+	idx, err := p.getDenomIndex(tokenIn.Denom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	poolWithAddedLiquidityAndShares := func(newLiquidity sdk.Coin, newShares sdk.Int) types.CFMMPoolI {
+		paCopy := p.Copy()
+		paCopy.updatePoolForJoin(sdk.NewCoins(newLiquidity), newShares)
+		return &paCopy
+	}
+
+	tokenInAmtAfterFee := sdk.NewDecFromInt(tokenIn.Amount).Mul(feeRatio(p.scaledReserves(), idx, swapFee)).TruncateInt()
+	return cfmm_common.BinarySearchSingleAssetJoin(p, sdk.NewCoin(tokenIn.Denom, tokenInAmtAfterFee), poolWithAddedLiquidityAndShares)
+}
+
+// joinPoolSharesInternal joins tokensIn into a copy-safe pool representation, mutating p.
+// The input tokens must either be:
+// - a single token
+// - contain exactly the same tokens as the pool contains
+func (p *Pool) joinPoolSharesInternal(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, tokensJoined sdk.Coins, err error) {
+	if !tokensIn.DenomsSubsetOf(p.PoolLiquidity) {
+		return sdk.ZeroInt(), sdk.NewCoins(), errors.New("attempted joining pool with assets that do not exist in pool")
+	}
+
+	if tokensIn.Len() == 1 {
+		numShares, err = p.calcSingleAssetJoinShares(tokensIn[0], swapFee)
+		if err != nil {
+			return sdk.ZeroInt(), sdk.NewCoins(), err
+		}
+		p.updatePoolForJoin(tokensIn, numShares)
+	} else if tokensIn.Len() != len(p.PoolLiquidity) {
+		return sdk.ZeroInt(), sdk.NewCoins(), errors.New("stableswap pool only supports LP'ing with one asset or all assets in pool")
+	} else {
+		// first do as much of a join as we can with no swaps, then single asset join the remaining coins
+		numShares, remCoins, err := cfmm_common.MaximalExactRatioJoin(p, ctx, tokensIn)
+		if err != nil {
+			return sdk.ZeroInt(), sdk.NewCoins(), err
+		}
+		p.updatePoolForJoin(tokensIn.Sub(remCoins...), numShares)
+
+		for _, coin := range remCoins {
+			newShares, err := p.calcSingleAssetJoinShares(coin, swapFee)
+			if err != nil {
+				return sdk.ZeroInt(), sdk.NewCoins(), err
+			}
+			p.updatePoolForJoin(sdk.NewCoins(coin), newShares)
+			numShares = numShares.Add(newShares)
+		}
+
+		if err := validateScaledAmounts(p.scaledReserves()); err != nil {
+			return sdk.ZeroInt(), sdk.NewCoins(), err
+		}
+		return numShares, tokensIn, nil
+	}
+
+	if err := validateScaledAmounts(p.scaledReserves()); err != nil {
+		return sdk.ZeroInt(), sdk.NewCoins(), err
+	}
+	return numShares, tokensIn, nil
+}
+
+// JoinPool joins the pool using all of the tokensIn provided, swapping internally as needed.
+func (p *Pool) JoinPool(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, err error) {
+	numShares, _, err = p.joinPoolSharesInternal(ctx, tokensIn, swapFee)
+	return numShares, err
+}
+
+// JoinPoolNoSwap joins the pool with an all-asset join using the maximum amount possible given the tokensIn provided.
+func (p *Pool) JoinPoolNoSwap(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, err error) {
+	numShares, tokensJoined, err := p.CalcJoinPoolNoSwapShares(ctx, tokensIn, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	p.updatePoolForJoin(tokensJoined, numShares)
+	return numShares, nil
+}
+
+// CalcJoinPoolShares returns how many LP shares JoinPool would return on these arguments.
+// This does not mutate the pool, or state.
+func (p *Pool) CalcJoinPoolShares(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, newLiquidity sdk.Coins, err error) {
+	pCopy := p.Copy()
+	return pCopy.joinPoolSharesInternal(ctx, tokensIn, swapFee)
+}
+
+// CalcJoinPoolNoSwapShares returns how many LP shares JoinPoolNoSwap would return on these arguments.
+// The input tokens must contain the same tokens as in the pool.
+// This does not mutate the pool, or state.
+func (p *Pool) CalcJoinPoolNoSwapShares(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, tokensJoined sdk.Coins, err error) {
+	if tokensIn.Len() != len(p.PoolLiquidity) || !tokensIn.DenomsSubsetOf(p.PoolLiquidity) {
+		return sdk.ZeroInt(), sdk.NewCoins(), errors.New("no-swap joins require LP'ing with all assets in pool")
+	}
+
+	numShares, remainingTokensIn, err := cfmm_common.MaximalExactRatioJoin(p, ctx, tokensIn)
+	if err != nil {
+		return sdk.ZeroInt(), sdk.NewCoins(), err
+	}
+
+	tokensJoined = tokensIn.Sub(remainingTokensIn...)
+	if tokensJoined.IsAnyGT(tokensIn) {
+		return sdk.ZeroInt(), sdk.NewCoins(), errors.New("an error has occurred, more coins joined than token In")
+	}
+
+	return numShares, tokensJoined, nil
+}
+
+// ExitPool exits numShares LP shares from the pool, and returns the coins being returned.
+func (p *Pool) ExitPool(ctx sdk.Context, exitingShares sdk.Int, exitFee sdk.Dec) (exitingCoins sdk.Coins, err error) {
+	exitingCoins, err = p.CalcExitPoolCoinsFromShares(ctx, exitingShares, exitFee)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if err := p.exitPool(exitingCoins, exitingShares); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	return exitingCoins, nil
+}
+
+// exitPool exits the pool given exitingCoins and exitingShares.
+// updates the pool's liquidity and totalShares.
+func (p *Pool) exitPool(exitingCoins sdk.Coins, exitingShares sdk.Int) error {
+	newLiquidity, hasNeg := p.PoolLiquidity.SafeSub(exitingCoins...)
+	if hasNeg || len(newLiquidity) != len(p.PoolLiquidity) {
+		return types.ErrTooManyTokensOut
+	}
+
+	p.PoolLiquidity = newLiquidity
+	p.TotalShares.Amount = p.TotalShares.Amount.Sub(exitingShares)
+	return nil
+}
+
+// CalcExitPoolCoinsFromShares returns how many coins ExitPool would return on these arguments.
+// This does not mutate the pool, or state.
+func (p Pool) CalcExitPoolCoinsFromShares(ctx sdk.Context, exitingShares sdk.Int, exitFee sdk.Dec) (exitedCoins sdk.Coins, err error) {
+	return cfmm_common.CalcExitPool(ctx, &p, exitingShares, exitFee)
+}
+
+// CalcTokenInShareAmountOut returns the number of tokenInDenom tokens
+// that would be needed to join the pool for an exact number of shares (shareOutAmount).
+// This method does not mutate the pool.
+func (p *Pool) CalcTokenInShareAmountOut(ctx sdk.Context, tokenInDenom string, shareOutAmount sdk.Int, swapFee sdk.Dec) (tokenInAmount sdk.Int, err error) {
+	idx, err := p.getDenomIndex(tokenInDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	// The shares are worth shareOutAmount / (totalShares + shareOutAmount) of the pool liquidity.
+	// We value that liquidity in tokenInDenom by swapping all of it to tokenInDenom with no fee,
+	// and then charge the swap fee on the portion that is effectively swapped.
+	pCopy := p.Copy()
+	pCopy.TotalShares.Amount = pCopy.TotalShares.Amount.Add(shareOutAmount)
+	exitedCoins, err := cfmm_common.CalcExitPool(ctx, &pCopy, shareOutAmount, sdk.ZeroDec())
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	poolForSwap := p.Copy()
+	tokenInNoFee, err := cfmm_common.SwapAllCoinsToSingleAsset(&poolForSwap, ctx, exitedCoins, tokenInDenom, sdk.ZeroDec())
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	// We round up tokenInAmount, as this is whats charged for the join.
+	tokenInAmount = sdk.NewDecFromInt(tokenInNoFee).Quo(feeRatio(p.scaledReserves(), idx, swapFee)).Ceil().TruncateInt()
+	if !tokenInAmount.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrNotPositiveRequireAmount, "token amount must be positive, was %s", tokenInAmount)
+	}
+	return tokenInAmount, nil
+}
+
+// JoinPoolTokenInMaxShareAmountOut joins the pool with a single asset for an exact number of shares.
+func (p *Pool) JoinPoolTokenInMaxShareAmountOut(ctx sdk.Context, tokenInDenom string, shareOutAmount sdk.Int) (tokenInAmount sdk.Int, err error) {
+	tokenInAmount, err = p.CalcTokenInShareAmountOut(ctx, tokenInDenom, shareOutAmount, p.GetSwapFee(ctx))
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	p.updatePoolForJoin(sdk.NewCoins(sdk.NewCoin(tokenInDenom, tokenInAmount)), shareOutAmount)
+	if err := validateScaledAmounts(p.scaledReserves()); err != nil {
+		return sdk.Int{}, err
+	}
+	return tokenInAmount, nil
+}
+
+// ExitSwapExactAmountOut exits the pool for an exact amount of a single token out,
+// using at most shareInMaxAmount shares.
+func (p *Pool) ExitSwapExactAmountOut(ctx sdk.Context, tokenOut sdk.Coin, shareInMaxAmount sdk.Int) (shareInAmount sdk.Int, err error) {
+	idx, err := p.getDenomIndex(tokenOut.Denom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	// A single asset join of tokenOut into the pool without tokenOut mints joinShares, which are worth
+	// joinShares / (totalShares + joinShares) of the pool. Exiting tokenOut hence burns that fraction of the total shares.
+	pCopy := p.Copy()
+	if err := pCopy.exitPool(sdk.NewCoins(tokenOut), sdk.ZeroInt()); err != nil {
+		return sdk.Int{}, err
+	}
+	joinShares, err := pCopy.calcSingleAssetJoinShares(tokenOut, sdk.ZeroDec())
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	totalShares := p.GetTotalShares()
+	sharesInNoFee := sdk.NewDecFromInt(joinShares.Mul(totalShares)).QuoInt(totalShares.Add(joinShares))
+
+	// charge the swap fee on the portion that is effectively swapped, and the exit fee on the whole exit.
+	// We round up the shares in, as this is whats charged for the exit.
+	sharesIn := sharesInNoFee.
+		Quo(feeRatio(pCopy.scaledReserves(), idx, p.GetSwapFee(ctx))).
+		Quo(sdk.OneDec().Sub(p.GetExitFee(ctx))).
+		Ceil().TruncateInt()
+
+	if !sharesIn.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrNotPositiveRequireAmount, "shares amount must be positive, was %s", sharesIn)
+	}
+	if sharesIn.GT(shareInMaxAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "%s resulted shares is larger than the max amount of %s", sharesIn, shareInMaxAmount)
+	}
+
+	if err := p.exitPool(sdk.NewCoins(tokenOut), sharesIn); err != nil {
+		return sdk.Int{}, err
+	}
+	return sharesIn, nil
+}
+
+// SetScalingFactors sets the scaling factors of the pool.
+// The sender must be the pool's scaling factor controller.
+func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, scalingFactorSender string) error {
+	if scalingFactorSender != p.ScalingFactorController {
+		return types.ErrNotScalingFactorGovernor
+	}
+
+	if err := validateScalingFactors(scalingFactors, len(p.PoolLiquidity)); err != nil {
+		return err
+	}
+
+	pCopy := p.Copy()
+	pCopy.ScalingFactors = scalingFactors
+	if err := validateScaledAmounts(pCopy.scaledReserves()); err != nil {
+		return err
+	}
+
+	p.ScalingFactors = scalingFactors
+	return nil
+}
@@ -0,0 +1,41 @@
+package stableswap
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+func NewPoolParams(swapFee, exitFee sdk.Dec) PoolParams {
+	return PoolParams{
+		SwapFee: swapFee,
+		ExitFee: exitFee,
+	}
+}
+
+func (params PoolParams) Validate() error {
+	if params.ExitFee.IsNegative() {
+		return types.ErrNegativeExitFee
+	}
+
+	if params.ExitFee.GTE(sdk.OneDec()) {
+		return types.ErrTooMuchExitFee
+	}
+
+	if params.SwapFee.IsNegative() {
+		return types.ErrNegativeSwapFee
+	}
+
+	if params.SwapFee.GTE(sdk.OneDec()) {
+		return types.ErrTooMuchSwapFee
+	}
+	return nil
+}
+
+func (params PoolParams) GetPoolSwapFee() sdk.Dec {
+	return params.SwapFee
+}
+
+func (params PoolParams) GetPoolExitFee() sdk.Dec {
+	return params.ExitFee
+}
@@ -0,0 +1,122 @@
+package stableswap
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+var defaultPoolParams = PoolParams{
+	SwapFee: defaultSwapFee,
+	ExitFee: sdk.ZeroDec(),
+}
+
+func TestNewStableswapPool(t *testing.T) {
+	tests := map[string]struct {
+		liquidity      sdk.Coins
+		scalingFactors []uint64
+		amplification  uint64
+		params         PoolParams
+		expectErr      error
+	}{
+		"valid pool": {
+			liquidity:      twoEvenStablePoolLiq,
+			scalingFactors: []uint64{1, 1},
+			amplification:  defaultAmplification,
+			params:         defaultPoolParams,
+		},
+		"default scaling factors": {
+			liquidity:     twoEvenStablePoolLiq,
+			amplification: defaultAmplification,
+			params:        defaultPoolParams,
+		},
+		"scaling factors length mismatch": {
+			liquidity:      twoEvenStablePoolLiq,
+			scalingFactors: []uint64{1, 1, 1},
+			amplification:  defaultAmplification,
+			params:         defaultPoolParams,
+			expectErr:      types.LiquidityAndScalingFactorCountMismatchError{LiquidityCount: 2, ScalingFactorCount: 3},
+		},
+		"zero scaling factor": {
+			liquidity:      twoEvenStablePoolLiq,
+			scalingFactors: []uint64{0, 1},
+			amplification:  defaultAmplification,
+			params:         defaultPoolParams,
+			expectErr:      types.ErrInvalidScalingFactors,
+		},
+		"zero amplification": {
+			liquidity:      twoEvenStablePoolLiq,
+			scalingFactors: []uint64{1, 1},
+			amplification:  0,
+			params:         defaultPoolParams,
+			expectErr:      types.ErrInvalidPool,
+		},
+		"scaled liquidity below minimum": {
+			liquidity:      twoEvenStablePoolLiq,
+			scalingFactors: []uint64{1, 10_000_000_000},
+			amplification:  defaultAmplification,
+			params:         defaultPoolParams,
+			expectErr:      types.ErrHitMinScaledAssets,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool, err := NewStableswapPool(1, tc.params, tc.liquidity, tc.scalingFactors, "", tc.amplification, "")
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, len(tc.liquidity), len(pool.GetScalingFactors()))
+			require.Equal(t, tc.amplification, pool.GetAmplification())
+			require.Equal(t, types.InitPoolSharesSupply.String(), pool.GetTotalShares().String())
+		})
+	}
+}
+
+func TestJoinExitPool(t *testing.T) {
+	pool, err := NewStableswapPool(1, defaultPoolParams, twoEvenStablePoolLiq, nil, "", defaultAmplification, "")
+	require.NoError(t, err)
+	initialShares := pool.GetTotalShares()
+
+	// a join with the pool ratio mints shares proportionally, without any swap
+	tokensIn := sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("foo", 1_000_000))
+	sharesOut, err := pool.JoinPool(sdk.Context{}, tokensIn, defaultSwapFee)
+	require.NoError(t, err)
+	require.Equal(t, initialShares.QuoRaw(1000).String(), sharesOut.String())
+	require.Equal(t, twoEvenStablePoolLiq.Add(tokensIn...).String(), pool.GetTotalPoolLiquidity(sdk.Context{}).String())
+
+	// exiting with the minted shares returns the joined tokens, rounded down in favor of the pool
+	exitedCoins, err := pool.ExitPool(sdk.Context{}, sharesOut, sdk.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, tokensIn.IsAllGTE(exitedCoins))
+	require.True(t, exitedCoins.Add(sdk.NewInt64Coin("bar", 1), sdk.NewInt64Coin("foo", 1)).IsAllGTE(tokensIn))
+	require.Equal(t, initialShares.String(), pool.GetTotalShares().String())
+
+	// a single asset join is charged the swap fee on the swapped share, so mints fewer shares
+	singleTokenIn := sdk.NewCoins(sdk.NewInt64Coin("foo", 2_000_000))
+	singleSharesOut, _, err := pool.CalcJoinPoolShares(sdk.Context{}, singleTokenIn, defaultSwapFee)
+	require.NoError(t, err)
+	require.True(t, singleSharesOut.LT(sharesOut))
+	require.True(t, singleSharesOut.GT(sharesOut.MulRaw(98).QuoRaw(100)))
+}
+
+func TestSetScalingFactors(t *testing.T) {
+	controller := "controller"
+	pool, err := NewStableswapPool(1, defaultPoolParams, twoEvenStablePoolLiq, []uint64{1, 1}, controller, defaultAmplification, "")
+	require.NoError(t, err)
+
+	err = pool.SetScalingFactors(sdk.Context{}, []uint64{1, 2}, "not controller")
+	require.ErrorIs(t, err, types.ErrNotScalingFactorGovernor)
+
+	err = pool.SetScalingFactors(sdk.Context{}, []uint64{1, 2, 3}, controller)
+	require.Error(t, err)
+
+	err = pool.SetScalingFactors(sdk.Context{}, []uint64{1, 2}, controller)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, pool.GetScalingFactors())
+}
@@ -0,0 +1,1336 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/gamm/poolmodels/stableswap/v1beta1/stableswap_pool.proto
+
+package stableswap
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PoolParams defined the parameters that will be managed by the pool
+// governance in the future. This params are not managed by the chain
+// governance. Instead they will be managed by the token holders of the pool.
+// The pool's token holders are specified in future_pool_governor.
+type PoolParams struct {
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+}
+
+func (m *PoolParams) Reset()         { *m = PoolParams{} }
+func (m *PoolParams) String() string { return proto.CompactTextString(m) }
+func (*PoolParams) ProtoMessage()    {}
+func (*PoolParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a7cd0a78be46c5b, []int{0}
+}
+func (m *PoolParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolParams.Merge(m, src)
+}
+func (m *PoolParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolParams proto.InternalMessageInfo
+
+// AmplificationRampParams defines a linear change of the pool's amplification
+// coefficient over time. The amplification is initial_amplification up until
+// start_time, moves linearly towards target_amplification between start_time
+// and end_time, and is target_amplification after end_time.
+type AmplificationRampParams struct {
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// The amplification at the time the ramp was scheduled.
+	InitialAmplification uint64 `protobuf:"varint,3,opt,name=initial_amplification,json=initialAmplification,proto3" json:"initial_amplification,omitempty" yaml:"initial_amplification"`
+	TargetAmplification  uint64 `protobuf:"varint,4,opt,name=target_amplification,json=targetAmplification,proto3" json:"target_amplification,omitempty" yaml:"target_amplification"`
+}
+
+func (m *AmplificationRampParams) Reset()         { *m = AmplificationRampParams{} }
+func (m *AmplificationRampParams) String() string { return proto.CompactTextString(m) }
+func (*AmplificationRampParams) ProtoMessage()    {}
+func (*AmplificationRampParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a7cd0a78be46c5b, []int{1}
+}
+func (m *AmplificationRampParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AmplificationRampParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AmplificationRampParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AmplificationRampParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmplificationRampParams.Merge(m, src)
+}
+func (m *AmplificationRampParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *AmplificationRampParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmplificationRampParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmplificationRampParams proto.InternalMessageInfo
+
+func (m *AmplificationRampParams) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *AmplificationRampParams) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *AmplificationRampParams) GetInitialAmplification() uint64 {
+	if m != nil {
+		return m.InitialAmplification
+	}
+	return 0
+}
+
+func (m *AmplificationRampParams) GetTargetAmplification() uint64 {
+	if m != nil {
+		return m.TargetAmplification
+	}
+	return 0
+}
+
+// Pool is the stableswap Pool struct
+type Pool struct {
+	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Id         uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	PoolParams PoolParams `protobuf:"bytes,3,opt,name=pool_params,json=poolParams,proto3" json:"pool_params" yaml:"stableswap_pool_params"`
+	// This string specifies who will govern the pool in the future.
+	// Valid forms of this are:
+	// {token name},{duration}
+	// {duration}
+	// where {token name} if specified is the token which determines the
+	// governor, and if not specified is the LP token for this pool.duration is
+	// a time specified as 0w,1w,2w, etc. which specifies how long the token
+	// would need to be locked up to count in governance. 0w means no lockup.
+	FuturePoolGovernor string `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
+	// sum of all LP shares
+	TotalShares types.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+	// assets in the pool, sorted by denomination
+	PoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=pool_liquidity,json=poolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_liquidity"`
+	// for calculation amongst assets with different precisions.
+	// scaling_factors[i] is the scaling factor of pool_liquidity[i]
+	ScalingFactors []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factors"`
+	// scaling_factor_controller is the address can adjust pool scaling factors
+	ScalingFactorController string `protobuf:"bytes,8,opt,name=scaling_factor_controller,json=scalingFactorController,proto3" json:"scaling_factor_controller,omitempty" yaml:"scaling_factor_controller"`
+	// amplification is the amplification coefficient (A) of the stableswap
+	// invariant. Higher values flatten the curve around the 1:1 scaled price.
+	Amplification uint64 `protobuf:"varint,9,opt,name=amplification,proto3" json:"amplification,omitempty" yaml:"amplification"`
+	// amplification_ramp_params is the ramp schedule of the amplification
+	// coefficient, if one is in progress. It is set by governance.
+	AmplificationRampParams *AmplificationRampParams `protobuf:"bytes,10,opt,name=amplification_ramp_params,json=amplificationRampParams,proto3" json:"amplification_ramp_params,omitempty" yaml:"amplification_ramp_params"`
+}
+
+func (m *Pool) Reset()      { *m = Pool{} }
+func (*Pool) ProtoMessage() {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a7cd0a78be46c5b, []int{2}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*PoolParams)(nil), "dymensionxyz.dymension.gamm.poolmodels.stableswap.v1beta1.PoolParams")
+	proto.RegisterType((*AmplificationRampParams)(nil), "dymensionxyz.dymension.gamm.poolmodels.stableswap.v1beta1.AmplificationRampParams")
+	proto.RegisterType((*Pool)(nil), "dymensionxyz.dymension.gamm.poolmodels.stableswap.v1beta1.Pool")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/gamm/poolmodels/stableswap/v1beta1/stableswap_pool.proto", fileDescriptor_4a7cd0a78be46c5b)
+}
+
+var fileDescriptor_4a7cd0a78be46c5b = []byte{
+	// 834 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4f, 0x6f, 0xdc, 0x44,
+	0x18, 0xc6, 0xd7, 0x9b, 0x6d, 0x37, 0x99, 0xd0, 0x54, 0x75, 0x17, 0x65, 0x93, 0xd2, 0x9d, 0x65,
+	0x44, 0x51, 0x0e, 0xc4, 0x26, 0x45, 0x1c, 0xe8, 0x01, 0x29, 0x5b, 0x08, 0x42, 0x42, 0x50, 0x06,
+	0x90, 0xf8, 0x27, 0x99, 0x59, 0x7b, 0xd6, 0x1d, 0x61, 0x7b, 0x8c, 0x67, 0x36, 0x64, 0xf9, 0x04,
+	0x5c, 0x90, 0x7a, 0xe4, 0xd8, 0x33, 0x47, 0xd4, 0x0f, 0x11, 0x71, 0xea, 0x11, 0x71, 0x70, 0x51,
+	0xf2, 0x0d, 0xfc, 0x09, 0xd0, 0x8c, 0xc7, 0x5e, 0xbb, 0xdd, 0x00, 0x6a, 0x4f, 0xeb, 0xf7, 0xf5,
+	0x33, 0xbf, 0x99, 0xf7, 0x9d, 0xe7, 0x5d, 0x83, 0x4f, 0x82, 0x45, 0x4c, 0x13, 0xc1, 0x78, 0x72,
+	0xb2, 0xf8, 0xc9, 0xad, 0x03, 0x37, 0x24, 0x71, 0xec, 0xa6, 0x9c, 0x47, 0x31, 0x0f, 0x68, 0x24,
+	0x5c, 0x21, 0xc9, 0x34, 0xa2, 0xe2, 0x47, 0x92, 0xba, 0xc7, 0x07, 0x53, 0x2a, 0xc9, 0x41, 0x23,
+	0xe5, 0x29, 0xa1, 0x93, 0x66, 0x5c, 0x72, 0xfb, 0x9d, 0x26, 0xd0, 0xa9, 0x03, 0x47, 0x01, 0x9d,
+	0x25, 0xd0, 0x59, 0xae, 0x76, 0x0c, 0x70, 0x77, 0xc7, 0xe7, 0x22, 0xe6, 0xc2, 0xd3, 0x20, 0xb7,
+	0x0c, 0x4a, 0xea, 0xee, 0x20, 0xe4, 0x21, 0x2f, 0xf3, 0xea, 0xc9, 0x64, 0x61, 0xc8, 0x79, 0x18,
+	0x51, 0x57, 0x47, 0xd3, 0xf9, 0xcc, 0x95, 0x2c, 0xa6, 0x42, 0x92, 0x38, 0x35, 0x82, 0x51, 0x09,
+	0x71, 0xa7, 0x44, 0xd0, 0xfa, 0xdc, 0x3e, 0x67, 0x49, 0xf9, 0x1e, 0x9d, 0x5a, 0x00, 0xdc, 0xe3,
+	0x3c, 0xba, 0x47, 0x32, 0x12, 0x0b, 0xfb, 0x5b, 0xb0, 0xae, 0xcb, 0x99, 0x51, 0x3a, 0xb4, 0xc6,
+	0xd6, 0xde, 0xc6, 0xe4, 0xf0, 0x34, 0x87, 0x9d, 0xbf, 0x72, 0xf8, 0x7a, 0xc8, 0xe4, 0xfd, 0xf9,
+	0xd4, 0xf1, 0x79, 0x6c, 0x0e, 0x66, 0x7e, 0xf6, 0x45, 0xf0, 0xbd, 0x2b, 0x17, 0x29, 0x15, 0xce,
+	0x7b, 0xd4, 0x2f, 0x72, 0x78, 0x75, 0x41, 0xe2, 0xe8, 0x0e, 0xaa, 0x38, 0x08, 0xf7, 0xd5, 0xe3,
+	0x11, 0xa5, 0x8a, 0x4e, 0x4f, 0x98, 0xd4, 0xf4, 0xee, 0x8b, 0xd1, 0x2b, 0x0e, 0xc2, 0x7d, 0xf5,
+	0x78, 0x44, 0x29, 0x2a, 0xba, 0x60, 0xfb, 0x30, 0x4e, 0x23, 0x36, 0x63, 0x3e, 0x91, 0x8c, 0x27,
+	0x98, 0xc4, 0xa9, 0xa9, 0xeb, 0x4b, 0x00, 0x84, 0x24, 0x99, 0xf4, 0x54, 0x7f, 0x74, 0x65, 0x9b,
+	0xb7, 0x77, 0x9d, 0xb2, 0x79, 0x4e, 0xd5, 0x3c, 0xe7, 0xf3, 0xaa, 0x79, 0x93, 0x9b, 0xea, 0x5c,
+	0x45, 0x0e, 0xaf, 0x99, 0x5a, 0xea, 0xb5, 0xe8, 0xc1, 0x13, 0x68, 0xe1, 0x0d, 0x9d, 0x50, 0x72,
+	0x1b, 0x83, 0x75, 0x9a, 0x04, 0x25, 0xb7, 0xfb, 0x9f, 0xdc, 0x1b, 0x86, 0x5b, 0x55, 0x91, 0x04,
+	0x0d, 0x6a, 0x9f, 0x26, 0x81, 0x66, 0x7e, 0x01, 0x5e, 0x66, 0x09, 0x93, 0x8c, 0x44, 0x1e, 0x69,
+	0x16, 0x34, 0x5c, 0x1b, 0x5b, 0x7b, 0xbd, 0xc9, 0xb8, 0xc8, 0xe1, 0x2b, 0x25, 0x60, 0xa5, 0x0c,
+	0xe1, 0x81, 0xc9, 0xb7, 0xda, 0x61, 0x63, 0x30, 0x90, 0x24, 0x0b, 0xa9, 0x7c, 0x8a, 0xda, 0xd3,
+	0x54, 0x58, 0xe4, 0xf0, 0x46, 0x49, 0x5d, 0xa5, 0x42, 0xf8, 0x7a, 0x99, 0x6e, 0x31, 0xd1, 0xef,
+	0x7d, 0xd0, 0x53, 0xfe, 0xb1, 0xdf, 0x00, 0x7d, 0x12, 0x04, 0x19, 0x15, 0xc2, 0x18, 0xc7, 0x2e,
+	0x72, 0xb8, 0x55, 0xf2, 0xcc, 0x0b, 0x84, 0x2b, 0x89, 0xbd, 0x05, 0xba, 0x2c, 0xd0, 0xfd, 0xea,
+	0xe1, 0x2e, 0x0b, 0xec, 0x5f, 0x2c, 0xb0, 0xa9, 0x46, 0xc3, 0x4b, 0xf5, 0x7d, 0xe9, 0x42, 0x37,
+	0x6f, 0xbf, 0xef, 0x3c, 0xf7, 0x28, 0x39, 0x4b, 0x53, 0x4f, 0x6e, 0x99, 0xa6, 0xdf, 0xac, 0x2f,
+	0xb3, 0x39, 0xb5, 0x66, 0x4b, 0x84, 0x41, 0xba, 0x9c, 0x83, 0x4f, 0xc1, 0x60, 0x36, 0x97, 0xf3,
+	0x8c, 0x96, 0x92, 0x90, 0x1f, 0xd3, 0x2c, 0xe1, 0x99, 0x6e, 0xd5, 0x46, 0xb3, 0x55, 0xab, 0x54,
+	0x08, 0xdb, 0x65, 0x5a, 0x9d, 0xe1, 0x03, 0x93, 0xb4, 0xbf, 0x02, 0x2f, 0x49, 0x2e, 0x49, 0xe4,
+	0x89, 0xfb, 0x24, 0xa3, 0x62, 0x78, 0x49, 0x97, 0xb8, 0xe3, 0x98, 0x29, 0x57, 0x03, 0x5a, 0x1f,
+	0xfe, 0x2e, 0x67, 0x49, 0xed, 0x95, 0xeb, 0xe6, 0x52, 0x1a, 0x8b, 0x11, 0xde, 0xd4, 0xe1, 0x67,
+	0x3a, 0xb2, 0x33, 0xb0, 0xa5, 0x0f, 0x10, 0xb1, 0x1f, 0xe6, 0x2c, 0x60, 0x72, 0x31, 0xbc, 0x3c,
+	0x5e, 0xfb, 0x77, 0xf8, 0x9b, 0x0a, 0xfe, 0xdb, 0x13, 0xb8, 0xf7, 0x3f, 0x06, 0x4f, 0x2d, 0x10,
+	0xf8, 0x8a, 0xda, 0xe2, 0xa3, 0x6a, 0x07, 0xfb, 0x63, 0x70, 0x55, 0xf8, 0x24, 0x62, 0x49, 0xe8,
+	0xcd, 0x88, 0x2f, 0x79, 0x26, 0x86, 0xfd, 0xf1, 0xda, 0x5e, 0x6f, 0x72, 0xab, 0xc8, 0xe1, 0xab,
+	0xcf, 0x74, 0xfa, 0x29, 0x2d, 0xc2, 0x5b, 0x26, 0x73, 0x54, 0x26, 0xec, 0xef, 0xc0, 0x4e, 0x5b,
+	0xe3, 0xf9, 0x3c, 0x91, 0x19, 0x8f, 0x22, 0x9a, 0x0d, 0xd7, 0x75, 0xdb, 0x5f, 0x2b, 0x72, 0x38,
+	0x36, 0xe4, 0x8b, 0xa4, 0x08, 0x6f, 0xb7, 0xc0, 0x77, 0xeb, 0x37, 0xf6, 0xbb, 0xe0, 0x4a, 0xdb,
+	0xf7, 0x1b, 0xda, 0xf7, 0xc3, 0x22, 0x87, 0x03, 0xe3, 0xd3, 0xb6, 0xe1, 0xdb, 0x72, 0xfb, 0x91,
+	0x05, 0x76, 0x5a, 0x19, 0x2f, 0x23, 0x71, 0x5a, 0x39, 0x16, 0xe8, 0xeb, 0xc4, 0x2f, 0xe0, 0xd8,
+	0x0b, 0xfe, 0xbb, 0x9a, 0x65, 0x5f, 0xb8, 0x3d, 0xc2, 0xdb, 0x64, 0xf5, 0xf2, 0x3b, 0xd7, 0x7e,
+	0x7e, 0x08, 0x3b, 0xbf, 0x3e, 0x84, 0x9d, 0x3f, 0x1e, 0xed, 0x5f, 0x52, 0x8e, 0xfc, 0x70, 0xf2,
+	0xcd, 0xe9, 0xd9, 0xc8, 0x7a, 0x7c, 0x36, 0xb2, 0xfe, 0x3e, 0x1b, 0x59, 0x0f, 0xce, 0x47, 0x9d,
+	0xc7, 0xe7, 0xa3, 0xce, 0x9f, 0xe7, 0xa3, 0xce, 0xd7, 0x87, 0x0d, 0x3b, 0x68, 0x1b, 0x30, 0xb1,
+	0x1f, 0x91, 0xa9, 0xa8, 0x02, 0xf7, 0xf8, 0xe0, 0x6d, 0xf7, 0x64, 0xf9, 0x6d, 0xdc, 0x7f, 0xe6,
+	0xe3, 0x38, 0xbd, 0xac, 0xff, 0xf6, 0xde, 0xfa, 0x67, 0x00, 0x23, 0x2a, 0xe1, 0xa3, 0x58, 0x07,
+	0x00, 0x00,
+}
+
+func (m *PoolParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExitFee.Size()
+		i -= size
+		if _, err := m.ExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AmplificationRampParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AmplificationRampParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AmplificationRampParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetAmplification != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.TargetAmplification))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InitialAmplification != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.InitialAmplification))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AmplificationRampParams != nil {
+		{
+			size, err := m.AmplificationRampParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Amplification != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ScalingFactorController) > 0 {
+		i -= len(m.ScalingFactorController)
+		copy(dAtA[i:], m.ScalingFactorController)
+		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.ScalingFactorController)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ScalingFactors) > 0 {
+		dAtA5 := make([]byte, len(m.ScalingFactors)*10)
+		var j4 int
+		for _, num := range m.ScalingFactors {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PoolLiquidity) > 0 {
+		for iNdEx := len(m.PoolLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.FuturePoolGovernor) > 0 {
+		i -= len(m.FuturePoolGovernor)
+		copy(dAtA[i:], m.FuturePoolGovernor)
+		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.FuturePoolGovernor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.PoolParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStableswapPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStableswapPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapFee.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	return n
+}
+
+func (m *AmplificationRampParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if m.InitialAmplification != 0 {
+		n += 1 + sovStableswapPool(uint64(m.InitialAmplification))
+	}
+	if m.TargetAmplification != 0 {
+		n += 1 + sovStableswapPool(uint64(m.TargetAmplification))
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovStableswapPool(uint64(m.Id))
+	}
+	l = m.PoolParams.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = len(m.FuturePoolGovernor)
+	if l > 0 {
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if len(m.PoolLiquidity) > 0 {
+		for _, e := range m.PoolLiquidity {
+			l = e.Size()
+			n += 1 + l + sovStableswapPool(uint64(l))
+		}
+	}
+	if len(m.ScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.ScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
+	l = len(m.ScalingFactorController)
+	if l > 0 {
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovStableswapPool(uint64(m.Amplification))
+	}
+	if m.AmplificationRampParams != nil {
+		l = m.AmplificationRampParams.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	return n
+}
+
+func sovStableswapPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStableswapPool(x uint64) (n int) {
+	return sovStableswapPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AmplificationRampParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AmplificationRampParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AmplificationRampParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmplification", wireType)
+			}
+			m.InitialAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmplification", wireType)
+			}
+			m.TargetAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuturePoolGovernor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FuturePoolGovernor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolLiquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolLiquidity = append(m.PoolLiquidity, types.Coin{})
+			if err := m.PoolLiquidity[len(m.PoolLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ScalingFactors = append(m.ScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ScalingFactors) == 0 {
+					m.ScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ScalingFactors = append(m.ScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactors", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorController", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScalingFactorController = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmplificationRampParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AmplificationRampParams == nil {
+				m.AmplificationRampParams = &AmplificationRampParams{}
+			}
+			if err := m.AmplificationRampParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStableswapPool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStableswapPool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStableswapPool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStableswapPool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStableswapPool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStableswapPool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStableswapPool = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,45 @@
+package stableswap
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+// validateScalingFactors ensures that there is one scaling factor per pool asset,
+// and that every scaling factor is positive and fits in 63 bits.
+func validateScalingFactors(scalingFactors []uint64, numAssets int) error {
+	if len(scalingFactors) != numAssets {
+		return types.LiquidityAndScalingFactorCountMismatchError{LiquidityCount: numAssets, ScalingFactorCount: len(scalingFactors)}
+	}
+
+	for _, scalingFactor := range scalingFactors {
+		if scalingFactor == 0 || scalingFactor > math.MaxInt64 {
+			return types.ErrInvalidScalingFactors
+		}
+	}
+
+	return nil
+}
+
+// validateAmplification ensures the amplification coefficient is within [MinAmplification, MaxAmplification].
+func validateAmplification(amplification uint64) error {
+	if amplification < MinAmplification || amplification > MaxAmplification {
+		return sdkerrors.Wrap(types.ErrInvalidPool, fmt.Sprintf("amplification must be in [%d, %d], got %d", MinAmplification, MaxAmplification, amplification))
+	}
+	return nil
+}
+
+// isSortedByDenom returns true if the coins are sorted by denom, without duplicates.
+func isSortedByDenom(coins sdk.Coins) bool {
+	for i := 1; i < len(coins); i++ {
+		if coins[i-1].Denom >= coins[i].Denom {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewCanonicalPool returns the canonical pool of the pair of the given denoms, ordering them.
+func NewCanonicalPool(denomA, denomB string, poolId uint64) CanonicalPool {
+	denomA, denomB = OrderDenomPair(denomA, denomB)
+	return CanonicalPool{DenomA: denomA, DenomB: denomB, PoolId: poolId}
+}
+
+// Validate performs stateless validation of the canonical pool: its denoms must be valid,
+// distinct and ordered, and its pool id positive.
+func (c CanonicalPool) Validate() error {
+	if err := validateDenomPair(c.DenomA, c.DenomB); err != nil {
+		return err
+	}
+	if c.DenomA > c.DenomB {
+		return fmt.Errorf("%w: denoms %s and %s are not ordered", ErrInvalidCanonicalPool, c.DenomA, c.DenomB)
+	}
+	if c.PoolId == 0 {
+		return fmt.Errorf("%w: pool id must be positive", ErrInvalidCanonicalPool)
+	}
+	return nil
+}
+
+// validateDenomPair checks that the denoms of a pair are valid and distinct.
+func validateDenomPair(denomA, denomB string) error {
+	if err := sdk.ValidateDenom(denomA); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidCanonicalPool, err)
+	}
+	if err := sdk.ValidateDenom(denomB); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidCanonicalPool, err)
+	}
+	if denomA == denomB {
+		return fmt.Errorf("%w: denoms must be distinct, got %s twice", ErrInvalidCanonicalPool, denomA)
+	}
+	return nil
+}
@@ -20,6 +20,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgJoinSwapShareAmountOut{}, "dymensionxyz/dymension/gamm/JoinSwapShareAmountOut", nil)
 	cdc.RegisterConcrete(&MsgExitSwapExternAmountOut{}, "dymensionxyz/dymension/gamm/ExitSwapExternAmountOut", nil)
 	cdc.RegisterConcrete(&MsgExitSwapShareAmountIn{}, "dymensionxyz/dymension/gamm/ExitSwapShareAmountIn", nil)
+	cdc.RegisterConcrete(&MsgReplaceMigrationRecords{}, "dymensionxyz/dymension/gamm/ReplaceMigrationRecords", nil)
+	cdc.RegisterConcrete(&MsgUpdateMigrationRecords{}, "dymensionxyz/dymension/gamm/UpdateMigrationRecords", nil)
+	cdc.RegisterConcrete(&MsgMigrateShares{}, "dymensionxyz/dymension/gamm/MigrateShares", nil)
+	cdc.RegisterConcrete(&MsgMigrateLockedShares{}, "dymensionxyz/dymension/gamm/MigrateLockedShares", nil)
+	cdc.RegisterConcrete(&MsgSetCanonicalPool{}, "dymensionxyz/dymension/gamm/SetCanonicalPool", nil)
+	cdc.RegisterConcrete(&MsgZapIn{}, "dymensionxyz/dymension/gamm/ZapIn", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -38,6 +44,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgJoinSwapShareAmountOut{},
 		&MsgExitSwapExternAmountOut{},
 		&MsgExitSwapShareAmountIn{},
+		&MsgReplaceMigrationRecords{},
+		&MsgUpdateMigrationRecords{},
+		&MsgMigrateShares{},
+		&MsgMigrateLockedShares{},
+		&MsgSetCanonicalPool{},
+		&MsgZapIn{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -76,4 +76,31 @@ var (
 
 	ErrPoolAssetNotAllowed = sdkerrors.Register(ModuleName, 67, "pool must cointain one of the whitelisted assets")
 	ErrPoolAlreadyExists   = sdkerrors.Register(ModuleName, 68, "pool with same assets already exists")
+
+	ErrInvalidAmplificationRamp = sdkerrors.Register(ModuleName, 69, "invalid amplification ramp")
+
+	ErrInvalidDynamicSwapFee = sdkerrors.Register(ModuleName, 70, "invalid dynamic swap fee params")
+
+	ErrNotPoolGovernor        = sdkerrors.Register(ModuleName, 71, "not pool governor")
+	ErrNotBalancerPool        = sdkerrors.Register(ModuleName, 72, "not balancer pool")
+	ErrFeeOutOfGovernorBounds = sdkerrors.Register(ModuleName, 73, "fee out of governor fee bounds")
+	ErrInvalidWeightChange    = sdkerrors.Register(ModuleName, 74, "invalid weight change")
+
+	ErrInvalidMigrationRecords = sdkerrors.Register(ModuleName, 75, "invalid migration records")
+	ErrNoMigrationRecord       = sdkerrors.Register(ModuleName, 76, "pool has no migration record")
+
+	ErrNotLiquidityBootstrappingPool = sdkerrors.Register(ModuleName, 77, "not liquidity bootstrapping pool")
+	ErrInvalidLiquidityBootstrapping = sdkerrors.Register(ModuleName, 78, "invalid liquidity bootstrapping pool")
+	ErrJoinRestricted                = sdkerrors.Register(ModuleName, 79, "only the creator can join the pool during its sale")
+
+	ErrInvalidFeeTier       = sdkerrors.Register(ModuleName, 80, "swap fee is not a fee tier")
+	ErrInvalidCanonicalPool = sdkerrors.Register(ModuleName, 81, "invalid canonical pool")
+
+	ErrInvalidPoolStats = sdkerrors.Register(ModuleName, 82, "invalid pool stats")
+	ErrNoValuation      = sdkerrors.Register(ModuleName, 83, "coin cannot be valued")
+
+	ErrInvalidSpotPriceBounds = sdkerrors.Register(ModuleName, 84, "invalid spot price bounds")
+	ErrSpotPriceOutOfBounds   = sdkerrors.Register(ModuleName, 85, "spot price out of bounds")
+
+	ErrLockedMigrationToConcentrated = sdkerrors.Register(ModuleName, 86, "locked shares can not be migrated to a concentrated liquidity pool")
 )
@@ -8,6 +8,11 @@ const (
 	TypeEvtMigrateShares      = "migrate_shares"
 	TypeEvtSwapExactAmountIn  = "swap_exact_amount_in"
 	TypeEvtSwapExactAmountOut = "swap_exact_amount_out"
+	TypeEvtAmplificationRamp  = "amplification_ramp"
+	TypeEvtWeightChange       = "weight_change"
+	TypeEvtPoolFeesSet        = "pool_fees_set"
+	TypeEvtSwapsPausedSet     = "swaps_paused_set"
+	TypeEvtZapIn              = "zap_in"
 
 	AttributeValueCategory     = ModuleName
 	AttributeKeyPoolId         = "pool_id"
@@ -18,4 +23,15 @@ const (
 	AttributeKeyClosingPrice   = "closing_price"
 	AttributeKeyTakerFee       = "taker_fee"
 	AttributeKeySwapFee        = "swap_fee"
+	AttributeKeyExitFee        = "exit_fee"
+	AttributeKeySwapsPaused    = "swaps_paused"
+	AttributeKeyLockId         = "lock_id"
+	AttributeKeyLiquidity      = "liquidity"
+
+	AttributeKeyTargetAmplification = "target_amplification"
+	AttributeKeyRampStartTime       = "ramp_start_time"
+	AttributeKeyRampEndTime         = "ramp_end_time"
+
+	AttributeKeyWeightChangeStartTime = "weight_change_start_time"
+	AttributeKeyWeightChangeDuration  = "weight_change_duration"
 )
@@ -1,9 +1,15 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	cltypes "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	epochstypes "github.com/osmosis-labs/osmosis/v15/x/epochs/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v15/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v15/x/lockup/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 	txfeestypes "github.com/osmosis-labs/osmosis/v15/x/txfees/types"
 )
@@ -61,6 +67,24 @@ type PoolManager interface {
 		tokenOut sdk.Coin,
 	) (tokenInAmount sdk.Int, err error)
 
+	SwapExactAmountInWithTakerFee(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		routes []poolmanagertypes.SwapAmountInRoute,
+		tokenIn sdk.Coin,
+		tokenOutMinAmount sdk.Int,
+		maxPriceImpact sdk.Dec,
+	) (tokenOutAmount sdk.Int, err error)
+
+	SwapExactAmountOutWithTakerFee(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		routes []poolmanagertypes.SwapAmountOutRoute,
+		tokenInMaxAmount sdk.Int,
+		tokenOut sdk.Coin,
+		maxPriceImpact sdk.Dec,
+	) (tokenInAmount sdk.Int, err error)
+
 	MultihopEstimateOutGivenExactAmountIn(
 		ctx sdk.Context,
 		routes []poolmanagertypes.SwapAmountInRoute,
@@ -79,8 +103,37 @@ type TxFeeKeeper interface {
 	GetFeeToken(ctx sdk.Context, denom string) (txfeestypes.FeeToken, error)
 	GetBaseDenom(ctx sdk.Context) (denom string, err error)
 	ChargeFeesFromPayer(ctx sdk.Context, payer sdk.AccAddress, takerFeeCoin sdk.Coin, beneficiary *sdk.AccAddress) error
+	ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin, error)
 }
 
 type RollappKeeper interface {
 	GetRollappOwnerByDenom(ctx sdk.Context, denom string) (sdk.AccAddress, error)
 }
+
+// LockupKeeper defines the contract needed to resolve the lockup-based governors of pools,
+// to migrate locked pool shares, and to lock the pool shares of zap-ins.
+type LockupKeeper interface {
+	GetPeriodLocksAccumulation(ctx sdk.Context, query lockuptypes.QueryCondition) sdk.Int
+	GetAccountLockedLongerDurationDenom(ctx sdk.Context, addr sdk.AccAddress, denom string, duration time.Duration) []lockuptypes.PeriodLock
+	GetLockByID(ctx sdk.Context, lockID uint64) (*lockuptypes.PeriodLock, error)
+	ConvertLockTokens(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, convert func(lockedCoins sdk.Coins) (sdk.Coins, error)) (*lockuptypes.PeriodLock, error)
+	CreateLock(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, duration time.Duration) (lockuptypes.PeriodLock, error)
+}
+
+// ConcentratedLiquidityKeeper defines the contract needed to migrate pool shares to concentrated liquidity pools.
+type ConcentratedLiquidityKeeper interface {
+	GetConcentratedPoolById(ctx sdk.Context, poolId uint64) (cltypes.ConcentratedPoolExtension, error)
+	CreateFullRangePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, coins sdk.Coins) (amount0, amount1 sdk.Int, liquidityCreated sdk.Dec, err error)
+}
+
+// EpochKeeper defines the contract needed to bucket the pool stats per epoch.
+type EpochKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) epochstypes.EpochInfo
+}
+
+// IncentivesKeeper defines the contract needed to estimate the rewards of the pool shares
+// for the pool APRs.
+type IncentivesKeeper interface {
+	GetActiveGauges(ctx sdk.Context) []incentivestypes.Gauge
+	GetEpochInfo(ctx sdk.Context) epochstypes.EpochInfo
+}
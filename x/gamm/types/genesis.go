@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
@@ -19,5 +21,30 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	if gs.MigrationRecords != nil {
+		if err := gs.MigrationRecords.Validate(); err != nil {
+			return err
+		}
+	}
+	pairs := make(map[[2]string]bool, len(gs.CanonicalPools))
+	for _, canonicalPool := range gs.CanonicalPools {
+		if err := canonicalPool.Validate(); err != nil {
+			return err
+		}
+		pair := [2]string{canonicalPool.DenomA, canonicalPool.DenomB}
+		if pairs[pair] {
+			return fmt.Errorf("%w: duplicate canonical pool for %s and %s", ErrInvalidCanonicalPool, canonicalPool.DenomA, canonicalPool.DenomB)
+		}
+		pairs[pair] = true
+	}
+	if err := validatePoolStats(gs.PoolStats); err != nil {
+		return err
+	}
+	if err := validatePoolStats(gs.CurrentEpochPoolStats); err != nil {
+		return err
+	}
+	if err := validatePoolStats(gs.EpochPoolStats); err != nil {
+		return err
+	}
 	return nil
 }
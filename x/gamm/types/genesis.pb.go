@@ -11,15 +11,19 @@ import (
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,8 +35,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Pools []*types.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 	// will be renamed to next_pool_id in an upcoming version
-	NextPoolNumber uint64 `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
-	Params         Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	NextPoolNumber   uint64            `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
+	Params           Params            `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	MigrationRecords *MigrationRecords `protobuf:"bytes,4,opt,name=migration_records,json=migrationRecords,proto3" json:"migration_records,omitempty"`
+	// canonical_pools are the canonical pools of the denom pairs.
+	CanonicalPools []CanonicalPool `protobuf:"bytes,5,rep,name=canonical_pools,json=canonicalPools,proto3" json:"canonical_pools"`
+	// pool_stats are the stats of the pools since they were created.
+	PoolStats []PoolStats `protobuf:"bytes,6,rep,name=pool_stats,json=poolStats,proto3" json:"pool_stats"`
+	// current_epoch_pool_stats are the stats of the pools over the current
+	// epoch.
+	CurrentEpochPoolStats []PoolStats `protobuf:"bytes,7,rep,name=current_epoch_pool_stats,json=currentEpochPoolStats,proto3" json:"current_epoch_pool_stats"`
+	// epoch_pool_stats are the stats of the pools over the last finished epochs.
+	EpochPoolStats []PoolStats `protobuf:"bytes,8,rep,name=epoch_pool_stats,json=epochPoolStats,proto3" json:"epoch_pool_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,11 +103,61 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetMigrationRecords() *MigrationRecords {
+	if m != nil {
+		return m.MigrationRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCanonicalPools() []CanonicalPool {
+	if m != nil {
+		return m.CanonicalPools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolStats() []PoolStats {
+	if m != nil {
+		return m.PoolStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCurrentEpochPoolStats() []PoolStats {
+	if m != nil {
+		return m.CurrentEpochPoolStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochPoolStats() []PoolStats {
+	if m != nil {
+		return m.EpochPoolStats
+	}
+	return nil
+}
+
 type Params struct {
 	PoolCreationFee      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
 	EnableGlobalPoolFees bool                                     `protobuf:"varint,2,opt,name=enable_global_pool_fees,json=enableGlobalPoolFees,proto3" json:"enable_global_pool_fees,omitempty"`
 	GlobalFees           GlobalFees                               `protobuf:"bytes,3,opt,name=global_fees,json=globalFees,proto3" json:"global_fees" yaml:"global_fees"`
 	TakerFee             github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,4,opt,name=taker_fee,json=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee" yaml:"taker_fee"`
+	// governor_fee_bounds bounds the swap and exit fees pool governors can set.
+	GovernorFeeBounds GovernorFeeBounds `protobuf:"bytes,5,opt,name=governor_fee_bounds,json=governorFeeBounds,proto3" json:"governor_fee_bounds" yaml:"governor_fee_bounds"`
+	// liquidity_bootstrapping_bounds bounds the sales of the liquidity
+	// bootstrapping pools.
+	LiquidityBootstrappingBounds LiquidityBootstrappingBounds `protobuf:"bytes,6,opt,name=liquidity_bootstrapping_bounds,json=liquidityBootstrappingBounds,proto3" json:"liquidity_bootstrapping_bounds" yaml:"liquidity_bootstrapping_bounds"`
+	// fee_tiers are the swap fees pools can be created with for assets some
+	// pool already holds. Pools holding the same assets must have distinct swap
+	// fees.
+	FeeTiers []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,rep,name=fee_tiers,json=feeTiers,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_tiers" yaml:"fee_tiers"`
+	// stats_epoch_identifier is the identifier of the epochs the pool stats are
+	// bucketed in.
+	StatsEpochIdentifier string `protobuf:"bytes,8,opt,name=stats_epoch_identifier,json=statsEpochIdentifier,proto3" json:"stats_epoch_identifier,omitempty" yaml:"stats_epoch_identifier"`
+	// stats_history_length is the number of finished epochs the pool stats are
+	// kept for.
+	StatsHistoryLength uint64 `protobuf:"varint,9,opt,name=stats_history_length,json=statsHistoryLength,proto3" json:"stats_history_length,omitempty" yaml:"stats_history_length"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -150,6 +214,34 @@ func (m *Params) GetGlobalFees() GlobalFees {
 	return GlobalFees{}
 }
 
+func (m *Params) GetGovernorFeeBounds() GovernorFeeBounds {
+	if m != nil {
+		return m.GovernorFeeBounds
+	}
+	return GovernorFeeBounds{}
+}
+
+func (m *Params) GetLiquidityBootstrappingBounds() LiquidityBootstrappingBounds {
+	if m != nil {
+		return m.LiquidityBootstrappingBounds
+	}
+	return LiquidityBootstrappingBounds{}
+}
+
+func (m *Params) GetStatsEpochIdentifier() string {
+	if m != nil {
+		return m.StatsEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetStatsHistoryLength() uint64 {
+	if m != nil {
+		return m.StatsHistoryLength
+	}
+	return 0
+}
+
 type GlobalFees struct {
 	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
 	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
@@ -188,10 +280,102 @@ func (m *GlobalFees) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GlobalFees proto.InternalMessageInfo
 
+type GovernorFeeBounds struct {
+	MinSwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min_swap_fee,json=minSwapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_swap_fee" yaml:"min_swap_fee"`
+	MaxSwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_swap_fee,json=maxSwapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_swap_fee" yaml:"max_swap_fee"`
+	MinExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_exit_fee,json=minExitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_exit_fee" yaml:"min_exit_fee"`
+	MaxExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_exit_fee,json=maxExitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_exit_fee" yaml:"max_exit_fee"`
+}
+
+func (m *GovernorFeeBounds) Reset()         { *m = GovernorFeeBounds{} }
+func (m *GovernorFeeBounds) String() string { return proto.CompactTextString(m) }
+func (*GovernorFeeBounds) ProtoMessage()    {}
+func (*GovernorFeeBounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc3b6373232d6d98, []int{3}
+}
+func (m *GovernorFeeBounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernorFeeBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernorFeeBounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernorFeeBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernorFeeBounds.Merge(m, src)
+}
+func (m *GovernorFeeBounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernorFeeBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernorFeeBounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernorFeeBounds proto.InternalMessageInfo
+
+// LiquidityBootstrappingBounds bound the sales of the liquidity bootstrapping
+// pools.
+type LiquidityBootstrappingBounds struct {
+	// The maximum ratio of the larger starting weight of a pool to its smaller
+	// starting weight.
+	MaxStartWeightRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_start_weight_ratio,json=maxStartWeightRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_start_weight_ratio" yaml:"max_start_weight_ratio"`
+	// The minimum duration of a sale.
+	MinDuration time.Duration `protobuf:"bytes,2,opt,name=min_duration,json=minDuration,proto3,stdduration" json:"min_duration" yaml:"min_duration"`
+}
+
+func (m *LiquidityBootstrappingBounds) Reset()         { *m = LiquidityBootstrappingBounds{} }
+func (m *LiquidityBootstrappingBounds) String() string { return proto.CompactTextString(m) }
+func (*LiquidityBootstrappingBounds) ProtoMessage()    {}
+func (*LiquidityBootstrappingBounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc3b6373232d6d98, []int{4}
+}
+func (m *LiquidityBootstrappingBounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityBootstrappingBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityBootstrappingBounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityBootstrappingBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityBootstrappingBounds.Merge(m, src)
+}
+func (m *LiquidityBootstrappingBounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityBootstrappingBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityBootstrappingBounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityBootstrappingBounds proto.InternalMessageInfo
+
+func (m *LiquidityBootstrappingBounds) GetMinDuration() time.Duration {
+	if m != nil {
+		return m.MinDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.gamm.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.gamm.v1beta1.Params")
 	proto.RegisterType((*GlobalFees)(nil), "dymensionxyz.dymension.gamm.v1beta1.GlobalFees")
+	proto.RegisterType((*GovernorFeeBounds)(nil), "dymensionxyz.dymension.gamm.v1beta1.GovernorFeeBounds")
+	proto.RegisterType((*LiquidityBootstrappingBounds)(nil), "dymensionxyz.dymension.gamm.v1beta1.LiquidityBootstrappingBounds")
 }
 
 func init() {
@@ -199,43 +383,74 @@ func init() {
 }
 
 var fileDescriptor_cc3b6373232d6d98 = []byte{
-	// 568 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6a, 0xdb, 0x4c,
-	0x14, 0xc5, 0xad, 0xd8, 0x71, 0xec, 0xf1, 0xc7, 0x97, 0x54, 0x18, 0xea, 0x78, 0x21, 0x1b, 0x15,
-	0x8a, 0xa0, 0x58, 0x53, 0xa7, 0x64, 0xd3, 0x5d, 0x94, 0xe2, 0x90, 0x52, 0x4a, 0x50, 0x77, 0x25,
-	0x20, 0x46, 0xce, 0xb5, 0x2a, 0x22, 0xcd, 0x18, 0x8f, 0x9c, 0x5a, 0x7d, 0x8a, 0x42, 0x5f, 0xa1,
-	0xab, 0xae, 0xbb, 0xea, 0x13, 0x98, 0xae, 0xb2, 0x2c, 0x5d, 0xb8, 0xc5, 0x7e, 0x83, 0x3c, 0x41,
-	0x99, 0x3f, 0x56, 0x0d, 0xdd, 0xb8, 0x74, 0xe5, 0xb9, 0x73, 0xef, 0xf9, 0x9d, 0xb9, 0xc7, 0xd8,
-	0xa8, 0x7f, 0x95, 0xa7, 0x40, 0x79, 0xcc, 0xe8, 0x2c, 0x7f, 0x87, 0x8b, 0x02, 0x47, 0x24, 0x4d,
-	0xf1, 0x4d, 0x3f, 0x84, 0x8c, 0xf4, 0x71, 0x04, 0x14, 0x78, 0xcc, 0xdd, 0xf1, 0x84, 0x65, 0xcc,
-	0x7c, 0xb0, 0x29, 0x71, 0x8b, 0xc2, 0x15, 0x12, 0x57, 0x4b, 0xda, 0xcd, 0x88, 0x45, 0x4c, 0xce,
-	0x63, 0x71, 0x52, 0xd2, 0xf6, 0x61, 0xc4, 0x58, 0x94, 0x00, 0x96, 0x55, 0x38, 0x1d, 0x61, 0x42,
-	0xf3, 0x75, 0x6b, 0xc8, 0x78, 0xca, 0x78, 0xa0, 0x34, 0xaa, 0xd0, 0x2d, 0x4b, 0x55, 0x38, 0x24,
-	0x1c, 0x8a, 0x37, 0x0d, 0x59, 0x4c, 0x55, 0xdf, 0xfe, 0x62, 0xa0, 0xff, 0xce, 0xd4, 0x13, 0x5f,
-	0x65, 0x24, 0x03, 0xf3, 0x18, 0xed, 0x8e, 0x19, 0x4b, 0x78, 0xcb, 0xe8, 0x96, 0x9d, 0xc6, 0x51,
-	0xd3, 0x55, 0xb6, 0xee, 0xda, 0xd6, 0x3d, 0xa1, 0xb9, 0x57, 0xff, 0xfa, 0xb9, 0xb7, 0x7b, 0xc1,
-	0x58, 0x72, 0xee, 0xab, 0x69, 0xd3, 0x41, 0x07, 0x14, 0x66, 0x59, 0x20, 0xaa, 0x80, 0x4e, 0xd3,
-	0x10, 0x26, 0xad, 0x9d, 0xae, 0xe1, 0x54, 0xfc, 0xff, 0xc5, 0xbd, 0x98, 0x7d, 0x29, 0x6f, 0xcd,
-	0x73, 0x54, 0x1d, 0x93, 0x09, 0x49, 0x79, 0xab, 0xdc, 0x35, 0x9c, 0xc6, 0xd1, 0x23, 0x77, 0x8b,
-	0x4c, 0xdc, 0x0b, 0x29, 0xf1, 0x2a, 0xf3, 0x45, 0xa7, 0xe4, 0x6b, 0x80, 0xfd, 0xb1, 0x8c, 0xaa,
-	0xaa, 0x61, 0x7e, 0x30, 0xd0, 0x3d, 0xe9, 0x3d, 0x9c, 0x00, 0xc9, 0x62, 0x46, 0x83, 0x11, 0x80,
-	0xde, 0xe1, 0xd0, 0xd5, 0x91, 0x88, 0x10, 0x0a, 0xe2, 0x29, 0x8b, 0xa9, 0xf7, 0x42, 0xf0, 0xee,
-	0x16, 0x9d, 0x56, 0x4e, 0xd2, 0xe4, 0xa9, 0xfd, 0x07, 0xc1, 0xfe, 0xf4, 0xa3, 0xe3, 0x44, 0x71,
-	0xf6, 0x66, 0x1a, 0xba, 0x43, 0x96, 0xea, 0x6c, 0xf5, 0x47, 0x8f, 0x5f, 0x5d, 0xe3, 0x2c, 0x1f,
-	0x03, 0x97, 0x30, 0xee, 0xef, 0x0b, 0xfd, 0xa9, 0x96, 0x0f, 0x40, 0x84, 0x79, 0x1f, 0x28, 0x09,
-	0x13, 0x08, 0xa2, 0x84, 0x85, 0x24, 0x51, 0xf1, 0x8c, 0x00, 0xb8, 0x0c, 0xa7, 0xe6, 0x37, 0x55,
-	0xfb, 0x4c, 0x76, 0x45, 0x48, 0x03, 0x00, 0x6e, 0x26, 0xa8, 0xa1, 0xe7, 0xe5, 0xa8, 0xca, 0x09,
-	0x6f, 0x95, 0x93, 0x22, 0x09, 0x8a, 0xd7, 0xd6, 0xbb, 0x99, 0x6a, 0xb7, 0x0d, 0xa2, 0xed, 0xa3,
-	0xa8, 0x98, 0x33, 0x03, 0x54, 0xcf, 0xc8, 0x35, 0x4c, 0x64, 0x62, 0x95, 0xae, 0xe1, 0xd4, 0x3d,
-	0x4f, 0x48, 0xbf, 0x2f, 0x3a, 0x0f, 0xb7, 0x58, 0xfd, 0x19, 0x0c, 0xef, 0x16, 0x9d, 0x03, 0x65,
-	0x52, 0x80, 0x6c, 0xbf, 0x26, 0xcf, 0x03, 0x00, 0x7b, 0x6e, 0x20, 0xf4, 0xfb, 0x5d, 0xe6, 0x25,
-	0xaa, 0xf1, 0xb7, 0x64, 0xac, 0xbf, 0x20, 0x61, 0x77, 0xf2, 0xd7, 0x76, 0xfb, 0xca, 0x6e, 0xcd,
-	0xb1, 0xfd, 0x3d, 0x71, 0x14, 0x91, 0x5f, 0xa2, 0x1a, 0xcc, 0xe2, 0x4c, 0xd2, 0x77, 0xfe, 0x8d,
-	0xbe, 0xe6, 0xd8, 0xfe, 0x9e, 0x38, 0x0e, 0x00, 0xbc, 0xe7, 0xf3, 0xa5, 0x65, 0xdc, 0x2e, 0x2d,
-	0xe3, 0xe7, 0xd2, 0x32, 0xde, 0xaf, 0xac, 0xd2, 0xed, 0xca, 0x2a, 0x7d, 0x5b, 0x59, 0xa5, 0xd7,
-	0x8f, 0x37, 0xe8, 0x92, 0x1a, 0xf3, 0x5e, 0x42, 0x42, 0xbe, 0x2e, 0xf0, 0x4d, 0xff, 0x18, 0xcf,
-	0xd4, 0x7f, 0x83, 0xf4, 0x0a, 0xab, 0xf2, 0x27, 0xf5, 0xe4, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x00, 0x4e, 0x39, 0x3b, 0x47, 0x04, 0x00, 0x00,
+	// 1065 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0xdc, 0xc4,
+	0x1b, 0x8e, 0xf3, 0x77, 0x33, 0xa9, 0xf2, 0x67, 0x92, 0x5f, 0x7f, 0xdb, 0xd0, 0xee, 0x06, 0x23,
+	0x50, 0x24, 0x14, 0xbb, 0x09, 0x0a, 0x07, 0x6e, 0x71, 0x9b, 0x94, 0xa0, 0x00, 0x65, 0x82, 0x54,
+	0x09, 0x15, 0xac, 0xb1, 0x77, 0xe2, 0x1d, 0xd5, 0x9e, 0x59, 0x3c, 0xb3, 0xe9, 0x2e, 0xe7, 0x9e,
+	0x38, 0x21, 0x71, 0xe1, 0x0b, 0x70, 0xe1, 0x8c, 0xe0, 0x2b, 0x44, 0x9c, 0x7a, 0x44, 0x1c, 0xb6,
+	0x28, 0xf9, 0x06, 0xb9, 0x70, 0x45, 0xf3, 0xc7, 0x8e, 0x93, 0xa0, 0x68, 0xa3, 0x3d, 0xad, 0xe7,
+	0x9d, 0xf7, 0x7d, 0x9e, 0xf7, 0x79, 0x66, 0x5e, 0xaf, 0xc1, 0x66, 0xab, 0x9f, 0x11, 0x26, 0x28,
+	0x67, 0xbd, 0xfe, 0x77, 0x7e, 0xb9, 0xf0, 0x13, 0x9c, 0x65, 0xfe, 0xf1, 0x66, 0x44, 0x24, 0xde,
+	0xf4, 0x13, 0xc2, 0x88, 0xa0, 0xc2, 0xeb, 0xe4, 0x5c, 0x72, 0xf8, 0x4e, 0xb5, 0xc4, 0x2b, 0x17,
+	0x9e, 0x2a, 0xf1, 0x6c, 0xc9, 0xea, 0x4a, 0xc2, 0x13, 0xae, 0xf3, 0x7d, 0xf5, 0x64, 0x4a, 0x57,
+	0xef, 0x25, 0x9c, 0x27, 0x29, 0xf1, 0xf5, 0x2a, 0xea, 0x1e, 0xf9, 0x98, 0xf5, 0x8b, 0xad, 0x98,
+	0x8b, 0x8c, 0x8b, 0xd0, 0xd4, 0x98, 0x85, 0xdd, 0x6a, 0x98, 0x95, 0x1f, 0x61, 0x41, 0xca, 0x9e,
+	0x62, 0x4e, 0x59, 0xb1, 0x7f, 0x15, 0xb5, 0xd5, 0xcd, 0xb1, 0x54, 0x2d, 0x99, 0xfd, 0x87, 0xc3,
+	0x68, 0x14, 0x6d, 0x9c, 0x93, 0x96, 0xa9, 0x70, 0x7f, 0x9e, 0x02, 0x77, 0x9e, 0x18, 0xd1, 0x87,
+	0x12, 0x4b, 0x02, 0xb7, 0xc1, 0x54, 0x87, 0xf3, 0x54, 0xd4, 0x9d, 0xb5, 0x89, 0xf5, 0xb9, 0xad,
+	0x15, 0xcf, 0x50, 0x7a, 0x05, 0xa5, 0xb7, 0xc3, 0xfa, 0xc1, 0xec, 0x1f, 0xbf, 0x6e, 0x4c, 0x3d,
+	0xe5, 0x3c, 0xdd, 0x47, 0x26, 0x1b, 0xae, 0x83, 0x45, 0x46, 0x7a, 0x32, 0x54, 0xab, 0x90, 0x75,
+	0xb3, 0x88, 0xe4, 0xf5, 0xf1, 0x35, 0x67, 0x7d, 0x12, 0xcd, 0xab, 0xb8, 0xca, 0xfd, 0x4c, 0x47,
+	0xe1, 0x3e, 0x98, 0xee, 0xe0, 0x1c, 0x67, 0xa2, 0x3e, 0xb1, 0xe6, 0xac, 0xcf, 0x6d, 0xbd, 0xef,
+	0x0d, 0xe1, 0xb2, 0xf7, 0x54, 0x97, 0x04, 0x93, 0x27, 0x83, 0xe6, 0x18, 0xb2, 0x00, 0x30, 0x02,
+	0x4b, 0x19, 0x4d, 0x8c, 0x03, 0x61, 0x4e, 0x62, 0x9e, 0xb7, 0x44, 0x7d, 0x52, 0xa3, 0x6e, 0x0f,
+	0x85, 0xfa, 0x69, 0x51, 0x8d, 0x4c, 0x31, 0x5a, 0xcc, 0xae, 0x44, 0x20, 0x06, 0x0b, 0x31, 0x66,
+	0x9c, 0xd1, 0x18, 0xa7, 0xa1, 0x71, 0x66, 0x4a, 0x3b, 0xb3, 0x35, 0x14, 0xc3, 0xa3, 0xa2, 0x56,
+	0x39, 0x60, 0xdb, 0x9f, 0x8f, 0xab, 0x41, 0x01, 0x0f, 0x01, 0xd0, 0xb6, 0x09, 0x89, 0xa5, 0xa8,
+	0x4f, 0x6b, 0x74, 0x6f, 0x38, 0x57, 0x38, 0x4f, 0xd5, 0xb1, 0x15, 0xc6, 0xcc, 0x76, 0x8a, 0x00,
+	0xcc, 0x40, 0x3d, 0xee, 0xe6, 0x39, 0x61, 0x32, 0x24, 0x1d, 0x1e, 0xb7, 0xc3, 0x0a, 0xc5, 0xcc,
+	0x08, 0x14, 0xff, 0xb3, 0xa8, 0xbb, 0x0a, 0xb4, 0xdc, 0x84, 0xdf, 0x80, 0xc5, 0x6b, 0x34, 0xb5,
+	0x11, 0x68, 0xe6, 0xc9, 0x25, 0x7c, 0xf7, 0x9f, 0x19, 0x30, 0x6d, 0xee, 0x00, 0xfc, 0xd1, 0x01,
+	0x4b, 0x9a, 0x25, 0xce, 0x89, 0x39, 0xfa, 0x23, 0x42, 0xec, 0x75, 0xbd, 0xe7, 0xd9, 0x79, 0x52,
+	0x13, 0x74, 0x71, 0x08, 0x9c, 0xb2, 0xe0, 0x40, 0xe1, 0x9e, 0x0f, 0x9a, 0xf5, 0x3e, 0xce, 0xd2,
+	0x8f, 0xdc, 0x6b, 0x08, 0xee, 0x2f, 0x6f, 0x9a, 0xeb, 0x09, 0x95, 0xed, 0x6e, 0xe4, 0xc5, 0x3c,
+	0xb3, 0x83, 0x69, 0x7f, 0x36, 0x44, 0xeb, 0x85, 0x2f, 0xfb, 0x1d, 0x22, 0x34, 0x98, 0x40, 0x0b,
+	0xaa, 0xfe, 0x91, 0x2d, 0xdf, 0x23, 0x6a, 0x6e, 0xfe, 0x4f, 0x18, 0x8e, 0x52, 0x12, 0x26, 0x29,
+	0x8f, 0xec, 0x5d, 0x51, 0xb8, 0x42, 0xcf, 0x41, 0x0d, 0xad, 0x98, 0xed, 0x27, 0x7a, 0x57, 0x09,
+	0xdb, 0x23, 0x44, 0xc0, 0x14, 0xcc, 0xd9, 0x7c, 0x9d, 0x6a, 0x46, 0xc2, 0x1f, 0xca, 0x32, 0x83,
+	0xa4, 0x50, 0x82, 0x55, 0xab, 0x0d, 0x1a, 0x6d, 0x15, 0x44, 0x17, 0x81, 0xa4, 0xcc, 0x83, 0x21,
+	0x98, 0x95, 0xf8, 0x05, 0xc9, 0xb5, 0x63, 0x6a, 0x50, 0x66, 0x83, 0x40, 0x95, 0xfe, 0x35, 0x68,
+	0xbe, 0x37, 0x84, 0xf4, 0xc7, 0x24, 0x3e, 0x1f, 0x34, 0x17, 0x0d, 0x49, 0x09, 0xe4, 0xa2, 0x9a,
+	0x7e, 0x56, 0x2e, 0x7c, 0xef, 0x80, 0xe5, 0x84, 0x1f, 0x93, 0x9c, 0x71, 0xbd, 0x17, 0x46, 0xbc,
+	0xcb, 0x5a, 0x6a, 0x64, 0x94, 0xae, 0x0f, 0x87, 0xd3, 0x65, 0xeb, 0xf7, 0x08, 0x09, 0x74, 0x75,
+	0xe0, 0x5a, 0x79, 0xab, 0x56, 0xde, 0x75, 0x02, 0x17, 0x2d, 0x25, 0x57, 0xcb, 0xe0, 0x6f, 0x0e,
+	0x68, 0xa4, 0xf4, 0xdb, 0x2e, 0x6d, 0x51, 0xd9, 0x0f, 0x23, 0xce, 0xa5, 0x90, 0x39, 0xee, 0x74,
+	0x28, 0x4b, 0x8a, 0xbe, 0xa6, 0x75, 0x5f, 0x3b, 0x43, 0xf5, 0x75, 0x50, 0x40, 0x05, 0x55, 0x24,
+	0xdb, 0xe2, 0x86, 0x6d, 0xf1, 0x5d, 0xd3, 0xe2, 0xcd, 0xb4, 0x2e, 0xba, 0x9f, 0xde, 0x00, 0xa6,
+	0x8e, 0x49, 0x49, 0x93, 0x94, 0xe4, 0x66, 0x58, 0x47, 0x38, 0xa6, 0x12, 0xc8, 0x45, 0xb5, 0x23,
+	0x42, 0xbe, 0x54, 0x8f, 0xf0, 0x19, 0xb8, 0xab, 0x47, 0xd4, 0xbe, 0x1a, 0x68, 0x8b, 0x30, 0x49,
+	0x8f, 0x28, 0xc9, 0xeb, 0x35, 0x7d, 0x29, 0xde, 0x3e, 0x1f, 0x34, 0x1f, 0x98, 0xfa, 0xff, 0xce,
+	0x73, 0xd1, 0x8a, 0xde, 0xd0, 0x6f, 0x81, 0xfd, 0x32, 0x0c, 0xbf, 0x00, 0x26, 0x1e, 0xb6, 0xa9,
+	0x90, 0x3c, 0xef, 0x87, 0x29, 0x61, 0x89, 0x6c, 0xd7, 0x67, 0xd5, 0x5f, 0x41, 0xd0, 0x3c, 0x1f,
+	0x34, 0xdf, 0xaa, 0xc2, 0x5e, 0xce, 0x72, 0x11, 0xd4, 0xe1, 0x8f, 0x4d, 0xf4, 0xc0, 0x04, 0x4f,
+	0x1c, 0x00, 0x2e, 0xae, 0x3a, 0x7c, 0x0e, 0x6a, 0xe2, 0x25, 0xee, 0xd8, 0x99, 0x57, 0xcd, 0xee,
+	0xdc, 0xda, 0x9a, 0x05, 0xdb, 0x83, 0xc5, 0x71, 0xd1, 0x8c, 0x7a, 0x54, 0xf7, 0xf7, 0x39, 0xa8,
+	0x91, 0x1e, 0x95, 0x1a, 0x7d, 0x7c, 0x34, 0xf4, 0x02, 0xc7, 0x45, 0x33, 0xea, 0x71, 0x8f, 0x10,
+	0xf7, 0xf7, 0x09, 0xb0, 0x74, 0xed, 0x76, 0xc3, 0x04, 0xdc, 0xc9, 0x28, 0x0b, 0xaf, 0xa8, 0xda,
+	0xbd, 0x35, 0xef, 0xb2, 0xe1, 0xad, 0x62, 0xb9, 0x08, 0x64, 0x94, 0x1d, 0x5a, 0x71, 0x8a, 0x08,
+	0xf7, 0x2e, 0x88, 0xc6, 0x47, 0x24, 0xc2, 0xbd, 0x4b, 0x44, 0xb8, 0x57, 0x25, 0xa2, 0x2c, 0x2c,
+	0x9d, 0x9c, 0x18, 0x5d, 0xd1, 0x85, 0x9b, 0x4a, 0xd1, 0xae, 0x31, 0xb4, 0x50, 0x54, 0x12, 0x4d,
+	0x8e, 0xae, 0xa8, 0x4a, 0x84, 0x7b, 0x96, 0xc8, 0x7d, 0x35, 0x0e, 0xee, 0xdf, 0x34, 0xff, 0xf0,
+	0x95, 0x03, 0xee, 0x6a, 0x43, 0x24, 0xce, 0x65, 0xf8, 0x92, 0xd0, 0xa4, 0x2d, 0x43, 0xfd, 0x25,
+	0x61, 0xcf, 0xf3, 0xf3, 0x5b, 0x37, 0xf5, 0xa0, 0x62, 0xf3, 0x35, 0x54, 0x17, 0x2d, 0x2b, 0xc3,
+	0x55, 0xfc, 0x99, 0x0e, 0x23, 0x15, 0x85, 0x5f, 0x1b, 0xe7, 0x8b, 0xcf, 0x42, 0x7d, 0xc4, 0xea,
+	0x5f, 0xf1, 0xea, 0x47, 0xdc, 0x63, 0x9b, 0x10, 0x34, 0xed, 0x7b, 0xab, 0x62, 0x75, 0x51, 0xec,
+	0xfe, 0xf4, 0xa6, 0xe9, 0xa0, 0xb9, 0x8c, 0xb2, 0x32, 0xfb, 0x93, 0x93, 0xd3, 0x86, 0xf3, 0xfa,
+	0xb4, 0xe1, 0xfc, 0x7d, 0xda, 0x70, 0x7e, 0x38, 0x6b, 0x8c, 0xbd, 0x3e, 0x6b, 0x8c, 0xfd, 0x79,
+	0xd6, 0x18, 0xfb, 0xea, 0x61, 0x45, 0x96, 0x96, 0x43, 0xc5, 0x46, 0x8a, 0x23, 0x51, 0x2c, 0xfc,
+	0xe3, 0xcd, 0x6d, 0xbf, 0x67, 0x3e, 0x44, 0xb5, 0xc8, 0x68, 0x5a, 0x37, 0xf3, 0xc1, 0xbf, 0x03,
+	0x00, 0x23, 0xb1, 0x81, 0x13, 0x98, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -258,6 +473,74 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EpochPoolStats) > 0 {
+		for iNdEx := len(m.EpochPoolStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochPoolStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CurrentEpochPoolStats) > 0 {
+		for iNdEx := len(m.CurrentEpochPoolStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentEpochPoolStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PoolStats) > 0 {
+		for iNdEx := len(m.PoolStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CanonicalPools) > 0 {
+		for iNdEx := len(m.CanonicalPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CanonicalPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MigrationRecords != nil {
+		{
+			size, err := m.MigrationRecords.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -310,6 +593,52 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StatsHistoryLength != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StatsHistoryLength))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.StatsEpochIdentifier) > 0 {
+		i -= len(m.StatsEpochIdentifier)
+		copy(dAtA[i:], m.StatsEpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StatsEpochIdentifier)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.FeeTiers) > 0 {
+		for iNdEx := len(m.FeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FeeTiers[iNdEx].Size()
+				i -= size
+				if _, err := m.FeeTiers[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.LiquidityBootstrappingBounds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.GovernorFeeBounds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size := m.TakerFee.Size()
 		i -= size
@@ -347,132 +676,943 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GlobalFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExitFee.Size()
+		i -= size
+		if _, err := m.ExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernorFeeBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernorFeeBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernorFeeBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxExitFee.Size()
+		i -= size
+		if _, err := m.MaxExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinExitFee.Size()
+		i -= size
+		if _, err := m.MinExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxSwapFee.Size()
+		i -= size
+		if _, err := m.MaxSwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinSwapFee.Size()
+		i -= size
+		if _, err := m.MinSwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityBootstrappingBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityBootstrappingBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityBootstrappingBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxStartWeightRatio.Size()
+		i -= size
+		if _, err := m.MaxStartWeightRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPoolNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPoolNumber))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MigrationRecords != nil {
+		l = m.MigrationRecords.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.CanonicalPools) > 0 {
+		for _, e := range m.CanonicalPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolStats) > 0 {
+		for _, e := range m.PoolStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CurrentEpochPoolStats) > 0 {
+		for _, e := range m.CurrentEpochPoolStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochPoolStats) > 0 {
+		for _, e := range m.EpochPoolStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EnableGlobalPoolFees {
+		n += 2
+	}
+	l = m.GlobalFees.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TakerFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.GovernorFeeBounds.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.LiquidityBootstrappingBounds.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeeTiers) > 0 {
+		for _, e := range m.FeeTiers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.StatsEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.StatsHistoryLength != 0 {
+		n += 1 + sovGenesis(uint64(m.StatsHistoryLength))
+	}
+	return n
+}
+
+func (m *GlobalFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GovernorFeeBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinSwapFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxSwapFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MinExitFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxExitFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *LiquidityBootstrappingBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxStartWeightRatio.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPoolNumber", wireType)
+			}
+			m.NextPoolNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPoolNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigrationRecords == nil {
+				m.MigrationRecords = &MigrationRecords{}
+			}
+			if err := m.MigrationRecords.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanonicalPools = append(m.CanonicalPools, CanonicalPool{})
+			if err := m.CanonicalPools[len(m.CanonicalPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolStats = append(m.PoolStats, PoolStats{})
+			if err := m.PoolStats[len(m.PoolStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochPoolStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentEpochPoolStats = append(m.CurrentEpochPoolStats, PoolStats{})
+			if err := m.CurrentEpochPoolStats[len(m.CurrentEpochPoolStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochPoolStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochPoolStats = append(m.EpochPoolStats, PoolStats{})
+			if err := m.EpochPoolStats[len(m.EpochPoolStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFee = append(m.PoolCreationFee, types1.Coin{})
+			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableGlobalPoolFees", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableGlobalPoolFees = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorFeeBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GovernorFeeBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityBootstrappingBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityBootstrappingBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTiers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.FeeTiers = append(m.FeeTiers, v)
+			if err := m.FeeTiers[len(m.FeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatsEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsHistoryLength", wireType)
+			}
+			m.StatsHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatsHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GlobalFees) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GlobalFees) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GlobalFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ExitFee.Size()
-		i -= size
-		if _, err := m.ExitFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.SwapFee.Size()
-		i -= size
-		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.NextPoolNumber != 0 {
-		n += 1 + sovGenesis(uint64(m.NextPoolNumber))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PoolCreationFee) > 0 {
-		for _, e := range m.PoolCreationFee {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.EnableGlobalPoolFees {
-		n += 2
-	}
-	l = m.GlobalFees.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.TakerFee.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func (m *GlobalFees) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.SwapFee.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.ExitFee.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *GlobalFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -495,17 +1635,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: GlobalFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GlobalFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -515,50 +1655,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPoolNumber", wireType)
-			}
-			m.NextPoolNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextPoolNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -568,22 +1689,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -608,7 +1730,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *GovernorFeeBounds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -631,17 +1753,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: GovernorFeeBounds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GovernorFeeBounds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -651,31 +1773,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCreationFee = append(m.PoolCreationFee, types1.Coin{})
-			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableGlobalPoolFees", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapFee", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -685,17 +1807,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableGlobalPoolFees = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExitFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -705,28 +1841,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GlobalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExitFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -754,7 +1891,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -779,7 +1916,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobalFees) Unmarshal(dAtA []byte) error {
+func (m *LiquidityBootstrappingBounds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -802,15 +1939,15 @@ func (m *GlobalFees) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalFees: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityBootstrappingBounds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityBootstrappingBounds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStartWeightRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -838,15 +1975,15 @@ func (m *GlobalFees) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxStartWeightRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDuration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -856,23 +1993,22 @@ func (m *GlobalFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -26,6 +27,18 @@ var (
 	// KeyTotalLiquidity defines key to store total liquidity.
 	KeyTotalLiquidity = []byte{0x03}
 	KeyMigrationInfo  = []byte{0x04}
+	// KeyPrefixDenomPairPools defines prefix to index the pools by the pairs of denoms they hold.
+	KeyPrefixDenomPairPools = []byte{0x05}
+	// KeyPrefixCanonicalPools defines prefix to store the canonical pool of each denom pair.
+	KeyPrefixCanonicalPools = []byte{0x06}
+	// KeyPrefixDenomPools defines prefix to index the pools by the denoms they hold.
+	KeyPrefixDenomPools = []byte{0x07}
+	// KeyPrefixPoolStats defines prefix to store the stats of each pool since it was created.
+	KeyPrefixPoolStats = []byte{0x08}
+	// KeyPrefixCurrentEpochPoolStats defines prefix to store the stats of each pool over the current epoch.
+	KeyPrefixCurrentEpochPoolStats = []byte{0x09}
+	// KeyPrefixEpochPoolStats defines prefix to store the stats of the pools over the last finished epochs.
+	KeyPrefixEpochPoolStats = []byte{0x0A}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -57,3 +70,64 @@ func GetPoolShareDenom(poolId uint64) string {
 func GetKeyPrefixPools(poolId uint64) []byte {
 	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
 }
+
+// GetKeyPrefixDenomPools returns the prefix of the keys indexing the pools holding the given denom.
+func GetKeyPrefixDenomPools(denom string) []byte {
+	return append(KeyPrefixDenomPools, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetKeyDenomPool returns the key indexing the given pool under the given denom.
+func GetKeyDenomPool(denom string, poolId uint64) []byte {
+	return append(GetKeyPrefixDenomPools(denom), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// OrderDenomPair returns the denoms of a pair in increasing order.
+func OrderDenomPair(denomA, denomB string) (string, string) {
+	if denomB < denomA {
+		return denomB, denomA
+	}
+	return denomA, denomB
+}
+
+// denomPairKey returns the key of the unordered pair of the given denoms,
+// made of the length prefixed ordered denoms.
+func denomPairKey(denomA, denomB string) []byte {
+	denomA, denomB = OrderDenomPair(denomA, denomB)
+	key := address.MustLengthPrefix([]byte(denomA))
+	return append(key, address.MustLengthPrefix([]byte(denomB))...)
+}
+
+// GetKeyPrefixDenomPairPools returns the prefix of the keys indexing the pools holding the given denoms.
+func GetKeyPrefixDenomPairPools(denomA, denomB string) []byte {
+	return append(KeyPrefixDenomPairPools, denomPairKey(denomA, denomB)...)
+}
+
+// GetKeyDenomPairPool returns the key indexing the given pool under the pair of the given denoms.
+func GetKeyDenomPairPool(denomA, denomB string, poolId uint64) []byte {
+	return append(GetKeyPrefixDenomPairPools(denomA, denomB), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyCanonicalPool returns the key storing the canonical pool of the pair of the given denoms.
+func GetKeyCanonicalPool(denomA, denomB string) []byte {
+	return append(KeyPrefixCanonicalPools, denomPairKey(denomA, denomB)...)
+}
+
+// GetKeyPoolStats returns the key storing the stats of the given pool since it was created.
+func GetKeyPoolStats(poolId uint64) []byte {
+	return append(KeyPrefixPoolStats, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyCurrentEpochPoolStats returns the key storing the stats of the given pool over the current epoch.
+func GetKeyCurrentEpochPoolStats(poolId uint64) []byte {
+	return append(KeyPrefixCurrentEpochPoolStats, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyPrefixEpochPoolStats returns the prefix of the keys storing the stats of the pools over the given epoch.
+func GetKeyPrefixEpochPoolStats(epochNumber int64) []byte {
+	return append(KeyPrefixEpochPoolStats, sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+// GetKeyEpochPoolStats returns the key storing the stats of the given pool over the given epoch.
+func GetKeyEpochPoolStats(epochNumber int64, poolId uint64) []byte {
+	return append(GetKeyPrefixEpochPoolStats(epochNumber), sdk.Uint64ToBigEndian(poolId)...)
+}
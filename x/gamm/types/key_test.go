@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"math"
 	"testing"
 
@@ -24,3 +25,14 @@ func TestGetPoolShareDenom(t *testing.T) {
 	require.NoError(t, sdk.ValidateDenom(denom))
 	require.Equal(t, "gamm/pool/18446744073709551615", denom)
 }
+
+func TestDenomIndexKeys(t *testing.T) {
+	// denoms are length prefixed, so a denom prefixing another one does not prefix its keys.
+	require.False(t, bytes.HasPrefix(types.GetKeyDenomPool("uatomx", 1), types.GetKeyPrefixDenomPools("uatom")))
+	require.True(t, bytes.HasPrefix(types.GetKeyDenomPool("uatom", 1), types.GetKeyPrefixDenomPools("uatom")))
+
+	// pairs are unordered.
+	require.Equal(t, types.GetKeyDenomPairPool("foo", "bar", 1), types.GetKeyDenomPairPool("bar", "foo", 1))
+	require.Equal(t, types.GetKeyCanonicalPool("foo", "bar"), types.GetKeyCanonicalPool("bar", "foo"))
+	require.NotEqual(t, types.GetKeyCanonicalPool("foo", "bar"), types.GetKeyCanonicalPool("foo", "baz"))
+}
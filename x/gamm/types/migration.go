@@ -0,0 +1,63 @@
+package types
+
+import (
+	fmt "fmt"
+)
+
+// Validate performs stateless validation of the migration records: every link must map
+// a pool to a different pool, and each pool can be migrated from and to by at most one link.
+func (r MigrationRecords) Validate() error {
+	oldPoolIds := make(map[uint64]bool)
+	newPoolIds := make(map[uint64]bool)
+	for _, link := range r.PoolMigrationLinks {
+		if link.OldPoolId == 0 || link.NewPoolId == 0 {
+			return fmt.Errorf("%w: pool ids must be positive, got %d -> %d", ErrInvalidMigrationRecords, link.OldPoolId, link.NewPoolId)
+		}
+		if link.OldPoolId == link.NewPoolId {
+			return fmt.Errorf("%w: pool %d is linked to itself", ErrInvalidMigrationRecords, link.OldPoolId)
+		}
+		if oldPoolIds[link.OldPoolId] {
+			return fmt.Errorf("%w: pool %d is migrated from by more than one link", ErrInvalidMigrationRecords, link.OldPoolId)
+		}
+		if newPoolIds[link.NewPoolId] {
+			return fmt.Errorf("%w: pool %d is migrated to by more than one link", ErrInvalidMigrationRecords, link.NewPoolId)
+		}
+		oldPoolIds[link.OldPoolId] = true
+		newPoolIds[link.NewPoolId] = true
+	}
+	return nil
+}
+
+// GetNewPoolId returns the pool the given pool is linked to, and false if it has no link.
+func (r MigrationRecords) GetNewPoolId(oldPoolId uint64) (uint64, bool) {
+	for _, link := range r.PoolMigrationLinks {
+		if link.OldPoolId == oldPoolId {
+			return link.NewPoolId, true
+		}
+	}
+	return 0, false
+}
+
+// Update returns the migration records with the links of the old pools of the given links
+// replaced by them. A link with a new pool id of 0 removes the link of its old pool.
+// The resulting records are not validated.
+func (r MigrationRecords) Update(links []PoolMigrationLink) MigrationRecords {
+	updates := make(map[uint64]uint64, len(links))
+	for _, link := range links {
+		updates[link.OldPoolId] = link.NewPoolId
+	}
+
+	updated := MigrationRecords{}
+	for _, link := range r.PoolMigrationLinks {
+		if _, ok := updates[link.OldPoolId]; ok {
+			continue
+		}
+		updated.PoolMigrationLinks = append(updated.PoolMigrationLinks, link)
+	}
+	for _, link := range links {
+		if link.NewPoolId != 0 {
+			updated.PoolMigrationLinks = append(updated.PoolMigrationLinks, link)
+		}
+	}
+	return updated
+}
@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+func TestMigrationRecordsValidate(t *testing.T) {
+	tests := map[string]struct {
+		links       []types.PoolMigrationLink
+		expectedErr bool
+	}{
+		"no links": {},
+		"valid links": {
+			links: []types.PoolMigrationLink{{OldPoolId: 1, NewPoolId: 2}, {OldPoolId: 2, NewPoolId: 3}},
+		},
+		"zero pool id": {
+			links:       []types.PoolMigrationLink{{OldPoolId: 1, NewPoolId: 0}},
+			expectedErr: true,
+		},
+		"pool linked to itself": {
+			links:       []types.PoolMigrationLink{{OldPoolId: 1, NewPoolId: 1}},
+			expectedErr: true,
+		},
+		"pool migrated from twice": {
+			links:       []types.PoolMigrationLink{{OldPoolId: 1, NewPoolId: 2}, {OldPoolId: 1, NewPoolId: 3}},
+			expectedErr: true,
+		},
+		"pool migrated to twice": {
+			links:       []types.PoolMigrationLink{{OldPoolId: 1, NewPoolId: 3}, {OldPoolId: 2, NewPoolId: 3}},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := types.MigrationRecords{PoolMigrationLinks: tc.links}.Validate()
+			if tc.expectedErr {
+				require.ErrorIs(t, err, types.ErrInvalidMigrationRecords)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMigrationRecordsUpdate(t *testing.T) {
+	records := types.MigrationRecords{PoolMigrationLinks: []types.PoolMigrationLink{
+		{OldPoolId: 1, NewPoolId: 2},
+		{OldPoolId: 3, NewPoolId: 4},
+		{OldPoolId: 5, NewPoolId: 6},
+	}}
+
+	updated := records.Update([]types.PoolMigrationLink{
+		{OldPoolId: 1, NewPoolId: 0},
+		{OldPoolId: 3, NewPoolId: 7},
+		{OldPoolId: 8, NewPoolId: 9},
+	})
+
+	require.Equal(t, []types.PoolMigrationLink{
+		{OldPoolId: 5, NewPoolId: 6},
+		{OldPoolId: 3, NewPoolId: 7},
+		{OldPoolId: 8, NewPoolId: 9},
+	}, updated.PoolMigrationLinks)
+
+	newPoolId, found := updated.GetNewPoolId(3)
+	require.True(t, found)
+	require.Equal(t, uint64(7), newPoolId)
+	_, found = updated.GetNewPoolId(1)
+	require.False(t, found)
+}
@@ -19,31 +19,61 @@ const (
 	TypeMsgJoinSwapShareAmountOut  = "join_swap_share_amount_out"
 	TypeMsgExitSwapExternAmountOut = "exit_swap_extern_amount_out"
 	TypeMsgExitSwapShareAmountIn   = "exit_swap_share_amount_in"
+	TypeMsgReplaceMigrationRecords = "replace_migration_records"
+	TypeMsgUpdateMigrationRecords  = "update_migration_records"
+	TypeMsgMigrateShares           = "migrate_shares"
+	TypeMsgMigrateLockedShares     = "migrate_locked_shares"
+	TypeMsgSetCanonicalPool        = "set_canonical_pool"
+	TypeMsgZapIn                   = "zap_in"
 )
 
 func ValidateFutureGovernor(governor string) error {
+	_, err := ParseFutureGovernor(governor)
+	return err
+}
+
+// FutureGovernor is the governor of a pool, parsed from its future governor string.
+// A pool is governed either by an address, or by the holders of LockDenom tokens
+// locked for LockDuration or longer.
+type FutureGovernor struct {
+	Address sdk.AccAddress
+	// LockDenom is empty if the governor only specifies a lock duration, in which case
+	// the governing tokens are the shares of the pool.
+	LockDenom    string
+	LockDuration time.Duration
+}
+
+// IsLockup returns true if the pool is governed by the holders of locked tokens.
+func (g FutureGovernor) IsLockup() bool {
+	return g.Address.Empty()
+}
+
+// ParseFutureGovernor parses a future governor, which is either empty, a bech32 address,
+// a lock duration, e.g. 100h, or a lock denom and a lock duration, e.g. token,100h.
+func ParseFutureGovernor(governor string) (FutureGovernor, error) {
 	// allow empty governor
 	if governor == "" {
-		return nil
+		return FutureGovernor{}, nil
 	}
 
 	// validation for future owner
-	_, err := sdk.AccAddressFromBech32(governor)
+	addr, err := sdk.AccAddressFromBech32(governor)
 	if err == nil {
-		return nil
+		return FutureGovernor{Address: addr}, nil
 	}
 
+	lockDenom := ""
 	lockTimeStr := ""
 	splits := strings.Split(governor, ",")
 	if len(splits) > 2 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
+		return FutureGovernor{}, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
 	}
 
 	// token,100h
 	if len(splits) == 2 {
-		lpTokenStr := splits[0]
-		if sdk.ValidateDenom(lpTokenStr) != nil {
-			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
+		lockDenom = splits[0]
+		if sdk.ValidateDenom(lockDenom) != nil {
+			return FutureGovernor{}, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
 		}
 		lockTimeStr = splits[1]
 	}
@@ -54,11 +84,11 @@ func ValidateFutureGovernor(governor string) error {
 	}
 
 	// Note that a duration of 0 is allowed
-	_, err = time.ParseDuration(lockTimeStr)
+	lockDuration, err := time.ParseDuration(lockTimeStr)
 	if err != nil {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
+		return FutureGovernor{}, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
 	}
-	return nil
+	return FutureGovernor{LockDenom: lockDenom, LockDuration: lockDuration}, nil
 }
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -156,6 +186,12 @@ func (msg MsgJoinPool) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, tokenInMaxs.String())
 	}
 
+	if msg.SpotPriceBounds != nil {
+		if err := msg.SpotPriceBounds.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -190,6 +226,12 @@ func (msg MsgExitPool) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, tokenOutMins.String())
 	}
 
+	if msg.SpotPriceBounds != nil {
+		if err := msg.SpotPriceBounds.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -223,6 +265,12 @@ func (msg MsgJoinSwapExternAmountIn) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrNotPositiveCriteria, msg.ShareOutMinAmount.String())
 	}
 
+	if msg.SpotPriceBounds != nil {
+		if err := msg.SpotPriceBounds.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -332,6 +380,12 @@ func (msg MsgExitSwapShareAmountIn) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrNotPositiveCriteria, msg.TokenOutMinAmount.String())
 	}
 
+	if msg.SpotPriceBounds != nil {
+		if err := msg.SpotPriceBounds.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -346,3 +400,208 @@ func (msg MsgExitSwapShareAmountIn) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgReplaceMigrationRecords{}
+
+func (msg MsgReplaceMigrationRecords) Route() string { return RouterKey }
+func (msg MsgReplaceMigrationRecords) Type() string  { return TypeMsgReplaceMigrationRecords }
+func (msg MsgReplaceMigrationRecords) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	return MigrationRecords{PoolMigrationLinks: msg.Records}.Validate()
+}
+
+func (msg MsgReplaceMigrationRecords) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgReplaceMigrationRecords) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgUpdateMigrationRecords{}
+
+func (msg MsgUpdateMigrationRecords) Route() string { return RouterKey }
+func (msg MsgUpdateMigrationRecords) Type() string  { return TypeMsgUpdateMigrationRecords }
+func (msg MsgUpdateMigrationRecords) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	if len(msg.Records) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMigrationRecords, "no records to update")
+	}
+
+	oldPoolIds := make(map[uint64]bool, len(msg.Records))
+	for _, link := range msg.Records {
+		if link.OldPoolId == 0 {
+			return sdkerrors.Wrap(ErrInvalidMigrationRecords, "old pool id must be positive")
+		}
+		if link.OldPoolId == link.NewPoolId {
+			return sdkerrors.Wrapf(ErrInvalidMigrationRecords, "pool %d is linked to itself", link.OldPoolId)
+		}
+		if oldPoolIds[link.OldPoolId] {
+			return sdkerrors.Wrapf(ErrInvalidMigrationRecords, "pool %d is updated more than once", link.OldPoolId)
+		}
+		oldPoolIds[link.OldPoolId] = true
+	}
+
+	return nil
+}
+
+func (msg MsgUpdateMigrationRecords) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateMigrationRecords) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgMigrateShares{}
+
+func (msg MsgMigrateShares) Route() string { return RouterKey }
+func (msg MsgMigrateShares) Type() string  { return TypeMsgMigrateShares }
+func (msg MsgMigrateShares) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.SharesToMigrate.IsValid() || !msg.SharesToMigrate.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.SharesToMigrate.String())
+	}
+
+	if err := ValidatePoolShareDenom(msg.SharesToMigrate.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s is not a pool share denom", msg.SharesToMigrate.Denom)
+	}
+
+	if !msg.ShareOutMinAmount.IsPositive() {
+		return sdkerrors.Wrap(ErrNotPositiveCriteria, msg.ShareOutMinAmount.String())
+	}
+
+	return nil
+}
+
+func (msg MsgMigrateShares) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgMigrateShares) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMigrateLockedShares{}
+
+func (msg MsgMigrateLockedShares) Route() string { return RouterKey }
+func (msg MsgMigrateLockedShares) Type() string  { return TypeMsgMigrateLockedShares }
+func (msg MsgMigrateLockedShares) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.LockId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "lock id must be positive")
+	}
+
+	if !msg.ShareOutMinAmount.IsPositive() {
+		return sdkerrors.Wrap(ErrNotPositiveCriteria, msg.ShareOutMinAmount.String())
+	}
+
+	return nil
+}
+
+func (msg MsgMigrateLockedShares) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgMigrateLockedShares) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetCanonicalPool{}
+
+func (msg MsgSetCanonicalPool) Route() string { return RouterKey }
+func (msg MsgSetCanonicalPool) Type() string  { return TypeMsgSetCanonicalPool }
+func (msg MsgSetCanonicalPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	return NewCanonicalPool(msg.DenomA, msg.DenomB, msg.PoolId).Validate()
+}
+
+func (msg MsgSetCanonicalPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetCanonicalPool) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgZapIn{}
+
+func (msg MsgZapIn) Route() string { return RouterKey }
+func (msg MsgZapIn) Type() string  { return TypeMsgZapIn }
+func (msg MsgZapIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	err = SwapAmountInRoutes(msg.Routes).Validate()
+	if err != nil {
+		return err
+	}
+
+	if !msg.ShareOutMinAmount.IsPositive() {
+		return sdkerrors.Wrap(ErrNotPositiveCriteria, msg.ShareOutMinAmount.String())
+	}
+
+	if msg.LockDuration < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lock duration must not be negative, got %s", msg.LockDuration)
+	}
+
+	return nil
+}
+
+func (msg MsgZapIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgZapIn) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -359,6 +360,91 @@ func TestMsgJoinPool(t *testing.T) {
 			}),
 			expectPass: true,
 		},
+		{
+			name: "spot price bounds",
+			msg: createMsg(func(msg gammtypes.MsgJoinPool) gammtypes.MsgJoinPool {
+				msg.SpotPriceBounds = &gammtypes.SpotPriceBounds{
+					BaseAssetDenom:  "test1",
+					QuoteAssetDenom: "test2",
+					MinSpotPrice:    sdk.MustNewDecFromStr("0.5"),
+					MaxSpotPrice:    sdk.NewDec(2),
+				}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "only max spot price",
+			msg: createMsg(func(msg gammtypes.MsgJoinPool) gammtypes.MsgJoinPool {
+				msg.SpotPriceBounds = &gammtypes.SpotPriceBounds{
+					BaseAssetDenom:  "test1",
+					QuoteAssetDenom: "test2",
+					MaxSpotPrice:    sdk.NewDec(2),
+				}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "unset spot price bounds",
+			msg: createMsg(func(msg gammtypes.MsgJoinPool) gammtypes.MsgJoinPool {
+				msg.SpotPriceBounds = &gammtypes.SpotPriceBounds{
+					BaseAssetDenom:  "test1",
+					QuoteAssetDenom: "test2",
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "same spot price denoms",
+			msg: createMsg(func(msg gammtypes.MsgJoinPool) gammtypes.MsgJoinPool {
+				msg.SpotPriceBounds = &gammtypes.SpotPriceBounds{
+					BaseAssetDenom:  "test1",
+					QuoteAssetDenom: "test1",
+					MinSpotPrice:    sdk.MustNewDecFromStr("0.5"),
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid spot price denom",
+			msg: createMsg(func(msg gammtypes.MsgJoinPool) gammtypes.MsgJoinPool {
+				msg.SpotPriceBounds = &gammtypes.SpotPriceBounds{
+					BaseAssetDenom:  "1",
+					QuoteAssetDenom: "test2",
+					MinSpotPrice:    sdk.MustNewDecFromStr("0.5"),
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative min spot price",
+			msg: createMsg(func(msg gammtypes.MsgJoinPool) gammtypes.MsgJoinPool {
+				msg.SpotPriceBounds = &gammtypes.SpotPriceBounds{
+					BaseAssetDenom:  "test1",
+					QuoteAssetDenom: "test2",
+					MinSpotPrice:    sdk.NewDec(-1),
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "min spot price greater than max",
+			msg: createMsg(func(msg gammtypes.MsgJoinPool) gammtypes.MsgJoinPool {
+				msg.SpotPriceBounds = &gammtypes.SpotPriceBounds{
+					BaseAssetDenom:  "test1",
+					QuoteAssetDenom: "test2",
+					MinSpotPrice:    sdk.NewDec(3),
+					MaxSpotPrice:    sdk.NewDec(2),
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -863,7 +949,357 @@ func TestMsgExitSwapShareAmountIn(t *testing.T) {
 	}
 }
 
+func TestMsgMigrateShares(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+		properMsg := gammtypes.MsgMigrateShares{
+			Sender:            addr1,
+			SharesToMigrate:   sdk.NewCoin("gamm/pool/1", sdk.NewInt(100)),
+			ShareOutMinAmount: sdk.NewInt(100),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), gammtypes.RouterKey)
+	require.Equal(t, msg.Type(), "migrate_shares")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        gammtypes.MsgMigrateShares
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "not pool shares",
+			msg: createMsg(func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+				msg.SharesToMigrate = sdk.NewCoin("test", sdk.NewInt(100))
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero shares",
+			msg: createMsg(func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+				msg.SharesToMigrate.Amount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero criteria",
+			msg: createMsg(func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+				msg.ShareOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgUpdateMigrationRecords(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg gammtypes.MsgUpdateMigrationRecords) gammtypes.MsgUpdateMigrationRecords) gammtypes.MsgUpdateMigrationRecords {
+		properMsg := gammtypes.MsgUpdateMigrationRecords{
+			Authority: addr1,
+			Records:   []gammtypes.PoolMigrationLink{{OldPoolId: 1, NewPoolId: 2}, {OldPoolId: 3, NewPoolId: 0}},
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg gammtypes.MsgUpdateMigrationRecords) gammtypes.MsgUpdateMigrationRecords {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), gammtypes.RouterKey)
+	require.Equal(t, msg.Type(), "update_migration_records")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        gammtypes.MsgUpdateMigrationRecords
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg gammtypes.MsgUpdateMigrationRecords) gammtypes.MsgUpdateMigrationRecords {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid authority",
+			msg: createMsg(func(msg gammtypes.MsgUpdateMigrationRecords) gammtypes.MsgUpdateMigrationRecords {
+				msg.Authority = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "no records",
+			msg: createMsg(func(msg gammtypes.MsgUpdateMigrationRecords) gammtypes.MsgUpdateMigrationRecords {
+				msg.Records = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "pool linked to itself",
+			msg: createMsg(func(msg gammtypes.MsgUpdateMigrationRecords) gammtypes.MsgUpdateMigrationRecords {
+				msg.Records = []gammtypes.PoolMigrationLink{{OldPoolId: 1, NewPoolId: 1}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "pool updated twice",
+			msg: createMsg(func(msg gammtypes.MsgUpdateMigrationRecords) gammtypes.MsgUpdateMigrationRecords {
+				msg.Records = []gammtypes.PoolMigrationLink{{OldPoolId: 1, NewPoolId: 2}, {OldPoolId: 1, NewPoolId: 0}}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSetCanonicalPool(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+
+	createMsg := func(after func(msg gammtypes.MsgSetCanonicalPool) gammtypes.MsgSetCanonicalPool) gammtypes.MsgSetCanonicalPool {
+		properMsg := gammtypes.MsgSetCanonicalPool{
+			Authority: addr1,
+			PoolId:    1,
+			DenomA:    "foo",
+			DenomB:    "bar",
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg gammtypes.MsgSetCanonicalPool) gammtypes.MsgSetCanonicalPool {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), gammtypes.RouterKey)
+	require.Equal(t, msg.Type(), "set_canonical_pool")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        gammtypes.MsgSetCanonicalPool
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg gammtypes.MsgSetCanonicalPool) gammtypes.MsgSetCanonicalPool {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid authority",
+			msg: createMsg(func(msg gammtypes.MsgSetCanonicalPool) gammtypes.MsgSetCanonicalPool {
+				msg.Authority = "invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero pool id",
+			msg: createMsg(func(msg gammtypes.MsgSetCanonicalPool) gammtypes.MsgSetCanonicalPool {
+				msg.PoolId = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: createMsg(func(msg gammtypes.MsgSetCanonicalPool) gammtypes.MsgSetCanonicalPool {
+				msg.DenomB = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "same denoms",
+			msg: createMsg(func(msg gammtypes.MsgSetCanonicalPool) gammtypes.MsgSetCanonicalPool {
+				msg.DenomB = msg.DenomA
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 // Test authz serialize and de-serializes for gamm msg.
+func TestMsgZapIn(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg gammtypes.MsgZapIn) gammtypes.MsgZapIn) gammtypes.MsgZapIn {
+		properMsg := gammtypes.MsgZapIn{
+			Sender:            addr1,
+			PoolId:            2,
+			TokenIn:           sdk.NewCoin("test", sdk.NewInt(100)),
+			Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "test2"}},
+			ShareOutMinAmount: sdk.NewInt(100),
+			LockDuration:      time.Hour,
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg gammtypes.MsgZapIn) gammtypes.MsgZapIn {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), gammtypes.RouterKey)
+	require.Equal(t, msg.Type(), "zap_in")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        gammtypes.MsgZapIn
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg gammtypes.MsgZapIn) gammtypes.MsgZapIn {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "no lock",
+			msg: createMsg(func(msg gammtypes.MsgZapIn) gammtypes.MsgZapIn {
+				msg.LockDuration = 0
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg gammtypes.MsgZapIn) gammtypes.MsgZapIn {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero token in",
+			msg: createMsg(func(msg gammtypes.MsgZapIn) gammtypes.MsgZapIn {
+				msg.TokenIn.Amount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg gammtypes.MsgZapIn) gammtypes.MsgZapIn {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid route denom",
+			msg: createMsg(func(msg gammtypes.MsgZapIn) gammtypes.MsgZapIn {
+				msg.Routes[0].TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero criteria",
+			msg: createMsg(func(msg gammtypes.MsgZapIn) gammtypes.MsgZapIn {
+				msg.ShareOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative lock duration",
+			msg: createMsg(func(msg gammtypes.MsgZapIn) gammtypes.MsgZapIn {
+				msg.LockDuration = -time.Hour
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
 	addr1 := sdk.AccAddress(pk1.Address()).String()
@@ -949,6 +1385,17 @@ func TestAuthzMsg(t *testing.T) {
 				TokenInMaxAmount: sdk.NewInt(1),
 			},
 		},
+		{
+			name: "MsgZapIn",
+			gammMsg: &gammtypes.MsgZapIn{
+				Sender:            addr1,
+				PoolId:            2,
+				TokenIn:           coin,
+				Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "test"}},
+				ShareOutMinAmount: sdk.NewInt(1),
+				LockDuration:      time.Hour,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -2,9 +2,13 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	epochstypes "github.com/osmosis-labs/osmosis/v15/x/epochs/types"
 )
 
 // Parameter store keys.
@@ -13,6 +17,12 @@ var (
 	KeyEnabledGlobalFees = []byte("EnabledGlobalFees")
 	KeyGlobalFees        = []byte("GlobalPoolFees")
 	KeyTakerFees         = []byte("TakerFees")
+	KeyGovernorFeeBounds = []byte("GovernorFeeBounds")
+
+	KeyLiquidityBootstrappingBounds = []byte("LiquidityBootstrappingBounds")
+	KeyFeeTiers                     = []byte("FeeTiers")
+	KeyStatsEpochIdentifier         = []byte("StatsEpochIdentifier")
+	KeyStatsHistoryLength           = []byte("StatsHistoryLength")
 )
 
 // ParamTable for gamm module.
@@ -26,6 +36,14 @@ func NewParams(poolCreationFee sdk.Coins) Params {
 		EnableGlobalPoolFees: false,
 		GlobalFees:           GlobalFees{sdk.ZeroDec(), sdk.ZeroDec()},
 		TakerFee:             sdk.ZeroDec(),
+		GovernorFeeBounds:    GovernorFeeBounds{sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()},
+		LiquidityBootstrappingBounds: LiquidityBootstrappingBounds{
+			MaxStartWeightRatio: sdk.OneDec(),
+			MinDuration:         0,
+		},
+		FeeTiers:             []sdk.Dec{},
+		StatsEpochIdentifier: "day",
+		StatsHistoryLength:   1,
 	}
 }
 
@@ -36,6 +54,23 @@ func DefaultParams() Params {
 		EnableGlobalPoolFees: false,
 		GlobalFees:           GlobalFees{sdk.MustNewDecFromStr("0.02"), sdk.ZeroDec()},
 		TakerFee:             sdk.MustNewDecFromStr("0.01"),
+		GovernorFeeBounds: GovernorFeeBounds{
+			MinSwapFee: sdk.ZeroDec(),
+			MaxSwapFee: sdk.MustNewDecFromStr("0.05"),
+			MinExitFee: sdk.ZeroDec(),
+			MaxExitFee: sdk.MustNewDecFromStr("0.01"),
+		},
+		LiquidityBootstrappingBounds: LiquidityBootstrappingBounds{
+			MaxStartWeightRatio: sdk.NewDec(99),
+			MinDuration:         24 * time.Hour,
+		},
+		FeeTiers: []sdk.Dec{
+			sdk.MustNewDecFromStr("0.001"),
+			sdk.MustNewDecFromStr("0.003"),
+			sdk.MustNewDecFromStr("0.01"),
+		},
+		StatsEpochIdentifier: "day",
+		StatsHistoryLength:   30,
 	}
 }
 
@@ -47,6 +82,21 @@ func (p Params) Validate() error {
 	if err := validateGlobalFees(p.GlobalFees); err != nil {
 		return err
 	}
+	if err := validateGovernorFeeBounds(p.GovernorFeeBounds); err != nil {
+		return err
+	}
+	if err := validateLiquidityBootstrappingBounds(p.LiquidityBootstrappingBounds); err != nil {
+		return err
+	}
+	if err := validateFeeTiers(p.FeeTiers); err != nil {
+		return err
+	}
+	if err := epochstypes.ValidateEpochIdentifierString(p.StatsEpochIdentifier); err != nil {
+		return err
+	}
+	if err := validateStatsHistoryLength(p.StatsHistoryLength); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -58,6 +108,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyEnabledGlobalFees, &p.EnableGlobalPoolFees, func(value interface{}) error { return nil }),
 		paramtypes.NewParamSetPair(KeyGlobalFees, &p.GlobalFees, validateGlobalFees),
 		paramtypes.NewParamSetPair(KeyTakerFees, &p.TakerFee, validateTakerFees),
+		paramtypes.NewParamSetPair(KeyGovernorFeeBounds, &p.GovernorFeeBounds, validateGovernorFeeBounds),
+		paramtypes.NewParamSetPair(KeyLiquidityBootstrappingBounds, &p.LiquidityBootstrappingBounds, validateLiquidityBootstrappingBounds),
+		paramtypes.NewParamSetPair(KeyFeeTiers, &p.FeeTiers, validateFeeTiers),
+		paramtypes.NewParamSetPair(KeyStatsEpochIdentifier, &p.StatsEpochIdentifier, epochstypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyStatsHistoryLength, &p.StatsHistoryLength, validateStatsHistoryLength),
 	}
 }
 
@@ -119,3 +174,129 @@ func validateTakerFees(i interface{}) error {
 
 	return nil
 }
+
+func validateGovernorFeeBounds(i interface{}) error {
+	v, ok := i.(GovernorFeeBounds)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.MinSwapFee.IsNil() || v.MaxSwapFee.IsNil() || v.MinExitFee.IsNil() || v.MaxExitFee.IsNil() {
+		return fmt.Errorf("invalid governor fee bounds: %+v", i)
+	}
+	if v.MinSwapFee.IsNegative() {
+		return ErrNegativeSwapFee
+	}
+	if v.MaxSwapFee.GTE(sdk.OneDec()) {
+		return ErrTooMuchSwapFee
+	}
+	if v.MinSwapFee.GT(v.MaxSwapFee) {
+		return fmt.Errorf("min swap fee (%s) must not be greater than max swap fee (%s)", v.MinSwapFee, v.MaxSwapFee)
+	}
+	if v.MinExitFee.IsNegative() {
+		return ErrNegativeExitFee
+	}
+	if v.MaxExitFee.GTE(sdk.OneDec()) {
+		return ErrTooMuchExitFee
+	}
+	if v.MinExitFee.GT(v.MaxExitFee) {
+		return fmt.Errorf("min exit fee (%s) must not be greater than max exit fee (%s)", v.MinExitFee, v.MaxExitFee)
+	}
+
+	return nil
+}
+
+func validateLiquidityBootstrappingBounds(i interface{}) error {
+	v, ok := i.(LiquidityBootstrappingBounds)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.MaxStartWeightRatio.IsNil() || v.MaxStartWeightRatio.LT(sdk.OneDec()) {
+		return fmt.Errorf("max start weight ratio (%s) must be at least 1", v.MaxStartWeightRatio)
+	}
+	if v.MinDuration < 0 {
+		return fmt.Errorf("min duration (%s) must not be negative", v.MinDuration)
+	}
+
+	return nil
+}
+
+func validateFeeTiers(i interface{}) error {
+	v, ok := i.([]sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for j, feeTier := range v {
+		if feeTier.IsNil() {
+			return fmt.Errorf("invalid fee tiers: %+v", i)
+		}
+		if feeTier.IsNegative() {
+			return ErrNegativeSwapFee
+		}
+		if feeTier.GTE(sdk.OneDec()) {
+			return ErrTooMuchSwapFee
+		}
+		for _, other := range v[:j] {
+			if feeTier.Equal(other) {
+				return fmt.Errorf("duplicate fee tier %s", feeTier)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateStatsHistoryLength(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("stats history length must be positive")
+	}
+
+	return nil
+}
+
+// IsFeeTier returns true if the given swap fee is one of the fee tiers.
+func (p Params) IsFeeTier(swapFee sdk.Dec) bool {
+	for _, feeTier := range p.FeeTiers {
+		if feeTier.Equal(swapFee) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateFees checks that the given swap and exit fees are within the bounds.
+func (b GovernorFeeBounds) ValidateFees(swapFee, exitFee sdk.Dec) error {
+	if swapFee.LT(b.MinSwapFee) || swapFee.GT(b.MaxSwapFee) {
+		return sdkerrors.Wrapf(ErrFeeOutOfGovernorBounds, "swap fee (%s) must be between %s and %s", swapFee, b.MinSwapFee, b.MaxSwapFee)
+	}
+	if exitFee.LT(b.MinExitFee) || exitFee.GT(b.MaxExitFee) {
+		return sdkerrors.Wrapf(ErrFeeOutOfGovernorBounds, "exit fee (%s) must be between %s and %s", exitFee, b.MinExitFee, b.MaxExitFee)
+	}
+	return nil
+}
+
+// ValidateSale checks that the ratio of the larger to the smaller of the starting weights,
+// and the duration of a liquidity bootstrapping pool sale are within the bounds.
+func (b LiquidityBootstrappingBounds) ValidateSale(startWeights []sdk.Int, duration time.Duration) error {
+	if len(startWeights) == 0 {
+		return sdkerrors.Wrap(ErrInvalidLiquidityBootstrapping, "no starting weights")
+	}
+	minWeight, maxWeight := startWeights[0], startWeights[0]
+	for _, weight := range startWeights[1:] {
+		minWeight = sdk.MinInt(minWeight, weight)
+		maxWeight = sdk.MaxInt(maxWeight, weight)
+	}
+	if !minWeight.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidLiquidityBootstrapping, "starting weights must be positive, got %s", minWeight)
+	}
+	if ratio := sdk.NewDecFromInt(maxWeight).QuoInt(minWeight); ratio.GT(b.MaxStartWeightRatio) {
+		return sdkerrors.Wrapf(ErrInvalidLiquidityBootstrapping, "starting weight ratio (%s) must not be greater than %s", ratio, b.MaxStartWeightRatio)
+	}
+	if duration < b.MinDuration {
+		return sdkerrors.Wrapf(ErrInvalidLiquidityBootstrapping, "sale duration (%s) must be at least %s", duration, b.MinDuration)
+	}
+	return nil
+}
@@ -109,6 +109,29 @@ type WeightedPoolExtension interface {
 	GetTokenWeight(denom string) (sdk.Int, error)
 }
 
+// AmplifiedPoolExtension is an extension of the PoolI interface
+// That defines an additional API for handling the pool's amplification coefficient.
+type AmplifiedPoolExtension interface {
+	CFMMPoolI
+
+	// PokePool determines if a pool's amplification needs to be updated and updates
+	// it if so.
+	PokePool(blockTime time.Time)
+
+	// GetAmplification returns the amplification coefficient of the pool.
+	GetAmplification() uint64
+}
+
+// DynamicSwapFeePoolExtension is an extension of the PoolI interface
+// for pools whose swap fee depends on the recent movement of their spot price.
+type DynamicSwapFeePoolExtension interface {
+	CFMMPoolI
+
+	// TrackPriceMovement records the move of the spot price of the pool since it was last
+	// tracked. It is called after each swap, and is a no-op if the swap fee of the pool is static.
+	TrackPriceMovement(ctx sdk.Context) error
+}
+
 func NewPoolAddress(poolId uint64) sdk.AccAddress {
 	return authtypes.NewModuleAddress(ModuleNameFromPoolId(poolId))
 }
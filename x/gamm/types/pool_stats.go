@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPoolStats returns the empty stats of the given pool over the given epoch.
+func NewPoolStats(poolId uint64, epochNumber int64) PoolStats {
+	return PoolStats{
+		PoolId:      poolId,
+		EpochNumber: epochNumber,
+		Volume:      sdk.Coins{},
+		SwapFees:    sdk.Coins{},
+		TakerFees:   sdk.Coins{},
+	}
+}
+
+// Validate performs stateless validation of the pool stats: the pool id must be positive,
+// the epoch number must not be negative, and the volume and fees must be valid coins.
+func (s PoolStats) Validate() error {
+	if s.PoolId == 0 {
+		return fmt.Errorf("%w: pool id must be positive", ErrInvalidPoolStats)
+	}
+	if s.EpochNumber < 0 {
+		return fmt.Errorf("%w: epoch number must not be negative, got %d", ErrInvalidPoolStats, s.EpochNumber)
+	}
+	if err := s.Volume.Validate(); err != nil {
+		return fmt.Errorf("%w: volume: %s", ErrInvalidPoolStats, err)
+	}
+	if err := s.SwapFees.Validate(); err != nil {
+		return fmt.Errorf("%w: swap fees: %s", ErrInvalidPoolStats, err)
+	}
+	if err := s.TakerFees.Validate(); err != nil {
+		return fmt.Errorf("%w: taker fees: %s", ErrInvalidPoolStats, err)
+	}
+	return nil
+}
+
+// validatePoolStats validates each of the given pool stats, which must be of distinct pools per epoch.
+func validatePoolStats(stats []PoolStats) error {
+	seen := make(map[[2]uint64]bool, len(stats))
+	for _, s := range stats {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		key := [2]uint64{uint64(s.EpochNumber), s.PoolId}
+		if seen[key] {
+			return fmt.Errorf("%w: duplicate stats of pool %d over epoch %d", ErrInvalidPoolStats, s.PoolId, s.EpochNumber)
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -14,20 +14,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types2 "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -669,23 +673,23 @@ func (m *QueryPoolParamsResponse) GetParams() *types.Any {
 	return nil
 }
 
-// =============================== PoolLiquidity
-type QueryTotalPoolLiquidityRequest struct {
+// =============================== StableSwapAmplification
+type QueryStableSwapAmplificationRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (m *QueryTotalPoolLiquidityRequest) Reset()         { *m = QueryTotalPoolLiquidityRequest{} }
-func (m *QueryTotalPoolLiquidityRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalPoolLiquidityRequest) ProtoMessage()    {}
-func (*QueryTotalPoolLiquidityRequest) Descriptor() ([]byte, []int) {
+func (m *QueryStableSwapAmplificationRequest) Reset()         { *m = QueryStableSwapAmplificationRequest{} }
+func (m *QueryStableSwapAmplificationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStableSwapAmplificationRequest) ProtoMessage()    {}
+func (*QueryStableSwapAmplificationRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e2e4a69339a7bfd, []int{14}
 }
-func (m *QueryTotalPoolLiquidityRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStableSwapAmplificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalPoolLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStableSwapAmplificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalPoolLiquidityRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStableSwapAmplificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -695,41 +699,50 @@ func (m *QueryTotalPoolLiquidityRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalPoolLiquidityRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalPoolLiquidityRequest.Merge(m, src)
+func (m *QueryStableSwapAmplificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStableSwapAmplificationRequest.Merge(m, src)
 }
-func (m *QueryTotalPoolLiquidityRequest) XXX_Size() int {
+func (m *QueryStableSwapAmplificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalPoolLiquidityRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalPoolLiquidityRequest.DiscardUnknown(m)
+func (m *QueryStableSwapAmplificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStableSwapAmplificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalPoolLiquidityRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryStableSwapAmplificationRequest proto.InternalMessageInfo
 
-func (m *QueryTotalPoolLiquidityRequest) GetPoolId() uint64 {
+func (m *QueryStableSwapAmplificationRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-type QueryTotalPoolLiquidityResponse struct {
-	Liquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
+type QueryStableSwapAmplificationResponse struct {
+	// amplification is the effective amplification at the current block time.
+	Amplification uint64 `protobuf:"varint,1,opt,name=amplification,proto3" json:"amplification,omitempty" yaml:"amplification"`
+	// ramping is true if the amplification is scheduled to change.
+	Ramping bool `protobuf:"varint,2,opt,name=ramping,proto3" json:"ramping,omitempty" yaml:"ramping"`
+	// The remaining fields describe the ramp in progress, and are only set if
+	// ramping is true.
+	InitialAmplification uint64    `protobuf:"varint,3,opt,name=initial_amplification,json=initialAmplification,proto3" json:"initial_amplification,omitempty" yaml:"initial_amplification"`
+	TargetAmplification  uint64    `protobuf:"varint,4,opt,name=target_amplification,json=targetAmplification,proto3" json:"target_amplification,omitempty" yaml:"target_amplification"`
+	RampStartTime        time.Time `protobuf:"bytes,5,opt,name=ramp_start_time,json=rampStartTime,proto3,stdtime" json:"ramp_start_time" yaml:"ramp_start_time"`
+	RampEndTime          time.Time `protobuf:"bytes,6,opt,name=ramp_end_time,json=rampEndTime,proto3,stdtime" json:"ramp_end_time" yaml:"ramp_end_time"`
 }
 
-func (m *QueryTotalPoolLiquidityResponse) Reset()         { *m = QueryTotalPoolLiquidityResponse{} }
-func (m *QueryTotalPoolLiquidityResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalPoolLiquidityResponse) ProtoMessage()    {}
-func (*QueryTotalPoolLiquidityResponse) Descriptor() ([]byte, []int) {
+func (m *QueryStableSwapAmplificationResponse) Reset()         { *m = QueryStableSwapAmplificationResponse{} }
+func (m *QueryStableSwapAmplificationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStableSwapAmplificationResponse) ProtoMessage()    {}
+func (*QueryStableSwapAmplificationResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e2e4a69339a7bfd, []int{15}
 }
-func (m *QueryTotalPoolLiquidityResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStableSwapAmplificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalPoolLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStableSwapAmplificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalPoolLiquidityResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStableSwapAmplificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -739,42 +752,79 @@ func (m *QueryTotalPoolLiquidityResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalPoolLiquidityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalPoolLiquidityResponse.Merge(m, src)
+func (m *QueryStableSwapAmplificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStableSwapAmplificationResponse.Merge(m, src)
 }
-func (m *QueryTotalPoolLiquidityResponse) XXX_Size() int {
+func (m *QueryStableSwapAmplificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalPoolLiquidityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalPoolLiquidityResponse.DiscardUnknown(m)
+func (m *QueryStableSwapAmplificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStableSwapAmplificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalPoolLiquidityResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStableSwapAmplificationResponse proto.InternalMessageInfo
 
-func (m *QueryTotalPoolLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryStableSwapAmplificationResponse) GetAmplification() uint64 {
 	if m != nil {
-		return m.Liquidity
+		return m.Amplification
 	}
-	return nil
+	return 0
 }
 
-// =============================== TotalShares
-type QueryTotalSharesRequest struct {
+func (m *QueryStableSwapAmplificationResponse) GetRamping() bool {
+	if m != nil {
+		return m.Ramping
+	}
+	return false
+}
+
+func (m *QueryStableSwapAmplificationResponse) GetInitialAmplification() uint64 {
+	if m != nil {
+		return m.InitialAmplification
+	}
+	return 0
+}
+
+func (m *QueryStableSwapAmplificationResponse) GetTargetAmplification() uint64 {
+	if m != nil {
+		return m.TargetAmplification
+	}
+	return 0
+}
+
+func (m *QueryStableSwapAmplificationResponse) GetRampStartTime() time.Time {
+	if m != nil {
+		return m.RampStartTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryStableSwapAmplificationResponse) GetRampEndTime() time.Time {
+	if m != nil {
+		return m.RampEndTime
+	}
+	return time.Time{}
+}
+
+// =============================== LiquidityBootstrappingPool
+type QueryLiquidityBootstrappingPoolRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (m *QueryTotalSharesRequest) Reset()         { *m = QueryTotalSharesRequest{} }
-func (m *QueryTotalSharesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalSharesRequest) ProtoMessage()    {}
-func (*QueryTotalSharesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryLiquidityBootstrappingPoolRequest) Reset() {
+	*m = QueryLiquidityBootstrappingPoolRequest{}
+}
+func (m *QueryLiquidityBootstrappingPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityBootstrappingPoolRequest) ProtoMessage()    {}
+func (*QueryLiquidityBootstrappingPoolRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e2e4a69339a7bfd, []int{16}
 }
-func (m *QueryTotalSharesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLiquidityBootstrappingPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLiquidityBootstrappingPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalSharesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLiquidityBootstrappingPoolRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -784,41 +834,50 @@ func (m *QueryTotalSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalSharesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalSharesRequest.Merge(m, src)
+func (m *QueryLiquidityBootstrappingPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityBootstrappingPoolRequest.Merge(m, src)
 }
-func (m *QueryTotalSharesRequest) XXX_Size() int {
+func (m *QueryLiquidityBootstrappingPoolRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalSharesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalSharesRequest.DiscardUnknown(m)
+func (m *QueryLiquidityBootstrappingPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityBootstrappingPoolRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalSharesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLiquidityBootstrappingPoolRequest proto.InternalMessageInfo
 
-func (m *QueryTotalSharesRequest) GetPoolId() uint64 {
+func (m *QueryLiquidityBootstrappingPoolRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-type QueryTotalSharesResponse struct {
-	TotalShares types1.Coin `protobuf:"bytes,1,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+type QueryLiquidityBootstrappingPoolResponse struct {
+	Creator     string    `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	StartTime   time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime     time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	EndBehavior string    `protobuf:"bytes,4,opt,name=end_behavior,json=endBehavior,proto3" json:"end_behavior,omitempty" yaml:"end_behavior"`
+	// sale_ended is true once the block time reached end_time.
+	SaleEnded bool `protobuf:"varint,5,opt,name=sale_ended,json=saleEnded,proto3" json:"sale_ended,omitempty" yaml:"sale_ended"`
+	// weights are the effective weights of the pool at the current block time.
+	Weights []TokenWeight `protobuf:"bytes,6,rep,name=weights,proto3" json:"weights" yaml:"weights"`
 }
 
-func (m *QueryTotalSharesResponse) Reset()         { *m = QueryTotalSharesResponse{} }
-func (m *QueryTotalSharesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalSharesResponse) ProtoMessage()    {}
-func (*QueryTotalSharesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryLiquidityBootstrappingPoolResponse) Reset() {
+	*m = QueryLiquidityBootstrappingPoolResponse{}
+}
+func (m *QueryLiquidityBootstrappingPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityBootstrappingPoolResponse) ProtoMessage()    {}
+func (*QueryLiquidityBootstrappingPoolResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e2e4a69339a7bfd, []int{17}
 }
-func (m *QueryTotalSharesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryLiquidityBootstrappingPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLiquidityBootstrappingPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalSharesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLiquidityBootstrappingPoolResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -828,95 +887,80 @@ func (m *QueryTotalSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalSharesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalSharesResponse.Merge(m, src)
+func (m *QueryLiquidityBootstrappingPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityBootstrappingPoolResponse.Merge(m, src)
 }
-func (m *QueryTotalSharesResponse) XXX_Size() int {
+func (m *QueryLiquidityBootstrappingPoolResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalSharesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalSharesResponse.DiscardUnknown(m)
+func (m *QueryLiquidityBootstrappingPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityBootstrappingPoolResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalSharesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLiquidityBootstrappingPoolResponse proto.InternalMessageInfo
 
-func (m *QueryTotalSharesResponse) GetTotalShares() types1.Coin {
+func (m *QueryLiquidityBootstrappingPoolResponse) GetCreator() string {
 	if m != nil {
-		return m.TotalShares
+		return m.Creator
 	}
-	return types1.Coin{}
+	return ""
 }
 
-// =============================== CalcJoinPoolNoSwapShares
-type QueryCalcJoinPoolNoSwapSharesRequest struct {
-	PoolId   uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	TokensIn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tokens_in,json=tokensIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_in"`
+func (m *QueryLiquidityBootstrappingPoolResponse) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) Reset()         { *m = QueryCalcJoinPoolNoSwapSharesRequest{} }
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCalcJoinPoolNoSwapSharesRequest) ProtoMessage()    {}
-func (*QueryCalcJoinPoolNoSwapSharesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e2e4a69339a7bfd, []int{18}
-}
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *QueryLiquidityBootstrappingPoolResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-}
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.Merge(m, src)
-}
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.DiscardUnknown(m)
+	return time.Time{}
 }
 
-var xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest proto.InternalMessageInfo
+func (m *QueryLiquidityBootstrappingPoolResponse) GetEndBehavior() string {
+	if m != nil {
+		return m.EndBehavior
+	}
+	return ""
+}
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) GetPoolId() uint64 {
+func (m *QueryLiquidityBootstrappingPoolResponse) GetSaleEnded() bool {
 	if m != nil {
-		return m.PoolId
+		return m.SaleEnded
 	}
-	return 0
+	return false
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) GetTokensIn() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryLiquidityBootstrappingPoolResponse) GetWeights() []TokenWeight {
 	if m != nil {
-		return m.TokensIn
+		return m.Weights
 	}
 	return nil
 }
 
-type QueryCalcJoinPoolNoSwapSharesResponse struct {
-	TokensOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tokens_out,json=tokensOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_out" yaml:"tokens_out"`
-	SharesOut github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,2,opt,name=shares_out,json=sharesOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shares_out"`
+type TokenWeight struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Weight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"weight" yaml:"weight"`
+	// normalized_weight is the share of the weight in the total weight of the
+	// pool.
+	NormalizedWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=normalized_weight,json=normalizedWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"normalized_weight" yaml:"normalized_weight"`
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) Reset()         { *m = QueryCalcJoinPoolNoSwapSharesResponse{} }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCalcJoinPoolNoSwapSharesResponse) ProtoMessage()    {}
-func (*QueryCalcJoinPoolNoSwapSharesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e2e4a69339a7bfd, []int{19}
+func (m *TokenWeight) Reset()         { *m = TokenWeight{} }
+func (m *TokenWeight) String() string { return proto.CompactTextString(m) }
+func (*TokenWeight) ProtoMessage()    {}
+func (*TokenWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{18}
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Unmarshal(b []byte) error {
+func (m *TokenWeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TokenWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TokenWeight.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -926,47 +970,51 @@ func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.Merge(m, src)
+func (m *TokenWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenWeight.Merge(m, src)
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Size() int {
+func (m *TokenWeight) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.DiscardUnknown(m)
+func (m *TokenWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenWeight.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse proto.InternalMessageInfo
+var xxx_messageInfo_TokenWeight proto.InternalMessageInfo
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) GetTokensOut() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *TokenWeight) GetDenom() string {
 	if m != nil {
-		return m.TokensOut
+		return m.Denom
 	}
-	return nil
+	return ""
 }
 
-// QuerySpotPriceRequest defines the gRPC request structure for a SpotPrice
-// query.
-//
-// Deprecated: Do not use.
-type QuerySpotPriceRequest struct {
+// =============================== LiquidityBootstrappingPriceCurve
+type QueryLiquidityBootstrappingPriceCurveRequest struct {
 	PoolId          uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 	BaseAssetDenom  string `protobuf:"bytes,2,opt,name=base_asset_denom,json=baseAssetDenom,proto3" json:"base_asset_denom,omitempty" yaml:"base_asset_denom"`
 	QuoteAssetDenom string `protobuf:"bytes,3,opt,name=quote_asset_denom,json=quoteAssetDenom,proto3" json:"quote_asset_denom,omitempty" yaml:"quote_asset_denom"`
+	// num_points is the number of points of the curve, from the start to the
+	// end of the sale. It must be between 2 and 100.
+	NumPoints uint64 `protobuf:"varint,4,opt,name=num_points,json=numPoints,proto3" json:"num_points,omitempty" yaml:"num_points"`
 }
 
-func (m *QuerySpotPriceRequest) Reset()         { *m = QuerySpotPriceRequest{} }
-func (m *QuerySpotPriceRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySpotPriceRequest) ProtoMessage()    {}
-func (*QuerySpotPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e2e4a69339a7bfd, []int{20}
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) Reset() {
+	*m = QueryLiquidityBootstrappingPriceCurveRequest{}
 }
-func (m *QuerySpotPriceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryLiquidityBootstrappingPriceCurveRequest) ProtoMessage() {}
+func (*QueryLiquidityBootstrappingPriceCurveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{19}
+}
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySpotPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySpotPriceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -976,59 +1024,66 @@ func (m *QuerySpotPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QuerySpotPriceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySpotPriceRequest.Merge(m, src)
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveRequest.Merge(m, src)
 }
-func (m *QuerySpotPriceRequest) XXX_Size() int {
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySpotPriceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySpotPriceRequest.DiscardUnknown(m)
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySpotPriceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveRequest proto.InternalMessageInfo
 
-func (m *QuerySpotPriceRequest) GetPoolId() uint64 {
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *QuerySpotPriceRequest) GetBaseAssetDenom() string {
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) GetBaseAssetDenom() string {
 	if m != nil {
 		return m.BaseAssetDenom
 	}
 	return ""
 }
 
-func (m *QuerySpotPriceRequest) GetQuoteAssetDenom() string {
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) GetQuoteAssetDenom() string {
 	if m != nil {
 		return m.QuoteAssetDenom
 	}
 	return ""
 }
 
-type QueryPoolsWithFilterRequest struct {
-	// String of the coins in single string seperated by comma. Ex)
-	// 10uatom,100uosmo
-	MinLiquidity string             `protobuf:"bytes,1,opt,name=min_liquidity,json=minLiquidity,proto3" json:"min_liquidity,omitempty" yaml:"min_liquidity"`
-	PoolType     string             `protobuf:"bytes,2,opt,name=pool_type,json=poolType,proto3" json:"pool_type,omitempty"`
-	Pagination   *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) GetNumPoints() uint64 {
+	if m != nil {
+		return m.NumPoints
+	}
+	return 0
 }
 
-func (m *QueryPoolsWithFilterRequest) Reset()         { *m = QueryPoolsWithFilterRequest{} }
-func (m *QueryPoolsWithFilterRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolsWithFilterRequest) ProtoMessage()    {}
-func (*QueryPoolsWithFilterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e2e4a69339a7bfd, []int{21}
+type QueryLiquidityBootstrappingPriceCurveResponse struct {
+	Points []PricePoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points" yaml:"points"`
 }
-func (m *QueryPoolsWithFilterRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) Reset() {
+	*m = QueryLiquidityBootstrappingPriceCurveResponse{}
+}
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryLiquidityBootstrappingPriceCurveResponse) ProtoMessage() {}
+func (*QueryLiquidityBootstrappingPriceCurveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{20}
+}
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolsWithFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolsWithFilterRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1038,57 +1093,42 @@ func (m *QueryPoolsWithFilterRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolsWithFilterRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolsWithFilterRequest.Merge(m, src)
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveResponse.Merge(m, src)
 }
-func (m *QueryPoolsWithFilterRequest) XXX_Size() int {
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolsWithFilterRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolsWithFilterRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryPoolsWithFilterRequest proto.InternalMessageInfo
-
-func (m *QueryPoolsWithFilterRequest) GetMinLiquidity() string {
-	if m != nil {
-		return m.MinLiquidity
-	}
-	return ""
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPoolsWithFilterRequest) GetPoolType() string {
-	if m != nil {
-		return m.PoolType
-	}
-	return ""
-}
+var xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveResponse proto.InternalMessageInfo
 
-func (m *QueryPoolsWithFilterRequest) GetPagination() *query.PageRequest {
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) GetPoints() []PricePoint {
 	if m != nil {
-		return m.Pagination
+		return m.Points
 	}
 	return nil
 }
 
-type QueryPoolsWithFilterResponse struct {
-	Pools []*types.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type PricePoint struct {
+	Time      time.Time                              `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	SpotPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=spot_price,json=spotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price" yaml:"spot_price"`
 }
 
-func (m *QueryPoolsWithFilterResponse) Reset()         { *m = QueryPoolsWithFilterResponse{} }
-func (m *QueryPoolsWithFilterResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolsWithFilterResponse) ProtoMessage()    {}
-func (*QueryPoolsWithFilterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e2e4a69339a7bfd, []int{22}
+func (m *PricePoint) Reset()         { *m = PricePoint{} }
+func (m *PricePoint) String() string { return proto.CompactTextString(m) }
+func (*PricePoint) ProtoMessage()    {}
+func (*PricePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{21}
 }
-func (m *QueryPoolsWithFilterResponse) XXX_Unmarshal(b []byte) error {
+func (m *PricePoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolsWithFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PricePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolsWithFilterResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PricePoint.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1098,53 +1138,43 @@ func (m *QueryPoolsWithFilterResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolsWithFilterResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolsWithFilterResponse.Merge(m, src)
+func (m *PricePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricePoint.Merge(m, src)
 }
-func (m *QueryPoolsWithFilterResponse) XXX_Size() int {
+func (m *PricePoint) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolsWithFilterResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolsWithFilterResponse.DiscardUnknown(m)
+func (m *PricePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricePoint.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolsWithFilterResponse proto.InternalMessageInfo
+var xxx_messageInfo_PricePoint proto.InternalMessageInfo
 
-func (m *QueryPoolsWithFilterResponse) GetPools() []*types.Any {
+func (m *PricePoint) GetTime() time.Time {
 	if m != nil {
-		return m.Pools
+		return m.Time
 	}
-	return nil
+	return time.Time{}
 }
 
-func (m *QueryPoolsWithFilterResponse) GetPagination() *query.PageResponse {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
-
-// QuerySpotPriceResponse defines the gRPC response structure for a SpotPrice
-// query.
-//
-// Deprecated: Do not use.
-type QuerySpotPriceResponse struct {
-	// String of the Dec. Ex) 10.203uatom
-	SpotPrice string `protobuf:"bytes,1,opt,name=spot_price,json=spotPrice,proto3" json:"spot_price,omitempty" yaml:"spot_price"`
+// =============================== CanonicalPool
+type QueryCanonicalPoolRequest struct {
+	DenomA string `protobuf:"bytes,1,opt,name=denom_a,json=denomA,proto3" json:"denom_a,omitempty" yaml:"denom_a"`
+	DenomB string `protobuf:"bytes,2,opt,name=denom_b,json=denomB,proto3" json:"denom_b,omitempty" yaml:"denom_b"`
 }
 
-func (m *QuerySpotPriceResponse) Reset()         { *m = QuerySpotPriceResponse{} }
-func (m *QuerySpotPriceResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySpotPriceResponse) ProtoMessage()    {}
-func (*QuerySpotPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e2e4a69339a7bfd, []int{23}
+func (m *QueryCanonicalPoolRequest) Reset()         { *m = QueryCanonicalPoolRequest{} }
+func (m *QueryCanonicalPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalPoolRequest) ProtoMessage()    {}
+func (*QueryCanonicalPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{22}
 }
-func (m *QuerySpotPriceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCanonicalPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySpotPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCanonicalPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySpotPriceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCanonicalPoolRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1154,45 +1184,48 @@ func (m *QuerySpotPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QuerySpotPriceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySpotPriceResponse.Merge(m, src)
+func (m *QueryCanonicalPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalPoolRequest.Merge(m, src)
 }
-func (m *QuerySpotPriceResponse) XXX_Size() int {
+func (m *QueryCanonicalPoolRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySpotPriceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySpotPriceResponse.DiscardUnknown(m)
+func (m *QueryCanonicalPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalPoolRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySpotPriceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCanonicalPoolRequest proto.InternalMessageInfo
 
-func (m *QuerySpotPriceResponse) GetSpotPrice() string {
+func (m *QueryCanonicalPoolRequest) GetDenomA() string {
 	if m != nil {
-		return m.SpotPrice
+		return m.DenomA
 	}
 	return ""
 }
 
-// =============================== EstimateSwapExactAmountIn
-type QuerySwapExactAmountInRequest struct {
-	Sender  string                     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	PoolId  uint64                     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	TokenIn string                     `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
-	Routes  []types2.SwapAmountInRoute `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+func (m *QueryCanonicalPoolRequest) GetDenomB() string {
+	if m != nil {
+		return m.DenomB
+	}
+	return ""
 }
 
-func (m *QuerySwapExactAmountInRequest) Reset()         { *m = QuerySwapExactAmountInRequest{} }
-func (m *QuerySwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySwapExactAmountInRequest) ProtoMessage()    {}
-func (*QuerySwapExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e2e4a69339a7bfd, []int{24}
+type QueryCanonicalPoolResponse struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
-func (m *QuerySwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryCanonicalPoolResponse) Reset()         { *m = QueryCanonicalPoolResponse{} }
+func (m *QueryCanonicalPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalPoolResponse) ProtoMessage()    {}
+func (*QueryCanonicalPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{23}
+}
+func (m *QueryCanonicalPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySwapExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCanonicalPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySwapExactAmountInRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCanonicalPoolResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1202,62 +1235,97 @@ func (m *QuerySwapExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QuerySwapExactAmountInRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySwapExactAmountInRequest.Merge(m, src)
+func (m *QueryCanonicalPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalPoolResponse.Merge(m, src)
 }
-func (m *QuerySwapExactAmountInRequest) XXX_Size() int {
+func (m *QueryCanonicalPoolResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySwapExactAmountInRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySwapExactAmountInRequest.DiscardUnknown(m)
+func (m *QueryCanonicalPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalPoolResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySwapExactAmountInRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCanonicalPoolResponse proto.InternalMessageInfo
 
-func (m *QuerySwapExactAmountInRequest) GetSender() string {
+func (m *QueryCanonicalPoolResponse) GetPoolId() uint64 {
 	if m != nil {
-		return m.Sender
+		return m.PoolId
 	}
-	return ""
+	return 0
 }
 
-func (m *QuerySwapExactAmountInRequest) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
+// =============================== PoolsByDenom
+type QueryPoolsByDenomRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolsByDenomRequest) Reset()         { *m = QueryPoolsByDenomRequest{} }
+func (m *QueryPoolsByDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByDenomRequest) ProtoMessage()    {}
+func (*QueryPoolsByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{24}
+}
+func (m *QueryPoolsByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QueryPoolsByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByDenomRequest.Merge(m, src)
+}
+func (m *QueryPoolsByDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByDenomRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySwapExactAmountInRequest) GetTokenIn() string {
+var xxx_messageInfo_QueryPoolsByDenomRequest proto.InternalMessageInfo
+
+func (m *QueryPoolsByDenomRequest) GetDenom() string {
 	if m != nil {
-		return m.TokenIn
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *QuerySwapExactAmountInRequest) GetRoutes() []types2.SwapAmountInRoute {
+func (m *QueryPoolsByDenomRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Routes
+		return m.Pagination
 	}
 	return nil
 }
 
-type QuerySwapExactAmountInResponse struct {
-	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+type QueryPoolsByDenomResponse struct {
+	Pools []PoolSummary `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools" yaml:"pools"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySwapExactAmountInResponse) Reset()         { *m = QuerySwapExactAmountInResponse{} }
-func (m *QuerySwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySwapExactAmountInResponse) ProtoMessage()    {}
-func (*QuerySwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPoolsByDenomResponse) Reset()         { *m = QueryPoolsByDenomResponse{} }
+func (m *QueryPoolsByDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByDenomResponse) ProtoMessage()    {}
+func (*QueryPoolsByDenomResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e2e4a69339a7bfd, []int{25}
 }
-func (m *QuerySwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolsByDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolsByDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySwapExactAmountInResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolsByDenomResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1267,38 +1335,52 @@ func (m *QuerySwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QuerySwapExactAmountInResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySwapExactAmountInResponse.Merge(m, src)
+func (m *QueryPoolsByDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByDenomResponse.Merge(m, src)
 }
-func (m *QuerySwapExactAmountInResponse) XXX_Size() int {
+func (m *QueryPoolsByDenomResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySwapExactAmountInResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySwapExactAmountInResponse.DiscardUnknown(m)
+func (m *QueryPoolsByDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByDenomResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySwapExactAmountInResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolsByDenomResponse proto.InternalMessageInfo
 
-// =============================== EstimateSwapExactAmountOut
-type QuerySwapExactAmountOutRequest struct {
-	Sender   string                      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	PoolId   uint64                      `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	Routes   []types2.SwapAmountOutRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes" yaml:"routes"`
-	TokenOut string                      `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty" yaml:"token_out"`
+func (m *QueryPoolsByDenomResponse) GetPools() []PoolSummary {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
 }
 
-func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAmountOutRequest{} }
-func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
-func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPoolsByDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// =============================== PoolsByPair
+type QueryPoolsByPairRequest struct {
+	DenomA string `protobuf:"bytes,1,opt,name=denom_a,json=denomA,proto3" json:"denom_a,omitempty" yaml:"denom_a"`
+	DenomB string `protobuf:"bytes,2,opt,name=denom_b,json=denomB,proto3" json:"denom_b,omitempty" yaml:"denom_b"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolsByPairRequest) Reset()         { *m = QueryPoolsByPairRequest{} }
+func (m *QueryPoolsByPairRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByPairRequest) ProtoMessage()    {}
+func (*QueryPoolsByPairRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e2e4a69339a7bfd, []int{26}
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolsByPairRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolsByPairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySwapExactAmountOutRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolsByPairRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1308,62 +1390,57 @@ func (m *QuerySwapExactAmountOutRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySwapExactAmountOutRequest.Merge(m, src)
+func (m *QueryPoolsByPairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByPairRequest.Merge(m, src)
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Size() int {
+func (m *QueryPoolsByPairRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySwapExactAmountOutRequest.DiscardUnknown(m)
+func (m *QueryPoolsByPairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByPairRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySwapExactAmountOutRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolsByPairRequest proto.InternalMessageInfo
 
-func (m *QuerySwapExactAmountOutRequest) GetSender() string {
+func (m *QueryPoolsByPairRequest) GetDenomA() string {
 	if m != nil {
-		return m.Sender
+		return m.DenomA
 	}
 	return ""
 }
 
-func (m *QuerySwapExactAmountOutRequest) GetPoolId() uint64 {
+func (m *QueryPoolsByPairRequest) GetDenomB() string {
 	if m != nil {
-		return m.PoolId
+		return m.DenomB
 	}
-	return 0
+	return ""
 }
 
-func (m *QuerySwapExactAmountOutRequest) GetRoutes() []types2.SwapAmountOutRoute {
+func (m *QueryPoolsByPairRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Routes
+		return m.Pagination
 	}
 	return nil
 }
 
-func (m *QuerySwapExactAmountOutRequest) GetTokenOut() string {
-	if m != nil {
-		return m.TokenOut
-	}
-	return ""
-}
-
-type QuerySwapExactAmountOutResponse struct {
-	TokenInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount" yaml:"token_in_amount"`
+type QueryPoolsByPairResponse struct {
+	Pools []PoolSummary `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools" yaml:"pools"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactAmountOutResponse{} }
-func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
-func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPoolsByPairResponse) Reset()         { *m = QueryPoolsByPairResponse{} }
+func (m *QueryPoolsByPairResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByPairResponse) ProtoMessage()    {}
+func (*QueryPoolsByPairResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e2e4a69339a7bfd, []int{27}
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolsByPairResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolsByPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySwapExactAmountOutResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolsByPairResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1373,33 +1450,52 @@ func (m *QuerySwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySwapExactAmountOutResponse.Merge(m, src)
+func (m *QueryPoolsByPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByPairResponse.Merge(m, src)
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_Size() int {
+func (m *QueryPoolsByPairResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySwapExactAmountOutResponse.DiscardUnknown(m)
+func (m *QueryPoolsByPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByPairResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySwapExactAmountOutResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolsByPairResponse proto.InternalMessageInfo
 
-type QueryTotalLiquidityRequest struct {
+func (m *QueryPoolsByPairResponse) GetPools() []PoolSummary {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
 }
 
-func (m *QueryTotalLiquidityRequest) Reset()         { *m = QueryTotalLiquidityRequest{} }
-func (m *QueryTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalLiquidityRequest) ProtoMessage()    {}
-func (*QueryTotalLiquidityRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPoolsByPairResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// PoolSummary is a pool found through the denom indexes, with its liquidity
+// and the spot prices of the queried denom in other assets of the pool.
+type PoolSummary struct {
+	PoolId     uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Liquidity  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
+	SpotPrices []DenomSpotPrice                         `protobuf:"bytes,3,rep,name=spot_prices,json=spotPrices,proto3" json:"spot_prices" yaml:"spot_prices"`
+}
+
+func (m *PoolSummary) Reset()         { *m = PoolSummary{} }
+func (m *PoolSummary) String() string { return proto.CompactTextString(m) }
+func (*PoolSummary) ProtoMessage()    {}
+func (*PoolSummary) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e2e4a69339a7bfd, []int{28}
 }
-func (m *QueryTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
+func (m *PoolSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PoolSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalLiquidityRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PoolSummary.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1409,34 +1505,57 @@ func (m *QueryTotalLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalLiquidityRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalLiquidityRequest.Merge(m, src)
+func (m *PoolSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolSummary.Merge(m, src)
 }
-func (m *QueryTotalLiquidityRequest) XXX_Size() int {
+func (m *PoolSummary) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalLiquidityRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalLiquidityRequest.DiscardUnknown(m)
+func (m *PoolSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolSummary.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalLiquidityRequest proto.InternalMessageInfo
+var xxx_messageInfo_PoolSummary proto.InternalMessageInfo
 
-type QueryTotalLiquidityResponse struct {
-	Liquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
+func (m *PoolSummary) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *QueryTotalLiquidityResponse) Reset()         { *m = QueryTotalLiquidityResponse{} }
-func (m *QueryTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalLiquidityResponse) ProtoMessage()    {}
-func (*QueryTotalLiquidityResponse) Descriptor() ([]byte, []int) {
+func (m *PoolSummary) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Liquidity
+	}
+	return nil
+}
+
+func (m *PoolSummary) GetSpotPrices() []DenomSpotPrice {
+	if m != nil {
+		return m.SpotPrices
+	}
+	return nil
+}
+
+// DenomSpotPrice is the spot price of the queried denom in a quote asset.
+type DenomSpotPrice struct {
+	QuoteAssetDenom string                                 `protobuf:"bytes,1,opt,name=quote_asset_denom,json=quoteAssetDenom,proto3" json:"quote_asset_denom,omitempty" yaml:"quote_asset_denom"`
+	SpotPrice       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=spot_price,json=spotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price" yaml:"spot_price"`
+}
+
+func (m *DenomSpotPrice) Reset()         { *m = DenomSpotPrice{} }
+func (m *DenomSpotPrice) String() string { return proto.CompactTextString(m) }
+func (*DenomSpotPrice) ProtoMessage()    {}
+func (*DenomSpotPrice) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e2e4a69339a7bfd, []int{29}
 }
-func (m *QueryTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
+func (m *DenomSpotPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DenomSpotPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalLiquidityResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DenomSpotPrice.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1446,2352 +1565,7615 @@ func (m *QueryTotalLiquidityResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalLiquidityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalLiquidityResponse.Merge(m, src)
+func (m *DenomSpotPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomSpotPrice.Merge(m, src)
 }
-func (m *QueryTotalLiquidityResponse) XXX_Size() int {
+func (m *DenomSpotPrice) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalLiquidityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalLiquidityResponse.DiscardUnknown(m)
+func (m *DenomSpotPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomSpotPrice.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalLiquidityResponse proto.InternalMessageInfo
+var xxx_messageInfo_DenomSpotPrice proto.InternalMessageInfo
 
-func (m *QueryTotalLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *DenomSpotPrice) GetQuoteAssetDenom() string {
 	if m != nil {
-		return m.Liquidity
+		return m.QuoteAssetDenom
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryPoolRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolRequest")
-	proto.RegisterType((*QueryPoolResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolResponse")
-	proto.RegisterType((*QueryPoolsRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolsRequest")
-	proto.RegisterType((*QueryPoolsResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolsResponse")
-	proto.RegisterType((*QueryNumPoolsRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryNumPoolsRequest")
-	proto.RegisterType((*QueryNumPoolsResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryNumPoolsResponse")
-	proto.RegisterType((*QueryCalcJoinPoolSharesRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryCalcJoinPoolSharesRequest")
-	proto.RegisterType((*QueryCalcJoinPoolSharesResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryCalcJoinPoolSharesResponse")
-	proto.RegisterType((*QueryCalcExitPoolCoinsFromSharesRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryCalcExitPoolCoinsFromSharesRequest")
-	proto.RegisterType((*QueryCalcExitPoolCoinsFromSharesResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryCalcExitPoolCoinsFromSharesResponse")
-	proto.RegisterType((*QueryPoolParamsRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolParamsRequest")
-	proto.RegisterType((*QueryPoolParamsResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolParamsResponse")
-	proto.RegisterType((*QueryTotalPoolLiquidityRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryTotalPoolLiquidityRequest")
-	proto.RegisterType((*QueryTotalPoolLiquidityResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryTotalPoolLiquidityResponse")
-	proto.RegisterType((*QueryTotalSharesRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryTotalSharesRequest")
-	proto.RegisterType((*QueryTotalSharesResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryTotalSharesResponse")
-	proto.RegisterType((*QueryCalcJoinPoolNoSwapSharesRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryCalcJoinPoolNoSwapSharesRequest")
-	proto.RegisterType((*QueryCalcJoinPoolNoSwapSharesResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryCalcJoinPoolNoSwapSharesResponse")
-	proto.RegisterType((*QuerySpotPriceRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QuerySpotPriceRequest")
-	proto.RegisterType((*QueryPoolsWithFilterRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolsWithFilterRequest")
-	proto.RegisterType((*QueryPoolsWithFilterResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolsWithFilterResponse")
-	proto.RegisterType((*QuerySpotPriceResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QuerySpotPriceResponse")
-	proto.RegisterType((*QuerySwapExactAmountInRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QuerySwapExactAmountInRequest")
-	proto.RegisterType((*QuerySwapExactAmountInResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QuerySwapExactAmountInResponse")
-	proto.RegisterType((*QuerySwapExactAmountOutRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QuerySwapExactAmountOutRequest")
-	proto.RegisterType((*QuerySwapExactAmountOutResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QuerySwapExactAmountOutResponse")
-	proto.RegisterType((*QueryTotalLiquidityRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryTotalLiquidityRequest")
-	proto.RegisterType((*QueryTotalLiquidityResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryTotalLiquidityResponse")
+// =============================== PoolStats
+type QueryPoolStatsRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/gamm/v1beta1/query.proto", fileDescriptor_3e2e4a69339a7bfd)
+func (m *QueryPoolStatsRequest) Reset()         { *m = QueryPoolStatsRequest{} }
+func (m *QueryPoolStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolStatsRequest) ProtoMessage()    {}
+func (*QueryPoolStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{30}
 }
-
-var fileDescriptor_3e2e4a69339a7bfd = []byte{
-	// 1858 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6c, 0x14, 0x47,
-	0x16, 0x76, 0x8f, 0xc7, 0xc6, 0x53, 0xc6, 0x7f, 0x85, 0x81, 0xf1, 0xd8, 0x3b, 0xb3, 0xaa, 0xdd,
-	0x05, 0x16, 0xf0, 0x0c, 0xc6, 0x18, 0x16, 0x8c, 0x17, 0x3c, 0xc6, 0x86, 0xf1, 0x62, 0x1b, 0x1a,
-	0x96, 0xd5, 0xee, 0x1e, 0x66, 0xdb, 0x9e, 0x66, 0xdc, 0x30, 0xdd, 0x35, 0x9e, 0xae, 0xc6, 0x9e,
-	0x5d, 0xa1, 0x95, 0x38, 0xed, 0x71, 0xb5, 0x2c, 0x7b, 0x40, 0xab, 0x28, 0xe7, 0x28, 0x52, 0x22,
-	0x25, 0x52, 0x2e, 0xc9, 0x21, 0x37, 0x14, 0xe5, 0x40, 0x84, 0x22, 0x45, 0x39, 0x4c, 0x22, 0xc8,
-	0x31, 0x87, 0x68, 0x2e, 0x91, 0x72, 0x88, 0xa2, 0xfa, 0xe9, 0x9f, 0xf9, 0xc3, 0xd3, 0x6d, 0x23,
-	0x91, 0x93, 0x3d, 0x55, 0xfd, 0x5e, 0x7d, 0xdf, 0xf7, 0xaa, 0x5e, 0xbd, 0x57, 0x20, 0x95, 0x2b,
-	0xeb, 0xaa, 0x61, 0x6a, 0xd8, 0xd8, 0x2a, 0xff, 0xdd, 0xfd, 0x91, 0xca, 0x2b, 0xba, 0x9e, 0xba,
-	0x37, 0xb1, 0xaa, 0x12, 0x65, 0x22, 0xb5, 0x61, 0xa9, 0xa5, 0x72, 0xb2, 0x58, 0xc2, 0x04, 0xc3,
-	0x5f, 0x79, 0x0d, 0x92, 0xce, 0x8f, 0x24, 0x35, 0x48, 0x0a, 0x83, 0xd8, 0x70, 0x1e, 0xe7, 0x31,
-	0xfb, 0x3e, 0x45, 0xff, 0xe3, 0xa6, 0xb1, 0x89, 0x76, 0xd6, 0xca, 0xab, 0x86, 0x6a, 0x6a, 0xa6,
-	0x30, 0x99, 0x6e, 0x61, 0x52, 0xc4, 0xb8, 0xa0, 0x2b, 0x86, 0x92, 0x57, 0x4b, 0x8e, 0xa5, 0xb9,
-	0xa9, 0x14, 0xb3, 0x25, 0x6c, 0x11, 0x55, 0x18, 0xc7, 0xd7, 0xb0, 0xa9, 0x63, 0x33, 0xb5, 0xaa,
-	0x98, 0xaa, 0xf3, 0xd5, 0x1a, 0xd6, 0x0c, 0x31, 0x7f, 0xd4, 0x3b, 0xcf, 0x38, 0x3a, 0x5f, 0x15,
-	0x95, 0xbc, 0x66, 0x28, 0x84, 0x12, 0xe3, 0xdf, 0x8e, 0xe5, 0x31, 0xce, 0x17, 0xd4, 0x94, 0x52,
-	0xd4, 0x52, 0x8a, 0x61, 0x60, 0xc2, 0x26, 0x6d, 0x98, 0x23, 0x62, 0x96, 0xfd, 0x5a, 0xb5, 0x6e,
-	0xa7, 0x14, 0xa3, 0x6c, 0x4f, 0xf1, 0x45, 0xb2, 0x5c, 0x0d, 0xfe, 0x83, 0x4f, 0xa1, 0x61, 0x00,
-	0xaf, 0xd3, 0x55, 0xaf, 0x29, 0x25, 0x45, 0x37, 0x65, 0x75, 0xc3, 0x52, 0x4d, 0x82, 0xfe, 0x06,
-	0xf6, 0xd5, 0x8c, 0x9a, 0x45, 0x6c, 0x98, 0x2a, 0xcc, 0x80, 0xee, 0x22, 0x1b, 0x89, 0x4a, 0xbf,
-	0x94, 0x8e, 0xf4, 0x9e, 0x3c, 0x96, 0x6c, 0x23, 0x10, 0x49, 0xee, 0x24, 0x1d, 0x7e, 0x52, 0x49,
-	0x74, 0xc8, 0xc2, 0x01, 0xba, 0x00, 0x06, 0xf9, 0x0a, 0x18, 0x17, 0xc4, 0xaa, 0xf0, 0x18, 0xd8,
-	0x43, 0x35, 0xcd, 0x6a, 0x39, 0xe6, 0x3f, 0x9c, 0x86, 0xd5, 0x4a, 0xa2, 0xbf, 0xac, 0xe8, 0x85,
-	0x73, 0x48, 0x4c, 0x20, 0xb9, 0x9b, 0xfe, 0x97, 0xc9, 0xa1, 0x2b, 0x60, 0xc8, 0xe3, 0x40, 0x00,
-	0x9c, 0x04, 0x61, 0x3a, 0x2d, 0xe0, 0x0d, 0x27, 0xb9, 0x24, 0x49, 0x5b, 0x92, 0xe4, 0xac, 0x51,
-	0x4e, 0x47, 0x3e, 0x79, 0x7f, 0xbc, 0x8b, 0x5a, 0x65, 0x64, 0xf6, 0x31, 0xfa, 0xab, 0xc7, 0x93,
-	0xad, 0x00, 0x5c, 0x00, 0xc0, 0xd5, 0x3f, 0x1a, 0x62, 0xfe, 0x0e, 0x25, 0x85, 0x74, 0x34, 0x58,
-	0x49, 0xbe, 0x21, 0x5d, 0x92, 0x79, 0x55, 0xd8, 0xca, 0x1e, 0x4b, 0xf4, 0x5f, 0xc9, 0x16, 0x98,
-	0x7b, 0x17, 0x40, 0xa7, 0x40, 0x17, 0x5d, 0x9b, 0x0a, 0xd9, 0xd9, 0x0e, 0x52, 0xfe, 0x35, 0xbc,
-	0xdc, 0x04, 0xd5, 0xe1, 0x6d, 0x51, 0xf1, 0x35, 0x6b, 0x60, 0xc5, 0xc0, 0x30, 0x43, 0xb5, 0x6c,
-	0xe9, 0x5e, 0xda, 0xe7, 0x42, 0x51, 0x09, 0x2d, 0x83, 0xfd, 0x75, 0x73, 0x02, 0xf4, 0x04, 0x88,
-	0x18, 0x96, 0x9e, 0xb5, 0x81, 0xd3, 0x08, 0x0d, 0x57, 0x2b, 0x89, 0x41, 0x1e, 0x21, 0x67, 0x0a,
-	0xc9, 0x3d, 0x86, 0x30, 0x65, 0xfe, 0x3e, 0x90, 0x40, 0x9c, 0x39, 0x9c, 0x53, 0x0a, 0x6b, 0x8b,
-	0x58, 0x33, 0xe8, 0xd4, 0x8d, 0x75, 0xa5, 0xa4, 0x9a, 0x41, 0x22, 0x0f, 0xd7, 0x41, 0x84, 0xe0,
-	0xbb, 0xaa, 0x61, 0x66, 0x35, 0xaa, 0x01, 0xd5, 0x6f, 0xa4, 0x46, 0x03, 0x9b, 0xfd, 0x1c, 0xd6,
-	0x8c, 0xf4, 0x09, 0xba, 0xed, 0xde, 0xfa, 0x2a, 0x71, 0x24, 0xaf, 0x91, 0x75, 0x6b, 0x35, 0xb9,
-	0x86, 0x75, 0x71, 0x02, 0xc4, 0x9f, 0x71, 0x33, 0x77, 0x37, 0x45, 0xca, 0x45, 0xd5, 0x64, 0x06,
-	0xa6, 0xdc, 0xc3, 0xbd, 0x67, 0x0c, 0xf4, 0x20, 0x04, 0x12, 0x2d, 0x91, 0x0b, 0x51, 0x4c, 0x30,
-	0x68, 0xd2, 0x91, 0x2c, 0xb6, 0x48, 0x56, 0xd1, 0xb1, 0x65, 0x10, 0xc6, 0x21, 0x92, 0xce, 0xd0,
-	0x95, 0xbf, 0xac, 0x24, 0x0e, 0xb5, 0xb1, 0x72, 0xc6, 0x20, 0xd5, 0x4a, 0xe2, 0x20, 0x67, 0x5c,
-	0xef, 0x0f, 0xc9, 0xfd, 0x6c, 0x68, 0xc5, 0x22, 0xb3, 0x6c, 0x00, 0xde, 0x01, 0x40, 0x48, 0x80,
-	0x2d, 0xf2, 0x2a, 0x34, 0x10, 0x0a, 0xaf, 0x58, 0x04, 0x3d, 0x96, 0xc0, 0x61, 0x47, 0x84, 0xf9,
-	0x2d, 0x8d, 0x50, 0x11, 0xd8, 0x57, 0x0b, 0x25, 0xac, 0xd7, 0xc6, 0xf1, 0x60, 0x5d, 0x1c, 0x9d,
-	0x98, 0xdd, 0x02, 0x03, 0x9c, 0x95, 0x66, 0xd8, 0x22, 0x85, 0x98, 0x48, 0x49, 0x7f, 0x22, 0xc9,
-	0x7d, 0xcc, 0x4d, 0xc6, 0xe0, 0x42, 0xa0, 0xff, 0x49, 0xe0, 0xc8, 0xf6, 0xe0, 0x44, 0xa8, 0x6a,
-	0x55, 0x93, 0x5e, 0xa9, 0x6a, 0xf3, 0xe0, 0x80, 0x73, 0xec, 0x6b, 0x72, 0xab, 0xbf, 0x2c, 0x77,
-	0x19, 0x1c, 0x6c, 0x70, 0x23, 0xd8, 0x1c, 0xaf, 0x4b, 0xc6, 0x4d, 0x73, 0x88, 0x93, 0x6f, 0x97,
-	0xc4, 0x19, 0xbc, 0x89, 0x89, 0x52, 0xa0, 0xde, 0xae, 0x6a, 0x1b, 0x96, 0x96, 0xd3, 0x48, 0x39,
-	0x10, 0xae, 0x37, 0x25, 0x71, 0x32, 0x9a, 0xf9, 0x13, 0x00, 0xef, 0x83, 0x48, 0xc1, 0x1e, 0xdc,
-	0x5e, 0xed, 0x4b, 0x54, 0x6d, 0x37, 0x9b, 0x38, 0x96, 0xc8, 0x5f, 0x04, 0x5c, 0xbb, 0x05, 0x21,
-	0x1d, 0x43, 0x18, 0x3c, 0xdd, 0x20, 0x0b, 0x44, 0x1b, 0xfd, 0x08, 0x8a, 0x7f, 0x06, 0x7b, 0x09,
-	0x1d, 0xce, 0xb2, 0x5d, 0x69, 0x47, 0xe2, 0x25, 0x2c, 0x47, 0x05, 0xcb, 0x7d, 0x7c, 0x31, 0xaf,
-	0x31, 0x92, 0x7b, 0x89, 0xbb, 0x04, 0xfa, 0x48, 0x02, 0xbf, 0x6e, 0xc8, 0x3d, 0xcb, 0xf8, 0xc6,
-	0xa6, 0x52, 0xfc, 0x59, 0xe4, 0xce, 0xef, 0x25, 0xf0, 0x9b, 0x6d, 0xf0, 0x0b, 0x11, 0xff, 0xe9,
-	0xef, 0x58, 0xce, 0x0b, 0x09, 0x87, 0x6c, 0x09, 0x6d, 0x53, 0x14, 0xf0, 0xac, 0xc2, 0x25, 0x00,
-	0x78, 0x08, 0x44, 0x36, 0x0d, 0x92, 0x97, 0x22, 0xdc, 0x03, 0x3d, 0xfa, 0xdf, 0x4a, 0xe2, 0x02,
-	0xbd, 0x51, 0xc4, 0xe4, 0x5a, 0x49, 0x5b, 0x53, 0x03, 0x85, 0x6a, 0x1e, 0x0c, 0x52, 0xf2, 0x59,
-	0xc5, 0x34, 0x55, 0x92, 0xcd, 0xa9, 0x06, 0xd6, 0x05, 0xb6, 0x51, 0xf7, 0xaa, 0xa8, 0xff, 0x02,
-	0xc9, 0xfd, 0x74, 0x68, 0x96, 0x8e, 0x5c, 0xa2, 0x03, 0xf0, 0x0a, 0x18, 0xda, 0xb0, 0x30, 0xa9,
-	0xf5, 0xd3, 0xc9, 0xfc, 0x8c, 0x55, 0x2b, 0x89, 0x28, 0xf7, 0xd3, 0xf0, 0x09, 0x92, 0x07, 0xd8,
-	0x98, 0xeb, 0x89, 0xde, 0xe5, 0x8b, 0xe1, 0x9e, 0xf0, 0x60, 0x97, 0xdc, 0xbb, 0xa9, 0x91, 0x75,
-	0x1a, 0xc9, 0x05, 0x55, 0x45, 0x1f, 0x4b, 0x60, 0xd4, 0xad, 0x70, 0xfe, 0xa4, 0x91, 0xf5, 0x05,
-	0xad, 0x40, 0xd4, 0x92, 0x4d, 0x7a, 0x06, 0xf4, 0xe9, 0x9a, 0x91, 0xf5, 0xa6, 0x02, 0xba, 0x78,
-	0xb4, 0x5a, 0x49, 0x0c, 0xf3, 0xc5, 0x6b, 0xa6, 0x91, 0xbc, 0x57, 0xd7, 0x0c, 0x27, 0x9b, 0xc0,
-	0x51, 0x10, 0x61, 0xd2, 0x50, 0xa9, 0x39, 0x7f, 0xb9, 0x87, 0x0e, 0xdc, 0x2c, 0x17, 0xd5, 0xba,
-	0x2a, 0xad, 0x33, 0x70, 0x95, 0xf6, 0x86, 0x04, 0xc6, 0x9a, 0x73, 0x78, 0x4d, 0xea, 0x35, 0x59,
-	0x5c, 0x27, 0x9e, 0x2d, 0x25, 0x90, 0x9d, 0x02, 0xc0, 0x2c, 0x62, 0x92, 0x2d, 0xd2, 0x51, 0xa1,
-	0xed, 0x7e, 0xf7, 0x78, 0xb8, 0x73, 0x48, 0x8e, 0x98, 0xb6, 0x35, 0xab, 0xcb, 0x1e, 0x85, 0xc0,
-	0x2f, 0xb8, 0xd3, 0x4d, 0xa5, 0x38, 0xbf, 0xa5, 0xac, 0x89, 0xea, 0x22, 0x63, 0xd8, 0xa1, 0xfb,
-	0x2d, 0xe8, 0x36, 0x55, 0x23, 0xa7, 0x96, 0x84, 0xdf, 0xa1, 0x6a, 0x25, 0xd1, 0x27, 0xfc, 0xb2,
-	0x71, 0x24, 0x8b, 0x0f, 0xbc, 0x5b, 0x3b, 0xb4, 0xed, 0xd6, 0x4e, 0x02, 0x9e, 0x27, 0x68, 0x12,
-	0xe2, 0x5b, 0x71, 0x5f, 0xb5, 0x92, 0x18, 0xf0, 0x1c, 0xe8, 0xac, 0x66, 0x20, 0x79, 0x0f, 0xfb,
-	0x37, 0x63, 0xc0, 0x02, 0xe8, 0x66, 0x3d, 0x95, 0x19, 0x0d, 0x33, 0xf9, 0x67, 0x5a, 0xf5, 0x1d,
-	0x9e, 0x96, 0xcc, 0xd1, 0x94, 0xb2, 0x73, 0x88, 0x51, 0x2f, 0xe9, 0xfd, 0x22, 0x83, 0x08, 0x2a,
-	0xdc, 0x35, 0x92, 0xc5, 0x1a, 0xe8, 0x91, 0x5d, 0xaf, 0x36, 0xd1, 0xc5, 0x2d, 0xfa, 0x38, 0xcc,
-	0xdd, 0x2b, 0xfa, 0xea, 0xfd, 0x21, 0xb9, 0x9f, 0x0d, 0x39, 0x45, 0x1f, 0x7a, 0x1c, 0x6a, 0x8e,
-	0x6b, 0xc5, 0x22, 0xaf, 0x3a, 0x60, 0xba, 0x13, 0x80, 0x4e, 0x16, 0x80, 0xdf, 0x07, 0x0b, 0x00,
-	0x45, 0xda, 0x46, 0x04, 0x68, 0xa3, 0xe1, 0xc8, 0x11, 0x0d, 0x33, 0x26, 0x9e, 0x46, 0xc3, 0x99,
-	0x42, 0xe2, 0xba, 0xa1, 0x49, 0xf7, 0xa1, 0x5d, 0x90, 0x34, 0x13, 0x47, 0x44, 0xad, 0x08, 0x06,
-	0xec, 0xcd, 0x55, 0x1b, 0xb4, 0x2b, 0xbe, 0x83, 0x76, 0xa0, 0x76, 0xaf, 0x3a, 0x31, 0xeb, 0x13,
-	0x5b, 0x56, 0x84, 0x6c, 0x0c, 0xc4, 0xdc, 0xda, 0xa1, 0xbe, 0xe2, 0x42, 0xff, 0xb7, 0x33, 0x67,
-	0xfd, 0xf4, 0x6b, 0x51, 0x40, 0x9d, 0x7c, 0x67, 0x04, 0x74, 0x31, 0x78, 0xf0, 0x5d, 0x09, 0x74,
-	0xf3, 0xea, 0x13, 0x9e, 0x69, 0xab, 0xe5, 0x6f, 0x7c, 0x52, 0x88, 0xfd, 0xce, 0xbf, 0x21, 0x97,
-	0x01, 0x4d, 0x3e, 0x78, 0xf6, 0xcd, 0xc3, 0xd0, 0x38, 0x3c, 0xd6, 0xd6, 0x3b, 0x11, 0xaf, 0x77,
-	0xe1, 0xdb, 0x12, 0x60, 0xa9, 0xd8, 0x84, 0xa7, 0x7d, 0x2c, 0xec, 0x69, 0x85, 0x63, 0x67, 0x7c,
-	0xdb, 0x09, 0xbc, 0x27, 0x19, 0xde, 0xe3, 0xf0, 0x68, 0x7b, 0x78, 0x19, 0xc8, 0x0f, 0x25, 0xd0,
-	0x63, 0xf7, 0xdb, 0xf0, 0x6c, 0xfb, 0x2b, 0xd7, 0xf5, 0xef, 0xb1, 0x73, 0x41, 0x4c, 0x05, 0xee,
-	0xb3, 0x0c, 0xf7, 0x09, 0x98, 0x6c, 0x0b, 0xb7, 0xd3, 0xee, 0xff, 0x2b, 0x24, 0xc1, 0xcf, 0x24,
-	0xd0, 0x5f, 0xbb, 0x89, 0xe1, 0x85, 0xf6, 0x91, 0x34, 0x3d, 0x1d, 0xb1, 0x8b, 0xc1, 0x1d, 0x08,
-	0x42, 0xe7, 0x19, 0xa1, 0xd3, 0xf0, 0x54, 0x5b, 0x84, 0x78, 0x2d, 0xee, 0x6c, 0x7f, 0xf8, 0x4c,
-	0x02, 0x03, 0x75, 0xe5, 0x00, 0xbc, 0xe8, 0x73, 0x4f, 0x34, 0x54, 0x43, 0xb1, 0xd9, 0x1d, 0x78,
-	0x10, 0xb4, 0xa6, 0x19, 0xad, 0x29, 0x38, 0xd9, 0x16, 0xad, 0xdb, 0xcc, 0x58, 0xcd, 0xf1, 0x60,
-	0xc1, 0xf7, 0x24, 0x10, 0xa6, 0x8e, 0xe1, 0x94, 0x3f, 0x20, 0x36, 0xfe, 0xd3, 0x7e, 0xcd, 0x02,
-	0xc5, 0x82, 0x61, 0x4d, 0xfd, 0x43, 0xdc, 0x43, 0xf7, 0x29, 0xea, 0x68, 0xab, 0x3e, 0x02, 0x66,
-	0xda, 0x87, 0xb4, 0x4d, 0x2f, 0x15, 0x5b, 0xdc, 0x0d, 0x57, 0x82, 0x71, 0x07, 0xfc, 0x4e, 0x02,
-	0xb0, 0xf1, 0xe5, 0x08, 0xce, 0x05, 0x5b, 0xa4, 0x16, 0xe9, 0xa5, 0x9d, 0x39, 0x11, 0x18, 0x57,
-	0x58, 0x54, 0x32, 0xf0, 0x72, 0x90, 0xa8, 0xa4, 0xee, 0x60, 0xcd, 0xc8, 0xb2, 0x17, 0x6f, 0x95,
-	0x5e, 0xb8, 0x59, 0xcd, 0x80, 0xff, 0x09, 0x81, 0xd1, 0x97, 0x3c, 0xc5, 0xc0, 0xab, 0xfe, 0x60,
-	0xbf, 0xfc, 0xb9, 0x29, 0xb6, 0xb4, 0x4b, 0xde, 0x84, 0x1a, 0xb7, 0x98, 0x1a, 0xd7, 0xe0, 0x72,
-	0x20, 0x35, 0xd4, 0x2d, 0x8d, 0x70, 0x35, 0xf8, 0x4b, 0x17, 0x2f, 0x09, 0xa8, 0x28, 0x9f, 0x4a,
-	0x00, 0xb8, 0x0f, 0x38, 0x70, 0xda, 0xdf, 0x11, 0xaa, 0xbd, 0x46, 0xcf, 0x07, 0x33, 0x16, 0x0c,
-	0xe7, 0x18, 0xc3, 0x19, 0x38, 0x1d, 0x88, 0xa1, 0xb8, 0x5a, 0xab, 0x12, 0x80, 0x8d, 0xcf, 0x3e,
-	0x7e, 0xb6, 0x75, 0xcb, 0x47, 0x28, 0x3f, 0xdb, 0xba, 0xf5, 0xcb, 0x13, 0xba, 0xce, 0x68, 0xfe,
-	0x01, 0x66, 0x02, 0xd1, 0xe4, 0x17, 0x01, 0xfb, 0xe9, 0xde, 0x06, 0x9f, 0x4b, 0xa0, 0xd7, 0xf3,
-	0x02, 0x04, 0xcf, 0xfb, 0x04, 0x5a, 0xbb, 0x71, 0x67, 0x02, 0x5a, 0x0b, 0x7e, 0x19, 0xc6, 0x6f,
-	0x0e, 0xce, 0xee, 0x80, 0x1f, 0x7f, 0xaf, 0xa0, 0x7b, 0x33, 0xe2, 0x34, 0x95, 0xd0, 0x47, 0xf9,
-	0x50, 0xff, 0xb8, 0x11, 0x9b, 0x0e, 0x64, 0x2b, 0x18, 0x2d, 0xec, 0x6c, 0x63, 0x52, 0x5f, 0xac,
-	0x10, 0xf9, 0x41, 0x02, 0x23, 0xf3, 0x26, 0xd1, 0x74, 0x85, 0xa8, 0x0d, 0xed, 0x1b, 0x4c, 0xfb,
-	0x80, 0xd8, 0xa2, 0x27, 0x8e, 0xcd, 0xed, 0xc8, 0x87, 0xa0, 0x7b, 0x93, 0xd1, 0x5d, 0x86, 0x57,
-	0xdb, 0xa2, 0xeb, 0xc9, 0x31, 0x82, 0x5a, 0xca, 0x93, 0x75, 0xdd, 0x3c, 0xf3, 0xa3, 0x04, 0x62,
-	0x2d, 0xc8, 0xaf, 0x58, 0x04, 0x06, 0x47, 0xee, 0x76, 0x98, 0x7e, 0x0e, 0x68, 0xeb, 0x4e, 0x0c,
-	0xfd, 0x91, 0xf1, 0x5f, 0x81, 0x4b, 0xbb, 0xc7, 0x1f, 0x5b, 0x24, 0xbd, 0xf8, 0xe4, 0x79, 0x5c,
-	0x7a, 0xfa, 0x3c, 0x2e, 0x7d, 0xfd, 0x3c, 0x2e, 0xfd, 0xfb, 0x45, 0xbc, 0xe3, 0xe9, 0x8b, 0x78,
-	0xc7, 0x17, 0x2f, 0xe2, 0x1d, 0x7f, 0x39, 0xe1, 0x69, 0x80, 0x58, 0xe3, 0xa3, 0x99, 0xe3, 0x05,
-	0x65, 0xd5, 0xb4, 0x7f, 0xa4, 0xee, 0x4d, 0x4c, 0xa5, 0xb6, 0xf8, 0xb2, 0xac, 0x1d, 0x5a, 0xed,
-	0x66, 0x4f, 0x3b, 0x93, 0x3f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x65, 0xcf, 0x98, 0x9d, 0x1e,
-	0x00, 0x00,
+func (m *QueryPoolStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolStatsRequest.Merge(m, src)
+}
+func (m *QueryPoolStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolStatsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPoolStatsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns lockup params.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error)
-	TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error)
-	// PoolsWithFilter allows you to query specific pools with requested
-	// parameters
-	PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFilterRequest, opts ...grpc.CallOption) (*QueryPoolsWithFilterResponse, error)
-	// Per Pool gRPC Endpoints
-	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
-	// Simulates joining pool without a swap. Returns the amount of shares you'd
-	// get and tokens needed to provide
-	CalcJoinPoolNoSwapShares(ctx context.Context, in *QueryCalcJoinPoolNoSwapSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolNoSwapSharesResponse, error)
-	CalcJoinPoolShares(ctx context.Context, in *QueryCalcJoinPoolSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolSharesResponse, error)
-	CalcExitPoolCoinsFromShares(ctx context.Context, in *QueryCalcExitPoolCoinsFromSharesRequest, opts ...grpc.CallOption) (*QueryCalcExitPoolCoinsFromSharesResponse, error)
-	PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error)
-	TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error)
-	TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
-	// SpotPrice defines a gRPC query handler that returns the spot price given
-	// a base denomination and a quote denomination.
-	SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
-	EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error)
-	EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error)
+func (m *QueryPoolStatsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryPoolStatsResponse struct {
+	// stats are the stats of the pool since it was created.
+	Stats PoolStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats" yaml:"stats"`
+	// current_epoch_stats are the stats of the pool over the current epoch.
+	CurrentEpochStats PoolStats `protobuf:"bytes,2,opt,name=current_epoch_stats,json=currentEpochStats,proto3" json:"current_epoch_stats" yaml:"current_epoch_stats"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPoolStatsResponse) Reset()         { *m = QueryPoolStatsResponse{} }
+func (m *QueryPoolStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolStatsResponse) ProtoMessage()    {}
+func (*QueryPoolStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{31}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPoolStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
-	out := new(QueryPoolsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/Pools", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPoolStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolStatsResponse.Merge(m, src)
+}
+func (m *QueryPoolStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolStatsResponse.DiscardUnknown(m)
 }
 
-// Deprecated: Do not use.
-func (c *queryClient) NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error) {
-	out := new(QueryNumPoolsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/NumPools", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolStatsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolStatsResponse) GetStats() PoolStats {
+	if m != nil {
+		return m.Stats
 	}
-	return out, nil
+	return PoolStats{}
 }
 
-func (c *queryClient) TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error) {
-	out := new(QueryTotalLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/TotalLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolStatsResponse) GetCurrentEpochStats() PoolStats {
+	if m != nil {
+		return m.CurrentEpochStats
 	}
-	return out, nil
+	return PoolStats{}
 }
 
-func (c *queryClient) PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFilterRequest, opts ...grpc.CallOption) (*QueryPoolsWithFilterResponse, error) {
-	out := new(QueryPoolsWithFilterResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolsWithFilter", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// =============================== PoolStatsHistory
+type QueryPoolStatsHistoryRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// num_epochs is the number of last finished epochs to return the stats of.
+	// It defaults to, and is capped at, the stats history length.
+	NumEpochs uint64 `protobuf:"varint,2,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty" yaml:"num_epochs"`
 }
 
-func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
-	out := new(QueryPoolResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/Pool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolStatsHistoryRequest) Reset()         { *m = QueryPoolStatsHistoryRequest{} }
+func (m *QueryPoolStatsHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolStatsHistoryRequest) ProtoMessage()    {}
+func (*QueryPoolStatsHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{32}
+}
+func (m *QueryPoolStatsHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolStatsHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolStatsHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPoolStatsHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolStatsHistoryRequest.Merge(m, src)
+}
+func (m *QueryPoolStatsHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolStatsHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolStatsHistoryRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) CalcJoinPoolNoSwapShares(ctx context.Context, in *QueryCalcJoinPoolNoSwapSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolNoSwapSharesResponse, error) {
-	out := new(QueryCalcJoinPoolNoSwapSharesResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/CalcJoinPoolNoSwapShares", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolStatsHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryPoolStatsHistoryRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) CalcJoinPoolShares(ctx context.Context, in *QueryCalcJoinPoolSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolSharesResponse, error) {
-	out := new(QueryCalcJoinPoolSharesResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/CalcJoinPoolShares", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolStatsHistoryRequest) GetNumEpochs() uint64 {
+	if m != nil {
+		return m.NumEpochs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) CalcExitPoolCoinsFromShares(ctx context.Context, in *QueryCalcExitPoolCoinsFromSharesRequest, opts ...grpc.CallOption) (*QueryCalcExitPoolCoinsFromSharesResponse, error) {
-	out := new(QueryCalcExitPoolCoinsFromSharesResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/CalcExitPoolCoinsFromShares", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryPoolStatsHistoryResponse struct {
+	// stats are the stats of the pool over the epochs it was swapped in, from
+	// the latest.
+	Stats []PoolStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats" yaml:"stats"`
 }
 
-func (c *queryClient) PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error) {
-	out := new(QueryPoolParamsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolStatsHistoryResponse) Reset()         { *m = QueryPoolStatsHistoryResponse{} }
+func (m *QueryPoolStatsHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolStatsHistoryResponse) ProtoMessage()    {}
+func (*QueryPoolStatsHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{33}
+}
+func (m *QueryPoolStatsHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolStatsHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolStatsHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPoolStatsHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolStatsHistoryResponse.Merge(m, src)
+}
+func (m *QueryPoolStatsHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolStatsHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolStatsHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error) {
-	out := new(QueryTotalPoolLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/TotalPoolLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolStatsHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPoolStatsHistoryResponse) GetStats() []PoolStats {
+	if m != nil {
+		return m.Stats
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error) {
-	out := new(QueryTotalSharesResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/TotalShares", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// =============================== PoolAPR
+type QueryPoolAPRRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-// Deprecated: Do not use.
-func (c *queryClient) SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error) {
-	out := new(QuerySpotPriceResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/SpotPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolAPRRequest) Reset()         { *m = QueryPoolAPRRequest{} }
+func (m *QueryPoolAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolAPRRequest) ProtoMessage()    {}
+func (*QueryPoolAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{34}
+}
+func (m *QueryPoolAPRRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolAPRRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPoolAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolAPRRequest.Merge(m, src)
+}
+func (m *QueryPoolAPRRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolAPRRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error) {
-	out := new(QuerySwapExactAmountInResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/EstimateSwapExactAmountIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolAPRRequest proto.InternalMessageInfo
+
+func (m *QueryPoolAPRRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error) {
-	out := new(QuerySwapExactAmountOutResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/EstimateSwapExactAmountOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryPoolAPRResponse struct {
+	// denom is the denom the liquidity and the revenue of the pool are valued
+	// in.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// liquidity_value is the value of the liquidity of the pool.
+	LiquidityValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquidity_value,json=liquidityValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_value" yaml:"liquidity_value"`
+	// swap_fee_apr is the annualized swap fee revenue of the pool over the last
+	// finished epochs, relative to its liquidity value.
+	SwapFeeApr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=swap_fee_apr,json=swapFeeApr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_apr" yaml:"swap_fee_apr"`
+	// incentives_apr is the annualized rewards of the active gauges of the pool
+	// shares, relative to the liquidity value of the pool.
+	IncentivesApr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=incentives_apr,json=incentivesApr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"incentives_apr" yaml:"incentives_apr"`
+	// apr is the sum of the swap fee and incentives APRs.
+	Apr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=apr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"apr" yaml:"apr"`
+}
+
+func (m *QueryPoolAPRResponse) Reset()         { *m = QueryPoolAPRResponse{} }
+func (m *QueryPoolAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolAPRResponse) ProtoMessage()    {}
+func (*QueryPoolAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{35}
+}
+func (m *QueryPoolAPRResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolAPRResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns lockup params.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
-	// Deprecated: please use the alternative in x/poolmanager
-	NumPools(context.Context, *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error)
-	TotalLiquidity(context.Context, *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error)
-	// PoolsWithFilter allows you to query specific pools with requested
-	// parameters
-	PoolsWithFilter(context.Context, *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error)
-	// Per Pool gRPC Endpoints
-	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
-	// Simulates joining pool without a swap. Returns the amount of shares you'd
-	// get and tokens needed to provide
-	CalcJoinPoolNoSwapShares(context.Context, *QueryCalcJoinPoolNoSwapSharesRequest) (*QueryCalcJoinPoolNoSwapSharesResponse, error)
-	CalcJoinPoolShares(context.Context, *QueryCalcJoinPoolSharesRequest) (*QueryCalcJoinPoolSharesResponse, error)
-	CalcExitPoolCoinsFromShares(context.Context, *QueryCalcExitPoolCoinsFromSharesRequest) (*QueryCalcExitPoolCoinsFromSharesResponse, error)
-	PoolParams(context.Context, *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error)
-	TotalPoolLiquidity(context.Context, *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error)
-	TotalShares(context.Context, *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error)
-	// SpotPrice defines a gRPC query handler that returns the spot price given
-	// a base denomination and a quote denomination.
-	SpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
-	EstimateSwapExactAmountIn(context.Context, *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error)
-	EstimateSwapExactAmountOut(context.Context, *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error)
+func (m *QueryPoolAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolAPRResponse.Merge(m, src)
+}
+func (m *QueryPoolAPRResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolAPRResponse.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryPoolAPRResponse proto.InternalMessageInfo
+
+func (m *QueryPoolAPRResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+// =============================== PoolLiquidity
+type QueryTotalPoolLiquidityRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
-func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+
+func (m *QueryTotalPoolLiquidityRequest) Reset()         { *m = QueryTotalPoolLiquidityRequest{} }
+func (m *QueryTotalPoolLiquidityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalPoolLiquidityRequest) ProtoMessage()    {}
+func (*QueryTotalPoolLiquidityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{36}
 }
-func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NumPools not implemented")
+func (m *QueryTotalPoolLiquidityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidity not implemented")
+func (m *QueryTotalPoolLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalPoolLiquidityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PoolsWithFilter(ctx context.Context, req *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolsWithFilter not implemented")
+func (m *QueryTotalPoolLiquidityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalPoolLiquidityRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+func (m *QueryTotalPoolLiquidityRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) CalcJoinPoolNoSwapShares(ctx context.Context, req *QueryCalcJoinPoolNoSwapSharesRequest) (*QueryCalcJoinPoolNoSwapSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalcJoinPoolNoSwapShares not implemented")
+func (m *QueryTotalPoolLiquidityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalPoolLiquidityRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) CalcJoinPoolShares(ctx context.Context, req *QueryCalcJoinPoolSharesRequest) (*QueryCalcJoinPoolSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalcJoinPoolShares not implemented")
+
+var xxx_messageInfo_QueryTotalPoolLiquidityRequest proto.InternalMessageInfo
+
+func (m *QueryTotalPoolLiquidityRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) CalcExitPoolCoinsFromShares(ctx context.Context, req *QueryCalcExitPoolCoinsFromSharesRequest) (*QueryCalcExitPoolCoinsFromSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalcExitPoolCoinsFromShares not implemented")
+
+type QueryTotalPoolLiquidityResponse struct {
+	Liquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
 }
-func (*UnimplementedQueryServer) PoolParams(ctx context.Context, req *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolParams not implemented")
+
+func (m *QueryTotalPoolLiquidityResponse) Reset()         { *m = QueryTotalPoolLiquidityResponse{} }
+func (m *QueryTotalPoolLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalPoolLiquidityResponse) ProtoMessage()    {}
+func (*QueryTotalPoolLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{37}
 }
-func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalPoolLiquidity not implemented")
+func (m *QueryTotalPoolLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) TotalShares(ctx context.Context, req *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalShares not implemented")
+func (m *QueryTotalPoolLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalPoolLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
+func (m *QueryTotalPoolLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalPoolLiquidityResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
+func (m *QueryTotalPoolLiquidityResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
+func (m *QueryTotalPoolLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalPoolLiquidityResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryTotalPoolLiquidityResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryTotalPoolLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Liquidity
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/Pools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// =============================== TotalShares
+type QueryTotalSharesRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func _Query_NumPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNumPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NumPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/NumPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NumPools(ctx, req.(*QueryNumPoolsRequest))
+func (m *QueryTotalSharesRequest) Reset()         { *m = QueryTotalSharesRequest{} }
+func (m *QueryTotalSharesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalSharesRequest) ProtoMessage()    {}
+func (*QueryTotalSharesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{38}
+}
+func (m *QueryTotalSharesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalSharesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTotalSharesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalSharesRequest.Merge(m, src)
+}
+func (m *QueryTotalSharesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalSharesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalSharesRequest.DiscardUnknown(m)
 }
 
-func _Query_TotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalLiquidityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/TotalLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalLiquidity(ctx, req.(*QueryTotalLiquidityRequest))
+var xxx_messageInfo_QueryTotalSharesRequest proto.InternalMessageInfo
+
+func (m *QueryTotalSharesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_PoolsWithFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolsWithFilterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolsWithFilter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolsWithFilter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolsWithFilter(ctx, req.(*QueryPoolsWithFilterRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryTotalSharesResponse struct {
+	TotalShares types1.Coin `protobuf:"bytes,1,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
 }
 
-func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/Pool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+func (m *QueryTotalSharesResponse) Reset()         { *m = QueryTotalSharesResponse{} }
+func (m *QueryTotalSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalSharesResponse) ProtoMessage()    {}
+func (*QueryTotalSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{39}
+}
+func (m *QueryTotalSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTotalSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalSharesResponse.Merge(m, src)
+}
+func (m *QueryTotalSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalSharesResponse.DiscardUnknown(m)
 }
 
-func _Query_CalcJoinPoolNoSwapShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCalcJoinPoolNoSwapSharesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CalcJoinPoolNoSwapShares(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/CalcJoinPoolNoSwapShares",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalcJoinPoolNoSwapShares(ctx, req.(*QueryCalcJoinPoolNoSwapSharesRequest))
+var xxx_messageInfo_QueryTotalSharesResponse proto.InternalMessageInfo
+
+func (m *QueryTotalSharesResponse) GetTotalShares() types1.Coin {
+	if m != nil {
+		return m.TotalShares
 	}
-	return interceptor(ctx, in, info, handler)
+	return types1.Coin{}
 }
 
-func _Query_CalcJoinPoolShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCalcJoinPoolSharesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CalcJoinPoolShares(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/CalcJoinPoolShares",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalcJoinPoolShares(ctx, req.(*QueryCalcJoinPoolSharesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// =============================== CalcJoinPoolNoSwapShares
+type QueryCalcJoinPoolNoSwapSharesRequest struct {
+	PoolId   uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokensIn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tokens_in,json=tokensIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_in"`
 }
 
-func _Query_CalcExitPoolCoinsFromShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCalcExitPoolCoinsFromSharesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CalcExitPoolCoinsFromShares(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/CalcExitPoolCoinsFromShares",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalcExitPoolCoinsFromShares(ctx, req.(*QueryCalcExitPoolCoinsFromSharesRequest))
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) Reset()         { *m = QueryCalcJoinPoolNoSwapSharesRequest{} }
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCalcJoinPoolNoSwapSharesRequest) ProtoMessage()    {}
+func (*QueryCalcJoinPoolNoSwapSharesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{40}
+}
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.Merge(m, src)
+}
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest.DiscardUnknown(m)
 }
 
-func _Query_PoolParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolParams(ctx, req.(*QueryPoolParamsRequest))
+var xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesRequest proto.InternalMessageInfo
+
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_TotalPoolLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalPoolLiquidityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalPoolLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/TotalPoolLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalPoolLiquidity(ctx, req.(*QueryTotalPoolLiquidityRequest))
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) GetTokensIn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensIn
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TotalShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalSharesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalShares(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/TotalShares",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalShares(ctx, req.(*QueryTotalSharesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryCalcJoinPoolNoSwapSharesResponse struct {
+	TokensOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tokens_out,json=tokensOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_out" yaml:"tokens_out"`
+	SharesOut github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,2,opt,name=shares_out,json=sharesOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shares_out"`
 }
 
-func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpotPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SpotPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/SpotPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SpotPrice(ctx, req.(*QuerySpotPriceRequest))
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) Reset()         { *m = QueryCalcJoinPoolNoSwapSharesResponse{} }
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCalcJoinPoolNoSwapSharesResponse) ProtoMessage()    {}
+func (*QueryCalcJoinPoolNoSwapSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{41}
+}
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.Merge(m, src)
+}
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse.DiscardUnknown(m)
 }
 
-func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySwapExactAmountInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/EstimateSwapExactAmountIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, req.(*QuerySwapExactAmountInRequest))
+var xxx_messageInfo_QueryCalcJoinPoolNoSwapSharesResponse proto.InternalMessageInfo
+
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) GetTokensOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensOut
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySwapExactAmountOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/EstimateSwapExactAmountOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, req.(*QuerySwapExactAmountOutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QuerySpotPriceRequest defines the gRPC request structure for a SpotPrice
+// query.
+//
+// Deprecated: Do not use.
+type QuerySpotPriceRequest struct {
+	PoolId          uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	BaseAssetDenom  string `protobuf:"bytes,2,opt,name=base_asset_denom,json=baseAssetDenom,proto3" json:"base_asset_denom,omitempty" yaml:"base_asset_denom"`
+	QuoteAssetDenom string `protobuf:"bytes,3,opt,name=quote_asset_denom,json=quoteAssetDenom,proto3" json:"quote_asset_denom,omitempty" yaml:"quote_asset_denom"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.gamm.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "NumPools",
-			Handler:    _Query_NumPools_Handler,
-		},
-		{
-			MethodName: "TotalLiquidity",
-			Handler:    _Query_TotalLiquidity_Handler,
-		},
-		{
-			MethodName: "PoolsWithFilter",
-			Handler:    _Query_PoolsWithFilter_Handler,
-		},
-		{
-			MethodName: "Pool",
-			Handler:    _Query_Pool_Handler,
-		},
-		{
-			MethodName: "CalcJoinPoolNoSwapShares",
-			Handler:    _Query_CalcJoinPoolNoSwapShares_Handler,
-		},
-		{
-			MethodName: "CalcJoinPoolShares",
-			Handler:    _Query_CalcJoinPoolShares_Handler,
-		},
-		{
-			MethodName: "CalcExitPoolCoinsFromShares",
-			Handler:    _Query_CalcExitPoolCoinsFromShares_Handler,
-		},
-		{
-			MethodName: "PoolParams",
-			Handler:    _Query_PoolParams_Handler,
-		},
-		{
-			MethodName: "TotalPoolLiquidity",
-			Handler:    _Query_TotalPoolLiquidity_Handler,
-		},
-		{
-			MethodName: "TotalShares",
-			Handler:    _Query_TotalShares_Handler,
-		},
-		{
-			MethodName: "SpotPrice",
-			Handler:    _Query_SpotPrice_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountIn",
-			Handler:    _Query_EstimateSwapExactAmountIn_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountOut",
-			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/gamm/v1beta1/query.proto",
+func (m *QuerySpotPriceRequest) Reset()         { *m = QuerySpotPriceRequest{} }
+func (m *QuerySpotPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpotPriceRequest) ProtoMessage()    {}
+func (*QuerySpotPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{42}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySpotPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpotPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpotPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QuerySpotPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpotPriceRequest.Merge(m, src)
+}
+func (m *QuerySpotPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpotPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpotPriceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySpotPriceRequest proto.InternalMessageInfo
+
+func (m *QuerySpotPriceRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySpotPriceRequest) GetBaseAssetDenom() string {
+	if m != nil {
+		return m.BaseAssetDenom
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySpotPriceRequest) GetQuoteAssetDenom() string {
+	if m != nil {
+		return m.QuoteAssetDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPoolsWithFilterRequest struct {
+	// String of the coins in single string seperated by comma. Ex)
+	// 10uatom,100uosmo
+	MinLiquidity string             `protobuf:"bytes,1,opt,name=min_liquidity,json=minLiquidity,proto3" json:"min_liquidity,omitempty" yaml:"min_liquidity"`
+	PoolType     string             `protobuf:"bytes,2,opt,name=pool_type,json=poolType,proto3" json:"pool_type,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPoolsWithFilterRequest) Reset()         { *m = QueryPoolsWithFilterRequest{} }
+func (m *QueryPoolsWithFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsWithFilterRequest) ProtoMessage()    {}
+func (*QueryPoolsWithFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{43}
+}
+func (m *QueryPoolsWithFilterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsWithFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsWithFilterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPoolsWithFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsWithFilterRequest.Merge(m, src)
 }
-
-func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPoolsWithFilterRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryPoolsWithFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsWithFilterRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolsWithFilterRequest proto.InternalMessageInfo
+
+func (m *QueryPoolsWithFilterRequest) GetMinLiquidity() string {
+	if m != nil {
+		return m.MinLiquidity
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPoolsWithFilterRequest) GetPoolType() string {
+	if m != nil {
+		return m.PoolType
+	}
+	return ""
 }
 
-func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryPoolsWithFilterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueryPoolsWithFilterResponse struct {
+	Pools []*types.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPoolsWithFilterResponse) Reset()         { *m = QueryPoolsWithFilterResponse{} }
+func (m *QueryPoolsWithFilterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsWithFilterResponse) ProtoMessage()    {}
+func (*QueryPoolsWithFilterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{44}
 }
-
-func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPoolsWithFilterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsWithFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsWithFilterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPoolsWithFilterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsWithFilterResponse.Merge(m, src)
+}
+func (m *QueryPoolsWithFilterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsWithFilterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsWithFilterResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolsWithFilterResponse proto.InternalMessageInfo
+
+func (m *QueryPoolsWithFilterResponse) GetPools() []*types.Any {
+	if m != nil {
+		return m.Pools
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPoolsWithFilterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// QuerySpotPriceResponse defines the gRPC response structure for a SpotPrice
+// query.
+//
+// Deprecated: Do not use.
+type QuerySpotPriceResponse struct {
+	// String of the Dec. Ex) 10.203uatom
+	SpotPrice string `protobuf:"bytes,1,opt,name=spot_price,json=spotPrice,proto3" json:"spot_price,omitempty" yaml:"spot_price"`
+}
+
+func (m *QuerySpotPriceResponse) Reset()         { *m = QuerySpotPriceResponse{} }
+func (m *QuerySpotPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpotPriceResponse) ProtoMessage()    {}
+func (*QuerySpotPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{45}
+}
+func (m *QuerySpotPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpotPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpotPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySpotPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpotPriceResponse.Merge(m, src)
+}
+func (m *QuerySpotPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpotPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpotPriceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryNumPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySpotPriceResponse proto.InternalMessageInfo
+
+func (m *QuerySpotPriceResponse) GetSpotPrice() string {
+	if m != nil {
+		return m.SpotPrice
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryNumPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// =============================== EstimateSwapExactAmountIn
+type QuerySwapExactAmountInRequest struct {
+	Sender  string                     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId  uint64                     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenIn string                     `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	Routes  []types2.SwapAmountInRoute `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes" yaml:"routes"`
 }
 
-func (m *QueryNumPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySwapExactAmountInRequest) Reset()         { *m = QuerySwapExactAmountInRequest{} }
+func (m *QuerySwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountInRequest) ProtoMessage()    {}
+func (*QuerySwapExactAmountInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{46}
+}
+func (m *QuerySwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountInRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapExactAmountInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountInRequest.Merge(m, src)
+}
+func (m *QuerySwapExactAmountInRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapExactAmountInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountInRequest.DiscardUnknown(m)
 }
 
-func (m *QueryNumPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySwapExactAmountInRequest proto.InternalMessageInfo
+
+func (m *QuerySwapExactAmountInRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryNumPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySwapExactAmountInRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *QueryNumPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumPools != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumPools))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySwapExactAmountInRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryCalcJoinPoolSharesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountInRequest) GetRoutes() []types2.SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCalcJoinPoolSharesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySwapExactAmountInResponse struct {
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
 }
 
-func (m *QueryCalcJoinPoolSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokensIn) > 0 {
-		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QuerySwapExactAmountInResponse) Reset()         { *m = QuerySwapExactAmountInResponse{} }
+func (m *QuerySwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountInResponse) ProtoMessage()    {}
+func (*QuerySwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{47}
+}
+func (m *QuerySwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryCalcJoinPoolSharesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountInResponse.Merge(m, src)
+}
+func (m *QuerySwapExactAmountInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountInResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCalcJoinPoolSharesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySwapExactAmountInResponse proto.InternalMessageInfo
+
+// =============================== EstimateSwapExactAmountOut
+type QuerySwapExactAmountOutRequest struct {
+	Sender   string                      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId   uint64                      `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Routes   []types2.SwapAmountOutRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	TokenOut string                      `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty" yaml:"token_out"`
 }
 
-func (m *QueryCalcJoinPoolSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokensOut) > 0 {
-		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size := m.ShareOutAmount.Size()
-		i -= size
-		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAmountOutRequest{} }
+func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
+func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{48}
+}
+func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapExactAmountOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountOutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySwapExactAmountOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountOutRequest.Merge(m, src)
+}
+func (m *QuerySwapExactAmountOutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapExactAmountOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountOutRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySwapExactAmountOutRequest proto.InternalMessageInfo
+
+func (m *QuerySwapExactAmountOutRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySwapExactAmountOutRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ShareInAmount.Size()
-		i -= size
-		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySwapExactAmountOutRequest) GetRoutes() []types2.SwapAmountOutRoute {
+	if m != nil {
+		return m.Routes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySwapExactAmountOutRequest) GetTokenOut() string {
+	if m != nil {
+		return m.TokenOut
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySwapExactAmountOutResponse struct {
+	TokenInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount" yaml:"token_in_amount"`
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokensOut) > 0 {
-		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactAmountOutResponse{} }
+func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
+func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{49}
+}
+func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPoolParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySwapExactAmountOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountOutResponse.Merge(m, src)
 }
-
-func (m *QueryPoolParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySwapExactAmountOutResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryPoolParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySwapExactAmountOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountOutResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPoolParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QuerySwapExactAmountOutResponse proto.InternalMessageInfo
 
-func (m *QueryPoolParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryTotalLiquidityRequest struct {
 }
 
-func (m *QueryPoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTotalLiquidityRequest) Reset()         { *m = QueryTotalLiquidityRequest{} }
+func (m *QueryTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidityRequest) ProtoMessage()    {}
+func (*QueryTotalLiquidityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{50}
+}
+func (m *QueryTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTotalLiquidityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidityRequest.Merge(m, src)
+}
+func (m *QueryTotalLiquidityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTotalPoolLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryTotalLiquidityRequest proto.InternalMessageInfo
+
+type QueryTotalLiquidityResponse struct {
+	Liquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
 }
 
-func (m *QueryTotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryTotalLiquidityResponse) Reset()         { *m = QueryTotalLiquidityResponse{} }
+func (m *QueryTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidityResponse) ProtoMessage()    {}
+func (*QueryTotalLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2e4a69339a7bfd, []int{51}
+}
+func (m *QueryTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTotalLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidityResponse.Merge(m, src)
+}
+func (m *QueryTotalLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTotalLiquidityResponse proto.InternalMessageInfo
+
+func (m *QueryTotalLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Liquidity
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTotalPoolLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryPoolRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolRequest")
+	proto.RegisterType((*QueryPoolResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolResponse")
+	proto.RegisterType((*QueryPoolsRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolsRequest")
+	proto.RegisterType((*QueryPoolsResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolsResponse")
+	proto.RegisterType((*QueryNumPoolsRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryNumPoolsRequest")
+	proto.RegisterType((*QueryNumPoolsResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryNumPoolsResponse")
+	proto.RegisterType((*QueryCalcJoinPoolSharesRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryCalcJoinPoolSharesRequest")
+	proto.RegisterType((*QueryCalcJoinPoolSharesResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryCalcJoinPoolSharesResponse")
+	proto.RegisterType((*QueryCalcExitPoolCoinsFromSharesRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryCalcExitPoolCoinsFromSharesRequest")
+	proto.RegisterType((*QueryCalcExitPoolCoinsFromSharesResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryCalcExitPoolCoinsFromSharesResponse")
+	proto.RegisterType((*QueryPoolParamsRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolParamsRequest")
+	proto.RegisterType((*QueryPoolParamsResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolParamsResponse")
+	proto.RegisterType((*QueryStableSwapAmplificationRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryStableSwapAmplificationRequest")
+	proto.RegisterType((*QueryStableSwapAmplificationResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryStableSwapAmplificationResponse")
+	proto.RegisterType((*QueryLiquidityBootstrappingPoolRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryLiquidityBootstrappingPoolRequest")
+	proto.RegisterType((*QueryLiquidityBootstrappingPoolResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryLiquidityBootstrappingPoolResponse")
+	proto.RegisterType((*TokenWeight)(nil), "dymensionxyz.dymension.gamm.v1beta1.TokenWeight")
+	proto.RegisterType((*QueryLiquidityBootstrappingPriceCurveRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryLiquidityBootstrappingPriceCurveRequest")
+	proto.RegisterType((*QueryLiquidityBootstrappingPriceCurveResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryLiquidityBootstrappingPriceCurveResponse")
+	proto.RegisterType((*PricePoint)(nil), "dymensionxyz.dymension.gamm.v1beta1.PricePoint")
+	proto.RegisterType((*QueryCanonicalPoolRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryCanonicalPoolRequest")
+	proto.RegisterType((*QueryCanonicalPoolResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryCanonicalPoolResponse")
+	proto.RegisterType((*QueryPoolsByDenomRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolsByDenomRequest")
+	proto.RegisterType((*QueryPoolsByDenomResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolsByDenomResponse")
+	proto.RegisterType((*QueryPoolsByPairRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolsByPairRequest")
+	proto.RegisterType((*QueryPoolsByPairResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolsByPairResponse")
+	proto.RegisterType((*PoolSummary)(nil), "dymensionxyz.dymension.gamm.v1beta1.PoolSummary")
+	proto.RegisterType((*DenomSpotPrice)(nil), "dymensionxyz.dymension.gamm.v1beta1.DenomSpotPrice")
+	proto.RegisterType((*QueryPoolStatsRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolStatsRequest")
+	proto.RegisterType((*QueryPoolStatsResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolStatsResponse")
+	proto.RegisterType((*QueryPoolStatsHistoryRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolStatsHistoryRequest")
+	proto.RegisterType((*QueryPoolStatsHistoryResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolStatsHistoryResponse")
+	proto.RegisterType((*QueryPoolAPRRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolAPRRequest")
+	proto.RegisterType((*QueryPoolAPRResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolAPRResponse")
+	proto.RegisterType((*QueryTotalPoolLiquidityRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryTotalPoolLiquidityRequest")
+	proto.RegisterType((*QueryTotalPoolLiquidityResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryTotalPoolLiquidityResponse")
+	proto.RegisterType((*QueryTotalSharesRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryTotalSharesRequest")
+	proto.RegisterType((*QueryTotalSharesResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryTotalSharesResponse")
+	proto.RegisterType((*QueryCalcJoinPoolNoSwapSharesRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryCalcJoinPoolNoSwapSharesRequest")
+	proto.RegisterType((*QueryCalcJoinPoolNoSwapSharesResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryCalcJoinPoolNoSwapSharesResponse")
+	proto.RegisterType((*QuerySpotPriceRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QuerySpotPriceRequest")
+	proto.RegisterType((*QueryPoolsWithFilterRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolsWithFilterRequest")
+	proto.RegisterType((*QueryPoolsWithFilterResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryPoolsWithFilterResponse")
+	proto.RegisterType((*QuerySpotPriceResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QuerySpotPriceResponse")
+	proto.RegisterType((*QuerySwapExactAmountInRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QuerySwapExactAmountInRequest")
+	proto.RegisterType((*QuerySwapExactAmountInResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QuerySwapExactAmountInResponse")
+	proto.RegisterType((*QuerySwapExactAmountOutRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QuerySwapExactAmountOutRequest")
+	proto.RegisterType((*QuerySwapExactAmountOutResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QuerySwapExactAmountOutResponse")
+	proto.RegisterType((*QueryTotalLiquidityRequest)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryTotalLiquidityRequest")
+	proto.RegisterType((*QueryTotalLiquidityResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.QueryTotalLiquidityResponse")
 }
 
-func (m *QueryTotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/gamm/v1beta1/query.proto", fileDescriptor_3e2e4a69339a7bfd)
 }
 
-func (m *QueryTotalSharesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_3e2e4a69339a7bfd = []byte{
+	// 3140 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x59, 0x8c, 0x1c, 0x47,
+	0x19, 0x76, 0xcf, 0x9e, 0xf3, 0xef, 0xe9, 0xf2, 0x7a, 0x77, 0xdd, 0x76, 0x76, 0xac, 0x4a, 0x70,
+	0x2e, 0x7b, 0xc6, 0x67, 0x8e, 0xf5, 0x95, 0x9d, 0xdd, 0xb5, 0x3d, 0x8e, 0xaf, 0xb4, 0x9d, 0x83,
+	0x80, 0xe8, 0xf4, 0xcc, 0xb4, 0x67, 0x3b, 0x99, 0xe9, 0x1e, 0x4f, 0xf7, 0xd8, 0x9e, 0x44, 0x11,
+	0x22, 0x2f, 0x1c, 0x12, 0x22, 0x22, 0x84, 0x87, 0x08, 0x21, 0xc4, 0x23, 0xe1, 0x01, 0x09, 0x24,
+	0x84, 0x38, 0x04, 0x3c, 0xa0, 0x08, 0x01, 0x0a, 0x44, 0x48, 0x28, 0x48, 0x1b, 0x94, 0xc0, 0x1b,
+	0x0f, 0x68, 0x5f, 0x10, 0x79, 0x40, 0xa8, 0xaa, 0xfe, 0xbe, 0x66, 0x76, 0x77, 0xba, 0x7b, 0xc7,
+	0x51, 0xc2, 0xd3, 0xee, 0x54, 0xd5, 0xff, 0xfd, 0x57, 0xf5, 0x5f, 0x7f, 0xfd, 0x7f, 0x41, 0xae,
+	0xdc, 0xaa, 0xe9, 0xa6, 0x6d, 0x58, 0xe6, 0xed, 0xd6, 0x8b, 0xfe, 0x8f, 0x5c, 0x45, 0xab, 0xd5,
+	0x72, 0x37, 0x0f, 0x15, 0x75, 0x47, 0x3b, 0x94, 0xbb, 0xd1, 0xd4, 0x1b, 0xad, 0x6c, 0xbd, 0x61,
+	0x39, 0x16, 0xb9, 0x3b, 0x48, 0x90, 0xf5, 0x7e, 0x64, 0x19, 0x41, 0x16, 0x09, 0xe4, 0xa9, 0x8a,
+	0x55, 0xb1, 0xf8, 0xfa, 0x1c, 0xfb, 0x4f, 0x90, 0xca, 0x87, 0xa2, 0xf0, 0xaa, 0xe8, 0xa6, 0x6e,
+	0x1b, 0x36, 0x92, 0x1c, 0x8c, 0x42, 0x62, 0xaf, 0x68, 0x0d, 0xbd, 0x8c, 0x14, 0xc7, 0x37, 0xa0,
+	0xa8, 0x5b, 0x56, 0xb5, 0xa6, 0x99, 0x5a, 0x45, 0x6f, 0xf8, 0x84, 0xb7, 0xb4, 0xba, 0xda, 0xb0,
+	0x9a, 0x8e, 0x8e, 0xc4, 0x73, 0x25, 0xcb, 0xae, 0x59, 0x76, 0xae, 0xa8, 0xd9, 0xba, 0xb7, 0xaa,
+	0x64, 0x19, 0x26, 0xce, 0x3f, 0x10, 0x9c, 0xe7, 0x56, 0xf1, 0x56, 0xd5, 0xb5, 0x8a, 0x61, 0x6a,
+	0x8e, 0x61, 0xb9, 0x6b, 0xf7, 0x54, 0x2c, 0xab, 0x52, 0xd5, 0x73, 0x5a, 0xdd, 0xc8, 0x69, 0xa6,
+	0x69, 0x39, 0x7c, 0xd2, 0x55, 0x6c, 0x17, 0xce, 0xf2, 0x5f, 0xc5, 0xe6, 0xf5, 0x9c, 0x66, 0xa2,
+	0x85, 0xe5, 0x4c, 0xfb, 0x94, 0x63, 0xd4, 0x74, 0xdb, 0xd1, 0x6a, 0x75, 0x97, 0x56, 0x48, 0xa1,
+	0x0a, 0x03, 0x8b, 0x1f, 0x62, 0x8a, 0x4e, 0x01, 0x79, 0x82, 0x89, 0x75, 0x45, 0x6b, 0x68, 0x35,
+	0x5b, 0xd1, 0x6f, 0x34, 0x75, 0xdb, 0xa1, 0xcf, 0xc1, 0x8e, 0xd0, 0xa8, 0x5d, 0xb7, 0x4c, 0x5b,
+	0x27, 0x05, 0x18, 0xac, 0xf3, 0x91, 0x59, 0x69, 0xaf, 0x74, 0xdf, 0xc8, 0xe1, 0x07, 0xb3, 0x11,
+	0x7c, 0x9b, 0x15, 0x20, 0xf9, 0xfe, 0xb7, 0x56, 0x33, 0xdb, 0x14, 0x04, 0xa0, 0xa7, 0x61, 0x52,
+	0x70, 0xb0, 0xac, 0x2a, 0x72, 0x25, 0x0f, 0xc2, 0x10, 0x33, 0xba, 0x6a, 0x94, 0x39, 0x7e, 0x7f,
+	0x9e, 0xac, 0xad, 0x66, 0xc6, 0x5b, 0x5a, 0xad, 0x3a, 0x4f, 0x71, 0x82, 0x2a, 0x83, 0xec, 0xbf,
+	0x42, 0x99, 0x9e, 0x83, 0xed, 0x01, 0x00, 0x14, 0xf0, 0x08, 0xf4, 0xb3, 0x69, 0x14, 0x6f, 0x2a,
+	0x2b, 0x0c, 0x93, 0x75, 0x0d, 0x93, 0x5d, 0x30, 0x5b, 0xf9, 0xf4, 0x6f, 0x7f, 0x74, 0x60, 0x80,
+	0x51, 0x15, 0x14, 0xbe, 0x98, 0x7e, 0x26, 0x80, 0xe4, 0x5a, 0x80, 0x9c, 0x01, 0xf0, 0x1d, 0x34,
+	0x9b, 0xe2, 0x78, 0xfb, 0xb2, 0x68, 0x3a, 0xe6, 0xcd, 0xac, 0xd8, 0xe3, 0xbe, 0x92, 0x15, 0x1d,
+	0x69, 0x95, 0x00, 0x25, 0xfd, 0x86, 0x04, 0x24, 0x88, 0x8e, 0x82, 0x1e, 0x83, 0x01, 0xc6, 0x9b,
+	0x19, 0xb2, 0x2f, 0x8a, 0xa4, 0x62, 0x35, 0x39, 0xbb, 0x8e, 0x54, 0xf7, 0x76, 0x95, 0x4a, 0xf0,
+	0x0c, 0x89, 0x25, 0xc3, 0x14, 0x97, 0xea, 0x52, 0xb3, 0x16, 0x54, 0x7b, 0x3e, 0x35, 0x2b, 0xd1,
+	0x4b, 0xb0, 0xb3, 0x6d, 0x0e, 0x85, 0x3e, 0x04, 0x69, 0xb3, 0x59, 0x53, 0x5d, 0xc1, 0x99, 0x87,
+	0xa6, 0xd6, 0x56, 0x33, 0x93, 0xc2, 0x43, 0xde, 0x14, 0x55, 0x86, 0x4d, 0x24, 0xe5, 0x78, 0x3f,
+	0x96, 0x60, 0x8e, 0x03, 0x2e, 0x6a, 0xd5, 0xd2, 0x79, 0xcb, 0x30, 0xd9, 0xd4, 0x55, 0xf6, 0x09,
+	0xda, 0x49, 0x3c, 0x4f, 0x56, 0x20, 0xed, 0x58, 0x2f, 0xe8, 0xa6, 0xad, 0x1a, 0xcc, 0x06, 0xcc,
+	0x7e, 0xbb, 0x42, 0x36, 0x70, 0xb5, 0x5f, 0xb4, 0x0c, 0x33, 0x7f, 0x90, 0x6d, 0xbb, 0xef, 0xbd,
+	0x97, 0xb9, 0xaf, 0x62, 0x38, 0x2b, 0xcd, 0x62, 0xb6, 0x64, 0xd5, 0xf0, 0x0b, 0xc0, 0x3f, 0x07,
+	0xec, 0xf2, 0x0b, 0x39, 0xa7, 0x55, 0xd7, 0x6d, 0x4e, 0x60, 0x2b, 0xc3, 0x02, 0xbd, 0x60, 0xd2,
+	0x57, 0x52, 0x90, 0xd9, 0x50, 0x72, 0x34, 0x8a, 0x0d, 0x93, 0x3c, 0x9c, 0xa8, 0x56, 0xd3, 0x51,
+	0xb5, 0x9a, 0xd5, 0x34, 0x1d, 0xae, 0x43, 0x3a, 0x5f, 0x60, 0x9c, 0xdf, 0x5d, 0xcd, 0xec, 0x8b,
+	0xc0, 0xb9, 0x60, 0x3a, 0x6b, 0xab, 0x99, 0x19, 0xa1, 0x71, 0x3b, 0x1e, 0x55, 0xc6, 0xf9, 0xd0,
+	0xe5, 0xa6, 0xb3, 0xc0, 0x07, 0xc8, 0xf3, 0x00, 0x68, 0x02, 0xab, 0xe9, 0xdc, 0x09, 0x1b, 0xa0,
+	0x85, 0x2f, 0x37, 0x1d, 0xfa, 0x86, 0x04, 0xf7, 0x7a, 0x46, 0x58, 0xbe, 0x6d, 0x38, 0xcc, 0x08,
+	0x7c, 0xd5, 0x99, 0x86, 0x55, 0x0b, 0xfb, 0x71, 0xa6, 0xcd, 0x8f, 0x9e, 0xcf, 0x9e, 0x82, 0x09,
+	0xa1, 0x95, 0x61, 0xba, 0x46, 0x4a, 0x71, 0x23, 0x65, 0xe3, 0x19, 0x49, 0x19, 0xe3, 0x30, 0x05,
+	0x53, 0x18, 0x82, 0x7e, 0x53, 0x82, 0xfb, 0xba, 0x0b, 0x87, 0xae, 0x0a, 0x5b, 0x4d, 0xba, 0xa3,
+	0x56, 0x5b, 0x86, 0x69, 0xef, 0xb3, 0x0f, 0xc5, 0xd6, 0x78, 0x51, 0xee, 0x2c, 0xcc, 0x74, 0xc0,
+	0xa0, 0x36, 0xfb, 0xdb, 0x82, 0xf1, 0xba, 0x31, 0xc4, 0x8b, 0xb7, 0x0a, 0xdc, 0xcd, 0x81, 0xae,
+	0x3a, 0x5a, 0xb1, 0xaa, 0x5f, 0xbd, 0xa5, 0xd5, 0x17, 0x6a, 0xf5, 0xaa, 0x71, 0xdd, 0x28, 0xf1,
+	0x80, 0x90, 0x48, 0xb8, 0x2f, 0xf7, 0xc3, 0x3d, 0x9b, 0x83, 0xa2, 0xa8, 0xa7, 0x60, 0x4c, 0x0b,
+	0x4e, 0x20, 0xf6, 0xec, 0xda, 0x6a, 0x66, 0x4a, 0x60, 0x87, 0xa6, 0xa9, 0x12, 0x5e, 0x4e, 0xf6,
+	0xc3, 0x50, 0x43, 0xab, 0xd5, 0x0d, 0xb3, 0xc2, 0x77, 0xcd, 0x70, 0x50, 0x2a, 0x9c, 0xa0, 0x8a,
+	0xbb, 0x84, 0x3c, 0x09, 0x3b, 0x0d, 0xd3, 0x70, 0x0c, 0xad, 0xaa, 0x86, 0xb9, 0xf6, 0x71, 0xae,
+	0x7b, 0xd7, 0x56, 0x33, 0x7b, 0x04, 0xed, 0xba, 0xcb, 0xa8, 0x32, 0x85, 0xe3, 0x21, 0x65, 0x88,
+	0x02, 0x53, 0x8e, 0xd6, 0xa8, 0xe8, 0x4e, 0x1b, 0x6a, 0x3f, 0x47, 0xcd, 0xac, 0xad, 0x66, 0x76,
+	0x0b, 0xd4, 0xf5, 0x56, 0x51, 0x65, 0x87, 0x18, 0x0e, 0x63, 0x5e, 0x87, 0x09, 0x26, 0xb5, 0x6a,
+	0x3b, 0x5a, 0xc3, 0x51, 0xd9, 0xb1, 0x3d, 0x3b, 0xc0, 0x9d, 0x29, 0x77, 0x38, 0xf3, 0x9a, 0x7b,
+	0xa6, 0xe7, 0x29, 0xdb, 0x97, 0x6b, 0xab, 0x99, 0x69, 0xdf, 0x00, 0x01, 0x00, 0xfa, 0xea, 0x7b,
+	0x19, 0x49, 0x19, 0x63, 0xa3, 0x57, 0xd9, 0x20, 0xa3, 0x23, 0xcf, 0x01, 0x1f, 0x50, 0x75, 0xb3,
+	0x2c, 0xb8, 0x0c, 0x76, 0xe5, 0xb2, 0x17, 0xb9, 0x4c, 0x05, 0xb8, 0xb8, 0xe4, 0x82, 0xc7, 0x08,
+	0x1b, 0x5b, 0x36, 0xcb, 0x8c, 0x86, 0x3e, 0x09, 0xfb, 0xf8, 0x56, 0xb8, 0x60, 0xdc, 0x68, 0x1a,
+	0x65, 0xc3, 0x69, 0xe5, 0x2d, 0xcb, 0xb1, 0x9d, 0x86, 0x56, 0x67, 0x3e, 0x49, 0x7c, 0xca, 0xff,
+	0xb5, 0x0f, 0xee, 0xed, 0x8a, 0xeb, 0x7d, 0x10, 0x43, 0xa5, 0x86, 0xae, 0x39, 0x56, 0x03, 0x03,
+	0x70, 0x00, 0x18, 0x27, 0xa8, 0xe2, 0x2e, 0x21, 0xcf, 0x00, 0x04, 0xac, 0x9e, 0xea, 0x6a, 0x8f,
+	0xbb, 0xd0, 0x1e, 0xdb, 0x05, 0x60, 0xbb, 0xc1, 0xd3, 0xb6, 0x67, 0x6c, 0x05, 0x86, 0x3d, 0x3b,
+	0xf7, 0x75, 0xc5, 0xdd, 0x8d, 0xb8, 0x13, 0x02, 0x37, 0x6c, 0xe2, 0x21, 0x5d, 0x98, 0x97, 0xcc,
+	0xc3, 0x28, 0x9b, 0x29, 0xea, 0x2b, 0xda, 0x4d, 0xc3, 0x6a, 0xf0, 0x4d, 0x97, 0xce, 0xcf, 0xac,
+	0xad, 0x66, 0x76, 0xf8, 0x74, 0xee, 0x2c, 0x55, 0x46, 0x74, 0xb3, 0x9c, 0xc7, 0x5f, 0xe4, 0x28,
+	0x80, 0xad, 0x55, 0x75, 0xe6, 0x3d, 0xbd, 0xcc, 0xf7, 0xd7, 0x70, 0x7e, 0x67, 0x40, 0x13, 0x6f,
+	0x8e, 0x2a, 0x69, 0xf6, 0x63, 0x99, 0xfd, 0x4f, 0x8a, 0x30, 0x74, 0x4b, 0x37, 0x2a, 0x2b, 0x8e,
+	0x3d, 0x3b, 0xc8, 0x23, 0xe5, 0xc1, 0x48, 0xc9, 0xde, 0x35, 0x16, 0x01, 0x9f, 0xe6, 0x84, 0xf9,
+	0x69, 0x54, 0x0d, 0x7d, 0x80, 0x70, 0x54, 0x71, 0x81, 0xe9, 0x17, 0x53, 0x30, 0x12, 0x20, 0x20,
+	0xfb, 0x60, 0xa0, 0xac, 0x9b, 0x56, 0x0d, 0xfd, 0x37, 0xb9, 0xb6, 0x9a, 0x19, 0x15, 0xb4, 0x7c,
+	0x98, 0x2a, 0x62, 0x9a, 0x3c, 0x0d, 0x83, 0x02, 0x02, 0x0f, 0x91, 0xd3, 0xb1, 0x4f, 0xda, 0xb1,
+	0xa0, 0x48, 0x54, 0x41, 0x38, 0x72, 0x0b, 0xb6, 0x9b, 0x56, 0xa3, 0xa6, 0x55, 0x8d, 0x17, 0xf5,
+	0xb2, 0x8a, 0x3c, 0xfa, 0x38, 0x8f, 0xf3, 0x31, 0x78, 0x2c, 0xe9, 0xa5, 0xb5, 0xd5, 0xcc, 0xac,
+	0xe0, 0xd1, 0x01, 0x48, 0x95, 0x49, 0x7f, 0x4c, 0x68, 0x4e, 0xbf, 0x9b, 0x82, 0xfd, 0x9b, 0xed,
+	0xf3, 0x86, 0x51, 0xd2, 0x17, 0x9b, 0x8d, 0x9b, 0x7a, 0xa2, 0x8c, 0x69, 0x19, 0x26, 0xd9, 0xf1,
+	0xa6, 0x6a, 0xb6, 0xad, 0x3b, 0xaa, 0x30, 0xb1, 0xb0, 0xdc, 0x6e, 0x3f, 0xeb, 0x68, 0x5f, 0x41,
+	0x95, 0x71, 0x36, 0xb4, 0xc0, 0x46, 0x96, 0xb8, 0xd9, 0xcf, 0xc1, 0xf6, 0x1b, 0x4d, 0xcb, 0x09,
+	0xe3, 0x08, 0xeb, 0xec, 0xf1, 0xf5, 0xed, 0x58, 0x42, 0x95, 0x09, 0x3e, 0x16, 0x40, 0x3a, 0x0a,
+	0x20, 0xd2, 0x45, 0xc3, 0x74, 0x6c, 0x8c, 0xa0, 0x81, 0x2d, 0xe9, 0xcf, 0x51, 0x25, 0xcd, 0x73,
+	0x49, 0xfe, 0xff, 0xd7, 0x24, 0x38, 0x10, 0xd1, 0x48, 0x18, 0x12, 0x3e, 0x07, 0x83, 0xc8, 0x43,
+	0x9c, 0xf6, 0xb9, 0x68, 0x17, 0x16, 0x06, 0xc4, 0x79, 0xe6, 0x77, 0xe2, 0x16, 0x1e, 0x73, 0x2d,
+	0x2b, 0x84, 0x42, 0x54, 0xfa, 0x13, 0x09, 0xc0, 0x5f, 0x4d, 0xce, 0x42, 0x3f, 0xff, 0xea, 0xa5,
+	0xae, 0x5f, 0xfd, 0x0c, 0xe2, 0x8e, 0xe0, 0x91, 0xe1, 0x7d, 0xf1, 0x1c, 0x80, 0x14, 0x01, 0xec,
+	0xba, 0xe5, 0xa8, 0x75, 0x86, 0x8d, 0xae, 0x5a, 0x8c, 0xbd, 0x01, 0xdd, 0x0f, 0xdc, 0x43, 0x62,
+	0x1f, 0x78, 0xdd, 0x72, 0xb8, 0xc4, 0xb4, 0x09, 0xbb, 0x30, 0x73, 0x32, 0x2d, 0xd3, 0x28, 0x69,
+	0xd5, 0xb6, 0x20, 0xcd, 0x7d, 0xa7, 0x6a, 0x9d, 0xb1, 0x14, 0x27, 0xa8, 0x32, 0xc8, 0xff, 0x5b,
+	0xf0, 0x17, 0x17, 0x67, 0x53, 0xeb, 0x2f, 0x2e, 0xba, 0x8b, 0xf3, 0xb4, 0x00, 0xf2, 0x7a, 0x6c,
+	0xd1, 0x61, 0xb1, 0x0e, 0x87, 0xaf, 0x48, 0x30, 0xeb, 0xdf, 0xad, 0xf2, 0x2d, 0xbe, 0xb7, 0x5c,
+	0x0d, 0xa2, 0xc6, 0x92, 0x5e, 0x5d, 0xf4, 0x7e, 0x2d, 0xc1, 0xae, 0x75, 0x84, 0x41, 0xbd, 0x3e,
+	0x1b, 0xbe, 0xef, 0x45, 0x8b, 0xa5, 0xfc, 0xb6, 0xd1, 0xac, 0xd5, 0xb4, 0x46, 0x2b, 0x3f, 0x85,
+	0x1b, 0x66, 0xd4, 0xb7, 0x85, 0x4d, 0x7b, 0x7e, 0x2d, 0xfc, 0xa9, 0x14, 0xc8, 0x37, 0xed, 0x7c,
+	0xeb, 0x8a, 0x66, 0x34, 0xee, 0xf8, 0x96, 0x68, 0x73, 0x41, 0x5f, 0x62, 0x17, 0xfc, 0xaa, 0x6d,
+	0x3f, 0x08, 0xe9, 0x3f, 0x59, 0x1e, 0x78, 0x33, 0x05, 0x23, 0x01, 0xae, 0xf1, 0xe2, 0xfc, 0xcb,
+	0x90, 0xae, 0xba, 0xa1, 0xb1, 0xfb, 0xad, 0x70, 0x09, 0x15, 0xc2, 0xfb, 0xbb, 0x47, 0x49, 0xe3,
+	0xdd, 0x79, 0x3c, 0x3a, 0x52, 0x87, 0x11, 0x3f, 0xd6, 0xd8, 0xb3, 0x7d, 0x5c, 0x80, 0x23, 0x91,
+	0x0c, 0xcd, 0xbf, 0x96, 0xab, 0x6e, 0x6c, 0xca, 0xcb, 0x28, 0x1a, 0x69, 0x8f, 0x60, 0xec, 0x22,
+	0xe3, 0x85, 0x30, 0x9b, 0xfe, 0x42, 0x82, 0xf1, 0x30, 0xe9, 0xfa, 0x87, 0x94, 0x94, 0xe4, 0x90,
+	0xfa, 0x28, 0x82, 0xf0, 0x12, 0xd6, 0x5a, 0xb8, 0xcb, 0x1d, 0xcd, 0x49, 0x76, 0x4b, 0xfc, 0x8f,
+	0x04, 0xd3, 0xed, 0x30, 0xb8, 0xed, 0x9f, 0x85, 0x01, 0x9b, 0x0d, 0xe0, 0x99, 0x94, 0x8d, 0xbe,
+	0xed, 0x19, 0x55, 0xfb, 0xa6, 0xe7, 0x50, 0x54, 0x11, 0x90, 0xe4, 0x15, 0x09, 0x76, 0x94, 0x9a,
+	0x8d, 0x86, 0x6e, 0x3a, 0xaa, 0x5e, 0xb7, 0x4a, 0x2b, 0xaa, 0x60, 0x95, 0x4a, 0xc4, 0xca, 0xbd,
+	0xd6, 0xc8, 0x98, 0xb1, 0x77, 0x02, 0x53, 0x65, 0x3b, 0x8e, 0x2e, 0xb3, 0x41, 0x4e, 0x46, 0xbf,
+	0x20, 0xc1, 0x9e, 0xb0, 0xee, 0xe7, 0x0c, 0xdb, 0xb1, 0x1a, 0xad, 0x44, 0x99, 0x12, 0x26, 0x26,
+	0x9c, 0xa9, 0x50, 0xa4, 0x23, 0x31, 0x11, 0x73, 0x22, 0x31, 0x59, 0x16, 0xff, 0xbf, 0x04, 0x77,
+	0x6d, 0x20, 0x42, 0xa7, 0x17, 0xfa, 0x7a, 0xec, 0x05, 0x9a, 0x77, 0x6b, 0xb5, 0x96, 0x55, 0x5d,
+	0xb8, 0xa2, 0x24, 0xda, 0x40, 0xef, 0xf6, 0xc1, 0x54, 0x18, 0x04, 0x05, 0x8f, 0x7a, 0x8a, 0xde,
+	0x80, 0x09, 0x2f, 0x0e, 0xa8, 0x37, 0xb5, 0x6a, 0xd3, 0xfd, 0x60, 0xce, 0xc5, 0xfe, 0x60, 0xa6,
+	0xdb, 0xc2, 0x91, 0x80, 0xa3, 0xca, 0xb8, 0x37, 0xf2, 0x14, 0x1b, 0x20, 0x15, 0x18, 0xe5, 0xe5,
+	0xf8, 0xeb, 0xba, 0xae, 0x6a, 0xf5, 0x06, 0x26, 0xa2, 0xcb, 0xb1, 0xf9, 0xe1, 0x05, 0x2a, 0x88,
+	0xc5, 0x82, 0xcc, 0x2d, 0xad, 0x7e, 0x46, 0xd7, 0x17, 0xea, 0x0d, 0x62, 0xc2, 0xb8, 0x61, 0x96,
+	0x74, 0xd3, 0x31, 0x6e, 0xea, 0x36, 0x67, 0x25, 0x6e, 0x5f, 0x67, 0x63, 0xb3, 0xda, 0xe9, 0x96,
+	0x1d, 0x82, 0x68, 0x54, 0x19, 0xf3, 0x07, 0x18, 0xbf, 0x4b, 0xd0, 0xc7, 0x98, 0x0c, 0x70, 0x26,
+	0x27, 0x62, 0x33, 0x01, 0xc1, 0x84, 0x23, 0x33, 0x20, 0x7a, 0x11, 0xcb, 0xaf, 0xd7, 0x2c, 0x47,
+	0x64, 0x5b, 0x5e, 0xfa, 0x9c, 0x68, 0xaf, 0x7c, 0x47, 0x82, 0xcc, 0x86, 0x78, 0xb8, 0x6d, 0x42,
+	0x07, 0x91, 0xf4, 0x51, 0x1f, 0x44, 0xf4, 0x0c, 0xcc, 0xf8, 0x12, 0x26, 0xaf, 0x34, 0xd3, 0x26,
+	0xcc, 0x76, 0xe2, 0xa0, 0x8a, 0x9f, 0x86, 0x51, 0x87, 0x0d, 0xab, 0xbc, 0x20, 0xe9, 0xc6, 0xd7,
+	0x4d, 0xb4, 0x74, 0x2f, 0xfa, 0xb8, 0xdf, 0x82, 0xc4, 0x54, 0x19, 0x71, 0x7c, 0x16, 0xf4, 0xe7,
+	0x12, 0xdc, 0xe3, 0x15, 0x35, 0xdd, 0xb2, 0xf3, 0x25, 0x8b, 0xd5, 0xd7, 0x3e, 0x11, 0x65, 0xf3,
+	0x7f, 0x4b, 0xf0, 0xa9, 0x2e, 0xf2, 0xa3, 0x11, 0x3f, 0x1f, 0xaf, 0x22, 0xbb, 0x1c, 0xae, 0xc1,
+	0xf8, 0xa4, 0x34, 0x61, 0x99, 0x96, 0x5c, 0x04, 0x10, 0x2e, 0xc0, 0x42, 0x7a, 0x92, 0x92, 0x74,
+	0x5a, 0x20, 0xb0, 0xaa, 0xef, 0x3f, 0x25, 0x3c, 0xcf, 0xbd, 0x7c, 0xe4, 0xff, 0xe2, 0xbe, 0xce,
+	0xda, 0x38, 0xe7, 0xfb, 0x87, 0xfb, 0x27, 0x07, 0x94, 0x91, 0x5b, 0x86, 0xb3, 0x72, 0x55, 0x04,
+	0x47, 0x96, 0x70, 0xef, 0xf6, 0x13, 0xee, 0xa7, 0x0d, 0x67, 0xe5, 0x8c, 0x51, 0x75, 0x74, 0xef,
+	0xca, 0x70, 0x12, 0xc6, 0x6a, 0x86, 0xa9, 0x06, 0x43, 0x01, 0x63, 0x1e, 0xa8, 0xfb, 0x86, 0xa6,
+	0xa9, 0x32, 0x5a, 0x33, 0x4c, 0x2f, 0x9a, 0x90, 0xdd, 0x90, 0xe6, 0xa6, 0x61, 0xa6, 0x16, 0xfa,
+	0x2b, 0xc3, 0x6c, 0xe0, 0x5a, 0xab, 0xae, 0xf7, 0xec, 0xd2, 0xf0, 0xed, 0x60, 0xfe, 0x10, 0xd2,
+	0xe1, 0x63, 0xd2, 0xaa, 0x53, 0x60, 0xba, 0x7d, 0x4b, 0xa1, 0x64, 0x47, 0x43, 0x09, 0xaa, 0xb0,
+	0xed, 0xce, 0x6e, 0x29, 0x27, 0x6f, 0xc9, 0xbd, 0x9e, 0xc2, 0x8c, 0x85, 0x79, 0x72, 0xf9, 0xb6,
+	0x56, 0xc2, 0xc6, 0x52, 0xc1, 0x6b, 0x04, 0xdc, 0x0f, 0x83, 0xb6, 0x6e, 0x96, 0x75, 0xb7, 0x96,
+	0xba, 0xdd, 0x2f, 0x82, 0x88, 0x71, 0xaa, 0xe0, 0x82, 0xe0, 0xd6, 0x4e, 0x75, 0xdd, 0xda, 0x59,
+	0x10, 0x71, 0x42, 0x35, 0x84, 0xd3, 0xd2, 0xf9, 0x1d, 0x7e, 0xf1, 0xd3, 0x9d, 0xa1, 0xca, 0x10,
+	0xff, 0xb7, 0x60, 0x92, 0x2a, 0x0c, 0xf2, 0x7e, 0x3b, 0xab, 0x12, 0x31, 0xf3, 0x9f, 0xdc, 0x28,
+	0x75, 0x0a, 0xb4, 0xeb, 0x3d, 0x9b, 0x8a, 0x8e, 0x04, 0x2a, 0xc6, 0x50, 0xda, 0xeb, 0x39, 0x02,
+	0x9a, 0x2a, 0xc8, 0x83, 0xbe, 0xee, 0xb6, 0x2a, 0xd7, 0xb1, 0x8b, 0xdf, 0xef, 0x13, 0x62, 0xf6,
+	0xae, 0xdf, 0xd7, 0x8e, 0x47, 0x95, 0x71, 0x3e, 0xe4, 0xf5, 0xfb, 0xe8, 0x1b, 0xa9, 0xf5, 0xe5,
+	0xba, 0xdc, 0x74, 0xee, 0xb4, 0xc3, 0x6a, 0x9e, 0x03, 0xc4, 0x7d, 0xee, 0x54, 0x32, 0x07, 0x30,
+	0x49, 0x23, 0x78, 0x80, 0xf5, 0x98, 0x3d, 0x73, 0x60, 0x9e, 0x15, 0xe8, 0x31, 0x7b, 0x53, 0x14,
+	0x8f, 0x1b, 0x16, 0x74, 0x5f, 0x73, 0x13, 0x92, 0xf5, 0x8c, 0x83, 0x5e, 0xab, 0xc3, 0x84, 0xbb,
+	0xb9, 0xc2, 0x4e, 0x3b, 0x17, 0xdb, 0x69, 0xd3, 0xe1, 0xbd, 0xea, 0xf9, 0x6c, 0x0c, 0xb7, 0x2c,
+	0xba, 0x6c, 0x0f, 0xc8, 0x7e, 0xee, 0xd0, 0x9e, 0x71, 0xd1, 0x6f, 0xb9, 0x91, 0xb3, 0x7d, 0xfa,
+	0x63, 0x91, 0x40, 0x1d, 0xfe, 0xc3, 0xfd, 0x30, 0xc0, 0xc5, 0x23, 0x3f, 0x90, 0x60, 0x50, 0x34,
+	0x1e, 0xc9, 0xc3, 0x91, 0x6e, 0x2d, 0x9d, 0xaf, 0x49, 0xe4, 0x47, 0xe2, 0x13, 0x0a, 0x33, 0xd0,
+	0x23, 0xaf, 0xbc, 0xf3, 0xf7, 0xd7, 0x52, 0x07, 0xc8, 0x83, 0x91, 0x5e, 0x1d, 0x89, 0x56, 0x27,
+	0xf9, 0xbe, 0x04, 0x3c, 0x14, 0xdb, 0xe4, 0xa1, 0x18, 0x8c, 0x03, 0xaf, 0x20, 0xe4, 0x87, 0x63,
+	0xd3, 0xa1, 0xbc, 0x87, 0xb9, 0xbc, 0xfb, 0xc9, 0x03, 0xd1, 0xe4, 0xe5, 0x42, 0xfe, 0x4c, 0x82,
+	0x61, 0xf7, 0xa9, 0x05, 0x79, 0x34, 0x3a, 0xe7, 0xb6, 0xa7, 0x1b, 0xf2, 0x7c, 0x12, 0x52, 0x94,
+	0xfb, 0x51, 0x2e, 0xf7, 0x41, 0x92, 0x8d, 0x24, 0xb7, 0xf7, 0xd2, 0xe3, 0x4b, 0x29, 0x89, 0xfc,
+	0x51, 0x82, 0xf1, 0xf0, 0x26, 0x26, 0xa7, 0xa3, 0x4b, 0xb2, 0xee, 0xd7, 0x21, 0x3f, 0x96, 0x1c,
+	0x00, 0x15, 0x3a, 0xc1, 0x15, 0x7a, 0x88, 0x1c, 0x8d, 0xa4, 0x90, 0xc8, 0xc5, 0xfd, 0x42, 0xd6,
+	0x3b, 0x12, 0x4c, 0xb4, 0xa5, 0x03, 0xe4, 0xb1, 0x98, 0x7b, 0xa2, 0x23, 0x1b, 0x92, 0x17, 0xb6,
+	0x80, 0x80, 0x6a, 0x1d, 0xe7, 0x6a, 0x1d, 0x23, 0x47, 0x22, 0xa9, 0x75, 0x9d, 0x13, 0xeb, 0x65,
+	0xe1, 0x2c, 0xf2, 0x43, 0x09, 0xfa, 0x19, 0x30, 0x39, 0x16, 0x4f, 0x10, 0x57, 0xfe, 0x87, 0xe2,
+	0x92, 0x25, 0xf2, 0x05, 0x97, 0x35, 0xf7, 0x12, 0x9e, 0x43, 0x2f, 0x33, 0xa9, 0x67, 0x37, 0xba,
+	0x47, 0x90, 0x42, 0x74, 0x91, 0xba, 0xdc, 0xa5, 0xe4, 0xf3, 0xbd, 0x80, 0x42, 0x8d, 0xb7, 0x91,
+	0x7f, 0x49, 0x40, 0x3a, 0x1f, 0x0d, 0x91, 0xc5, 0x64, 0x4c, 0xc2, 0x92, 0x2e, 0x6d, 0x0d, 0x04,
+	0x65, 0xbc, 0xcc, 0xbd, 0x52, 0x20, 0x67, 0x93, 0x78, 0x25, 0xf7, 0xbc, 0x65, 0x98, 0x2a, 0x2f,
+	0x99, 0xe8, 0xec, 0xc0, 0x55, 0x0d, 0x93, 0x7c, 0x3d, 0x05, 0xbb, 0x37, 0x79, 0x85, 0x43, 0x2e,
+	0xc4, 0x13, 0x7b, 0xf3, 0x97, 0x46, 0xf2, 0xc5, 0x1e, 0xa1, 0xa1, 0x35, 0x9e, 0xe2, 0xd6, 0xb8,
+	0x42, 0x2e, 0x25, 0xb2, 0x86, 0x7e, 0xdb, 0x70, 0x84, 0x35, 0xc4, 0x23, 0x27, 0x91, 0x12, 0x30,
+	0xa3, 0xfc, 0x8e, 0x35, 0x08, 0xbd, 0xb7, 0x3b, 0xe4, 0x78, 0xbc, 0x4f, 0x28, 0x7c, 0x8c, 0x9e,
+	0x48, 0x46, 0x8c, 0x1a, 0x2e, 0x72, 0x0d, 0x4f, 0x92, 0xe3, 0x89, 0x34, 0xc4, 0xa3, 0xf5, 0x43,
+	0x09, 0x66, 0x36, 0x78, 0xec, 0x43, 0xce, 0x45, 0x17, 0x6f, 0xf3, 0x47, 0x48, 0x72, 0xa1, 0x07,
+	0x48, 0xa8, 0xf5, 0x79, 0xae, 0xf5, 0x12, 0xc9, 0x27, 0xd2, 0x3a, 0xfc, 0x0a, 0xe9, 0xab, 0x29,
+	0x90, 0x37, 0x7e, 0x86, 0x42, 0x1e, 0x8f, 0x2e, 0x75, 0xd7, 0x47, 0x32, 0xf2, 0x85, 0xde, 0x80,
+	0xa1, 0x15, 0xae, 0x71, 0x2b, 0x5c, 0x22, 0x17, 0x12, 0x59, 0xc1, 0xaf, 0xc4, 0x16, 0x83, 0x1c,
+	0xc8, 0x9b, 0x29, 0xd8, 0xdb, 0xad, 0x13, 0x4f, 0x9e, 0xd8, 0xb2, 0x22, 0xed, 0x4f, 0x1f, 0x64,
+	0xa5, 0x97, 0x90, 0x68, 0xa1, 0xe7, 0xb8, 0x85, 0x9e, 0x25, 0xcf, 0xf4, 0xd2, 0x42, 0x39, 0x7e,
+	0xf9, 0x56, 0x4b, 0xdc, 0x10, 0xbf, 0x97, 0x60, 0x2c, 0xd4, 0xf3, 0x26, 0xa7, 0xe2, 0x84, 0xb0,
+	0xce, 0x1e, 0xbd, 0x7c, 0x3a, 0x31, 0x7d, 0xa2, 0x6c, 0xa2, 0xe4, 0x62, 0xf0, 0x74, 0x82, 0xfc,
+	0x49, 0x82, 0xd1, 0x60, 0xab, 0x9b, 0x9c, 0x8c, 0x99, 0xde, 0x84, 0xfb, 0xf5, 0xf2, 0xa9, 0xa4,
+	0xe4, 0xc9, 0xe3, 0x9b, 0x5a, 0x6c, 0x89, 0x8a, 0x57, 0xee, 0x25, 0xfe, 0xe7, 0x65, 0xf2, 0x1b,
+	0x09, 0x46, 0x02, 0xcd, 0x63, 0x72, 0x22, 0xb6, 0x50, 0x81, 0x8e, 0xb9, 0x7c, 0x32, 0x21, 0x35,
+	0x6a, 0x34, 0xcf, 0x35, 0x3a, 0x4a, 0x0e, 0xc7, 0xd3, 0xa8, 0xce, 0x04, 0x7f, 0x4b, 0x82, 0xb4,
+	0xd7, 0x3f, 0x22, 0xf3, 0xf1, 0x04, 0x09, 0x36, 0x22, 0xe5, 0xe3, 0x89, 0x68, 0x51, 0x85, 0x3c,
+	0x57, 0xe1, 0x04, 0x99, 0x4f, 0xf4, 0x59, 0x89, 0x2e, 0xe3, 0x3f, 0x24, 0x98, 0x6c, 0x6f, 0xac,
+	0x91, 0x85, 0x04, 0x52, 0x85, 0xfb, 0x82, 0x72, 0x7e, 0x2b, 0x10, 0x3d, 0x39, 0x5e, 0xb8, 0x7e,
+	0xb9, 0x15, 0x54, 0xe9, 0x97, 0x12, 0x0c, 0x61, 0xfb, 0x8d, 0x3c, 0x12, 0x4f, 0x36, 0xbf, 0xed,
+	0x27, 0x3f, 0x9a, 0x80, 0x12, 0x95, 0x79, 0x8c, 0x2b, 0x33, 0x4f, 0x1e, 0x49, 0x76, 0x56, 0xd6,
+	0x1b, 0x64, 0x4d, 0x02, 0xd2, 0xd9, 0x15, 0x8a, 0x93, 0xf5, 0x6e, 0xd8, 0xa3, 0x92, 0x97, 0xb6,
+	0x06, 0x82, 0x3a, 0x3e, 0xc1, 0x75, 0x7c, 0x9c, 0x14, 0x12, 0xe9, 0x28, 0xee, 0x89, 0xfc, 0xa7,
+	0x7f, 0x59, 0xfc, 0xb3, 0xc4, 0x1e, 0x31, 0x7a, 0xdd, 0x9b, 0x38, 0x31, 0xa3, 0xb3, 0x3f, 0x25,
+	0x9f, 0x4c, 0x48, 0x8d, 0xfa, 0x15, 0xb8, 0x7e, 0x8b, 0x64, 0x61, 0x0b, 0xfa, 0x89, 0x76, 0x06,
+	0x4b, 0x5d, 0xd3, 0xfe, 0xb3, 0x8a, 0x18, 0x21, 0xa4, 0xbd, 0xf7, 0x21, 0x1f, 0x4f, 0x44, 0x8b,
+	0x1a, 0x9d, 0xd9, 0x5a, 0xde, 0xca, 0xb0, 0x78, 0x9d, 0xe2, 0x43, 0x09, 0x76, 0x2d, 0xdb, 0x8e,
+	0x51, 0xd3, 0x1c, 0xbd, 0xa3, 0xba, 0x4b, 0x62, 0x04, 0x83, 0x8d, 0x4a, 0xe6, 0xf2, 0xe2, 0x96,
+	0x30, 0x12, 0xa5, 0x6a, 0xbe, 0xa2, 0x3a, 0xaa, 0x96, 0x0b, 0x5c, 0xca, 0xfc, 0x6b, 0xc8, 0x7f,
+	0x25, 0x90, 0x37, 0x50, 0x9e, 0x75, 0xc1, 0x92, 0x4b, 0xee, 0x17, 0xa0, 0xe5, 0xa5, 0xad, 0x81,
+	0xa0, 0xfe, 0x4f, 0x72, 0xfd, 0x2f, 0x93, 0x8b, 0xbd, 0xd3, 0xdf, 0x6a, 0x3a, 0xf9, 0xf3, 0x6f,
+	0xbd, 0x3f, 0x27, 0xbd, 0xfd, 0xfe, 0x9c, 0xf4, 0xb7, 0xf7, 0xe7, 0xa4, 0x57, 0x3f, 0x98, 0xdb,
+	0xf6, 0xf6, 0x07, 0x73, 0xdb, 0xfe, 0xf2, 0xc1, 0xdc, 0xb6, 0x67, 0x0f, 0x06, 0xea, 0xa3, 0xbc,
+	0x2e, 0x6a, 0xd8, 0x07, 0xaa, 0x5a, 0xd1, 0x76, 0x7f, 0xe4, 0x6e, 0x1e, 0x3a, 0x96, 0xbb, 0x2d,
+	0xd8, 0xf2, 0x6a, 0x69, 0x71, 0x90, 0x77, 0x7e, 0x8e, 0xfc, 0x6f, 0x00, 0xc8, 0xf7, 0xe3, 0x2b,
+	0x0a, 0x39, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns lockup params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error)
+	TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error)
+	// PoolsWithFilter allows you to query specific pools with requested
+	// parameters
+	PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFilterRequest, opts ...grpc.CallOption) (*QueryPoolsWithFilterResponse, error)
+	// Per Pool gRPC Endpoints
+	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	// Simulates joining pool without a swap. Returns the amount of shares you'd
+	// get and tokens needed to provide
+	CalcJoinPoolNoSwapShares(ctx context.Context, in *QueryCalcJoinPoolNoSwapSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolNoSwapSharesResponse, error)
+	CalcJoinPoolShares(ctx context.Context, in *QueryCalcJoinPoolSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolSharesResponse, error)
+	CalcExitPoolCoinsFromShares(ctx context.Context, in *QueryCalcExitPoolCoinsFromSharesRequest, opts ...grpc.CallOption) (*QueryCalcExitPoolCoinsFromSharesResponse, error)
+	PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error)
+	// StableSwapAmplification returns the effective amplification coefficient of
+	// a stableswap pool at the current block time, together with the ramp
+	// schedule if one is in progress.
+	StableSwapAmplification(ctx context.Context, in *QueryStableSwapAmplificationRequest, opts ...grpc.CallOption) (*QueryStableSwapAmplificationResponse, error)
+	// LiquidityBootstrappingPool returns the sale of a liquidity bootstrapping
+	// pool, together with its effective weights at the current block time.
+	LiquidityBootstrappingPool(ctx context.Context, in *QueryLiquidityBootstrappingPoolRequest, opts ...grpc.CallOption) (*QueryLiquidityBootstrappingPoolResponse, error)
+	// LiquidityBootstrappingPriceCurve returns the spot prices a liquidity
+	// bootstrapping pool is projected to have over its sale, at evenly spaced
+	// times, assuming its balances do not change.
+	LiquidityBootstrappingPriceCurve(ctx context.Context, in *QueryLiquidityBootstrappingPriceCurveRequest, opts ...grpc.CallOption) (*QueryLiquidityBootstrappingPriceCurveResponse, error)
+	// CanonicalPool returns the canonical pool of a denom pair, which routing
+	// queries use by default for swaps between the two denoms.
+	CanonicalPool(ctx context.Context, in *QueryCanonicalPoolRequest, opts ...grpc.CallOption) (*QueryCanonicalPoolResponse, error)
+	// PoolsByDenom returns the pools holding a denom, with their liquidity and
+	// the spot prices of the denom in the other assets of each pool.
+	PoolsByDenom(ctx context.Context, in *QueryPoolsByDenomRequest, opts ...grpc.CallOption) (*QueryPoolsByDenomResponse, error)
+	// PoolsByPair returns the pools holding both denoms of a pair, with their
+	// liquidity and the spot price of denom_a in denom_b in each pool.
+	PoolsByPair(ctx context.Context, in *QueryPoolsByPairRequest, opts ...grpc.CallOption) (*QueryPoolsByPairResponse, error)
+	// PoolStats returns the swap volume and fee revenue of a pool since it was
+	// created, and over the current epoch.
+	PoolStats(ctx context.Context, in *QueryPoolStatsRequest, opts ...grpc.CallOption) (*QueryPoolStatsResponse, error)
+	// PoolStatsHistory returns the swap volume and fee revenue of a pool over
+	// the last finished epochs.
+	PoolStatsHistory(ctx context.Context, in *QueryPoolStatsHistoryRequest, opts ...grpc.CallOption) (*QueryPoolStatsHistoryResponse, error)
+	// PoolAPR returns the annual percentage rate of the liquidity of a pool,
+	// from its swap fee revenue over the last finished epochs and the rewards of
+	// the active gauges of its shares.
+	PoolAPR(ctx context.Context, in *QueryPoolAPRRequest, opts ...grpc.CallOption) (*QueryPoolAPRResponse, error)
+	TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error)
+	TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
+	// SpotPrice defines a gRPC query handler that returns the spot price given
+	// a base denomination and a quote denomination.
+	SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
+	EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error)
+	EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTotalSharesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/Pools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTotalSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+// Deprecated: Do not use.
+func (c *queryClient) NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error) {
+	out := new(QueryNumPoolsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/NumPools", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTotalSharesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error) {
+	out := new(QueryTotalLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/TotalLiquidity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTotalSharesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFilterRequest, opts ...grpc.CallOption) (*QueryPoolsWithFilterResponse, error) {
+	out := new(QueryPoolsWithFilterResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolsWithFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTotalSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
+	out := new(QueryPoolResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/Pool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CalcJoinPoolNoSwapShares(ctx context.Context, in *QueryCalcJoinPoolNoSwapSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolNoSwapSharesResponse, error) {
+	out := new(QueryCalcJoinPoolNoSwapSharesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/CalcJoinPoolNoSwapShares", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CalcJoinPoolShares(ctx context.Context, in *QueryCalcJoinPoolSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolSharesResponse, error) {
+	out := new(QueryCalcJoinPoolSharesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/CalcJoinPoolShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokensIn) > 0 {
-		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) CalcExitPoolCoinsFromShares(ctx context.Context, in *QueryCalcExitPoolCoinsFromSharesRequest, opts ...grpc.CallOption) (*QueryCalcExitPoolCoinsFromSharesResponse, error) {
+	out := new(QueryCalcExitPoolCoinsFromSharesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/CalcExitPoolCoinsFromShares", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error) {
+	out := new(QueryPoolParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StableSwapAmplification(ctx context.Context, in *QueryStableSwapAmplificationRequest, opts ...grpc.CallOption) (*QueryStableSwapAmplificationResponse, error) {
+	out := new(QueryStableSwapAmplificationResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/StableSwapAmplification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SharesOut.Size()
-		i -= size
-		if _, err := m.SharesOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.TokensOut) > 0 {
-		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) LiquidityBootstrappingPool(ctx context.Context, in *QueryLiquidityBootstrappingPoolRequest, opts ...grpc.CallOption) (*QueryLiquidityBootstrappingPoolResponse, error) {
+	out := new(QueryLiquidityBootstrappingPoolResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/LiquidityBootstrappingPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LiquidityBootstrappingPriceCurve(ctx context.Context, in *QueryLiquidityBootstrappingPriceCurveRequest, opts ...grpc.CallOption) (*QueryLiquidityBootstrappingPriceCurveResponse, error) {
+	out := new(QueryLiquidityBootstrappingPriceCurveResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/LiquidityBootstrappingPriceCurve", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CanonicalPool(ctx context.Context, in *QueryCanonicalPoolRequest, opts ...grpc.CallOption) (*QueryCanonicalPoolResponse, error) {
+	out := new(QueryCanonicalPoolResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/CanonicalPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QuerySpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QuoteAssetDenom) > 0 {
-		i -= len(m.QuoteAssetDenom)
-		copy(dAtA[i:], m.QuoteAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BaseAssetDenom) > 0 {
-		i -= len(m.BaseAssetDenom)
-		copy(dAtA[i:], m.BaseAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) PoolsByDenom(ctx context.Context, in *QueryPoolsByDenomRequest, opts ...grpc.CallOption) (*QueryPoolsByDenomResponse, error) {
+	out := new(QueryPoolsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolsByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *queryClient) PoolsByPair(ctx context.Context, in *QueryPoolsByPairRequest, opts ...grpc.CallOption) (*QueryPoolsByPairResponse, error) {
+	out := new(QueryPoolsByPairResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolsByPair", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolsWithFilterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolStats(ctx context.Context, in *QueryPoolStatsRequest, opts ...grpc.CallOption) (*QueryPoolStatsResponse, error) {
+	out := new(QueryPoolStatsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolsWithFilterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolStatsHistory(ctx context.Context, in *QueryPoolStatsHistoryRequest, opts ...grpc.CallOption) (*QueryPoolStatsHistoryResponse, error) {
+	out := new(QueryPoolStatsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolStatsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolsWithFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (c *queryClient) PoolAPR(ctx context.Context, in *QueryPoolAPRRequest, opts ...grpc.CallOption) (*QueryPoolAPRResponse, error) {
+	out := new(QueryPoolAPRResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PoolType) > 0 {
-		i -= len(m.PoolType)
-		copy(dAtA[i:], m.PoolType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolType)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *queryClient) TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error) {
+	out := new(QueryTotalPoolLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/TotalPoolLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MinLiquidity) > 0 {
-		i -= len(m.MinLiquidity)
-		copy(dAtA[i:], m.MinLiquidity)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinLiquidity)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error) {
+	out := new(QueryTotalSharesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/TotalShares", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolsWithFilterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Deprecated: Do not use.
+func (c *queryClient) SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error) {
+	out := new(QuerySpotPriceResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/SpotPrice", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolsWithFilterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error) {
+	out := new(QuerySwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/EstimateSwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolsWithFilterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error) {
+	out := new(QuerySwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Query/EstimateSwapExactAmountOut", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns lockup params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+	// Deprecated: please use the alternative in x/poolmanager
+	NumPools(context.Context, *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error)
+	TotalLiquidity(context.Context, *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error)
+	// PoolsWithFilter allows you to query specific pools with requested
+	// parameters
+	PoolsWithFilter(context.Context, *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error)
+	// Per Pool gRPC Endpoints
+	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	// Simulates joining pool without a swap. Returns the amount of shares you'd
+	// get and tokens needed to provide
+	CalcJoinPoolNoSwapShares(context.Context, *QueryCalcJoinPoolNoSwapSharesRequest) (*QueryCalcJoinPoolNoSwapSharesResponse, error)
+	CalcJoinPoolShares(context.Context, *QueryCalcJoinPoolSharesRequest) (*QueryCalcJoinPoolSharesResponse, error)
+	CalcExitPoolCoinsFromShares(context.Context, *QueryCalcExitPoolCoinsFromSharesRequest) (*QueryCalcExitPoolCoinsFromSharesResponse, error)
+	PoolParams(context.Context, *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error)
+	// StableSwapAmplification returns the effective amplification coefficient of
+	// a stableswap pool at the current block time, together with the ramp
+	// schedule if one is in progress.
+	StableSwapAmplification(context.Context, *QueryStableSwapAmplificationRequest) (*QueryStableSwapAmplificationResponse, error)
+	// LiquidityBootstrappingPool returns the sale of a liquidity bootstrapping
+	// pool, together with its effective weights at the current block time.
+	LiquidityBootstrappingPool(context.Context, *QueryLiquidityBootstrappingPoolRequest) (*QueryLiquidityBootstrappingPoolResponse, error)
+	// LiquidityBootstrappingPriceCurve returns the spot prices a liquidity
+	// bootstrapping pool is projected to have over its sale, at evenly spaced
+	// times, assuming its balances do not change.
+	LiquidityBootstrappingPriceCurve(context.Context, *QueryLiquidityBootstrappingPriceCurveRequest) (*QueryLiquidityBootstrappingPriceCurveResponse, error)
+	// CanonicalPool returns the canonical pool of a denom pair, which routing
+	// queries use by default for swaps between the two denoms.
+	CanonicalPool(context.Context, *QueryCanonicalPoolRequest) (*QueryCanonicalPoolResponse, error)
+	// PoolsByDenom returns the pools holding a denom, with their liquidity and
+	// the spot prices of the denom in the other assets of each pool.
+	PoolsByDenom(context.Context, *QueryPoolsByDenomRequest) (*QueryPoolsByDenomResponse, error)
+	// PoolsByPair returns the pools holding both denoms of a pair, with their
+	// liquidity and the spot price of denom_a in denom_b in each pool.
+	PoolsByPair(context.Context, *QueryPoolsByPairRequest) (*QueryPoolsByPairResponse, error)
+	// PoolStats returns the swap volume and fee revenue of a pool since it was
+	// created, and over the current epoch.
+	PoolStats(context.Context, *QueryPoolStatsRequest) (*QueryPoolStatsResponse, error)
+	// PoolStatsHistory returns the swap volume and fee revenue of a pool over
+	// the last finished epochs.
+	PoolStatsHistory(context.Context, *QueryPoolStatsHistoryRequest) (*QueryPoolStatsHistoryResponse, error)
+	// PoolAPR returns the annual percentage rate of the liquidity of a pool,
+	// from its swap fee revenue over the last finished epochs and the rewards of
+	// the active gauges of its shares.
+	PoolAPR(context.Context, *QueryPoolAPRRequest) (*QueryPoolAPRResponse, error)
+	TotalPoolLiquidity(context.Context, *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error)
+	TotalShares(context.Context, *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error)
+	// SpotPrice defines a gRPC query handler that returns the spot price given
+	// a base denomination and a quote denomination.
+	SpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
+	EstimateSwapExactAmountIn(context.Context, *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error)
+	EstimateSwapExactAmountOut(context.Context, *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error)
 }
 
-func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpotPrice) > 0 {
-		i -= len(m.SpotPrice)
-		copy(dAtA[i:], m.SpotPrice)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QuerySwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+}
+func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NumPools not implemented")
+}
+func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) PoolsWithFilter(ctx context.Context, req *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolsWithFilter not implemented")
+}
+func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (*UnimplementedQueryServer) CalcJoinPoolNoSwapShares(ctx context.Context, req *QueryCalcJoinPoolNoSwapSharesRequest) (*QueryCalcJoinPoolNoSwapSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcJoinPoolNoSwapShares not implemented")
+}
+func (*UnimplementedQueryServer) CalcJoinPoolShares(ctx context.Context, req *QueryCalcJoinPoolSharesRequest) (*QueryCalcJoinPoolSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcJoinPoolShares not implemented")
+}
+func (*UnimplementedQueryServer) CalcExitPoolCoinsFromShares(ctx context.Context, req *QueryCalcExitPoolCoinsFromSharesRequest) (*QueryCalcExitPoolCoinsFromSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcExitPoolCoinsFromShares not implemented")
+}
+func (*UnimplementedQueryServer) PoolParams(ctx context.Context, req *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolParams not implemented")
+}
+func (*UnimplementedQueryServer) StableSwapAmplification(ctx context.Context, req *QueryStableSwapAmplificationRequest) (*QueryStableSwapAmplificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAmplification not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityBootstrappingPool(ctx context.Context, req *QueryLiquidityBootstrappingPoolRequest) (*QueryLiquidityBootstrappingPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityBootstrappingPool not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityBootstrappingPriceCurve(ctx context.Context, req *QueryLiquidityBootstrappingPriceCurveRequest) (*QueryLiquidityBootstrappingPriceCurveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityBootstrappingPriceCurve not implemented")
+}
+func (*UnimplementedQueryServer) CanonicalPool(ctx context.Context, req *QueryCanonicalPoolRequest) (*QueryCanonicalPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalPool not implemented")
+}
+func (*UnimplementedQueryServer) PoolsByDenom(ctx context.Context, req *QueryPoolsByDenomRequest) (*QueryPoolsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolsByDenom not implemented")
+}
+func (*UnimplementedQueryServer) PoolsByPair(ctx context.Context, req *QueryPoolsByPairRequest) (*QueryPoolsByPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolsByPair not implemented")
+}
+func (*UnimplementedQueryServer) PoolStats(ctx context.Context, req *QueryPoolStatsRequest) (*QueryPoolStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolStats not implemented")
+}
+func (*UnimplementedQueryServer) PoolStatsHistory(ctx context.Context, req *QueryPoolStatsHistoryRequest) (*QueryPoolStatsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolStatsHistory not implemented")
+}
+func (*UnimplementedQueryServer) PoolAPR(ctx context.Context, req *QueryPoolAPRRequest) (*QueryPoolAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolAPR not implemented")
+}
+func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalPoolLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) TotalShares(ctx context.Context, req *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalShares not implemented")
+}
+func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
 }
 
-func (m *QuerySwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QuerySwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/Params",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TokenOutAmount.Size()
-		i -= size
-		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/Pools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_NumPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNumPoolsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).NumPools(ctx, in)
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/NumPools",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NumPools(ctx, req.(*QueryNumPoolsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidityRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TokenInAmount.Size()
-		i -= size
-		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidity(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/TotalLiquidity",
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidity(ctx, req.(*QueryTotalLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolsWithFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsWithFilterRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolsWithFilter(ctx, in)
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolsWithFilter",
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolsWithFilter(ctx, req.(*QueryPoolsWithFilterRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).Pool(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/Pool",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CalcJoinPoolNoSwapShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalcJoinPoolNoSwapSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).CalcJoinPoolNoSwapShares(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/CalcJoinPoolNoSwapShares",
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalcJoinPoolNoSwapShares(ctx, req.(*QueryCalcJoinPoolNoSwapSharesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CalcJoinPoolShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalcJoinPoolSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).CalcJoinPoolShares(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/CalcJoinPoolShares",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalcJoinPoolShares(ctx, req.(*QueryCalcJoinPoolSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNumPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CalcExitPoolCoinsFromShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalcExitPoolCoinsFromSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryNumPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).CalcExitPoolCoinsFromShares(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.NumPools != 0 {
-		n += 1 + sovQuery(uint64(m.NumPools))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/CalcExitPoolCoinsFromShares",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalcExitPoolCoinsFromShares(ctx, req.(*QueryCalcExitPoolCoinsFromSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalcJoinPoolSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).PoolParams(ctx, in)
 	}
-	if len(m.TokensIn) > 0 {
-		for _, e := range m.TokensIn {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolParams",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolParams(ctx, req.(*QueryPoolParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalcJoinPoolSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_StableSwapAmplification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStableSwapAmplificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ShareOutAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.TokensOut) > 0 {
-		for _, e := range m.TokensOut {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).StableSwapAmplification(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/StableSwapAmplification",
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StableSwapAmplification(ctx, req.(*QueryStableSwapAmplificationRequest))
 	}
-	l = m.ShareInAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LiquidityBootstrappingPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityBootstrappingPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.TokensOut) > 0 {
-		for _, e := range m.TokensOut {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityBootstrappingPool(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryPoolParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/LiquidityBootstrappingPool",
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityBootstrappingPool(ctx, req.(*QueryLiquidityBootstrappingPoolRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LiquidityBootstrappingPriceCurve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityBootstrappingPriceCurveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityBootstrappingPriceCurve(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryTotalPoolLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/LiquidityBootstrappingPriceCurve",
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityBootstrappingPriceCurve(ctx, req.(*QueryLiquidityBootstrappingPriceCurveRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalPoolLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CanonicalPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanonicalPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).CanonicalPool(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryTotalSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/CanonicalPool",
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanonicalPool(ctx, req.(*QueryCanonicalPoolRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TotalShares.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).PoolsByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolsByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolsByDenom(ctx, req.(*QueryPoolsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolsByPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsByPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).PoolsByPair(ctx, in)
 	}
-	if len(m.TokensIn) > 0 {
-		for _, e := range m.TokensIn {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolsByPair",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolsByPair(ctx, req.(*QueryPoolsByPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.TokensOut) > 0 {
-		for _, e := range m.TokensOut {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolStats(ctx, in)
 	}
-	l = m.SharesOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolStats(ctx, req.(*QueryPoolStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolStatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolStatsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).PoolStatsHistory(ctx, in)
 	}
-	l = len(m.BaseAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolStatsHistory",
 	}
-	l = len(m.QuoteAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolStatsHistory(ctx, req.(*QueryPoolStatsHistoryRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolsWithFilterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.MinLiquidity)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PoolAPR(ctx, in)
 	}
-	l = len(m.PoolType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/PoolAPR",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolAPR(ctx, req.(*QueryPoolAPRRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolsWithFilterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TotalPoolLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalPoolLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalPoolLiquidity(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/TotalPoolLiquidity",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalPoolLiquidity(ctx, req.(*QueryTotalPoolLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TotalShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SpotPrice)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).TotalShares(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/TotalShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalShares(ctx, req.(*QueryTotalSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapExactAmountInRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpotPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).SpotPrice(ctx, in)
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/SpotPrice",
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpotPrice(ctx, req.(*QuerySpotPriceRequest))
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapExactAmountInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/EstimateSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, req.(*QuerySwapExactAmountInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapExactAmountOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Query/EstimateSwapExactAmountOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, req.(*QuerySwapExactAmountOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.gamm.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "NumPools",
+			Handler:    _Query_NumPools_Handler,
+		},
+		{
+			MethodName: "TotalLiquidity",
+			Handler:    _Query_TotalLiquidity_Handler,
+		},
+		{
+			MethodName: "PoolsWithFilter",
+			Handler:    _Query_PoolsWithFilter_Handler,
+		},
+		{
+			MethodName: "Pool",
+			Handler:    _Query_Pool_Handler,
+		},
+		{
+			MethodName: "CalcJoinPoolNoSwapShares",
+			Handler:    _Query_CalcJoinPoolNoSwapShares_Handler,
+		},
+		{
+			MethodName: "CalcJoinPoolShares",
+			Handler:    _Query_CalcJoinPoolShares_Handler,
+		},
+		{
+			MethodName: "CalcExitPoolCoinsFromShares",
+			Handler:    _Query_CalcExitPoolCoinsFromShares_Handler,
+		},
+		{
+			MethodName: "PoolParams",
+			Handler:    _Query_PoolParams_Handler,
+		},
+		{
+			MethodName: "StableSwapAmplification",
+			Handler:    _Query_StableSwapAmplification_Handler,
+		},
+		{
+			MethodName: "LiquidityBootstrappingPool",
+			Handler:    _Query_LiquidityBootstrappingPool_Handler,
+		},
+		{
+			MethodName: "LiquidityBootstrappingPriceCurve",
+			Handler:    _Query_LiquidityBootstrappingPriceCurve_Handler,
+		},
+		{
+			MethodName: "CanonicalPool",
+			Handler:    _Query_CanonicalPool_Handler,
+		},
+		{
+			MethodName: "PoolsByDenom",
+			Handler:    _Query_PoolsByDenom_Handler,
+		},
+		{
+			MethodName: "PoolsByPair",
+			Handler:    _Query_PoolsByPair_Handler,
+		},
+		{
+			MethodName: "PoolStats",
+			Handler:    _Query_PoolStats_Handler,
+		},
+		{
+			MethodName: "PoolStatsHistory",
+			Handler:    _Query_PoolStatsHistory_Handler,
+		},
+		{
+			MethodName: "PoolAPR",
+			Handler:    _Query_PoolAPR_Handler,
+		},
+		{
+			MethodName: "TotalPoolLiquidity",
+			Handler:    _Query_TotalPoolLiquidity_Handler,
+		},
+		{
+			MethodName: "TotalShares",
+			Handler:    _Query_TotalShares_Handler,
+		},
+		{
+			MethodName: "SpotPrice",
+			Handler:    _Query_SpotPrice_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountIn",
+			Handler:    _Query_EstimateSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountOut",
+			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/gamm/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNumPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNumPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNumPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNumPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNumPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNumPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumPools != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPools))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalcJoinPoolSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalcJoinPoolSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalcJoinPoolSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensIn) > 0 {
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalcJoinPoolSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalcJoinPoolSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalcJoinPoolSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareInAmount.Size()
+		i -= size
+		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalcExitPoolCoinsFromSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalcExitPoolCoinsFromSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalcExitPoolCoinsFromSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStableSwapAmplificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStableSwapAmplificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStableSwapAmplificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStableSwapAmplificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStableSwapAmplificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStableSwapAmplificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RampEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RampEndTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RampStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RampStartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	if m.TargetAmplification != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TargetAmplification))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InitialAmplification != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InitialAmplification))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Ramping {
+		i--
+		if m.Ramping {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Amplification != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityBootstrappingPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityBootstrappingPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityBootstrappingPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityBootstrappingPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityBootstrappingPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityBootstrappingPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Weights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.SaleEnded {
+		i--
+		if m.SaleEnded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EndBehavior) > 0 {
+		i -= len(m.EndBehavior)
+		copy(dAtA[i:], m.EndBehavior)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EndBehavior)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NormalizedWeight.Size()
+		i -= size
+		if _, err := m.NormalizedWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumPoints != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPoints))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.QuoteAssetDenom) > 0 {
+		i -= len(m.QuoteAssetDenom)
+		copy(dAtA[i:], m.QuoteAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAssetDenom) > 0 {
+		i -= len(m.BaseAssetDenom)
+		copy(dAtA[i:], m.BaseAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PricePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PricePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomB) > 0 {
+		i -= len(m.DenomB)
+		copy(dAtA[i:], m.DenomB)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomB)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomA) > 0 {
+		i -= len(m.DenomA)
+		copy(dAtA[i:], m.DenomA)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsByDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsByPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsByPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsByPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomB) > 0 {
+		i -= len(m.DenomB)
+		copy(dAtA[i:], m.DenomB)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomB)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomA) > 0 {
+		i -= len(m.DenomA)
+		copy(dAtA[i:], m.DenomA)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsByPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsByPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsByPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpotPrices) > 0 {
+		for iNdEx := len(m.SpotPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpotPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomSpotPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomSpotPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomSpotPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.QuoteAssetDenom) > 0 {
+		i -= len(m.QuoteAssetDenom)
+		copy(dAtA[i:], m.QuoteAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrentEpochStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolStatsHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolStatsHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolStatsHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolStatsHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolStatsHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolStatsHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.IncentivesApr.Size()
+		i -= size
+		if _, err := m.IncentivesApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SwapFeeApr.Size()
+		i -= size
+		if _, err := m.SwapFeeApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidityValue.Size()
+		i -= size
+		if _, err := m.LiquidityValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPoolLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPoolLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensIn) > 0 {
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SharesOut.Size()
+		i -= size
+		if _, err := m.SharesOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteAssetDenom) > 0 {
+		i -= len(m.QuoteAssetDenom)
+		copy(dAtA[i:], m.QuoteAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAssetDenom) > 0 {
+		i -= len(m.BaseAssetDenom)
+		copy(dAtA[i:], m.BaseAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsWithFilterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsWithFilterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsWithFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PoolType) > 0 {
+		i -= len(m.PoolType)
+		copy(dAtA[i:], m.PoolType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinLiquidity) > 0 {
+		i -= len(m.MinLiquidity)
+		copy(dAtA[i:], m.MinLiquidity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinLiquidity)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsWithFilterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsWithFilterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsWithFilterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpotPrice) > 0 {
+		i -= len(m.SpotPrice)
+		copy(dAtA[i:], m.SpotPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNumPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNumPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPools != 0 {
+		n += 1 + sovQuery(uint64(m.NumPools))
+	}
+	return n
+}
+
+func (m *QueryCalcJoinPoolSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCalcJoinPoolSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCalcExitPoolCoinsFromSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStableSwapAmplificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryStableSwapAmplificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amplification != 0 {
+		n += 1 + sovQuery(uint64(m.Amplification))
+	}
+	if m.Ramping {
+		n += 2
+	}
+	if m.InitialAmplification != 0 {
+		n += 1 + sovQuery(uint64(m.InitialAmplification))
+	}
+	if m.TargetAmplification != 0 {
+		n += 1 + sovQuery(uint64(m.TargetAmplification))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RampStartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RampEndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidityBootstrappingPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryLiquidityBootstrappingPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.EndBehavior)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SaleEnded {
+		n += 2
+	}
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NormalizedWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumPoints != 0 {
+		n += 1 + sovQuery(uint64(m.NumPoints))
+	}
+	return n
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PricePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCanonicalPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomA)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DenomB)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanonicalPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomA)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DenomB)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SpotPrices) > 0 {
+		for _, e := range m.SpotPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomSpotPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QuoteAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentEpochStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolStatsHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.NumEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.NumEpochs))
+	}
+	return n
+}
+
+func (m *QueryPoolStatsHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.LiquidityValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SwapFeeApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.IncentivesApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalPoolLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryTotalPoolLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryTotalSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalShares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.SharesOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsWithFilterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MinLiquidity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PoolType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsWithFilterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpotPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapExactAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &types.Any{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNumPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNumPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
+			}
+			m.NumPools = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPools |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensIn = append(m.TokensIn, types1.Coin{})
+			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOut = append(m.TokensOut, types1.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOut = append(m.TokensOut, types1.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &types.Any{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStableSwapAmplificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStableSwapAmplificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStableSwapAmplificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStableSwapAmplificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStableSwapAmplificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStableSwapAmplificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ramping", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ramping = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmplification", wireType)
+			}
+			m.InitialAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmplification", wireType)
+			}
+			m.TargetAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RampStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RampEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityBootstrappingPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityBootstrappingPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityBootstrappingPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityBootstrappingPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityBootstrappingPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityBootstrappingPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBehavior", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndBehavior = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleEnded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SaleEnded = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weights = append(m.Weights, TokenWeight{})
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NormalizedWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityBootstrappingPriceCurveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityBootstrappingPriceCurveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPoints", wireType)
+			}
+			m.NumPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityBootstrappingPriceCurveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityBootstrappingPriceCurveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, PricePoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PricePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PricePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PricePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *QuerySwapExactAmountInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TokenOutAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QuerySwapExactAmountOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCanonicalPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QuerySwapExactAmountOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryTotalLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryTotalLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCanonicalPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCanonicalPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCanonicalPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3814,12 +9196,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanonicalPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanonicalPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3841,7 +9242,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3864,15 +9265,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsByDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3899,7 +9332,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3924,7 +9360,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3947,17 +9383,17 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsByDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3967,64 +9403,29 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Pools = append(m.Pools, PoolSummary{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4051,10 +9452,10 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &types.Any{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4079,7 +9480,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsByPairRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4102,13 +9503,77 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsByPairRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsByPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4165,7 +9630,7 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsByPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4188,10 +9653,10 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsByPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsByPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4223,7 +9688,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types.Any{})
+			m.Pools = append(m.Pools, PoolSummary{})
 			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4285,7 +9750,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4308,12 +9773,99 @@ func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNumPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidity = append(m.Liquidity, types1.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotPrices = append(m.SpotPrices, DenomSpotPrice{})
+			if err := m.SpotPrices[len(m.SpotPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4335,7 +9887,7 @@ func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *DenomSpotPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4358,17 +9910,49 @@ func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNumPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomSpotPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomSpotPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.NumPools = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4378,11 +9962,26 @@ func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumPools |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4404,7 +10003,7 @@ func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4427,10 +10026,10 @@ func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4452,40 +10051,6 @@ func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokensIn = append(m.TokensIn, types1.Coin{})
-			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4507,7 +10072,7 @@ func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4530,17 +10095,17 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4550,29 +10115,28 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4599,8 +10163,7 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensOut = append(m.TokensOut, types1.Coin{})
-			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentEpochStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4625,7 +10188,7 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolStatsHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4648,10 +10211,10 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolStatsHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolStatsHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4674,10 +10237,10 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
 			}
-			var stringLen uint64
+			m.NumEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4687,26 +10250,11 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumEpochs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4728,7 +10276,7 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolStatsHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4751,15 +10299,15 @@ func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolStatsHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolStatsHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4786,8 +10334,8 @@ func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensOut = append(m.TokensOut, types1.Coin{})
-			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stats = append(m.Stats, PoolStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4812,7 +10360,7 @@ func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolAPRRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4835,10 +10383,10 @@ func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolAPRRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4881,7 +10429,7 @@ func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolAPRResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4904,17 +10452,17 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolAPRResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4924,25 +10472,157 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &types.Any{}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityValue", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFeeApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivesApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentivesApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
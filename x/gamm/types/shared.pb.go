@@ -0,0 +1,1191 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/gamm/v1beta1/shared.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MigrationRecords contains all the links between the gamm pools liquidity
+// can be migrated from and the gamm pools it is migrated to.
+type MigrationRecords struct {
+	PoolMigrationLinks []PoolMigrationLink `protobuf:"bytes,1,rep,name=pool_migration_links,json=poolMigrationLinks,proto3" json:"pool_migration_links"`
+}
+
+func (m *MigrationRecords) Reset()         { *m = MigrationRecords{} }
+func (m *MigrationRecords) String() string { return proto.CompactTextString(m) }
+func (*MigrationRecords) ProtoMessage()    {}
+func (*MigrationRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f340883af93a87be, []int{0}
+}
+func (m *MigrationRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrationRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrationRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrationRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrationRecords.Merge(m, src)
+}
+func (m *MigrationRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrationRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrationRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrationRecords proto.InternalMessageInfo
+
+func (m *MigrationRecords) GetPoolMigrationLinks() []PoolMigrationLink {
+	if m != nil {
+		return m.PoolMigrationLinks
+	}
+	return nil
+}
+
+// PoolMigrationLink links a gamm pool to the gamm pool its liquidity providers
+// can migrate their shares to. Both pools must hold the same assets. Each pool
+// can be migrated from, and migrated to, by at most one link.
+type PoolMigrationLink struct {
+	OldPoolId uint64 `protobuf:"varint,1,opt,name=old_pool_id,json=oldPoolId,proto3" json:"old_pool_id,omitempty" yaml:"old_pool_id"`
+	NewPoolId uint64 `protobuf:"varint,2,opt,name=new_pool_id,json=newPoolId,proto3" json:"new_pool_id,omitempty" yaml:"new_pool_id"`
+}
+
+func (m *PoolMigrationLink) Reset()         { *m = PoolMigrationLink{} }
+func (m *PoolMigrationLink) String() string { return proto.CompactTextString(m) }
+func (*PoolMigrationLink) ProtoMessage()    {}
+func (*PoolMigrationLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f340883af93a87be, []int{1}
+}
+func (m *PoolMigrationLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolMigrationLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolMigrationLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolMigrationLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolMigrationLink.Merge(m, src)
+}
+func (m *PoolMigrationLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolMigrationLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolMigrationLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolMigrationLink proto.InternalMessageInfo
+
+func (m *PoolMigrationLink) GetOldPoolId() uint64 {
+	if m != nil {
+		return m.OldPoolId
+	}
+	return 0
+}
+
+func (m *PoolMigrationLink) GetNewPoolId() uint64 {
+	if m != nil {
+		return m.NewPoolId
+	}
+	return 0
+}
+
+// CanonicalPool is the pool routing queries use by default for swaps between
+// the two denoms of a pair, among the gamm pools holding both of them. The
+// denoms are ordered.
+type CanonicalPool struct {
+	DenomA string `protobuf:"bytes,1,opt,name=denom_a,json=denomA,proto3" json:"denom_a,omitempty" yaml:"denom_a"`
+	DenomB string `protobuf:"bytes,2,opt,name=denom_b,json=denomB,proto3" json:"denom_b,omitempty" yaml:"denom_b"`
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *CanonicalPool) Reset()         { *m = CanonicalPool{} }
+func (m *CanonicalPool) String() string { return proto.CompactTextString(m) }
+func (*CanonicalPool) ProtoMessage()    {}
+func (*CanonicalPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f340883af93a87be, []int{2}
+}
+func (m *CanonicalPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanonicalPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalPool.Merge(m, src)
+}
+func (m *CanonicalPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalPool proto.InternalMessageInfo
+
+func (m *CanonicalPool) GetDenomA() string {
+	if m != nil {
+		return m.DenomA
+	}
+	return ""
+}
+
+func (m *CanonicalPool) GetDenomB() string {
+	if m != nil {
+		return m.DenomB
+	}
+	return ""
+}
+
+func (m *CanonicalPool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// PoolStats is the swap activity of a pool, either since the pool was created,
+// or over an epoch of the stats epoch identifier.
+type PoolStats struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// epoch_number is the number of the epoch the stats are bucketed in. It is
+	// zero for the stats since the pool was created.
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
+	// volume is the amount of each denom swapped in and out of the pool.
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume" yaml:"volume"`
+	// swap_fees is the swap fee revenue of the liquidity providers of the pool.
+	SwapFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=swap_fees,json=swapFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_fees" yaml:"swap_fees"`
+	// taker_fees is the taker fee revenue of the swaps routed through the pool
+	// first.
+	TakerFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=taker_fees,json=takerFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"taker_fees" yaml:"taker_fees"`
+}
+
+func (m *PoolStats) Reset()         { *m = PoolStats{} }
+func (m *PoolStats) String() string { return proto.CompactTextString(m) }
+func (*PoolStats) ProtoMessage()    {}
+func (*PoolStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f340883af93a87be, []int{3}
+}
+func (m *PoolStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolStats.Merge(m, src)
+}
+func (m *PoolStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolStats proto.InternalMessageInfo
+
+func (m *PoolStats) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolStats) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *PoolStats) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *PoolStats) GetSwapFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SwapFees
+	}
+	return nil
+}
+
+func (m *PoolStats) GetTakerFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TakerFees
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MigrationRecords)(nil), "dymensionxyz.dymension.gamm.v1beta1.MigrationRecords")
+	proto.RegisterType((*PoolMigrationLink)(nil), "dymensionxyz.dymension.gamm.v1beta1.PoolMigrationLink")
+	proto.RegisterType((*CanonicalPool)(nil), "dymensionxyz.dymension.gamm.v1beta1.CanonicalPool")
+	proto.RegisterType((*PoolStats)(nil), "dymensionxyz.dymension.gamm.v1beta1.PoolStats")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/gamm/v1beta1/shared.proto", fileDescriptor_f340883af93a87be)
+}
+
+var fileDescriptor_f340883af93a87be = []byte{
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xb1, 0x6e, 0xd3, 0x40,
+	0x1c, 0xc6, 0xe3, 0xa6, 0x04, 0x7c, 0xa5, 0xa8, 0x35, 0x15, 0x84, 0x0e, 0x76, 0x65, 0x96, 0x48,
+	0xa8, 0x76, 0x03, 0xa2, 0x43, 0xb7, 0xba, 0x80, 0x04, 0x02, 0x84, 0xcc, 0xc6, 0x62, 0x9d, 0xed,
+	0x23, 0x39, 0xc5, 0xbe, 0xbf, 0xe5, 0x73, 0x92, 0x06, 0x21, 0x21, 0xc1, 0x0b, 0x30, 0xf1, 0x10,
+	0x3c, 0x49, 0xc7, 0x8e, 0x4c, 0x01, 0x25, 0x13, 0x6b, 0x9e, 0x00, 0xdd, 0x9d, 0xe3, 0x5a, 0x05,
+	0x29, 0x74, 0xca, 0xfd, 0xf3, 0xff, 0x7e, 0xdf, 0xf7, 0x49, 0x77, 0x09, 0x3a, 0x88, 0x27, 0x29,
+	0x61, 0x9c, 0x02, 0x3b, 0x9d, 0x7c, 0x70, 0xab, 0xc1, 0xed, 0xe1, 0x34, 0x75, 0x47, 0xdd, 0x90,
+	0x14, 0xb8, 0xeb, 0xf2, 0x3e, 0xce, 0x49, 0xec, 0x64, 0x39, 0x14, 0x60, 0xdc, 0xaf, 0x13, 0x4e,
+	0x35, 0x38, 0x82, 0x70, 0x4a, 0x62, 0x77, 0xa7, 0x07, 0x3d, 0x90, 0x7a, 0x57, 0x9c, 0x14, 0xba,
+	0x6b, 0x46, 0xc0, 0x53, 0xe0, 0x6e, 0x88, 0x39, 0xa9, 0xcc, 0x23, 0xa0, 0x4c, 0xed, 0xed, 0xcf,
+	0x1a, 0xda, 0x7a, 0x45, 0x7b, 0x39, 0x2e, 0x28, 0x30, 0x9f, 0x44, 0x90, 0xc7, 0xdc, 0x60, 0x68,
+	0x27, 0x03, 0x48, 0x82, 0x74, 0xb9, 0x08, 0x12, 0xca, 0x06, 0xbc, 0xad, 0xed, 0x35, 0x3b, 0x1b,
+	0x0f, 0x0f, 0x9d, 0xff, 0xa8, 0xe3, 0xbc, 0x01, 0x48, 0x2a, 0xe3, 0x97, 0x94, 0x0d, 0xbc, 0xf5,
+	0xb3, 0xa9, 0xd5, 0xf0, 0x8d, 0xec, 0xf2, 0x82, 0xdb, 0x5f, 0x34, 0xb4, 0xfd, 0x97, 0xde, 0x38,
+	0x44, 0x1b, 0x90, 0xc4, 0x81, 0x6c, 0x42, 0xe3, 0xb6, 0xb6, 0xa7, 0x75, 0xd6, 0xbd, 0x3b, 0x8b,
+	0xa9, 0x65, 0x4c, 0x70, 0x9a, 0x1c, 0xd9, 0xb5, 0xa5, 0xed, 0xeb, 0x90, 0xc4, 0xc2, 0xe2, 0x79,
+	0x2c, 0x38, 0x46, 0xc6, 0x15, 0xb7, 0x76, 0x99, 0xab, 0x2d, 0x6d, 0x5f, 0x67, 0x64, 0xac, 0x38,
+	0xfb, 0x9b, 0x86, 0x36, 0x4f, 0x30, 0x03, 0x46, 0x23, 0x9c, 0x88, 0xef, 0x8c, 0x07, 0xe8, 0x7a,
+	0x4c, 0x18, 0xa4, 0x01, 0x96, 0xe9, 0xba, 0x67, 0x2c, 0xa6, 0xd6, 0x2d, 0xe5, 0x52, 0x2e, 0x6c,
+	0xbf, 0x25, 0x4f, 0xc7, 0x17, 0xe2, 0xb0, 0xbd, 0xf6, 0x6f, 0x71, 0xb8, 0x14, 0x7b, 0x42, 0xbc,
+	0xec, 0xd7, 0x94, 0xfd, 0x6a, 0xe2, 0xaa, 0x5b, 0x2b, 0x53, 0xc5, 0x7e, 0x37, 0x91, 0x2e, 0xfa,
+	0xbc, 0x2d, 0x70, 0xc1, 0xeb, 0xa8, 0xb6, 0x0a, 0x35, 0x8e, 0xd0, 0x4d, 0x92, 0x41, 0xd4, 0x0f,
+	0xd8, 0x30, 0x0d, 0x49, 0x2e, 0x9b, 0x35, 0xbd, 0xbb, 0x8b, 0xa9, 0x75, 0x5b, 0x11, 0xf5, 0xad,
+	0xed, 0x6f, 0xc8, 0xf1, 0xb5, 0x9c, 0x8c, 0x02, 0xb5, 0x46, 0x90, 0x0c, 0x53, 0xd2, 0x6e, 0xca,
+	0x7b, 0xbf, 0xe7, 0xa8, 0xb7, 0xe4, 0x88, 0xb7, 0x54, 0xdd, 0xf3, 0x09, 0x50, 0xe6, 0x1d, 0x8b,
+	0xab, 0x5d, 0x4c, 0xad, 0x4d, 0x65, 0xaa, 0x30, 0xfb, 0xfb, 0x4f, 0xab, 0xd3, 0xa3, 0x45, 0x7f,
+	0x18, 0x3a, 0x11, 0xa4, 0x6e, 0xf9, 0x12, 0xd5, 0xc7, 0x3e, 0x8f, 0x07, 0x6e, 0x31, 0xc9, 0x08,
+	0x97, 0x0e, 0xdc, 0x2f, 0xb3, 0x8c, 0x8f, 0x48, 0xe7, 0x63, 0x9c, 0x05, 0xef, 0x09, 0xe1, 0xed,
+	0xf5, 0x55, 0xc1, 0x4f, 0xca, 0xe0, 0x2d, 0x15, 0x5c, 0x91, 0x57, 0xcb, 0xbe, 0x21, 0xb8, 0x67,
+	0x84, 0x70, 0xe3, 0x13, 0x42, 0x05, 0x1e, 0x90, 0x5c, 0xc5, 0x5f, 0x5b, 0x15, 0xff, 0xb4, 0x8c,
+	0xdf, 0x56, 0xf1, 0x17, 0xe8, 0xd5, 0xf2, 0x75, 0x09, 0x8a, 0x02, 0xde, 0x8b, 0xb3, 0x99, 0xa9,
+	0x9d, 0xcf, 0x4c, 0xed, 0xd7, 0xcc, 0xd4, 0xbe, 0xce, 0xcd, 0xc6, 0xf9, 0xdc, 0x6c, 0xfc, 0x98,
+	0x9b, 0x8d, 0x77, 0x07, 0x35, 0x3b, 0x69, 0x43, 0xf9, 0x7e, 0x82, 0x43, 0xbe, 0x1c, 0xdc, 0x51,
+	0xf7, 0xb1, 0x7b, 0xaa, 0xfe, 0x45, 0xa4, 0x79, 0xd8, 0x92, 0x3f, 0xf1, 0x47, 0x7f, 0x06, 0x00,
+	0x7d, 0xd1, 0x62, 0xca, 0x71, 0x04, 0x00, 0x00,
+}
+
+func (m *MigrationRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrationRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrationRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolMigrationLinks) > 0 {
+		for iNdEx := len(m.PoolMigrationLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolMigrationLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShared(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolMigrationLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolMigrationLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolMigrationLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPoolId != 0 {
+		i = encodeVarintShared(dAtA, i, uint64(m.NewPoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldPoolId != 0 {
+		i = encodeVarintShared(dAtA, i, uint64(m.OldPoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CanonicalPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanonicalPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintShared(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DenomB) > 0 {
+		i -= len(m.DenomB)
+		copy(dAtA[i:], m.DenomB)
+		i = encodeVarintShared(dAtA, i, uint64(len(m.DenomB)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomA) > 0 {
+		i -= len(m.DenomA)
+		copy(dAtA[i:], m.DenomA)
+		i = encodeVarintShared(dAtA, i, uint64(len(m.DenomA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TakerFees) > 0 {
+		for iNdEx := len(m.TakerFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShared(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SwapFees) > 0 {
+		for iNdEx := len(m.SwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShared(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShared(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintShared(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintShared(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintShared(dAtA []byte, offset int, v uint64) int {
+	offset -= sovShared(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MigrationRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolMigrationLinks) > 0 {
+		for _, e := range m.PoolMigrationLinks {
+			l = e.Size()
+			n += 1 + l + sovShared(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolMigrationLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldPoolId != 0 {
+		n += 1 + sovShared(uint64(m.OldPoolId))
+	}
+	if m.NewPoolId != 0 {
+		n += 1 + sovShared(uint64(m.NewPoolId))
+	}
+	return n
+}
+
+func (m *CanonicalPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomA)
+	if l > 0 {
+		n += 1 + l + sovShared(uint64(l))
+	}
+	l = len(m.DenomB)
+	if l > 0 {
+		n += 1 + l + sovShared(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovShared(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovShared(uint64(m.PoolId))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovShared(uint64(m.EpochNumber))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovShared(uint64(l))
+		}
+	}
+	if len(m.SwapFees) > 0 {
+		for _, e := range m.SwapFees {
+			l = e.Size()
+			n += 1 + l + sovShared(uint64(l))
+		}
+	}
+	if len(m.TakerFees) > 0 {
+		for _, e := range m.TakerFees {
+			l = e.Size()
+			n += 1 + l + sovShared(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovShared(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozShared(x uint64) (n int) {
+	return sovShared(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MigrationRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShared
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrationRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrationRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolMigrationLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShared
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShared
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShared
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolMigrationLinks = append(m.PoolMigrationLinks, PoolMigrationLink{})
+			if err := m.PoolMigrationLinks[len(m.PoolMigrationLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShared(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShared
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolMigrationLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShared
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolMigrationLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolMigrationLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPoolId", wireType)
+			}
+			m.OldPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShared
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPoolId", wireType)
+			}
+			m.NewPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShared
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShared(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShared
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanonicalPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShared
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanonicalPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanonicalPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShared
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShared
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShared
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShared
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShared
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShared
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShared
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShared(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShared
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShared
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShared
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShared
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShared
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShared
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShared
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShared
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShared
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShared
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapFees = append(m.SwapFees, types.Coin{})
+			if err := m.SwapFees[len(m.SwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShared
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShared
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShared
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFees = append(m.TakerFees, types.Coin{})
+			if err := m.TakerFees[len(m.TakerFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShared(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShared
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipShared(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowShared
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowShared
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowShared
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthShared
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupShared
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthShared
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthShared        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowShared          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupShared = fmt.Errorf("proto: unexpected end of group")
+)
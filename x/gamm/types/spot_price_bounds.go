@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs stateless validation of the spot price bounds: the base and quote asset
+// denoms must be valid and distinct, the set bounds must be positive, at least one of them
+// must be set, and the min spot price must not exceed the max spot price.
+func (b SpotPriceBounds) Validate() error {
+	if err := sdk.ValidateDenom(b.BaseAssetDenom); err != nil {
+		return fmt.Errorf("%w: base asset denom: %s", ErrInvalidSpotPriceBounds, err)
+	}
+	if err := sdk.ValidateDenom(b.QuoteAssetDenom); err != nil {
+		return fmt.Errorf("%w: quote asset denom: %s", ErrInvalidSpotPriceBounds, err)
+	}
+	if b.BaseAssetDenom == b.QuoteAssetDenom {
+		return fmt.Errorf("%w: base and quote asset denoms must differ, got %s", ErrInvalidSpotPriceBounds, b.BaseAssetDenom)
+	}
+
+	hasMin, hasMax := b.HasMinSpotPrice(), b.HasMaxSpotPrice()
+	if !hasMin && !hasMax {
+		return fmt.Errorf("%w: min or max spot price must be set", ErrInvalidSpotPriceBounds)
+	}
+	if hasMin && b.MinSpotPrice.IsNegative() {
+		return fmt.Errorf("%w: min spot price must not be negative, got %s", ErrInvalidSpotPriceBounds, b.MinSpotPrice)
+	}
+	if hasMax && b.MaxSpotPrice.IsNegative() {
+		return fmt.Errorf("%w: max spot price must not be negative, got %s", ErrInvalidSpotPriceBounds, b.MaxSpotPrice)
+	}
+	if hasMin && hasMax && b.MinSpotPrice.GT(b.MaxSpotPrice) {
+		return fmt.Errorf("%w: min spot price %s exceeds max spot price %s", ErrInvalidSpotPriceBounds, b.MinSpotPrice, b.MaxSpotPrice)
+	}
+	return nil
+}
+
+// HasMinSpotPrice returns true if the min spot price is set.
+func (b SpotPriceBounds) HasMinSpotPrice() bool {
+	return !b.MinSpotPrice.IsNil() && !b.MinSpotPrice.IsZero()
+}
+
+// HasMaxSpotPrice returns true if the max spot price is set.
+func (b SpotPriceBounds) HasMaxSpotPrice() bool {
+	return !b.MaxSpotPrice.IsNil() && !b.MaxSpotPrice.IsZero()
+}
+
+// Contains returns true if the given spot price is within the set bounds.
+func (b SpotPriceBounds) Contains(spotPrice sdk.Dec) bool {
+	if b.HasMinSpotPrice() && spotPrice.LT(b.MinSpotPrice) {
+		return false
+	}
+	if b.HasMaxSpotPrice() && spotPrice.GT(b.MaxSpotPrice) {
+		return false
+	}
+	return true
+}
@@ -33,35 +33,44 @@ func init() {
 }
 
 var fileDescriptor_d8d618b55f2ad4cd = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcd, 0xca, 0xd3, 0x40,
-	0x14, 0x86, 0x3b, 0x08, 0xf2, 0x39, 0x3b, 0x83, 0x3f, 0x90, 0x45, 0x36, 0x6e, 0x35, 0xf3, 0x45,
-	0x51, 0xfc, 0x41, 0xe5, 0x2b, 0x54, 0x69, 0xa1, 0x28, 0x76, 0xe7, 0xa6, 0x4c, 0xd2, 0x21, 0x1d,
-	0x48, 0xe6, 0xc4, 0xcc, 0x24, 0x4d, 0xbc, 0x0a, 0x05, 0xb7, 0x82, 0xe0, 0xd6, 0x0b, 0x71, 0xd9,
-	0xa5, 0x4b, 0x69, 0x6f, 0x44, 0xa6, 0x49, 0x86, 0xfe, 0x09, 0x49, 0xbb, 0xcb, 0x99, 0x79, 0x9f,
-	0x77, 0xde, 0x9c, 0x03, 0x07, 0xdf, 0x9f, 0x95, 0x31, 0x13, 0x92, 0x83, 0x28, 0xca, 0xcf, 0xc4,
-	0x14, 0x24, 0xa4, 0x71, 0x4c, 0x72, 0xcf, 0x67, 0x8a, 0x7a, 0x44, 0x15, 0x6e, 0x92, 0x82, 0x02,
-	0xeb, 0xde, 0xb6, 0xda, 0x35, 0x85, 0xab, 0xd5, 0x6e, 0xad, 0xb6, 0x6f, 0x85, 0x10, 0xc2, 0x46,
-	0x4f, 0xf4, 0x57, 0x85, 0xda, 0x4e, 0x00, 0x32, 0x06, 0x49, 0x7c, 0x2a, 0x99, 0x31, 0x0e, 0x80,
-	0x8b, 0xfa, 0xfe, 0xc5, 0x7f, 0x82, 0x24, 0x00, 0x51, 0x4c, 0x05, 0x0d, 0x59, 0x6a, 0x30, 0xb9,
-	0xa0, 0xc9, 0x34, 0x85, 0x4c, 0xb1, 0x1a, 0xf6, 0xda, 0xfd, 0xc5, 0x54, 0x83, 0x35, 0xf2, 0xa4,
-	0x25, 0x12, 0xf1, 0x4f, 0x19, 0x9f, 0x71, 0x55, 0x56, 0xdc, 0xc3, 0x5f, 0x37, 0xf0, 0xb5, 0xb1,
-	0x0c, 0xad, 0x1c, 0x5f, 0x8c, 0x80, 0x8b, 0xf7, 0x00, 0x91, 0x75, 0xe9, 0xb6, 0xe8, 0x8b, 0x3b,
-	0x96, 0x61, 0x43, 0xd8, 0x4f, 0xbb, 0x12, 0x1f, 0x98, 0x4c, 0x40, 0x48, 0x66, 0xfd, 0x40, 0xf8,
-	0x8e, 0x3e, 0x9c, 0x2c, 0x68, 0x32, 0x28, 0x14, 0x4b, 0xc5, 0x55, 0x0c, 0x99, 0x50, 0x43, 0x61,
-	0xbd, 0xea, 0x62, 0x7a, 0xc8, 0xdb, 0x6f, 0xce, 0xe3, 0x8f, 0x46, 0x9c, 0xcc, 0x69, 0xca, 0x2a,
-	0xc5, 0xbb, 0x4c, 0x75, 0x8f, 0xb8, 0xcb, 0x77, 0x8f, 0xb8, 0xcb, 0x9b, 0x88, 0x39, 0xbe, 0x18,
-	0x14, 0x5c, 0x75, 0x9b, 0x5e, 0x43, 0xb4, 0x9f, 0x5e, 0x43, 0x98, 0x77, 0x7f, 0x22, 0x7c, 0x57,
-	0x1f, 0xee, 0x77, 0x4f, 0xf7, 0xe6, 0x75, 0x17, 0xd7, 0x23, 0x06, 0xf6, 0xdb, 0x33, 0x0d, 0x4c,
-	0xca, 0xef, 0x08, 0xdf, 0x6e, 0x34, 0x5b, 0x0d, 0x1c, 0x0a, 0xeb, 0x65, 0xd7, 0x27, 0x76, 0x70,
-	0x7b, 0x70, 0x16, 0x6e, 0xf2, 0x7d, 0x45, 0xf8, 0x66, 0x95, 0x9f, 0x06, 0xca, 0x64, 0x7b, 0xd6,
-	0xd6, 0xfc, 0x00, 0xb5, 0xaf, 0x4e, 0x46, 0x4d, 0xa6, 0x6f, 0x08, 0x5b, 0x7b, 0xb7, 0x7a, 0xa8,
-	0xcf, 0x4f, 0x74, 0xd6, 0xf3, 0xec, 0x9f, 0xce, 0x36, 0xb1, 0xfa, 0xa3, 0xdf, 0x2b, 0x07, 0x2d,
-	0x57, 0x0e, 0xfa, 0xbb, 0x72, 0xd0, 0x97, 0xb5, 0xd3, 0x5b, 0xae, 0x9d, 0xde, 0x9f, 0xb5, 0xd3,
-	0xfb, 0x78, 0x19, 0x72, 0x35, 0xcf, 0x7c, 0x37, 0x80, 0x98, 0x6c, 0x56, 0x33, 0x97, 0x0f, 0x22,
-	0xea, 0xcb, 0xa6, 0x20, 0xb9, 0xf7, 0x98, 0x14, 0xd5, 0x3e, 0x54, 0x65, 0xc2, 0xa4, 0x7f, 0x7d,
-	0xb3, 0x01, 0x1f, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x6c, 0xbd, 0x4d, 0x34, 0x06, 0x00,
-	0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcd, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0x33, 0x88, 0x52, 0x06, 0x84, 0xba, 0x7e, 0xc1, 0x1c, 0xf6, 0xe2, 0xd5, 0xee, 0x26,
+	0x4a, 0xa4, 0x5a, 0xaa, 0xb6, 0x25, 0x29, 0x29, 0x06, 0x25, 0xc1, 0x4b, 0x2f, 0x61, 0xb2, 0x19,
+	0xb6, 0x83, 0xbb, 0xf3, 0xae, 0x3b, 0x93, 0x34, 0xe9, 0xdd, 0xab, 0x28, 0x08, 0x3d, 0x09, 0x82,
+	0xff, 0x8c, 0xc7, 0x1e, 0x3d, 0x4a, 0xf2, 0x8f, 0xc8, 0x6e, 0x76, 0x87, 0x7c, 0xb5, 0xec, 0xc7,
+	0x2d, 0xb3, 0xf3, 0xfc, 0x9e, 0x79, 0x66, 0xde, 0xe1, 0xcd, 0xe0, 0xa7, 0x83, 0x89, 0xcf, 0x84,
+	0xe4, 0x20, 0xc6, 0x93, 0x0b, 0x5b, 0x0f, 0x6c, 0x97, 0xfa, 0xbe, 0x3d, 0xaa, 0xf5, 0x99, 0xa2,
+	0x35, 0x5b, 0x8d, 0xad, 0x20, 0x04, 0x05, 0xc6, 0x93, 0x45, 0xb5, 0xa5, 0x07, 0x56, 0xa4, 0xb6,
+	0x12, 0x35, 0x79, 0xe0, 0x82, 0x0b, 0xb1, 0xde, 0x8e, 0x7e, 0xcd, 0x51, 0x62, 0x3a, 0x20, 0x7d,
+	0x90, 0x76, 0x9f, 0x4a, 0xa6, 0x8d, 0x1d, 0xe0, 0x22, 0x99, 0xdf, 0xbb, 0x26, 0x48, 0x00, 0xe0,
+	0xf9, 0x54, 0x50, 0x97, 0x85, 0x1a, 0x93, 0xe7, 0x34, 0xe8, 0x85, 0x30, 0x54, 0x2c, 0x81, 0x6b,
+	0xd9, 0x76, 0xd1, 0x8b, 0xc0, 0x04, 0x79, 0x91, 0x11, 0xf1, 0xf8, 0xe7, 0x21, 0x1f, 0x70, 0x35,
+	0xc9, 0xc9, 0xf9, 0xdc, 0x0d, 0xa9, 0x8a, 0x0e, 0xe7, 0xe6, 0xfd, 0xad, 0x72, 0x0e, 0x15, 0x20,
+	0xb8, 0x43, 0xbd, 0x5e, 0xb4, 0xf3, 0x04, 0xae, 0x66, 0x84, 0x2f, 0xd2, 0xed, 0x3d, 0xbb, 0xdc,
+	0xc6, 0xb7, 0xda, 0xd2, 0x35, 0x46, 0x78, 0xeb, 0x04, 0xb8, 0xf8, 0x00, 0xe0, 0x19, 0x55, 0x2b,
+	0x43, 0xf9, 0xac, 0xb6, 0x74, 0x53, 0x82, 0xec, 0xe6, 0x25, 0x3a, 0x4c, 0x06, 0x20, 0x24, 0x33,
+	0x7e, 0x21, 0xfc, 0x28, 0xfa, 0xd8, 0x3d, 0xa7, 0x41, 0x63, 0xac, 0x58, 0x28, 0x0e, 0x7c, 0x18,
+	0x0a, 0xd5, 0x12, 0xc6, 0xeb, 0x3c, 0xa6, 0xeb, 0x3c, 0x69, 0x96, 0xe3, 0x37, 0x46, 0xec, 0x9e,
+	0xd1, 0x90, 0xcd, 0x15, 0xef, 0x87, 0x2a, 0x7f, 0xc4, 0x65, 0x9e, 0x34, 0xcb, 0xf1, 0x3a, 0xe2,
+	0x08, 0x6f, 0x35, 0xc6, 0x5c, 0xe5, 0xab, 0x5e, 0x4a, 0x90, 0xdd, 0xbc, 0x84, 0x5e, 0xf7, 0x37,
+	0xc2, 0x8f, 0xa3, 0x8f, 0xab, 0xa7, 0x17, 0x9d, 0xcd, 0x9b, 0x3c, 0xae, 0x1b, 0x0c, 0xc8, 0x71,
+	0x49, 0x03, 0x9d, 0xf2, 0x27, 0xc2, 0x0f, 0x53, 0xcd, 0xc2, 0x01, 0xb6, 0x84, 0xb1, 0x9f, 0x77,
+	0x89, 0x25, 0x9c, 0x34, 0x4a, 0xe1, 0x3a, 0xdf, 0x77, 0x84, 0xef, 0xcd, 0xf3, 0x53, 0x47, 0xe9,
+	0x6c, 0x2f, 0xb3, 0x9a, 0xaf, 0xa1, 0xe4, 0xa0, 0x30, 0xaa, 0x33, 0xfd, 0x40, 0xd8, 0x58, 0x99,
+	0x8d, 0x8a, 0xfa, 0xaa, 0xa0, 0x73, 0x54, 0xcf, 0xc3, 0xe2, 0xec, 0xd2, 0x85, 0xeb, 0xb0, 0xc0,
+	0xa3, 0x0e, 0x6b, 0xa7, 0x8d, 0xb3, 0xc3, 0x1c, 0x08, 0x07, 0x32, 0xfb, 0x85, 0xbb, 0xc6, 0x80,
+	0x1c, 0x97, 0x34, 0x58, 0xea, 0x18, 0x1f, 0x83, 0x01, 0x55, 0xeb, 0x21, 0x33, 0x77, 0x8c, 0xcd,
+	0x3c, 0x69, 0x96, 0xe3, 0x75, 0xc4, 0x2f, 0x08, 0xdf, 0x9d, 0x4f, 0xb2, 0xf8, 0x52, 0x4a, 0xa3,
+	0x9e, 0xd5, 0x79, 0x09, 0x23, 0xfb, 0x85, 0x30, 0x9d, 0xe3, 0x12, 0xe1, 0xfb, 0xc9, 0xcc, 0x3b,
+	0x70, 0x3e, 0xb1, 0x41, 0x92, 0x66, 0x2f, 0xa7, 0xed, 0x22, 0x4c, 0x8e, 0x4a, 0xc0, 0x3a, 0xd9,
+	0x57, 0x84, 0xb7, 0xbb, 0x4c, 0x1d, 0xa5, 0xff, 0xb3, 0x71, 0x73, 0xcd, 0xdc, 0x2a, 0x57, 0x49,
+	0xf2, 0xb6, 0x28, 0xa9, 0x03, 0xf9, 0xf8, 0xf6, 0x29, 0x0d, 0x5a, 0xc2, 0xd8, 0xc9, 0x6a, 0x15,
+	0xcb, 0x49, 0x3d, 0x97, 0x3c, 0x5d, 0xee, 0xf0, 0xe4, 0xcf, 0xd4, 0x44, 0x57, 0x53, 0x13, 0xfd,
+	0x9b, 0x9a, 0xe8, 0xdb, 0xcc, 0xac, 0x5c, 0xcd, 0xcc, 0xca, 0xdf, 0x99, 0x59, 0x39, 0xad, 0xba,
+	0x5c, 0x9d, 0x0d, 0xfb, 0x96, 0x03, 0xbe, 0x1d, 0x3f, 0xd6, 0xb8, 0xdc, 0xf1, 0x68, 0x5f, 0xa6,
+	0x03, 0x7b, 0x54, 0xab, 0xdb, 0xe3, 0xf9, 0xa3, 0x43, 0x4d, 0x02, 0x26, 0xfb, 0x77, 0xe2, 0xc7,
+	0xc6, 0xf3, 0xff, 0x03, 0x00, 0x08, 0xad, 0x82, 0x6b, 0x46, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -84,6 +93,12 @@ type MsgClient interface {
 	ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapShareAmountIn, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInResponse, error)
 	SwapExactAmountIn(ctx context.Context, in *MsgSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSwapExactAmountInResponse, error)
 	SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSwapExactAmountOutResponse, error)
+	ReplaceMigrationRecords(ctx context.Context, in *MsgReplaceMigrationRecords, opts ...grpc.CallOption) (*MsgReplaceMigrationRecordsResponse, error)
+	UpdateMigrationRecords(ctx context.Context, in *MsgUpdateMigrationRecords, opts ...grpc.CallOption) (*MsgUpdateMigrationRecordsResponse, error)
+	MigrateShares(ctx context.Context, in *MsgMigrateShares, opts ...grpc.CallOption) (*MsgMigrateSharesResponse, error)
+	MigrateLockedShares(ctx context.Context, in *MsgMigrateLockedShares, opts ...grpc.CallOption) (*MsgMigrateLockedSharesResponse, error)
+	SetCanonicalPool(ctx context.Context, in *MsgSetCanonicalPool, opts ...grpc.CallOption) (*MsgSetCanonicalPoolResponse, error)
+	ZapIn(ctx context.Context, in *MsgZapIn, opts ...grpc.CallOption) (*MsgZapInResponse, error)
 }
 
 type msgClient struct {
@@ -166,6 +181,60 @@ func (c *msgClient) SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmou
 	return out, nil
 }
 
+func (c *msgClient) ReplaceMigrationRecords(ctx context.Context, in *MsgReplaceMigrationRecords, opts ...grpc.CallOption) (*MsgReplaceMigrationRecordsResponse, error) {
+	out := new(MsgReplaceMigrationRecordsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Msg/ReplaceMigrationRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMigrationRecords(ctx context.Context, in *MsgUpdateMigrationRecords, opts ...grpc.CallOption) (*MsgUpdateMigrationRecordsResponse, error) {
+	out := new(MsgUpdateMigrationRecordsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Msg/UpdateMigrationRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateShares(ctx context.Context, in *MsgMigrateShares, opts ...grpc.CallOption) (*MsgMigrateSharesResponse, error) {
+	out := new(MsgMigrateSharesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Msg/MigrateShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateLockedShares(ctx context.Context, in *MsgMigrateLockedShares, opts ...grpc.CallOption) (*MsgMigrateLockedSharesResponse, error) {
+	out := new(MsgMigrateLockedSharesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Msg/MigrateLockedShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCanonicalPool(ctx context.Context, in *MsgSetCanonicalPool, opts ...grpc.CallOption) (*MsgSetCanonicalPoolResponse, error) {
+	out := new(MsgSetCanonicalPoolResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Msg/SetCanonicalPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ZapIn(ctx context.Context, in *MsgZapIn, opts ...grpc.CallOption) (*MsgZapInResponse, error) {
+	out := new(MsgZapInResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.gamm.v1beta1.Msg/ZapIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
@@ -176,6 +245,12 @@ type MsgServer interface {
 	ExitSwapShareAmountIn(context.Context, *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error)
 	SwapExactAmountIn(context.Context, *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error)
 	SwapExactAmountOut(context.Context, *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error)
+	ReplaceMigrationRecords(context.Context, *MsgReplaceMigrationRecords) (*MsgReplaceMigrationRecordsResponse, error)
+	UpdateMigrationRecords(context.Context, *MsgUpdateMigrationRecords) (*MsgUpdateMigrationRecordsResponse, error)
+	MigrateShares(context.Context, *MsgMigrateShares) (*MsgMigrateSharesResponse, error)
+	MigrateLockedShares(context.Context, *MsgMigrateLockedShares) (*MsgMigrateLockedSharesResponse, error)
+	SetCanonicalPool(context.Context, *MsgSetCanonicalPool) (*MsgSetCanonicalPoolResponse, error)
+	ZapIn(context.Context, *MsgZapIn) (*MsgZapInResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -206,6 +281,24 @@ func (*UnimplementedMsgServer) SwapExactAmountIn(ctx context.Context, req *MsgSw
 func (*UnimplementedMsgServer) SwapExactAmountOut(ctx context.Context, req *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapExactAmountOut not implemented")
 }
+func (*UnimplementedMsgServer) ReplaceMigrationRecords(ctx context.Context, req *MsgReplaceMigrationRecords) (*MsgReplaceMigrationRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceMigrationRecords not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMigrationRecords(ctx context.Context, req *MsgUpdateMigrationRecords) (*MsgUpdateMigrationRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMigrationRecords not implemented")
+}
+func (*UnimplementedMsgServer) MigrateShares(ctx context.Context, req *MsgMigrateShares) (*MsgMigrateSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateShares not implemented")
+}
+func (*UnimplementedMsgServer) MigrateLockedShares(ctx context.Context, req *MsgMigrateLockedShares) (*MsgMigrateLockedSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateLockedShares not implemented")
+}
+func (*UnimplementedMsgServer) SetCanonicalPool(ctx context.Context, req *MsgSetCanonicalPool) (*MsgSetCanonicalPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCanonicalPool not implemented")
+}
+func (*UnimplementedMsgServer) ZapIn(ctx context.Context, req *MsgZapIn) (*MsgZapInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZapIn not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -355,6 +448,114 @@ func _Msg_SwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReplaceMigrationRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplaceMigrationRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplaceMigrationRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Msg/ReplaceMigrationRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplaceMigrationRecords(ctx, req.(*MsgReplaceMigrationRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMigrationRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMigrationRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMigrationRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Msg/UpdateMigrationRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMigrationRecords(ctx, req.(*MsgUpdateMigrationRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Msg/MigrateShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateShares(ctx, req.(*MsgMigrateShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateLockedShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateLockedShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateLockedShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Msg/MigrateLockedShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateLockedShares(ctx, req.(*MsgMigrateLockedShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCanonicalPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCanonicalPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCanonicalPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Msg/SetCanonicalPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCanonicalPool(ctx, req.(*MsgSetCanonicalPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ZapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgZapIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ZapIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.gamm.v1beta1.Msg/ZapIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ZapIn(ctx, req.(*MsgZapIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.gamm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -391,6 +592,30 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SwapExactAmountOut",
 			Handler:    _Msg_SwapExactAmountOut_Handler,
 		},
+		{
+			MethodName: "ReplaceMigrationRecords",
+			Handler:    _Msg_ReplaceMigrationRecords_Handler,
+		},
+		{
+			MethodName: "UpdateMigrationRecords",
+			Handler:    _Msg_UpdateMigrationRecords_Handler,
+		},
+		{
+			MethodName: "MigrateShares",
+			Handler:    _Msg_MigrateShares_Handler,
+		},
+		{
+			MethodName: "MigrateLockedShares",
+			Handler:    _Msg_MigrateLockedShares_Handler,
+		},
+		{
+			MethodName: "SetCanonicalPool",
+			Handler:    _Msg_SetCanonicalPool_Handler,
+		},
+		{
+			MethodName: "ZapIn",
+			Handler:    _Msg_ZapIn_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/gamm/v1beta1/tx.proto",
@@ -0,0 +1,586 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/gamm/v1beta1/tx_canonical_pool.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ===================== MsgSetCanonicalPool
+// MsgSetCanonicalPool sets the canonical pool of a denom pair to a gamm pool
+// holding both denoms. It must be executed through governance.
+type MsgSetCanonicalPool struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	PoolId    uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	DenomA    string `protobuf:"bytes,3,opt,name=denom_a,json=denomA,proto3" json:"denom_a,omitempty" yaml:"denom_a"`
+	DenomB    string `protobuf:"bytes,4,opt,name=denom_b,json=denomB,proto3" json:"denom_b,omitempty" yaml:"denom_b"`
+}
+
+func (m *MsgSetCanonicalPool) Reset()         { *m = MsgSetCanonicalPool{} }
+func (m *MsgSetCanonicalPool) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCanonicalPool) ProtoMessage()    {}
+func (*MsgSetCanonicalPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b4c018dc70f1f0b, []int{0}
+}
+func (m *MsgSetCanonicalPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCanonicalPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCanonicalPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCanonicalPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCanonicalPool.Merge(m, src)
+}
+func (m *MsgSetCanonicalPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCanonicalPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCanonicalPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCanonicalPool proto.InternalMessageInfo
+
+func (m *MsgSetCanonicalPool) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetCanonicalPool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgSetCanonicalPool) GetDenomA() string {
+	if m != nil {
+		return m.DenomA
+	}
+	return ""
+}
+
+func (m *MsgSetCanonicalPool) GetDenomB() string {
+	if m != nil {
+		return m.DenomB
+	}
+	return ""
+}
+
+type MsgSetCanonicalPoolResponse struct {
+}
+
+func (m *MsgSetCanonicalPoolResponse) Reset()         { *m = MsgSetCanonicalPoolResponse{} }
+func (m *MsgSetCanonicalPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCanonicalPoolResponse) ProtoMessage()    {}
+func (*MsgSetCanonicalPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b4c018dc70f1f0b, []int{1}
+}
+func (m *MsgSetCanonicalPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCanonicalPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCanonicalPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCanonicalPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCanonicalPoolResponse.Merge(m, src)
+}
+func (m *MsgSetCanonicalPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCanonicalPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCanonicalPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCanonicalPoolResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetCanonicalPool)(nil), "dymensionxyz.dymension.gamm.v1beta1.MsgSetCanonicalPool")
+	proto.RegisterType((*MsgSetCanonicalPoolResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.MsgSetCanonicalPoolResponse")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/gamm/v1beta1/tx_canonical_pool.proto", fileDescriptor_8b4c018dc70f1f0b)
+}
+
+var fileDescriptor_8b4c018dc70f1f0b = []byte{
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x3f, 0x4b, 0xc3, 0x40,
+	0x18, 0xc6, 0x7b, 0x5a, 0x2a, 0xcd, 0x20, 0x12, 0x3b, 0x04, 0xc5, 0x6b, 0x39, 0x97, 0x82, 0x98,
+	0xb3, 0x8a, 0x8b, 0x4e, 0xc6, 0x49, 0x41, 0x90, 0xb8, 0xb9, 0x84, 0xbb, 0xe6, 0x48, 0x0f, 0x72,
+	0xf7, 0x86, 0xde, 0xb5, 0x34, 0x7e, 0x0a, 0x3f, 0x96, 0x63, 0x47, 0xa7, 0x22, 0xed, 0x37, 0xe8,
+	0x27, 0x90, 0xa4, 0x6d, 0xda, 0xa1, 0xdb, 0xfb, 0xf0, 0xfc, 0x7e, 0x2f, 0xf7, 0xc7, 0x79, 0x8c,
+	0x73, 0x25, 0xb4, 0x91, 0xa0, 0x27, 0xf9, 0x17, 0xad, 0x02, 0x4d, 0x98, 0x52, 0x74, 0xdc, 0xe3,
+	0xc2, 0xb2, 0x1e, 0xb5, 0x93, 0xa8, 0xcf, 0x34, 0x68, 0xd9, 0x67, 0x69, 0x94, 0x01, 0xa4, 0x7e,
+	0x36, 0x04, 0x0b, 0xee, 0xe5, 0xae, 0xec, 0x57, 0xc1, 0x2f, 0x64, 0x7f, 0x2d, 0x9f, 0xb5, 0x12,
+	0x48, 0xa0, 0xe4, 0x69, 0x31, 0xad, 0x54, 0x32, 0x45, 0xce, 0xe9, 0x9b, 0x49, 0x3e, 0x84, 0x7d,
+	0xde, 0x6c, 0x7e, 0x07, 0x48, 0xdd, 0x5b, 0xa7, 0xc9, 0x46, 0x76, 0x00, 0x43, 0x69, 0x73, 0x0f,
+	0x75, 0x50, 0xb7, 0x19, 0xb4, 0x96, 0xb3, 0xf6, 0x49, 0xce, 0x54, 0xfa, 0x40, 0xaa, 0x8a, 0x84,
+	0x5b, 0xcc, 0xbd, 0x72, 0x8e, 0x8a, 0x43, 0x45, 0x32, 0xf6, 0x0e, 0x3a, 0xa8, 0x5b, 0x0f, 0xdc,
+	0xe5, 0xac, 0x7d, 0xbc, 0x32, 0xd6, 0x05, 0x09, 0x1b, 0xc5, 0xf4, 0x12, 0x17, 0x70, 0x2c, 0x34,
+	0xa8, 0x88, 0x79, 0x87, 0xe5, 0xfa, 0x1d, 0x78, 0x5d, 0x90, 0xb0, 0x51, 0x4e, 0x4f, 0x5b, 0x98,
+	0x7b, 0xf5, 0xfd, 0x30, 0xdf, 0xc0, 0x01, 0xb9, 0x70, 0xce, 0xf7, 0xdc, 0x28, 0x14, 0x26, 0x03,
+	0x6d, 0x44, 0xf0, 0xfa, 0x33, 0xc7, 0x68, 0x3a, 0xc7, 0xe8, 0x6f, 0x8e, 0xd1, 0xf7, 0x02, 0xd7,
+	0xa6, 0x0b, 0x5c, 0xfb, 0x5d, 0xe0, 0xda, 0xe7, 0x4d, 0x22, 0xed, 0x60, 0xc4, 0xfd, 0x3e, 0x28,
+	0x0a, 0x46, 0x81, 0x91, 0xe6, 0x3a, 0x65, 0xdc, 0x6c, 0x02, 0x1d, 0xf7, 0xee, 0xe9, 0x64, 0xf5,
+	0x25, 0x36, 0xcf, 0x84, 0xe1, 0x8d, 0xf2, 0x11, 0xef, 0xfe, 0x07, 0x00, 0x01, 0x77, 0x99, 0x53,
+	0xbe, 0x01, 0x00, 0x00,
+}
+
+func (m *MsgSetCanonicalPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCanonicalPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCanonicalPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomB) > 0 {
+		i -= len(m.DenomB)
+		copy(dAtA[i:], m.DenomB)
+		i = encodeVarintTxCanonicalPool(dAtA, i, uint64(len(m.DenomB)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DenomA) > 0 {
+		i -= len(m.DenomA)
+		copy(dAtA[i:], m.DenomA)
+		i = encodeVarintTxCanonicalPool(dAtA, i, uint64(len(m.DenomA)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTxCanonicalPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTxCanonicalPool(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCanonicalPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCanonicalPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCanonicalPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTxCanonicalPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTxCanonicalPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetCanonicalPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTxCanonicalPool(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTxCanonicalPool(uint64(m.PoolId))
+	}
+	l = len(m.DenomA)
+	if l > 0 {
+		n += 1 + l + sovTxCanonicalPool(uint64(l))
+	}
+	l = len(m.DenomB)
+	if l > 0 {
+		n += 1 + l + sovTxCanonicalPool(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetCanonicalPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTxCanonicalPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTxCanonicalPool(x uint64) (n int) {
+	return sovTxCanonicalPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetCanonicalPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxCanonicalPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCanonicalPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCanonicalPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxCanonicalPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxCanonicalPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxCanonicalPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxCanonicalPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxCanonicalPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxCanonicalPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxCanonicalPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxCanonicalPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxCanonicalPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxCanonicalPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxCanonicalPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxCanonicalPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCanonicalPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxCanonicalPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCanonicalPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCanonicalPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxCanonicalPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxCanonicalPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTxCanonicalPool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTxCanonicalPool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxCanonicalPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxCanonicalPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTxCanonicalPool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTxCanonicalPool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTxCanonicalPool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTxCanonicalPool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTxCanonicalPool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTxCanonicalPool = fmt.Errorf("proto: unexpected end of group")
+)
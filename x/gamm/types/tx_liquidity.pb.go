@@ -26,6 +26,63 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ===================== SpotPriceBounds
+// SpotPriceBounds bounds the spot price of a pool, in quote asset per base
+// asset, a join or exit is executed at. A zero bound is unset.
+type SpotPriceBounds struct {
+	BaseAssetDenom  string                                 `protobuf:"bytes,1,opt,name=base_asset_denom,json=baseAssetDenom,proto3" json:"base_asset_denom,omitempty" yaml:"base_asset_denom"`
+	QuoteAssetDenom string                                 `protobuf:"bytes,2,opt,name=quote_asset_denom,json=quoteAssetDenom,proto3" json:"quote_asset_denom,omitempty" yaml:"quote_asset_denom"`
+	MinSpotPrice    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_spot_price,json=minSpotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_spot_price" yaml:"min_spot_price"`
+	MaxSpotPrice    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_spot_price,json=maxSpotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_spot_price" yaml:"max_spot_price"`
+}
+
+func (m *SpotPriceBounds) Reset()         { *m = SpotPriceBounds{} }
+func (m *SpotPriceBounds) String() string { return proto.CompactTextString(m) }
+func (*SpotPriceBounds) ProtoMessage()    {}
+func (*SpotPriceBounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d62ed88473db554b, []int{0}
+}
+func (m *SpotPriceBounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpotPriceBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpotPriceBounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpotPriceBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotPriceBounds.Merge(m, src)
+}
+func (m *SpotPriceBounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpotPriceBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotPriceBounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpotPriceBounds proto.InternalMessageInfo
+
+func (m *SpotPriceBounds) GetBaseAssetDenom() string {
+	if m != nil {
+		return m.BaseAssetDenom
+	}
+	return ""
+}
+
+func (m *SpotPriceBounds) GetQuoteAssetDenom() string {
+	if m != nil {
+		return m.QuoteAssetDenom
+	}
+	return ""
+}
+
 // ===================== MsgJoinPool
 // This is really MsgJoinPoolNoSwap
 type MsgJoinPool struct {
@@ -33,13 +90,16 @@ type MsgJoinPool struct {
 	PoolId         uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 	ShareOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_amount" yaml:"pool_amount_out"`
 	TokenInMaxs    []types.Coin                           `protobuf:"bytes,4,rep,name=token_in_maxs,json=tokenInMaxs,proto3" json:"token_in_maxs" yaml:"token_in_max_amounts"`
+	// spot_price_bounds optionally bounds the spot price of the pool the message
+	// is executed at.
+	SpotPriceBounds *SpotPriceBounds `protobuf:"bytes,5,opt,name=spot_price_bounds,json=spotPriceBounds,proto3" json:"spot_price_bounds,omitempty" yaml:"spot_price_bounds"`
 }
 
 func (m *MsgJoinPool) Reset()         { *m = MsgJoinPool{} }
 func (m *MsgJoinPool) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinPool) ProtoMessage()    {}
 func (*MsgJoinPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d62ed88473db554b, []int{0}
+	return fileDescriptor_d62ed88473db554b, []int{1}
 }
 func (m *MsgJoinPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -89,6 +149,13 @@ func (m *MsgJoinPool) GetTokenInMaxs() []types.Coin {
 	return nil
 }
 
+func (m *MsgJoinPool) GetSpotPriceBounds() *SpotPriceBounds {
+	if m != nil {
+		return m.SpotPriceBounds
+	}
+	return nil
+}
+
 type MsgJoinPoolResponse struct {
 	ShareOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_amount" yaml:"share_out_amount"`
 	TokenIn        []types.Coin                           `protobuf:"bytes,2,rep,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
@@ -98,7 +165,7 @@ func (m *MsgJoinPoolResponse) Reset()         { *m = MsgJoinPoolResponse{} }
 func (m *MsgJoinPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinPoolResponse) ProtoMessage()    {}
 func (*MsgJoinPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d62ed88473db554b, []int{1}
+	return fileDescriptor_d62ed88473db554b, []int{2}
 }
 func (m *MsgJoinPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -140,13 +207,16 @@ type MsgExitPool struct {
 	PoolId        uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 	ShareInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=share_in_amount,json=shareInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_in_amount" yaml:"share_in_amount"`
 	TokenOutMins  []types.Coin                           `protobuf:"bytes,4,rep,name=token_out_mins,json=tokenOutMins,proto3" json:"token_out_mins" yaml:"token_out_min_amounts"`
+	// spot_price_bounds optionally bounds the spot price of the pool the message
+	// is executed at.
+	SpotPriceBounds *SpotPriceBounds `protobuf:"bytes,5,opt,name=spot_price_bounds,json=spotPriceBounds,proto3" json:"spot_price_bounds,omitempty" yaml:"spot_price_bounds"`
 }
 
 func (m *MsgExitPool) Reset()         { *m = MsgExitPool{} }
 func (m *MsgExitPool) String() string { return proto.CompactTextString(m) }
 func (*MsgExitPool) ProtoMessage()    {}
 func (*MsgExitPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d62ed88473db554b, []int{2}
+	return fileDescriptor_d62ed88473db554b, []int{3}
 }
 func (m *MsgExitPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,6 +266,13 @@ func (m *MsgExitPool) GetTokenOutMins() []types.Coin {
 	return nil
 }
 
+func (m *MsgExitPool) GetSpotPriceBounds() *SpotPriceBounds {
+	if m != nil {
+		return m.SpotPriceBounds
+	}
+	return nil
+}
+
 type MsgExitPoolResponse struct {
 	TokenOut []types.Coin `protobuf:"bytes,1,rep,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
 }
@@ -204,7 +281,7 @@ func (m *MsgExitPoolResponse) Reset()         { *m = MsgExitPoolResponse{} }
 func (m *MsgExitPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExitPoolResponse) ProtoMessage()    {}
 func (*MsgExitPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d62ed88473db554b, []int{3}
+	return fileDescriptor_d62ed88473db554b, []int{4}
 }
 func (m *MsgExitPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,13 +324,20 @@ type MsgJoinSwapExternAmountIn struct {
 	PoolId            uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 	TokenIn           types.Coin                             `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
 	ShareOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=share_out_min_amount,json=shareOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_min_amount" yaml:"share_out_min_amount"`
+	// repeated cosmos.base.v1beta1.Coin tokensIn = 5 [
+	//   (gogoproto.moretags) = "yaml:\"tokens_in\"",
+	//   (gogoproto.nullable) = false
+	// ];
+	// spot_price_bounds optionally bounds the spot price of the pool the message
+	// is executed at.
+	SpotPriceBounds *SpotPriceBounds `protobuf:"bytes,5,opt,name=spot_price_bounds,json=spotPriceBounds,proto3" json:"spot_price_bounds,omitempty" yaml:"spot_price_bounds"`
 }
 
 func (m *MsgJoinSwapExternAmountIn) Reset()         { *m = MsgJoinSwapExternAmountIn{} }
 func (m *MsgJoinSwapExternAmountIn) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapExternAmountIn) ProtoMessage()    {}
 func (*MsgJoinSwapExternAmountIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d62ed88473db554b, []int{4}
+	return fileDescriptor_d62ed88473db554b, []int{5}
 }
 func (m *MsgJoinSwapExternAmountIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -303,6 +387,13 @@ func (m *MsgJoinSwapExternAmountIn) GetTokenIn() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgJoinSwapExternAmountIn) GetSpotPriceBounds() *SpotPriceBounds {
+	if m != nil {
+		return m.SpotPriceBounds
+	}
+	return nil
+}
+
 type MsgJoinSwapExternAmountInResponse struct {
 	ShareOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_amount" yaml:"share_out_amount"`
 }
@@ -311,7 +402,7 @@ func (m *MsgJoinSwapExternAmountInResponse) Reset()         { *m = MsgJoinSwapEx
 func (m *MsgJoinSwapExternAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapExternAmountInResponse) ProtoMessage()    {}
 func (*MsgJoinSwapExternAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d62ed88473db554b, []int{5}
+	return fileDescriptor_d62ed88473db554b, []int{6}
 }
 func (m *MsgJoinSwapExternAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,7 +444,7 @@ func (m *MsgJoinSwapShareAmountOut) Reset()         { *m = MsgJoinSwapShareAmoun
 func (m *MsgJoinSwapShareAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapShareAmountOut) ProtoMessage()    {}
 func (*MsgJoinSwapShareAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d62ed88473db554b, []int{6}
+	return fileDescriptor_d62ed88473db554b, []int{7}
 }
 func (m *MsgJoinSwapShareAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,7 +502,7 @@ func (m *MsgJoinSwapShareAmountOutResponse) Reset()         { *m = MsgJoinSwapSh
 func (m *MsgJoinSwapShareAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapShareAmountOutResponse) ProtoMessage()    {}
 func (*MsgJoinSwapShareAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d62ed88473db554b, []int{7}
+	return fileDescriptor_d62ed88473db554b, []int{8}
 }
 func (m *MsgJoinSwapShareAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,13 +538,16 @@ type MsgExitSwapShareAmountIn struct {
 	TokenOutDenom     string                                 `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
 	ShareInAmount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=share_in_amount,json=shareInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_in_amount" yaml:"share_in_amount"`
 	TokenOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// spot_price_bounds optionally bounds the spot price of the pool the message
+	// is executed at.
+	SpotPriceBounds *SpotPriceBounds `protobuf:"bytes,6,opt,name=spot_price_bounds,json=spotPriceBounds,proto3" json:"spot_price_bounds,omitempty" yaml:"spot_price_bounds"`
 }
 
 func (m *MsgExitSwapShareAmountIn) Reset()         { *m = MsgExitSwapShareAmountIn{} }
 func (m *MsgExitSwapShareAmountIn) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapShareAmountIn) ProtoMessage()    {}
 func (*MsgExitSwapShareAmountIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d62ed88473db554b, []int{8}
+	return fileDescriptor_d62ed88473db554b, []int{9}
 }
 func (m *MsgExitSwapShareAmountIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -503,6 +597,13 @@ func (m *MsgExitSwapShareAmountIn) GetTokenOutDenom() string {
 	return ""
 }
 
+func (m *MsgExitSwapShareAmountIn) GetSpotPriceBounds() *SpotPriceBounds {
+	if m != nil {
+		return m.SpotPriceBounds
+	}
+	return nil
+}
+
 type MsgExitSwapShareAmountInResponse struct {
 	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
 }
@@ -511,7 +612,7 @@ func (m *MsgExitSwapShareAmountInResponse) Reset()         { *m = MsgExitSwapSha
 func (m *MsgExitSwapShareAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapShareAmountInResponse) ProtoMessage()    {}
 func (*MsgExitSwapShareAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d62ed88473db554b, []int{9}
+	return fileDescriptor_d62ed88473db554b, []int{10}
 }
 func (m *MsgExitSwapShareAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -552,7 +653,7 @@ func (m *MsgExitSwapExternAmountOut) Reset()         { *m = MsgExitSwapExternAmo
 func (m *MsgExitSwapExternAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapExternAmountOut) ProtoMessage()    {}
 func (*MsgExitSwapExternAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d62ed88473db554b, []int{10}
+	return fileDescriptor_d62ed88473db554b, []int{11}
 }
 func (m *MsgExitSwapExternAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,7 +711,7 @@ func (m *MsgExitSwapExternAmountOutResponse) Reset()         { *m = MsgExitSwapE
 func (m *MsgExitSwapExternAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapExternAmountOutResponse) ProtoMessage()    {}
 func (*MsgExitSwapExternAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d62ed88473db554b, []int{11}
+	return fileDescriptor_d62ed88473db554b, []int{12}
 }
 func (m *MsgExitSwapExternAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -640,6 +741,7 @@ func (m *MsgExitSwapExternAmountOutResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgExitSwapExternAmountOutResponse proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*SpotPriceBounds)(nil), "dymensionxyz.dymension.gamm.v1beta1.SpotPriceBounds")
 	proto.RegisterType((*MsgJoinPool)(nil), "dymensionxyz.dymension.gamm.v1beta1.MsgJoinPool")
 	proto.RegisterType((*MsgJoinPoolResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.MsgJoinPoolResponse")
 	proto.RegisterType((*MsgExitPool)(nil), "dymensionxyz.dymension.gamm.v1beta1.MsgExitPool")
@@ -659,62 +761,130 @@ func init() {
 }
 
 var fileDescriptor_d62ed88473db554b = []byte{
-	// 879 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4d, 0x6f, 0xd3, 0x48,
-	0x18, 0x8e, 0x93, 0x6c, 0x3f, 0xa6, 0x9b, 0x34, 0x75, 0xbb, 0x5b, 0x37, 0x5d, 0x25, 0xd9, 0xe9,
-	0x6a, 0xd5, 0xd5, 0xaa, 0x36, 0x05, 0xc1, 0x01, 0x0e, 0x88, 0x40, 0x25, 0x52, 0x11, 0xa5, 0x72,
-	0x6f, 0x5c, 0x2c, 0xa7, 0xb1, 0xd2, 0x51, 0xe3, 0x19, 0x93, 0x19, 0xb7, 0x09, 0x48, 0xfd, 0x0d,
-	0x5c, 0xf8, 0xf8, 0x05, 0x88, 0x3f, 0x01, 0x07, 0xb8, 0xf4, 0xd8, 0x23, 0x70, 0x88, 0x50, 0xfb,
-	0x0f, 0xf2, 0x0b, 0x90, 0xed, 0xb1, 0xe3, 0x98, 0x44, 0xfd, 0xb4, 0x38, 0x25, 0xf3, 0xf1, 0xbe,
-	0xcf, 0xfb, 0xf1, 0xcc, 0xf3, 0xca, 0xe0, 0x4e, 0xa3, 0x6b, 0x1a, 0x98, 0x22, 0x82, 0x3b, 0xdd,
-	0xe7, 0x4a, 0xb0, 0x50, 0x9a, 0xba, 0x69, 0x2a, 0xfb, 0xeb, 0x75, 0x83, 0xe9, 0xeb, 0x0a, 0xeb,
-	0x68, 0x2d, 0xf4, 0xcc, 0x46, 0x0d, 0xc4, 0xba, 0xb2, 0xd5, 0x26, 0x8c, 0x88, 0x2b, 0x61, 0x3b,
-	0x39, 0x58, 0xc8, 0x8e, 0x9d, 0xcc, 0xed, 0xf2, 0x0b, 0x4d, 0xd2, 0x24, 0xee, 0x7d, 0xc5, 0xf9,
-	0xe7, 0x99, 0xe6, 0x0b, 0x3b, 0x84, 0x9a, 0x84, 0x2a, 0x75, 0x9d, 0x1a, 0x01, 0xc4, 0x0e, 0x41,
-	0x98, 0x9f, 0xdf, 0x1b, 0x13, 0x92, 0x45, 0x48, 0xcb, 0xd4, 0xb1, 0xde, 0x34, 0xda, 0x81, 0x19,
-	0x3d, 0xd0, 0x2d, 0xad, 0x4d, 0x6c, 0x66, 0x78, 0xc6, 0xf0, 0x43, 0x12, 0xcc, 0x54, 0x69, 0x73,
-	0x93, 0x20, 0xbc, 0x45, 0x48, 0x4b, 0xfc, 0x0f, 0x4c, 0x50, 0x03, 0x37, 0x8c, 0xb6, 0x24, 0x94,
-	0x84, 0xd5, 0xe9, 0xf2, 0x5c, 0xbf, 0x57, 0xcc, 0x74, 0x75, 0xb3, 0x75, 0x17, 0x7a, 0xfb, 0x50,
-	0xe5, 0x17, 0xc4, 0xff, 0xc1, 0xa4, 0x03, 0xa1, 0xa1, 0x86, 0x94, 0x2c, 0x09, 0xab, 0xe9, 0xb2,
-	0xd8, 0xef, 0x15, 0xb3, 0xde, 0x5d, 0x7e, 0x00, 0xd5, 0x09, 0xe7, 0x5f, 0xa5, 0x21, 0xb6, 0x41,
-	0x8e, 0xee, 0xea, 0x6d, 0x43, 0x23, 0x36, 0xd3, 0x74, 0x93, 0xd8, 0x98, 0x49, 0x29, 0x17, 0xe1,
-	0xf1, 0x51, 0xaf, 0x98, 0xf8, 0xd6, 0x2b, 0xfe, 0xdb, 0x44, 0x6c, 0xd7, 0xae, 0xcb, 0x3b, 0xc4,
-	0x54, 0x78, 0xc6, 0xde, 0xcf, 0x1a, 0x6d, 0xec, 0x29, 0xac, 0x6b, 0x19, 0x54, 0xae, 0x60, 0xd6,
-	0xef, 0x15, 0xff, 0x0c, 0x61, 0x78, 0xae, 0x1c, 0xaf, 0x50, 0xcd, 0xba, 0x08, 0x35, 0x9b, 0x3d,
-	0x70, 0x37, 0xc5, 0x3a, 0xc8, 0x30, 0xb2, 0x67, 0x60, 0x0d, 0x61, 0xcd, 0xd4, 0x3b, 0x54, 0x4a,
-	0x97, 0x52, 0xab, 0x33, 0x37, 0x97, 0x64, 0xcf, 0xaf, 0xec, 0x14, 0xd4, 0xaf, 0xbd, 0xfc, 0x90,
-	0x20, 0x5c, 0x5e, 0x71, 0x62, 0xe9, 0xf7, 0x8a, 0xcb, 0x1e, 0x42, 0xd8, 0x9a, 0x23, 0x51, 0xa8,
-	0xce, 0xb8, 0xdb, 0x15, 0x5c, 0xd5, 0x3b, 0x14, 0x7e, 0x15, 0xc0, 0x7c, 0xa8, 0x7e, 0xaa, 0x41,
-	0x2d, 0x82, 0xa9, 0x21, 0xd2, 0x11, 0xf9, 0x7a, 0x15, 0xad, 0x5c, 0x38, 0xdf, 0x45, 0x5e, 0xff,
-	0x88, 0xbf, 0x9f, 0x13, 0xae, 0x82, 0x29, 0x3f, 0x64, 0x29, 0x79, 0x56, 0xae, 0x8b, 0x3c, 0xd7,
-	0xd9, 0xe1, 0x5c, 0xa1, 0x3a, 0xc9, 0xf3, 0x83, 0x1f, 0x3d, 0x6e, 0x6c, 0x74, 0x10, 0x8b, 0x95,
-	0x1b, 0x16, 0x98, 0xf5, 0x72, 0x43, 0xf8, 0x9a, 0xa8, 0x11, 0x71, 0x07, 0xd5, 0x8c, 0xbb, 0x53,
-	0xc1, 0xbc, 0x50, 0x06, 0xc8, 0x7a, 0xf9, 0x3a, 0xd5, 0x34, 0x11, 0x3e, 0x07, 0x35, 0xfe, 0xe1,
-	0xe5, 0xfa, 0x2b, 0x5c, 0x2e, 0x6e, 0x3e, 0xe0, 0xc6, 0xef, 0xee, 0x7e, 0xcd, 0x66, 0x55, 0x84,
-	0x29, 0x6c, 0xba, 0xdc, 0xf0, 0xeb, 0x17, 0x70, 0x63, 0x0b, 0x4c, 0x07, 0xe6, 0x92, 0x70, 0x16,
-	0xb0, 0xc4, 0x81, 0x73, 0x11, 0x60, 0xa8, 0x4e, 0xf9, 0x60, 0xf0, 0x53, 0x12, 0x2c, 0x71, 0x16,
-	0x6e, 0x1f, 0xe8, 0xd6, 0x46, 0x87, 0x19, 0x6d, 0x9e, 0x6b, 0x05, 0xc7, 0xd6, 0xb7, 0x30, 0xdd,
-	0x9c, 0x86, 0x5d, 0x8d, 0x6e, 0xe2, 0x21, 0x58, 0x18, 0x50, 0x7c, 0x50, 0x55, 0x29, 0xed, 0x06,
-	0x5d, 0xbd, 0x30, 0x17, 0x96, 0xa3, 0xcf, 0xc6, 0x0c, 0x11, 0x62, 0xce, 0x7f, 0x3a, 0x55, 0xc4,
-	0x0b, 0x05, 0xdf, 0x0a, 0xe0, 0xef, 0xb1, 0x45, 0xfc, 0xa5, 0x0f, 0x1b, 0xbe, 0x4b, 0x0d, 0xf5,
-	0x77, 0xdb, 0x39, 0xf5, 0x8e, 0x6a, 0x36, 0x8b, 0xad, 0xbf, 0xf7, 0xfd, 0x57, 0x82, 0xb0, 0xd6,
-	0x30, 0x30, 0x31, 0xf9, 0xb3, 0x5c, 0xea, 0xf7, 0x8a, 0x7f, 0x44, 0x14, 0xd2, 0x3d, 0xf7, 0xf9,
-	0x5f, 0xc1, 0x8f, 0x9c, 0xe5, 0xc8, 0x5a, 0xa5, 0xe3, 0x16, 0xc1, 0x17, 0x60, 0x7e, 0x84, 0x6e,
-	0x4b, 0xbf, 0xb9, 0xb8, 0x4f, 0x2e, 0x8c, 0x9b, 0x1f, 0x3b, 0x0a, 0xa0, 0x9a, 0x1b, 0x4c, 0x02,
-	0xde, 0xa8, 0x57, 0xc3, 0x1c, 0x1a, 0x6e, 0x54, 0xc0, 0x21, 0x0b, 0xcc, 0x06, 0xfe, 0x86, 0x28,
-	0x74, 0x69, 0xc1, 0x8b, 0xb8, 0x83, 0x6a, 0x86, 0x87, 0xc6, 0xe3, 0x7a, 0x9f, 0x02, 0x12, 0x97,
-	0xa2, 0x48, 0x5c, 0x31, 0xea, 0x43, 0xd9, 0x4f, 0xd3, 0x69, 0x57, 0x98, 0x40, 0xf9, 0x68, 0xe0,
-	0xc1, 0x05, 0x3f, 0xf0, 0x9a, 0xcd, 0x3c, 0x0a, 0x8d, 0x98, 0x0d, 0xe9, 0x78, 0x67, 0xc3, 0x21,
-	0x58, 0x18, 0x25, 0xee, 0x9c, 0x40, 0x97, 0x96, 0xa1, 0x51, 0x3e, 0xa1, 0x3a, 0x17, 0x9a, 0x17,
-	0xbc, 0x55, 0x6f, 0x04, 0x50, 0x1a, 0xd7, 0xaa, 0xb0, 0x0a, 0x0d, 0x1c, 0x5e, 0x8f, 0x0a, 0x45,
-	0xfd, 0x41, 0x35, 0xeb, 0x07, 0xc7, 0x23, 0xfb, 0x9c, 0x04, 0xf9, 0x50, 0x64, 0x61, 0x81, 0x8c,
-	0x53, 0x86, 0x86, 0xc6, 0xe5, 0x99, 0x73, 0xe6, 0x1c, 0xe3, 0xd2, 0x91, 0x88, 0x80, 0x05, 0x21,
-	0x89, 0x48, 0x5f, 0x4d, 0x22, 0x46, 0xb8, 0x84, 0x6a, 0x8e, 0x93, 0x6b, 0x20, 0x11, 0xaf, 0x05,
-	0x00, 0xc7, 0x57, 0x31, 0xac, 0x11, 0x51, 0xe2, 0x0b, 0xb1, 0x12, 0xbf, 0xbc, 0x79, 0x74, 0x52,
-	0x10, 0x8e, 0x4f, 0x0a, 0xc2, 0xf7, 0x93, 0x82, 0xf0, 0xf2, 0xb4, 0x90, 0x38, 0x3e, 0x2d, 0x24,
-	0xbe, 0x9c, 0x16, 0x12, 0x4f, 0x6f, 0x84, 0xa0, 0x5c, 0x08, 0x44, 0xd7, 0x5a, 0x7a, 0x9d, 0xfa,
-	0x0b, 0x65, 0x7f, 0xfd, 0xb6, 0xd2, 0xf1, 0xbe, 0x81, 0x5c, 0xe0, 0xfa, 0x84, 0xfb, 0x75, 0x71,
-	0xeb, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x35, 0xd3, 0xf6, 0x2f, 0x0d, 0x00, 0x00,
+	// 1044 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcb, 0x6e, 0xdb, 0x46,
+	0x14, 0x35, 0x2d, 0xc5, 0x89, 0xc7, 0xb1, 0x1e, 0xb4, 0xdb, 0xd0, 0x72, 0x20, 0xa9, 0x93, 0xa2,
+	0x70, 0x51, 0x84, 0xac, 0xd3, 0xc7, 0xa2, 0x5d, 0x14, 0x51, 0x63, 0x34, 0x0a, 0x2a, 0xd8, 0xa0,
+	0x77, 0xdd, 0x10, 0x94, 0x44, 0x28, 0x83, 0x88, 0x33, 0x8c, 0x66, 0x98, 0x50, 0x2d, 0x60, 0xf4,
+	0x13, 0xba, 0xe9, 0xe3, 0x0b, 0x8a, 0xfe, 0x44, 0xbb, 0x68, 0x37, 0x59, 0x66, 0xd9, 0x76, 0x41,
+	0x14, 0xf6, 0x1f, 0xe8, 0x0b, 0x0a, 0x72, 0x86, 0xd4, 0x90, 0x96, 0x62, 0xbb, 0x8e, 0xea, 0x55,
+	0xc4, 0x79, 0x9c, 0x7b, 0xef, 0x99, 0x33, 0xe7, 0x4e, 0x0c, 0x3e, 0xee, 0x8f, 0x5d, 0x07, 0x53,
+	0x44, 0x70, 0x30, 0xfe, 0xda, 0x48, 0x3f, 0x8c, 0x81, 0xed, 0xba, 0xc6, 0xb3, 0xdd, 0xae, 0xc3,
+	0xec, 0x5d, 0x83, 0x05, 0xd6, 0x10, 0x3d, 0xf5, 0x51, 0x1f, 0xb1, 0xb1, 0xee, 0x8d, 0x08, 0x23,
+	0xea, 0x1d, 0x79, 0x9f, 0x9e, 0x7e, 0xe8, 0xd1, 0x3e, 0x5d, 0xec, 0xab, 0x6d, 0x0e, 0xc8, 0x80,
+	0xc4, 0xeb, 0x8d, 0xe8, 0x17, 0xdf, 0x5a, 0xab, 0xf7, 0x08, 0x75, 0x09, 0x35, 0xba, 0x36, 0x75,
+	0xd2, 0x10, 0x3d, 0x82, 0xb0, 0x98, 0xff, 0x74, 0x4e, 0x4a, 0x1e, 0x21, 0x43, 0xd7, 0xc6, 0xf6,
+	0xc0, 0x19, 0xa5, 0xdb, 0xe8, 0x73, 0xdb, 0xb3, 0x46, 0xc4, 0x67, 0x0e, 0xdf, 0x0c, 0xbf, 0x2d,
+	0x80, 0xf2, 0xa1, 0x47, 0xd8, 0xc1, 0x08, 0xf5, 0x9c, 0x16, 0xf1, 0x71, 0x9f, 0xaa, 0x7b, 0xa0,
+	0x12, 0xc5, 0xb2, 0x6c, 0x4a, 0x1d, 0x66, 0xf5, 0x1d, 0x4c, 0x5c, 0x4d, 0x69, 0x2a, 0x3b, 0xab,
+	0xad, 0xed, 0x49, 0xd8, 0xb8, 0x35, 0xb6, 0xdd, 0xe1, 0x27, 0x30, 0xbf, 0x02, 0x9a, 0xa5, 0x68,
+	0xe8, 0x7e, 0x34, 0xf2, 0x20, 0x1a, 0x50, 0x1f, 0x82, 0xea, 0x53, 0x9f, 0xb0, 0x2c, 0xce, 0x72,
+	0x8c, 0x73, 0x7b, 0x12, 0x36, 0x34, 0x8e, 0x73, 0x6a, 0x09, 0x34, 0xcb, 0xf1, 0x98, 0x84, 0xe4,
+	0x82, 0x92, 0x8b, 0xb0, 0x45, 0x3d, 0xc2, 0x2c, 0x2f, 0x4a, 0x54, 0x2b, 0xc4, 0x30, 0x5f, 0xbc,
+	0x08, 0x1b, 0x4b, 0x7f, 0x87, 0x8d, 0x77, 0x06, 0x88, 0x3d, 0xf6, 0xbb, 0x7a, 0x8f, 0xb8, 0x86,
+	0x20, 0x8b, 0xff, 0x73, 0x97, 0xf6, 0x9f, 0x18, 0x6c, 0xec, 0x39, 0x54, 0x7f, 0xe0, 0xf4, 0x26,
+	0x61, 0xe3, 0x0d, 0x1e, 0x34, 0x8b, 0x06, 0xcd, 0x9b, 0x2e, 0xc2, 0x29, 0x0b, 0x71, 0x38, 0x3b,
+	0x90, 0xc3, 0x15, 0x2f, 0x19, 0xce, 0x0e, 0x72, 0xe1, 0xec, 0x20, 0x0d, 0x07, 0x7f, 0x2d, 0x80,
+	0xb5, 0x0e, 0x1d, 0x3c, 0x22, 0x08, 0x1f, 0x10, 0x32, 0x54, 0xdf, 0x05, 0x2b, 0xd4, 0xc1, 0x7d,
+	0x67, 0x24, 0x48, 0xaf, 0x4e, 0xc2, 0xc6, 0x3a, 0x07, 0xe2, 0xe3, 0xd0, 0x14, 0x0b, 0xd4, 0xf7,
+	0xc0, 0xf5, 0xe8, 0x94, 0x2d, 0xd4, 0x8f, 0x89, 0x2d, 0xb6, 0xd4, 0x49, 0xd8, 0x28, 0xf1, 0xb5,
+	0x62, 0x02, 0x9a, 0x2b, 0xd1, 0xaf, 0x76, 0x5f, 0x1d, 0x81, 0x0a, 0x7d, 0x6c, 0x8f, 0x1c, 0x8b,
+	0xf8, 0xcc, 0xb2, 0x5d, 0xe2, 0x63, 0x26, 0x78, 0x7c, 0x78, 0x81, 0xc2, 0xda, 0x98, 0x4d, 0xc2,
+	0xc6, 0x9b, 0x52, 0x0c, 0x0e, 0x15, 0xa1, 0x42, 0xb3, 0x14, 0x47, 0xd8, 0xf7, 0xd9, 0xfd, 0x78,
+	0x50, 0xed, 0x82, 0x75, 0x46, 0x9e, 0x38, 0xd8, 0x42, 0xd8, 0x72, 0xed, 0x80, 0x6a, 0xc5, 0x66,
+	0x61, 0x67, 0xed, 0xde, 0x96, 0xce, 0x71, 0xf5, 0x48, 0x32, 0x89, 0xfc, 0xf5, 0xcf, 0x09, 0xc2,
+	0xad, 0x3b, 0x51, 0x2e, 0x93, 0xb0, 0xb1, 0xcd, 0x23, 0xc8, 0xbb, 0x45, 0x24, 0x0a, 0xcd, 0xb5,
+	0x78, 0xb8, 0x8d, 0x3b, 0x76, 0x40, 0xd5, 0x23, 0x50, 0x9d, 0x92, 0x6b, 0x75, 0x63, 0x0d, 0x6b,
+	0xd7, 0x9a, 0xca, 0xce, 0xda, 0xbd, 0x0f, 0xf5, 0x73, 0x5c, 0x3b, 0x3d, 0xa7, 0x7f, 0x59, 0x9d,
+	0xa7, 0x80, 0xa1, 0x59, 0xa6, 0xd9, 0xe5, 0xf0, 0x2f, 0x05, 0x6c, 0x48, 0xe7, 0x67, 0x3a, 0xd4,
+	0x23, 0x98, 0x3a, 0x2a, 0x9d, 0xc1, 0x37, 0x3f, 0xd1, 0xf6, 0x85, 0xf9, 0x16, 0x97, 0x2e, 0x8f,
+	0x77, 0x9a, 0xf0, 0x0e, 0xb8, 0x91, 0x50, 0xa6, 0x2d, 0x9f, 0xc5, 0xf5, 0x2d, 0xc1, 0x75, 0x39,
+	0xcb, 0x35, 0x34, 0xaf, 0x0b, 0x7e, 0xe1, 0x6f, 0x5c, 0x9b, 0x7b, 0x01, 0x62, 0x0b, 0xd5, 0xa6,
+	0x07, 0xca, 0xbc, 0x36, 0x84, 0x5f, 0x93, 0x34, 0x73, 0x70, 0xd0, 0x5c, 0x8f, 0x47, 0xda, 0x58,
+	0x10, 0xe5, 0x80, 0x12, 0xaf, 0x37, 0x62, 0xd3, 0x45, 0xf8, 0x1c, 0xd2, 0x7c, 0x5b, 0xd0, 0x75,
+	0x5b, 0xa6, 0x4b, 0x6c, 0x9f, 0x6a, 0xf3, 0x66, 0x3c, 0xbe, 0xef, 0xb3, 0x0e, 0xc2, 0x57, 0x2f,
+	0xce, 0x01, 0xd8, 0x90, 0xce, 0x2f, 0xd5, 0xe6, 0x01, 0x58, 0x4d, 0xd3, 0xd7, 0x94, 0xb3, 0x0a,
+	0xd7, 0x44, 0xe1, 0x95, 0x5c, 0xe1, 0xd0, 0xbc, 0x91, 0x14, 0x0b, 0x7f, 0x2f, 0x80, 0x2d, 0x71,
+	0x0b, 0x0e, 0x9f, 0xdb, 0xde, 0x5e, 0xc0, 0x9c, 0x91, 0xe0, 0xba, 0x8d, 0x17, 0xa6, 0x1b, 0x59,
+	0xee, 0x85, 0xa6, 0xf2, 0xea, 0x32, 0xce, 0x94, 0xbb, 0x7a, 0x04, 0x36, 0xa7, 0x57, 0x6c, 0x7a,
+	0xaa, 0xc2, 0xff, 0x3b, 0x17, 0xd6, 0xe2, 0x76, 0xfe, 0xda, 0xba, 0x92, 0x20, 0xab, 0xc9, 0xd5,
+	0xed, 0xa0, 0x44, 0x94, 0x57, 0xad, 0x96, 0x9f, 0x14, 0xf0, 0xd6, 0xdc, 0x43, 0xbc, 0x52, 0x63,
+	0x83, 0x3f, 0x67, 0xf5, 0x75, 0x18, 0xcd, 0xf2, 0xa9, 0x7d, 0x9f, 0x2d, 0x4c, 0x5f, 0x9f, 0x25,
+	0x2e, 0x81, 0xb0, 0x78, 0xc0, 0x70, 0x5b, 0xda, 0x9a, 0x36, 0xf7, 0xec, 0x7c, 0x72, 0xff, 0xdb,
+	0x98, 0x3f, 0x5d, 0x66, 0x71, 0x55, 0x5c, 0x74, 0x13, 0xf8, 0x06, 0x6c, 0xcc, 0xe8, 0x9b, 0xb1,
+	0x90, 0x56, 0x5b, 0x5f, 0x5e, 0x38, 0x6e, 0x6d, 0x6e, 0x2b, 0x86, 0x66, 0x65, 0xda, 0x89, 0xc5,
+	0x41, 0x7d, 0x9f, 0xd5, 0x50, 0xf6, 0xa0, 0x52, 0x0d, 0x79, 0xa0, 0x9c, 0xe2, 0x65, 0x24, 0xf4,
+	0x9f, 0x0d, 0x3f, 0x07, 0x07, 0xcd, 0x75, 0x91, 0x9a, 0xc8, 0xeb, 0x97, 0x22, 0xd0, 0x84, 0x15,
+	0xe6, 0xf2, 0x5a, 0xa0, 0x3f, 0xb5, 0x92, 0x32, 0xa3, 0xe3, 0x92, 0x05, 0x54, 0xcb, 0x27, 0x9e,
+	0x2e, 0x48, 0x12, 0xdf, 0xf7, 0xc5, 0xeb, 0x77, 0x46, 0x6f, 0x2c, 0x2e, 0xb6, 0x37, 0x1e, 0x81,
+	0xcd, 0x59, 0xcd, 0x4d, 0xbb, 0x76, 0x39, 0x1b, 0x9c, 0x85, 0x09, 0xcd, 0xaa, 0xd4, 0x2f, 0x5f,
+	0x65, 0x83, 0x2b, 0xff, 0x9f, 0x0d, 0xfe, 0xa8, 0x80, 0xe6, 0x3c, 0xa9, 0xc8, 0x2e, 0x38, 0x2d,
+	0xe8, 0xf5, 0xb8, 0x60, 0x1e, 0x0f, 0x9a, 0xa5, 0x84, 0x1c, 0x21, 0xe2, 0x3f, 0x96, 0x41, 0x4d,
+	0xca, 0x4c, 0x36, 0xe8, 0x45, 0xda, 0x60, 0xe6, 0xb9, 0x70, 0x66, 0x9f, 0x3d, 0xc7, 0x73, 0x21,
+	0xb2, 0xa8, 0x54, 0x85, 0x92, 0x45, 0x15, 0x2f, 0x67, 0x51, 0x33, 0x20, 0xa1, 0x59, 0x11, 0xe2,
+	0x9e, 0x5a, 0xd4, 0x0f, 0x0a, 0x80, 0xf3, 0x59, 0x94, 0x3d, 0x2a, 0x7f, 0xf1, 0x94, 0x85, 0x5e,
+	0xbc, 0xd6, 0xa3, 0x17, 0xc7, 0x75, 0xe5, 0xe5, 0x71, 0x5d, 0xf9, 0xe7, 0xb8, 0xae, 0x7c, 0x77,
+	0x52, 0x5f, 0x7a, 0x79, 0x52, 0x5f, 0xfa, 0xf3, 0xa4, 0xbe, 0xf4, 0xd5, 0xfb, 0x52, 0xa8, 0x38,
+	0x04, 0xa2, 0x77, 0x87, 0x76, 0x97, 0x26, 0x1f, 0xc6, 0xb3, 0xdd, 0x8f, 0x8c, 0x80, 0xff, 0x19,
+	0x22, 0x0e, 0xdc, 0x5d, 0x89, 0xff, 0x83, 0xff, 0xc1, 0xbf, 0x03, 0x00, 0x58, 0x84, 0x46, 0x1a,
+	0xb2, 0x10, 0x00, 0x00,
+}
+
+func (m *SpotPriceBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpotPriceBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpotPriceBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSpotPrice.Size()
+		i -= size
+		if _, err := m.MaxSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinSpotPrice.Size()
+		i -= size
+		if _, err := m.MinSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.QuoteAssetDenom) > 0 {
+		i -= len(m.QuoteAssetDenom)
+		copy(dAtA[i:], m.QuoteAssetDenom)
+		i = encodeVarintTxLiquidity(dAtA, i, uint64(len(m.QuoteAssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseAssetDenom) > 0 {
+		i -= len(m.BaseAssetDenom)
+		copy(dAtA[i:], m.BaseAssetDenom)
+		i = encodeVarintTxLiquidity(dAtA, i, uint64(len(m.BaseAssetDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *MsgJoinPool) Marshal() (dAtA []byte, err error) {
@@ -737,6 +907,18 @@ func (m *MsgJoinPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SpotPriceBounds != nil {
+		{
+			size, err := m.SpotPriceBounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.TokenInMaxs) > 0 {
 		for iNdEx := len(m.TokenInMaxs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -843,6 +1025,18 @@ func (m *MsgExitPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SpotPriceBounds != nil {
+		{
+			size, err := m.SpotPriceBounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.TokenOutMins) > 0 {
 		for iNdEx := len(m.TokenOutMins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -939,6 +1133,18 @@ func (m *MsgJoinSwapExternAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.SpotPriceBounds != nil {
+		{
+			size, err := m.SpotPriceBounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.ShareOutMinAmount.Size()
 		i -= size
@@ -1122,6 +1328,18 @@ func (m *MsgExitSwapShareAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.SpotPriceBounds != nil {
+		{
+			size, err := m.SpotPriceBounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		size := m.TokenOutMinAmount.Size()
 		i -= size
@@ -1296,6 +1514,27 @@ func encodeVarintTxLiquidity(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *SpotPriceBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovTxLiquidity(uint64(l))
+	}
+	l = len(m.QuoteAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovTxLiquidity(uint64(l))
+	}
+	l = m.MinSpotPrice.Size()
+	n += 1 + l + sovTxLiquidity(uint64(l))
+	l = m.MaxSpotPrice.Size()
+	n += 1 + l + sovTxLiquidity(uint64(l))
+	return n
+}
+
 func (m *MsgJoinPool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1317,6 +1556,10 @@ func (m *MsgJoinPool) Size() (n int) {
 			n += 1 + l + sovTxLiquidity(uint64(l))
 		}
 	}
+	if m.SpotPriceBounds != nil {
+		l = m.SpotPriceBounds.Size()
+		n += 1 + l + sovTxLiquidity(uint64(l))
+	}
 	return n
 }
 
@@ -1358,6 +1601,10 @@ func (m *MsgExitPool) Size() (n int) {
 			n += 1 + l + sovTxLiquidity(uint64(l))
 		}
 	}
+	if m.SpotPriceBounds != nil {
+		l = m.SpotPriceBounds.Size()
+		n += 1 + l + sovTxLiquidity(uint64(l))
+	}
 	return n
 }
 
@@ -1393,6 +1640,10 @@ func (m *MsgJoinSwapExternAmountIn) Size() (n int) {
 	n += 1 + l + sovTxLiquidity(uint64(l))
 	l = m.ShareOutMinAmount.Size()
 	n += 1 + l + sovTxLiquidity(uint64(l))
+	if m.SpotPriceBounds != nil {
+		l = m.SpotPriceBounds.Size()
+		n += 1 + l + sovTxLiquidity(uint64(l))
+	}
 	return n
 }
 
@@ -1463,6 +1714,10 @@ func (m *MsgExitSwapShareAmountIn) Size() (n int) {
 	n += 1 + l + sovTxLiquidity(uint64(l))
 	l = m.TokenOutMinAmount.Size()
 	n += 1 + l + sovTxLiquidity(uint64(l))
+	if m.SpotPriceBounds != nil {
+		l = m.SpotPriceBounds.Size()
+		n += 1 + l + sovTxLiquidity(uint64(l))
+	}
 	return n
 }
 
@@ -1514,6 +1769,188 @@ func sovTxLiquidity(x uint64) (n int) {
 func sozTxLiquidity(x uint64) (n int) {
 	return sovTxLiquidity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *SpotPriceBounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpotPriceBounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpotPriceBounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1662,6 +2099,42 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPriceBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpotPriceBounds == nil {
+				m.SpotPriceBounds = &SpotPriceBounds{}
+			}
+			if err := m.SpotPriceBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxLiquidity(dAtA[iNdEx:])
@@ -1949,6 +2422,42 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPriceBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpotPriceBounds == nil {
+				m.SpotPriceBounds = &SpotPriceBounds{}
+			}
+			if err := m.SpotPriceBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxLiquidity(dAtA[iNdEx:])
@@ -2201,6 +2710,42 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPriceBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpotPriceBounds == nil {
+				m.SpotPriceBounds = &SpotPriceBounds{}
+			}
+			if err := m.SpotPriceBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxLiquidity(dAtA[iNdEx:])
@@ -2771,6 +3316,42 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPriceBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpotPriceBounds == nil {
+				m.SpotPriceBounds = &SpotPriceBounds{}
+			}
+			if err := m.SpotPriceBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxLiquidity(dAtA[iNdEx:])
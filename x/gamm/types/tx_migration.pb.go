@@ -0,0 +1,1874 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/gamm/v1beta1/tx_migration.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ===================== MsgReplaceMigrationRecords
+// MsgReplaceMigrationRecords replaces all the migration records with the
+// given links. It must be executed through governance.
+type MsgReplaceMigrationRecords struct {
+	Authority string              `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Records   []PoolMigrationLink `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
+}
+
+func (m *MsgReplaceMigrationRecords) Reset()         { *m = MsgReplaceMigrationRecords{} }
+func (m *MsgReplaceMigrationRecords) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceMigrationRecords) ProtoMessage()    {}
+func (*MsgReplaceMigrationRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4e21de1873d9d19, []int{0}
+}
+func (m *MsgReplaceMigrationRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceMigrationRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceMigrationRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceMigrationRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceMigrationRecords.Merge(m, src)
+}
+func (m *MsgReplaceMigrationRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceMigrationRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceMigrationRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceMigrationRecords proto.InternalMessageInfo
+
+func (m *MsgReplaceMigrationRecords) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgReplaceMigrationRecords) GetRecords() []PoolMigrationLink {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type MsgReplaceMigrationRecordsResponse struct {
+}
+
+func (m *MsgReplaceMigrationRecordsResponse) Reset()         { *m = MsgReplaceMigrationRecordsResponse{} }
+func (m *MsgReplaceMigrationRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceMigrationRecordsResponse) ProtoMessage()    {}
+func (*MsgReplaceMigrationRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4e21de1873d9d19, []int{1}
+}
+func (m *MsgReplaceMigrationRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceMigrationRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceMigrationRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceMigrationRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceMigrationRecordsResponse.Merge(m, src)
+}
+func (m *MsgReplaceMigrationRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceMigrationRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceMigrationRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceMigrationRecordsResponse proto.InternalMessageInfo
+
+// ===================== MsgUpdateMigrationRecords
+// MsgUpdateMigrationRecords adds, modifies or removes the links of the given
+// old pools, leaving the other links unchanged. A link with a new_pool_id of 0
+// removes the link of its old pool. It must be executed through governance.
+type MsgUpdateMigrationRecords struct {
+	Authority string              `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Records   []PoolMigrationLink `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
+}
+
+func (m *MsgUpdateMigrationRecords) Reset()         { *m = MsgUpdateMigrationRecords{} }
+func (m *MsgUpdateMigrationRecords) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMigrationRecords) ProtoMessage()    {}
+func (*MsgUpdateMigrationRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4e21de1873d9d19, []int{2}
+}
+func (m *MsgUpdateMigrationRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMigrationRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMigrationRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMigrationRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMigrationRecords.Merge(m, src)
+}
+func (m *MsgUpdateMigrationRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMigrationRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMigrationRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMigrationRecords proto.InternalMessageInfo
+
+func (m *MsgUpdateMigrationRecords) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateMigrationRecords) GetRecords() []PoolMigrationLink {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type MsgUpdateMigrationRecordsResponse struct {
+}
+
+func (m *MsgUpdateMigrationRecordsResponse) Reset()         { *m = MsgUpdateMigrationRecordsResponse{} }
+func (m *MsgUpdateMigrationRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMigrationRecordsResponse) ProtoMessage()    {}
+func (*MsgUpdateMigrationRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4e21de1873d9d19, []int{3}
+}
+func (m *MsgUpdateMigrationRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMigrationRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMigrationRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMigrationRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMigrationRecordsResponse.Merge(m, src)
+}
+func (m *MsgUpdateMigrationRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMigrationRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMigrationRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMigrationRecordsResponse proto.InternalMessageInfo
+
+// ===================== MsgMigrateShares
+// MsgMigrateShares migrates shares of a gamm pool to the pool it is linked to
+// by the migration records, without charging the exit fee of the old pool.
+// Liquidity migrated to a concentrated liquidity pool is deposited into a full
+// range position of the sender, and share_out_min_amount then bounds the
+// liquidity created.
+type MsgMigrateShares struct {
+	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	SharesToMigrate   types.Coin                             `protobuf:"bytes,2,opt,name=shares_to_migrate,json=sharesToMigrate,proto3" json:"shares_to_migrate" yaml:"shares_to_migrate"`
+	ShareOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=share_out_min_amount,json=shareOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_min_amount" yaml:"share_out_min_amount"`
+}
+
+func (m *MsgMigrateShares) Reset()         { *m = MsgMigrateShares{} }
+func (m *MsgMigrateShares) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateShares) ProtoMessage()    {}
+func (*MsgMigrateShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4e21de1873d9d19, []int{4}
+}
+func (m *MsgMigrateShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateShares.Merge(m, src)
+}
+func (m *MsgMigrateShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateShares proto.InternalMessageInfo
+
+func (m *MsgMigrateShares) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgMigrateShares) GetSharesToMigrate() types.Coin {
+	if m != nil {
+		return m.SharesToMigrate
+	}
+	return types.Coin{}
+}
+
+type MsgMigrateSharesResponse struct {
+	PoolIdEntering uint64 `protobuf:"varint,1,opt,name=pool_id_entering,json=poolIdEntering,proto3" json:"pool_id_entering,omitempty" yaml:"pool_id_entering"`
+	// share_out_amount is zero when migrating to a concentrated liquidity pool.
+	ShareOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_amount" yaml:"share_out_amount"`
+	// liquidity_created is the liquidity added to the full range position of the
+	// sender when migrating to a concentrated liquidity pool, and zero otherwise.
+	LiquidityCreated github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=liquidity_created,json=liquidityCreated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_created" yaml:"liquidity_created"`
+}
+
+func (m *MsgMigrateSharesResponse) Reset()         { *m = MsgMigrateSharesResponse{} }
+func (m *MsgMigrateSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateSharesResponse) ProtoMessage()    {}
+func (*MsgMigrateSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4e21de1873d9d19, []int{5}
+}
+func (m *MsgMigrateSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateSharesResponse.Merge(m, src)
+}
+func (m *MsgMigrateSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateSharesResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateSharesResponse) GetPoolIdEntering() uint64 {
+	if m != nil {
+		return m.PoolIdEntering
+	}
+	return 0
+}
+
+// ===================== MsgMigrateLockedShares
+// MsgMigrateLockedShares migrates the gamm pool shares of a lock to the pool
+// they are linked to by the migration records. The lock keeps its ID, duration
+// and end time, and holds the new pool shares afterwards. Locked shares can only
+// be migrated to gamm pools.
+type MsgMigrateLockedShares struct {
+	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId            uint64                                 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+	ShareOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=share_out_min_amount,json=shareOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_min_amount" yaml:"share_out_min_amount"`
+}
+
+func (m *MsgMigrateLockedShares) Reset()         { *m = MsgMigrateLockedShares{} }
+func (m *MsgMigrateLockedShares) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateLockedShares) ProtoMessage()    {}
+func (*MsgMigrateLockedShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4e21de1873d9d19, []int{6}
+}
+func (m *MsgMigrateLockedShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateLockedShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateLockedShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateLockedShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateLockedShares.Merge(m, src)
+}
+func (m *MsgMigrateLockedShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateLockedShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateLockedShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateLockedShares proto.InternalMessageInfo
+
+func (m *MsgMigrateLockedShares) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgMigrateLockedShares) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+type MsgMigrateLockedSharesResponse struct {
+	PoolIdEntering uint64                                 `protobuf:"varint,1,opt,name=pool_id_entering,json=poolIdEntering,proto3" json:"pool_id_entering,omitempty" yaml:"pool_id_entering"`
+	ShareOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_amount" yaml:"share_out_amount"`
+}
+
+func (m *MsgMigrateLockedSharesResponse) Reset()         { *m = MsgMigrateLockedSharesResponse{} }
+func (m *MsgMigrateLockedSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateLockedSharesResponse) ProtoMessage()    {}
+func (*MsgMigrateLockedSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4e21de1873d9d19, []int{7}
+}
+func (m *MsgMigrateLockedSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateLockedSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateLockedSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateLockedSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateLockedSharesResponse.Merge(m, src)
+}
+func (m *MsgMigrateLockedSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateLockedSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateLockedSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateLockedSharesResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateLockedSharesResponse) GetPoolIdEntering() uint64 {
+	if m != nil {
+		return m.PoolIdEntering
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgReplaceMigrationRecords)(nil), "dymensionxyz.dymension.gamm.v1beta1.MsgReplaceMigrationRecords")
+	proto.RegisterType((*MsgReplaceMigrationRecordsResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.MsgReplaceMigrationRecordsResponse")
+	proto.RegisterType((*MsgUpdateMigrationRecords)(nil), "dymensionxyz.dymension.gamm.v1beta1.MsgUpdateMigrationRecords")
+	proto.RegisterType((*MsgUpdateMigrationRecordsResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.MsgUpdateMigrationRecordsResponse")
+	proto.RegisterType((*MsgMigrateShares)(nil), "dymensionxyz.dymension.gamm.v1beta1.MsgMigrateShares")
+	proto.RegisterType((*MsgMigrateSharesResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.MsgMigrateSharesResponse")
+	proto.RegisterType((*MsgMigrateLockedShares)(nil), "dymensionxyz.dymension.gamm.v1beta1.MsgMigrateLockedShares")
+	proto.RegisterType((*MsgMigrateLockedSharesResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.MsgMigrateLockedSharesResponse")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/gamm/v1beta1/tx_migration.proto", fileDescriptor_e4e21de1873d9d19)
+}
+
+var fileDescriptor_e4e21de1873d9d19 = []byte{
+	// 647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xcf, 0x4e, 0xd4, 0x40,
+	0x1c, 0xde, 0x2e, 0x04, 0xc2, 0x10, 0x71, 0x69, 0x88, 0x2c, 0x4b, 0xd2, 0xae, 0xc5, 0x18, 0x8c,
+	0xa1, 0x05, 0x8c, 0x1c, 0xbc, 0xb9, 0xc8, 0x61, 0x09, 0x1b, 0x4d, 0xfd, 0x73, 0xf0, 0xd2, 0x74,
+	0x3b, 0x93, 0x32, 0xd9, 0x76, 0x7e, 0x6b, 0x67, 0x16, 0x59, 0x0f, 0x3e, 0x83, 0x4f, 0xa1, 0x3e,
+	0x0a, 0x37, 0x39, 0x1a, 0x63, 0x1a, 0x03, 0x2f, 0x60, 0xf6, 0x09, 0x4c, 0x3b, 0xd3, 0x2e, 0x59,
+	0x25, 0x81, 0x93, 0xc6, 0x53, 0x3b, 0xdf, 0xcc, 0xef, 0xf7, 0x7d, 0xdf, 0x6f, 0xbe, 0x64, 0xd0,
+	0x0e, 0x1e, 0xc6, 0x84, 0x71, 0x0a, 0xec, 0x78, 0xf8, 0xce, 0x29, 0x17, 0x4e, 0xe8, 0xc7, 0xb1,
+	0x73, 0xb4, 0xd5, 0x25, 0xc2, 0xdf, 0x72, 0xc4, 0xb1, 0x17, 0xd3, 0x30, 0xf1, 0x05, 0x05, 0x66,
+	0xf7, 0x13, 0x10, 0xa0, 0xaf, 0x5d, 0xac, 0xb3, 0xcb, 0x85, 0x9d, 0xd5, 0xd9, 0xaa, 0xae, 0xb1,
+	0x14, 0x42, 0x08, 0xf9, 0x79, 0x27, 0xfb, 0x93, 0xa5, 0x0d, 0x23, 0x00, 0x1e, 0x03, 0x77, 0xba,
+	0x3e, 0x27, 0x25, 0x45, 0x00, 0x54, 0xb5, 0x6e, 0x6c, 0x5e, 0x45, 0x12, 0x3f, 0xf4, 0x13, 0x82,
+	0x65, 0x85, 0xf5, 0x59, 0x43, 0x8d, 0x0e, 0x0f, 0x5d, 0xd2, 0x8f, 0xfc, 0x80, 0x74, 0x0a, 0xa9,
+	0x2e, 0x09, 0x20, 0xc1, 0x5c, 0xdf, 0x46, 0x73, 0xfe, 0x40, 0x1c, 0x42, 0x42, 0xc5, 0xb0, 0xae,
+	0x35, 0xb5, 0xf5, 0xb9, 0xd6, 0xd2, 0x28, 0x35, 0x6b, 0x43, 0x3f, 0x8e, 0x1e, 0x59, 0xe5, 0x96,
+	0xe5, 0x8e, 0x8f, 0xe9, 0xaf, 0xd0, 0x6c, 0x22, 0xcb, 0xeb, 0xd5, 0xe6, 0xd4, 0xfa, 0xfc, 0xf6,
+	0x8e, 0x7d, 0x05, 0xc7, 0xf6, 0x33, 0x80, 0xa8, 0xe4, 0x3f, 0xa0, 0xac, 0xd7, 0x9a, 0x3e, 0x49,
+	0xcd, 0x8a, 0x5b, 0x34, 0xb3, 0xee, 0x20, 0xeb, 0x72, 0xa5, 0x2e, 0xe1, 0x7d, 0x60, 0x9c, 0x58,
+	0x9f, 0x34, 0xb4, 0xd2, 0xe1, 0xe1, 0xcb, 0x3e, 0xf6, 0xc5, 0xbf, 0xed, 0x67, 0x0d, 0xdd, 0xbe,
+	0x54, 0x68, 0x69, 0xe7, 0x63, 0x15, 0xd5, 0x3a, 0x3c, 0x94, 0xfb, 0xe4, 0x79, 0x76, 0x75, 0x5c,
+	0xbf, 0x87, 0x66, 0x38, 0x61, 0x98, 0x24, 0xca, 0xc2, 0xe2, 0x28, 0x35, 0x6f, 0x48, 0x0b, 0x12,
+	0xb7, 0x5c, 0x75, 0x40, 0x0f, 0xd1, 0x62, 0x7e, 0xdf, 0xdc, 0x13, 0xa0, 0x92, 0x48, 0xea, 0xd5,
+	0xa6, 0xb6, 0x3e, 0xbf, 0xbd, 0x62, 0xcb, 0x34, 0xd9, 0x59, 0x9a, 0x4a, 0xd9, 0xbb, 0x40, 0x59,
+	0xab, 0x99, 0x29, 0x1d, 0xa5, 0x66, 0x5d, 0x35, 0x9d, 0xec, 0x60, 0xb9, 0x37, 0x25, 0xf6, 0x02,
+	0x94, 0x32, 0xfd, 0x3d, 0x5a, 0xca, 0x21, 0x0f, 0x06, 0xc2, 0x8b, 0x29, 0xf3, 0xfc, 0x18, 0x06,
+	0x4c, 0xd4, 0xa7, 0x72, 0x85, 0x9d, 0xac, 0xe1, 0xb7, 0xd4, 0xbc, 0x1b, 0x52, 0x71, 0x38, 0xe8,
+	0xda, 0x01, 0xc4, 0x8e, 0xca, 0xb2, 0xfc, 0x6c, 0x70, 0xdc, 0x73, 0xc4, 0xb0, 0x4f, 0xb8, 0xdd,
+	0x66, 0x62, 0x94, 0x9a, 0xab, 0x17, 0xa8, 0x27, 0x7a, 0x5a, 0xae, 0xf4, 0xf4, 0x74, 0x20, 0x3a,
+	0x94, 0x3d, 0x96, 0xd8, 0x97, 0x2a, 0xaa, 0x4f, 0x0e, 0xaa, 0x98, 0xa2, 0xbe, 0x87, 0x6a, 0x7d,
+	0x80, 0xc8, 0xa3, 0xd8, 0x23, 0x4c, 0x90, 0x84, 0xb2, 0x30, 0x1f, 0xdd, 0x74, 0x6b, 0x75, 0x94,
+	0x9a, 0xcb, 0x92, 0x6a, 0xf2, 0x84, 0xe5, 0x2e, 0x64, 0x50, 0x1b, 0xef, 0x29, 0x40, 0xe7, 0xa8,
+	0x36, 0xd6, 0xa3, 0xfc, 0x55, 0x73, 0x7f, 0xed, 0x6b, 0xfb, 0x5b, 0x9e, 0xf4, 0x57, 0x78, 0x5b,
+	0x28, 0xbc, 0x49, 0x63, 0xfa, 0x5b, 0xb4, 0x18, 0xd1, 0x37, 0x03, 0x8a, 0xa9, 0x18, 0x7a, 0x41,
+	0x42, 0x7c, 0x41, 0xb0, 0x9a, 0xea, 0xfe, 0x35, 0x58, 0x9f, 0x90, 0x60, 0x7c, 0xa1, 0xbf, 0x35,
+	0xb4, 0xdc, 0x5a, 0x89, 0xed, 0x2a, 0xe8, 0xa7, 0x86, 0x6e, 0x8d, 0x27, 0x7a, 0x00, 0x41, 0x8f,
+	0xe0, 0xeb, 0x07, 0xf0, 0x3e, 0x9a, 0x8d, 0x20, 0xe8, 0x79, 0x14, 0xe7, 0xa3, 0x9a, 0x6e, 0xe9,
+	0xa3, 0xd4, 0x5c, 0x50, 0x32, 0xe4, 0x86, 0xe5, 0xce, 0x64, 0x7f, 0x6d, 0xfc, 0xd7, 0x43, 0xf4,
+	0x5d, 0x43, 0xc6, 0x9f, 0x2d, 0xff, 0x0f, 0x51, 0x6a, 0xed, 0x9f, 0x9c, 0x19, 0xda, 0xe9, 0x99,
+	0xa1, 0xfd, 0x38, 0x33, 0xb4, 0x0f, 0xe7, 0x46, 0xe5, 0xf4, 0xdc, 0xa8, 0x7c, 0x3d, 0x37, 0x2a,
+	0xaf, 0x37, 0x2f, 0x90, 0xe5, 0x24, 0x94, 0x6f, 0x44, 0x7e, 0x97, 0x17, 0x0b, 0xe7, 0x68, 0xeb,
+	0xa1, 0x73, 0x2c, 0xdf, 0x91, 0x9c, 0xba, 0x3b, 0x93, 0xbf, 0x1f, 0x0f, 0x7e, 0x0d, 0x00, 0x88,
+	0x30, 0x1c, 0xb6, 0x06, 0x07, 0x00, 0x00,
+}
+
+func (m *MsgReplaceMigrationRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceMigrationRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceMigrationRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxMigration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTxMigration(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceMigrationRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceMigrationRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceMigrationRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMigrationRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMigrationRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMigrationRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxMigration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTxMigration(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMigrationRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMigrationRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMigrationRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareOutMinAmount.Size()
+		i -= size
+		if _, err := m.ShareOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxMigration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.SharesToMigrate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTxMigration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTxMigration(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidityCreated.Size()
+		i -= size
+		if _, err := m.LiquidityCreated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxMigration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxMigration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolIdEntering != 0 {
+		i = encodeVarintTxMigration(dAtA, i, uint64(m.PoolIdEntering))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateLockedShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateLockedShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateLockedShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareOutMinAmount.Size()
+		i -= size
+		if _, err := m.ShareOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxMigration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LockId != 0 {
+		i = encodeVarintTxMigration(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTxMigration(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateLockedSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateLockedSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateLockedSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxMigration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolIdEntering != 0 {
+		i = encodeVarintTxMigration(dAtA, i, uint64(m.PoolIdEntering))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTxMigration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTxMigration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgReplaceMigrationRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTxMigration(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTxMigration(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgReplaceMigrationRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMigrationRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTxMigration(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTxMigration(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateMigrationRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTxMigration(uint64(l))
+	}
+	l = m.SharesToMigrate.Size()
+	n += 1 + l + sovTxMigration(uint64(l))
+	l = m.ShareOutMinAmount.Size()
+	n += 1 + l + sovTxMigration(uint64(l))
+	return n
+}
+
+func (m *MsgMigrateSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolIdEntering != 0 {
+		n += 1 + sovTxMigration(uint64(m.PoolIdEntering))
+	}
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTxMigration(uint64(l))
+	l = m.LiquidityCreated.Size()
+	n += 1 + l + sovTxMigration(uint64(l))
+	return n
+}
+
+func (m *MsgMigrateLockedShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTxMigration(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTxMigration(uint64(m.LockId))
+	}
+	l = m.ShareOutMinAmount.Size()
+	n += 1 + l + sovTxMigration(uint64(l))
+	return n
+}
+
+func (m *MsgMigrateLockedSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolIdEntering != 0 {
+		n += 1 + sovTxMigration(uint64(m.PoolIdEntering))
+	}
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTxMigration(uint64(l))
+	return n
+}
+
+func sovTxMigration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTxMigration(x uint64) (n int) {
+	return sovTxMigration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgReplaceMigrationRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceMigrationRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceMigrationRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, PoolMigrationLink{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceMigrationRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceMigrationRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceMigrationRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMigrationRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMigrationRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMigrationRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, PoolMigrationLink{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMigrationRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMigrationRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMigrationRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesToMigrate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesToMigrate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdEntering", wireType)
+			}
+			m.PoolIdEntering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdEntering |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCreated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityCreated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateLockedShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateLockedShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateLockedShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateLockedSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateLockedSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateLockedSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdEntering", wireType)
+			}
+			m.PoolIdEntering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdEntering |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTxMigration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTxMigration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxMigration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTxMigration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTxMigration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTxMigration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTxMigration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTxMigration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTxMigration = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,806 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/gamm/v1beta1/tx_zap.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types1 "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ===================== MsgZapIn
+// MsgZapIn joins a gamm pool with a token it does not hold, by swapping the
+// token through the routes to an asset of the pool, and joining the pool with
+// the proceeds. The shares are optionally locked for the lock duration.
+type MsgZapIn struct {
+	Sender  string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId  uint64     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenIn types.Coin `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	// routes swap the token in to an asset of the pool.
+	Routes            []types1.SwapAmountInRoute             `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes"`
+	ShareOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=share_out_min_amount,json=shareOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_min_amount" yaml:"share_out_min_amount"`
+	// lock_duration is the duration the shares are locked for, they are not
+	// locked if it is zero.
+	LockDuration time.Duration `protobuf:"bytes,6,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration" yaml:"lock_duration"`
+}
+
+func (m *MsgZapIn) Reset()         { *m = MsgZapIn{} }
+func (m *MsgZapIn) String() string { return proto.CompactTextString(m) }
+func (*MsgZapIn) ProtoMessage()    {}
+func (*MsgZapIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0046c17f1979d880, []int{0}
+}
+func (m *MsgZapIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgZapIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgZapIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgZapIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgZapIn.Merge(m, src)
+}
+func (m *MsgZapIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgZapIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgZapIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgZapIn proto.InternalMessageInfo
+
+func (m *MsgZapIn) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgZapIn) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgZapIn) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgZapIn) GetRoutes() []types1.SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *MsgZapIn) GetLockDuration() time.Duration {
+	if m != nil {
+		return m.LockDuration
+	}
+	return 0
+}
+
+type MsgZapInResponse struct {
+	ShareOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_amount" yaml:"share_out_amount"`
+	// lock_id is the id of the lock of the shares, zero if they are not locked.
+	LockId uint64 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+}
+
+func (m *MsgZapInResponse) Reset()         { *m = MsgZapInResponse{} }
+func (m *MsgZapInResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgZapInResponse) ProtoMessage()    {}
+func (*MsgZapInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0046c17f1979d880, []int{1}
+}
+func (m *MsgZapInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgZapInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgZapInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgZapInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgZapInResponse.Merge(m, src)
+}
+func (m *MsgZapInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgZapInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgZapInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgZapInResponse proto.InternalMessageInfo
+
+func (m *MsgZapInResponse) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgZapIn)(nil), "dymensionxyz.dymension.gamm.v1beta1.MsgZapIn")
+	proto.RegisterType((*MsgZapInResponse)(nil), "dymensionxyz.dymension.gamm.v1beta1.MsgZapInResponse")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/gamm/v1beta1/tx_zap.proto", fileDescriptor_0046c17f1979d880)
+}
+
+var fileDescriptor_0046c17f1979d880 = []byte{
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x69, 0x71, 0x8a, 0x4b, 0x4b, 0x6b, 0x45, 0xaa, 0x29, 0x92, 0x1d, 0x19, 0x09, 0x05,
+	0x55, 0xdd, 0x6d, 0x8a, 0xb8, 0x80, 0x38, 0x60, 0xb8, 0x18, 0x29, 0x42, 0x32, 0xb7, 0x72, 0x30,
+	0xeb, 0x78, 0x71, 0xad, 0xc4, 0xbb, 0x96, 0x77, 0xdd, 0x26, 0x3d, 0xf0, 0x0d, 0x1c, 0xf9, 0x10,
+	0x3e, 0xa2, 0xc7, 0x1e, 0x11, 0x07, 0x83, 0x92, 0x1f, 0x40, 0xf9, 0x02, 0xe4, 0xdd, 0x75, 0x08,
+	0xa8, 0x3d, 0x70, 0xca, 0xcc, 0xce, 0xbc, 0x99, 0xf7, 0x9e, 0x27, 0xc6, 0x51, 0x3c, 0xcd, 0x30,
+	0x61, 0x29, 0x25, 0x93, 0xe9, 0x05, 0x5c, 0x26, 0x30, 0x41, 0x59, 0x06, 0xcf, 0xfa, 0x11, 0xe6,
+	0xa8, 0x0f, 0xf9, 0x24, 0xbc, 0x40, 0x39, 0xc8, 0x0b, 0xca, 0xa9, 0xf9, 0x70, 0x15, 0x01, 0x96,
+	0x09, 0xa8, 0x11, 0x40, 0x21, 0xf6, 0x3b, 0x09, 0x4d, 0xa8, 0xe8, 0x87, 0x75, 0x24, 0xa1, 0xfb,
+	0x76, 0x42, 0x69, 0x32, 0xc6, 0x50, 0x64, 0x51, 0xf9, 0x11, 0xc6, 0x65, 0x81, 0x78, 0x0d, 0x56,
+	0xf5, 0x21, 0x65, 0x19, 0x65, 0x30, 0x42, 0x0c, 0x2f, 0x97, 0x0f, 0x69, 0xda, 0xd4, 0x9f, 0xdf,
+	0x40, 0x36, 0xa7, 0x74, 0x9c, 0x21, 0x82, 0x12, 0x5c, 0x2c, 0x61, 0xec, 0x1c, 0xe5, 0x61, 0x41,
+	0x4b, 0x8e, 0x25, 0xd8, 0xfd, 0xb5, 0x66, 0x6c, 0x0c, 0x58, 0x72, 0x82, 0x72, 0x9f, 0x98, 0x8f,
+	0x0d, 0x9d, 0x61, 0x12, 0xe3, 0xc2, 0xd2, 0xba, 0x5a, 0xef, 0x8e, 0xb7, 0xbb, 0xa8, 0x9c, 0xad,
+	0x29, 0xca, 0xc6, 0xcf, 0x5c, 0xf9, 0xee, 0x06, 0xaa, 0xc1, 0x3c, 0x30, 0xda, 0xf5, 0xfc, 0x30,
+	0x8d, 0xad, 0x5b, 0x5d, 0xad, 0xb7, 0xee, 0x99, 0x8b, 0xca, 0xd9, 0x96, 0xbd, 0xaa, 0xe0, 0x06,
+	0x7a, 0x1d, 0xf9, 0xb1, 0x39, 0x30, 0x36, 0x38, 0x1d, 0x61, 0x12, 0xa6, 0xc4, 0x5a, 0xeb, 0x6a,
+	0xbd, 0xcd, 0xe3, 0xfb, 0x40, 0x8a, 0x02, 0xb5, 0xa8, 0xc6, 0x1f, 0xf0, 0x8a, 0xa6, 0xc4, 0xdb,
+	0xbb, 0xac, 0x9c, 0xd6, 0xa2, 0x72, 0xee, 0xc9, 0x61, 0x0d, 0xd0, 0x0d, 0xda, 0x22, 0xf4, 0x89,
+	0xf9, 0xde, 0xd0, 0x85, 0x04, 0x66, 0xad, 0x77, 0xd7, 0x7a, 0x9b, 0xc7, 0x2f, 0xc0, 0x0d, 0xe6,
+	0xaf, 0x38, 0xb0, 0xdc, 0xf1, 0xee, 0x1c, 0xe5, 0x2f, 0x33, 0x5a, 0x12, 0xee, 0x93, 0xa0, 0x9e,
+	0xe2, 0xad, 0xd7, 0x0b, 0x03, 0x35, 0xd2, 0xfc, 0x64, 0x74, 0xd8, 0x29, 0x2a, 0x70, 0x48, 0x4b,
+	0x1e, 0x66, 0x29, 0x09, 0x91, 0x68, 0xb6, 0x6e, 0x0b, 0x47, 0x06, 0x75, 0xef, 0xf7, 0xca, 0x79,
+	0x94, 0xa4, 0xfc, 0xb4, 0x8c, 0xc0, 0x90, 0x66, 0x50, 0x7d, 0x1e, 0xf9, 0x73, 0xc8, 0xe2, 0x11,
+	0xe4, 0xd3, 0x1c, 0x33, 0xe0, 0x13, 0xbe, 0xa8, 0x9c, 0x07, 0xca, 0xbf, 0x6b, 0x66, 0xba, 0xc1,
+	0xae, 0x78, 0x7e, 0x5b, 0xf2, 0x41, 0x4a, 0x24, 0x29, 0xf3, 0x83, 0xb1, 0x35, 0xa6, 0xc3, 0x51,
+	0xd8, 0x1c, 0x81, 0xa5, 0x2b, 0xc3, 0xe4, 0x95, 0x80, 0xe6, 0x4a, 0xc0, 0x6b, 0xd5, 0xe0, 0x75,
+	0x95, 0x61, 0x1d, 0xb9, 0xe9, 0x2f, 0xb4, 0xfb, 0xe5, 0x87, 0xa3, 0x05, 0x77, 0xeb, 0xb7, 0xa6,
+	0xdf, 0xfd, 0xaa, 0x19, 0x3b, 0xcd, 0x27, 0x0f, 0x30, 0xcb, 0x29, 0x61, 0xd8, 0x64, 0xc6, 0xce,
+	0x1f, 0x8a, 0x4a, 0xb2, 0x3c, 0x02, 0xff, 0xbf, 0x25, 0xef, 0xfd, 0x2b, 0xb9, 0x91, 0xbb, 0xdd,
+	0xc8, 0x55, 0x5a, 0x0f, 0x8c, 0xb6, 0x60, 0x7b, 0xdd, 0x11, 0xa9, 0x82, 0x1b, 0xe8, 0x75, 0xe4,
+	0xc7, 0xde, 0x9b, 0xcb, 0x99, 0xad, 0x5d, 0xcd, 0x6c, 0xed, 0xe7, 0xcc, 0xd6, 0x3e, 0xcf, 0xed,
+	0xd6, 0xd5, 0xdc, 0x6e, 0x7d, 0x9b, 0xdb, 0xad, 0x93, 0xa3, 0x15, 0x66, 0x82, 0x51, 0xca, 0x0e,
+	0xc7, 0x28, 0x62, 0x4d, 0x02, 0xcf, 0xfa, 0x4f, 0xe1, 0x44, 0xfe, 0x79, 0x05, 0xcf, 0x48, 0x17,
+	0x2e, 0x3e, 0xf9, 0x3d, 0x00, 0x30, 0x16, 0x6a, 0x7c, 0xe8, 0x03, 0x00, 0x00,
+}
+
+func (m *MsgZapIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgZapIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgZapIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTxZap(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ShareOutMinAmount.Size()
+		i -= size
+		if _, err := m.ShareOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxZap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxZap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTxZap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTxZap(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTxZap(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgZapInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgZapInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgZapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		i = encodeVarintTxZap(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxZap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTxZap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTxZap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgZapIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTxZap(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTxZap(uint64(m.PoolId))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTxZap(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTxZap(uint64(l))
+		}
+	}
+	l = m.ShareOutMinAmount.Size()
+	n += 1 + l + sovTxZap(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovTxZap(uint64(l))
+	return n
+}
+
+func (m *MsgZapInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTxZap(uint64(l))
+	if m.LockId != 0 {
+		n += 1 + sovTxZap(uint64(m.LockId))
+	}
+	return n
+}
+
+func sovTxZap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTxZap(x uint64) (n int) {
+	return sovTxZap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgZapIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxZap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgZapIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgZapIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxZap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxZap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxZap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxZap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxZap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxZap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxZap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxZap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxZap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxZap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types1.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxZap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxZap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxZap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxZap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxZap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxZap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxZap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxZap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgZapInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxZap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgZapInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgZapInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxZap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxZap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxZap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxZap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxZap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxZap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTxZap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTxZap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxZap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxZap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTxZap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTxZap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTxZap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTxZap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTxZap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTxZap = fmt.Errorf("proto: unexpected end of group")
+)
@@ -25,3 +25,8 @@ func (k Keeper) GetCoinsFromLocks(locks []types.PeriodLock) sdk.Coins {
 func (k Keeper) Lock(ctx sdk.Context, lock types.PeriodLock, tokensToLock sdk.Coins) error {
 	return k.lock(ctx, lock, tokensToLock)
 }
+
+// OverrideHooks replaces the hooks of the keeper, which SetHooks only allows to set once.
+func (k *Keeper) OverrideHooks(hooks types.LockupHooks) {
+	k.hooks = hooks
+}
@@ -426,6 +426,64 @@ func (k Keeper) ExtendLockup(ctx sdk.Context, lockID uint64, owner sdk.AccAddres
 	return nil
 }
 
+// ConvertLockTokens replaces the tokens of the given lock in place, keeping its ID, duration and end time.
+// The locked tokens are sent back to the owner and passed to convert, which exchanges them from the
+// owner's balance and returns the tokens to lock in their place.
+// This is used by modules migrating locked tokens, e.g. gamm pool shares to the shares of a newer pool.
+// The hooks see the lock unlock its previous tokens and lock the new ones.
+func (k Keeper) ConvertLockTokens(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, convert func(lockedCoins sdk.Coins) (sdk.Coins, error)) (*types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+
+	if lock.GetOwner() != owner.String() {
+		return nil, types.ErrNotLockOwner
+	}
+
+	// lock refs are keyed by denom, so they are deleted and re-added with the new coins
+	err = k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), *lock)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, lock.Coins); err != nil {
+		return nil, err
+	}
+	for _, coin := range lock.Coins {
+		k.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(lock.Duration), coin.Amount)
+	}
+	if k.hooks != nil {
+		k.hooks.OnTokenUnlocked(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
+	}
+
+	newCoins, err := convert(lock.Coins)
+	if err != nil {
+		return nil, err
+	}
+	if !newCoins.IsAllPositive() {
+		return nil, fmt.Errorf("lock %d can not be converted to %s", lock.ID, newCoins)
+	}
+
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, newCoins); err != nil {
+		return nil, err
+	}
+	for _, coin := range newCoins {
+		k.accumulationStore(ctx, coin.Denom).Increase(accumulationKey(lock.Duration), coin.Amount)
+	}
+
+	lock.Coins = newCoins
+	err = k.setLockAndAddLockRefs(ctx, *lock)
+	if err != nil {
+		return nil, err
+	}
+	if k.hooks != nil {
+		k.hooks.OnTokenLocked(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
+	}
+
+	return lock, nil
+}
+
 // InitializeAllLocks takes a set of locks, and initializes state to be storing
 // them all correctly. This utilizes batch optimizations to improve efficiency,
 // as this becomes a bottleneck at chain initialization & upgrades.
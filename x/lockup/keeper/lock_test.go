@@ -745,6 +745,94 @@ func (suite *KeeperTestSuite) TestEditLockup() {
 	suite.Require().Equal(int64(0), acc.Int64())
 }
 
+// hookCall is a lockup hook call recorded by lockHooksRecorder.
+type hookCall struct {
+	hook   string
+	lockID uint64
+	amount sdk.Coins
+}
+
+// lockHooksRecorder records the token lock and unlock hook calls.
+type lockHooksRecorder struct {
+	types.MultiLockupHooks
+	calls []hookCall
+}
+
+func (h *lockHooksRecorder) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.calls = append(h.calls, hookCall{"OnTokenLocked", lockID, amount})
+}
+
+func (h *lockHooksRecorder) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.calls = append(h.calls, hookCall{"OnTokenUnlocked", lockID, amount})
+}
+
+func (suite *KeeperTestSuite) TestConvertLockTokens() {
+	for _, unlocking := range []bool{false, true} {
+		suite.SetupTest()
+		addr := sdk.AccAddress([]byte("addr1---------------"))
+		coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+		newCoins := sdk.Coins{sdk.NewInt64Coin("foo", 20)}
+		suite.LockTokens(addr, coins, time.Second)
+		suite.FundAcc(addr, newCoins)
+		if unlocking {
+			_, err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 1, nil)
+			suite.Require().NoError(err)
+		}
+		lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, 1)
+		suite.Require().NoError(err)
+
+		convert := func(lockedCoins sdk.Coins) (sdk.Coins, error) {
+			suite.Require().Equal(coins, lockedCoins)
+			suite.Require().Equal(coins, suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr).Sub(newCoins...))
+			return newCoins, nil
+		}
+
+		// only the owner can convert a lock
+		_, err = suite.App.LockupKeeper.ConvertLockTokens(suite.Ctx, lock.ID, sdk.AccAddress([]byte("addr2---------------")), convert)
+		suite.Require().ErrorIs(err, types.ErrNotLockOwner)
+
+		convertedLock, err := suite.App.LockupKeeper.ConvertLockTokens(suite.Ctx, lock.ID, addr, convert)
+		suite.Require().NoError(err)
+		suite.Require().Equal(lock.ID, convertedLock.ID)
+		suite.Require().Equal(lock.Duration, convertedLock.Duration)
+		suite.Require().Equal(lock.EndTime, convertedLock.EndTime)
+		suite.Require().Equal(newCoins, convertedLock.Coins)
+		suite.Require().Equal(coins, suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr))
+
+		// check lock refs and accumulations
+		suite.Require().Len(suite.App.LockupKeeper.GetLocksLongerThanDurationDenom(suite.Ctx, "stake", time.Second), 0)
+		suite.Require().Len(suite.App.LockupKeeper.GetLocksLongerThanDurationDenom(suite.Ctx, "foo", time.Second), 1)
+		acc := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{Denom: "stake", Duration: time.Second})
+		suite.Require().Equal(int64(0), acc.Int64())
+		acc = suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{Denom: "foo", Duration: time.Second})
+		suite.Require().Equal(int64(20), acc.Int64())
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertLockTokensHooks() {
+	suite.SetupTest()
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	newCoins := sdk.Coins{sdk.NewInt64Coin("foo", 20)}
+	suite.LockTokens(addr, coins, time.Second)
+	suite.FundAcc(addr, newCoins)
+
+	hooks := &lockHooksRecorder{}
+	lockupKeeper := *suite.App.LockupKeeper
+	lockupKeeper.OverrideHooks(hooks)
+
+	_, err := lockupKeeper.ConvertLockTokens(suite.Ctx, 1, addr, func(sdk.Coins) (sdk.Coins, error) {
+		return newCoins, nil
+	})
+	suite.Require().NoError(err)
+
+	// the subscribers see the previous tokens unlocked and the new ones locked
+	suite.Require().Equal([]hookCall{
+		{"OnTokenUnlocked", 1, coins},
+		{"OnTokenLocked", 1, newCoins},
+	}, hooks.calls)
+}
+
 func (suite *KeeperTestSuite) TestForceUnlock() {
 	addr1 := sdk.AccAddress([]byte("addr1---------------"))
 
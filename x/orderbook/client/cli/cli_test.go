@@ -0,0 +1,45 @@
+package cli_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v15/testutils/apptesting"
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/client/cli"
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+)
+
+var testAddresses = apptesting.CreateRandomAccounts(3)
+
+func TestNewPlaceLimitOrderCmd(t *testing.T) {
+	desc, _ := cli.NewPlaceLimitOrderCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgPlaceLimitOrder]{
+		"ask": {
+			Cmd: "1 105 1000 --side=ask --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgPlaceLimitOrder{
+				Sender:   testAddresses[0].String(),
+				PoolId:   1,
+				Side:     types.Ask,
+				Tick:     105,
+				Quantity: sdk.NewInt(1000),
+			},
+		},
+		"bid, case insensitive side": {
+			Cmd: "1 95 1000 --side=Bid --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgPlaceLimitOrder{
+				Sender:   testAddresses[0].String(),
+				PoolId:   1,
+				Side:     types.Bid,
+				Tick:     95,
+				Quantity: sdk.NewInt(1000),
+			},
+		},
+		"invalid side": {
+			Cmd:         "1 105 1000 --side=buy --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
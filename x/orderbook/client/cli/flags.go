@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+)
+
+const (
+	// Will be parsed to types.OrderSide.
+	FlagSide = "side"
+)
+
+func FlagSetOrderSide() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagSide, "", "side of the book: bid to buy the base asset, ask to sell it")
+	return fs
+}
+
+// orderSide parses the side of the book from its name, case insensitive.
+func orderSide(fs *flag.FlagSet) (types.OrderSide, error) {
+	sideStr, err := fs.GetString(FlagSide)
+	if err != nil {
+		return 0, err
+	}
+	for side, name := range types.OrderSide_name {
+		if strings.EqualFold(sideStr, name) {
+			return types.OrderSide(side), nil
+		}
+	}
+	return 0, fmt.Errorf("order side must be bid or ask, got %q", sideStr)
+}
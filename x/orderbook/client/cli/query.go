@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPools)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdOrder)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPoolOrders)
+	cmd.AddCommand(
+		osmocli.GetParams[*types.QueryParamsRequest](
+			types.ModuleName, types.NewQueryClient),
+	)
+
+	return cmd
+}
+
+func GetCmdPools() (*osmocli.QueryDescriptor, *types.QueryPoolsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pools",
+		Short: "Query orderbook pools",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pools`}, &types.QueryPoolsRequest{}
+}
+
+func GetCmdOrder() (*osmocli.QueryDescriptor, *types.QueryOrderRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "order [order-id]",
+		Short: "Query a limit order",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} order 1`}, &types.QueryOrderRequest{}
+}
+
+func GetCmdPoolOrders() (*osmocli.QueryDescriptor, *types.QueryPoolOrdersRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-orders [pool-id]",
+		Short: "Query the resting orders on one side of the book of a pool, best price first",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-orders 1 --side=bid`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Side": osmocli.FlagOnlyParser(orderSide),
+		},
+		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetOrderSide()}},
+	}, &types.QueryPoolOrdersRequest{}
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/model"
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+)
+
+func NewTxCmd() *cobra.Command {
+	txCmd := osmocli.TxIndexCmd(types.ModuleName)
+	osmocli.AddTxCmd(txCmd, NewCreateOrderbookPoolCmd)
+	osmocli.AddTxCmd(txCmd, NewPlaceLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewCancelLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewClaimLimitOrderCmd)
+	return txCmd
+}
+
+func NewCreateOrderbookPoolCmd() (*osmocli.TxCliDesc, *model.MsgCreateOrderbookPool) {
+	return &osmocli.TxCliDesc{
+		Use:   "create-orderbook-pool [base-denom] [quote-denom] [tick-size] [swap-fee]",
+		Short: "create an orderbook pool",
+		Long: `{{.Short}}
+The price of tick t is t * tick-size, in quote-denom per unit of base-denom.{{.ExampleHeader}}
+{{.CommandPrefix}} create-orderbook-pool uatom uosmo 0.01 0.001`,
+	}, &model.MsgCreateOrderbookPool{}
+}
+
+func NewPlaceLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgPlaceLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:   "place-limit-order [pool-id] [tick] [quantity]",
+		Short: "place a limit order to buy (bid) or sell (ask) a quantity of the base asset of an orderbook pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} place-limit-order 1 105 1000 --side=ask`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Side": osmocli.FlagOnlyParser(orderSide),
+		},
+		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetOrderSide()}},
+	}, &types.MsgPlaceLimitOrder{}
+}
+
+func NewCancelLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgCancelLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:   "cancel-limit-order [order-id]",
+		Short: "cancel a limit order, refunding its unfilled part and its unclaimed proceeds",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} cancel-limit-order 1`,
+	}, &types.MsgCancelLimitOrder{}
+}
+
+func NewClaimLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgClaimLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:   "claim-limit-order [order-id]",
+		Short: "claim the proceeds of the fills of a limit order",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} claim-limit-order 1`,
+	}, &types.MsgClaimLimitOrder{}
+}
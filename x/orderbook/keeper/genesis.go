@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+)
+
+// InitGenesis initializes the x/orderbook module's state from a provided genesis
+// state, which includes the pools and their limit orders.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpacker codectypes.AnyUnpacker) {
+	k.SetParams(ctx, genState.Params)
+	k.SetNextOrderId(ctx, genState.NextOrderId)
+
+	for _, any := range genState.Pools {
+		var pool types.OrderbookPoolExtension
+		err := unpacker.UnpackAny(any, &pool)
+		if err != nil {
+			panic(err)
+		}
+		err = k.setPool(ctx, pool)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, order := range genState.Orders {
+		k.setOrder(ctx, order)
+	}
+}
+
+// ExportGenesis returns the x/orderbook module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	pools, err := k.GetPools(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	poolAnys := []*codectypes.Any{}
+	for _, pool := range pools {
+		any, err := codectypes.NewAnyWithValue(pool)
+		if err != nil {
+			panic(err)
+		}
+		poolAnys = append(poolAnys, any)
+	}
+
+	orders, err := k.GetAllOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Params:      k.GetParams(ctx),
+		Pools:       poolAnys,
+		Orders:      orders,
+		NextOrderId: k.GetNextOrderId(ctx),
+	}
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/orderbook keeper providing gRPC method
+// handlers.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+// Params returns the parameters of the orderbook module.
+func (q Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryParamsResponse{Params: q.Keeper.GetParams(sdkCtx)}, nil
+}
+
+// Pools returns the orderbook pools, paginated.
+func (q Querier) Pools(ctx context.Context, req *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(q.Keeper.storeKey)
+	poolStore := prefix.NewStore(store, types.KeyPrefixPools)
+
+	var anys []*codectypes.Any
+	pageRes, err := query.Paginate(poolStore, req.Pagination, func(_, value []byte) error {
+		pool, err := q.Keeper.unmarshalPool(value)
+		if err != nil {
+			return err
+		}
+
+		any, err := codectypes.NewAnyWithValue(pool)
+		if err != nil {
+			return err
+		}
+
+		anys = append(anys, any)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolsResponse{
+		Pools:      anys,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Order returns a limit order by id.
+func (q Querier) Order(ctx context.Context, req *types.QueryOrderRequest) (*types.QueryOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	order, err := q.Keeper.GetOrder(sdkCtx, req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryOrderResponse{Order: order}, nil
+}
+
+// PoolOrders returns the resting orders on one side of the book of a pool in price-time priority, paginated.
+func (q Querier) PoolOrders(ctx context.Context, req *types.QueryPoolOrdersRequest) (*types.QueryPoolOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateOrderSide(req.Side); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if _, err := q.Keeper.getPoolById(sdkCtx, req.PoolId); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	store := sdkCtx.KVStore(q.Keeper.storeKey)
+	bookStore := prefix.NewStore(store, types.KeyBookSide(req.PoolId, req.Side))
+
+	orders := []types.LimitOrder{}
+	pageRes, err := query.Paginate(bookStore, req.Pagination, func(_, value []byte) error {
+		order, err := q.Keeper.GetOrder(sdkCtx, sdk.BigEndianToUint64(value))
+		if err != nil {
+			return err
+		}
+
+		orders = append(orders, order)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolOrdersResponse{
+		Orders:     orders,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v15/testutils/apptesting"
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/model"
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+var (
+	ETH  = apptesting.ETH
+	USDC = apptesting.USDC
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	msgServer types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+	s.msgServer = keeper.NewMsgServerImpl(s.App.OrderbookKeeper)
+}
+
+// getOrderbookPool returns the orderbook pool with the given id as currently stored.
+func (s *KeeperTestSuite) getOrderbookPool(poolId uint64) types.OrderbookPoolExtension {
+	pool, err := s.App.OrderbookKeeper.GetOrderbookPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	return pool
+}
+
+func (s *KeeperTestSuite) TestInitializePool() {
+	tests := map[string]struct {
+		tickSize      sdk.Dec
+		swapFee       sdk.Dec
+		expectedError error
+	}{
+		"valid pool": {
+			tickSize: sdk.NewDecWithPrec(1, 2),
+			swapFee:  sdk.NewDecWithPrec(1, 3),
+		},
+		"error: zero tick size": {
+			tickSize:      sdk.ZeroDec(),
+			swapFee:       sdk.ZeroDec(),
+			expectedError: types.ErrInvalidTickSize,
+		},
+		"error: negative swap fee": {
+			tickSize:      sdk.OneDec(),
+			swapFee:       sdk.NewDec(-1),
+			expectedError: types.ErrNegativeSwapFee,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+
+			poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, model.NewMsgCreateOrderbookPool(s.TestAccs[0], ETH, USDC, tc.tickSize, tc.swapFee))
+			if tc.expectedError != nil {
+				s.Require().ErrorContains(err, tc.expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			pool := s.getOrderbookPool(poolId)
+			s.Require().Equal(poolmanagertypes.Orderbook, pool.GetType())
+			s.Require().Equal(tc.tickSize, pool.GetTickSize())
+			s.Require().Equal(tc.swapFee, pool.GetSwapFee(s.Ctx))
+			s.Require().Zero(pool.GetBestBidTick())
+			s.Require().Zero(pool.GetBestAskTick())
+
+			// an empty book has no price.
+			_, err = pool.SpotPrice(s.Ctx, USDC, ETH)
+			s.Require().ErrorIs(err, types.ErrPoolHasNoPrice)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSpotPrice() {
+	s.SetupTest()
+	pool := s.PrepareOrderbookPool()
+
+	s.PlaceLimitOrder(pool, s.TestAccs[0], types.Bid, 90, sdk.NewInt(10))
+	spotPrice, err := s.getOrderbookPool(pool.GetId()).SpotPrice(s.Ctx, USDC, ETH)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(90), spotPrice)
+
+	s.PlaceLimitOrder(pool, s.TestAccs[0], types.Ask, 110, sdk.NewInt(10))
+	spotPrice, err = s.getOrderbookPool(pool.GetId()).SpotPrice(s.Ctx, USDC, ETH)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(100), spotPrice)
+
+	spotPrice, err = s.getOrderbookPool(pool.GetId()).SpotPrice(s.Ctx, ETH, USDC)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec().QuoInt64(100), spotPrice)
+}
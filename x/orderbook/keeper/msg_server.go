@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/model"
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+)
+
+type msgServer struct {
+	keeper *Keeper
+}
+
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+func NewMsgCreatorServerImpl(keeper *Keeper) model.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var (
+	_ types.MsgServer = msgServer{}
+	_ model.MsgServer = msgServer{}
+)
+
+// CreateOrderbookPool creates an orderbook pool through the pool manager.
+// The pool creation fee is used to fund the community pool.
+func (server msgServer) CreateOrderbookPool(goCtx context.Context, msg *model.MsgCreateOrderbookPool) (*model.MsgCreateOrderbookPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Send pool creation fee to community pool
+	params := server.keeper.GetParams(ctx)
+	sender := msg.PoolCreator()
+	if err := server.keeper.communityPoolKeeper.FundCommunityPool(ctx, params.PoolCreationFee, sender); err != nil {
+		return nil, err
+	}
+
+	poolId, err := server.keeper.poolManager.CreatePool(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			gammtypes.TypeEvtPoolCreated,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &model.MsgCreateOrderbookPoolResponse{PoolID: poolId}, nil
+}
+
+// PlaceLimitOrder places a resting limit order of the sender on a price level of a pool.
+func (server msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	orderId, err := server.keeper.PlaceLimitOrder(ctx, sender, msg.PoolId, msg.Side, msg.Tick, msg.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPlaceLimitOrder,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(orderId, 10)),
+			sdk.NewAttribute(types.AttributeKeySide, msg.Side.String()),
+			sdk.NewAttribute(types.AttributeKeyTick, strconv.FormatInt(msg.Tick, 10)),
+			sdk.NewAttribute(types.AttributeKeyQuantity, msg.Quantity.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgPlaceLimitOrderResponse{OrderId: orderId}, nil
+}
+
+// CancelLimitOrder removes a limit order of the sender from the book and refunds it.
+func (server msgServer) CancelLimitOrder(goCtx context.Context, msg *types.MsgCancelLimitOrder) (*types.MsgCancelLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	refunded, err := server.keeper.CancelLimitOrder(ctx, sender, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelLimitOrder,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(msg.OrderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokens, refunded.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgCancelLimitOrderResponse{Refunded: refunded}, nil
+}
+
+// ClaimLimitOrder sends the proceeds of the fills of a limit order of the sender to the sender.
+func (server msgServer) ClaimLimitOrder(goCtx context.Context, msg *types.MsgClaimLimitOrder) (*types.MsgClaimLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := server.keeper.ClaimLimitOrder(ctx, sender, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtClaimLimitOrder,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(msg.OrderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokens, claimed.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgClaimLimitOrderResponse{Claimed: claimed}, nil
+}
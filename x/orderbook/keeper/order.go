@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+)
+
+// PlaceLimitOrder places a resting order of the sender on a price level of the pool and escrows
+// the asset it offers in the pool's account: the base quantity for an ask, its value in the quote
+// asset, rounded up, for a bid. Orders that would cross the book are rejected, they must be
+// swapped against the pool instead.
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, side types.OrderSide, tick int64, quantity sdk.Int) (uint64, error) {
+	if err := types.ValidateOrderSide(side); err != nil {
+		return 0, err
+	}
+	if tick <= 0 {
+		return 0, types.InvalidTickError{Tick: tick}
+	}
+	if quantity.IsNil() || !quantity.IsPositive() {
+		return 0, types.ErrInvalidQuantity
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+
+	if side == types.Bid && pool.GetBestAskTick() > 0 && tick >= pool.GetBestAskTick() {
+		return 0, types.OrderCrossesBookError{Side: side, Tick: tick, OpposingTick: pool.GetBestAskTick()}
+	}
+	if side == types.Ask && pool.GetBestBidTick() > 0 && tick <= pool.GetBestBidTick() {
+		return 0, types.OrderCrossesBookError{Side: side, Tick: tick, OpposingTick: pool.GetBestBidTick()}
+	}
+
+	escrow := orderEscrow(pool, side, tick, quantity)
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), sdk.Coins{escrow}); err != nil {
+		return 0, err
+	}
+
+	order := types.LimitOrder{
+		Id:        k.getNextOrderIdAndIncrement(ctx),
+		PoolId:    poolId,
+		Owner:     sender.String(),
+		Side:      side,
+		Tick:      tick,
+		Quantity:  quantity,
+		Remaining: quantity,
+		Claimable: sdk.ZeroInt(),
+	}
+	k.setOrder(ctx, order)
+
+	if err := k.updateBestTicks(ctx, pool); err != nil {
+		return 0, err
+	}
+
+	return order.Id, nil
+}
+
+// CancelLimitOrder removes an order of the sender from the book. The escrow of the unfilled
+// part of the order and the unclaimed proceeds of its fills are sent back to the sender.
+func (k Keeper) CancelLimitOrder(ctx sdk.Context, sender sdk.AccAddress, orderId uint64) (sdk.Coins, error) {
+	order, err := k.getOwnedOrder(ctx, sender, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := k.getPoolById(ctx, order.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	refunded := sdk.NewCoins(
+		orderEscrow(pool, order.Side, order.Tick, order.Remaining),
+		orderProceeds(pool, order),
+	)
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, refunded); err != nil {
+		return nil, err
+	}
+
+	k.deleteOrder(ctx, order)
+	if err := k.updateBestTicks(ctx, pool); err != nil {
+		return nil, err
+	}
+
+	return refunded, nil
+}
+
+// ClaimLimitOrder sends the proceeds of the fills of an order to its owner. The order is removed
+// once it is filled and its proceeds are claimed.
+func (k Keeper) ClaimLimitOrder(ctx sdk.Context, sender sdk.AccAddress, orderId uint64) (sdk.Coin, error) {
+	order, err := k.getOwnedOrder(ctx, sender, orderId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !order.Claimable.IsPositive() {
+		return sdk.Coin{}, types.ErrNothingToClaim
+	}
+
+	pool, err := k.getPoolById(ctx, order.PoolId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	claimed := orderProceeds(pool, order)
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, sdk.Coins{claimed}); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	order.Claimable = sdk.ZeroInt()
+	if order.IsFilled() {
+		k.deleteOrder(ctx, order)
+	} else {
+		k.setOrder(ctx, order)
+	}
+
+	return claimed, nil
+}
+
+// GetOrder returns the limit order with the given id.
+func (k Keeper) GetOrder(ctx sdk.Context, orderId uint64) (types.LimitOrder, error) {
+	store := ctx.KVStore(k.storeKey)
+	order := types.LimitOrder{}
+	found, err := osmoutils.Get(store, types.KeyOrder(orderId), &order)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	if !found {
+		return types.LimitOrder{}, types.OrderNotFoundError{OrderId: orderId}
+	}
+	return order, nil
+}
+
+// GetAllOrders returns all the limit orders, ordered by id.
+func (k Keeper) GetAllOrders(ctx sdk.Context) ([]types.LimitOrder, error) {
+	store := ctx.KVStore(k.storeKey)
+	return osmoutils.GatherValuesFromStorePrefix(store, types.KeyPrefixOrders, func(bz []byte) (types.LimitOrder, error) {
+		order := types.LimitOrder{}
+		err := k.cdc.Unmarshal(bz, &order)
+		return order, err
+	})
+}
+
+// GetNextOrderId returns the id of the next limit order.
+func (k Keeper) GetNextOrderId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	nextOrderId := gogotypes.UInt64Value{}
+	osmoutils.MustGet(store, types.KeyNextOrderId, &nextOrderId)
+	return nextOrderId.Value
+}
+
+// SetNextOrderId sets the id of the next limit order.
+func (k Keeper) SetNextOrderId(ctx sdk.Context, orderId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyNextOrderId, &gogotypes.UInt64Value{Value: orderId})
+}
+
+func (k Keeper) getNextOrderIdAndIncrement(ctx sdk.Context) uint64 {
+	nextOrderId := k.GetNextOrderId(ctx)
+	k.SetNextOrderId(ctx, nextOrderId+1)
+	return nextOrderId
+}
+
+// getOwnedOrder returns the limit order with the given id if it is owned by sender.
+func (k Keeper) getOwnedOrder(ctx sdk.Context, sender sdk.AccAddress, orderId uint64) (types.LimitOrder, error) {
+	order, err := k.GetOrder(ctx, orderId)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	if order.Owner != sender.String() {
+		return types.LimitOrder{}, types.NotOrderOwnerError{OrderId: orderId, Owner: order.Owner, Sender: sender.String()}
+	}
+	return order, nil
+}
+
+// setOrder stores the order, and keeps it in the book of its pool as long as it is not filled.
+func (k Keeper) setOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyOrder(order.Id), &order)
+
+	bookKey := types.KeyBookOrder(order.PoolId, order.Side, order.Tick, order.Id)
+	if order.IsFilled() {
+		store.Delete(bookKey)
+	} else {
+		store.Set(bookKey, sdk.Uint64ToBigEndian(order.Id))
+	}
+}
+
+func (k Keeper) deleteOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyOrder(order.Id))
+	store.Delete(types.KeyBookOrder(order.PoolId, order.Side, order.Tick, order.Id))
+}
+
+// bestTick returns the tick of the resting order with the highest priority on one side of
+// the book of a pool, zero if that side is empty.
+func (k Keeper) bestTick(ctx sdk.Context, poolId uint64, side types.OrderSide) (int64, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyBookSide(poolId, side))
+	defer iter.Close() //nolint:errcheck
+
+	if !iter.Valid() {
+		return 0, nil
+	}
+	order, err := k.GetOrder(ctx, sdk.BigEndianToUint64(iter.Value()))
+	if err != nil {
+		return 0, err
+	}
+	return order.Tick, nil
+}
+
+// orderEscrow returns the amount escrowed by an order for the given base quantity.
+func orderEscrow(pool types.OrderbookPoolExtension, side types.OrderSide, tick int64, quantity sdk.Int) sdk.Coin {
+	if side == types.Ask {
+		return sdk.NewCoin(pool.GetBaseDenom(), quantity)
+	}
+	return sdk.NewCoin(pool.GetQuoteDenom(), pool.TickToPrice(tick).MulInt(quantity).Ceil().TruncateInt())
+}
+
+// orderProceeds returns the unclaimed proceeds of the fills of an order.
+func orderProceeds(pool types.OrderbookPoolExtension, order types.LimitOrder) sdk.Coin {
+	if order.Side == types.Ask {
+		return sdk.NewCoin(pool.GetQuoteDenom(), order.Claimable)
+	}
+	return sdk.NewCoin(pool.GetBaseDenom(), order.Claimable)
+}
@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+)
+
+func (s *KeeperTestSuite) TestPlaceLimitOrder() {
+	tests := map[string]struct {
+		// restingOrder is placed on the book by the first test account before the tested order.
+		restingOrder *types.LimitOrder
+		poolId       uint64
+		side         types.OrderSide
+		tick         int64
+		quantity     sdk.Int
+
+		expectedEscrow  sdk.Coin
+		expectedBestBid int64
+		expectedBestAsk int64
+		expectedError   error
+	}{
+		"bid on an empty book": {
+			side:            types.Bid,
+			tick:            100,
+			quantity:        sdk.NewInt(10),
+			expectedEscrow:  sdk.NewCoin(USDC, sdk.NewInt(1000)),
+			expectedBestBid: 100,
+		},
+		"ask on an empty book": {
+			side:            types.Ask,
+			tick:            100,
+			quantity:        sdk.NewInt(10),
+			expectedEscrow:  sdk.NewCoin(ETH, sdk.NewInt(10)),
+			expectedBestAsk: 100,
+		},
+		"bid below the best ask": {
+			restingOrder:    &types.LimitOrder{Side: types.Ask, Tick: 101, Quantity: sdk.NewInt(5)},
+			side:            types.Bid,
+			tick:            100,
+			quantity:        sdk.NewInt(10),
+			expectedEscrow:  sdk.NewCoin(USDC, sdk.NewInt(1000)),
+			expectedBestBid: 100,
+			expectedBestAsk: 101,
+		},
+		"bid below the best bid does not move it": {
+			restingOrder:    &types.LimitOrder{Side: types.Bid, Tick: 100, Quantity: sdk.NewInt(5)},
+			side:            types.Bid,
+			tick:            99,
+			quantity:        sdk.NewInt(10),
+			expectedEscrow:  sdk.NewCoin(USDC, sdk.NewInt(990)),
+			expectedBestBid: 100,
+		},
+		"error: bid crossing the best ask": {
+			restingOrder:  &types.LimitOrder{Side: types.Ask, Tick: 100, Quantity: sdk.NewInt(5)},
+			side:          types.Bid,
+			tick:          100,
+			quantity:      sdk.NewInt(10),
+			expectedError: types.OrderCrossesBookError{Side: types.Bid, Tick: 100, OpposingTick: 100},
+		},
+		"error: ask crossing the best bid": {
+			restingOrder:  &types.LimitOrder{Side: types.Bid, Tick: 100, Quantity: sdk.NewInt(5)},
+			side:          types.Ask,
+			tick:          99,
+			quantity:      sdk.NewInt(10),
+			expectedError: types.OrderCrossesBookError{Side: types.Ask, Tick: 99, OpposingTick: 100},
+		},
+		"error: pool not found": {
+			poolId:        2,
+			side:          types.Bid,
+			tick:          100,
+			quantity:      sdk.NewInt(10),
+			expectedError: types.PoolNotFoundError{PoolId: 2},
+		},
+		"error: non-positive tick": {
+			side:          types.Bid,
+			tick:          0,
+			quantity:      sdk.NewInt(10),
+			expectedError: types.InvalidTickError{Tick: 0},
+		},
+		"error: zero quantity": {
+			side:          types.Ask,
+			tick:          100,
+			quantity:      sdk.ZeroInt(),
+			expectedError: types.ErrInvalidQuantity,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareOrderbookPool()
+			if tc.restingOrder != nil {
+				s.PlaceLimitOrder(pool, s.TestAccs[0], tc.restingOrder.Side, tc.restingOrder.Tick, tc.restingOrder.Quantity)
+			}
+			poolId := pool.GetId()
+			if tc.poolId != 0 {
+				poolId = tc.poolId
+			}
+
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(1000)), sdk.NewCoin(USDC, sdk.NewInt(100000))))
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			poolBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+
+			orderId, err := s.App.OrderbookKeeper.PlaceLimitOrder(s.Ctx, owner, poolId, tc.side, tc.tick, tc.quantity)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			order, err := s.App.OrderbookKeeper.GetOrder(s.Ctx, orderId)
+			s.Require().NoError(err)
+			s.Require().Equal(owner.String(), order.Owner)
+			s.Require().Equal(tc.quantity, order.Remaining)
+			s.Require().True(order.Claimable.IsZero())
+
+			// the escrow is moved from the owner to the pool.
+			balanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			poolBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+			s.Require().Equal(balanceBefore.Sub(tc.expectedEscrow).String(), balanceAfter.String())
+			s.Require().Equal(poolBalanceBefore.Add(tc.expectedEscrow).String(), poolBalanceAfter.String())
+
+			pool = s.getOrderbookPool(pool.GetId())
+			s.Require().Equal(tc.expectedBestBid, pool.GetBestBidTick())
+			s.Require().Equal(tc.expectedBestAsk, pool.GetBestAskTick())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelLimitOrder() {
+	tests := map[string]struct {
+		// amountSold is the amount of eth sold to the bid before it is canceled.
+		amountSold sdk.Int
+		// notOwner makes the order canceled by another account than its owner.
+		notOwner bool
+		orderId  uint64
+
+		expectedRefund sdk.Coins
+		expectedError  error
+	}{
+		"unfilled bid": {
+			amountSold:     sdk.ZeroInt(),
+			expectedRefund: sdk.NewCoins(sdk.NewCoin(USDC, sdk.NewInt(1000))),
+		},
+		"partially filled bid": {
+			amountSold:     sdk.NewInt(4),
+			expectedRefund: sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(4)), sdk.NewCoin(USDC, sdk.NewInt(600))),
+		},
+		"filled bid": {
+			amountSold:     sdk.NewInt(10),
+			expectedRefund: sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(10))),
+		},
+		"error: not the owner of the order": {
+			amountSold: sdk.ZeroInt(),
+			notOwner:   true,
+		},
+		"error: order not found": {
+			amountSold:    sdk.ZeroInt(),
+			orderId:       2,
+			expectedError: types.OrderNotFoundError{OrderId: 2},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareOrderbookPool()
+			owner := s.TestAccs[1]
+			orderId := s.PlaceLimitOrder(pool, owner, types.Bid, 100, sdk.NewInt(10))
+			if tc.amountSold.IsPositive() {
+				s.swapExactAmountIn(pool.GetId(), sdk.NewCoin(ETH, tc.amountSold), USDC)
+			}
+
+			sender := owner
+			if tc.notOwner {
+				sender = s.TestAccs[2]
+			}
+			if tc.orderId != 0 {
+				orderId = tc.orderId
+			}
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+
+			res, err := s.msgServer.CancelLimitOrder(sdk.WrapSDKContext(s.Ctx), &types.MsgCancelLimitOrder{Sender: sender.String(), OrderId: orderId})
+			if tc.notOwner {
+				s.Require().ErrorAs(err, &types.NotOrderOwnerError{})
+				return
+			}
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedRefund.String(), res.Refunded.String())
+
+			balanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+			s.Require().Equal(balanceBefore.Add(tc.expectedRefund...).String(), balanceAfter.String())
+
+			_, err = s.App.OrderbookKeeper.GetOrder(s.Ctx, orderId)
+			s.Require().ErrorIs(err, types.OrderNotFoundError{OrderId: orderId})
+			s.Require().Zero(s.getOrderbookPool(pool.GetId()).GetBestBidTick())
+
+			// the pool keeps nothing once its only order is canceled.
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress()).IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestClaimLimitOrder() {
+	s.SetupTest()
+	pool := s.PrepareOrderbookPool()
+	owner := s.TestAccs[1]
+	orderId := s.PlaceLimitOrder(pool, owner, types.Ask, 100, sdk.NewInt(10))
+
+	// nothing to claim before the order is filled.
+	_, err := s.msgServer.ClaimLimitOrder(sdk.WrapSDKContext(s.Ctx), &types.MsgClaimLimitOrder{Sender: owner.String(), OrderId: orderId})
+	s.Require().ErrorIs(err, types.ErrNothingToClaim)
+
+	// a partial fill is claimed and the order is left on the book.
+	s.swapExactAmountIn(pool.GetId(), sdk.NewCoin(USDC, sdk.NewInt(400)), ETH)
+	res, err := s.msgServer.ClaimLimitOrder(sdk.WrapSDKContext(s.Ctx), &types.MsgClaimLimitOrder{Sender: owner.String(), OrderId: orderId})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(USDC, sdk.NewInt(400)), res.Claimed)
+	s.Require().Equal(sdk.NewInt(400), s.App.BankKeeper.GetBalance(s.Ctx, owner, USDC).Amount)
+
+	order, err := s.App.OrderbookKeeper.GetOrder(s.Ctx, orderId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(6), order.Remaining)
+	s.Require().True(order.Claimable.IsZero())
+	s.Require().Equal(int64(100), s.getOrderbookPool(pool.GetId()).GetBestAskTick())
+
+	_, err = s.msgServer.ClaimLimitOrder(sdk.WrapSDKContext(s.Ctx), &types.MsgClaimLimitOrder{Sender: owner.String(), OrderId: orderId})
+	s.Require().ErrorIs(err, types.ErrNothingToClaim)
+
+	// the order is removed once it is filled and claimed.
+	s.swapExactAmountIn(pool.GetId(), sdk.NewCoin(USDC, sdk.NewInt(600)), ETH)
+	s.Require().Zero(s.getOrderbookPool(pool.GetId()).GetBestAskTick())
+	res, err = s.msgServer.ClaimLimitOrder(sdk.WrapSDKContext(s.Ctx), &types.MsgClaimLimitOrder{Sender: owner.String(), OrderId: orderId})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(USDC, sdk.NewInt(600)), res.Claimed)
+
+	_, err = s.App.OrderbookKeeper.GetOrder(s.Ctx, orderId)
+	s.Require().ErrorIs(err, types.OrderNotFoundError{OrderId: orderId})
+}
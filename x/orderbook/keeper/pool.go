@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// InitializePool initializes the state of an orderbook pool created by the pool manager.
+// The book of the pool is empty until its first limit order is placed.
+func (k Keeper) InitializePool(ctx sdk.Context, pool poolmanagertypes.PoolI, _ sdk.AccAddress) error {
+	orderbookPool, err := asOrderbook(pool)
+	if err != nil {
+		return err
+	}
+
+	return k.setPool(ctx, orderbookPool)
+}
+
+// GetPool returns the orderbook pool with the given id as a PoolI.
+func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error) {
+	return k.getPoolById(ctx, poolId)
+}
+
+// GetOrderbookPoolById returns the orderbook pool with the given id.
+func (k Keeper) GetOrderbookPoolById(ctx sdk.Context, poolId uint64) (types.OrderbookPoolExtension, error) {
+	return k.getPoolById(ctx, poolId)
+}
+
+func (k Keeper) getPoolById(ctx sdk.Context, poolId uint64) (types.OrderbookPoolExtension, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPool(poolId))
+	if bz == nil {
+		return nil, types.PoolNotFoundError{PoolId: poolId}
+	}
+
+	return k.unmarshalPool(bz)
+}
+
+// GetPools returns all the orderbook pools.
+func (k Keeper) GetPools(ctx sdk.Context) ([]types.OrderbookPoolExtension, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixPools)
+	defer iter.Close() //nolint:errcheck
+
+	pools := []types.OrderbookPoolExtension{}
+	for ; iter.Valid(); iter.Next() {
+		pool, err := k.unmarshalPool(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, pool)
+	}
+
+	return pools, nil
+}
+
+// GetTotalPoolLiquidity returns the coins held by the pool's account, i.e. the escrow
+// of the resting orders and the proceeds of the fills not claimed yet.
+func (k Keeper) GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := k.bankKeeper.GetAllBalances(ctx, pool.GetAddress())
+	return sdk.NewCoins(
+		sdk.NewCoin(pool.GetBaseDenom(), balances.AmountOf(pool.GetBaseDenom())),
+		sdk.NewCoin(pool.GetQuoteDenom(), balances.AmountOf(pool.GetQuoteDenom())),
+	), nil
+}
+
+func (k Keeper) setPool(ctx sdk.Context, pool types.OrderbookPoolExtension) error {
+	bz, err := k.cdc.MarshalInterface(pool)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPool(pool.GetId()), bz)
+	return nil
+}
+
+func (k Keeper) unmarshalPool(bz []byte) (types.OrderbookPoolExtension, error) {
+	var pool types.OrderbookPoolExtension
+	return pool, k.cdc.UnmarshalInterface(bz, &pool)
+}
+
+// updateBestTicks sets the best bid and ask ticks of the pool from its book and stores the pool.
+func (k Keeper) updateBestTicks(ctx sdk.Context, pool types.OrderbookPoolExtension) error {
+	bestBidTick, err := k.bestTick(ctx, pool.GetId(), types.Bid)
+	if err != nil {
+		return err
+	}
+	bestAskTick, err := k.bestTick(ctx, pool.GetId(), types.Ask)
+	if err != nil {
+		return err
+	}
+
+	pool.SetBestTicks(bestBidTick, bestAskTick)
+	return k.setPool(ctx, pool)
+}
+
+// asOrderbook converts a PoolI to an OrderbookPoolExtension.
+func asOrderbook(pool poolmanagertypes.PoolI) (types.OrderbookPoolExtension, error) {
+	orderbookPool, ok := pool.(types.OrderbookPoolExtension)
+	if !ok {
+		return nil, fmt.Errorf("%w: pool %d of type %s", types.ErrNotOrderbookPool, pool.GetId(), pool.GetType())
+	}
+	return orderbookPool, nil
+}
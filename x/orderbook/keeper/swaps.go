@@ -0,0 +1,414 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/events"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// orderFill is the fill of a resting order by a swap.
+type orderFill struct {
+	orderId uint64
+	// baseFilled is the base quantity of the order filled by the swap.
+	baseFilled sdk.Int
+	// amountIn is the amount of token in paid by the taker for the fill, swap fee included.
+	// It is credited to the maker as the proceeds of the fill.
+	amountIn sdk.Int
+	// amountOut is the amount of token out received by the taker from the fill.
+	amountOut sdk.Int
+}
+
+// swapResult is the amounts swapped along with the fills of the resting orders.
+type swapResult struct {
+	tokenIn  sdk.Coin
+	tokenOut sdk.Coin
+	fills    []orderFill
+}
+
+// SwapExactAmountIn swaps an exact amount of tokenIn for as much tokenOutDenom as the resting orders of the
+// pool give, using the provided swapFee. Returns an error if the amount out is lower than tokenOutMinAmount.
+func (k Keeper) SwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
+) (tokenOutAmount sdk.Int, err error) {
+	if tokenIn.Denom == tokenOutDenom {
+		return sdk.Int{}, types.ErrSameDenomSwap
+	}
+	poolSwapFee := poolI.GetSwapFee(ctx)
+	if swapFee.LT(poolSwapFee.QuoInt64(2)) {
+		return sdk.Int{}, fmt.Errorf("given swap fee (%s) must be greater than or equal to half of the pool's swap fee (%s)", swapFee, poolSwapFee)
+	}
+
+	pool, err := asOrderbook(poolI)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	result, err := k.computeOutAmtGivenIn(ctx, pool, tokenIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenOutAmount = result.tokenOut.Amount
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s token is lesser than min amount", tokenOutDenom)
+	}
+
+	if err := k.updatePoolForSwap(ctx, pool, sender, result, swapFee); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return tokenOutAmount, nil
+}
+
+// SwapExactAmountOut swaps as little tokenInDenom as the resting orders of the pool require for an exact amount
+// of tokenOut, using the provided swapFee. Returns an error if the amount in is greater than tokenInMaxAmount.
+func (k Keeper) SwapExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolI poolmanagertypes.PoolI,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
+) (tokenInAmount sdk.Int, err error) {
+	if tokenInDenom == tokenOut.Denom {
+		return sdk.Int{}, types.ErrSameDenomSwap
+	}
+	poolSwapFee := poolI.GetSwapFee(ctx)
+	if swapFee.LT(poolSwapFee.QuoInt64(2)) {
+		return sdk.Int{}, fmt.Errorf("given swap fee (%s) must be greater than or equal to half of the pool's swap fee (%s)", swapFee, poolSwapFee)
+	}
+
+	pool, err := asOrderbook(poolI)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	result, err := k.computeInAmtGivenOut(ctx, pool, tokenOut, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenInAmount = result.tokenIn.Amount
+	if tokenInAmount.GT(tokenInMaxAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "Swap requires %s, which is greater than the amount %s", result.tokenIn, tokenInMaxAmount)
+	}
+
+	if err := k.updatePoolForSwap(ctx, pool, sender, result, swapFee); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return tokenInAmount, nil
+}
+
+// CalcOutAmtGivenIn calculates the amount of tokenOut given tokenIn and the resting orders of the pool.
+// The state is left untouched.
+func (k Keeper) CalcOutAmtGivenIn(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+) (tokenOut sdk.Coin, err error) {
+	pool, err := asOrderbook(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	result, err := k.computeOutAmtGivenIn(ctx, pool, tokenIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return result.tokenOut, nil
+}
+
+// CalcInAmtGivenOut calculates the amount of tokenIn given tokenOut and the resting orders of the pool.
+// The state is left untouched.
+func (k Keeper) CalcInAmtGivenOut(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (tokenIn sdk.Coin, err error) {
+	pool, err := asOrderbook(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	result, err := k.computeInAmtGivenOut(ctx, pool, tokenOut, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return result.tokenIn, nil
+}
+
+// SimulateSwapExactAmountIn calculates the amount of tokenOut given tokenIn and returns the pool
+// with the best ticks of its book moved by the swap. The state is left untouched.
+func (k Keeper) SimulateSwapExactAmountIn(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+) (tokenOut sdk.Coin, poolAfterSwap poolmanagertypes.PoolI, err error) {
+	pool, err := asOrderbook(poolI)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	result, err := k.computeOutAmtGivenIn(cacheCtx, pool, tokenIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	if err := k.applyFills(cacheCtx, pool, result.fills); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	return result.tokenOut, pool, nil
+}
+
+// SimulateSwapExactAmountOut calculates the amount of tokenIn given tokenOut and returns the pool
+// with the best ticks of its book moved by the swap. The state is left untouched.
+func (k Keeper) SimulateSwapExactAmountOut(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (tokenIn sdk.Coin, poolAfterSwap poolmanagertypes.PoolI, err error) {
+	pool, err := asOrderbook(poolI)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	result, err := k.computeInAmtGivenOut(cacheCtx, pool, tokenOut, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	if err := k.applyFills(cacheCtx, pool, result.fills); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	return result.tokenIn, pool, nil
+}
+
+// computeOutAmtGivenIn matches tokenIn against the resting orders of the pool until it is fully consumed.
+// The state is left untouched.
+func (k Keeper) computeOutAmtGivenIn(ctx sdk.Context, pool types.OrderbookPoolExtension, tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec) (swapResult, error) {
+	side, err := validateSwapDenoms(pool, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return swapResult{}, err
+	}
+	if !tokenIn.Amount.IsPositive() {
+		return swapResult{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "token in (%s) must be positive", tokenIn)
+	}
+
+	fills, amountIn, amountOut, err := k.matchOrders(ctx, pool, side, tokenIn.Amount, swapFee, true)
+	if err != nil {
+		return swapResult{}, err
+	}
+	if !amountOut.IsPositive() {
+		return swapResult{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+
+	return swapResult{
+		tokenIn:  sdk.NewCoin(tokenIn.Denom, amountIn),
+		tokenOut: sdk.NewCoin(tokenOutDenom, amountOut),
+		fills:    fills,
+	}, nil
+}
+
+// computeInAmtGivenOut matches tokenOut against the resting orders of the pool until it is fully filled.
+// The state is left untouched.
+func (k Keeper) computeInAmtGivenOut(ctx sdk.Context, pool types.OrderbookPoolExtension, tokenOut sdk.Coin, tokenInDenom string, swapFee sdk.Dec) (swapResult, error) {
+	side, err := validateSwapDenoms(pool, tokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return swapResult{}, err
+	}
+	if !tokenOut.Amount.IsPositive() {
+		return swapResult{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "token out (%s) must be positive", tokenOut)
+	}
+
+	fills, amountIn, amountOut, err := k.matchOrders(ctx, pool, side, tokenOut.Amount, swapFee, false)
+	if err != nil {
+		return swapResult{}, err
+	}
+	if !amountIn.IsPositive() {
+		return swapResult{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+
+	return swapResult{
+		tokenIn:  sdk.NewCoin(tokenInDenom, amountIn),
+		tokenOut: sdk.NewCoin(tokenOut.Denom, amountOut),
+		fills:    fills,
+	}, nil
+}
+
+// matchOrders fills the resting orders on the given side of the book in price-time priority until
+// amountSpecified is swapped. amountSpecified is the amount in if exactIn is true, the amount out
+// otherwise. Returns an error if the book is exhausted before the amount specified is fully swapped.
+func (k Keeper) matchOrders(ctx sdk.Context, pool types.OrderbookPoolExtension, side types.OrderSide, amountSpecified sdk.Int, swapFee sdk.Dec, exactIn bool) (fills []orderFill, amountIn, amountOut sdk.Int, err error) {
+	feeFactor := sdk.OneDec().Sub(swapFee)
+	amountRemaining := amountSpecified
+	amountIn, amountOut = sdk.ZeroInt(), sdk.ZeroInt()
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyBookSide(pool.GetId(), side))
+	defer iter.Close() //nolint:errcheck
+
+	for ; iter.Valid() && amountRemaining.IsPositive(); iter.Next() {
+		order, err := k.GetOrder(ctx, sdk.BigEndianToUint64(iter.Value()))
+		if err != nil {
+			return nil, sdk.Int{}, sdk.Int{}, err
+		}
+
+		fill := computeFill(order, pool.TickToPrice(order.Tick), amountRemaining, feeFactor, exactIn)
+		if fill.amountOut.IsZero() && (!exactIn || fill.amountIn.LT(amountRemaining)) {
+			// the order is too small to give a unit of token out at its price, it is left on the book.
+			// The last fill of an exact amount in swap still pays the remainder of the amount in to the maker.
+			continue
+		}
+
+		fills = append(fills, fill)
+		amountIn = amountIn.Add(fill.amountIn)
+		amountOut = amountOut.Add(fill.amountOut)
+		if exactIn {
+			amountRemaining = amountRemaining.Sub(fill.amountIn)
+		} else {
+			amountRemaining = amountRemaining.Sub(fill.amountOut)
+		}
+	}
+
+	if amountRemaining.IsPositive() {
+		return nil, sdk.Int{}, sdk.Int{}, sdkerrors.Wrapf(types.ErrNotEnoughLiquidity, "pool %d, amount remaining %s", pool.GetId(), amountRemaining)
+	}
+
+	return fills, amountIn, amountOut, nil
+}
+
+// computeFill returns the fill of a resting order at the given price by the remaining amount of a swap.
+// For an exact amount in swap, a taker amount lower than what fills the order is entirely paid to the maker.
+// Amounts are rounded in favor of the makers: up for the taker's amount in, down for its amount out.
+// Orders are filled by whole units of the base asset, so the amount out of an exact amount out swap
+// against the bids may exceed the amount specified by less than the value of a unit of base.
+func computeFill(order types.LimitOrder, price sdk.Dec, amountRemaining sdk.Int, feeFactor sdk.Dec, exactIn bool) orderFill {
+	var baseFilled, netIn, amountIn, amountOut sdk.Int
+	if order.Side == types.Ask {
+		// the taker buys base, paying quote.
+		if exactIn {
+			netIn = price.MulInt(order.Remaining).Ceil().TruncateInt()
+			amountIn = grossAmount(netIn, feeFactor)
+			baseFilled = order.Remaining
+			if amountRemaining.LT(amountIn) {
+				amountIn = amountRemaining
+				netIn = feeFactor.MulInt(amountIn).TruncateInt()
+				baseFilled = sdk.MinInt(sdk.NewDecFromInt(netIn).Quo(price).TruncateInt(), order.Remaining)
+			}
+		} else {
+			baseFilled = sdk.MinInt(amountRemaining, order.Remaining)
+			amountIn = grossAmount(price.MulInt(baseFilled).Ceil().TruncateInt(), feeFactor)
+		}
+		amountOut = baseFilled
+	} else {
+		// the taker sells base, receiving quote.
+		if exactIn {
+			amountIn = grossAmount(order.Remaining, feeFactor)
+			baseFilled = order.Remaining
+			if amountRemaining.LT(amountIn) {
+				amountIn = amountRemaining
+				baseFilled = sdk.MinInt(feeFactor.MulInt(amountIn).TruncateInt(), order.Remaining)
+			}
+			amountOut = price.MulInt(baseFilled).TruncateInt()
+		} else {
+			baseFilled = sdk.MinInt(sdk.NewDecFromInt(amountRemaining).Quo(price).Ceil().TruncateInt(), order.Remaining)
+			amountIn = grossAmount(baseFilled, feeFactor)
+			amountOut = price.MulInt(baseFilled).TruncateInt()
+		}
+	}
+
+	return orderFill{
+		orderId:    order.Id,
+		baseFilled: baseFilled,
+		amountIn:   amountIn,
+		amountOut:  amountOut,
+	}
+}
+
+// grossAmount returns the amount to pay for netAmount to remain once the swap fee is charged.
+func grossAmount(netAmount sdk.Int, feeFactor sdk.Dec) sdk.Int {
+	return sdk.NewDecFromInt(netAmount).Quo(feeFactor).Ceil().TruncateInt()
+}
+
+// applyFills updates the orders filled by a swap and the best ticks of the pool.
+// The makers' proceeds are credited to their orders, to be claimed later.
+func (k Keeper) applyFills(ctx sdk.Context, pool types.OrderbookPoolExtension, fills []orderFill) error {
+	for _, fill := range fills {
+		order, err := k.GetOrder(ctx, fill.orderId)
+		if err != nil {
+			return err
+		}
+		order.Remaining = order.Remaining.Sub(fill.baseFilled)
+		order.Claimable = order.Claimable.Add(fill.amountIn)
+		k.setOrder(ctx, order)
+	}
+
+	return k.updateBestTicks(ctx, pool)
+}
+
+// updatePoolForSwap applies the fills of a swap to the pool, and settles the balances
+// between the sender and the pool.
+func (k Keeper) updatePoolForSwap(ctx sdk.Context, pool types.OrderbookPoolExtension, sender sdk.AccAddress, result swapResult, swapFee sdk.Dec) error {
+	if err := k.applyFills(ctx, pool, result.fills); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), sdk.Coins{result.tokenIn}); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, sdk.Coins{result.tokenOut}); err != nil {
+		return err
+	}
+
+	// the book may be emptied by the swap, in which case the pool has no price.
+	spotPrice, err := pool.SpotPrice(ctx, result.tokenIn.Denom, result.tokenOut.Denom)
+	if errors.Is(err, types.ErrPoolHasNoPrice) {
+		spotPrice, err = sdk.ZeroDec(), nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// the taker fee is charged by the entrypoint of the swap, not by the pool.
+	events.EmitSwapEvent(ctx, sender, pool.GetId(), sdk.Coins{result.tokenIn}, sdk.Coins{result.tokenOut}, spotPrice, sdk.ZeroDec(), swapFee)
+	return nil
+}
+
+// validateSwapDenoms checks that both denoms are the denoms of the pool and returns the side
+// of the book the swap is matched against: the bids if the base asset is swapped in, the asks otherwise.
+func validateSwapDenoms(pool types.OrderbookPoolExtension, tokenInDenom, tokenOutDenom string) (types.OrderSide, error) {
+	if tokenInDenom == tokenOutDenom {
+		return 0, types.ErrSameDenomSwap
+	}
+	for _, denom := range []string{tokenInDenom, tokenOutDenom} {
+		if denom != pool.GetBaseDenom() && denom != pool.GetQuoteDenom() {
+			return 0, types.DenomNotInPoolError{PoolId: pool.GetId(), Denom: denom}
+		}
+	}
+	if tokenInDenom == pool.GetBaseDenom() {
+		return types.Bid, nil
+	}
+	return types.Ask, nil
+}
@@ -0,0 +1,284 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+)
+
+// orderState is the expected state of a resting order after a swap.
+type orderState struct {
+	remaining int64
+	claimable int64
+}
+
+// prepareDefaultBook returns an eth/usdc pool with the given swap fee and the following book:
+//
+//	asks: 10 at 101 (order 1), 5 at 101 (order 2), 10 at 103 (order 3)
+//	bids: 10 at 99 (order 4), 20 at 97 (order 5)
+func (s *KeeperTestSuite) prepareDefaultBook(swapFee sdk.Dec) types.OrderbookPoolExtension {
+	pool := s.PrepareCustomOrderbookPool(s.TestAccs[0], ETH, USDC, sdk.OneDec(), swapFee)
+	s.PlaceLimitOrder(pool, s.TestAccs[0], types.Ask, 101, sdk.NewInt(10))
+	s.PlaceLimitOrder(pool, s.TestAccs[1], types.Ask, 101, sdk.NewInt(5))
+	s.PlaceLimitOrder(pool, s.TestAccs[0], types.Ask, 103, sdk.NewInt(10))
+	s.PlaceLimitOrder(pool, s.TestAccs[0], types.Bid, 99, sdk.NewInt(10))
+	s.PlaceLimitOrder(pool, s.TestAccs[1], types.Bid, 97, sdk.NewInt(20))
+	return s.getOrderbookPool(pool.GetId())
+}
+
+// swapExactAmountIn funds the third test account with tokenIn and swaps it against the pool.
+func (s *KeeperTestSuite) swapExactAmountIn(poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) sdk.Int {
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(tokenIn))
+	pool := s.getOrderbookPool(poolId)
+	tokenOutAmount, err := s.App.OrderbookKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool, tokenIn, tokenOutDenom, sdk.OneInt(), pool.GetSwapFee(s.Ctx))
+	s.Require().NoError(err)
+	return tokenOutAmount
+}
+
+// assertOrderStates checks the remaining quantity and the unclaimed proceeds of the given orders.
+func (s *KeeperTestSuite) assertOrderStates(expected map[uint64]orderState) {
+	for orderId, state := range expected {
+		order, err := s.App.OrderbookKeeper.GetOrder(s.Ctx, orderId)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt(state.remaining), order.Remaining, "order %d", orderId)
+		s.Require().Equal(sdk.NewInt(state.claimable), order.Claimable, "order %d", orderId)
+	}
+}
+
+func (s *KeeperTestSuite) TestSwapExactAmountIn() {
+	tests := map[string]struct {
+		tokenIn           sdk.Coin
+		tokenOutDenom     string
+		tokenOutMinAmount sdk.Int
+		swapFee           sdk.Dec
+
+		expectedTokenOut    sdk.Int
+		expectedOrderStates map[uint64]orderState
+		expectedBestBid     int64
+		expectedBestAsk     int64
+		expectedError       error
+	}{
+		"usdc in partially filling the best ask": {
+			tokenIn:             sdk.NewCoin(USDC, sdk.NewInt(505)),
+			tokenOutDenom:       ETH,
+			tokenOutMinAmount:   sdk.OneInt(),
+			swapFee:             sdk.ZeroDec(),
+			expectedTokenOut:    sdk.NewInt(5),
+			expectedOrderStates: map[uint64]orderState{1: {5, 505}, 2: {5, 0}},
+			expectedBestBid:     99,
+			expectedBestAsk:     101,
+		},
+		"usdc in crossing price levels in time priority": {
+			tokenIn:             sdk.NewCoin(USDC, sdk.NewInt(1721)),
+			tokenOutDenom:       ETH,
+			tokenOutMinAmount:   sdk.OneInt(),
+			swapFee:             sdk.ZeroDec(),
+			expectedTokenOut:    sdk.NewInt(17),
+			expectedOrderStates: map[uint64]orderState{1: {0, 1010}, 2: {0, 505}, 3: {8, 206}},
+			expectedBestBid:     99,
+			expectedBestAsk:     103,
+		},
+		"usdc in with a remainder paid to the maker": {
+			tokenIn:             sdk.NewCoin(USDC, sdk.NewInt(150)),
+			tokenOutDenom:       ETH,
+			tokenOutMinAmount:   sdk.OneInt(),
+			swapFee:             sdk.ZeroDec(),
+			expectedTokenOut:    sdk.OneInt(),
+			expectedOrderStates: map[uint64]orderState{1: {9, 150}},
+			expectedBestBid:     99,
+			expectedBestAsk:     101,
+		},
+		"eth in with swap fee credited to the maker": {
+			tokenIn:             sdk.NewCoin(ETH, sdk.NewInt(11)),
+			tokenOutDenom:       USDC,
+			tokenOutMinAmount:   sdk.OneInt(),
+			swapFee:             sdk.NewDecWithPrec(1, 2),
+			expectedTokenOut:    sdk.NewInt(990),
+			expectedOrderStates: map[uint64]orderState{4: {0, 11}, 5: {20, 0}},
+			expectedBestBid:     97,
+			expectedBestAsk:     101,
+		},
+		"eth in crossing price levels": {
+			tokenIn:             sdk.NewCoin(ETH, sdk.NewInt(15)),
+			tokenOutDenom:       USDC,
+			tokenOutMinAmount:   sdk.OneInt(),
+			swapFee:             sdk.ZeroDec(),
+			expectedTokenOut:    sdk.NewInt(1475),
+			expectedOrderStates: map[uint64]orderState{4: {0, 10}, 5: {15, 5}},
+			expectedBestBid:     97,
+			expectedBestAsk:     101,
+		},
+		"error: amount in too small for a unit of token out": {
+			tokenIn:           sdk.NewCoin(USDC, sdk.NewInt(50)),
+			tokenOutDenom:     ETH,
+			tokenOutMinAmount: sdk.OneInt(),
+			swapFee:           sdk.ZeroDec(),
+			expectedError:     types.ErrInvalidMathApprox,
+		},
+		"error: not enough liquidity": {
+			tokenIn:           sdk.NewCoin(ETH, sdk.NewInt(31)),
+			tokenOutDenom:     USDC,
+			tokenOutMinAmount: sdk.OneInt(),
+			swapFee:           sdk.ZeroDec(),
+			expectedError:     types.ErrNotEnoughLiquidity,
+		},
+		"error: amount out below min amount": {
+			tokenIn:           sdk.NewCoin(USDC, sdk.NewInt(505)),
+			tokenOutDenom:     ETH,
+			tokenOutMinAmount: sdk.NewInt(6),
+			swapFee:           sdk.ZeroDec(),
+			expectedError:     types.ErrLimitMinAmount,
+		},
+		"error: same denom": {
+			tokenIn:           sdk.NewCoin(ETH, sdk.NewInt(10)),
+			tokenOutDenom:     ETH,
+			tokenOutMinAmount: sdk.OneInt(),
+			swapFee:           sdk.ZeroDec(),
+			expectedError:     types.ErrSameDenomSwap,
+		},
+		"error: denom not in pool": {
+			tokenIn:           sdk.NewCoin("foo", sdk.NewInt(10)),
+			tokenOutDenom:     ETH,
+			tokenOutMinAmount: sdk.OneInt(),
+			swapFee:           sdk.ZeroDec(),
+			expectedError:     types.DenomNotInPoolError{PoolId: 1, Denom: "foo"},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.prepareDefaultBook(tc.swapFee)
+			sender := s.TestAccs[2]
+			s.FundAcc(sender, sdk.NewCoins(tc.tokenIn))
+
+			tokenOutAmount, err := s.App.OrderbookKeeper.SwapExactAmountIn(s.Ctx, sender, pool, tc.tokenIn, tc.tokenOutDenom, tc.tokenOutMinAmount, tc.swapFee)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedTokenOut, tokenOutAmount)
+
+			balance := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(tc.tokenOutDenom, tc.expectedTokenOut)).String(), balance.String())
+
+			s.assertOrderStates(tc.expectedOrderStates)
+			pool = s.getOrderbookPool(pool.GetId())
+			s.Require().Equal(tc.expectedBestBid, pool.GetBestBidTick())
+			s.Require().Equal(tc.expectedBestAsk, pool.GetBestAskTick())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSwapExactAmountOut() {
+	tests := map[string]struct {
+		tokenOut         sdk.Coin
+		tokenInDenom     string
+		tokenInMaxAmount sdk.Int
+		swapFee          sdk.Dec
+
+		expectedTokenIn     sdk.Int
+		expectedTokenOut    sdk.Int
+		expectedOrderStates map[uint64]orderState
+		expectedBestBid     int64
+		expectedBestAsk     int64
+		expectedError       error
+	}{
+		"eth out crossing orders of a price level": {
+			tokenOut:            sdk.NewCoin(ETH, sdk.NewInt(12)),
+			tokenInDenom:        USDC,
+			tokenInMaxAmount:    sdk.NewInt(10000),
+			swapFee:             sdk.ZeroDec(),
+			expectedTokenIn:     sdk.NewInt(1212),
+			expectedTokenOut:    sdk.NewInt(12),
+			expectedOrderStates: map[uint64]orderState{1: {0, 1010}, 2: {3, 202}, 3: {10, 0}},
+			expectedBestBid:     99,
+			expectedBestAsk:     101,
+		},
+		"eth out with swap fee credited to the maker": {
+			tokenOut:            sdk.NewCoin(ETH, sdk.NewInt(5)),
+			tokenInDenom:        USDC,
+			tokenInMaxAmount:    sdk.NewInt(10000),
+			swapFee:             sdk.NewDecWithPrec(1, 2),
+			expectedTokenIn:     sdk.NewInt(511),
+			expectedTokenOut:    sdk.NewInt(5),
+			expectedOrderStates: map[uint64]orderState{1: {5, 511}},
+			expectedBestBid:     99,
+			expectedBestAsk:     101,
+		},
+		"usdc out rounded up to a unit of eth": {
+			tokenOut:         sdk.NewCoin(USDC, sdk.NewInt(1000)),
+			tokenInDenom:     ETH,
+			tokenInMaxAmount: sdk.NewInt(100),
+			swapFee:          sdk.ZeroDec(),
+			expectedTokenIn:  sdk.NewInt(11),
+			// the last unit of eth is sold at the price of the second bid.
+			expectedTokenOut:    sdk.NewInt(1087),
+			expectedOrderStates: map[uint64]orderState{4: {0, 10}, 5: {19, 1}},
+			expectedBestBid:     97,
+			expectedBestAsk:     101,
+		},
+		"error: amount in above max amount": {
+			tokenOut:         sdk.NewCoin(ETH, sdk.NewInt(12)),
+			tokenInDenom:     USDC,
+			tokenInMaxAmount: sdk.NewInt(1000),
+			swapFee:          sdk.ZeroDec(),
+			expectedError:    types.ErrLimitMaxAmount,
+		},
+		"error: not enough liquidity": {
+			tokenOut:         sdk.NewCoin(ETH, sdk.NewInt(26)),
+			tokenInDenom:     USDC,
+			tokenInMaxAmount: sdk.NewInt(10000),
+			swapFee:          sdk.ZeroDec(),
+			expectedError:    types.ErrNotEnoughLiquidity,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.prepareDefaultBook(tc.swapFee)
+			sender := s.TestAccs[2]
+			s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(tc.tokenInDenom, tc.tokenInMaxAmount)))
+
+			tokenInAmount, err := s.App.OrderbookKeeper.SwapExactAmountOut(s.Ctx, sender, pool, tc.tokenInDenom, tc.tokenInMaxAmount, tc.tokenOut, tc.swapFee)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedTokenIn, tokenInAmount)
+
+			balance := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+			s.Require().Equal(tc.tokenInMaxAmount.Sub(tc.expectedTokenIn), balance.AmountOf(tc.tokenInDenom))
+			s.Require().Equal(tc.expectedTokenOut, balance.AmountOf(tc.tokenOut.Denom))
+
+			s.assertOrderStates(tc.expectedOrderStates)
+			pool = s.getOrderbookPool(pool.GetId())
+			s.Require().Equal(tc.expectedBestBid, pool.GetBestBidTick())
+			s.Require().Equal(tc.expectedBestAsk, pool.GetBestAskTick())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSimulateSwapExactAmountIn() {
+	s.SetupTest()
+	pool := s.prepareDefaultBook(sdk.ZeroDec())
+	tokenIn := sdk.NewCoin(USDC, sdk.NewInt(1721))
+
+	tokenOut, poolAfterSwap, err := s.App.OrderbookKeeper.SimulateSwapExactAmountIn(s.Ctx, pool, tokenIn, ETH, sdk.ZeroDec())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(ETH, sdk.NewInt(17)), tokenOut)
+
+	calcTokenOut, err := s.App.OrderbookKeeper.CalcOutAmtGivenIn(s.Ctx, pool, tokenIn, ETH, sdk.ZeroDec())
+	s.Require().NoError(err)
+	s.Require().Equal(tokenOut, calcTokenOut)
+
+	// the returned pool reflects the swap, the stored pool and orders are left untouched.
+	s.Require().Equal(int64(103), poolAfterSwap.(types.OrderbookPoolExtension).GetBestAskTick())
+	s.Require().Equal(int64(101), s.getOrderbookPool(pool.GetId()).GetBestAskTick())
+	s.assertOrderStates(map[uint64]orderState{1: {10, 0}, 2: {5, 0}, 3: {10, 0}})
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+const (
+	TypeMsgCreateOrderbookPool = "create_orderbook_pool"
+)
+
+var (
+	_ sdk.Msg                        = &MsgCreateOrderbookPool{}
+	_ poolmanagertypes.CreatePoolMsg = &MsgCreateOrderbookPool{}
+)
+
+func NewMsgCreateOrderbookPool(
+	sender sdk.AccAddress,
+	baseDenom string,
+	quoteDenom string,
+	tickSize sdk.Dec,
+	swapFee sdk.Dec,
+) MsgCreateOrderbookPool {
+	return MsgCreateOrderbookPool{
+		Sender:     sender.String(),
+		BaseDenom:  baseDenom,
+		QuoteDenom: quoteDenom,
+		TickSize:   tickSize,
+		SwapFee:    swapFee,
+	}
+}
+
+func (msg MsgCreateOrderbookPool) Route() string { return types.RouterKey }
+func (msg MsgCreateOrderbookPool) Type() string  { return TypeMsgCreateOrderbookPool }
+func (msg MsgCreateOrderbookPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.BaseDenom); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidPoolDenoms, err.Error())
+	}
+	if err := sdk.ValidateDenom(msg.QuoteDenom); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidPoolDenoms, err.Error())
+	}
+	if msg.BaseDenom == msg.QuoteDenom {
+		return types.ErrInvalidPoolDenoms
+	}
+
+	if msg.TickSize.IsNil() || !msg.TickSize.IsPositive() {
+		return types.ErrInvalidTickSize
+	}
+
+	if msg.SwapFee.IsNil() || msg.SwapFee.IsNegative() {
+		return types.ErrNegativeSwapFee
+	}
+	if msg.SwapFee.GTE(sdk.OneDec()) {
+		return types.ErrTooMuchSwapFee
+	}
+
+	return nil
+}
+
+func (msg MsgCreateOrderbookPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateOrderbookPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+/// Implement the CreatePoolMsg interface
+
+func (msg MsgCreateOrderbookPool) PoolCreator() sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return sender
+}
+
+func (msg MsgCreateOrderbookPool) Validate(ctx sdk.Context) error {
+	return msg.ValidateBasic()
+}
+
+// InitialLiquidity returns empty coins, liquidity is provided by placing limit orders.
+func (msg MsgCreateOrderbookPool) InitialLiquidity() sdk.Coins {
+	return sdk.Coins{}
+}
+
+func (msg MsgCreateOrderbookPool) CreatePool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error) {
+	pool, err := NewOrderbookPool(poolId, msg.BaseDenom, msg.QuoteDenom, msg.TickSize, msg.SwapFee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pool, nil
+}
+
+func (msg MsgCreateOrderbookPool) GetPoolType() poolmanagertypes.PoolType {
+	return poolmanagertypes.Orderbook
+}
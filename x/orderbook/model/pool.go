@@ -0,0 +1,152 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+var (
+	_ poolmanagertypes.PoolI       = &Pool{}
+	_ types.OrderbookPoolExtension = &Pool{}
+)
+
+// NewOrderbookPool creates a new orderbook pool with the given parameters.
+// The book of the pool is empty until its first limit order is placed.
+func NewOrderbookPool(poolId uint64, baseDenom, quoteDenom string, tickSize sdk.Dec, swapFee sdk.Dec) (Pool, error) {
+	if baseDenom == quoteDenom {
+		return Pool{}, types.ErrInvalidPoolDenoms
+	}
+
+	if tickSize.IsNil() || !tickSize.IsPositive() {
+		return Pool{}, types.ErrInvalidTickSize
+	}
+
+	if swapFee.IsNegative() {
+		return Pool{}, types.ErrNegativeSwapFee
+	}
+	if swapFee.GTE(sdk.OneDec()) {
+		return Pool{}, types.ErrTooMuchSwapFee
+	}
+
+	return Pool{
+		Address:    gammtypes.NewPoolAddress(poolId).String(),
+		Id:         poolId,
+		BaseDenom:  baseDenom,
+		QuoteDenom: quoteDenom,
+		TickSize:   tickSize,
+		SwapFee:    swapFee,
+	}, nil
+}
+
+// GetAddress returns the address of the orderbook pool.
+func (p Pool) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode address of pool with id: %d", p.GetId()))
+	}
+	return addr
+}
+
+func (p Pool) String() string {
+	out, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+func (p Pool) GetId() uint64 {
+	return p.Id
+}
+
+// GetSwapFee returns the swap fee charged to takers, it is paid to the makers of the orders filled.
+func (p Pool) GetSwapFee(_ sdk.Context) sdk.Dec {
+	return p.SwapFee
+}
+
+// GetExitFee returns zero, orders are cancelled without an exit fee.
+func (p Pool) GetExitFee(_ sdk.Context) sdk.Dec {
+	return sdk.ZeroDec()
+}
+
+func (p Pool) IsActive(_ sdk.Context) bool {
+	return true
+}
+
+// GetTotalShares returns zero, orderbook pools do not issue LP shares.
+func (p Pool) GetTotalShares() sdk.Int {
+	return sdk.ZeroInt()
+}
+
+// GetTotalPoolLiquidity returns empty coins. The pool does not track its balances,
+// they are held by the pool's account and can be queried from the bank keeper.
+func (p Pool) GetTotalPoolLiquidity(_ sdk.Context) sdk.Coins {
+	return sdk.Coins{}
+}
+
+func (p Pool) GetType() poolmanagertypes.PoolType {
+	return poolmanagertypes.Orderbook
+}
+
+// SpotPrice returns the spot price of the base asset in terms of the quote asset.
+// The price of the pool's base denom is the mid price between the best bid and the best ask,
+// or the price of the only side of the book with resting orders.
+func (p Pool) SpotPrice(_ sdk.Context, quoteAssetDenom string, baseAssetDenom string) (sdk.Dec, error) {
+	var price sdk.Dec
+	switch {
+	case p.BestBidTick > 0 && p.BestAskTick > 0:
+		price = p.TickToPrice(p.BestBidTick).Add(p.TickToPrice(p.BestAskTick)).QuoInt64(2)
+	case p.BestBidTick > 0:
+		price = p.TickToPrice(p.BestBidTick)
+	case p.BestAskTick > 0:
+		price = p.TickToPrice(p.BestAskTick)
+	default:
+		return sdk.Dec{}, types.ErrPoolHasNoPrice
+	}
+
+	switch {
+	case baseAssetDenom == p.BaseDenom && quoteAssetDenom == p.QuoteDenom:
+		return price, nil
+	case baseAssetDenom == p.QuoteDenom && quoteAssetDenom == p.BaseDenom:
+		return sdk.OneDec().Quo(price), nil
+	default:
+		return sdk.Dec{}, fmt.Errorf("base (%s) and quote (%s) assets must be the denoms of pool %d (%s, %s)",
+			baseAssetDenom, quoteAssetDenom, p.Id, p.BaseDenom, p.QuoteDenom)
+	}
+}
+
+func (p Pool) GetBaseDenom() string {
+	return p.BaseDenom
+}
+
+func (p Pool) GetQuoteDenom() string {
+	return p.QuoteDenom
+}
+
+func (p Pool) GetTickSize() sdk.Dec {
+	return p.TickSize
+}
+
+func (p Pool) GetBestBidTick() int64 {
+	return p.BestBidTick
+}
+
+func (p Pool) GetBestAskTick() int64 {
+	return p.BestAskTick
+}
+
+func (p *Pool) SetBestTicks(bestBidTick, bestAskTick int64) {
+	p.BestBidTick = bestBidTick
+	p.BestAskTick = bestAskTick
+}
+
+// TickToPrice returns the price of the base asset in terms of the quote asset at the given tick.
+func (p Pool) TickToPrice(tick int64) sdk.Dec {
+	return p.TickSize.MulInt64(tick)
+}
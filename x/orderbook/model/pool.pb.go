@@ -0,0 +1,606 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/orderbook/v1beta1/pool.proto
+
+package model
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Pool is the orderbook Pool struct. Liquidity is provided by resting limit
+// orders placed on price levels, the ticks of the pool. Swaps fill the resting
+// orders from the best price level outwards.
+type Pool struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// base_denom is the asset traded on the book. Prices are the amount of
+	// quote_denom per unit of base_denom.
+	BaseDenom  string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	QuoteDenom string `protobuf:"bytes,4,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// tick_size is the price increment between two consecutive ticks. The
+	// price of tick t is t * tick_size, only positive ticks are valid.
+	TickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=tick_size,json=tickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tick_size" yaml:"tick_size"`
+	// best_bid_tick and best_ask_tick are the ticks of the highest resting bid
+	// and of the lowest resting ask. Zero when that side of the book is empty.
+	BestBidTick int64                                  `protobuf:"varint,6,opt,name=best_bid_tick,json=bestBidTick,proto3" json:"best_bid_tick,omitempty" yaml:"best_bid_tick"`
+	BestAskTick int64                                  `protobuf:"varint,7,opt,name=best_ask_tick,json=bestAskTick,proto3" json:"best_ask_tick,omitempty" yaml:"best_ask_tick"`
+	SwapFee     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+}
+
+func (m *Pool) Reset()      { *m = Pool{} }
+func (*Pool) ProtoMessage() {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76b3dc5b699bdd85, []int{0}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Pool)(nil), "dymensionxyz.dymension.orderbook.v1beta1.Pool")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/orderbook/v1beta1/pool.proto", fileDescriptor_76b3dc5b699bdd85)
+}
+
+var fileDescriptor_76b3dc5b699bdd85 = []byte{
+	// 468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xed, 0x34, 0x34, 0xc9, 0x55, 0x14, 0xb0, 0x4a, 0x65, 0x3a, 0xf8, 0xa2, 0x1b, 0x50,
+	0x06, 0x62, 0x2b, 0x2a, 0x15, 0x52, 0xc5, 0x52, 0xab, 0xb0, 0x16, 0x19, 0x26, 0x84, 0x64, 0xd9,
+	0xbe, 0x23, 0x9c, 0x6c, 0xe7, 0x85, 0x9c, 0x5b, 0x92, 0x7c, 0x02, 0x46, 0xc4, 0xc4, 0xd8, 0x0f,
+	0xc1, 0x87, 0xa8, 0x98, 0x3a, 0x22, 0x06, 0x0b, 0x25, 0xdf, 0xc0, 0x9f, 0x00, 0xf9, 0xce, 0x36,
+	0x1e, 0x58, 0x98, 0xfc, 0xfe, 0xef, 0xf9, 0xf7, 0x7f, 0xf7, 0xa4, 0x3f, 0x3a, 0xa6, 0xab, 0x94,
+	0xcd, 0x04, 0x87, 0xd9, 0x72, 0xb5, 0x76, 0x1a, 0xe1, 0xc0, 0x82, 0xb2, 0x45, 0x08, 0x10, 0x3b,
+	0x57, 0x93, 0x90, 0x65, 0xc1, 0xc4, 0x99, 0x03, 0x24, 0xf6, 0x7c, 0x01, 0x19, 0x18, 0xa3, 0x36,
+	0x64, 0x37, 0xc2, 0x6e, 0x20, 0xbb, 0x82, 0x8e, 0x1e, 0x45, 0x20, 0x52, 0x10, 0xbe, 0xe4, 0x1c,
+	0x25, 0x94, 0xc9, 0xd1, 0xc1, 0x14, 0xa6, 0xa0, 0xfa, 0x65, 0xa5, 0xba, 0xe4, 0x6b, 0x17, 0x75,
+	0x5f, 0x01, 0x24, 0xc6, 0x13, 0xd4, 0x0b, 0x28, 0x5d, 0x30, 0x21, 0x4c, 0x7d, 0xa8, 0x8f, 0x06,
+	0xae, 0x51, 0xe4, 0x78, 0x7f, 0x15, 0xa4, 0xc9, 0x29, 0xa9, 0x06, 0xc4, 0xab, 0x7f, 0x31, 0xf6,
+	0x51, 0x87, 0x53, 0xb3, 0x33, 0xd4, 0x47, 0x5d, 0xaf, 0xc3, 0xa9, 0xf1, 0x14, 0xa1, 0x30, 0x10,
+	0xcc, 0xa7, 0x6c, 0x06, 0xa9, 0xb9, 0x23, 0x0d, 0x1e, 0x16, 0x39, 0x7e, 0xa0, 0x0c, 0xfe, 0xce,
+	0x88, 0x37, 0x28, 0xc5, 0x79, 0x59, 0x1b, 0xcf, 0xd0, 0xde, 0xc7, 0x4b, 0xc8, 0x6a, 0xac, 0x2b,
+	0xb1, 0xc3, 0x22, 0xc7, 0x86, 0xc2, 0x5a, 0x43, 0xe2, 0x21, 0xa9, 0x14, 0xe8, 0xa3, 0x41, 0xc6,
+	0xa3, 0xd8, 0x17, 0x7c, 0xcd, 0xcc, 0x3b, 0x12, 0x73, 0x6f, 0x72, 0xac, 0xfd, 0xca, 0xf1, 0xe3,
+	0x29, 0xcf, 0x3e, 0x5c, 0x86, 0x76, 0x04, 0x69, 0x75, 0x7f, 0xf5, 0x19, 0x0b, 0x1a, 0x3b, 0xd9,
+	0x6a, 0xce, 0x84, 0x7d, 0xce, 0xa2, 0x22, 0xc7, 0xf7, 0xd5, 0x92, 0xc6, 0x88, 0x78, 0xfd, 0xb2,
+	0x7e, 0xcd, 0xd7, 0xcc, 0x78, 0x8e, 0xee, 0x86, 0x4c, 0x64, 0x7e, 0xc8, 0xa9, 0x5f, 0x36, 0xcd,
+	0xdd, 0xa1, 0x3e, 0xda, 0x71, 0xcd, 0x22, 0xc7, 0x07, 0xd5, 0x49, 0xed, 0x31, 0xf1, 0xf6, 0x4a,
+	0xed, 0x72, 0xfa, 0x86, 0x47, 0x71, 0x43, 0x07, 0x22, 0x56, 0x74, 0xef, 0x9f, 0x74, 0x3d, 0xae,
+	0xe8, 0x33, 0x11, 0x4b, 0xfa, 0x1d, 0xea, 0x8b, 0x4f, 0xc1, 0xdc, 0x7f, 0xcf, 0x98, 0xd9, 0x97,
+	0xb7, 0x9d, 0xfd, 0xf7, 0x6d, 0xf7, 0xd4, 0x9a, 0xda, 0x87, 0x78, 0xbd, 0xb2, 0x7c, 0xc9, 0xd8,
+	0x29, 0xf9, 0x7c, 0x8d, 0xb5, 0x6f, 0xd7, 0x58, 0xfb, 0xf1, 0x7d, 0x7c, 0x78, 0x51, 0x07, 0xa8,
+	0x8c, 0xc0, 0x8b, 0x65, 0xa6, 0x72, 0xe5, 0x5e, 0xdc, 0x6c, 0x2c, 0xfd, 0x76, 0x63, 0xe9, 0xbf,
+	0x37, 0x96, 0xfe, 0x65, 0x6b, 0x69, 0xb7, 0x5b, 0x4b, 0xfb, 0xb9, 0xb5, 0xb4, 0xb7, 0x27, 0xad,
+	0x17, 0xc8, 0xcd, 0x5c, 0x8c, 0x93, 0x20, 0x14, 0xb5, 0x70, 0xae, 0x26, 0x27, 0xce, 0xb2, 0x95,
+	0xe6, 0x14, 0x28, 0x4b, 0xc2, 0x5d, 0x19, 0xb6, 0xe3, 0x3f, 0x03, 0x00, 0x98, 0x62, 0x99, 0x58,
+	0xfe, 0x02, 0x00, 0x00,
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.BestAskTick != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.BestAskTick))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BestBidTick != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.BestBidTick))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.TickSize.Size()
+		i -= size
+		if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovPool(uint64(m.Id))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = m.TickSize.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.BestBidTick != 0 {
+		n += 1 + sovPool(uint64(m.BestBidTick))
+	}
+	if m.BestAskTick != 0 {
+		n += 1 + sovPool(uint64(m.BestAskTick))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovPool(uint64(l))
+	return n
+}
+
+func sovPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPool(x uint64) (n int) {
+	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestBidTick", wireType)
+			}
+			m.BestBidTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestBidTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestAskTick", wireType)
+			}
+			m.BestAskTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestAskTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPool = fmt.Errorf("proto: unexpected end of group")
+)
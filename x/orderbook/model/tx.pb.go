@@ -0,0 +1,774 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/orderbook/poolmodel/orderbook/v1beta1/tx.proto
+
+package model
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ===================== MsgCreateOrderbookPool
+type MsgCreateOrderbookPool struct {
+	Sender     string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	BaseDenom  string                                 `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	QuoteDenom string                                 `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	TickSize   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=tick_size,json=tickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tick_size" yaml:"tick_size"`
+	SwapFee    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+}
+
+func (m *MsgCreateOrderbookPool) Reset()         { *m = MsgCreateOrderbookPool{} }
+func (m *MsgCreateOrderbookPool) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateOrderbookPool) ProtoMessage()    {}
+func (*MsgCreateOrderbookPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7c9a188af7a587d, []int{0}
+}
+func (m *MsgCreateOrderbookPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateOrderbookPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateOrderbookPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateOrderbookPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateOrderbookPool.Merge(m, src)
+}
+func (m *MsgCreateOrderbookPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateOrderbookPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateOrderbookPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateOrderbookPool proto.InternalMessageInfo
+
+func (m *MsgCreateOrderbookPool) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCreateOrderbookPool) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *MsgCreateOrderbookPool) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+// Returns a unique poolID to identify the pool with.
+type MsgCreateOrderbookPoolResponse struct {
+	PoolID uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *MsgCreateOrderbookPoolResponse) Reset()         { *m = MsgCreateOrderbookPoolResponse{} }
+func (m *MsgCreateOrderbookPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateOrderbookPoolResponse) ProtoMessage()    {}
+func (*MsgCreateOrderbookPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7c9a188af7a587d, []int{1}
+}
+func (m *MsgCreateOrderbookPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateOrderbookPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateOrderbookPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateOrderbookPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateOrderbookPoolResponse.Merge(m, src)
+}
+func (m *MsgCreateOrderbookPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateOrderbookPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateOrderbookPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateOrderbookPoolResponse proto.InternalMessageInfo
+
+func (m *MsgCreateOrderbookPoolResponse) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgCreateOrderbookPool)(nil), "dymensionxyz.dymension.orderbook.poolmodel.orderbook.v1beta1.MsgCreateOrderbookPool")
+	proto.RegisterType((*MsgCreateOrderbookPoolResponse)(nil), "dymensionxyz.dymension.orderbook.poolmodel.orderbook.v1beta1.MsgCreateOrderbookPoolResponse")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/orderbook/poolmodel/orderbook/v1beta1/tx.proto", fileDescriptor_f7c9a188af7a587d)
+}
+
+var fileDescriptor_f7c9a188af7a587d = []byte{
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0xb6, 0xa4, 0xcd, 0x22, 0x04, 0x35, 0x50, 0x45, 0x3d, 0xd8, 0xc8, 0x48, 0x08,
+	0x0e, 0xdd, 0x55, 0x80, 0x0a, 0x09, 0x71, 0x21, 0xa4, 0x48, 0x3d, 0x54, 0x45, 0x86, 0x13, 0xaa,
+	0x64, 0xd9, 0xd9, 0xc1, 0x58, 0xb1, 0x3d, 0xc6, 0xbb, 0x2d, 0x49, 0x9e, 0x82, 0xc7, 0x2a, 0xb7,
+	0x72, 0x43, 0x1c, 0x2c, 0x94, 0x3c, 0x01, 0x79, 0x02, 0xb4, 0xeb, 0x3f, 0xf8, 0x90, 0x0b, 0x42,
+	0x9c, 0x3c, 0xdf, 0xce, 0x7c, 0x3f, 0xaf, 0xbf, 0x5d, 0x93, 0x23, 0x3e, 0x4b, 0x20, 0x15, 0x11,
+	0xa6, 0xd3, 0xd9, 0x9c, 0x35, 0x82, 0x61, 0xce, 0x21, 0x0f, 0x10, 0x27, 0x2c, 0x43, 0x8c, 0x13,
+	0xe4, 0x10, 0xb7, 0xd6, 0x2e, 0x06, 0x01, 0x48, 0x7f, 0xc0, 0xe4, 0x94, 0x66, 0x39, 0x4a, 0x34,
+	0x5f, 0xb4, 0x31, 0xb4, 0x11, 0xb4, 0xb1, 0xd0, 0x06, 0xd3, 0x5a, 0xab, 0x30, 0xfb, 0x77, 0x42,
+	0x0c, 0x51, 0x83, 0x98, 0xaa, 0x4a, 0xa6, 0xf3, 0x6b, 0x83, 0xec, 0x9d, 0x88, 0xf0, 0x55, 0x0e,
+	0xbe, 0x84, 0xd3, 0xda, 0xf4, 0x06, 0x31, 0x36, 0x1f, 0x91, 0xae, 0x80, 0x94, 0x43, 0xde, 0x37,
+	0xee, 0x19, 0x0f, 0x7b, 0xc3, 0xdd, 0x55, 0x61, 0xdf, 0x98, 0xf9, 0x49, 0xfc, 0xdc, 0x29, 0xd7,
+	0x1d, 0xb7, 0x1a, 0x30, 0x9f, 0x12, 0x12, 0xf8, 0x02, 0x3c, 0x0e, 0x29, 0x26, 0xfd, 0x0d, 0x3d,
+	0x7e, 0x77, 0x55, 0xd8, 0xbb, 0xe5, 0xf8, 0x9f, 0x9e, 0xe3, 0xf6, 0x94, 0x18, 0xa9, 0xda, 0x7c,
+	0x46, 0xae, 0x7f, 0x3a, 0x47, 0x59, 0xdb, 0x36, 0xb5, 0x6d, 0x6f, 0x55, 0xd8, 0x66, 0x69, 0x6b,
+	0x35, 0x1d, 0x97, 0x68, 0x55, 0x1a, 0x3d, 0xd2, 0x93, 0xd1, 0x78, 0xe2, 0x89, 0x68, 0x0e, 0xfd,
+	0x2d, 0x6d, 0x1b, 0x5e, 0x16, 0x76, 0xe7, 0x47, 0x61, 0x3f, 0x08, 0x23, 0xf9, 0xf1, 0x3c, 0xa0,
+	0x63, 0x4c, 0xd8, 0x18, 0x45, 0x82, 0xa2, 0x7a, 0x1c, 0x08, 0x3e, 0x61, 0x72, 0x96, 0x81, 0xa0,
+	0x23, 0x18, 0xaf, 0x0a, 0xfb, 0x56, 0xf9, 0x92, 0x06, 0xe4, 0xb8, 0x3b, 0xaa, 0x7e, 0x1b, 0xcd,
+	0xc1, 0x3c, 0x23, 0x3b, 0xe2, 0xb3, 0x9f, 0x79, 0x1f, 0x00, 0xfa, 0xd7, 0x34, 0xff, 0xe5, 0x5f,
+	0xf3, 0x6f, 0x56, 0x51, 0x55, 0x1c, 0xc7, 0xdd, 0x56, 0xe5, 0x6b, 0x00, 0xe7, 0x88, 0x58, 0xeb,
+	0x23, 0x77, 0x41, 0x64, 0x98, 0x0a, 0x30, 0xef, 0x93, 0x6d, 0x75, 0x94, 0x5e, 0xc4, 0x75, 0xf6,
+	0x5b, 0x43, 0xb2, 0x28, 0xec, 0xae, 0x1a, 0x39, 0x1e, 0xb9, 0x5d, 0xd5, 0x3a, 0xe6, 0x8f, 0xbf,
+	0x19, 0x64, 0xf3, 0x44, 0x84, 0xe6, 0x57, 0x83, 0xdc, 0x5e, 0x77, 0x7e, 0xef, 0xe8, 0xbf, 0xdc,
+	0x17, 0xba, 0x7e, 0x8b, 0xfb, 0x67, 0xff, 0x83, 0x5a, 0x7f, 0xf8, 0xf0, 0xf4, 0x72, 0x61, 0x19,
+	0x57, 0x0b, 0xcb, 0xf8, 0xb9, 0xb0, 0x8c, 0x2f, 0x4b, 0xab, 0x73, 0xb5, 0xb4, 0x3a, 0xdf, 0x97,
+	0x56, 0xe7, 0xfd, 0x61, 0x2b, 0x78, 0x1d, 0x78, 0x24, 0x0e, 0x62, 0x3f, 0x10, 0xb5, 0x60, 0x17,
+	0x83, 0x43, 0x36, 0x6d, 0xfd, 0x3e, 0x7a, 0x07, 0x41, 0x57, 0x5f, 0xf3, 0x27, 0xbf, 0x07, 0x00,
+	0x9f, 0x90, 0x13, 0x24, 0x83, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateOrderbookPool(ctx context.Context, in *MsgCreateOrderbookPool, opts ...grpc.CallOption) (*MsgCreateOrderbookPoolResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateOrderbookPool(ctx context.Context, in *MsgCreateOrderbookPool, opts ...grpc.CallOption) (*MsgCreateOrderbookPoolResponse, error) {
+	out := new(MsgCreateOrderbookPoolResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.orderbook.poolmodel.orderbook.v1beta1.Msg/CreateOrderbookPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateOrderbookPool(context.Context, *MsgCreateOrderbookPool) (*MsgCreateOrderbookPoolResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateOrderbookPool(ctx context.Context, req *MsgCreateOrderbookPool) (*MsgCreateOrderbookPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrderbookPool not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateOrderbookPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateOrderbookPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateOrderbookPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.orderbook.poolmodel.orderbook.v1beta1.Msg/CreateOrderbookPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateOrderbookPool(ctx, req.(*MsgCreateOrderbookPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.orderbook.poolmodel.orderbook.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOrderbookPool",
+			Handler:    _Msg_CreateOrderbookPool_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/orderbook/poolmodel/orderbook/v1beta1/tx.proto",
+}
+
+func (m *MsgCreateOrderbookPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOrderbookPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOrderbookPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TickSize.Size()
+		i -= size
+		if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOrderbookPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOrderbookPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOrderbookPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateOrderbookPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TickSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SwapFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateOrderbookPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateOrderbookPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOrderbookPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOrderbookPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateOrderbookPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOrderbookPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOrderbookPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,78 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type PoolNotFoundError struct {
+	PoolId uint64
+}
+
+func (e PoolNotFoundError) Error() string {
+	return fmt.Sprintf("orderbook pool with ID %d does not exist", e.PoolId)
+}
+
+type OrderNotFoundError struct {
+	OrderId uint64
+}
+
+func (e OrderNotFoundError) Error() string {
+	return fmt.Sprintf("limit order with ID %d does not exist", e.OrderId)
+}
+
+type InvalidTickError struct {
+	Tick int64
+}
+
+func (e InvalidTickError) Error() string {
+	return fmt.Sprintf("tick (%d) is invalid, must be positive", e.Tick)
+}
+
+type OrderCrossesBookError struct {
+	Side         OrderSide
+	Tick         int64
+	OpposingTick int64
+}
+
+func (e OrderCrossesBookError) Error() string {
+	return fmt.Sprintf("%s at tick %d would cross the best opposing order at tick %d, swap against the pool instead", e.Side, e.Tick, e.OpposingTick)
+}
+
+type NotOrderOwnerError struct {
+	OrderId uint64
+	Owner   string
+	Sender  string
+}
+
+func (e NotOrderOwnerError) Error() string {
+	return fmt.Sprintf("limit order %d is owned by %s, not %s", e.OrderId, e.Owner, e.Sender)
+}
+
+type DenomNotInPoolError struct {
+	PoolId uint64
+	Denom  string
+}
+
+func (e DenomNotInPoolError) Error() string {
+	return fmt.Sprintf("denom (%s) does not exist in pool %d", e.Denom, e.PoolId)
+}
+
+// x/orderbook module sentinel errors.
+var (
+	ErrPoolHasNoPrice     = sdkerrors.Register(ModuleName, 1, "pool has no price, its book is empty")
+	ErrNotEnoughLiquidity = sdkerrors.Register(ModuleName, 2, "not enough resting orders in the pool to fill the swap")
+	ErrLimitMinAmount     = sdkerrors.Register(ModuleName, 3, "calculated amount is lesser than min amount")
+	ErrLimitMaxAmount     = sdkerrors.Register(ModuleName, 4, "calculated amount is larger than max amount")
+	ErrInvalidMathApprox  = sdkerrors.Register(ModuleName, 5, "invalid calculated result")
+	ErrNegativeSwapFee    = sdkerrors.Register(ModuleName, 6, "swap fee is negative")
+	ErrTooMuchSwapFee     = sdkerrors.Register(ModuleName, 7, "swap fee should be lesser than 1 (100%)")
+	ErrInvalidPoolDenoms  = sdkerrors.Register(ModuleName, 8, "pool denoms must be valid and distinct")
+	ErrInvalidTickSize    = sdkerrors.Register(ModuleName, 9, "tick size must be positive")
+	ErrNotOrderbookPool   = sdkerrors.Register(ModuleName, 10, "pool is not an orderbook pool")
+	ErrSameDenomSwap      = sdkerrors.Register(ModuleName, 11, "cannot trade same denomination in and out")
+	ErrInvalidQuantity    = sdkerrors.Register(ModuleName, 12, "order quantity must be positive")
+	ErrInvalidOrderSide   = sdkerrors.Register(ModuleName, 13, "order side must be bid or ask")
+	ErrNothingToClaim     = sdkerrors.Register(ModuleName, 14, "limit order has no proceeds to claim")
+)
@@ -0,0 +1,15 @@
+package types
+
+const (
+	TypeEvtPlaceLimitOrder  = "place_limit_order"
+	TypeEvtCancelLimitOrder = "cancel_limit_order"
+	TypeEvtClaimLimitOrder  = "claim_limit_order"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyPoolId     = "pool_id"
+	AttributeKeyOrderId    = "order_id"
+	AttributeKeySide       = "side"
+	AttributeKeyTick       = "tick"
+	AttributeKeyQuantity   = "quantity"
+	AttributeKeyTokens     = "tokens"
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// DefaultGenesis creates a default GenesisState object.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:      DefaultParams(),
+		Pools:       []*codectypes.Any{},
+		Orders:      []LimitOrder{},
+		NextOrderId: 1,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.NextOrderId == 0 {
+		return fmt.Errorf("next order id must be positive")
+	}
+
+	seenIds := make(map[uint64]bool, len(gs.Orders))
+	for _, order := range gs.Orders {
+		if err := order.Validate(); err != nil {
+			return err
+		}
+		if seenIds[order.Id] {
+			return fmt.Errorf("duplicate limit order id %d", order.Id)
+		}
+		if order.Id >= gs.NextOrderId {
+			return fmt.Errorf("limit order id %d must be lower than the next order id %d", order.Id, gs.NextOrderId)
+		}
+		seenIds[order.Id] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName = "orderbook"
+
+	StoreKey = ModuleName
+
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+var (
+	// KeyPrefixPools defines prefix to store pools.
+	KeyPrefixPools = []byte{0x01}
+	// KeyPrefixOrders defines prefix to store limit orders by id.
+	KeyPrefixOrders = []byte{0x02}
+	// KeyPrefixBook defines prefix to store the resting orders of each pool in price-time priority.
+	KeyPrefixBook = []byte{0x03}
+	// KeyNextOrderId defines key to store the id of the next limit order.
+	KeyNextOrderId = []byte{0x04}
+)
+
+// KeyPool returns the key of the pool with the given id.
+func KeyPool(poolId uint64) []byte {
+	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyOrder returns the key of the limit order with the given id.
+func KeyOrder(orderId uint64) []byte {
+	return append(KeyPrefixOrders, sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// KeyBookSide returns the prefix of the resting orders on one side of the book of a pool.
+func KeyBookSide(poolId uint64, side OrderSide) []byte {
+	key := append(KeyPrefixBook, sdk.Uint64ToBigEndian(poolId)...)
+	return append(key, byte(side))
+}
+
+// KeyBookOrder returns the key of a resting order in the book of a pool. Keys are ordered
+// by price-time priority: the best price level first, and the oldest order first within
+// a price level.
+func KeyBookOrder(poolId uint64, side OrderSide, tick int64, orderId uint64) []byte {
+	key := append(KeyBookSide(poolId, side), priorityToBytes(side, tick)...)
+	return append(key, sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// priorityToBytes converts a tick to a byte representation whose lexicographic order
+// is the priority of the price levels of a side: ascending ticks for asks, descending
+// ticks for bids.
+func priorityToBytes(side OrderSide, tick int64) []byte {
+	if side == Bid {
+		tick = -tick
+	}
+	bz := make([]byte, 8)
+	// flipping the sign bit makes negative values sort before positive ones
+	binary.BigEndian.PutUint64(bz, uint64(tick)^(1<<63))
+	return bz
+}
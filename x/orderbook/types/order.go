@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateOrderSide returns an error if side is not one of the sides of the book.
+func ValidateOrderSide(side OrderSide) error {
+	if side != Bid && side != Ask {
+		return ErrInvalidOrderSide
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of a limit order.
+func (o LimitOrder) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(o.Owner); err != nil {
+		return fmt.Errorf("invalid owner address (%s) of limit order %d: %w", o.Owner, o.Id, err)
+	}
+	if err := ValidateOrderSide(o.Side); err != nil {
+		return err
+	}
+	if o.Tick <= 0 {
+		return InvalidTickError{Tick: o.Tick}
+	}
+	if o.Quantity.IsNil() || !o.Quantity.IsPositive() {
+		return ErrInvalidQuantity
+	}
+	if o.Remaining.IsNil() || o.Remaining.IsNegative() || o.Remaining.GT(o.Quantity) {
+		return fmt.Errorf("remaining quantity (%s) of limit order %d must be between 0 and its quantity (%s)", o.Remaining, o.Id, o.Quantity)
+	}
+	if o.Claimable.IsNil() || o.Claimable.IsNegative() {
+		return fmt.Errorf("claimable amount (%s) of limit order %d must not be negative", o.Claimable, o.Id)
+	}
+	return nil
+}
+
+// IsFilled returns true if nothing is left of the order on the book.
+func (o LimitOrder) IsFilled() bool {
+	return o.Remaining.IsZero()
+}
@@ -0,0 +1,649 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/orderbook/v1beta1/order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OrderSide is the side of the book a limit order rests on.
+type OrderSide int32
+
+const (
+	// Bid is an order to buy the base asset, it escrows the quote asset.
+	Bid OrderSide = 0
+	// Ask is an order to sell the base asset, it escrows the base asset.
+	Ask OrderSide = 1
+)
+
+var OrderSide_name = map[int32]string{
+	0: "Bid",
+	1: "Ask",
+}
+
+var OrderSide_value = map[string]int32{
+	"Bid": 0,
+	"Ask": 1,
+}
+
+func (x OrderSide) String() string {
+	return proto.EnumName(OrderSide_name, int32(x))
+}
+
+func (OrderSide) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0302a4592cb734e4, []int{0}
+}
+
+// LimitOrder is a resting order on a price level of an orderbook pool.
+type LimitOrder struct {
+	Id     uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PoolId uint64    `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Owner  string    `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Side   OrderSide `protobuf:"varint,4,opt,name=side,proto3,enum=dymensionxyz.dymension.orderbook.v1beta1.OrderSide" json:"side,omitempty"`
+	Tick   int64     `protobuf:"varint,5,opt,name=tick,proto3" json:"tick,omitempty"`
+	// quantity is the amount of the base asset the order was placed for.
+	Quantity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quantity"`
+	// remaining is the amount of the base asset not filled yet.
+	Remaining github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=remaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining"`
+	// claimable is the proceeds of the fills not claimed yet, in the quote
+	// asset for an ask and in the base asset for a bid.
+	Claimable github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=claimable,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimable"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0302a4592cb734e4, []int{0}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetSide() OrderSide {
+	if m != nil {
+		return m.Side
+	}
+	return Bid
+}
+
+func (m *LimitOrder) GetTick() int64 {
+	if m != nil {
+		return m.Tick
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.orderbook.v1beta1.OrderSide", OrderSide_name, OrderSide_value)
+	proto.RegisterType((*LimitOrder)(nil), "dymensionxyz.dymension.orderbook.v1beta1.LimitOrder")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/orderbook/v1beta1/order.proto", fileDescriptor_0302a4592cb734e4)
+}
+
+var fileDescriptor_0302a4592cb734e4 = []byte{
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xbf, 0x6e, 0xdb, 0x30,
+	0x10, 0xc6, 0x45, 0x5b, 0xb1, 0x63, 0xa2, 0x30, 0x0c, 0xa2, 0x03, 0x91, 0x41, 0x36, 0x5c, 0x20,
+	0x10, 0x5a, 0x84, 0x84, 0x9b, 0x66, 0xe9, 0x56, 0x2d, 0x45, 0x8a, 0x00, 0x01, 0xd4, 0xad, 0x4b,
+	0x21, 0x89, 0x84, 0x7a, 0xd0, 0x1f, 0xba, 0x22, 0x93, 0x46, 0x7d, 0x82, 0x8e, 0x7d, 0x87, 0xbe,
+	0x43, 0x9f, 0x21, 0x63, 0xc6, 0xa2, 0x83, 0x50, 0xd8, 0x6f, 0x90, 0x27, 0x28, 0x44, 0xb9, 0xaa,
+	0xc7, 0x20, 0x13, 0xbf, 0xbb, 0xe3, 0xef, 0xbb, 0x23, 0x71, 0xf8, 0x95, 0xa8, 0x0b, 0x59, 0x6a,
+	0x50, 0xe5, 0x4d, 0xfd, 0x95, 0xf7, 0x01, 0x57, 0x95, 0x90, 0x55, 0xac, 0x54, 0xc6, 0xaf, 0x57,
+	0xb1, 0x34, 0xd1, 0xaa, 0xcb, 0xb0, 0x75, 0xa5, 0x8c, 0x22, 0xfe, 0x3e, 0xc5, 0xfa, 0x80, 0xf5,
+	0x14, 0xdb, 0x51, 0x47, 0x4f, 0x53, 0x95, 0x2a, 0x0b, 0xf1, 0x56, 0x75, 0xfc, 0xf2, 0xe7, 0x10,
+	0xe3, 0x0b, 0x28, 0xc0, 0x5c, 0xb6, 0x00, 0x99, 0xe2, 0x01, 0x08, 0x8a, 0x16, 0xc8, 0x77, 0xc3,
+	0x01, 0x08, 0xf2, 0x02, 0x8f, 0xd7, 0x4a, 0xe5, 0x1f, 0x41, 0xd0, 0x41, 0x9b, 0x0c, 0xc8, 0x7d,
+	0x33, 0x9f, 0xd6, 0x51, 0x91, 0xbf, 0x5e, 0xee, 0x0a, 0xcb, 0x70, 0xd4, 0xaa, 0x73, 0x41, 0x8e,
+	0xf1, 0x81, 0xfa, 0x52, 0xca, 0x8a, 0x0e, 0x17, 0xc8, 0x9f, 0x04, 0xb3, 0xfb, 0x66, 0xfe, 0xa4,
+	0xbb, 0x6a, 0xd3, 0xcb, 0xb0, 0x2b, 0x93, 0xb7, 0xd8, 0xd5, 0x20, 0x24, 0x75, 0x17, 0xc8, 0x9f,
+	0xbe, 0x3c, 0x65, 0x0f, 0x7d, 0x02, 0xb3, 0x33, 0xbe, 0x07, 0x21, 0x43, 0x6b, 0x40, 0x08, 0x76,
+	0x0d, 0x24, 0x19, 0x3d, 0x58, 0x20, 0x7f, 0x18, 0x5a, 0x4d, 0xde, 0xe1, 0xc3, 0xcf, 0x57, 0x51,
+	0x69, 0xc0, 0xd4, 0x74, 0x64, 0xe7, 0x60, 0xb7, 0xcd, 0xdc, 0xf9, 0xdd, 0xcc, 0x8f, 0x53, 0x30,
+	0x9f, 0xae, 0x62, 0x96, 0xa8, 0x82, 0x27, 0x4a, 0x17, 0x4a, 0xef, 0x8e, 0x13, 0x2d, 0x32, 0x6e,
+	0xea, 0xb5, 0xd4, 0xec, 0xbc, 0x34, 0x61, 0xcf, 0x93, 0x0b, 0x3c, 0xa9, 0x64, 0x11, 0x41, 0x09,
+	0x65, 0x4a, 0xc7, 0x8f, 0x32, 0xfb, 0x6f, 0xd0, 0xba, 0x25, 0x79, 0x04, 0x45, 0x14, 0xe7, 0x92,
+	0x1e, 0x3e, 0xce, 0xad, 0x37, 0x78, 0xfe, 0x0c, 0x4f, 0xfa, 0xef, 0x20, 0x63, 0x3c, 0x0c, 0x40,
+	0xcc, 0x9c, 0x56, 0xbc, 0xd1, 0xd9, 0x0c, 0x1d, 0xb9, 0xdf, 0x7e, 0x78, 0x4e, 0x70, 0x79, 0xbb,
+	0xf1, 0xd0, 0xdd, 0xc6, 0x43, 0x7f, 0x36, 0x1e, 0xfa, 0xbe, 0xf5, 0x9c, 0xbb, 0xad, 0xe7, 0xfc,
+	0xda, 0x7a, 0xce, 0x87, 0xb3, 0xbd, 0x8e, 0xb6, 0x13, 0xe8, 0x93, 0x3c, 0x8a, 0xf5, 0xbf, 0x80,
+	0x5f, 0xaf, 0xce, 0xf8, 0xcd, 0xde, 0xf2, 0xd9, 0x21, 0xe2, 0x91, 0xdd, 0x9a, 0xd3, 0xbf, 0x03,
+	0x00, 0xb2, 0xdb, 0x39, 0x77, 0xad, 0x02, 0x00, 0x00,
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Claimable.Size()
+		i -= size
+		if _, err := m.Claimable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Tick != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Tick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Side != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOrder(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovOrder(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovOrder(uint64(m.Side))
+	}
+	if m.Tick != 0 {
+		n += 1 + sovOrder(uint64(m.Tick))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.Claimable.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	return n
+}
+
+func sovOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrder(x uint64) (n int) {
+	return sovOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= OrderSide(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
+			}
+			m.Tick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOrder = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// OrderbookPoolExtension is the interface of orderbook pools.
+// On top of the generic PoolI methods, it exposes the state the keeper
+// needs to match swaps against the resting orders of the pool.
+type OrderbookPoolExtension interface {
+	poolmanagertypes.PoolI
+
+	GetBaseDenom() string
+	GetQuoteDenom() string
+	GetTickSize() sdk.Dec
+	GetBestBidTick() int64
+	GetBestAskTick() int64
+	// SetBestTicks sets the ticks of the best resting bid and ask, zero for an empty side.
+	SetBestTicks(bestBidTick, bestAskTick int64)
+	// TickToPrice returns the price of the base asset in terms of the quote asset at the given tick.
+	TickToPrice(tick int64) sdk.Dec
+}
@@ -0,0 +1,224 @@
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v15/testutils/apptesting"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/client/cli"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+var testAddresses = apptesting.CreateRandomAccounts(3)
+
+func TestNewSwapExactAmountInCmd(t *testing.T) {
+	desc, _ := cli.NewSwapExactAmountInCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgSwapExactAmountIn]{
+		"swap exact amount in": {
+			Cmd: "10stake 3 --swap-route-pool-ids=1 --swap-route-denoms=node0token --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSwapExactAmountIn{
+				Sender:            testAddresses[0].String(),
+				Routes:            []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "node0token"}},
+				TokenIn:           sdk.NewInt64Coin("stake", 10),
+				TokenOutMinAmount: sdk.NewIntFromUint64(3),
+			},
+		},
+		"multihop swap exact amount in": {
+			Cmd: "10stake 3 --swap-route-pool-ids=1,2 --swap-route-denoms=node0token,udym --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSwapExactAmountIn{
+				Sender:            testAddresses[0].String(),
+				Routes:            []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "node0token"}, {PoolId: 2, TokenOutDenom: "udym"}},
+				TokenIn:           sdk.NewInt64Coin("stake", 10),
+				TokenOutMinAmount: sdk.NewIntFromUint64(3),
+			},
+		},
+		"swap exact amount in with max price impact": {
+			Cmd: "10stake 3 --swap-route-pool-ids=1 --swap-route-denoms=node0token --max-price-impact=0.05 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSwapExactAmountIn{
+				Sender:            testAddresses[0].String(),
+				Routes:            []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "node0token"}},
+				TokenIn:           sdk.NewInt64Coin("stake", 10),
+				TokenOutMinAmount: sdk.NewIntFromUint64(3),
+				MaxPriceImpact:    sdk.NewDecWithPrec(5, 2),
+			},
+		},
+		"invalid max price impact": {
+			Cmd:         "10stake 3 --swap-route-pool-ids=1 --swap-route-denoms=node0token --max-price-impact=abc --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+		"swap route pool ids and denoms mismatch": {
+			Cmd:         "10stake 3 --swap-route-pool-ids=1,2 --swap-route-denoms=node0token --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewSwapExactAmountOutCmd(t *testing.T) {
+	desc, _ := cli.NewSwapExactAmountOutCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgSwapExactAmountOut]{
+		"swap exact amount out": {
+			Cmd: "10stake 20 --swap-route-pool-ids=1 --swap-route-denoms=node0token --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSwapExactAmountOut{
+				Sender:           testAddresses[0].String(),
+				Routes:           []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "node0token"}},
+				TokenInMaxAmount: sdk.NewIntFromUint64(20),
+				TokenOut:         sdk.NewInt64Coin("stake", 10),
+			},
+		},
+		"swap exact amount out with max price impact": {
+			Cmd: "10stake 20 --swap-route-pool-ids=1 --swap-route-denoms=node0token --max-price-impact=0.05 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSwapExactAmountOut{
+				Sender:           testAddresses[0].String(),
+				Routes:           []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "node0token"}},
+				TokenInMaxAmount: sdk.NewIntFromUint64(20),
+				TokenOut:         sdk.NewInt64Coin("stake", 10),
+				MaxPriceImpact:   sdk.NewDecWithPrec(5, 2),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewSplitRouteSwapExactAmountInCmd(t *testing.T) {
+	routesFile := testutil.WriteToNewTempFile(t, `[
+		{"pools": [{"pool_id": 1, "token_out_denom": "adym"}, {"pool_id": 2, "token_out_denom": "uion"}], "token_in_amount": "1000"},
+		{"pools": [{"pool_id": 3, "token_out_denom": "uion"}], "token_in_amount": "2000"}
+	]`)
+	badAmountFile := testutil.WriteToNewTempFile(t, `[{"pools": [{"pool_id": 1, "token_out_denom": "uion"}], "token_in_amount": "abc"}]`)
+
+	desc, _ := cli.NewSplitRouteSwapExactAmountInCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgSplitRouteSwapExactAmountIn]{
+		"split route swap exact amount in": {
+			Cmd: "stake 3 --routes-file=" + routesFile.Name() + " --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSplitRouteSwapExactAmountIn{
+				Sender: testAddresses[0].String(),
+				Routes: []types.SwapAmountInSplitRoute{
+					{
+						Pools:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "adym"}, {PoolId: 2, TokenOutDenom: "uion"}},
+						TokenInAmount: sdk.NewInt(1000),
+					},
+					{
+						Pools:         []types.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: "uion"}},
+						TokenInAmount: sdk.NewInt(2000),
+					},
+				},
+				TokenInDenom:      "stake",
+				TokenOutMinAmount: sdk.NewIntFromUint64(3),
+			},
+		},
+		"invalid token in amount": {
+			Cmd:         "stake 3 --routes-file=" + badAmountFile.Name() + " --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+		"missing routes file": {
+			Cmd:         "stake 3 --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestGetCmdPool(t *testing.T) {
+	desc, _ := cli.GetCmdPool()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.PoolRequest]{
+		"basic test": {
+			Cmd:           "1",
+			ExpectedQuery: &types.PoolRequest{PoolId: 1},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdSpotPrice(t *testing.T) {
+	desc, _ := cli.GetCmdSpotPrice()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.SpotPriceRequest]{
+		"basic test": {
+			Cmd: "1 adym uion",
+			ExpectedQuery: &types.SpotPriceRequest{
+				PoolId:          1,
+				BaseAssetDenom:  "adym",
+				QuoteAssetDenom: "uion",
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdEstimateSwapExactAmountIn(t *testing.T) {
+	desc, _ := cli.GetCmdEstimateSwapExactAmountIn()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.EstimateSwapExactAmountInRequest]{
+		"basic test": {
+			Cmd: "10stake --swap-route-pool-ids=1,2 --swap-route-denoms=adym,uion",
+			ExpectedQuery: &types.EstimateSwapExactAmountInRequest{
+				TokenIn: "10stake",
+				Routes:  []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "adym"}, {PoolId: 2, TokenOutDenom: "uion"}},
+			},
+		},
+		"with max price impact": {
+			Cmd: "10stake --swap-route-pool-ids=1 --swap-route-denoms=adym --max-price-impact=0.1",
+			ExpectedQuery: &types.EstimateSwapExactAmountInRequest{
+				TokenIn:        "10stake",
+				Routes:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "adym"}},
+				MaxPriceImpact: sdk.NewDecWithPrec(1, 1),
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdEstimateSwapExactAmountOut(t *testing.T) {
+	desc, _ := cli.GetCmdEstimateSwapExactAmountOut()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.EstimateSwapExactAmountOutRequest]{
+		"basic test": {
+			Cmd: "10uion --swap-route-pool-ids=1,2 --swap-route-denoms=stake,adym",
+			ExpectedQuery: &types.EstimateSwapExactAmountOutRequest{
+				TokenOut: "10uion",
+				Routes:   []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "stake"}, {PoolId: 2, TokenInDenom: "adym"}},
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdEstimateBestRouteExactAmountIn(t *testing.T) {
+	desc, _ := cli.GetCmdEstimateBestRouteExactAmountIn()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.EstimateBestRouteExactAmountInRequest]{
+		"basic test": {
+			Cmd: "10stake uion 3",
+			ExpectedQuery: &types.EstimateBestRouteExactAmountInRequest{
+				TokenIn:       "10stake",
+				TokenOutDenom: "uion",
+				MaxHops:       3,
+			},
+		},
+		"all pools": {
+			Cmd: "10stake uion 3 --all-pools",
+			ExpectedQuery: &types.EstimateBestRouteExactAmountInRequest{
+				TokenIn:       "10stake",
+				TokenOutDenom: "uion",
+				MaxHops:       3,
+				AllPools:      true,
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestNewFlashSwapCmd(t *testing.T) {
+	desc, _ := cli.NewFlashSwapCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgFlashSwap]{
+		"missing msgs file": {
+			Cmd:         "10stake 20 missing.json --swap-route-pool-ids=1 --swap-route-denoms=node0token --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+		"invalid token in max amount": {
+			Cmd:         "10stake x missing.json --swap-route-pool-ids=1 --swap-route-denoms=node0token --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
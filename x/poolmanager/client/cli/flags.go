@@ -0,0 +1,52 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	// Will be parsed to []uint64.
+	FlagSwapRoutePoolIds = "swap-route-pool-ids"
+	// Will be parsed to []string.
+	FlagSwapRouteDenoms = "swap-route-denoms"
+	// Will be parsed to []types.SwapAmountInSplitRoute.
+	FlagSplitRoutesFile = "routes-file"
+	// Will be parsed to sdk.Dec.
+	FlagMaxPriceImpact = "max-price-impact"
+	// Will be parsed to bool.
+	FlagAllPools = "all-pools"
+)
+
+// splitRouteInputs is the JSON description of one route of a split route swap.
+type splitRouteInputs struct {
+	Pools []struct {
+		PoolId        uint64 `json:"pool_id"`
+		TokenOutDenom string `json:"token_out_denom"`
+	} `json:"pools"`
+	TokenInAmount string `json:"token_in_amount"`
+}
+
+func FlagSetMultihopSwapRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagSwapRoutePoolIds, "", "swap route pool ids")
+	fs.String(FlagSwapRouteDenoms, "", "swap route denoms")
+	return fs
+}
+
+func FlagSetSplitRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagSplitRoutesFile, "", "path to a JSON file describing the split routes")
+	return fs
+}
+
+func FlagSetMaxPriceImpact() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagMaxPriceImpact, "", "max relative change of the spot price of each pool of the route, e.g. 0.05 (no bound if unset)")
+	return fs
+}
+
+func FlagSetAllPools() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(FlagAllPools, false, "route through any pool of a denom pair, instead of only its canonical pool if it has one")
+	return fs
+}
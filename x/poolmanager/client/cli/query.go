@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPool)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAllPools)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdSpotPrice)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountIn)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSplitRouteSwapExactAmountIn)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateBestRouteExactAmountIn)
+	cmd.AddCommand(
+		GetCmdNumPools(),
+	)
+
+	return cmd
+}
+
+func GetCmdNumPools() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.NumPoolsRequest](
+		"num-pools",
+		"Query number of pools, of all types",
+		"{{.Short}}",
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdPool() (*osmocli.QueryDescriptor, *types.PoolRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool [poolID]",
+		Short: "Query pool, of any type",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool 1`}, &types.PoolRequest{}
+}
+
+func GetCmdAllPools() (*osmocli.QueryDescriptor, *types.AllPoolsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "all-pools",
+		Short: "Query all pools, of all types",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} all-pools`}, &types.AllPoolsRequest{}
+}
+
+func GetCmdSpotPrice() (*osmocli.QueryDescriptor, *types.SpotPriceRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "spot-price [pool-ID] [base-asset-denom] [quote-asset-denom]",
+		Short: "Query the spot price of the base asset in terms of the quote asset",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} spot-price 1 adym ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2
+`}, &types.SpotPriceRequest{}
+}
+
+func GetCmdEstimateSwapExactAmountIn() (*osmocli.QueryDescriptor, *types.EstimateSwapExactAmountInRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-swap-exact-amount-in [token-in]",
+		Short: "Query the amount out of a multihop swap, taker fee included",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-swap-exact-amount-in 10stake --swap-route-pool-ids=2,3 --swap-route-denoms=adym,uion`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes":         osmocli.FlagOnlyParser(swapAmountInRoutes),
+			"MaxPriceImpact": osmocli.FlagOnlyParser(maxPriceImpact),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetMaxPriceImpact()},
+		},
+	}, &types.EstimateSwapExactAmountInRequest{}
+}
+
+func GetCmdEstimateSwapExactAmountOut() (*osmocli.QueryDescriptor, *types.EstimateSwapExactAmountOutRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-swap-exact-amount-out [token-out]",
+		Short: "Query the amount in of a multihop swap, taker fee included",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-swap-exact-amount-out 10uion --swap-route-pool-ids=2,3 --swap-route-denoms=stake,adym`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes":         osmocli.FlagOnlyParser(swapAmountOutRoutes),
+			"MaxPriceImpact": osmocli.FlagOnlyParser(maxPriceImpact),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetMaxPriceImpact()},
+		},
+	}, &types.EstimateSwapExactAmountOutRequest{}
+}
+
+func GetCmdEstimateSplitRouteSwapExactAmountIn() (*osmocli.QueryDescriptor, *types.EstimateSplitRouteSwapExactAmountInRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-split-route-swap-exact-amount-in [token-in-denom]",
+		Short: "Query the total amount out of a split route swap, taker fees included",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-split-route-swap-exact-amount-in stake --routes-file=routes.json`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes":         osmocli.FlagOnlyParser(splitRoutes),
+			"MaxPriceImpact": osmocli.FlagOnlyParser(maxPriceImpact),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetSplitRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetMaxPriceImpact()},
+		},
+	}, &types.EstimateSplitRouteSwapExactAmountInRequest{}
+}
+
+func GetCmdEstimateBestRouteExactAmountIn() (*osmocli.QueryDescriptor, *types.EstimateBestRouteExactAmountInRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-best-route [token-in] [token-out-denom] [max-hops]",
+		Short: "Query the route of at most max hops pools giving the most token out",
+		Long: `{{.Short}}. The route only goes through the canonical pool of a denom pair if it has one, unless --all-pools is set.{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-best-route 10stake uion 3`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"AllPools": osmocli.FlagOnlyParser(allPools),
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetAllPools()}},
+	}, &types.EstimateBestRouteExactAmountInRequest{}
+}
+
+func allPools(fs *flag.FlagSet) (bool, error) {
+	return fs.GetBool(FlagAllPools)
+}
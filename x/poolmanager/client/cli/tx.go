@@ -0,0 +1,271 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+func NewTxCmd() *cobra.Command {
+	txCmd := osmocli.TxIndexCmd(types.ModuleName)
+	osmocli.AddTxCmd(txCmd, NewSwapExactAmountInCmd)
+	osmocli.AddTxCmd(txCmd, NewSwapExactAmountOutCmd)
+	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountInCmd)
+	osmocli.AddTxCmd(txCmd, NewFlashSwapCmd)
+	return txCmd
+}
+
+func NewSwapExactAmountInCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountIn) {
+	return &osmocli.TxCliDesc{
+		Use:   "swap-exact-amount-in [token-in] [token-out-min-amount]",
+		Short: "swap exact amount in",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes":         osmocli.FlagOnlyParser(swapAmountInRoutes),
+			"MaxPriceImpact": osmocli.FlagOnlyParser(maxPriceImpact),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetMaxPriceImpact()},
+		},
+	}, &types.MsgSwapExactAmountIn{}
+}
+
+func NewSwapExactAmountOutCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountOut) {
+	// The args are out of order with respect to the msg fields, hence the custom parser.
+	return &osmocli.TxCliDesc{
+		Use:              "swap-exact-amount-out [token-out] [token-in-max-amount]",
+		Short:            "swap exact amount out",
+		NumArgs:          2,
+		ParseAndBuildMsg: NewBuildSwapExactAmountOutMsg,
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetMaxPriceImpact()},
+		},
+	}, &types.MsgSwapExactAmountOut{}
+}
+
+func NewSplitRouteSwapExactAmountInCmd() (*osmocli.TxCliDesc, *types.MsgSplitRouteSwapExactAmountIn) {
+	return &osmocli.TxCliDesc{
+		Use:   "split-route-swap-exact-amount-in [token-in-denom] [token-out-min-amount]",
+		Short: "swap exact amount in over several routes at once",
+		Long: `Must provide path to a JSON file (--routes-file) describing the routes and the amount in of each.
+Sample routes JSON file contents:
+[
+	{
+		"pools": [{"pool_id": 1, "token_out_denom": "adym"}, {"pool_id": 2, "token_out_denom": "uion"}],
+		"token_in_amount": "1000"
+	},
+	{
+		"pools": [{"pool_id": 3, "token_out_denom": "uion"}],
+		"token_in_amount": "2000"
+	}
+]
+`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes":         osmocli.FlagOnlyParser(splitRoutes),
+			"MaxPriceImpact": osmocli.FlagOnlyParser(maxPriceImpact),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetSplitRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetMaxPriceImpact()},
+		},
+	}, &types.MsgSplitRouteSwapExactAmountIn{}
+}
+
+func NewFlashSwapCmd() (*osmocli.TxCliDesc, *types.MsgFlashSwap) {
+	return &osmocli.TxCliDesc{
+		Use:   "flash-swap [token-out] [token-in-max-amount] [msgs-tx-json-file]",
+		Short: "borrow token out, execute messages with it and repay it by swapping at most token in max amount",
+		Long: `Lends token-out to the sender from the last pool of the route, executes the messages of the given
+transaction, then swaps at most token-in-max-amount of the sender over the route for token-out to repay
+the loan. Everything is reverted if the loan cannot be repaid.
+The messages must be signed by the sender only. They can be generated with the --generate-only flag, e.g.
+dymd tx poolmanager swap-exact-amount-in 1000uion 1 --swap-route-pool-ids=2 --swap-route-denoms=adym --from=mykey --generate-only > msgs.json
+dymd tx poolmanager flash-swap 1000uion 2000 msgs.json --swap-route-pool-ids=1 --swap-route-denoms=adym --from=mykey`,
+		NumArgs:          3,
+		ParseAndBuildMsg: NewBuildFlashSwapMsg,
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+		},
+	}, &types.MsgFlashSwap{}
+}
+
+// swapRoutePoolIdsAndDenoms parses the pool ids and denoms of the swap route flags.
+func swapRoutePoolIdsAndDenoms(fs *flag.FlagSet) ([]uint64, []string, error) {
+	swapRoutePoolIds, err := fs.GetString(FlagSwapRoutePoolIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	swapRouteDenoms, err := fs.GetString(FlagSwapRouteDenoms)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	swapRoutePoolIdsArray := strings.Split(swapRoutePoolIds, ",")
+	swapRouteDenomsArray := strings.Split(swapRouteDenoms, ",")
+	if len(swapRoutePoolIdsArray) != len(swapRouteDenomsArray) {
+		return nil, nil, errors.New("swap route pool ids and denoms mismatch")
+	}
+
+	poolIds := make([]uint64, 0, len(swapRoutePoolIdsArray))
+	for _, poolIdStr := range swapRoutePoolIdsArray {
+		poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+		if err != nil {
+			return nil, nil, err
+		}
+		poolIds = append(poolIds, poolId)
+	}
+	return poolIds, swapRouteDenomsArray, nil
+}
+
+func swapAmountInRoutes(fs *flag.FlagSet) ([]types.SwapAmountInRoute, error) {
+	poolIds, denoms, err := swapRoutePoolIdsAndDenoms(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]types.SwapAmountInRoute, 0, len(poolIds))
+	for i, poolId := range poolIds {
+		routes = append(routes, types.SwapAmountInRoute{
+			PoolId:        poolId,
+			TokenOutDenom: denoms[i],
+		})
+	}
+	return routes, nil
+}
+
+func swapAmountOutRoutes(fs *flag.FlagSet) ([]types.SwapAmountOutRoute, error) {
+	poolIds, denoms, err := swapRoutePoolIdsAndDenoms(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]types.SwapAmountOutRoute, 0, len(poolIds))
+	for i, poolId := range poolIds {
+		routes = append(routes, types.SwapAmountOutRoute{
+			PoolId:       poolId,
+			TokenInDenom: denoms[i],
+		})
+	}
+	return routes, nil
+}
+
+func splitRoutes(fs *flag.FlagSet) ([]types.SwapAmountInSplitRoute, error) {
+	routesFile, err := fs.GetString(FlagSplitRoutesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if routesFile == "" {
+		return nil, fmt.Errorf("must pass in a routes json using the --%s flag", FlagSplitRoutesFile)
+	}
+
+	contents, err := os.ReadFile(routesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var inputs []splitRouteInputs
+	if err := json.Unmarshal(contents, &inputs); err != nil {
+		return nil, err
+	}
+
+	routes := make([]types.SwapAmountInSplitRoute, 0, len(inputs))
+	for _, input := range inputs {
+		tokenInAmount, ok := sdk.NewIntFromString(input.TokenInAmount)
+		if !ok {
+			return nil, fmt.Errorf("invalid token in amount: %s", input.TokenInAmount)
+		}
+
+		pools := make([]types.SwapAmountInRoute, 0, len(input.Pools))
+		for _, pool := range input.Pools {
+			pools = append(pools, types.SwapAmountInRoute{
+				PoolId:        pool.PoolId,
+				TokenOutDenom: pool.TokenOutDenom,
+			})
+		}
+
+		routes = append(routes, types.SwapAmountInSplitRoute{
+			Pools:         pools,
+			TokenInAmount: tokenInAmount,
+		})
+	}
+	return routes, nil
+}
+
+// maxPriceImpact parses the optional max price impact flag, leaving the bound unset if it is not given.
+func maxPriceImpact(fs *flag.FlagSet) (sdk.Dec, error) {
+	maxPriceImpactStr, err := fs.GetString(FlagMaxPriceImpact)
+	if err != nil || maxPriceImpactStr == "" {
+		return sdk.Dec{}, err
+	}
+	return sdk.NewDecFromStr(maxPriceImpactStr)
+}
+
+func NewBuildSwapExactAmountOutMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	tokenOutStr, tokenInMaxAmountStr := args[0], args[1]
+	routes, err := swapAmountOutRoutes(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOut, err := sdk.ParseCoinNormalized(tokenOutStr)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInMaxAmount, ok := sdk.NewIntFromString(tokenInMaxAmountStr)
+	if !ok {
+		return nil, errors.New("invalid token in max amount")
+	}
+
+	priceImpactBound, err := maxPriceImpact(fs)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSwapExactAmountOut{
+		Sender:           clientCtx.GetFromAddress().String(),
+		Routes:           routes,
+		TokenInMaxAmount: tokenInMaxAmount,
+		TokenOut:         tokenOut,
+		MaxPriceImpact:   priceImpactBound,
+	}, nil
+}
+
+func NewBuildFlashSwapMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	tokenOutStr, tokenInMaxAmountStr, msgsFile := args[0], args[1], args[2]
+	routes, err := swapAmountOutRoutes(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOut, err := sdk.ParseCoinNormalized(tokenOutStr)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInMaxAmount, ok := sdk.NewIntFromString(tokenInMaxAmountStr)
+	if !ok {
+		return nil, errors.New("invalid token in max amount")
+	}
+
+	msgsTx, err := authclient.ReadTxFromFile(clientCtx, msgsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewMsgFlashSwap(clientCtx.GetFromAddress(), routes, tokenInMaxAmount, tokenOut, msgsTx.GetMsgs())
+}
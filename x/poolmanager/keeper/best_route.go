@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// poolEdge is a swap from one denom to tokenOutDenom on a pool.
+type poolEdge struct {
+	pool          types.PoolI
+	tokenOutDenom string
+}
+
+// BestRoute is a route found by the best route finder, with its estimated amount out
+// and price impact.
+type BestRoute struct {
+	Routes         []types.SwapAmountInRoute
+	TokenOutAmount sdk.Int
+	PriceImpact    sdk.Dec
+}
+
+// FindBestRouteExactAmountIn searches the active pools for the route of at most maxHops pools
+// that gives the most tokenOutDenom for tokenIn, with the taker fee subtracted from tokenIn.
+// Each candidate route is simulated with CalcOutAmtGivenIn on the current pool state.
+// Unless allPools is set, swaps between the denoms of a pair with a canonical pool only go
+// through that pool. Only the pools of the modules indexing their pools by denom are searched.
+//
+// The search is deterministic: pools are visited in increasing pool id order, ties on the amount
+// out are broken in favor of the shortest route, then of the first route found. At most
+// MaxBestRoutePoolsLoaded pools are loaded and MaxBestRoutePathsExplored paths explored, so the
+// gas consumed is bounded.
+func (k Keeper) FindBestRouteExactAmountIn(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops uint32,
+	allPools bool,
+) (BestRoute, error) {
+	if maxHops == 0 || maxHops > types.MaxBestRouteHops {
+		return BestRoute{}, types.InvalidMaxHopsError{MaxHops: maxHops}
+	}
+	if tokenIn.Denom == tokenOutDenom {
+		return BestRoute{}, types.ErrSameTokenInAndOut
+	}
+
+	graph, err := k.buildPoolGraph(ctx, tokenIn.Denom, int(maxHops), allPools)
+	if err != nil {
+		return BestRoute{}, err
+	}
+	candidates := findCandidateRoutes(graph, tokenIn.Denom, tokenOutDenom, int(maxHops))
+
+	// the price impact is computed on the amount actually swapped, after the taker fee.
+	takerFee := k.takerFeeKeeper.GetTakerFee(ctx)
+	tokenInAfterSubTakerFee, _ := k.takerFeeKeeper.SubTakerFee(tokenIn, takerFee)
+
+	var best BestRoute
+	found := false
+	for _, candidate := range candidates {
+		routes := make([]types.SwapAmountInRoute, len(candidate))
+		for i, edge := range candidate {
+			routes[i] = types.SwapAmountInRoute{PoolId: edge.pool.GetId(), TokenOutDenom: edge.tokenOutDenom}
+		}
+
+		// Routes that cannot be swapped, e.g. due to lack of liquidity, are skipped.
+		tokenOutAmount, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, routes, tokenInAfterSubTakerFee)
+		if err != nil {
+			continue
+		}
+
+		if found && !tokenOutAmount.GT(best.TokenOutAmount) &&
+			!(tokenOutAmount.Equal(best.TokenOutAmount) && len(routes) < len(best.Routes)) {
+			continue
+		}
+
+		priceImpact, err := routePriceImpact(ctx, candidate, tokenInAfterSubTakerFee, tokenOutAmount)
+		if err != nil {
+			continue
+		}
+
+		best = BestRoute{Routes: routes, TokenOutAmount: tokenOutAmount, PriceImpact: priceImpact}
+		found = true
+	}
+
+	if !found {
+		return BestRoute{}, types.NoRouteFoundError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom, MaxHops: maxHops}
+	}
+	return best, nil
+}
+
+// buildPoolGraph returns, for each denom, the swaps out of it on the active pools reachable
+// from tokenInDenom in at most maxHops swaps, ordered by pool id and token out denom. The pools
+// are found through the denom indexes of the pool modules, expanding outward from tokenInDenom
+// one hop at a time, and at most MaxBestRoutePoolsLoaded pools are loaded. Unless allPools is
+// set, the swaps between the denoms of a pair with a canonical pool are only on that pool.
+func (k Keeper) buildPoolGraph(ctx sdk.Context, tokenInDenom string, maxHops int, allPools bool) (map[string][]poolEdge, error) {
+	graph := make(map[string][]poolEdge)
+	loadedPools := make(map[uint64]bool)
+	reachedDenoms := map[string]bool{tokenInDenom: true}
+	frontier := []string{tokenInDenom}
+
+loadPools:
+	for hop := 0; hop < maxHops && len(frontier) > 0; hop++ {
+		var nextFrontier []string
+		for _, denom := range frontier {
+			for _, poolId := range k.getDenomPoolIds(ctx, denom) {
+				if loadedPools[poolId] {
+					continue
+				}
+				if len(loadedPools) >= types.MaxBestRoutePoolsLoaded {
+					break loadPools
+				}
+				loadedPools[poolId] = true
+
+				poolDenoms, err := k.addPoolEdges(ctx, graph, poolId, allPools)
+				if err != nil {
+					return nil, err
+				}
+				for _, poolDenom := range poolDenoms {
+					if !reachedDenoms[poolDenom] {
+						reachedDenoms[poolDenom] = true
+						nextFrontier = append(nextFrontier, poolDenom)
+					}
+				}
+			}
+		}
+		frontier = nextFrontier
+	}
+
+	// pools are loaded in the order they are reached, the swaps out of each denom are visited
+	// in pool id order. The swaps out of a pool are already ordered by token out denom.
+	for _, edges := range graph {
+		sort.SliceStable(edges, func(i, j int) bool {
+			return edges[i].pool.GetId() < edges[j].pool.GetId()
+		})
+	}
+	return graph, nil
+}
+
+// getDenomPoolIds returns the ids of the pools holding the given denom, in increasing order,
+// across the pool modules indexing their pools by denom.
+func (k Keeper) getDenomPoolIds(ctx sdk.Context, denom string) []uint64 {
+	var poolIds []uint64
+	for _, swapModule := range []types.SwapI{k.gammKeeper, k.concentratedKeeper, k.orderbookKeeper} {
+		if index, ok := swapModule.(types.DenomPoolIndex); ok {
+			poolIds = append(poolIds, index.GetDenomPoolIds(ctx, denom)...)
+		}
+	}
+	// pool ids are unique across modules
+	sort.Slice(poolIds, func(i, j int) bool { return poolIds[i] < poolIds[j] })
+	return poolIds
+}
+
+// addPoolEdges adds the swaps on the given pool to the graph if it is active, and returns the
+// denoms it holds.
+func (k Keeper) addPoolEdges(ctx sdk.Context, graph map[string][]poolEdge, poolId uint64, allPools bool) ([]string, error) {
+	swapModule, err := k.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := swapModule.GetPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	if !pool.IsActive(ctx) {
+		return nil, nil
+	}
+	canonicalPoolKeeper, hasCanonicalPools := swapModule.(types.CanonicalPoolKeeper)
+
+	// Coins are sorted by denom and only hold positive amounts.
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+	denoms := make([]string, 0, len(liquidity))
+	for _, coinIn := range liquidity {
+		denoms = append(denoms, coinIn.Denom)
+		for _, coinOut := range liquidity {
+			if coinIn.Denom == coinOut.Denom {
+				continue
+			}
+			if !allPools && hasCanonicalPools {
+				canonicalPoolId, found := canonicalPoolKeeper.GetCanonicalPoolId(ctx, coinIn.Denom, coinOut.Denom)
+				if found && canonicalPoolId != pool.GetId() {
+					continue
+				}
+			}
+			graph[coinIn.Denom] = append(graph[coinIn.Denom], poolEdge{pool: pool, tokenOutDenom: coinOut.Denom})
+		}
+	}
+	return denoms, nil
+}
+
+// findCandidateRoutes returns the routes of at most maxHops pools from tokenInDenom to
+// tokenOutDenom that do not visit a denom or a pool twice, exploring at most
+// MaxBestRoutePathsExplored paths.
+func findCandidateRoutes(graph map[string][]poolEdge, tokenInDenom, tokenOutDenom string, maxHops int) [][]poolEdge {
+	var (
+		candidates    [][]poolEdge
+		path          []poolEdge
+		pathsExplored int
+	)
+	visitedDenoms := map[string]bool{tokenInDenom: true}
+	usedPools := map[uint64]bool{}
+
+	var search func(denom string)
+	search = func(denom string) {
+		for _, edge := range graph[denom] {
+			if pathsExplored >= types.MaxBestRoutePathsExplored {
+				return
+			}
+			if visitedDenoms[edge.tokenOutDenom] || usedPools[edge.pool.GetId()] {
+				continue
+			}
+			pathsExplored++
+
+			path = append(path, edge)
+			if edge.tokenOutDenom == tokenOutDenom {
+				candidate := make([]poolEdge, len(path))
+				copy(candidate, path)
+				candidates = append(candidates, candidate)
+			} else if len(path) < maxHops {
+				visitedDenoms[edge.tokenOutDenom] = true
+				usedPools[edge.pool.GetId()] = true
+				search(edge.tokenOutDenom)
+				visitedDenoms[edge.tokenOutDenom] = false
+				usedPools[edge.pool.GetId()] = false
+			}
+			path = path[:len(path)-1]
+		}
+	}
+	search(tokenInDenom)
+
+	return candidates
+}
+
+// routePriceImpact returns 1 - tokenOutAmount / spotTokenOutAmount, where spotTokenOutAmount is
+// the amount out of tokenIn at the spot prices of the pools of the route.
+func routePriceImpact(ctx sdk.Context, route []poolEdge, tokenIn sdk.Coin, tokenOutAmount sdk.Int) (sdk.Dec, error) {
+	spotTokenOut := sdk.NewDecFromInt(tokenIn.Amount)
+	tokenInDenom := tokenIn.Denom
+	for _, edge := range route {
+		// price of the token out in terms of the token in
+		spotPrice, err := edge.pool.SpotPrice(ctx, tokenInDenom, edge.tokenOutDenom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		if !spotPrice.IsPositive() {
+			return sdk.Dec{}, types.ErrInvalidPool
+		}
+		spotTokenOut = spotTokenOut.Quo(spotPrice)
+		tokenInDenom = edge.tokenOutDenom
+	}
+
+	if !spotTokenOut.IsPositive() {
+		return sdk.Dec{}, types.ErrInvalidPool
+	}
+	priceImpact := sdk.OneDec().Sub(sdk.NewDecFromInt(tokenOutAmount).Quo(spotTokenOut))
+	if priceImpact.IsNegative() {
+		// rounding of the spot prices
+		return sdk.ZeroDec(), nil
+	}
+	return priceImpact, nil
+}
@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+func (suite *KeeperTestSuite) prepareBalancerPool(coins ...sdk.Coin) uint64 {
+	suite.FundAcc(suite.TestAccs[0], coins)
+	return suite.PrepareBalancerPoolWithCoins(coins...)
+}
+
+func (suite *KeeperTestSuite) TestFindBestRouteExactAmountIn() {
+	tests := map[string]struct {
+		tokenIn        sdk.Coin
+		tokenOutDenom  string
+		maxHops        uint32
+		expectedRoutes func(shallowPoolId uint64, deepPoolIds []uint64) []types.SwapAmountInRoute
+		expectedError  error
+	}{
+		"deep two hop route gives more than a shallow direct pool": {
+			tokenIn:       sdk.NewCoin(baseDenom, sdk.NewInt(5000)),
+			tokenOutDenom: fooDenom,
+			maxHops:       2,
+			expectedRoutes: func(_ uint64, deepPoolIds []uint64) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: deepPoolIds[0], TokenOutDenom: barDenom}, {PoolId: deepPoolIds[1], TokenOutDenom: fooDenom}}
+			},
+		},
+		"one hop only considers direct pools": {
+			tokenIn:       sdk.NewCoin(baseDenom, sdk.NewInt(5000)),
+			tokenOutDenom: fooDenom,
+			maxHops:       1,
+			expectedRoutes: func(shallowPoolId uint64, _ []uint64) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: shallowPoolId, TokenOutDenom: fooDenom}}
+			},
+		},
+		"intermediate denom reached directly": {
+			tokenIn:       sdk.NewCoin(baseDenom, sdk.NewInt(5000)),
+			tokenOutDenom: barDenom,
+			maxHops:       3,
+			expectedRoutes: func(_ uint64, deepPoolIds []uint64) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: deepPoolIds[0], TokenOutDenom: barDenom}}
+			},
+		},
+		"error: no pool with the token out": {
+			tokenIn:       sdk.NewCoin(baseDenom, sdk.NewInt(5000)),
+			tokenOutDenom: "baz",
+			maxHops:       3,
+			expectedError: types.NoRouteFoundError{TokenInDenom: baseDenom, TokenOutDenom: "baz", MaxHops: 3},
+		},
+		"error: zero max hops": {
+			tokenIn:       sdk.NewCoin(baseDenom, sdk.NewInt(5000)),
+			tokenOutDenom: fooDenom,
+			maxHops:       0,
+			expectedError: types.InvalidMaxHopsError{MaxHops: 0},
+		},
+		"error: too many max hops": {
+			tokenIn:       sdk.NewCoin(baseDenom, sdk.NewInt(5000)),
+			tokenOutDenom: fooDenom,
+			maxHops:       types.MaxBestRouteHops + 1,
+			expectedError: types.InvalidMaxHopsError{MaxHops: types.MaxBestRouteHops + 1},
+		},
+		"error: same token in and out": {
+			tokenIn:       sdk.NewCoin(baseDenom, sdk.NewInt(5000)),
+			tokenOutDenom: baseDenom,
+			maxHops:       2,
+			expectedError: types.ErrSameTokenInAndOut,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			shallowPoolId := suite.prepareBalancerPool(sdk.NewCoin(baseDenom, sdk.NewInt(10000)), sdk.NewCoin(fooDenom, sdk.NewInt(10000)))
+			deepPoolIds := []uint64{
+				suite.prepareBalancerPool(sdk.NewCoin(baseDenom, sdk.NewInt(1000000)), sdk.NewCoin(barDenom, sdk.NewInt(1000000))),
+				suite.prepareBalancerPool(sdk.NewCoin(barDenom, sdk.NewInt(1000000)), sdk.NewCoin(fooDenom, sdk.NewInt(1000000))),
+			}
+
+			bestRoute, err := suite.App.PoolManagerKeeper.FindBestRouteExactAmountIn(suite.Ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxHops, false)
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedRoutes(shallowPoolId, deepPoolIds), bestRoute.Routes)
+
+			// the amount out is the estimate of the route, taker fee subtracted.
+			takerFee := suite.App.GAMMKeeper.GetTakerFee(suite.Ctx)
+			tokenInAfterTakerFee, _ := suite.App.GAMMKeeper.SubTakerFee(tc.tokenIn, takerFee)
+			expectedTokenOut, err := suite.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(suite.Ctx, bestRoute.Routes, tokenInAfterTakerFee)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenOut.String(), bestRoute.TokenOutAmount.String())
+
+			// swap fees and slippage make the amount out lower than at the spot price.
+			suite.Require().True(bestRoute.PriceImpact.IsPositive())
+			suite.Require().True(bestRoute.PriceImpact.LT(sdk.OneDec()))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFindBestRouteIsDeterministic() {
+	suite.SetupTest()
+	// two identical pools give the same amount out, the first one is picked.
+	poolCoins := []sdk.Coin{sdk.NewCoin(baseDenom, sdk.NewInt(1000000)), sdk.NewCoin(fooDenom, sdk.NewInt(1000000))}
+	firstPoolId := suite.prepareBalancerPool(poolCoins...)
+	suite.prepareBalancerPool(poolCoins...)
+
+	for i := 0; i < 3; i++ {
+		bestRoute, err := suite.App.PoolManagerKeeper.FindBestRouteExactAmountIn(suite.Ctx, sdk.NewCoin(baseDenom, sdk.NewInt(5000)), fooDenom, 2, true)
+		suite.Require().NoError(err)
+		suite.Require().Equal([]types.SwapAmountInRoute{{PoolId: firstPoolId, TokenOutDenom: fooDenom}}, bestRoute.Routes)
+	}
+}
+
+func (suite *KeeperTestSuite) TestFindBestRouteUsesCanonicalPools() {
+	suite.SetupTest()
+	// the shallow pool is the canonical pool of the pair, as the first one created.
+	shallowPoolId := suite.prepareBalancerPool(sdk.NewCoin(baseDenom, sdk.NewInt(10000)), sdk.NewCoin(fooDenom, sdk.NewInt(10000)))
+	deepPoolId := suite.prepareBalancerPool(sdk.NewCoin(baseDenom, sdk.NewInt(1000000)), sdk.NewCoin(fooDenom, sdk.NewInt(1000000)))
+	tokenIn := sdk.NewCoin(baseDenom, sdk.NewInt(5000))
+
+	bestRoute, err := suite.App.PoolManagerKeeper.FindBestRouteExactAmountIn(suite.Ctx, tokenIn, fooDenom, 2, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SwapAmountInRoute{{PoolId: shallowPoolId, TokenOutDenom: fooDenom}}, bestRoute.Routes)
+
+	bestRoute, err = suite.App.PoolManagerKeeper.FindBestRouteExactAmountIn(suite.Ctx, tokenIn, fooDenom, 2, true)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SwapAmountInRoute{{PoolId: deepPoolId, TokenOutDenom: fooDenom}}, bestRoute.Routes)
+
+	err = suite.App.GAMMKeeper.SetCanonicalPool(suite.Ctx, deepPoolId, baseDenom, fooDenom)
+	suite.Require().NoError(err)
+	bestRoute, err = suite.App.PoolManagerKeeper.FindBestRouteExactAmountIn(suite.Ctx, tokenIn, fooDenom, 2, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SwapAmountInRoute{{PoolId: deepPoolId, TokenOutDenom: fooDenom}}, bestRoute.Routes)
+}
+
+func (suite *KeeperTestSuite) TestFindBestRouteSkipsRoutesWithNoAmountOut() {
+	suite.SetupTest()
+	// the only pool holds too little foo for the swap to give any, so it cannot be routed.
+	suite.prepareBalancerPool(sdk.NewCoin(baseDenom, sdk.NewInt(1000000)), sdk.NewCoin(fooDenom, sdk.NewInt(1)))
+
+	_, err := suite.App.PoolManagerKeeper.FindBestRouteExactAmountIn(suite.Ctx, sdk.NewCoin(baseDenom, sdk.NewInt(100)), fooDenom, 2, false)
+	suite.Require().ErrorIs(err, types.NoRouteFoundError{TokenInDenom: baseDenom, TokenOutDenom: fooDenom, MaxHops: 2})
+}
+
+func (suite *KeeperTestSuite) TestFindBestRouteOnlyLoadsReachablePools() {
+	suite.SetupTest()
+	suite.prepareBalancerPool(sdk.NewCoin(baseDenom, sdk.NewInt(1000000)), sdk.NewCoin(fooDenom, sdk.NewInt(1000000)))
+	tokenIn := sdk.NewCoin(baseDenom, sdk.NewInt(5000))
+
+	gasConsumed := func() sdk.Gas {
+		ctx := suite.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err := suite.App.PoolManagerKeeper.FindBestRouteExactAmountIn(ctx, tokenIn, fooDenom, 2, false)
+		suite.Require().NoError(err)
+		return ctx.GasMeter().GasConsumed()
+	}
+	gasBefore := gasConsumed()
+
+	// pools unreachable from the token in are not loaded, so they do not add to the gas consumed.
+	for i := 0; i < 5; i++ {
+		suite.prepareBalancerPool(sdk.NewCoin(barDenom, sdk.NewInt(1000000)), sdk.NewCoin("baz", sdk.NewInt(1000000)))
+	}
+	suite.Require().Equal(gasBefore, gasConsumed())
+}
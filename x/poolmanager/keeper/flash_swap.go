@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// FlashSwap lends tokenOut to the sender from the last pool of the routes and executes the given
+// messages. It then estimates with CalcInAmtGivenOut the amount in the routes require for tokenOut,
+// swap fees and taker fee included, and swaps it from the sender for tokenOut, which repays the loan.
+// The amount in is estimated once the messages are executed, at the state the repaying swap runs at.
+// All state changes are reverted if the messages fail or the amount in exceeds tokenInMaxAmount.
+// Returns the amount in spent and the responses of the messages.
+func (k Keeper) FlashSwap(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountOutRoute,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	msgs []sdk.Msg,
+) (tokenInAmount sdk.Int, msgResponses []*codectypes.Any, err error) {
+	if err := types.SwapAmountOutRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, nil, err
+	}
+
+	lendingPool, err := k.GetPool(ctx, routes[len(routes)-1].PoolId)
+	if err != nil {
+		return sdk.Int{}, nil, err
+	}
+
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		loan := sdk.NewCoins(tokenOut)
+		if err := k.bankKeeper.SendCoins(ctx, lendingPool.GetAddress(), sender, loan); err != nil {
+			return err
+		}
+
+		msgResponses, err = k.executeFlashSwapMsgs(ctx, sender, msgs)
+		if err != nil {
+			return err
+		}
+
+		tokenInRequired, err := k.MultihopEstimateInGivenExactAmountOutWithTakerFee(ctx, routes, tokenOut, sdk.Dec{})
+		if err != nil {
+			return err
+		}
+		if tokenInRequired.GT(tokenInMaxAmount) {
+			return types.FlashSwapNotRepaidError{TokenInRequired: sdk.NewCoin(routes[0].TokenInDenom, tokenInRequired), TokenInMaxAmount: tokenInMaxAmount}
+		}
+
+		tokenInAmount, err = k.SwapExactAmountOutWithTakerFee(ctx, sender, routes, tokenInMaxAmount, tokenOut, sdk.Dec{})
+		if err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoins(ctx, sender, lendingPool.GetAddress(), loan)
+	})
+	if err != nil {
+		return sdk.Int{}, nil, err
+	}
+
+	return tokenInAmount, msgResponses, nil
+}
+
+// executeFlashSwapMsgs executes the messages of a flash swap through the message router,
+// in order, and returns their responses.
+func (k Keeper) executeFlashSwapMsgs(ctx sdk.Context, sender sdk.AccAddress, msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	msgResponses := []*codectypes.Any{}
+	for i, msg := range msgs {
+		if isFlashSwap, err := types.IsFlashSwap(msg); err != nil {
+			return nil, err
+		} else if isFlashSwap {
+			return nil, types.ErrNestedFlashSwap
+		}
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(sender) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidFlashSwapMsg, "message %d is signed by %v", i, signers)
+		}
+
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute message %d (%s): %w", i, sdk.MsgTypeURL(msg), err)
+		}
+
+		// the handler emits its events in a new event manager and returns them.
+		ctx.EventManager().EmitEvents(res.GetEvents())
+		msgResponses = append(msgResponses, res.MsgResponses...)
+	}
+	return msgResponses, nil
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// TestFlashSwap tests flash swaps arbitraging between a pool selling foo at 0.5 base and
+// a pool buying foo at 2 base, by a sender with no funds.
+func (suite *KeeperTestSuite) TestFlashSwap() {
+	tokenOut := sdk.NewCoin(fooDenom, sdk.NewInt(100000))
+
+	tests := map[string]struct {
+		tokenInMaxAmount sdk.Int
+		// followUpMsgs returns the messages executed with the borrowed foo.
+		followUpMsgs    func(sender, other sdk.AccAddress, buyingPoolId uint64) []sdk.Msg
+		expectedErr     error
+		expectNotRepaid bool
+		expectErr       bool
+	}{
+		"arbitrage repays the flash swap": {
+			tokenInMaxAmount: sdk.NewInt(100000),
+			followUpMsgs: func(sender, _ sdk.AccAddress, buyingPoolId uint64) []sdk.Msg {
+				return []sdk.Msg{&types.MsgSwapExactAmountIn{
+					Sender:            sender.String(),
+					Routes:            []types.SwapAmountInRoute{{PoolId: buyingPoolId, TokenOutDenom: baseDenom}},
+					TokenIn:           tokenOut,
+					TokenOutMinAmount: sdk.OneInt(),
+				}}
+			},
+		},
+		"error: amount in above the max amount in": {
+			tokenInMaxAmount: sdk.NewInt(1000),
+			followUpMsgs: func(sender, _ sdk.AccAddress, buyingPoolId uint64) []sdk.Msg {
+				return []sdk.Msg{&types.MsgSwapExactAmountIn{
+					Sender:            sender.String(),
+					Routes:            []types.SwapAmountInRoute{{PoolId: buyingPoolId, TokenOutDenom: baseDenom}},
+					TokenIn:           tokenOut,
+					TokenOutMinAmount: sdk.OneInt(),
+				}}
+			},
+			expectNotRepaid: true,
+		},
+		"error: sender cannot repay": {
+			tokenInMaxAmount: sdk.NewInt(100000),
+			followUpMsgs: func(sender, other sdk.AccAddress, _ uint64) []sdk.Msg {
+				return []sdk.Msg{banktypes.NewMsgSend(sender, other, sdk.NewCoins(tokenOut))}
+			},
+			expectErr: true,
+		},
+		"error: message signed by another account": {
+			tokenInMaxAmount: sdk.NewInt(100000),
+			followUpMsgs: func(_, other sdk.AccAddress, _ uint64) []sdk.Msg {
+				return []sdk.Msg{banktypes.NewMsgSend(other, other, sdk.NewCoins(tokenOut))}
+			},
+			expectedErr: types.ErrInvalidFlashSwapMsg,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			sellingPoolId := suite.prepareBalancerPool(sdk.NewCoin(baseDenom, sdk.NewInt(1000000)), sdk.NewCoin(fooDenom, sdk.NewInt(2000000)))
+			buyingPoolId := suite.prepareBalancerPool(sdk.NewCoin(baseDenom, sdk.NewInt(2000000)), sdk.NewCoin(fooDenom, sdk.NewInt(1000000)))
+			sender, other := suite.TestAccs[1], suite.TestAccs[2]
+			suite.FundAcc(other, sdk.NewCoins(tokenOut))
+			sellingPool, err := suite.App.PoolManagerKeeper.GetPool(suite.Ctx, sellingPoolId)
+			suite.Require().NoError(err)
+			sellingPoolBalances := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sellingPool.GetAddress())
+			otherBalances := suite.App.BankKeeper.GetAllBalances(suite.Ctx, other)
+
+			routes := []types.SwapAmountOutRoute{{PoolId: sellingPoolId, TokenInDenom: baseDenom}}
+			msg, err := types.NewMsgFlashSwap(sender, routes, tc.tokenInMaxAmount, tokenOut, tc.followUpMsgs(sender, other, buyingPoolId))
+			suite.Require().NoError(err)
+
+			res, err := keeper.NewMsgServerImpl(suite.App.PoolManagerKeeper).FlashSwap(sdk.WrapSDKContext(suite.Ctx), msg)
+			if tc.expectedErr != nil || tc.expectNotRepaid || tc.expectErr {
+				suite.Require().Error(err)
+				if tc.expectedErr != nil {
+					suite.Require().ErrorIs(err, tc.expectedErr)
+				}
+				if tc.expectNotRepaid {
+					var notRepaidErr types.FlashSwapNotRepaidError
+					suite.Require().ErrorAs(err, &notRepaidErr)
+					suite.Require().True(notRepaidErr.TokenInRequired.Amount.GT(tc.tokenInMaxAmount))
+				}
+
+				// nothing is lent nor sent.
+				suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender).IsZero())
+				suite.Require().Equal(otherBalances, suite.App.BankKeeper.GetAllBalances(suite.Ctx, other))
+				suite.Require().Equal(sellingPoolBalances, suite.App.BankKeeper.GetAllBalances(suite.Ctx, sellingPool.GetAddress()))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(res.MsgResponses, 1)
+
+			// the sender keeps the profit of the arbitrage, and the pool lending foo is repaid in base.
+			senderBalances := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Require().True(senderBalances.AmountOf(fooDenom).IsZero())
+			suite.Require().True(senderBalances.AmountOf(baseDenom).IsPositive())
+
+			sellingPool, err = suite.App.PoolManagerKeeper.GetPool(suite.Ctx, sellingPoolId)
+			suite.Require().NoError(err)
+			poolBalances := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sellingPool.GetAddress())
+			suite.Require().True(poolBalances.AmountOf(fooDenom).LT(sellingPoolBalances.AmountOf(fooDenom)))
+			suite.Require().True(poolBalances.AmountOf(baseDenom).GT(sellingPoolBalances.AmountOf(baseDenom)))
+			// the loan is repaid in full, so the pool holds the liquidity it accounts for.
+			suite.Require().Equal(sellingPool.GetTotalPoolLiquidity(suite.Ctx), poolBalances)
+		})
+	}
+}
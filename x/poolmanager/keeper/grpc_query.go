@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/poolmanager keeper providing gRPC method
+// handlers.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+// NumPools returns the number of pools created, of all types.
+func (q Querier) NumPools(ctx context.Context, _ *types.NumPoolsRequest) (*types.NumPoolsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.NumPoolsResponse{
+		NumPools: q.Keeper.GetNextPoolId(sdkCtx) - 1,
+	}, nil
+}
+
+// Pool returns the pool with the given id, whichever module owns it.
+func (q Querier) Pool(ctx context.Context, req *types.PoolRequest) (*types.PoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.GetPool(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	any, err := codectypes.NewAnyWithValue(pool)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.PoolResponse{Pool: any}, nil
+}
+
+// AllPools returns all the pools, of all types, ordered by pool id.
+func (q Querier) AllPools(ctx context.Context, _ *types.AllPoolsRequest) (*types.AllPoolsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pools, err := q.Keeper.GetAllPools(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	anys := make([]*codectypes.Any, 0, len(pools))
+	for _, pool := range pools {
+		any, err := codectypes.NewAnyWithValue(pool)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		anys = append(anys, any)
+	}
+
+	return &types.AllPoolsResponse{Pools: anys}, nil
+}
+
+// SpotPrice returns the spot price of the base asset in terms of the quote asset in the given pool.
+func (q Querier) SpotPrice(ctx context.Context, req *types.SpotPriceRequest) (*types.SpotPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.BaseAssetDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid base asset denom")
+	}
+
+	if req.QuoteAssetDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid quote asset denom")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	spotPrice, err := q.Keeper.RouteCalculateSpotPrice(sdkCtx, req.PoolId, req.QuoteAssetDenom, req.BaseAssetDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.SpotPriceResponse{
+		SpotPrice: spotPrice.String(),
+	}, nil
+}
+
+// EstimateSwapExactAmountIn estimates the token output amount of a multihop swap, taker fee included.
+func (q Querier) EstimateSwapExactAmountIn(ctx context.Context, req *types.EstimateSwapExactAmountInRequest) (*types.EstimateSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	if err := types.ValidateMaxPriceImpact(req.MaxPriceImpact); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	tokenOutAmount, err := q.Keeper.MultihopEstimateOutGivenExactAmountInWithTakerFee(sdkCtx, req.Routes, tokenIn, req.MaxPriceImpact)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.EstimateSwapExactAmountInResponse{
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
+// EstimateSwapExactAmountOut estimates the token input amount of a multihop swap, taker fee included.
+func (q Querier) EstimateSwapExactAmountOut(ctx context.Context, req *types.EstimateSwapExactAmountOutRequest) (*types.EstimateSwapExactAmountOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TokenOut == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	tokenOut, err := sdk.ParseCoinNormalized(req.TokenOut)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	if err := types.ValidateMaxPriceImpact(req.MaxPriceImpact); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	tokenInAmount, err := q.Keeper.MultihopEstimateInGivenExactAmountOutWithTakerFee(sdkCtx, req.Routes, tokenOut, req.MaxPriceImpact)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.EstimateSwapExactAmountOutResponse{
+		TokenInAmount: tokenInAmount,
+	}, nil
+}
+
+// EstimateSplitRouteSwapExactAmountIn estimates the total token output amount of a split route swap.
+func (q Querier) EstimateSplitRouteSwapExactAmountIn(ctx context.Context, req *types.EstimateSplitRouteSwapExactAmountInRequest) (*types.EstimateSplitRouteSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.TokenInDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token in denom: %s", err.Error())
+	}
+
+	if err := types.ValidateMaxPriceImpact(req.MaxPriceImpact); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	tokenOutAmount, err := q.Keeper.MultihopEstimateOutGivenExactAmountInSplitRoute(sdkCtx, req.Routes, req.TokenInDenom, req.MaxPriceImpact)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.EstimateSplitRouteSwapExactAmountInResponse{
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
+// EstimateBestRouteExactAmountIn returns the route of at most max hops pools giving the most
+// token out for the token in, with its estimated amount out and price impact.
+func (q Querier) EstimateBestRouteExactAmountIn(ctx context.Context, req *types.EstimateBestRouteExactAmountInRequest) (*types.EstimateBestRouteExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.TokenOutDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token out denom: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	bestRoute, err := q.Keeper.FindBestRouteExactAmountIn(sdkCtx, tokenIn, req.TokenOutDenom, req.MaxHops, req.AllPools)
+	if errors.As(err, &types.NoRouteFoundError{}) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.EstimateBestRouteExactAmountInResponse{
+		Routes:         bestRoute.Routes,
+		TokenOutAmount: bestRoute.TokenOutAmount,
+		PriceImpact:    bestRoute.PriceImpact,
+	}, nil
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryPools() {
+	suite.SetupTest()
+	querier := keeper.NewQuerier(*suite.App.PoolManagerKeeper)
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+
+	numPoolsRes, err := querier.NumPools(ctx, &types.NumPoolsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), numPoolsRes.NumPools)
+
+	balancerPoolId := suite.PrepareBalancerPool()
+	clPool := suite.PrepareConcentratedPool()
+
+	numPoolsRes, err = querier.NumPools(ctx, &types.NumPoolsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), numPoolsRes.NumPools)
+
+	// pools of any type are returned through the route map.
+	for _, poolId := range []uint64{balancerPoolId, clPool.GetId()} {
+		poolRes, err := querier.Pool(ctx, &types.PoolRequest{PoolId: poolId})
+		suite.Require().NoError(err)
+
+		var pool types.PoolI
+		err = suite.App.InterfaceRegistry().UnpackAny(poolRes.Pool, &pool)
+		suite.Require().NoError(err)
+		suite.Require().Equal(poolId, pool.GetId())
+	}
+
+	_, err = querier.Pool(ctx, &types.PoolRequest{PoolId: 3})
+	suite.Require().Error(err)
+
+	allPoolsRes, err := querier.AllPools(ctx, &types.AllPoolsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(allPoolsRes.Pools, 2)
+	for i, poolAny := range allPoolsRes.Pools {
+		var pool types.PoolI
+		err = suite.App.InterfaceRegistry().UnpackAny(poolAny, &pool)
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(i+1), pool.GetId())
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerySpotPrice() {
+	tests := map[string]struct {
+		req           *types.SpotPriceRequest
+		expectedPrice string
+		expectError   bool
+	}{
+		"base in terms of quote": {
+			req:           &types.SpotPriceRequest{PoolId: 1, BaseAssetDenom: baseDenom, QuoteAssetDenom: fooDenom},
+			expectedPrice: sdk.NewDec(2).String(),
+		},
+		"quote in terms of base": {
+			req:           &types.SpotPriceRequest{PoolId: 1, BaseAssetDenom: fooDenom, QuoteAssetDenom: baseDenom},
+			expectedPrice: sdk.NewDecWithPrec(5, 1).String(),
+		},
+		"error: empty base asset denom": {
+			req:         &types.SpotPriceRequest{PoolId: 1, QuoteAssetDenom: fooDenom},
+			expectError: true,
+		},
+		"error: empty quote asset denom": {
+			req:         &types.SpotPriceRequest{PoolId: 1, BaseAssetDenom: baseDenom},
+			expectError: true,
+		},
+		"error: pool does not exist": {
+			req:         &types.SpotPriceRequest{PoolId: 2, BaseAssetDenom: baseDenom, QuoteAssetDenom: fooDenom},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.prepareBalancerPool(sdk.NewCoin(baseDenom, sdk.NewInt(1000000)), sdk.NewCoin(fooDenom, sdk.NewInt(2000000)))
+			querier := keeper.NewQuerier(*suite.App.PoolManagerKeeper)
+
+			res, err := querier.SpotPrice(sdk.WrapSDKContext(suite.Ctx), tc.req)
+			if tc.expectError {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedPrice, res.SpotPrice)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryEstimateSwaps() {
+	suite.SetupTest()
+	poolId := suite.prepareTakerFeePool()
+	querier := keeper.NewQuerier(*suite.App.PoolManagerKeeper)
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+
+	tokenIn := sdk.NewCoin(baseDenom, sdk.NewInt(10000))
+	inRoutes := []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: fooDenom}}
+	expectedOut, err := suite.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountInWithTakerFee(suite.Ctx, inRoutes, tokenIn, sdk.Dec{})
+	suite.Require().NoError(err)
+
+	inRes, err := querier.EstimateSwapExactAmountIn(ctx, &types.EstimateSwapExactAmountInRequest{TokenIn: tokenIn.String(), Routes: inRoutes})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedOut.String(), inRes.TokenOutAmount.String())
+
+	// the taker fee is charged, so the estimate is below the plain pool estimate.
+	noFeeOut, err := suite.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(suite.Ctx, inRoutes, tokenIn)
+	suite.Require().NoError(err)
+	suite.Require().True(inRes.TokenOutAmount.LT(noFeeOut))
+
+	tokenOut := sdk.NewCoin(fooDenom, sdk.NewInt(10000))
+	outRoutes := []types.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: baseDenom}}
+	expectedIn, err := suite.App.PoolManagerKeeper.MultihopEstimateInGivenExactAmountOutWithTakerFee(suite.Ctx, outRoutes, tokenOut, sdk.Dec{})
+	suite.Require().NoError(err)
+
+	outRes, err := querier.EstimateSwapExactAmountOut(ctx, &types.EstimateSwapExactAmountOutRequest{TokenOut: tokenOut.String(), Routes: outRoutes})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedIn.String(), outRes.TokenInAmount.String())
+
+	_, err = querier.EstimateSwapExactAmountIn(ctx, &types.EstimateSwapExactAmountInRequest{TokenIn: "abc", Routes: inRoutes})
+	suite.Require().Error(err)
+
+	_, err = querier.EstimateSwapExactAmountOut(ctx, &types.EstimateSwapExactAmountOutRequest{TokenOut: tokenOut.String()})
+	suite.Require().Error(err)
+}
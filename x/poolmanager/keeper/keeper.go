@@ -13,19 +13,26 @@ import (
 type Keeper struct {
 	storeKey storetypes.StoreKey
 
-	gammKeeper    types.SwapI
-	bankKeeper    types.BankI
-	accountKeeper types.AccountI
+	gammKeeper         types.SwapI
+	concentratedKeeper types.SwapI
+	orderbookKeeper    types.SwapI
+	bankKeeper         types.BankI
+	accountKeeper      types.AccountI
+	takerFeeKeeper     types.TakerFeeKeeper
+	router             types.MessageRouter
 
 	routes map[types.PoolType]types.SwapI
 }
 
-func NewKeeper(storeKey storetypes.StoreKey, gammKeeper types.SwapI, bankKeeper types.BankI, accountKeeper types.AccountI) *Keeper {
+func NewKeeper(storeKey storetypes.StoreKey, gammKeeper types.SwapI, concentratedKeeper types.SwapI, orderbookKeeper types.SwapI, bankKeeper types.BankI, accountKeeper types.AccountI, takerFeeKeeper types.TakerFeeKeeper, router types.MessageRouter) *Keeper {
 	routes := map[types.PoolType]types.SwapI{
-		types.Balancer: gammKeeper,
+		types.Balancer:     gammKeeper,
+		types.Stableswap:   gammKeeper,
+		types.Concentrated: concentratedKeeper,
+		types.Orderbook:    orderbookKeeper,
 	}
 
-	return &Keeper{storeKey: storeKey, gammKeeper: gammKeeper, bankKeeper: bankKeeper, accountKeeper: accountKeeper, routes: routes}
+	return &Keeper{storeKey: storeKey, gammKeeper: gammKeeper, concentratedKeeper: concentratedKeeper, orderbookKeeper: orderbookKeeper, bankKeeper: bankKeeper, accountKeeper: accountKeeper, takerFeeKeeper: takerFeeKeeper, router: router, routes: routes}
 }
 
 // InitGenesis initializes the poolmanager module's state from a provided genesis
@@ -36,8 +36,16 @@ func (suite *KeeperTestSuite) SetupTest() {
 
 // createPoolFromType creates a basic pool of the given type for testing.
 func (suite *KeeperTestSuite) createPoolFromType(poolType types.PoolType) {
-	suite.PrepareBalancerPool()
-	return
+	switch poolType {
+	case types.Stableswap:
+		suite.PrepareBasicStableswapPool()
+	case types.Concentrated:
+		suite.PrepareConcentratedPool()
+	case types.Orderbook:
+		suite.PrepareOrderbookPool()
+	default:
+		suite.PrepareBalancerPool()
+	}
 }
 
 // createBalancerPoolsFromCoinsWithSwapFee creates balancer pools from given sets of coins and respective swap fees.
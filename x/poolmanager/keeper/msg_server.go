@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+type msgServer struct {
+	keeper *Keeper
+}
+
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgSwapExactAmountIn) (*types.MsgSwapExactAmountInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := server.keeper.SwapExactAmountInWithTakerFee(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount, msg.MaxPriceImpact)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap event is handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSwapExactAmountIn,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, msg.TokenIn.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, tokenOutAmount.String()),
+		),
+	})
+
+	return &types.MsgSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
+}
+
+func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.MsgSwapExactAmountOut) (*types.MsgSwapExactAmountOutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInAmount, err := server.keeper.SwapExactAmountOutWithTakerFee(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut, msg.MaxPriceImpact)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap event is handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSwapExactAmountOut,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, sdk.NewCoin(msg.TokenInDenom(), tokenInAmount).String()),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, msg.TokenOut.String()),
+		),
+	})
+
+	return &types.MsgSwapExactAmountOutResponse{TokenInAmount: tokenInAmount}, nil
+}
+
+func (server msgServer) SplitRouteSwapExactAmountIn(goCtx context.Context, msg *types.MsgSplitRouteSwapExactAmountIn) (*types.MsgSplitRouteSwapExactAmountInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := server.keeper.SplitRouteSwapExactAmountIn(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount, msg.MaxPriceImpact)
+	if err != nil {
+		return nil, err
+	}
+
+	totalTokenInAmount := sdk.ZeroInt()
+	for _, route := range msg.Routes {
+		totalTokenInAmount = totalTokenInAmount.Add(route.TokenInAmount)
+	}
+
+	// Swap event is handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSplitRouteSwapExactAmountIn,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, sdk.NewCoin(msg.TokenInDenom, totalTokenInAmount).String()),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, sdk.NewCoin(msg.TokenOutDenom(), tokenOutAmount).String()),
+		),
+	})
+
+	return &types.MsgSplitRouteSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
+}
+
+func (server msgServer) FlashSwap(goCtx context.Context, msg *types.MsgFlashSwap) (*types.MsgFlashSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInAmount, msgResponses, err := server.keeper.FlashSwap(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap event is handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtFlashSwap,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, sdk.NewCoin(msg.Routes[0].TokenInDenom, tokenInAmount).String()),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, msg.TokenOut.String()),
+		),
+	})
+
+	return &types.MsgFlashSwapResponse{TokenInAmount: tokenInAmount, MsgResponses: msgResponses}, nil
+}
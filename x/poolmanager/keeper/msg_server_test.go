@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+const (
+	baseDenom = "adym"
+	fooDenom  = "foo"
+)
+
+// prepareTakerFeePool creates a balancer adym/foo pool and sets adym as the txfees base denom,
+// so that the taker fee charged on swaps of adym does not need to be swapped.
+func (suite *KeeperTestSuite) prepareTakerFeePool() uint64 {
+	suite.App.TxFeesKeeper.SetBaseDenom(suite.Ctx, baseDenom)
+	poolCoins := sdk.NewCoins(sdk.NewCoin(baseDenom, sdk.NewInt(1000000)), sdk.NewCoin(fooDenom, sdk.NewInt(1000000)))
+	suite.FundAcc(suite.TestAccs[0], poolCoins)
+	return suite.PrepareBalancerPoolWithCoins(poolCoins...)
+}
+
+func (suite *KeeperTestSuite) TestSwapExactAmountInMsg() {
+	tests := map[string]struct {
+		tokenIn           sdk.Coin
+		tokenOutMinAmount sdk.Int
+		expectError       bool
+	}{
+		"swap charges the taker fee from the token in": {
+			tokenIn:           sdk.NewCoin(baseDenom, sdk.NewInt(10000)),
+			tokenOutMinAmount: sdk.OneInt(),
+		},
+		"error: token out below min amount": {
+			tokenIn:           sdk.NewCoin(baseDenom, sdk.NewInt(10000)),
+			tokenOutMinAmount: sdk.NewInt(10000),
+			expectError:       true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolId := suite.prepareTakerFeePool()
+			sender := suite.TestAccs[1]
+			suite.FundAcc(sender, sdk.NewCoins(tc.tokenIn))
+			routes := []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: fooDenom}}
+
+			takerFee := suite.App.GAMMKeeper.GetTakerFee(suite.Ctx)
+			suite.Require().True(takerFee.IsPositive())
+			tokenInAfterTakerFee, _ := suite.App.GAMMKeeper.SubTakerFee(tc.tokenIn, takerFee)
+			expectedTokenOut, err := suite.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(suite.Ctx, routes, tokenInAfterTakerFee)
+			suite.Require().NoError(err)
+			balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+
+			msgServer := keeper.NewMsgServerImpl(suite.App.PoolManagerKeeper)
+			res, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(suite.Ctx), &types.MsgSwapExactAmountIn{
+				Sender:            sender.String(),
+				Routes:            routes,
+				TokenIn:           tc.tokenIn,
+				TokenOutMinAmount: tc.tokenOutMinAmount,
+			})
+			if tc.expectError {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenOut.String(), res.TokenOutAmount.String())
+
+			// the whole token in is spent, taker fee included.
+			balanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Require().Equal(balanceBefore.AmountOf(baseDenom).Sub(tc.tokenIn.Amount).String(), balanceAfter.AmountOf(baseDenom).String())
+			suite.Require().Equal(balanceBefore.AmountOf(fooDenom).Add(res.TokenOutAmount).String(), balanceAfter.AmountOf(fooDenom).String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSwapExactAmountOutMsg() {
+	tests := map[string]struct {
+		emptyRoutes      bool
+		tokenOut         sdk.Coin
+		tokenInMaxAmount sdk.Int
+		expectError      bool
+	}{
+		"swap charges the taker fee on top of the token in": {
+			tokenOut:         sdk.NewCoin(fooDenom, sdk.NewInt(10000)),
+			tokenInMaxAmount: sdk.NewInt(20000),
+		},
+		"error: token in max amount leaves no room for the taker fee": {
+			tokenOut:         sdk.NewCoin(fooDenom, sdk.NewInt(10000)),
+			tokenInMaxAmount: sdk.NewInt(10101),
+			expectError:      true,
+		},
+		"error: empty routes": {
+			emptyRoutes:      true,
+			tokenOut:         sdk.NewCoin(fooDenom, sdk.NewInt(10000)),
+			tokenInMaxAmount: sdk.NewInt(20000),
+			expectError:      true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolId := suite.prepareTakerFeePool()
+			sender := suite.TestAccs[1]
+			suite.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(baseDenom, tc.tokenInMaxAmount)))
+			routes := []types.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: baseDenom}}
+			if tc.emptyRoutes {
+				routes = []types.SwapAmountOutRoute{}
+			}
+
+			takerFee := suite.App.GAMMKeeper.GetTakerFee(suite.Ctx)
+			suite.Require().True(takerFee.IsPositive())
+			var expectedTokenIn sdk.Coin
+			if !tc.emptyRoutes {
+				estimatedTokenIn, err := suite.App.PoolManagerKeeper.MultihopEstimateInGivenExactAmountOut(suite.Ctx, routes, tc.tokenOut)
+				suite.Require().NoError(err)
+				expectedTokenIn, _ = suite.App.GAMMKeeper.AddTakerFee(sdk.NewCoin(baseDenom, estimatedTokenIn), takerFee)
+			}
+			balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+
+			msgServer := keeper.NewMsgServerImpl(suite.App.PoolManagerKeeper)
+			res, err := msgServer.SwapExactAmountOut(sdk.WrapSDKContext(suite.Ctx), &types.MsgSwapExactAmountOut{
+				Sender:           sender.String(),
+				Routes:           routes,
+				TokenInMaxAmount: tc.tokenInMaxAmount,
+				TokenOut:         tc.tokenOut,
+			})
+			if tc.expectError {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenIn.Amount.String(), res.TokenInAmount.String())
+
+			// the amount in returned is spent, taker fee included.
+			balanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Require().Equal(balanceBefore.AmountOf(baseDenom).Sub(res.TokenInAmount).String(), balanceAfter.AmountOf(baseDenom).String())
+			suite.Require().Equal(balanceBefore.AmountOf(fooDenom).Add(tc.tokenOut.Amount).String(), balanceAfter.AmountOf(fooDenom).String())
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/testutils/apptesting"
+	orderbooktypes "github.com/osmosis-labs/osmosis/v15/x/orderbook/types"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// prepareOrderbookRoute creates the adym/foo balancer pool of the taker fee tests and a foo/usdc orderbook
+// pool with a bid of 1000000 foo at 2 usdc per foo, and returns their pool ids.
+func (suite *KeeperTestSuite) prepareOrderbookRoute() (balancerPoolId, orderbookPoolId uint64) {
+	balancerPoolId = suite.prepareTakerFeePool()
+
+	pool := suite.PrepareCustomOrderbookPool(suite.TestAccs[0], fooDenom, apptesting.USDC, sdk.OneDec(), sdk.ZeroDec())
+	suite.PlaceLimitOrder(pool, suite.TestAccs[0], orderbooktypes.Bid, 2, sdk.NewInt(1000000))
+	return balancerPoolId, pool.GetId()
+}
+
+// TestSwapExactAmountInMsgAcrossOrderbook tests a multihop swap ending in an orderbook pool,
+// with the taker fee charged on the token in.
+func (suite *KeeperTestSuite) TestSwapExactAmountInMsgAcrossOrderbook() {
+	suite.SetupTest()
+	balancerPoolId, orderbookPoolId := suite.prepareOrderbookRoute()
+	routes := []types.SwapAmountInRoute{{PoolId: balancerPoolId, TokenOutDenom: fooDenom}, {PoolId: orderbookPoolId, TokenOutDenom: apptesting.USDC}}
+	tokenIn := sdk.NewCoin(baseDenom, sdk.NewInt(10000))
+	sender := suite.TestAccs[1]
+	suite.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+	takerFee := suite.App.GAMMKeeper.GetTakerFee(suite.Ctx)
+	tokenInAfterTakerFee, _ := suite.App.GAMMKeeper.SubTakerFee(tokenIn, takerFee)
+	expectedTokenOut, err := suite.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(suite.Ctx, routes, tokenInAfterTakerFee)
+	suite.Require().NoError(err)
+	suite.Require().True(expectedTokenOut.IsPositive())
+
+	msgServer := keeper.NewMsgServerImpl(suite.App.PoolManagerKeeper)
+	res, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(suite.Ctx), &types.MsgSwapExactAmountIn{
+		Sender:            sender.String(),
+		Routes:            routes,
+		TokenIn:           tokenIn,
+		TokenOutMinAmount: sdk.OneInt(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTokenOut.String(), res.TokenOutAmount.String())
+
+	balance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(apptesting.USDC, res.TokenOutAmount)).String(), balance.String())
+
+	// the foo swapped into the orderbook pool is credited to the bid.
+	order, err := suite.App.OrderbookKeeper.GetOrder(suite.Ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.TokenOutAmount.QuoRaw(2).String(), order.Claimable.String())
+}
+
+// TestSwapExactAmountOutMsgAcrossOrderbook tests a multihop swap for an exact amount out of an orderbook pool,
+// with the taker fee charged on top of the token in.
+func (suite *KeeperTestSuite) TestSwapExactAmountOutMsgAcrossOrderbook() {
+	suite.SetupTest()
+	balancerPoolId, orderbookPoolId := suite.prepareOrderbookRoute()
+	routes := []types.SwapAmountOutRoute{{PoolId: balancerPoolId, TokenInDenom: baseDenom}, {PoolId: orderbookPoolId, TokenInDenom: fooDenom}}
+	tokenOut := sdk.NewCoin(apptesting.USDC, sdk.NewInt(5000))
+	tokenInMaxAmount := sdk.NewInt(10000)
+	sender := suite.TestAccs[1]
+	suite.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(baseDenom, tokenInMaxAmount)))
+
+	takerFee := suite.App.GAMMKeeper.GetTakerFee(suite.Ctx)
+	estimatedTokenIn, err := suite.App.PoolManagerKeeper.MultihopEstimateInGivenExactAmountOut(suite.Ctx, routes, tokenOut)
+	suite.Require().NoError(err)
+	expectedTokenIn, _ := suite.App.GAMMKeeper.AddTakerFee(sdk.NewCoin(baseDenom, estimatedTokenIn), takerFee)
+
+	msgServer := keeper.NewMsgServerImpl(suite.App.PoolManagerKeeper)
+	res, err := msgServer.SwapExactAmountOut(sdk.WrapSDKContext(suite.Ctx), &types.MsgSwapExactAmountOut{
+		Sender:           sender.String(),
+		Routes:           routes,
+		TokenInMaxAmount: tokenInMaxAmount,
+		TokenOut:         tokenOut,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTokenIn.Amount.String(), res.TokenInAmount.String())
+
+	balance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+	suite.Require().Equal(tokenInMaxAmount.Sub(res.TokenInAmount).String(), balance.AmountOf(baseDenom).String())
+	suite.Require().Equal(tokenOut.Amount.String(), balance.AmountOf(apptesting.USDC).String())
+
+	order, err := suite.App.OrderbookKeeper.GetOrder(suite.Ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(2500).String(), order.Claimable.String())
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// hopSpotPrice returns the spot price of the token out in terms of the token in of a hop on the given pool.
+func hopSpotPrice(ctx sdk.Context, pool types.PoolI, tokenInDenom, tokenOutDenom string) (sdk.Dec, error) {
+	spotPrice, err := pool.SpotPrice(ctx, tokenInDenom, tokenOutDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !spotPrice.IsPositive() {
+		return sdk.Dec{}, types.ErrInvalidPool
+	}
+	return spotPrice, nil
+}
+
+// checkHopPriceImpact returns a PriceImpactExceededError if the spot price of the hop on the pool,
+// as it is after the swap, moved by more than maxPriceImpact from spotPriceBefore.
+func checkHopPriceImpact(
+	ctx sdk.Context,
+	poolAfterSwap types.PoolI,
+	tokenInDenom, tokenOutDenom string,
+	spotPriceBefore, maxPriceImpact sdk.Dec,
+) error {
+	spotPriceAfter, err := hopSpotPrice(ctx, poolAfterSwap, tokenInDenom, tokenOutDenom)
+	if err != nil {
+		return err
+	}
+
+	priceImpact := types.PriceImpact(spotPriceBefore, spotPriceAfter)
+	if priceImpact.GT(maxPriceImpact) {
+		return types.PriceImpactExceededError{PoolId: poolAfterSwap.GetId(), PriceImpact: priceImpact, MaxPriceImpact: maxPriceImpact}
+	}
+	return nil
+}
+
+// checkPriceImpactAfterSwap checks the price impact of a hop executed on the pool with the given id,
+// reading the pool back from its module once the swap is applied.
+func checkPriceImpactAfterSwap(
+	ctx sdk.Context,
+	swapModule types.SwapI,
+	poolId uint64,
+	tokenInDenom, tokenOutDenom string,
+	spotPriceBefore, maxPriceImpact sdk.Dec,
+) error {
+	poolAfterSwap, err := swapModule.GetPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	return checkHopPriceImpact(ctx, poolAfterSwap, tokenInDenom, tokenOutDenom, spotPriceBefore, maxPriceImpact)
+}
+
+// simulateHopOutGivenIn estimates the amount out of a hop of an exact amount in swap,
+// and checks its price impact on the pool against maxPriceImpact.
+func simulateHopOutGivenIn(
+	ctx sdk.Context,
+	swapModule types.SwapI,
+	pool types.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee, maxPriceImpact sdk.Dec,
+) (sdk.Coin, error) {
+	spotPriceBefore, err := hopSpotPrice(ctx, pool, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenOut, poolAfterSwap, err := swapModule.SimulateSwapExactAmountIn(ctx, pool, tokenIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := checkHopPriceImpact(ctx, poolAfterSwap, tokenIn.Denom, tokenOutDenom, spotPriceBefore, maxPriceImpact); err != nil {
+		return sdk.Coin{}, err
+	}
+	return tokenOut, nil
+}
+
+// simulateHopInGivenOut estimates the amount in of a hop of an exact amount out swap,
+// and checks its price impact on the pool against maxPriceImpact.
+func simulateHopInGivenOut(
+	ctx sdk.Context,
+	swapModule types.SwapI,
+	pool types.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee, maxPriceImpact sdk.Dec,
+) (sdk.Coin, error) {
+	spotPriceBefore, err := hopSpotPrice(ctx, pool, tokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenIn, poolAfterSwap, err := swapModule.SimulateSwapExactAmountOut(ctx, pool, tokenOut, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := checkHopPriceImpact(ctx, poolAfterSwap, tokenInDenom, tokenOut.Denom, spotPriceBefore, maxPriceImpact); err != nil {
+		return sdk.Coin{}, err
+	}
+	return tokenIn, nil
+}
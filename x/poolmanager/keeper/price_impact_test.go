@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+var (
+	tightMaxPriceImpact = sdk.NewDecWithPrec(5, 2)
+	looseMaxPriceImpact = sdk.NewDecWithPrec(5, 1)
+)
+
+// preparePriceImpactPools creates a deep adym/bar pool followed by a shallow bar/foo pool,
+// so that a swap of 10000 adym to foo barely moves the first pool but moves the second one by about 20%.
+func (suite *KeeperTestSuite) preparePriceImpactPools() (deepPoolId, shallowPoolId uint64) {
+	suite.App.TxFeesKeeper.SetBaseDenom(suite.Ctx, baseDenom)
+	deepPoolId = suite.prepareBalancerPool(sdk.NewCoin(baseDenom, sdk.NewInt(1000000000)), sdk.NewCoin(barDenom, sdk.NewInt(1000000000)))
+	shallowPoolId = suite.prepareBalancerPool(sdk.NewCoin(barDenom, sdk.NewInt(100000)), sdk.NewCoin(fooDenom, sdk.NewInt(100000)))
+	return deepPoolId, shallowPoolId
+}
+
+// requirePriceImpactExceeded checks that err is a PriceImpactExceededError on the given pool.
+func (suite *KeeperTestSuite) requirePriceImpactExceeded(err error, poolId uint64, maxPriceImpact sdk.Dec) {
+	suite.Require().Error(err)
+	var priceImpactErr types.PriceImpactExceededError
+	suite.Require().True(errors.As(err, &priceImpactErr), err.Error())
+	suite.Require().Equal(poolId, priceImpactErr.PoolId)
+	suite.Require().Equal(maxPriceImpact, priceImpactErr.MaxPriceImpact)
+	suite.Require().True(priceImpactErr.PriceImpact.GT(maxPriceImpact))
+}
+
+func (suite *KeeperTestSuite) TestSwapExactAmountInMaxPriceImpact() {
+	tests := map[string]struct {
+		maxPriceImpact sdk.Dec
+		expectExceeded bool
+	}{
+		"no bound": {
+			maxPriceImpact: sdk.Dec{},
+		},
+		"zero bound is no bound": {
+			maxPriceImpact: sdk.ZeroDec(),
+		},
+		"bound above the price impact of each pool": {
+			maxPriceImpact: looseMaxPriceImpact,
+		},
+		"error: bound below the price impact of the shallow pool": {
+			maxPriceImpact: tightMaxPriceImpact,
+			expectExceeded: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			deepPoolId, shallowPoolId := suite.preparePriceImpactPools()
+			routes := []types.SwapAmountInRoute{{PoolId: deepPoolId, TokenOutDenom: barDenom}, {PoolId: shallowPoolId, TokenOutDenom: fooDenom}}
+			tokenIn := sdk.NewCoin(baseDenom, sdk.NewInt(10000))
+			sender := suite.TestAccs[1]
+			suite.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+			querier := keeper.NewQuerier(*suite.App.PoolManagerKeeper)
+			estimateRes, estimateErr := querier.EstimateSwapExactAmountIn(sdk.WrapSDKContext(suite.Ctx), &types.EstimateSwapExactAmountInRequest{
+				TokenIn:        tokenIn.String(),
+				Routes:         routes,
+				MaxPriceImpact: tc.maxPriceImpact,
+			})
+
+			msgServer := keeper.NewMsgServerImpl(suite.App.PoolManagerKeeper)
+			res, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(suite.Ctx), &types.MsgSwapExactAmountIn{
+				Sender:            sender.String(),
+				Routes:            routes,
+				TokenIn:           tokenIn,
+				TokenOutMinAmount: sdk.OneInt(),
+				MaxPriceImpact:    tc.maxPriceImpact,
+			})
+			if tc.expectExceeded {
+				// the querier returns the error as a gRPC status, naming the pool.
+				suite.Require().ErrorContains(estimateErr, fmt.Sprintf("on pool %d exceeds max price impact", shallowPoolId))
+				suite.requirePriceImpactExceeded(err, shallowPoolId, tc.maxPriceImpact)
+				return
+			}
+			suite.Require().NoError(estimateErr)
+			suite.Require().NoError(err)
+			suite.Require().Equal(estimateRes.TokenOutAmount.String(), res.TokenOutAmount.String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSwapExactAmountOutMaxPriceImpact() {
+	tests := map[string]struct {
+		maxPriceImpact sdk.Dec
+		expectExceeded bool
+	}{
+		"no bound": {
+			maxPriceImpact: sdk.Dec{},
+		},
+		"bound above the price impact of each pool": {
+			maxPriceImpact: looseMaxPriceImpact,
+		},
+		"error: bound below the price impact of the shallow pool": {
+			maxPriceImpact: tightMaxPriceImpact,
+			expectExceeded: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			deepPoolId, shallowPoolId := suite.preparePriceImpactPools()
+			routes := []types.SwapAmountOutRoute{{PoolId: deepPoolId, TokenInDenom: baseDenom}, {PoolId: shallowPoolId, TokenInDenom: barDenom}}
+			tokenOut := sdk.NewCoin(fooDenom, sdk.NewInt(10000))
+			sender := suite.TestAccs[1]
+			suite.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(baseDenom, sdk.NewInt(100000))))
+
+			estimatedIn, estimateErr := suite.App.PoolManagerKeeper.MultihopEstimateInGivenExactAmountOutWithTakerFee(suite.Ctx, routes, tokenOut, tc.maxPriceImpact)
+
+			msgServer := keeper.NewMsgServerImpl(suite.App.PoolManagerKeeper)
+			res, err := msgServer.SwapExactAmountOut(sdk.WrapSDKContext(suite.Ctx), &types.MsgSwapExactAmountOut{
+				Sender:           sender.String(),
+				Routes:           routes,
+				TokenInMaxAmount: sdk.NewInt(100000),
+				TokenOut:         tokenOut,
+				MaxPriceImpact:   tc.maxPriceImpact,
+			})
+			if tc.expectExceeded {
+				suite.requirePriceImpactExceeded(estimateErr, shallowPoolId, tc.maxPriceImpact)
+				suite.requirePriceImpactExceeded(err, shallowPoolId, tc.maxPriceImpact)
+				return
+			}
+			suite.Require().NoError(estimateErr)
+			suite.Require().NoError(err)
+			suite.Require().Equal(estimatedIn.String(), res.TokenInAmount.String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEstimateMaxPriceImpactConcentratedPool() {
+	suite.SetupTest()
+	suite.App.TxFeesKeeper.SetBaseDenom(suite.Ctx, baseDenom)
+	pool := suite.PrepareConcentratedPoolWithCoinsAndFullRangePosition(baseDenom, fooDenom, sdk.ZeroDec(),
+		sdk.NewCoins(sdk.NewCoin(baseDenom, sdk.NewInt(1000000)), sdk.NewCoin(fooDenom, sdk.NewInt(1000000))))
+	routes := []types.SwapAmountInRoute{{PoolId: pool.GetId(), TokenOutDenom: fooDenom}}
+	tokenIn := sdk.NewCoin(baseDenom, sdk.NewInt(100000))
+
+	unboundedOut, err := suite.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountInWithTakerFee(suite.Ctx, routes, tokenIn, sdk.Dec{})
+	suite.Require().NoError(err)
+
+	boundedOut, err := suite.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountInWithTakerFee(suite.Ctx, routes, tokenIn, looseMaxPriceImpact)
+	suite.Require().NoError(err)
+	suite.Require().Equal(unboundedOut.String(), boundedOut.String())
+
+	_, err = suite.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountInWithTakerFee(suite.Ctx, routes, tokenIn, tightMaxPriceImpact)
+	suite.requirePriceImpactExceeded(err, pool.GetId(), tightMaxPriceImpact)
+
+	// the simulation leaves the pool untouched.
+	poolAfter, err := suite.App.PoolManagerKeeper.GetPool(suite.Ctx, pool.GetId())
+	suite.Require().NoError(err)
+	spotPriceBefore, err := pool.SpotPrice(suite.Ctx, baseDenom, fooDenom)
+	suite.Require().NoError(err)
+	spotPriceAfter, err := poolAfter.SpotPrice(suite.Ctx, baseDenom, fooDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(spotPriceBefore, spotPriceAfter)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -18,6 +19,19 @@ func (k Keeper) RouteExactAmountIn(
 	routes []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 	tokenOutMinAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	return k.routeExactAmountIn(ctx, sender, routes, tokenIn, tokenOutMinAmount, sdk.Dec{})
+}
+
+// routeExactAmountIn is RouteExactAmountIn with an optional bound on the price impact of each hop,
+// the relative change of the spot price of the pool over the swap.
+func (k Keeper) routeExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount sdk.Int,
+	maxPriceImpact sdk.Dec,
 ) (tokenOutAmount sdk.Int, err error) {
 	route := types.SwapAmountInRoutes(routes)
 	if err := route.Validate(); err != nil {
@@ -48,6 +62,15 @@ func (k Keeper) RouteExactAmountIn(
 			return sdk.Int{}, fmt.Errorf("pool %d is not active", pool.GetId())
 		}
 
+		hasPriceImpactBound := types.HasPriceImpactBound(maxPriceImpact)
+		var spotPriceBefore sdk.Dec
+		if hasPriceImpactBound {
+			spotPriceBefore, err = hopSpotPrice(ctx, pool, tokenIn.Denom, route.TokenOutDenom)
+			if err != nil {
+				return sdk.Int{}, err
+			}
+		}
+
 		swapFee := pool.GetSwapFee(ctx)
 
 		tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenIn, route.TokenOutDenom, _outMinAmount, swapFee)
@@ -56,6 +79,12 @@ func (k Keeper) RouteExactAmountIn(
 			return sdk.Int{}, err
 		}
 
+		if hasPriceImpactBound {
+			if err := checkPriceImpactAfterSwap(ctx, swapModule, route.PoolId, tokenIn.Denom, route.TokenOutDenom, spotPriceBefore, maxPriceImpact); err != nil {
+				return sdk.Int{}, err
+			}
+		}
+
 		// Chain output of current pool as the input for the next routed pool
 		tokenIn = sdk.NewCoin(route.TokenOutDenom, tokenOutAmount)
 	}
@@ -66,6 +95,18 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 	ctx sdk.Context,
 	routes []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
+) (tokenOutAmount sdk.Int, err error) {
+	return k.multihopEstimateOutGivenExactAmountIn(ctx, routes, tokenIn, sdk.Dec{})
+}
+
+// multihopEstimateOutGivenExactAmountIn is MultihopEstimateOutGivenExactAmountIn with an optional
+// bound on the price impact of each hop. With a bound, each hop is simulated to get the spot
+// price of its pool after the swap.
+func (k Keeper) multihopEstimateOutGivenExactAmountIn(
+	ctx sdk.Context,
+	routes []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	maxPriceImpact sdk.Dec,
 ) (tokenOutAmount sdk.Int, err error) {
 	// recover from panic
 	defer func() {
@@ -94,7 +135,12 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 
 		swapFee := poolI.GetSwapFee(ctx)
 
-		tokenOut, err := swapModule.CalcOutAmtGivenIn(ctx, poolI, tokenIn, route.TokenOutDenom, swapFee)
+		var tokenOut sdk.Coin
+		if types.HasPriceImpactBound(maxPriceImpact) {
+			tokenOut, err = simulateHopOutGivenIn(ctx, swapModule, poolI, tokenIn, route.TokenOutDenom, swapFee, maxPriceImpact)
+		} else {
+			tokenOut, err = swapModule.CalcOutAmtGivenIn(ctx, poolI, tokenIn, route.TokenOutDenom, swapFee)
+		}
 		if err != nil {
 			return sdk.Int{}, err
 		}
@@ -119,6 +165,18 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	routes []types.SwapAmountOutRoute,
 	tokenInMaxAmount sdk.Int,
 	tokenOut sdk.Coin,
+) (tokenInAmount sdk.Int, err error) {
+	return k.routeExactAmountOut(ctx, sender, routes, tokenInMaxAmount, tokenOut, sdk.Dec{})
+}
+
+// routeExactAmountOut is RouteExactAmountOut with an optional bound on the price impact of each hop,
+// the relative change of the spot price of the pool over the swap.
+func (k Keeper) routeExactAmountOut(ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountOutRoute,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	maxPriceImpact sdk.Dec,
 ) (tokenInAmount sdk.Int, err error) {
 	route := types.SwapAmountOutRoutes(routes)
 	if err := route.Validate(); err != nil {
@@ -134,7 +192,7 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 
 	// Determine what the estimated input would be for each pool along the multi-hop route
 	var insExpected []sdk.Int
-	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut)
+	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut, sdk.Dec{})
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -172,12 +230,27 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 			return sdk.Int{}, fmt.Errorf("pool %d is not active", pool.GetId())
 		}
 
+		hasPriceImpactBound := types.HasPriceImpactBound(maxPriceImpact)
+		var spotPriceBefore sdk.Dec
+		if hasPriceImpactBound {
+			spotPriceBefore, err = hopSpotPrice(ctx, pool, route.TokenInDenom, _tokenOut.Denom)
+			if err != nil {
+				return sdk.Int{}, err
+			}
+		}
+
 		swapFee := pool.GetSwapFee(ctx)
 		_tokenInAmount, swapErr := swapModule.SwapExactAmountOut(ctx, sender, pool, route.TokenInDenom, insExpected[i], _tokenOut, swapFee)
 		if swapErr != nil {
 			return sdk.Int{}, swapErr
 		}
 
+		if hasPriceImpactBound {
+			if err := checkPriceImpactAfterSwap(ctx, swapModule, route.PoolId, route.TokenInDenom, _tokenOut.Denom, spotPriceBefore, maxPriceImpact); err != nil {
+				return sdk.Int{}, err
+			}
+		}
+
 		// Sets the final amount of tokens that need to be input into the first pool. Even though this is the final return value for the
 		// whole method and will not change after the first iteration, we still iterate through the rest of the pools to execute their respective
 		// swaps.
@@ -193,6 +266,17 @@ func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	ctx sdk.Context,
 	routes []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
+) (tokenInAmount sdk.Int, err error) {
+	return k.multihopEstimateInGivenExactAmountOut(ctx, routes, tokenOut, sdk.Dec{})
+}
+
+// multihopEstimateInGivenExactAmountOut is MultihopEstimateInGivenExactAmountOut with an optional
+// bound on the price impact of each hop.
+func (k Keeper) multihopEstimateInGivenExactAmountOut(
+	ctx sdk.Context,
+	routes []types.SwapAmountOutRoute,
+	tokenOut sdk.Coin,
+	maxPriceImpact sdk.Dec,
 ) (tokenInAmount sdk.Int, err error) {
 	var insExpected []sdk.Int
 
@@ -210,7 +294,7 @@ func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	}
 
 	// Determine what the estimated input would be for each pool along the multi-hop route
-	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut)
+	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut, maxPriceImpact)
 
 	if err != nil {
 		return sdk.Int{}, err
@@ -226,12 +310,14 @@ func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 // the route of pools the caller is intending to hop through in a fixed-output multihop tx. It estimates the input
 // amount for this last pool and then chains that input as the output of the previous pool in the route, repeating
 // until the first pool is reached. It returns an array of inputs, each of which correspond to a pool ID in the
-// route of pools for the original multihop transaction.
+// route of pools for the original multihop transaction. If maxPriceImpact bounds the hops,
+// each hop is simulated and fails if it moves the spot price of its pool by more than it.
 // TODO: test this.
 func (k Keeper) createMultihopExpectedSwapOuts(
 	ctx sdk.Context,
 	routes []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
+	maxPriceImpact sdk.Dec,
 ) ([]sdk.Int, error) {
 	insExpected := make([]sdk.Int, len(routes))
 	for i := len(routes) - 1; i >= 0; i-- {
@@ -247,7 +333,12 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 			return nil, err
 		}
 
-		tokenIn, err := swapModule.CalcInAmtGivenOut(ctx, poolI, tokenOut, route.TokenInDenom, poolI.GetSwapFee(ctx))
+		var tokenIn sdk.Coin
+		if types.HasPriceImpactBound(maxPriceImpact) {
+			tokenIn, err = simulateHopInGivenOut(ctx, swapModule, poolI, tokenOut, route.TokenInDenom, poolI.GetSwapFee(ctx), maxPriceImpact)
+		} else {
+			tokenIn, err = swapModule.CalcInAmtGivenOut(ctx, poolI, tokenOut, route.TokenInDenom, poolI.GetSwapFee(ctx))
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -258,3 +349,48 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 
 	return insExpected, nil
 }
+
+// GetPool returns the pool with the given id from the module owning it.
+func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
+	swapModule, err := k.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return swapModule.GetPool(ctx, poolId)
+}
+
+// GetAllPools returns all the pools, of all types, ordered by pool id.
+func (k Keeper) GetAllPools(ctx sdk.Context) ([]types.PoolI, error) {
+	moduleRoutes := k.GetAllPoolRoutes(ctx)
+	// pool route keys are not ordered by pool id.
+	sort.Slice(moduleRoutes, func(i, j int) bool {
+		return moduleRoutes[i].PoolId < moduleRoutes[j].PoolId
+	})
+
+	pools := make([]types.PoolI, 0, len(moduleRoutes))
+	for _, moduleRoute := range moduleRoutes {
+		pool, err := k.GetPool(ctx, moduleRoute.PoolId)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
+
+// RouteCalculateSpotPrice returns the spot price of the base asset in terms of the
+// quote asset in the given pool, whichever module owns it.
+func (k Keeper) RouteCalculateSpotPrice(
+	ctx sdk.Context,
+	poolId uint64,
+	quoteAssetDenom string,
+	baseAssetDenom string,
+) (sdk.Dec, error) {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return pool.SpotPrice(ctx, quoteAssetDenom, baseAssetDenom)
+}
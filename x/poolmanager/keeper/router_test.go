@@ -5,8 +5,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v15/testutils/apptesting"
+	cl "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/keeper"
 	gamm "github.com/osmosis-labs/osmosis/v15/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/stableswap"
+	orderbook "github.com/osmosis-labs/osmosis/v15/x/orderbook/keeper"
 	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
@@ -24,6 +28,8 @@ var (
 	defaultPoolSwapFee        = sdk.NewDecWithPrec(1, 2) // 1% pool swap fee default
 	defaultSwapAmount         = sdk.NewInt(1000000)
 	gammKeeperType            = reflect.TypeOf(&gamm.Keeper{})
+	concentratedKeeperType    = reflect.TypeOf(&cl.Keeper{})
+	orderbookKeeperType       = reflect.TypeOf(&orderbook.Keeper{})
 )
 
 // TestGetPoolModule tests that the correct pool module is returned for a given pool id.
@@ -42,6 +48,21 @@ func (suite *KeeperTestSuite) TestGetPoolModule() {
 			poolId:            1,
 			expectedModule:    gammKeeperType,
 		},
+		"valid stableswap pool": {
+			preCreatePoolType: types.Stableswap,
+			poolId:            1,
+			expectedModule:    gammKeeperType,
+		},
+		"valid concentrated liquidity pool": {
+			preCreatePoolType: types.Concentrated,
+			poolId:            1,
+			expectedModule:    concentratedKeeperType,
+		},
+		"valid orderbook pool": {
+			preCreatePoolType: types.Orderbook,
+			poolId:            1,
+			expectedModule:    orderbookKeeperType,
+		},
 		"non-existent pool": {
 			preCreatePoolType: types.Balancer,
 			poolId:            2,
@@ -555,6 +576,64 @@ func (suite *KeeperTestSuite) TestMultihopEstimateOutGivenExactAmountIn() {
 			},
 			expectPass: true,
 		},
+		{
+			name: "Swap across pool types - foo -> bar(balancer pool 1) - bar(stableswap pool 2) -> baz",
+			param: param{
+				routes: []types.SwapAmountInRoute{
+					{
+						PoolId:        1,
+						TokenOutDenom: bar,
+					},
+					{
+						PoolId:        2,
+						TokenOutDenom: baz,
+					},
+				},
+				estimateRoutes: []types.SwapAmountInRoute{
+					{
+						PoolId:        3,
+						TokenOutDenom: bar,
+					},
+					{
+						PoolId:        4,
+						TokenOutDenom: baz,
+					},
+				},
+				tokenIn:           sdk.NewCoin(foo, sdk.NewInt(100000)),
+				tokenOutMinAmount: sdk.NewInt(1),
+			},
+			expectPass: true,
+			poolType:   types.Stableswap,
+		},
+		{
+			name: "Swap across pool types - foo -> bar(balancer pool 1) - bar(concentrated pool 2) -> baz",
+			param: param{
+				routes: []types.SwapAmountInRoute{
+					{
+						PoolId:        1,
+						TokenOutDenom: bar,
+					},
+					{
+						PoolId:        2,
+						TokenOutDenom: baz,
+					},
+				},
+				estimateRoutes: []types.SwapAmountInRoute{
+					{
+						PoolId:        3,
+						TokenOutDenom: bar,
+					},
+					{
+						PoolId:        4,
+						TokenOutDenom: baz,
+					},
+				},
+				tokenIn:           sdk.NewCoin(foo, sdk.NewInt(100000)),
+				tokenOutMinAmount: sdk.NewInt(1),
+			},
+			expectPass: true,
+			poolType:   types.Concentrated,
+		},
 	}
 
 	for _, test := range tests {
@@ -566,10 +645,8 @@ func (suite *KeeperTestSuite) TestMultihopEstimateOutGivenExactAmountIn() {
 
 			firstEstimatePoolId, secondEstimatePoolId := suite.setupPools(test.poolType, defaultPoolSwapFee)
 
-			firstEstimatePool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, firstEstimatePoolId)
-			suite.Require().NoError(err)
-			secondEstimatePool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, secondEstimatePoolId)
-			suite.Require().NoError(err)
+			firstEstimatePool := suite.getPool(firstEstimatePoolId)
+			secondEstimatePool := suite.getPool(secondEstimatePoolId)
 
 			// calculate token out amount using `MultihopSwapExactAmountIn`
 			multihopTokenOutAmount, errMultihop := poolmanagerKeeper.RouteExactAmountIn(
@@ -594,10 +671,8 @@ func (suite *KeeperTestSuite) TestMultihopEstimateOutGivenExactAmountIn() {
 				suite.Require().Error(errEstimate, "test: %v", test.name)
 			}
 			// ensure that pool state has not been altered after estimation
-			firstEstimatePoolAfterSwap, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, firstEstimatePoolId)
-			suite.Require().NoError(err)
-			secondEstimatePoolAfterSwap, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, secondEstimatePoolId)
-			suite.Require().NoError(err)
+			firstEstimatePoolAfterSwap := suite.getPool(firstEstimatePoolId)
+			secondEstimatePoolAfterSwap := suite.getPool(secondEstimatePoolId)
 
 			suite.Require().Equal(firstEstimatePool, firstEstimatePoolAfterSwap)
 			suite.Require().Equal(secondEstimatePool, secondEstimatePoolAfterSwap)
@@ -679,6 +754,64 @@ func (suite *KeeperTestSuite) TestMultihopEstimateInGivenExactAmountOut() {
 			expectPass:        true,
 			reducedFeeApplied: true,
 		},
+		{
+			name: "Swap across pool types: foo -> bar (balancer pool 1), bar -> baz (stableswap pool 2)",
+			param: param{
+				routes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       1,
+						TokenInDenom: foo,
+					},
+					{
+						PoolId:       2,
+						TokenInDenom: bar,
+					},
+				},
+				estimateRoutes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       3,
+						TokenInDenom: foo,
+					},
+					{
+						PoolId:       4,
+						TokenInDenom: bar,
+					},
+				},
+				tokenInMaxAmount: sdk.NewInt(90000000),
+				tokenOut:         sdk.NewCoin(baz, sdk.NewInt(100000)),
+			},
+			expectPass: true,
+			poolType:   types.Stableswap,
+		},
+		{
+			name: "Swap across pool types: foo -> bar (balancer pool 1), bar -> baz (concentrated pool 2)",
+			param: param{
+				routes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       1,
+						TokenInDenom: foo,
+					},
+					{
+						PoolId:       2,
+						TokenInDenom: bar,
+					},
+				},
+				estimateRoutes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       3,
+						TokenInDenom: foo,
+					},
+					{
+						PoolId:       4,
+						TokenInDenom: bar,
+					},
+				},
+				tokenInMaxAmount: sdk.NewInt(90000000),
+				tokenOut:         sdk.NewCoin(baz, sdk.NewInt(100000)),
+			},
+			expectPass: true,
+			poolType:   types.Concentrated,
+		},
 	}
 
 	for _, test := range tests {
@@ -690,10 +823,8 @@ func (suite *KeeperTestSuite) TestMultihopEstimateInGivenExactAmountOut() {
 
 			firstEstimatePoolId, secondEstimatePoolId := suite.setupPools(test.poolType, defaultPoolSwapFee)
 
-			firstEstimatePool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, firstEstimatePoolId)
-			suite.Require().NoError(err)
-			secondEstimatePool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, secondEstimatePoolId)
-			suite.Require().NoError(err)
+			firstEstimatePool := suite.getPool(firstEstimatePoolId)
+			secondEstimatePool := suite.getPool(secondEstimatePoolId)
 
 			multihopTokenInAmount, errMultihop := poolmanagerKeeper.RouteExactAmountOut(
 				suite.Ctx,
@@ -717,10 +848,8 @@ func (suite *KeeperTestSuite) TestMultihopEstimateInGivenExactAmountOut() {
 			}
 
 			// ensure that pool state has not been altered after estimation
-			firstEstimatePoolAfterSwap, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, firstEstimatePoolId)
-			suite.Require().NoError(err)
-			secondEstimatePoolAfterSwap, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, secondEstimatePoolId)
-			suite.Require().NoError(err)
+			firstEstimatePoolAfterSwap := suite.getPool(firstEstimatePoolId)
+			secondEstimatePoolAfterSwap := suite.getPool(secondEstimatePoolId)
 
 			suite.Require().Equal(firstEstimatePool, firstEstimatePoolAfterSwap)
 			suite.Require().Equal(secondEstimatePool, secondEstimatePoolAfterSwap)
@@ -795,23 +924,48 @@ func (suite *KeeperTestSuite) setupPools(poolType types.PoolType, poolDefaultSwa
 	// Prepare 4 pools,
 	// Two pools for calculating `MultihopSwapExactAmountOut`
 	// and two pools for calculating `EstimateMultihopSwapExactAmountOut`
+	// If the pool type is stableswap or concentrated, the second pool of each pair is of that type,
+	// so that the routes go across pools of different types.
 	suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{
 		SwapFee: poolDefaultSwapFee, // 1%
 		ExitFee: sdk.NewDec(0),
 	})
-	suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{
-		SwapFee: poolDefaultSwapFee,
-		ExitFee: sdk.NewDec(0),
-	})
+	suite.prepareSecondPool(poolType, poolDefaultSwapFee)
 
 	firstEstimatePoolId = suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{
 		SwapFee: poolDefaultSwapFee, // 1%
 		ExitFee: sdk.NewDec(0),
 	})
 
-	secondEstimatePoolId = suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{
+	secondEstimatePoolId = suite.prepareSecondPool(poolType, poolDefaultSwapFee)
+	return
+}
+
+func (suite *KeeperTestSuite) prepareSecondPool(poolType types.PoolType, poolDefaultSwapFee sdk.Dec) uint64 {
+	if poolType == types.Concentrated {
+		coins := sdk.NewCoins(sdk.NewCoin(bar, sdk.NewInt(5000000)), sdk.NewCoin(baz, sdk.NewInt(5000000)))
+		pool := suite.PrepareConcentratedPoolWithCoinsAndFullRangePosition(bar, baz, poolDefaultSwapFee, coins)
+		return pool.GetId()
+	}
+	if poolType == types.Stableswap {
+		coins := sdk.NewCoins()
+		for _, asset := range apptesting.DefaultPoolAssets {
+			coins = coins.Add(asset.Token)
+		}
+		return suite.PrepareCustomStableswapPool(coins, stableswap.PoolParams{
+			SwapFee: poolDefaultSwapFee,
+			ExitFee: sdk.NewDec(0),
+		}, []uint64{1, 1, 1, 1}, 100)
+	}
+	return suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{
 		SwapFee: poolDefaultSwapFee,
 		ExitFee: sdk.NewDec(0),
 	})
-	return
+}
+
+// getPool returns the pool with the given id from the module it is routed to.
+func (suite *KeeperTestSuite) getPool(poolId uint64) types.PoolI {
+	pool, err := suite.App.PoolManagerKeeper.GetPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	return pool
 }
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// SplitRouteSwapExactAmountIn swaps tokenInDenom over each of the given routes with its own
+// amount in, charging the taker fee of each route separately. The swap succeeds when the total
+// amount out of all routes is at least tokenOutMinAmount. The routes are executed atomically:
+// if any of them fails, none of them is applied. A positive maxPriceImpact bounds the price
+// impact of each hop of each route.
+func (k Keeper) SplitRouteSwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	tokenOutMinAmount sdk.Int,
+	maxPriceImpact sdk.Dec,
+) (totalTokenOutAmount sdk.Int, err error) {
+	if err := types.SwapAmountInSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	totalTokenOutAmount = sdk.ZeroInt()
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		for _, route := range routes {
+			// The min amount out is only enforced on the total, so that a route with
+			// a smaller share of the amount in does not fail the whole swap.
+			tokenIn := sdk.NewCoin(tokenInDenom, route.TokenInAmount)
+			tokenOutAmount, err := k.SwapExactAmountInWithTakerFee(ctx, sender, route.Pools, tokenIn, sdk.OneInt(), maxPriceImpact)
+			if err != nil {
+				return err
+			}
+			totalTokenOutAmount = totalTokenOutAmount.Add(tokenOutAmount)
+		}
+
+		if totalTokenOutAmount.LT(tokenOutMinAmount) {
+			return fmt.Errorf("%w: %s is lesser than %s", types.ErrLimitMinAmount, totalTokenOutAmount, tokenOutMinAmount)
+		}
+		return nil
+	})
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return totalTokenOutAmount, nil
+}
+
+// MultihopEstimateOutGivenExactAmountInSplitRoute estimates the total amount out of a split route
+// swap of tokenInDenom, with the taker fee subtracted from the amount in of each route.
+// Each route is estimated against the current pool state, so routes sharing a pool are
+// estimated as if they were the only route swapping on it.
+func (k Keeper) MultihopEstimateOutGivenExactAmountInSplitRoute(
+	ctx sdk.Context,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	maxPriceImpact sdk.Dec,
+) (totalTokenOutAmount sdk.Int, err error) {
+	if err := types.SwapAmountInSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	totalTokenOutAmount = sdk.ZeroInt()
+	for _, route := range routes {
+		tokenIn := sdk.NewCoin(tokenInDenom, route.TokenInAmount)
+		tokenOutAmount, err := k.MultihopEstimateOutGivenExactAmountInWithTakerFee(ctx, route.Pools, tokenIn, maxPriceImpact)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		totalTokenOutAmount = totalTokenOutAmount.Add(tokenOutAmount)
+	}
+
+	return totalTokenOutAmount, nil
+}
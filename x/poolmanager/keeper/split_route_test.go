@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+const barDenom = "bar"
+
+// prepareSplitRoutePools creates an adym/foo pool, used by a direct route, and an adym/bar and
+// a bar/foo pool, used by a two hop route.
+func (suite *KeeperTestSuite) prepareSplitRoutePools() (directRoute, twoHopRoute []types.SwapAmountInRoute) {
+	directPoolId := suite.prepareTakerFeePool()
+
+	poolCoins := []sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(baseDenom, sdk.NewInt(1000000)), sdk.NewCoin(barDenom, sdk.NewInt(1000000))),
+		sdk.NewCoins(sdk.NewCoin(barDenom, sdk.NewInt(1000000)), sdk.NewCoin(fooDenom, sdk.NewInt(1000000))),
+	}
+	poolIds := make([]uint64, 0, len(poolCoins))
+	for _, coins := range poolCoins {
+		suite.FundAcc(suite.TestAccs[0], coins)
+		poolIds = append(poolIds, suite.PrepareBalancerPoolWithCoins(coins...))
+	}
+
+	directRoute = []types.SwapAmountInRoute{{PoolId: directPoolId, TokenOutDenom: fooDenom}}
+	twoHopRoute = []types.SwapAmountInRoute{{PoolId: poolIds[0], TokenOutDenom: barDenom}, {PoolId: poolIds[1], TokenOutDenom: fooDenom}}
+	return directRoute, twoHopRoute
+}
+
+func (suite *KeeperTestSuite) TestSplitRouteSwapExactAmountIn() {
+	tests := map[string]struct {
+		directAmountIn    sdk.Int
+		twoHopAmountIn    sdk.Int
+		tokenOutMinAmount sdk.Int
+		expectedError     error
+	}{
+		"split route swap over two routes": {
+			directAmountIn:    sdk.NewInt(10000),
+			twoHopAmountIn:    sdk.NewInt(5000),
+			tokenOutMinAmount: sdk.OneInt(),
+		},
+		"error: total token out below min amount": {
+			directAmountIn:    sdk.NewInt(10000),
+			twoHopAmountIn:    sdk.NewInt(5000),
+			tokenOutMinAmount: sdk.NewInt(15000),
+			expectedError:     types.ErrLimitMinAmount,
+		},
+		"error: route with no amount in": {
+			directAmountIn:    sdk.NewInt(10000),
+			twoHopAmountIn:    sdk.ZeroInt(),
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedError:     types.ErrNotPositiveTokenInAmount,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			directRoute, twoHopRoute := suite.prepareSplitRoutePools()
+			routes := []types.SwapAmountInSplitRoute{
+				{Pools: directRoute, TokenInAmount: tc.directAmountIn},
+				{Pools: twoHopRoute, TokenInAmount: tc.twoHopAmountIn},
+			}
+			totalAmountIn := tc.directAmountIn.Add(tc.twoHopAmountIn)
+
+			sender := suite.TestAccs[1]
+			suite.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(baseDenom, totalAmountIn)))
+			balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+
+			// the routes do not share a pool, so the estimate is exact.
+			expectedTokenOut, estimateErr := suite.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountInSplitRoute(suite.Ctx, routes, baseDenom, sdk.Dec{})
+
+			msgServer := keeper.NewMsgServerImpl(suite.App.PoolManagerKeeper)
+			res, err := msgServer.SplitRouteSwapExactAmountIn(sdk.WrapSDKContext(suite.Ctx), &types.MsgSplitRouteSwapExactAmountIn{
+				Sender:            sender.String(),
+				Routes:            routes,
+				TokenInDenom:      baseDenom,
+				TokenOutMinAmount: tc.tokenOutMinAmount,
+			})
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+				// no leg of the swap is applied.
+				suite.Require().Equal(balanceBefore.String(), suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender).String())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NoError(estimateErr)
+			suite.Require().Equal(expectedTokenOut.String(), res.TokenOutAmount.String())
+
+			// the amount in of both routes is spent, taker fees included.
+			balanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Require().Equal(balanceBefore.AmountOf(baseDenom).Sub(totalAmountIn).String(), balanceAfter.AmountOf(baseDenom).String())
+			suite.Require().Equal(balanceBefore.AmountOf(fooDenom).Add(res.TokenOutAmount).String(), balanceAfter.AmountOf(fooDenom).String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSplitRouteChargesTakerFeePerRoute() {
+	suite.SetupTest()
+	directRoute, twoHopRoute := suite.prepareSplitRoutePools()
+	directAmountIn, twoHopAmountIn := sdk.NewInt(10000), sdk.NewInt(5000)
+
+	// the split route swap matches the same routes swapped one by one, each charged its own taker fee.
+	sender := suite.TestAccs[1]
+	suite.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(baseDenom, directAmountIn.Add(twoHopAmountIn))))
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	directOut, err := suite.App.PoolManagerKeeper.SwapExactAmountInWithTakerFee(cacheCtx, sender, directRoute, sdk.NewCoin(baseDenom, directAmountIn), sdk.OneInt(), sdk.Dec{})
+	suite.Require().NoError(err)
+	twoHopOut, err := suite.App.PoolManagerKeeper.SwapExactAmountInWithTakerFee(cacheCtx, sender, twoHopRoute, sdk.NewCoin(baseDenom, twoHopAmountIn), sdk.OneInt(), sdk.Dec{})
+	suite.Require().NoError(err)
+
+	tokenOut, err := suite.App.PoolManagerKeeper.SplitRouteSwapExactAmountIn(suite.Ctx, sender, []types.SwapAmountInSplitRoute{
+		{Pools: directRoute, TokenInAmount: directAmountIn},
+		{Pools: twoHopRoute, TokenInAmount: twoHopAmountIn},
+	}, baseDenom, sdk.OneInt(), sdk.Dec{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(directOut.Add(twoHopOut).String(), tokenOut.String())
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// SwapExactAmountInWithTakerFee routes an exact amount in swap over the given routes after
+// subtracting the taker fee from tokenIn, and charges the taker fee from the sender.
+// The taker fee is swapped on the first pool of the route if it is not a fee token.
+// A positive maxPriceImpact bounds the price impact of each hop of the route.
+func (k Keeper) SwapExactAmountInWithTakerFee(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount sdk.Int,
+	maxPriceImpact sdk.Dec,
+) (tokenOutAmount sdk.Int, err error) {
+	takerFee := k.takerFeeKeeper.GetTakerFee(ctx)
+	tokenInAfterSubTakerFee, takerFeeCoin := k.takerFeeKeeper.SubTakerFee(tokenIn, takerFee)
+
+	tokenOutAmount, err = k.routeExactAmountIn(ctx, sender, routes, tokenInAfterSubTakerFee, tokenOutMinAmount, maxPriceImpact)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	// first pool for taker fee swaps if needed
+	takerFeeRoute := routes[0]
+
+	// OUT denom is the last route's token out denom.
+	outDenom := routes[len(routes)-1].TokenOutDenom
+
+	err = k.takerFeeKeeper.ChargeTakerFee(ctx, takerFeeCoin, sender, takerFeeRoute, tokenIn.Denom, outDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return tokenOutAmount, nil
+}
+
+// SwapExactAmountOutWithTakerFee routes an exact amount out swap over the given routes, keeping
+// enough of tokenInMaxAmount aside for the taker fee, and charges the taker fee from the sender.
+// Returns the amount in spent, taker fee included.
+// A positive maxPriceImpact bounds the price impact of each hop of the route.
+func (k Keeper) SwapExactAmountOutWithTakerFee(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountOutRoute,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	maxPriceImpact sdk.Dec,
+) (tokenInAmount sdk.Int, err error) {
+	if err := types.SwapAmountOutRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	takerFee := k.takerFeeKeeper.GetTakerFee(ctx)
+	inDenom := routes[0].TokenInDenom
+
+	// limit the tokenInMaxAmount to have enough for taker fee
+	maxTokenIn := sdk.NewCoin(inDenom, tokenInMaxAmount)
+	tokenInAfterSubTakerFee, _ := k.takerFeeKeeper.SubTakerFee(maxTokenIn, takerFee)
+
+	tokenInAmount, err = k.routeExactAmountOut(ctx, sender, routes, tokenInAfterSubTakerFee.Amount, tokenOut, maxPriceImpact)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenInCoin := sdk.NewCoin(inDenom, tokenInAmount)
+	tokenInWithTakerFee, takerFeeCoin := k.takerFeeKeeper.AddTakerFee(tokenInCoin, takerFee)
+
+	// first pool for taker fee swaps if needed
+	takerFeeRoute := types.SwapAmountInRoute{PoolId: routes[0].PoolId}
+	if len(routes) > 1 {
+		takerFeeRoute.TokenOutDenom = routes[1].TokenInDenom
+	} else {
+		takerFeeRoute.TokenOutDenom = tokenOut.Denom
+	}
+
+	err = k.takerFeeKeeper.ChargeTakerFee(ctx, takerFeeCoin, sender, takerFeeRoute, inDenom, tokenOut.Denom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return tokenInWithTakerFee.Amount, nil
+}
+
+// MultihopEstimateOutGivenExactAmountInWithTakerFee estimates the amount out of
+// SwapExactAmountInWithTakerFee, with the taker fee subtracted from tokenIn.
+// A positive maxPriceImpact bounds the price impact of each hop of the route.
+func (k Keeper) MultihopEstimateOutGivenExactAmountInWithTakerFee(
+	ctx sdk.Context,
+	routes []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	maxPriceImpact sdk.Dec,
+) (tokenOutAmount sdk.Int, err error) {
+	takerFee := k.takerFeeKeeper.GetTakerFee(ctx)
+	tokenInAfterSubTakerFee, _ := k.takerFeeKeeper.SubTakerFee(tokenIn, takerFee)
+	return k.multihopEstimateOutGivenExactAmountIn(ctx, routes, tokenInAfterSubTakerFee, maxPriceImpact)
+}
+
+// MultihopEstimateInGivenExactAmountOutWithTakerFee estimates the amount in of
+// SwapExactAmountOutWithTakerFee, with the taker fee added to the amount in.
+// A positive maxPriceImpact bounds the price impact of each hop of the route.
+func (k Keeper) MultihopEstimateInGivenExactAmountOutWithTakerFee(
+	ctx sdk.Context,
+	routes []types.SwapAmountOutRoute,
+	tokenOut sdk.Coin,
+	maxPriceImpact sdk.Dec,
+) (tokenInAmount sdk.Int, err error) {
+	if err := types.SwapAmountOutRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenInAmount, err = k.multihopEstimateInGivenExactAmountOut(ctx, routes, tokenOut, maxPriceImpact)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	takerFee := k.takerFeeKeeper.GetTakerFee(ctx)
+	tokenInAfterAddTakerFee, _ := k.takerFeeKeeper.AddTakerFee(sdk.NewCoin(routes[0].TokenInDenom, tokenInAmount), takerFee)
+	return tokenInAfterAddTakerFee.Amount, nil
+}
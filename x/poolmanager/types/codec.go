@@ -4,15 +4,29 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
 )
 
-// RegisterLegacyAminoCodec registers the necessary x/gamm interfaces and concrete types
+// RegisterLegacyAminoCodec registers the necessary x/poolmanager interfaces and concrete types
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSwapExactAmountIn{}, "dymensionxyz/dymension/poolmanager/SwapExactAmountIn", nil)
+	cdc.RegisterConcrete(&MsgSwapExactAmountOut{}, "dymensionxyz/dymension/poolmanager/SwapExactAmountOut", nil)
+	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountIn{}, "dymensionxyz/dymension/poolmanager/SplitRouteSwapExactAmountIn", nil)
+	cdc.RegisterConcrete(&MsgFlashSwap{}, "dymensionxyz/dymension/poolmanager/FlashSwap", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgSwapExactAmountIn{},
+		&MsgSwapExactAmountOut{},
+		&MsgSplitRouteSwapExactAmountIn{},
+		&MsgFlashSwap{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
 var (
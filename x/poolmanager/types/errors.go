@@ -3,6 +3,8 @@ package types
 import (
 	"errors"
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -10,6 +12,21 @@ var (
 	ErrInvalidPool       = errors.New("attempting to create an invalid pool")
 	ErrTooFewPoolAssets  = errors.New("pool should have at least 2 assets, as they must be swapping between at least two assets")
 	ErrTooManyPoolAssets = errors.New("pool has too many assets (currently capped at 8 assets per pool)")
+
+	ErrNotPositiveCriteria = errors.New("min out amount or max in amount should be positive")
+	ErrLimitMinAmount      = errors.New("token amount is lesser than min amount")
+
+	ErrNotPositiveTokenInAmount  = errors.New("token in amount of each split route should be positive")
+	ErrInvalidFinalTokenOut      = errors.New("all split routes should end in the same token out denom")
+	ErrDuplicateRoutesNotAllowed = errors.New("duplicate split routes are not allowed")
+
+	ErrSameTokenInAndOut = errors.New("token in and token out denoms must differ")
+
+	ErrNegativeMaxPriceImpact = errors.New("max price impact must not be negative")
+
+	ErrNoFlashSwapMsgs     = errors.New("flash swap must execute at least one message")
+	ErrNestedFlashSwap     = errors.New("flash swaps cannot be nested")
+	ErrInvalidFlashSwapMsg = errors.New("flash swap messages must be signed by the flash swap sender only")
 )
 
 type FailedToFindRouteError struct {
@@ -28,3 +45,40 @@ type UndefinedRouteError struct {
 func (e UndefinedRouteError) Error() string {
 	return fmt.Sprintf("route is not defined for the given pool type (%s) and pool id (%d)", e.PoolType, e.PoolId)
 }
+
+type InvalidMaxHopsError struct {
+	MaxHops uint32
+}
+
+func (e InvalidMaxHopsError) Error() string {
+	return fmt.Sprintf("max hops (%d) must be between 1 and %d", e.MaxHops, MaxBestRouteHops)
+}
+
+type NoRouteFoundError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+	MaxHops       uint32
+}
+
+func (e NoRouteFoundError) Error() string {
+	return fmt.Sprintf("no route found from %s to %s within %d hops", e.TokenInDenom, e.TokenOutDenom, e.MaxHops)
+}
+
+type PriceImpactExceededError struct {
+	PoolId         uint64
+	PriceImpact    sdk.Dec
+	MaxPriceImpact sdk.Dec
+}
+
+func (e PriceImpactExceededError) Error() string {
+	return fmt.Sprintf("price impact (%s) on pool %d exceeds max price impact (%s)", e.PriceImpact, e.PoolId, e.MaxPriceImpact)
+}
+
+type FlashSwapNotRepaidError struct {
+	TokenInRequired  sdk.Coin
+	TokenInMaxAmount sdk.Int
+}
+
+func (e FlashSwapNotRepaidError) Error() string {
+	return fmt.Sprintf("repaying the flash swap requires %s, more than the max amount in (%s)", e.TokenInRequired, e.TokenInMaxAmount)
+}
@@ -1,5 +1,14 @@
 package types
 
 const (
+	TypeEvtSwapExactAmountIn  = "swap_exact_amount_in"
+	TypeEvtSwapExactAmountOut = "swap_exact_amount_out"
+
+	TypeEvtSplitRouteSwapExactAmountIn = "split_route_swap_exact_amount_in"
+
+	TypeEvtFlashSwap = "flash_swap"
+
 	AttributeValueCategory = ModuleName
+	AttributeKeyTokensIn   = "tokens_in"
+	AttributeKeyTokensOut  = "tokens_out"
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -21,6 +22,11 @@ type BankI interface {
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
+// MessageRouter routes the messages executed by flash swaps to their handlers.
+type MessageRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
 // TODO: godoc
 type SwapI interface {
 	InitializePool(ctx sdk.Context, pool PoolI, creatorAddress sdk.AccAddress) error
@@ -64,4 +70,47 @@ type SwapI interface {
 		tokenInDenom string,
 		swapFee sdk.Dec,
 	) (tokenIn sdk.Coin, err error)
+
+	// SimulateSwapExactAmountIn calculates the amount of tokenOut given tokenIn and returns the pool
+	// as it would be after the swap. The given pool may be updated in memory, the state is left untouched.
+	SimulateSwapExactAmountIn(
+		ctx sdk.Context,
+		poolI PoolI,
+		tokenIn sdk.Coin,
+		tokenOutDenom string,
+		swapFee sdk.Dec,
+	) (tokenOut sdk.Coin, poolAfterSwap PoolI, err error)
+	// SimulateSwapExactAmountOut calculates the amount of tokenIn given tokenOut and returns the pool
+	// as it would be after the swap. The given pool may be updated in memory, the state is left untouched.
+	SimulateSwapExactAmountOut(
+		ctx sdk.Context,
+		poolI PoolI,
+		tokenOut sdk.Coin,
+		tokenInDenom string,
+		swapFee sdk.Dec,
+	) (tokenIn sdk.Coin, poolAfterSwap PoolI, err error)
+}
+
+// CanonicalPoolKeeper is implemented by the pool modules keeping a canonical pool per
+// denom pair, which the best route finder routes through by default.
+type CanonicalPoolKeeper interface {
+	// GetCanonicalPoolId returns the canonical pool of the pair of the given denoms,
+	// and false if the pair has none.
+	GetCanonicalPoolId(ctx sdk.Context, denomA, denomB string) (uint64, bool)
+}
+
+// DenomPoolIndex is implemented by the pool modules indexing their pools by denom, which the
+// best route finder searches.
+type DenomPoolIndex interface {
+	// GetDenomPoolIds returns the ids of the pools holding the given denom, in increasing order.
+	GetDenomPoolIds(ctx sdk.Context, denom string) []uint64
+}
+
+// TakerFeeKeeper defines the contract needed to charge the taker fee on
+// swaps routed through the pool manager.
+type TakerFeeKeeper interface {
+	GetTakerFee(ctx sdk.Context) sdk.Dec
+	SubTakerFee(tokenIn sdk.Coin, takerFee sdk.Dec) (sdk.Coin, sdk.Coin)
+	AddTakerFee(tokenIn sdk.Coin, takerFee sdk.Dec) (sdk.Coin, sdk.Coin)
+	ChargeTakerFee(ctx sdk.Context, takerFeeCoin sdk.Coin, sender sdk.AccAddress, route SwapAmountInRoute, inDenom, outDenom string) error
 }
@@ -29,14 +29,30 @@ type PoolType int32
 const (
 	// Balancer is the standard xy=k curve. Its pool model is defined in x/gamm.
 	Balancer PoolType = 0
+	// Stableswap is the Curve-style stableswap curve with an amplification
+	// coefficient and per-asset scaling factors. Its pool model is defined in
+	// x/gamm.
+	Stableswap PoolType = 1
+	// Concentrated is the pool model specific to concentrated liquidity. It is
+	// defined in x/concentrated-liquidity.
+	Concentrated PoolType = 2
+	// Orderbook is the pool model of resting limit orders on price levels. It is
+	// defined in x/orderbook.
+	Orderbook PoolType = 3
 )
 
 var PoolType_name = map[int32]string{
 	0: "Balancer",
+	1: "Stableswap",
+	2: "Concentrated",
+	3: "Orderbook",
 }
 
 var PoolType_value = map[string]int32{
-	"Balancer": 0,
+	"Balancer":     0,
+	"Stableswap":   1,
+	"Concentrated": 2,
+	"Orderbook":    3,
 }
 
 func (x PoolType) String() string {
@@ -114,26 +130,28 @@ func init() {
 }
 
 var fileDescriptor_8a0b43b2f1d9c1c4 = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0x4d, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x0b, 0xf2, 0xf3, 0x73,
-	0x72, 0x13, 0xf3, 0x12, 0xd3, 0x53, 0x8b, 0xf4, 0xcb, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5,
-	0x73, 0xf3, 0x53, 0x4a, 0x73, 0x52, 0xe3, 0x8b, 0xf2, 0x4b, 0x4b, 0x52, 0xf5, 0x0a, 0x8a, 0xf2,
-	0x4b, 0xf2, 0x85, 0xb4, 0x90, 0xb5, 0xeb, 0xc1, 0x39, 0x7a, 0x48, 0xda, 0xf5, 0xa0, 0xda, 0xa5,
-	0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xda, 0xf4, 0x41, 0x2c, 0x88, 0x09, 0x4a, 0x13, 0x19, 0xb9,
-	0xb8, 0x7d, 0xc1, 0x06, 0x07, 0x81, 0xcc, 0x15, 0x0a, 0xe4, 0xe2, 0x04, 0x69, 0x8e, 0x2f, 0xa9,
-	0x2c, 0x48, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x33, 0x32, 0xd1, 0x23, 0xde, 0x16, 0xbd, 0x80,
-	0xfc, 0xfc, 0x9c, 0x90, 0xca, 0x82, 0xd4, 0x20, 0x8e, 0x02, 0x28, 0x4b, 0x48, 0x9f, 0x8b, 0x1d,
-	0x6c, 0x64, 0x66, 0x8a, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x8b, 0x93, 0xd8, 0x89, 0x7b, 0xf2, 0x8c,
-	0x9f, 0xee, 0xc9, 0xf3, 0x55, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0x41, 0x25, 0x95, 0x82, 0xd8, 0x40,
-	0x2c, 0xcf, 0x14, 0x2d, 0x39, 0x2e, 0x0e, 0x98, 0x31, 0x42, 0x3c, 0x5c, 0x1c, 0x4e, 0x89, 0x39,
-	0x89, 0x79, 0xc9, 0xa9, 0x45, 0x02, 0x0c, 0x52, 0x2c, 0x1d, 0x8b, 0xe5, 0x18, 0x9c, 0x02, 0x4f,
-	0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18,
-	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x3c, 0x3d, 0xb3, 0x24, 0xa3, 0x34,
-	0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0xbf, 0x38, 0x37, 0xbf, 0x38, 0xb3, 0x58, 0x37, 0x27, 0x31,
-	0xa9, 0x18, 0xc6, 0xd1, 0x2f, 0x33, 0x34, 0xd5, 0xaf, 0x40, 0x09, 0x5d, 0x90, 0x47, 0x8b, 0x93,
-	0xd8, 0xc0, 0xa1, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x30, 0xa2, 0x8b, 0x6c, 0x90, 0x01,
-	0x00, 0x00,
+	// 334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xbf, 0x6a, 0x2a, 0x41,
+	0x18, 0xc5, 0x77, 0xbc, 0xe2, 0xd5, 0xb9, 0x5e, 0x59, 0x96, 0x10, 0xc4, 0x62, 0x14, 0x2b, 0x11,
+	0x32, 0x83, 0xf9, 0x43, 0x20, 0x90, 0xc6, 0x54, 0x29, 0x82, 0xd1, 0xa4, 0x4a, 0x23, 0xb3, 0xee,
+	0xb0, 0x59, 0x32, 0xbb, 0xdf, 0x32, 0x33, 0x1a, 0x37, 0x4f, 0x90, 0x32, 0x79, 0x86, 0xbc, 0x8c,
+	0xa5, 0x65, 0x2a, 0x09, 0xfa, 0x06, 0x79, 0x82, 0xb0, 0x1b, 0x15, 0xd3, 0xa5, 0x3b, 0x87, 0xe1,
+	0xf7, 0x1b, 0x38, 0x1f, 0x3e, 0xf7, 0x92, 0x50, 0x44, 0x3a, 0x80, 0x68, 0x9a, 0x3c, 0xb1, 0x6d,
+	0x61, 0x31, 0x80, 0x0c, 0x79, 0xc4, 0x7d, 0xa1, 0xd8, 0xa4, 0xe3, 0x0a, 0xc3, 0x3b, 0x2c, 0x04,
+	0x6f, 0x2c, 0xc5, 0x50, 0xc1, 0xd8, 0x08, 0x1a, 0x2b, 0x30, 0xe0, 0xb4, 0x77, 0x71, 0xba, 0x2d,
+	0x74, 0x07, 0xa7, 0x6b, 0xbc, 0xb6, 0xe7, 0x83, 0x0f, 0x19, 0xc6, 0xd2, 0xf4, 0x6d, 0x68, 0xbe,
+	0x22, 0xfc, 0xef, 0x2a, 0x13, 0x0f, 0x52, 0xaf, 0xd3, 0xc7, 0xa5, 0x14, 0x1e, 0x9a, 0x24, 0x16,
+	0x55, 0xd4, 0x40, 0xad, 0xca, 0xe1, 0x31, 0xfd, 0xfd, 0x2f, 0xf4, 0x1a, 0x40, 0xde, 0x26, 0xb1,
+	0x18, 0x14, 0xe3, 0x75, 0x72, 0x18, 0xfe, 0x9b, 0x29, 0x03, 0xaf, 0x9a, 0x6b, 0xa0, 0x56, 0xbe,
+	0xbb, 0x3f, 0x5b, 0xd4, 0xd1, 0xe7, 0xa2, 0x5e, 0x49, 0x78, 0x28, 0xcf, 0x9a, 0xeb, 0xc7, 0xe6,
+	0xa0, 0x90, 0xa6, 0x4b, 0xaf, 0xdd, 0xc3, 0xc5, 0x8d, 0xc6, 0x29, 0xe3, 0x62, 0x97, 0x4b, 0x1e,
+	0x8d, 0x84, 0xb2, 0x2d, 0xa7, 0x82, 0xf1, 0x8d, 0xe1, 0xae, 0x14, 0xfa, 0x91, 0xc7, 0x36, 0x72,
+	0x6c, 0x5c, 0xbe, 0x80, 0x68, 0x24, 0x22, 0xa3, 0xb8, 0x11, 0x9e, 0x9d, 0x73, 0xfe, 0xe3, 0x52,
+	0x4f, 0x79, 0x42, 0xb9, 0x00, 0x0f, 0xf6, 0x9f, 0x5a, 0xfe, 0xf9, 0x8d, 0x58, 0xdd, 0xfe, 0x6c,
+	0x49, 0xd0, 0x7c, 0x49, 0xd0, 0xc7, 0x92, 0xa0, 0x97, 0x15, 0xb1, 0xe6, 0x2b, 0x62, 0xbd, 0xaf,
+	0x88, 0x75, 0x77, 0xea, 0x07, 0xe6, 0x7e, 0xec, 0xd2, 0x11, 0x84, 0x0c, 0x74, 0x08, 0x3a, 0xd0,
+	0x07, 0x92, 0xbb, 0x7a, 0x53, 0xd8, 0xa4, 0x73, 0xc2, 0xa6, 0x3f, 0xce, 0x91, 0x2e, 0xa3, 0xdd,
+	0x42, 0x36, 0xdf, 0xd1, 0xd7, 0x00, 0xb0, 0x9e, 0x4b, 0x18, 0xc1, 0x01, 0x00, 0x00,
 }
 
 func (m *ModuleRoute) Marshal() (dAtA []byte, err error) {
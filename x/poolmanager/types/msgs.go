@@ -0,0 +1,264 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+)
+
+// constants.
+const (
+	TypeMsgSwapExactAmountIn  = "swap_exact_amount_in"
+	TypeMsgSwapExactAmountOut = "swap_exact_amount_out"
+
+	TypeMsgSplitRouteSwapExactAmountIn = "split_route_swap_exact_amount_in"
+
+	TypeMsgFlashSwap = "flash_swap"
+)
+
+var _ sdk.Msg = &MsgSwapExactAmountIn{}
+
+func (msg MsgSwapExactAmountIn) Route() string { return RouterKey }
+func (msg MsgSwapExactAmountIn) Type() string  { return TypeMsgSwapExactAmountIn }
+func (msg MsgSwapExactAmountIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = SwapAmountInRoutes(msg.Routes).Validate()
+	if err != nil {
+		return err
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return ErrNotPositiveCriteria
+	}
+
+	return ValidateMaxPriceImpact(msg.MaxPriceImpact)
+}
+
+func (msg MsgSwapExactAmountIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSwapExactAmountIn) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg MsgSwapExactAmountIn) TokenOutDenom() string {
+	lastRouteIndex := len(msg.Routes) - 1
+	return msg.Routes[lastRouteIndex].GetTokenOutDenom()
+}
+
+var _ sdk.Msg = &MsgSwapExactAmountOut{}
+
+func (msg MsgSwapExactAmountOut) Route() string { return RouterKey }
+func (msg MsgSwapExactAmountOut) Type() string  { return TypeMsgSwapExactAmountOut }
+func (msg MsgSwapExactAmountOut) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = SwapAmountOutRoutes(msg.Routes).Validate()
+	if err != nil {
+		return err
+	}
+
+	if !msg.TokenOut.IsValid() || !msg.TokenOut.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenOut.String())
+	}
+
+	if !msg.TokenInMaxAmount.IsPositive() {
+		return ErrNotPositiveCriteria
+	}
+
+	return ValidateMaxPriceImpact(msg.MaxPriceImpact)
+}
+
+func (msg MsgSwapExactAmountOut) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSwapExactAmountOut) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg MsgSwapExactAmountOut) TokenInDenom() string {
+	return msg.Routes[0].GetTokenInDenom()
+}
+
+var _ sdk.Msg = &MsgSplitRouteSwapExactAmountIn{}
+
+func (msg MsgSplitRouteSwapExactAmountIn) Route() string { return RouterKey }
+func (msg MsgSplitRouteSwapExactAmountIn) Type() string  { return TypeMsgSplitRouteSwapExactAmountIn }
+func (msg MsgSplitRouteSwapExactAmountIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = sdk.ValidateDenom(msg.TokenInDenom)
+	if err != nil {
+		return err
+	}
+
+	err = SwapAmountInSplitRoutes(msg.Routes).Validate()
+	if err != nil {
+		return err
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return ErrNotPositiveCriteria
+	}
+
+	return ValidateMaxPriceImpact(msg.MaxPriceImpact)
+}
+
+func (msg MsgSplitRouteSwapExactAmountIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSplitRouteSwapExactAmountIn) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg MsgSplitRouteSwapExactAmountIn) TokenOutDenom() string {
+	return msg.Routes[0].TokenOutDenom()
+}
+
+var (
+	_ sdk.Msg                            = &MsgFlashSwap{}
+	_ codectypes.UnpackInterfacesMessage = MsgFlashSwap{}
+)
+
+// NewMsgFlashSwap returns a flash swap of the sender over the given routes, executing the given messages.
+func NewMsgFlashSwap(sender sdk.AccAddress, routes []SwapAmountOutRoute, tokenInMaxAmount sdk.Int, tokenOut sdk.Coin, msgs []sdk.Msg) (*MsgFlashSwap, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgFlashSwap{
+		Sender:           sender.String(),
+		Routes:           routes,
+		TokenInMaxAmount: tokenInMaxAmount,
+		TokenOut:         tokenOut,
+		Msgs:             anys,
+	}, nil
+}
+
+func (msg MsgFlashSwap) Route() string { return RouterKey }
+func (msg MsgFlashSwap) Type() string  { return TypeMsgFlashSwap }
+func (msg MsgFlashSwap) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = SwapAmountOutRoutes(msg.Routes).Validate()
+	if err != nil {
+		return err
+	}
+
+	if !msg.TokenOut.IsValid() || !msg.TokenOut.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenOut.String())
+	}
+
+	if !msg.TokenInMaxAmount.IsPositive() {
+		return ErrNotPositiveCriteria
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return ErrNoFlashSwapMsgs
+	}
+	for i, innerMsg := range msgs {
+		if err := innerMsg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+		if isFlashSwap, err := IsFlashSwap(innerMsg); err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		} else if isFlashSwap {
+			return ErrNestedFlashSwap
+		}
+		signers := innerMsg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(sender) {
+			return sdkerrors.Wrapf(ErrInvalidFlashSwapMsg, "message %d is signed by %v", i, signers)
+		}
+	}
+
+	return nil
+}
+
+// IsFlashSwap returns whether the message is a flash swap, or an authz MsgExec executing one,
+// possibly through other MsgExec.
+func IsFlashSwap(msg sdk.Msg) (bool, error) {
+	switch msg := msg.(type) {
+	case *MsgFlashSwap:
+		return true, nil
+	case *authz.MsgExec:
+		execMsgs, err := msg.GetMessages()
+		if err != nil {
+			return false, err
+		}
+		for _, execMsg := range execMsgs {
+			if isFlashSwap, err := IsFlashSwap(execMsg); err != nil || isFlashSwap {
+				return isFlashSwap, err
+			}
+		}
+	}
+	return false, nil
+}
+
+// GetSignBytes uses the authz amino codec, on which modules register their messages,
+// so that the messages executed by the flash swap can be serialized.
+func (msg MsgFlashSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgFlashSwap) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetMessages returns the messages executed by the flash swap.
+func (msg MsgFlashSwap) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "MsgFlashSwap")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.
+func (msg MsgFlashSwap) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, x := range msg.Msgs {
+		var innerMsg sdk.Msg
+		if err := unpacker.UnpackAny(x, &innerMsg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v15/testutils/apptesting"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+func TestMsgSplitRouteSwapExactAmountInValidateBasic(t *testing.T) {
+	sender := apptesting.CreateRandomAccounts(1)[0].String()
+	validRoutes := func() []types.SwapAmountInSplitRoute {
+		return []types.SwapAmountInSplitRoute{
+			{
+				Pools:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "adym"}, {PoolId: 2, TokenOutDenom: "uion"}},
+				TokenInAmount: sdk.NewInt(1000),
+			},
+			{
+				Pools:         []types.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: "uion"}},
+				TokenInAmount: sdk.NewInt(2000),
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		modify        func(msg *types.MsgSplitRouteSwapExactAmountIn)
+		expectedError error
+	}{
+		"valid msg": {
+			modify: func(msg *types.MsgSplitRouteSwapExactAmountIn) {},
+		},
+		"invalid sender": {
+			modify:        func(msg *types.MsgSplitRouteSwapExactAmountIn) { msg.Sender = "" },
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		"empty routes": {
+			modify:        func(msg *types.MsgSplitRouteSwapExactAmountIn) { msg.Routes = nil },
+			expectedError: types.ErrEmptyRoutes,
+		},
+		"route with empty pools": {
+			modify:        func(msg *types.MsgSplitRouteSwapExactAmountIn) { msg.Routes[1].Pools = nil },
+			expectedError: types.ErrEmptyRoutes,
+		},
+		"route with no amount in": {
+			modify:        func(msg *types.MsgSplitRouteSwapExactAmountIn) { msg.Routes[0].TokenInAmount = sdk.ZeroInt() },
+			expectedError: types.ErrNotPositiveTokenInAmount,
+		},
+		"routes ending in different denoms": {
+			modify:        func(msg *types.MsgSplitRouteSwapExactAmountIn) { msg.Routes[1].Pools[0].TokenOutDenom = "adym" },
+			expectedError: types.ErrInvalidFinalTokenOut,
+		},
+		"duplicate routes": {
+			modify:        func(msg *types.MsgSplitRouteSwapExactAmountIn) { msg.Routes[1].Pools = msg.Routes[0].Pools },
+			expectedError: types.ErrDuplicateRoutesNotAllowed,
+		},
+		"non positive min amount out": {
+			modify:        func(msg *types.MsgSplitRouteSwapExactAmountIn) { msg.TokenOutMinAmount = sdk.ZeroInt() },
+			expectedError: types.ErrNotPositiveCriteria,
+		},
+		"valid max price impact": {
+			modify: func(msg *types.MsgSplitRouteSwapExactAmountIn) { msg.MaxPriceImpact = sdk.NewDecWithPrec(5, 2) },
+		},
+		"negative max price impact": {
+			modify:        func(msg *types.MsgSplitRouteSwapExactAmountIn) { msg.MaxPriceImpact = sdk.NewDecWithPrec(-5, 2) },
+			expectedError: types.ErrNegativeMaxPriceImpact,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg := types.MsgSplitRouteSwapExactAmountIn{
+				Sender:            sender,
+				Routes:            validRoutes(),
+				TokenInDenom:      "stake",
+				TokenOutMinAmount: sdk.OneInt(),
+			}
+			tc.modify(&msg)
+
+			err := msg.ValidateBasic()
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgFlashSwapValidateBasic(t *testing.T) {
+	accounts := apptesting.CreateRandomAccounts(2)
+	sender, other := accounts[0], accounts[1]
+	routes := []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "adym"}}
+	tokenOut := sdk.NewCoin("uion", sdk.NewInt(1000))
+	swapMsg := &types.MsgSwapExactAmountIn{
+		Sender:            sender.String(),
+		Routes:            []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "adym"}},
+		TokenIn:           tokenOut,
+		TokenOutMinAmount: sdk.OneInt(),
+	}
+	nestedMsg, err := types.NewMsgFlashSwap(sender, routes, sdk.NewInt(1000), tokenOut, []sdk.Msg{swapMsg})
+	require.NoError(t, err)
+	execMsg := authz.NewMsgExec(sender, []sdk.Msg{nestedMsg})
+	nestedExecMsg := authz.NewMsgExec(sender, []sdk.Msg{&execMsg})
+
+	tests := map[string]struct {
+		msgs          []sdk.Msg
+		modify        func(msg *types.MsgFlashSwap)
+		expectedError error
+	}{
+		"valid msg": {
+			msgs: []sdk.Msg{swapMsg},
+		},
+		"invalid sender": {
+			msgs:          []sdk.Msg{swapMsg},
+			modify:        func(msg *types.MsgFlashSwap) { msg.Sender = "" },
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		"empty routes": {
+			msgs:          []sdk.Msg{swapMsg},
+			modify:        func(msg *types.MsgFlashSwap) { msg.Routes = nil },
+			expectedError: types.ErrEmptyRoutes,
+		},
+		"non positive token out": {
+			msgs:          []sdk.Msg{swapMsg},
+			modify:        func(msg *types.MsgFlashSwap) { msg.TokenOut.Amount = sdk.ZeroInt() },
+			expectedError: sdkerrors.ErrInvalidCoins,
+		},
+		"non positive max amount in": {
+			msgs:          []sdk.Msg{swapMsg},
+			modify:        func(msg *types.MsgFlashSwap) { msg.TokenInMaxAmount = sdk.ZeroInt() },
+			expectedError: types.ErrNotPositiveCriteria,
+		},
+		"no msgs": {
+			expectedError: types.ErrNoFlashSwapMsgs,
+		},
+		"nested flash swap": {
+			msgs:          []sdk.Msg{nestedMsg},
+			expectedError: types.ErrNestedFlashSwap,
+		},
+		"flash swap nested in authz exec": {
+			msgs:          []sdk.Msg{&execMsg},
+			expectedError: types.ErrNestedFlashSwap,
+		},
+		"flash swap nested in nested authz exec": {
+			msgs:          []sdk.Msg{&nestedExecMsg},
+			expectedError: types.ErrNestedFlashSwap,
+		},
+		"msg with an invalid signer": {
+			msgs:          []sdk.Msg{&banktypes.MsgSend{FromAddress: "", ToAddress: other.String(), Amount: sdk.NewCoins(tokenOut)}},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		"msg signed by another account": {
+			msgs:          []sdk.Msg{banktypes.NewMsgSend(other, sender, sdk.NewCoins(tokenOut))},
+			expectedError: types.ErrInvalidFlashSwapMsg,
+		},
+		"invalid msg": {
+			msgs:          []sdk.Msg{banktypes.NewMsgSend(sender, other, sdk.Coins{sdk.Coin{Denom: "uion", Amount: sdk.ZeroInt()}})},
+			expectedError: sdkerrors.ErrInvalidCoins,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := types.NewMsgFlashSwap(sender, routes, sdk.NewInt(1000), tokenOut, tc.msgs)
+			require.NoError(t, err)
+			if tc.modify != nil {
+				tc.modify(msg)
+			}
+
+			err = msg.ValidateBasic()
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.NotPanics(t, func() { msg.GetSignBytes() })
+		})
+	}
+}
+
+func TestPriceImpact(t *testing.T) {
+	tests := map[string]struct {
+		spotPriceBefore     sdk.Dec
+		spotPriceAfter      sdk.Dec
+		expectedPriceImpact sdk.Dec
+	}{
+		"price up": {
+			spotPriceBefore:     sdk.NewDec(2),
+			spotPriceAfter:      sdk.NewDecWithPrec(21, 1),
+			expectedPriceImpact: sdk.NewDecWithPrec(5, 2),
+		},
+		"price down": {
+			spotPriceBefore:     sdk.NewDec(2),
+			spotPriceAfter:      sdk.NewDecWithPrec(19, 1),
+			expectedPriceImpact: sdk.NewDecWithPrec(5, 2),
+		},
+		"price unchanged": {
+			spotPriceBefore:     sdk.NewDec(2),
+			spotPriceAfter:      sdk.NewDec(2),
+			expectedPriceImpact: sdk.ZeroDec(),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedPriceImpact, types.PriceImpact(tc.spotPriceBefore, tc.spotPriceAfter))
+		})
+	}
+}
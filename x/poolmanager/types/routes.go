@@ -1,9 +1,44 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// MaxBestRouteHops is the maximum number of pools in a route searched by the best route finder.
+	MaxBestRouteHops = 4
+	// MaxBestRoutePathsExplored bounds the number of paths explored by the best route finder,
+	// which bounds the gas it consumes regardless of the number of pools.
+	MaxBestRoutePathsExplored = 1000
+	// MaxBestRoutePoolsLoaded bounds the number of pools loaded by the best route finder to
+	// build its graph, which bounds the gas it consumes regardless of the number of pools.
+	MaxBestRoutePoolsLoaded = 200
+)
+
+// ValidateMaxPriceImpact checks that the optional max price impact of a swap is not negative.
+// A nil or zero max price impact means no bound.
+func ValidateMaxPriceImpact(maxPriceImpact sdk.Dec) error {
+	if maxPriceImpact.IsNil() {
+		return nil
+	}
+	if maxPriceImpact.IsNegative() {
+		return ErrNegativeMaxPriceImpact
+	}
+	return nil
+}
+
+// HasPriceImpactBound returns true if the max price impact of a swap bounds its hops.
+func HasPriceImpactBound(maxPriceImpact sdk.Dec) bool {
+	return !maxPriceImpact.IsNil() && maxPriceImpact.IsPositive()
+}
+
+// PriceImpact returns the relative change of a spot price over a swap, |after - before| / before.
+func PriceImpact(spotPriceBefore, spotPriceAfter sdk.Dec) sdk.Dec {
+	return spotPriceAfter.Sub(spotPriceBefore).Abs().Quo(spotPriceBefore)
+}
+
 type SwapAmountInRoutes []SwapAmountInRoute
 
 func (routes SwapAmountInRoutes) Validate() error {
@@ -37,3 +72,51 @@ func (routes SwapAmountOutRoutes) Validate() error {
 
 	return nil
 }
+
+type SwapAmountInSplitRoutes []SwapAmountInSplitRoute
+
+// Validate checks that each route is a valid multihop route with a positive amount in,
+// that all routes end in the same denom and that no route is repeated.
+func (routes SwapAmountInSplitRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	uniqueRoutes := make(map[string]struct{}, len(routes))
+	finalTokenOutDenom := routes[0].TokenOutDenom()
+	for _, route := range routes {
+		err := SwapAmountInRoutes(route.Pools).Validate()
+		if err != nil {
+			return err
+		}
+
+		if route.TokenInAmount.IsNil() || !route.TokenInAmount.IsPositive() {
+			return ErrNotPositiveTokenInAmount
+		}
+
+		if route.TokenOutDenom() != finalTokenOutDenom {
+			return ErrInvalidFinalTokenOut
+		}
+
+		routeKey := route.routeKey()
+		if _, ok := uniqueRoutes[routeKey]; ok {
+			return ErrDuplicateRoutesNotAllowed
+		}
+		uniqueRoutes[routeKey] = struct{}{}
+	}
+
+	return nil
+}
+
+// TokenOutDenom returns the denom swapped out by the last pool of the route.
+func (route SwapAmountInSplitRoute) TokenOutDenom() string {
+	if len(route.Pools) == 0 {
+		return ""
+	}
+	return route.Pools[len(route.Pools)-1].TokenOutDenom
+}
+
+// routeKey returns a key identifying the pools and denoms of the route.
+func (route SwapAmountInSplitRoute) routeKey() string {
+	return fmt.Sprint(route.Pools)
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -127,9 +128,57 @@ func (m *SwapAmountOutRoute) GetTokenInDenom() string {
 	return ""
 }
 
+// SwapAmountInSplitRoute is one leg of a split route swap: a multihop route
+// and the amount of the token in to swap over it.
+type SwapAmountInSplitRoute struct {
+	Pools         []SwapAmountInRoute                    `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools" yaml:"pools"`
+	TokenInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount" yaml:"token_in_amount"`
+}
+
+func (m *SwapAmountInSplitRoute) Reset()         { *m = SwapAmountInSplitRoute{} }
+func (m *SwapAmountInSplitRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapAmountInSplitRoute) ProtoMessage()    {}
+func (*SwapAmountInSplitRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdca2fef76f2d15c, []int{2}
+}
+func (m *SwapAmountInSplitRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapAmountInSplitRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapAmountInSplitRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapAmountInSplitRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapAmountInSplitRoute.Merge(m, src)
+}
+func (m *SwapAmountInSplitRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapAmountInSplitRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapAmountInSplitRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapAmountInSplitRoute proto.InternalMessageInfo
+
+func (m *SwapAmountInSplitRoute) GetPools() []SwapAmountInRoute {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SwapAmountInRoute)(nil), "dymensionxyz.dymension.poolmanager.v1beta1.SwapAmountInRoute")
 	proto.RegisterType((*SwapAmountOutRoute)(nil), "dymensionxyz.dymension.poolmanager.v1beta1.SwapAmountOutRoute")
+	proto.RegisterType((*SwapAmountInSplitRoute)(nil), "dymensionxyz.dymension.poolmanager.v1beta1.SwapAmountInSplitRoute")
 }
 
 func init() {
@@ -137,29 +186,62 @@ func init() {
 }
 
 var fileDescriptor_fdca2fef76f2d15c = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0x4e, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x0b, 0xf2, 0xf3, 0x73,
-	0x72, 0x13, 0xf3, 0x12, 0xd3, 0x53, 0x8b, 0xf4, 0xcb, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5,
-	0x8b, 0xcb, 0x13, 0x0b, 0xe2, 0x8b, 0xf2, 0x4b, 0x4b, 0x52, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0xb4, 0x90, 0x35, 0xeb, 0xc1, 0x39, 0x7a, 0x48, 0x9a, 0xf5, 0xa0, 0x9a, 0xa5, 0x44, 0xd2,
-	0xf3, 0xd3, 0xf3, 0xc1, 0xda, 0xf4, 0x41, 0x2c, 0x88, 0x09, 0x4a, 0x2d, 0x8c, 0x5c, 0x82, 0xc1,
-	0xe5, 0x89, 0x05, 0x8e, 0xb9, 0xf9, 0xa5, 0x79, 0x25, 0x9e, 0x79, 0x41, 0x20, 0xd3, 0x85, 0xb4,
-	0xb9, 0xd8, 0x41, 0x46, 0xc4, 0x67, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0xb0, 0x38, 0x09, 0x7d,
-	0xba, 0x27, 0xcf, 0x57, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x04, 0x95, 0x50, 0x0a, 0x62, 0x03, 0xb1,
-	0x3c, 0x53, 0x84, 0x9c, 0xb8, 0xf8, 0x4b, 0xf2, 0xb3, 0x53, 0xf3, 0xe2, 0xf3, 0x4b, 0x4b, 0xe2,
-	0x53, 0x52, 0xf3, 0xf2, 0x73, 0x25, 0x98, 0x14, 0x18, 0x35, 0x38, 0x9d, 0xa4, 0x3e, 0xdd, 0x93,
-	0x17, 0x83, 0x68, 0x42, 0x53, 0xa0, 0x14, 0xc4, 0x0b, 0x16, 0xf1, 0x2f, 0x2d, 0x71, 0x01, 0xf3,
-	0x9b, 0x19, 0xb9, 0x84, 0x10, 0xce, 0xf0, 0x2f, 0x2d, 0x21, 0xc3, 0x1d, 0x0e, 0x5c, 0x7c, 0x10,
-	0x6b, 0x32, 0xf3, 0x88, 0x76, 0x06, 0x0f, 0x58, 0xc4, 0x33, 0x0f, 0xec, 0x0a, 0xa7, 0xc0, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xcf, 0x2f, 0xce, 0xcd, 0x2f, 0xce, 0x2c, 0xd6, 0xcd, 0x49, 0x4c,
-	0x2a, 0x86, 0x71, 0xf4, 0xcb, 0x0c, 0x4d, 0xf5, 0x2b, 0x50, 0x22, 0xad, 0xa4, 0xb2, 0x20, 0xb5,
-	0x38, 0x89, 0x0d, 0x1c, 0xcc, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x21, 0x72, 0x3b, 0xfe,
-	0xe7, 0x01, 0x00, 0x00,
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xbf, 0xab, 0xd3, 0x40,
+	0x1c, 0xcf, 0xe9, 0xf3, 0x89, 0xe7, 0xf3, 0x89, 0xe1, 0xf1, 0x28, 0x1d, 0x92, 0x92, 0x41, 0x8a,
+	0xd2, 0x3b, 0xaa, 0x88, 0x50, 0x11, 0x34, 0x38, 0x98, 0xa9, 0x98, 0x6e, 0x2e, 0xe1, 0xd2, 0x84,
+	0x78, 0x34, 0xb9, 0x0b, 0xbd, 0x4b, 0xdb, 0xb8, 0xba, 0x39, 0xf9, 0x27, 0xf8, 0xe7, 0x74, 0xec,
+	0x28, 0x0e, 0x41, 0xda, 0xc5, 0xb9, 0xe0, 0x2e, 0xb9, 0xa4, 0x6d, 0xac, 0x4b, 0x79, 0x53, 0xee,
+	0x73, 0xc9, 0xe7, 0xc7, 0xf7, 0x93, 0x2f, 0x7c, 0x15, 0xe4, 0x49, 0xc8, 0x04, 0xe5, 0x6c, 0x91,
+	0x7f, 0xc6, 0x7b, 0x80, 0x53, 0xce, 0xe3, 0x84, 0x30, 0x12, 0x85, 0x53, 0x3c, 0xeb, 0xfb, 0xa1,
+	0x24, 0x7d, 0x2c, 0xe6, 0x24, 0xf5, 0xa6, 0x3c, 0x93, 0x21, 0x4a, 0xa7, 0x5c, 0x72, 0xfd, 0x49,
+	0x93, 0x8c, 0xf6, 0x00, 0x35, 0xc8, 0xa8, 0x26, 0xb7, 0xaf, 0x22, 0x1e, 0x71, 0x45, 0xc3, 0xe5,
+	0xa9, 0x52, 0xb0, 0xbe, 0x02, 0xf8, 0x68, 0x34, 0x27, 0xe9, 0xdb, 0x84, 0x67, 0x4c, 0x3a, 0xcc,
+	0x2d, 0xd5, 0xf5, 0xa7, 0xf0, 0x6e, 0x29, 0xe1, 0xd1, 0xa0, 0x05, 0x3a, 0xa0, 0x7b, 0x66, 0xeb,
+	0xdb, 0xc2, 0xbc, 0xcc, 0x49, 0x12, 0x0f, 0xac, 0xfa, 0x85, 0xe5, 0x9e, 0x97, 0x27, 0x27, 0xd0,
+	0x6d, 0xf8, 0x50, 0xf2, 0x49, 0xc8, 0x3c, 0x9e, 0x49, 0x2f, 0x08, 0x19, 0x4f, 0x5a, 0xb7, 0x3a,
+	0xa0, 0x7b, 0xcf, 0x6e, 0x6f, 0x0b, 0xf3, 0xba, 0x22, 0x1d, 0x7d, 0x60, 0xb9, 0x0f, 0xd4, 0xcd,
+	0x30, 0x93, 0xef, 0x4a, 0x3c, 0x38, 0xfb, 0xfd, 0xdd, 0x04, 0xd6, 0x17, 0x00, 0xf5, 0x43, 0x98,
+	0x61, 0x26, 0x6f, 0x90, 0xe6, 0x0d, 0xbc, 0xac, 0xcc, 0x28, 0x3b, 0x39, 0xcc, 0x85, 0xba, 0x71,
+	0x98, 0xca, 0x62, 0xfd, 0x01, 0xf0, 0xba, 0x59, 0xc9, 0x28, 0x8d, 0x69, 0x9d, 0x84, 0xc2, 0x3b,
+	0xa5, 0x8d, 0x68, 0x81, 0xce, 0xed, 0xee, 0xfd, 0x67, 0xaf, 0xd1, 0xe9, 0xfd, 0xa3, 0xff, 0x5a,
+	0xb6, 0xaf, 0x96, 0x85, 0xa9, 0x6d, 0x0b, 0xf3, 0xe2, 0x30, 0x8a, 0xb0, 0xdc, 0xca, 0x41, 0x4f,
+	0x77, 0xad, 0x52, 0xe6, 0x11, 0x45, 0xab, 0x07, 0x79, 0x5f, 0xb2, 0x7e, 0x16, 0xe6, 0xe3, 0x88,
+	0xca, 0x4f, 0x99, 0x8f, 0xc6, 0x3c, 0xc1, 0x63, 0x2e, 0x12, 0x2e, 0xea, 0x47, 0x4f, 0x04, 0x13,
+	0x2c, 0xf3, 0x34, 0x14, 0xc8, 0x61, 0xf2, 0x78, 0xec, 0xbd, 0xdc, 0xee, 0x1f, 0x38, 0xac, 0x4a,
+	0x65, 0x7f, 0x58, 0xae, 0x0d, 0xb0, 0x5a, 0x1b, 0xe0, 0xd7, 0xda, 0x00, 0xdf, 0x36, 0x86, 0xb6,
+	0xda, 0x18, 0xda, 0x8f, 0x8d, 0xa1, 0x7d, 0x7c, 0xd9, 0xb0, 0x52, 0x16, 0x54, 0xf4, 0x62, 0xe2,
+	0x8b, 0x1d, 0xc0, 0xb3, 0xfe, 0x0b, 0xbc, 0xf8, 0x67, 0x65, 0x95, 0xbf, 0x7f, 0xae, 0x96, 0xec,
+	0xf9, 0xdf, 0x01, 0x00, 0xd7, 0x0f, 0xcf, 0xd9, 0xe5, 0x02, 0x00, 0x00,
 }
 
+func (this *SwapAmountInRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SwapAmountInRoute)
+	if !ok {
+		that2, ok := that.(SwapAmountInRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.TokenOutDenom != that1.TokenOutDenom {
+		return false
+	}
+	return true
+}
 func (m *SwapAmountInRoute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -230,6 +312,53 @@ func (m *SwapAmountOutRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SwapAmountInSplitRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapAmountInSplitRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapAmountInSplitRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSwapRoute(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSwapRoute(v)
 	base := offset
@@ -273,6 +402,23 @@ func (m *SwapAmountOutRoute) Size() (n int) {
 	return n
 }
 
+func (m *SwapAmountInSplitRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovSwapRoute(uint64(l))
+		}
+	}
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovSwapRoute(uint64(l))
+	return n
+}
+
 func sovSwapRoute(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -481,6 +627,124 @@ func (m *SwapAmountOutRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SwapAmountInSplitRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwapRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapAmountInSplitRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapAmountInSplitRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, SwapAmountInRoute{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwapRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSwapRoute(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
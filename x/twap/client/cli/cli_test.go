@@ -0,0 +1,51 @@
+package cli_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v15/x/twap/client/cli"
+	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
+)
+
+func TestGetCmdArithmeticTwap(t *testing.T) {
+	desc, _ := cli.GetCmdArithmeticTwap()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.ArithmeticTwapRequest]{
+		"unix times": {
+			Cmd: "1 uatom adym 1667088000 1667174400",
+			ExpectedQuery: &types.ArithmeticTwapRequest{
+				PoolId:     1,
+				BaseAsset:  "uatom",
+				QuoteAsset: "adym",
+				StartTime:  time.Unix(1667088000, 0),
+				EndTime:    time.Unix(1667174400, 0),
+			},
+		},
+		"missing end time": {
+			Cmd:         "1 uatom adym 1667088000",
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdGeometricTwapToNow(t *testing.T) {
+	desc, _ := cli.GetCmdGeometricTwapToNow()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.GeometricTwapToNowRequest]{
+		"unix start time": {
+			Cmd: "1 uatom adym 1667088000",
+			ExpectedQuery: &types.GeometricTwapToNowRequest{
+				PoolId:     1,
+				BaseAsset:  "uatom",
+				QuoteAsset: "adym",
+				StartTime:  time.Unix(1667088000, 0),
+			},
+		},
+		"invalid start time": {
+			Cmd:         "1 uatom adym yesterday",
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdArithmeticTwap)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdArithmeticTwapToNow)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdGeometricTwap)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdGeometricTwapToNow)
+	cmd.AddCommand(
+		osmocli.GetParams[*types.ParamsRequest](
+			types.ModuleName, types.NewQueryClient),
+	)
+
+	return cmd
+}
+
+func GetCmdArithmeticTwap() (*osmocli.QueryDescriptor, *types.ArithmeticTwapRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "arithmetic [pool-id] [base-asset] [quote-asset] [start-time] [end-time]",
+		Short: "Query the arithmetic twap of the base asset in terms of the quote asset over a time window",
+		Long: `{{.Short}}
+Times are unix timestamps or formatted as 2006-01-02T15:04:05.000000000.{{.ExampleHeader}}
+{{.CommandPrefix}} arithmetic 1 uatom adym 1667088000 1667174400`}, &types.ArithmeticTwapRequest{}
+}
+
+func GetCmdArithmeticTwapToNow() (*osmocli.QueryDescriptor, *types.ArithmeticTwapToNowRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "arithmetic-to-now [pool-id] [base-asset] [quote-asset] [start-time]",
+		Short: "Query the arithmetic twap of the base asset in terms of the quote asset from a time to now",
+		Long: `{{.Short}}
+The start time is a unix timestamp or formatted as 2006-01-02T15:04:05.000000000.{{.ExampleHeader}}
+{{.CommandPrefix}} arithmetic-to-now 1 uatom adym 1667088000`}, &types.ArithmeticTwapToNowRequest{}
+}
+
+func GetCmdGeometricTwap() (*osmocli.QueryDescriptor, *types.GeometricTwapRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "geometric [pool-id] [base-asset] [quote-asset] [start-time] [end-time]",
+		Short: "Query the geometric twap of the base asset in terms of the quote asset over a time window",
+		Long: `{{.Short}}
+Times are unix timestamps or formatted as 2006-01-02T15:04:05.000000000.{{.ExampleHeader}}
+{{.CommandPrefix}} geometric 1 uatom adym 1667088000 1667174400`}, &types.GeometricTwapRequest{}
+}
+
+func GetCmdGeometricTwapToNow() (*osmocli.QueryDescriptor, *types.GeometricTwapToNowRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "geometric-to-now [pool-id] [base-asset] [quote-asset] [start-time]",
+		Short: "Query the geometric twap of the base asset in terms of the quote asset from a time to now",
+		Long: `{{.Short}}
+The start time is a unix timestamp or formatted as 2006-01-02T15:04:05.000000000.{{.ExampleHeader}}
+{{.CommandPrefix}} geometric-to-now 1 uatom adym 1667088000`}, &types.GeometricTwapToNowRequest{}
+}
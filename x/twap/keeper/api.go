@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
+)
+
+// twapFn computes a twap of the base asset from the records at the start and end of the window.
+type twapFn func(start, end types.TwapRecord, baseAsset string) (sdk.Dec, error)
+
+// GetArithmeticTwap returns the arithmetic mean of the spot price of baseAsset in terms of quoteAsset
+// in a pool over [startTime, endTime].
+// endTime must not be after the current block time, and startTime must not be before
+// the first record of the pool kept in state.
+func (k Keeper) GetArithmeticTwap(ctx sdk.Context, poolId uint64, baseAsset, quoteAsset string, startTime, endTime time.Time) (sdk.Dec, error) {
+	return k.getTwap(ctx, poolId, baseAsset, quoteAsset, startTime, endTime, types.ArithmeticTwap)
+}
+
+// GetArithmeticTwapToNow returns the arithmetic twap over [startTime, block time].
+func (k Keeper) GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAsset, quoteAsset string, startTime time.Time) (sdk.Dec, error) {
+	return k.GetArithmeticTwap(ctx, poolId, baseAsset, quoteAsset, startTime, ctx.BlockTime())
+}
+
+// GetGeometricTwap returns the geometric mean of the spot price of baseAsset in terms of quoteAsset
+// in a pool over [startTime, endTime].
+// endTime must not be after the current block time, and startTime must not be before
+// the first record of the pool kept in state.
+func (k Keeper) GetGeometricTwap(ctx sdk.Context, poolId uint64, baseAsset, quoteAsset string, startTime, endTime time.Time) (sdk.Dec, error) {
+	return k.getTwap(ctx, poolId, baseAsset, quoteAsset, startTime, endTime, types.GeometricTwap)
+}
+
+// GetGeometricTwapToNow returns the geometric twap over [startTime, block time].
+func (k Keeper) GetGeometricTwapToNow(ctx sdk.Context, poolId uint64, baseAsset, quoteAsset string, startTime time.Time) (sdk.Dec, error) {
+	return k.GetGeometricTwap(ctx, poolId, baseAsset, quoteAsset, startTime, ctx.BlockTime())
+}
+
+func (k Keeper) getTwap(ctx sdk.Context, poolId uint64, baseAsset, quoteAsset string, startTime, endTime time.Time, computeTwap twapFn) (sdk.Dec, error) {
+	if !startTime.Before(endTime) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTimeRange, "start time (%s) must be before end time (%s)", startTime, endTime)
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTimeRange, "end time (%s) must not be after the block time (%s)", endTime, ctx.BlockTime())
+	}
+
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(baseAsset, quoteAsset)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	startRecord, err := k.getInterpolatedRecord(ctx, poolId, asset0Denom, asset1Denom, startTime)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	endRecord, err := k.getInterpolatedRecord(ctx, poolId, asset0Denom, asset1Denom, endTime)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return computeTwap(startRecord, endRecord, baseAsset)
+}
+
+// getInterpolatedRecord returns the record of a pair of a pool at the given time,
+// interpolated from the last record before it.
+func (k Keeper) getInterpolatedRecord(ctx sdk.Context, poolId uint64, asset0Denom, asset1Denom string, t time.Time) (types.TwapRecord, error) {
+	record, err := k.getRecordAtOrBeforeTime(ctx, poolId, asset0Denom, asset1Denom, t)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	return record.InterpolateAt(t), nil
+}
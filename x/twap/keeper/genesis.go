@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
+)
+
+// InitGenesis initializes the x/twap module's state from a provided genesis
+// state, which includes the historical records of all the pools.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	for _, record := range genState.Twaps {
+		k.storeHistoricalRecord(ctx, record)
+
+		// the most recent record of a pair is its last historical one
+		mostRecent, err := k.getMostRecentRecord(ctx, record.PoolId, record.Asset0Denom, record.Asset1Denom)
+		if err != nil || !record.Time.Before(mostRecent.Time) {
+			k.storeNewRecord(ctx, record)
+		}
+	}
+}
+
+// ExportGenesis returns the x/twap module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	records, err := k.GetAllHistoricalRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+		Twaps:  records,
+	}
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/twap keeper providing gRPC method
+// handlers.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+// Params returns the parameters of the twap module.
+func (q Querier) Params(ctx context.Context, req *types.ParamsRequest) (*types.ParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.ParamsResponse{Params: q.Keeper.GetParams(sdkCtx)}, nil
+}
+
+// ArithmeticTwap returns the arithmetic twap of a pool over a window.
+func (q Querier) ArithmeticTwap(ctx context.Context, req *types.ArithmeticTwapRequest) (*types.ArithmeticTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	twap, err := q.Keeper.GetArithmeticTwap(sdkCtx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.ArithmeticTwapResponse{ArithmeticTwap: twap}, nil
+}
+
+// ArithmeticTwapToNow returns the arithmetic twap of a pool from the given time to the block time.
+func (q Querier) ArithmeticTwapToNow(ctx context.Context, req *types.ArithmeticTwapToNowRequest) (*types.ArithmeticTwapToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	twap, err := q.Keeper.GetArithmeticTwapToNow(sdkCtx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.ArithmeticTwapToNowResponse{ArithmeticTwap: twap}, nil
+}
+
+// GeometricTwap returns the geometric twap of a pool over a window.
+func (q Querier) GeometricTwap(ctx context.Context, req *types.GeometricTwapRequest) (*types.GeometricTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	twap, err := q.Keeper.GetGeometricTwap(sdkCtx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.GeometricTwapResponse{GeometricTwap: twap}, nil
+}
+
+// GeometricTwapToNow returns the geometric twap of a pool from the given time to the block time.
+func (q Querier) GeometricTwapToNow(ctx context.Context, req *types.GeometricTwapToNowRequest) (*types.GeometricTwapToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	twap, err := q.Keeper.GetGeometricTwapToNow(sdkCtx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.GeometricTwapToNowResponse{GeometricTwap: twap}, nil
+}
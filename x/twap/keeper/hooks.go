@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/osmosis-labs/osmosis/v15/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+// Hooks is the wrapper struct for the twap keeper.
+type Hooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = Hooks{}
+var _ gammtypes.GammHooks = Hooks{}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 epoch hooks                                */
+/* -------------------------------------------------------------------------- */
+
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// AfterEpochEnd prunes the records older than the record history keep period.
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	params := h.k.GetParams(ctx)
+	if epochIdentifier != params.PruneEpochIdentifier {
+		return nil
+	}
+	return h.k.pruneRecordsBeforeTime(ctx, ctx.BlockTime().Add(-params.RecordHistoryKeepPeriod))
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 pool hooks                                 */
+/* -------------------------------------------------------------------------- */
+
+// AfterPoolCreated creates the first records of all the pairs of the pool, so that
+// twaps can start at its creation.
+func (h Hooks) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	if err := h.k.trackPool(ctx, poolId); err != nil {
+		h.k.Logger(ctx).Error("failed to create twap records", "pool_id", poolId, "error", err)
+	}
+}
+
+// AfterJoinPool marks the pool's records to be updated at the end of the block.
+func (h Hooks) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+	h.k.trackChangedPool(ctx, poolId)
+}
+
+// AfterExitPool marks the pool's records to be updated at the end of the block.
+func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+	h.k.trackChangedPool(ctx, poolId)
+}
+
+// AfterSwap marks the pool's records to be updated at the end of the block.
+func (h Hooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	h.k.trackChangedPool(ctx, poolId)
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
+)
+
+type Keeper struct {
+	storeKey     storetypes.StoreKey
+	transientKey storetypes.StoreKey
+	cdc          codec.BinaryCodec
+
+	paramSpace paramtypes.Subspace
+
+	// keepers
+	ammKeeper types.AmmInterface
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	transientKey storetypes.StoreKey,
+	paramSpace paramtypes.Subspace,
+	ammKeeper types.AmmInterface,
+) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	if ammKeeper == nil {
+		panic("amm keeper is nil")
+	}
+
+	return Keeper{
+		storeKey:     storeKey,
+		transientKey: transientKey,
+		cdc:          cdc,
+		paramSpace:   paramSpace,
+		// keepers
+		ammKeeper: ammKeeper,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set params.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
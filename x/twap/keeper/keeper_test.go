@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v15/testutils/apptesting"
+	gammkeeper "github.com/osmosis-labs/osmosis/v15/x/gamm/keeper"
+	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+}
+
+// advanceTime moves the block time and height of the context forward.
+func (s *KeeperTestSuite) advanceTime(d time.Duration) {
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(d)).WithBlockHeight(s.Ctx.BlockHeight() + 1)
+}
+
+// spotPrice returns the spot price of the base asset in terms of the quote asset.
+func (s *KeeperTestSuite) spotPrice(poolId uint64, baseAsset, quoteAsset string) sdk.Dec {
+	price, err := s.App.GAMMKeeper.CalculateSpotPrice(s.Ctx, poolId, quoteAsset, baseAsset)
+	s.Require().NoError(err)
+	return price
+}
+
+// swap funds the first test account and swaps the given tokens through the pool.
+func (s *KeeperTestSuite) swap(poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) {
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+	_, err := gammkeeper.NewMsgServerImpl(s.App.GAMMKeeper).SwapExactAmountIn(sdk.WrapSDKContext(s.Ctx), &gammtypes.MsgSwapExactAmountIn{
+		Sender:            s.TestAccs[0].String(),
+		Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}},
+		TokenIn:           tokenIn,
+		TokenOutMinAmount: sdk.ZeroInt(),
+	})
+	s.Require().NoError(err)
+}
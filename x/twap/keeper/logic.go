@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
+)
+
+// trackChangedPool marks a pool as changed during the current block, its records are
+// updated at the end of the block. This keeps the gas cost of swaps, joins and exits
+// independent of the number of pairs of the pool.
+func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyChangedPool(poolId), []byte{})
+}
+
+// getChangedPools returns the ids of the pools changed during the current block.
+func (k Keeper) getChangedPools(ctx sdk.Context) []uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	poolIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	return poolIds
+}
+
+// EndBlock updates the records of the pools changed during the block, with their
+// spot prices at the end of the block.
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	for _, poolId := range k.getChangedPools(ctx) {
+		if err := k.trackPool(ctx, poolId); err != nil {
+			k.Logger(ctx).Error("failed to update twap records", "pool_id", poolId, "error", err)
+		}
+	}
+}
+
+// trackPool creates or updates the records of all the pairs of a pool, with
+// its spot prices at the current block.
+func (k Keeper) trackPool(ctx sdk.Context, poolId uint64) error {
+	denoms, err := k.ammKeeper.GetPoolDenoms(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(denoms); i++ {
+		for j := i + 1; j < len(denoms); j++ {
+			asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(denoms[i], denoms[j])
+			if err != nil {
+				return err
+			}
+
+			record, err := k.getMostRecentRecord(ctx, poolId, asset0Denom, asset1Denom)
+			if err != nil {
+				// pools created before the module was added have no record yet
+				record = newRecord(poolId, asset0Denom, asset1Denom, ctx.BlockTime())
+			}
+			k.storeNewRecord(ctx, k.updateRecord(ctx, record))
+		}
+	}
+	return nil
+}
+
+// newRecord returns a record with empty accumulators.
+func newRecord(poolId uint64, asset0Denom, asset1Denom string, t time.Time) types.TwapRecord {
+	return types.TwapRecord{
+		PoolId:                      poolId,
+		Asset0Denom:                 asset0Denom,
+		Asset1Denom:                 asset1Denom,
+		Time:                        t,
+		P0LastSpotPrice:             sdk.ZeroDec(),
+		P1LastSpotPrice:             sdk.ZeroDec(),
+		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
+	}
+}
+
+// updateRecord advances the accumulators of a record to the current block time, then sets
+// its spot prices to the current ones. If they cannot be computed, the previous ones are
+// kept and the error time is recorded.
+func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) types.TwapRecord {
+	record = record.InterpolateAt(ctx.BlockTime())
+	record.Height = ctx.BlockHeight()
+
+	p0, err0 := k.ammKeeper.CalculateSpotPrice(ctx, record.PoolId, record.Asset1Denom, record.Asset0Denom)
+	p1, err1 := k.ammKeeper.CalculateSpotPrice(ctx, record.PoolId, record.Asset0Denom, record.Asset1Denom)
+	if err0 != nil || err1 != nil || !p0.IsPositive() || !p1.IsPositive() {
+		k.Logger(ctx).Error("failed to compute spot price for twap", "pool_id", record.PoolId, "asset0", record.Asset0Denom, "asset1", record.Asset1Denom)
+		record.LastErrorTime = ctx.BlockTime()
+		return record
+	}
+
+	record.P0LastSpotPrice = p0
+	record.P1LastSpotPrice = p1
+	return record
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
+)
+
+// storeNewRecord stores a record as the most recent one of its pair, and in the historical indexes.
+func (k Keeper) storeNewRecord(ctx sdk.Context, record types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.KeyMostRecentRecord(record.PoolId, record.Asset0Denom, record.Asset1Denom), bz)
+	k.storeHistoricalRecord(ctx, record)
+}
+
+// storeHistoricalRecord stores a record in the historical indexes.
+func (k Keeper) storeHistoricalRecord(ctx sdk.Context, record types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.KeyHistoricalRecordByPool(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time), bz)
+	store.Set(types.KeyHistoricalRecordByTime(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time), bz)
+}
+
+// deleteHistoricalRecord removes a record from the historical indexes.
+func (k Keeper) deleteHistoricalRecord(ctx sdk.Context, record types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyHistoricalRecordByPool(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time))
+	store.Delete(types.KeyHistoricalRecordByTime(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time))
+}
+
+// getMostRecentRecord returns the most recent record of a pair of a pool.
+func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, asset0Denom, asset1Denom string) (types.TwapRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyMostRecentRecord(poolId, asset0Denom, asset1Denom))
+	if bz == nil {
+		return types.TwapRecord{}, sdkerrors.Wrapf(types.ErrRecordNotFound, "pool %d, assets %s and %s", poolId, asset0Denom, asset1Denom)
+	}
+
+	var record types.TwapRecord
+	if err := k.cdc.Unmarshal(bz, &record); err != nil {
+		return types.TwapRecord{}, err
+	}
+	return record, nil
+}
+
+// GetAllMostRecentRecordsForPool returns the most recent records of all the pairs of a pool.
+func (k Keeper) GetAllMostRecentRecordsForPool(ctx sdk.Context, poolId uint64) ([]types.TwapRecord, error) {
+	return k.getRecords(ctx, types.KeyMostRecentRecordsByPoolId(poolId))
+}
+
+// GetAllHistoricalRecords returns the historical records of all the pools, ordered by time.
+func (k Keeper) GetAllHistoricalRecords(ctx sdk.Context) ([]types.TwapRecord, error) {
+	return k.getRecords(ctx, types.KeyPrefixHistoricalRecordsByTime)
+}
+
+func (k Keeper) getRecords(ctx sdk.Context, prefix []byte) ([]types.TwapRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	records := []types.TwapRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TwapRecord
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// getRecordAtOrBeforeTime returns the last record of a pair of a pool not after the given time.
+func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, asset0Denom, asset1Denom string, t time.Time) (types.TwapRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	start := types.KeyHistoricalRecordsByPair(poolId, asset0Denom, asset1Denom)
+	end := sdk.PrefixEndBytes(types.KeyHistoricalRecordByPool(poolId, asset0Denom, asset1Denom, t))
+	iterator := store.ReverseIterator(start, end)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.TwapRecord{}, sdkerrors.Wrapf(types.ErrRecordNotFound, "pool %d, assets %s and %s, at or before %s", poolId, asset0Denom, asset1Denom, t)
+	}
+
+	var record types.TwapRecord
+	if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+		return types.TwapRecord{}, err
+	}
+	return record, nil
+}
+
+// pruneRecordsBeforeTime deletes the historical records older than the given time.
+// The last record of each pair before that time is kept, as twaps starting
+// after it but before the next record are interpolated from it.
+func (k Keeper) pruneRecordsBeforeTime(ctx sdk.Context, t time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(types.KeyPrefixHistoricalRecordsByTime, types.KeyHistoricalRecordsBeforeTime(t))
+	defer iterator.Close()
+
+	seenPairs := make(map[string]bool)
+	toDelete := []types.TwapRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TwapRecord
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return err
+		}
+
+		pair := string(types.KeyMostRecentRecord(record.PoolId, record.Asset0Denom, record.Asset1Denom))
+		if !seenPairs[pair] {
+			seenPairs[pair] = true
+			continue
+		}
+		toDelete = append(toDelete, record)
+	}
+
+	for _, record := range toDelete {
+		k.deleteHistoricalRecord(ctx, record)
+	}
+	return nil
+}
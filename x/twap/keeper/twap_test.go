@@ -0,0 +1,224 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
+)
+
+func (s *KeeperTestSuite) TestRecordsTrackPools() {
+	s.SetupTest()
+	twapKeeper := s.App.TwapKeeper
+
+	// creating a pool records all its pairs
+	poolId := s.PrepareBalancerPool()
+	records, err := twapKeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Len(records, 6)
+	for _, record := range records {
+		s.Require().Less(record.Asset0Denom, record.Asset1Denom)
+		s.Require().Equal(s.Ctx.BlockTime(), record.Time)
+		s.Require().Equal(s.spotPrice(poolId, record.Asset0Denom, record.Asset1Denom), record.P0LastSpotPrice)
+		s.Require().Equal(s.spotPrice(poolId, record.Asset1Denom, record.Asset0Denom), record.P1LastSpotPrice)
+		s.Require().True(record.P0ArithmeticTwapAccumulator.IsZero())
+	}
+
+	// swaps, joins and exits update the records at the end of the block
+	for _, action := range []func(uint64){s.RunBasicSwap, s.RunBasicJoin, s.RunBasicExit} {
+		s.advanceTime(time.Second)
+		action(poolId)
+
+		records, err := twapKeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+		s.Require().NoError(err)
+		s.Require().True(records[0].Time.Before(s.Ctx.BlockTime()))
+
+		twapKeeper.EndBlock(s.Ctx)
+		records, err = twapKeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+		s.Require().NoError(err)
+		for _, record := range records {
+			s.Require().Equal(s.Ctx.BlockTime(), record.Time)
+			s.Require().Equal(s.Ctx.BlockHeight(), record.Height)
+			s.Require().Equal(s.spotPrice(poolId, record.Asset0Denom, record.Asset1Denom), record.P0LastSpotPrice)
+			s.Require().True(record.P0ArithmeticTwapAccumulator.IsPositive())
+		}
+	}
+
+	historical, err := twapKeeper.GetAllHistoricalRecords(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(historical, 4*6)
+}
+
+func (s *KeeperTestSuite) TestTwaps() {
+	s.SetupTest()
+	twapKeeper := s.App.TwapKeeper
+
+	startTime := s.Ctx.BlockTime()
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("foo", 1000000))
+	priceBefore := s.spotPrice(poolId, "bar", "foo")
+
+	// the price stays the same for 10 seconds, then changes for the next 30 seconds
+	s.advanceTime(10 * time.Second)
+	s.swap(poolId, sdk.NewInt64Coin("foo", 400000), "bar")
+	twapKeeper.EndBlock(s.Ctx)
+	priceAfter := s.spotPrice(poolId, "bar", "foo")
+	s.Require().NotEqual(priceBefore, priceAfter)
+	s.advanceTime(30 * time.Second)
+	endTime := s.Ctx.BlockTime()
+
+	expectedArithmetic := priceBefore.MulInt64(10).Add(priceAfter.MulInt64(30)).QuoInt64(40)
+	arithmetic, err := twapKeeper.GetArithmeticTwap(s.Ctx, poolId, "bar", "foo", startTime, endTime)
+	s.Require().NoError(err)
+	s.Require().True(expectedArithmetic.Sub(arithmetic).Abs().LTE(sdk.NewDecWithPrec(1, 12)), "expected %s, got %s", expectedArithmetic, arithmetic)
+
+	// the arithmetic twap to now is the same as the one ending at the block time
+	toNow, err := twapKeeper.GetArithmeticTwapToNow(s.Ctx, poolId, "bar", "foo", startTime)
+	s.Require().NoError(err)
+	s.Require().Equal(arithmetic, toNow)
+
+	// windows after the last update only see its price
+	arithmetic, err = twapKeeper.GetArithmeticTwap(s.Ctx, poolId, "bar", "foo", startTime.Add(20*time.Second), endTime)
+	s.Require().NoError(err)
+	s.Require().True(priceAfter.Sub(arithmetic).Abs().LTE(sdk.NewDecWithPrec(1, 12)), "expected %s, got %s", priceAfter, arithmetic)
+
+	// geometric twap: priceBefore^(1/4) * priceAfter^(3/4)
+	geometric, err := twapKeeper.GetGeometricTwap(s.Ctx, poolId, "bar", "foo", startTime, endTime)
+	s.Require().NoError(err)
+	expectedGeometric, err := priceBefore.Mul(priceAfter.Power(3)).ApproxRoot(4)
+	s.Require().NoError(err)
+	s.Require().True(expectedGeometric.Sub(geometric).Abs().LTE(sdk.NewDecWithPrec(1, 9)), "expected %s, got %s", expectedGeometric, geometric)
+	s.Require().True(geometric.LT(expectedArithmetic))
+
+	// the geometric twap of the quote asset is the inverse
+	inverse, err := twapKeeper.GetGeometricTwapToNow(s.Ctx, poolId, "foo", "bar", startTime)
+	s.Require().NoError(err)
+	s.Require().True(sdk.OneDec().Quo(geometric).Sub(inverse).Abs().LTE(sdk.NewDecWithPrec(1, 9)), "expected %s, got %s", sdk.OneDec().Quo(geometric), inverse)
+}
+
+func (s *KeeperTestSuite) TestTwapErrors() {
+	s.SetupTest()
+	twapKeeper := s.App.TwapKeeper
+
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("foo", 1000000))
+	createTime := s.Ctx.BlockTime()
+	s.advanceTime(time.Minute)
+	now := s.Ctx.BlockTime()
+
+	tests := map[string]struct {
+		poolId     uint64
+		baseAsset  string
+		quoteAsset string
+		startTime  time.Time
+		endTime    time.Time
+		expectErr  error
+	}{
+		"valid": {poolId: poolId, baseAsset: "bar", quoteAsset: "foo", startTime: createTime, endTime: now},
+		"start not before end": {
+			poolId: poolId, baseAsset: "bar", quoteAsset: "foo", startTime: now, endTime: now,
+			expectErr: types.ErrInvalidTimeRange,
+		},
+		"end after block time": {
+			poolId: poolId, baseAsset: "bar", quoteAsset: "foo", startTime: createTime, endTime: now.Add(time.Second),
+			expectErr: types.ErrInvalidTimeRange,
+		},
+		"start before pool creation": {
+			poolId: poolId, baseAsset: "bar", quoteAsset: "foo", startTime: createTime.Add(-time.Second), endTime: now,
+			expectErr: types.ErrRecordNotFound,
+		},
+		"same assets": {
+			poolId: poolId, baseAsset: "bar", quoteAsset: "bar", startTime: createTime, endTime: now,
+			expectErr: types.ErrInvalidAssets,
+		},
+		"asset not in pool": {
+			poolId: poolId, baseAsset: "bar", quoteAsset: "baz", startTime: createTime, endTime: now,
+			expectErr: types.ErrRecordNotFound,
+		},
+		"unknown pool": {
+			poolId: poolId + 1, baseAsset: "bar", quoteAsset: "foo", startTime: createTime, endTime: now,
+			expectErr: types.ErrRecordNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			_, err := twapKeeper.GetArithmeticTwap(s.Ctx, tc.poolId, tc.baseAsset, tc.quoteAsset, tc.startTime, tc.endTime)
+			_, geometricErr := twapKeeper.GetGeometricTwap(s.Ctx, tc.poolId, tc.baseAsset, tc.quoteAsset, tc.startTime, tc.endTime)
+			if tc.expectErr != nil {
+				s.Require().ErrorIs(err, tc.expectErr)
+				s.Require().ErrorIs(geometricErr, tc.expectErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NoError(geometricErr)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPruneRecords() {
+	s.SetupTest()
+	twapKeeper := s.App.TwapKeeper
+	params := twapKeeper.GetParams(s.Ctx)
+
+	createTime := s.Ctx.BlockTime()
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("foo", 1000000))
+	s.advanceTime(time.Hour)
+	s.RunBasicSwap(poolId)
+	twapKeeper.EndBlock(s.Ctx)
+	lastBeforeCutoffTime := s.Ctx.BlockTime()
+	s.advanceTime(params.RecordHistoryKeepPeriod)
+	s.RunBasicSwap(poolId)
+	twapKeeper.EndBlock(s.Ctx)
+
+	// only the other epochs do not prune
+	err := twapKeeper.Hooks().AfterEpochEnd(s.Ctx, params.PruneEpochIdentifier+"-other", 1)
+	s.Require().NoError(err)
+	historical, err := twapKeeper.GetAllHistoricalRecords(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(historical, 3)
+
+	s.advanceTime(time.Minute)
+	err = twapKeeper.Hooks().AfterEpochEnd(s.Ctx, params.PruneEpochIdentifier, 1)
+	s.Require().NoError(err)
+
+	// the record at creation is pruned, the last one before the cutoff is kept
+	historical, err = twapKeeper.GetAllHistoricalRecords(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(historical, 2)
+	s.Require().Equal(lastBeforeCutoffTime, historical[0].Time)
+
+	_, err = twapKeeper.GetArithmeticTwapToNow(s.Ctx, poolId, "bar", "foo", createTime)
+	s.Require().ErrorIs(err, types.ErrRecordNotFound)
+	_, err = twapKeeper.GetArithmeticTwapToNow(s.Ctx, poolId, "bar", "foo", s.Ctx.BlockTime().Add(-params.RecordHistoryKeepPeriod))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.SetupTest()
+	twapKeeper := s.App.TwapKeeper
+
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("foo", 1000000))
+	startTime := s.Ctx.BlockTime()
+	s.advanceTime(time.Minute)
+	s.RunBasicSwap(poolId)
+	twapKeeper.EndBlock(s.Ctx)
+	s.advanceTime(time.Minute)
+
+	genesis := twapKeeper.ExportGenesis(s.Ctx)
+	s.Require().Len(genesis.Twaps, 2)
+	s.Require().NoError(genesis.Validate())
+	twapBefore, err := twapKeeper.GetArithmeticTwapToNow(s.Ctx, poolId, "bar", "foo", startTime)
+	s.Require().NoError(err)
+
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(2 * time.Minute))
+	s.App.TwapKeeper.InitGenesis(s.Ctx, *genesis)
+	s.Require().Equal(genesis, s.App.TwapKeeper.ExportGenesis(s.Ctx))
+
+	twapAfter, err := s.App.TwapKeeper.GetArithmeticTwapToNow(s.Ctx, poolId, "bar", "foo", startTime)
+	s.Require().NoError(err)
+	s.Require().Equal(twapBefore, twapAfter)
+	records, err := s.App.TwapKeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(genesis.Twaps[1], records[0])
+}
@@ -0,0 +1,16 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/twap module errors.
+var (
+	ErrRecordNotFound    = sdkerrors.Register(ModuleName, 1, "twap record not found")
+	ErrInvalidTimeRange  = sdkerrors.Register(ModuleName, 2, "invalid twap time range")
+	ErrSpotPriceInWindow = sdkerrors.Register(ModuleName, 3, "spot price could not be computed within the twap window")
+	ErrInvalidAssets     = sdkerrors.Register(ModuleName, 4, "invalid twap assets")
+	ErrInvalidRecord     = sdkerrors.Register(ModuleName, 5, "invalid twap record")
+)
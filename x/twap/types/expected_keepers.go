@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AmmInterface defines the contract needed to be fulfilled by the pools the twaps are recorded for.
+// The x/gamm keeper is expected to satisfy this interface.
+type AmmInterface interface {
+	CalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteDenom, baseDenom string) (sdk.Dec, error)
+	GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
+}
@@ -0,0 +1,25 @@
+package types
+
+// DefaultGenesis creates a default GenesisState object.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Twaps:  []TwapRecord{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, record := range gs.Twaps {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	ModuleName = "twap"
+
+	StoreKey = ModuleName
+
+	// TransientStoreKey is the key of the store of the pools changed during the current block.
+	TransientStoreKey = "transient_" + ModuleName
+
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+var (
+	// KeyPrefixMostRecentRecords defines prefix to store the most recent record of each pair of each pool.
+	KeyPrefixMostRecentRecords = []byte{0x01}
+	// KeyPrefixHistoricalRecordsByPool defines prefix to store the historical records of each pair of each pool,
+	// ordered by time.
+	KeyPrefixHistoricalRecordsByPool = []byte{0x02}
+	// KeyPrefixHistoricalRecordsByTime defines prefix to store the historical records of all the pools,
+	// ordered by time. It is used for pruning.
+	KeyPrefixHistoricalRecordsByTime = []byte{0x03}
+)
+
+// KeyChangedPool returns the key marking a pool as changed in the transient store.
+func KeyChangedPool(poolId uint64) []byte {
+	return sdk.Uint64ToBigEndian(poolId)
+}
+
+// pairKey returns the key suffix identifying a pair of assets of a pool.
+// Denoms are length prefixed since they may contain any separator.
+func pairKey(poolId uint64, asset0Denom, asset1Denom string) []byte {
+	key := sdk.Uint64ToBigEndian(poolId)
+	key = append(key, address.MustLengthPrefix([]byte(asset0Denom))...)
+	return append(key, address.MustLengthPrefix([]byte(asset1Denom))...)
+}
+
+// KeyMostRecentRecordsByPoolId returns the prefix of the most recent records of all the pairs of a pool.
+func KeyMostRecentRecordsByPoolId(poolId uint64) []byte {
+	return append(KeyPrefixMostRecentRecords, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyMostRecentRecord returns the key of the most recent record of a pair of a pool.
+func KeyMostRecentRecord(poolId uint64, asset0Denom, asset1Denom string) []byte {
+	return append(KeyPrefixMostRecentRecords, pairKey(poolId, asset0Denom, asset1Denom)...)
+}
+
+// KeyHistoricalRecordsByPair returns the prefix of the historical records of a pair of a pool.
+func KeyHistoricalRecordsByPair(poolId uint64, asset0Denom, asset1Denom string) []byte {
+	return append(KeyPrefixHistoricalRecordsByPool, pairKey(poolId, asset0Denom, asset1Denom)...)
+}
+
+// KeyHistoricalRecordByPool returns the key of the record of a pair of a pool at the given time,
+// in the index ordered by pool.
+func KeyHistoricalRecordByPool(poolId uint64, asset0Denom, asset1Denom string, t time.Time) []byte {
+	return append(KeyHistoricalRecordsByPair(poolId, asset0Denom, asset1Denom), sdk.FormatTimeBytes(t)...)
+}
+
+// KeyHistoricalRecordsBeforeTime returns the key before which all the records strictly older than
+// the given time are stored, in the index ordered by time.
+func KeyHistoricalRecordsBeforeTime(t time.Time) []byte {
+	return append(KeyPrefixHistoricalRecordsByTime, sdk.FormatTimeBytes(t)...)
+}
+
+// KeyHistoricalRecordByTime returns the key of the record of a pair of a pool at the given time,
+// in the index ordered by time.
+func KeyHistoricalRecordByTime(poolId uint64, asset0Denom, asset1Denom string, t time.Time) []byte {
+	return append(KeyHistoricalRecordsBeforeTime(t), pairKey(poolId, asset0Denom, asset1Denom)...)
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyPruneEpochIdentifier    = []byte("PruneEpochIdentifier")
+	KeyRecordHistoryKeepPeriod = []byte("RecordHistoryKeepPeriod")
+)
+
+// ParamTable for twap module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(pruneEpochIdentifier string, recordHistoryKeepPeriod time.Duration) Params {
+	return Params{
+		PruneEpochIdentifier:    pruneEpochIdentifier,
+		RecordHistoryKeepPeriod: recordHistoryKeepPeriod,
+	}
+}
+
+// default twap module parameters.
+func DefaultParams() Params {
+	return Params{
+		PruneEpochIdentifier:    "day",
+		RecordHistoryKeepPeriod: 48 * time.Hour,
+	}
+}
+
+// validate params.
+func (p Params) Validate() error {
+	if err := validatePruneEpochIdentifier(p.PruneEpochIdentifier); err != nil {
+		return err
+	}
+	if err := validateRecordHistoryKeepPeriod(p.RecordHistoryKeepPeriod); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyPruneEpochIdentifier, &p.PruneEpochIdentifier, validatePruneEpochIdentifier),
+		paramtypes.NewParamSetPair(KeyRecordHistoryKeepPeriod, &p.RecordHistoryKeepPeriod, validateRecordHistoryKeepPeriod),
+	}
+}
+
+func validatePruneEpochIdentifier(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == "" {
+		return fmt.Errorf("prune epoch identifier cannot be empty")
+	}
+	return nil
+}
+
+func validateRecordHistoryKeepPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("record history keep period must be positive, got %s", v)
+	}
+	return nil
+}
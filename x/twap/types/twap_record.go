@@ -0,0 +1,116 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// LexicographicalOrderDenoms returns the two denoms of a pair in the order records store them.
+func LexicographicalOrderDenoms(denomA, denomB string) (asset0Denom, asset1Denom string, err error) {
+	if denomA == denomB {
+		return "", "", sdkerrors.Wrapf(ErrInvalidAssets, "both assets are %s", denomA)
+	}
+	if denomA > denomB {
+		denomA, denomB = denomB, denomA
+	}
+	return denomA, denomB, nil
+}
+
+// Validate checks the record is well formed.
+func (r TwapRecord) Validate() error {
+	if r.PoolId == 0 {
+		return sdkerrors.Wrap(ErrInvalidRecord, "pool id must be positive")
+	}
+	if err := sdk.ValidateDenom(r.Asset0Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRecord, "invalid asset0 denom: %s", err)
+	}
+	if err := sdk.ValidateDenom(r.Asset1Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRecord, "invalid asset1 denom: %s", err)
+	}
+	if r.Asset0Denom >= r.Asset1Denom {
+		return sdkerrors.Wrapf(ErrInvalidRecord, "asset0 denom (%s) must be lexicographically smaller than asset1 denom (%s)", r.Asset0Denom, r.Asset1Denom)
+	}
+	if r.Height < 0 {
+		return sdkerrors.Wrapf(ErrInvalidRecord, "height must not be negative, got %d", r.Height)
+	}
+	for _, dec := range []sdk.Dec{r.P0LastSpotPrice, r.P1LastSpotPrice, r.P0ArithmeticTwapAccumulator, r.P1ArithmeticTwapAccumulator} {
+		if dec.IsNil() || dec.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalidRecord, "spot prices and arithmetic accumulators of pool %d must not be negative", r.PoolId)
+		}
+	}
+	if r.GeometricTwapAccumulator.IsNil() {
+		return sdkerrors.Wrapf(ErrInvalidRecord, "geometric accumulator of pool %d must be set", r.PoolId)
+	}
+	return nil
+}
+
+// InterpolateAt returns the record advanced to the given time, assuming the spot prices
+// did not change since the record was last updated.
+// CONTRACT: t is not before r.Time.
+func (r TwapRecord) InterpolateAt(t time.Time) TwapRecord {
+	elapsedMs := t.Sub(r.Time).Milliseconds()
+	r.Time = t
+	r.P0ArithmeticTwapAccumulator = r.P0ArithmeticTwapAccumulator.Add(r.P0LastSpotPrice.MulInt64(elapsedMs))
+	r.P1ArithmeticTwapAccumulator = r.P1ArithmeticTwapAccumulator.Add(r.P1LastSpotPrice.MulInt64(elapsedMs))
+	// a non positive spot price can only come from a spot price error, which is recorded
+	// in LastErrorTime and makes the twaps over this period invalid anyway.
+	if r.P0LastSpotPrice.IsPositive() {
+		log2Price := osmomath.BigDecFromSDKDec(r.P0LastSpotPrice).LogBase2().SDKDec()
+		r.GeometricTwapAccumulator = r.GeometricTwapAccumulator.Add(log2Price.MulInt64(elapsedMs))
+	}
+	return r
+}
+
+// ArithmeticTwap returns the arithmetic mean of the spot price of baseAsset between two records
+// of the same pair.
+func ArithmeticTwap(start, end TwapRecord, baseAsset string) (sdk.Dec, error) {
+	elapsedMs, err := twapWindowMs(start, end)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	var accumDiff sdk.Dec
+	if baseAsset == start.Asset0Denom {
+		accumDiff = end.P0ArithmeticTwapAccumulator.Sub(start.P0ArithmeticTwapAccumulator)
+	} else {
+		accumDiff = end.P1ArithmeticTwapAccumulator.Sub(start.P1ArithmeticTwapAccumulator)
+	}
+	return accumDiff.QuoInt64(elapsedMs), nil
+}
+
+// GeometricTwap returns the geometric mean of the spot price of baseAsset between two records
+// of the same pair.
+func GeometricTwap(start, end TwapRecord, baseAsset string) (sdk.Dec, error) {
+	elapsedMs, err := twapWindowMs(start, end)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// the accumulator tracks log2(p0), hence the twap of asset1 is the inverse of the one of asset0.
+	log2Twap := osmomath.BigDecFromSDKDec(end.GeometricTwapAccumulator.Sub(start.GeometricTwapAccumulator)).QuoInt64(elapsedMs)
+	if baseAsset == start.Asset1Denom {
+		log2Twap = log2Twap.Neg()
+	}
+
+	// osmomath.Exp2 only supports non-negative exponents.
+	if log2Twap.IsNegative() {
+		return osmomath.OneDec().Quo(osmomath.Exp2(log2Twap.Neg())).SDKDec(), nil
+	}
+	return osmomath.Exp2(log2Twap).SDKDec(), nil
+}
+
+// twapWindowMs returns the length of the window between two records in milliseconds.
+func twapWindowMs(start, end TwapRecord) (int64, error) {
+	elapsedMs := end.Time.Sub(start.Time).Milliseconds()
+	if elapsedMs <= 0 {
+		return 0, sdkerrors.Wrapf(ErrInvalidTimeRange, "start time (%s) must be before end time (%s)", start.Time, end.Time)
+	}
+	if !end.LastErrorTime.Before(start.Time) {
+		return 0, sdkerrors.Wrapf(ErrSpotPriceInWindow, "last error at %s", end.LastErrorTime)
+	}
+	return elapsedMs, nil
+}
@@ -0,0 +1,139 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
+)
+
+var baseTime = time.Unix(1667088000, 0).UTC()
+
+// recordWithPrice returns a record of bar/foo at baseTime with empty accumulators,
+// where one bar is worth p0 foo.
+func recordWithPrice(p0 sdk.Dec) types.TwapRecord {
+	return types.TwapRecord{
+		PoolId:                      1,
+		Asset0Denom:                 "bar",
+		Asset1Denom:                 "foo",
+		Time:                        baseTime,
+		P0LastSpotPrice:             p0,
+		P1LastSpotPrice:             sdk.OneDec().Quo(p0),
+		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
+	}
+}
+
+// updatePrice advances a record by d and sets its spot price.
+func updatePrice(record types.TwapRecord, d time.Duration, p0 sdk.Dec) types.TwapRecord {
+	record = record.InterpolateAt(record.Time.Add(d))
+	record.P0LastSpotPrice = p0
+	record.P1LastSpotPrice = sdk.OneDec().Quo(p0)
+	return record
+}
+
+func TestLexicographicalOrderDenoms(t *testing.T) {
+	asset0, asset1, err := types.LexicographicalOrderDenoms("foo", "bar")
+	require.NoError(t, err)
+	require.Equal(t, "bar", asset0)
+	require.Equal(t, "foo", asset1)
+
+	_, _, err = types.LexicographicalOrderDenoms("foo", "foo")
+	require.ErrorIs(t, err, types.ErrInvalidAssets)
+}
+
+func TestInterpolateAt(t *testing.T) {
+	record := recordWithPrice(sdk.NewDec(4)).InterpolateAt(baseTime.Add(10 * time.Second))
+
+	require.Equal(t, baseTime.Add(10*time.Second), record.Time)
+	require.Equal(t, sdk.NewDec(40000), record.P0ArithmeticTwapAccumulator)
+	require.Equal(t, sdk.NewDec(2500), record.P1ArithmeticTwapAccumulator)
+	// log2(4) = 2
+	require.Equal(t, sdk.NewDec(20000), record.GeometricTwapAccumulator)
+}
+
+func TestTwaps(t *testing.T) {
+	// one bar is worth 2 foo for 10 seconds, then 8 foo for 30 seconds.
+	start := recordWithPrice(sdk.NewDec(2))
+	end := updatePrice(start, 10*time.Second, sdk.NewDec(8)).InterpolateAt(baseTime.Add(40 * time.Second))
+
+	tests := map[string]struct {
+		twapFn       func(start, end types.TwapRecord, baseAsset string) (sdk.Dec, error)
+		baseAsset    string
+		expectedTwap sdk.Dec
+	}{
+		"arithmetic asset0": {
+			twapFn:       types.ArithmeticTwap,
+			baseAsset:    "bar",
+			expectedTwap: sdk.MustNewDecFromStr("6.5"),
+		},
+		"arithmetic asset1": {
+			twapFn:       types.ArithmeticTwap,
+			baseAsset:    "foo",
+			expectedTwap: sdk.MustNewDecFromStr("0.21875"),
+		},
+		// 2^(1/4) * 8^(3/4) = 2^(10/4)
+		"geometric asset0": {
+			twapFn:       types.GeometricTwap,
+			baseAsset:    "bar",
+			expectedTwap: sdk.MustNewDecFromStr("5.656854249492380195"),
+		},
+		"geometric asset1": {
+			twapFn:       types.GeometricTwap,
+			baseAsset:    "foo",
+			expectedTwap: sdk.MustNewDecFromStr("0.176776695296636881"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			twap, err := tc.twapFn(start, end, tc.baseAsset)
+			require.NoError(t, err)
+			require.True(t, tc.expectedTwap.Sub(twap).Abs().LTE(sdk.NewDecWithPrec(1, 15)), "expected %s, got %s", tc.expectedTwap, twap)
+		})
+	}
+}
+
+func TestTwapErrors(t *testing.T) {
+	start := recordWithPrice(sdk.NewDec(2))
+	end := start.InterpolateAt(baseTime.Add(time.Minute))
+
+	_, err := types.ArithmeticTwap(start, start, "bar")
+	require.ErrorIs(t, err, types.ErrInvalidTimeRange)
+
+	// a spot price error within the window invalidates it
+	end.LastErrorTime = baseTime.Add(time.Second)
+	_, err = types.GeometricTwap(start, end, "bar")
+	require.ErrorIs(t, err, types.ErrSpotPriceInWindow)
+
+	// but not an older one
+	end.LastErrorTime = baseTime.Add(-time.Second)
+	_, err = types.GeometricTwap(start, end, "bar")
+	require.NoError(t, err)
+}
+
+func TestGenesisValidate(t *testing.T) {
+	require.NoError(t, types.DefaultGenesis().Validate())
+
+	genesis := types.DefaultGenesis()
+	genesis.Twaps = []types.TwapRecord{recordWithPrice(sdk.NewDec(2))}
+	require.NoError(t, genesis.Validate())
+
+	unordered := recordWithPrice(sdk.NewDec(2))
+	unordered.Asset0Denom, unordered.Asset1Denom = "foo", "bar"
+	genesis.Twaps = []types.TwapRecord{unordered}
+	require.ErrorIs(t, genesis.Validate(), types.ErrInvalidRecord)
+
+	negative := recordWithPrice(sdk.NewDec(2))
+	negative.P0ArithmeticTwapAccumulator = sdk.NewDec(-1)
+	genesis.Twaps = []types.TwapRecord{negative}
+	require.ErrorIs(t, genesis.Validate(), types.ErrInvalidRecord)
+
+	genesis = types.DefaultGenesis()
+	genesis.Params.RecordHistoryKeepPeriod = 0
+	require.Error(t, genesis.Validate())
+}
@@ -103,8 +103,8 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
-// IsSufficientFee checks if the feeCoin provided (in any asset), is worth enough adym at current spot prices
-// to pay the gas cost of this tx.
+// IsSufficientFee checks if the feeCoin provided (in any asset), is worth enough adym at the lowest of its
+// current spot price, its twap and its observed average price to pay the gas cost of this tx.
 func (mfd MempoolFeeDecorator) IsSufficientFee(ctx sdk.Context, minBaseGasPrice sdk.Dec, gasRequested uint64, feeCoin sdk.Coin) error {
 	baseDenom, err := mfd.TxFeesKeeper.GetBaseDenom(ctx)
 	if err != nil {
@@ -116,7 +116,7 @@ func (mfd MempoolFeeDecorator) IsSufficientFee(ctx sdk.Context, minBaseGasPrice
 	glDec := sdk.NewDec(int64(gasRequested))
 	requiredBaseFee := sdk.NewCoin(baseDenom, minBaseGasPrice.Mul(glDec).Ceil().RoundInt())
 
-	convertedFee, err := mfd.TxFeesKeeper.ConvertToBaseToken(ctx, feeCoin)
+	convertedFee, err := mfd.TxFeesKeeper.ConvertToBaseTokenConservative(ctx, feeCoin)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,19 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	// Will be parsed to []uint64.
+	FlagSwapRoutePoolIds = "swap-route-pool-ids"
+	// Will be parsed to []string.
+	FlagSwapRouteDenoms = "swap-route-denoms"
+)
+
+func FlagSetMultihopSwapRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagSwapRoutePoolIds, "", "swap route pool ids from the fee token to the base denom, starting with the fee token pool")
+	fs.String(FlagSwapRouteDenoms, "", "swap route denoms from the fee token to the base denom, ending with the base denom")
+	return fs
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils/osmocli"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v15/x/txfees/types"
+)
+
+func NewTxCmd() *cobra.Command {
+	txCmd := osmocli.TxIndexCmd(types.ModuleName)
+	osmocli.AddTxCmd(txCmd, NewUpdateFeeTokenCmd)
+	osmocli.AddTxCmd(txCmd, NewRemoveFeeTokenCmd)
+	return txCmd
+}
+
+const govMsgLong = `The message must be executed through governance: generate it with --from set to the gov module
+account and --generate-only, and submit its body with "tx gov submit-proposal".`
+
+func NewUpdateFeeTokenCmd() (*osmocli.TxCliDesc, *types.MsgUpdateFeeToken) {
+	return &osmocli.TxCliDesc{
+		Use:   "update-fee-token [denom] [pool-id]",
+		Short: "register a fee token, or change the pool it is priced through",
+		Long: `Register a fee token, or change the pool it is priced through. The pool must hold both the denom and the base denom.
+Tokens without a pool paired with the base denom can be priced through a swap route to the base denom, starting with the pool.
+` + govMsgLong,
+		Example: fmt.Sprintf(`%[1]s tx txfees update-fee-token uatom 1 --from=<gov-address> --generate-only
+%[1]s tx txfees update-fee-token uatom 2 --swap-route-pool-ids=2,1 --swap-route-denoms=uusdc,adym --from=<gov-address> --generate-only`, version.AppName),
+		TxSignerFieldName:  "Authority",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{"Routes": osmocli.FlagOnlyParser(swapAmountInRoutes)},
+		Flags:              osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
+	}, &types.MsgUpdateFeeToken{}
+}
+
+func NewRemoveFeeTokenCmd() (*osmocli.TxCliDesc, *types.MsgRemoveFeeToken) {
+	return &osmocli.TxCliDesc{
+		Use:               "remove-fee-token [denom]",
+		Short:             "delist a fee token",
+		Long:              "Delist a fee token.\n" + govMsgLong,
+		Example:           fmt.Sprintf("%s tx txfees remove-fee-token uatom --from=<gov-address> --generate-only", version.AppName),
+		TxSignerFieldName: "Authority",
+	}, &types.MsgRemoveFeeToken{}
+}
+
+// swapAmountInRoutes parses the optional swap route flags, returning no routes if they are unset.
+func swapAmountInRoutes(fs *flag.FlagSet) ([]poolmanagertypes.SwapAmountInRoute, error) {
+	swapRoutePoolIds, err := fs.GetString(FlagSwapRoutePoolIds)
+	if err != nil {
+		return nil, err
+	}
+
+	swapRouteDenoms, err := fs.GetString(FlagSwapRouteDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	if swapRoutePoolIds == "" && swapRouteDenoms == "" {
+		return nil, nil
+	}
+
+	swapRoutePoolIdsArray := strings.Split(swapRoutePoolIds, ",")
+	swapRouteDenomsArray := strings.Split(swapRouteDenoms, ",")
+	if len(swapRoutePoolIdsArray) != len(swapRouteDenomsArray) {
+		return nil, errors.New("swap route pool ids and denoms mismatch")
+	}
+
+	routes := make([]poolmanagertypes.SwapAmountInRoute, 0, len(swapRoutePoolIdsArray))
+	for i, poolIdStr := range swapRoutePoolIdsArray {
+		poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, poolmanagertypes.SwapAmountInRoute{
+			PoolId:        poolId,
+			TokenOutDenom: swapRouteDenomsArray[i],
+		})
+	}
+	return routes, nil
+}
@@ -0,0 +1,65 @@
+package cli_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v15/osmoutils/osmocli"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v15/x/txfees/client/cli"
+	"github.com/osmosis-labs/osmosis/v15/x/txfees/types"
+)
+
+var govAddress = sdk.AccAddress(authtypes.NewModuleAddress(govtypes.ModuleName)).String()
+
+func TestNewUpdateFeeTokenCmd(t *testing.T) {
+	desc, _ := cli.NewUpdateFeeTokenCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgUpdateFeeToken]{
+		"update fee token": {
+			Cmd: "uatom 1 --from=" + govAddress,
+			ExpectedMsg: &types.MsgUpdateFeeToken{
+				Authority: govAddress,
+				Denom:     "uatom",
+				PoolId:    1,
+			},
+		},
+		"update multihop fee token": {
+			Cmd: "uatom 2 --swap-route-pool-ids=2,1 --swap-route-denoms=uusdc,adym --from=" + govAddress,
+			ExpectedMsg: &types.MsgUpdateFeeToken{
+				Authority: govAddress,
+				Denom:     "uatom",
+				PoolId:    2,
+				Routes: []poolmanagertypes.SwapAmountInRoute{
+					{PoolId: 2, TokenOutDenom: "uusdc"},
+					{PoolId: 1, TokenOutDenom: "adym"},
+				},
+			},
+		},
+		"swap route mismatch": {
+			Cmd:         "uatom 2 --swap-route-pool-ids=2,1 --swap-route-denoms=adym --from=" + govAddress,
+			ExpectedErr: true,
+		},
+		"invalid pool id": {
+			Cmd:         "uatom foo --from=" + govAddress,
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewRemoveFeeTokenCmd(t *testing.T) {
+	desc, _ := cli.NewRemoveFeeTokenCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgRemoveFeeToken]{
+		"remove fee token": {
+			Cmd: "uatom --from=" + govAddress,
+			ExpectedMsg: &types.MsgRemoveFeeToken{
+				Authority: govAddress,
+				Denom:     "uatom",
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
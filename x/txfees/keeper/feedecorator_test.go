@@ -2,14 +2,17 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	bankutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v15/x/txfees/ante"
 	"github.com/osmosis-labs/osmosis/v15/x/txfees/types"
 )
@@ -226,3 +229,42 @@ func (suite *KeeperTestSuite) TestFeeDecorator() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestFeeDecoratorWithTwap() {
+	suite.SetupTest()
+	baseDenom := sdk.DefaultBondDenom
+	twapWindow := time.Minute
+	gas := uint64(10000)
+	requiredBaseFee := sdk.NewDec(int64(gas))
+
+	params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+	params.TwapWindow = twapWindow
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+
+	poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1000000), sdk.NewInt64Coin("uion", 1000000))
+
+	// the price of uion doubles for the second half of the window
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(twapWindow / 2))
+	_, err := suite.App.PoolManagerKeeper.RouteExactAmountIn(suite.Ctx, suite.TestAccs[0],
+		[]poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "uion"}}, sdk.NewInt64Coin(baseDenom, 414214), sdk.ZeroInt())
+	suite.Require().NoError(err)
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(twapWindow / 2))
+
+	spotPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, baseDenom, "uion")
+	suite.Require().NoError(err)
+	twap, err := suite.App.TwapKeeper.GetArithmeticTwapToNow(suite.Ctx, poolId, "uion", baseDenom, suite.Ctx.BlockTime().Add(-twapWindow))
+	suite.Require().NoError(err)
+	suite.Require().True(twap.LT(spotPrice))
+
+	mfd := ante.NewMempoolFeeDecorator(*suite.App.TxFeesKeeper, nil)
+
+	// a fee worth enough at the spot price is not enough at the lower twap
+	feeAtSpotPrice := sdk.NewCoin("uion", requiredBaseFee.Quo(spotPrice).Ceil().TruncateInt())
+	err = mfd.IsSufficientFee(suite.Ctx, sdk.OneDec(), gas, feeAtSpotPrice)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	feeAtTwap := sdk.NewCoin("uion", requiredBaseFee.Quo(twap).Ceil().TruncateInt())
+	err = mfd.IsSufficientFee(suite.Ctx, sdk.OneDec(), gas, feeAtTwap)
+	suite.Require().NoError(err)
+}
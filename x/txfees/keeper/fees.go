@@ -8,7 +8,6 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
-	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v15/x/txfees/types"
 )
 
@@ -40,10 +39,9 @@ func (k Keeper) ChargeFeesFromPayer(
 // The fee must be sent to the module account beforehand.
 // Payer field if optional and is only used for the event.
 //
-// If a beneficiary is provided, half of the fee is sent to the beneficiary.
-// The remaining fee is sent to the txfees module account.
-// If the fee token is the base denomination, it is burned.
-// If the fee token is a registered fee token, it is swapped to the base denomination and then burned.
+// If the fee token is a registered fee token, it is first swapped to the base denomination.
+// The fee in base denomination is then split according to the fee split param: portions are sent
+// to the beneficiary if provided, the community pool and the fee collector, and the rest is burned.
 // If the fee token is unknown, it is sent to the community pool.
 func (k Keeper) ChargeFees(
 	ctx sdk.Context,
@@ -83,39 +81,66 @@ func (k Keeper) ChargeFees(
 		return nil
 	}
 
-	// Send 50% of the base denom fee to the beneficiary if presented
-	var beneficiaryCoins sdk.Coins
-	if beneficiary != nil {
-		fee := baseDenomFee[0]
-		// beneficiaryCoin = takerFeeCoin / 2
-		// note that beneficiaryCoin * 2 != takerFeeCoin because of the integer division rounding
-		beneficiaryCoins = sdk.Coins{{Denom: fee.Denom, Amount: fee.Amount.QuoRaw(2)}}
-		// takerFeeCoin = takerFeeCoin - beneficiaryCoin
-		baseDenomFee = baseDenomFee.Sub(beneficiaryCoins...)
+	// Split the base denom fee between its recipients, and burn the rest
+	event, err := k.distributeFee(ctx, baseDenomFee[0], beneficiary)
+	if err != nil {
+		return fmt.Errorf("distribute fee: %w", err)
+	}
+
+	event.Payer = payer
+	err = uevent.EmitTypedEvent(ctx, &event)
+	if err != nil {
+		k.Logger(ctx).Error("Failed to emit event", "event", "EventChargeFee", "error", err)
+	}
+
+	return nil
+}
+
+// distributeFee splits a fee in base denom held by the module account according to the fee split
+// param. It sends each portion to its recipient and burns the rest, and returns the event reporting
+// the portions.
+func (k Keeper) distributeFee(ctx sdk.Context, fee sdk.Coin, beneficiary *sdk.AccAddress) (types.EventChargeFee, error) {
+	burn, communityPool, feeCollector, beneficiaryRevenue := k.GetParams(ctx).FeeSplit.Split(fee, beneficiary != nil)
+	var (
+		burnCoins               = sdk.NewCoins(burn)
+		communityPoolCoins      = sdk.NewCoins(communityPool)
+		feeCollectorCoins       = sdk.NewCoins(feeCollector)
+		beneficiaryRevenueCoins = sdk.NewCoins(beneficiaryRevenue)
+	)
 
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, *beneficiary, beneficiaryCoins)
+	if !beneficiaryRevenueCoins.Empty() {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, *beneficiary, beneficiaryRevenueCoins)
 		if err != nil {
-			return fmt.Errorf("send coins from fee payer to beneficiary: %w", err)
+			return types.EventChargeFee{}, fmt.Errorf("send coins from fee payer to beneficiary: %w", err)
 		}
 	}
 
-	// Burn the remaining base denom fee
-	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, baseDenomFee)
-	if err != nil {
-		return fmt.Errorf("burn coins: %w", err)
+	if !communityPoolCoins.Empty() {
+		err := k.communityPool.FundCommunityPool(ctx, communityPoolCoins, k.accountKeeper.GetModuleAddress(types.ModuleName))
+		if err != nil {
+			return types.EventChargeFee{}, fmt.Errorf("fund community pool: %w", err)
+		}
 	}
 
-	err = uevent.EmitTypedEvent(ctx, &types.EventChargeFee{
-		Payer:              payer,
-		TakerFee:           baseDenomFee.String(),
-		Beneficiary:        ValueFromPtr(beneficiary).String(),
-		BeneficiaryRevenue: beneficiaryCoins.String(),
-	})
+	if !feeCollectorCoins.Empty() {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.FeeCollectorName, feeCollectorCoins)
+		if err != nil {
+			return types.EventChargeFee{}, fmt.Errorf("send coins to fee collector: %w", err)
+		}
+	}
+
+	err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins)
 	if err != nil {
-		k.Logger(ctx).Error("Failed to emit event", "event", "EventChargeFee", "error", err)
+		return types.EventChargeFee{}, fmt.Errorf("burn coins: %w", err)
 	}
 
-	return nil
+	return types.EventChargeFee{
+		TakerFee:             burnCoins.String(),
+		Beneficiary:          ValueFromPtr(beneficiary).String(),
+		BeneficiaryRevenue:   beneficiaryRevenueCoins.String(),
+		CommunityPoolRevenue: communityPoolCoins.String(),
+		FeeCollectorRevenue:  feeCollectorCoins.String(),
+	}, nil
 }
 
 func ValueFromPtr[T any](ptr *T) (zero T) {
@@ -152,10 +177,7 @@ func (k Keeper) swapFeeToBaseDenom(
 	// Swap the coin to base denom
 	var (
 		tokenOutAmount = sdk.ZeroInt() // Token amount in base denom
-		route          = []poolmanagertypes.SwapAmountInRoute{{
-			PoolId:        feetoken.PoolID,
-			TokenOutDenom: baseDenom,
-		}}
+		route          = feetoken.SwapRoutes(baseDenom)
 	)
 
 	err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
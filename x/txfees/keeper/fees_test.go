@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/osmosis-labs/osmosis/v15/testutils/apptesting"
@@ -152,6 +153,92 @@ func (s *KeeperTestSuite) TestChargeFees() {
 	}
 }
 
+func (s *KeeperTestSuite) TestFeeSplit() {
+	accs := apptesting.CreateRandomAccounts(2)
+	baseDenom := sdk.DefaultBondDenom
+
+	testCases := map[string]struct {
+		beneficiary        *sdk.AccAddress
+		epochEnd           bool
+		expBurned          sdk.Coins
+		expCommunityRev    sdk.Coins
+		expFeeCollectorRev sdk.Coins
+		expBeneficiaryRev  sdk.Coins
+	}{
+		"charge fees with beneficiary": {
+			beneficiary:        &accs[1],
+			expBurned:          sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 101)),
+			expCommunityRev:    sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 200)),
+			expFeeCollectorRev: sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 300)),
+			expBeneficiaryRev:  sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 400)),
+		},
+		"charge fees without beneficiary": {
+			expBurned:          sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 501)),
+			expCommunityRev:    sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 200)),
+			expFeeCollectorRev: sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 300)),
+		},
+		"epoch end": {
+			epochEnd:           true,
+			expBurned:          sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 501)),
+			expCommunityRev:    sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 200)),
+			expFeeCollectorRev: sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 300)),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			s.SetupTest()
+
+			params := s.App.TxFeesKeeper.GetParams(s.Ctx)
+			params.FeeSplit = types.FeeSplit{
+				Burn:          sdk.MustNewDecFromStr("0.1"),
+				CommunityPool: sdk.MustNewDecFromStr("0.2"),
+				FeeCollector:  sdk.MustNewDecFromStr("0.3"),
+				Beneficiary:   sdk.MustNewDecFromStr("0.4"),
+			}
+			s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+
+			fee := sdk.NewInt64Coin(baseDenom, 1001)
+			feeCollectorAddr := s.App.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+			feeCollectorBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, feeCollectorAddr)
+			supplyBefore := s.App.BankKeeper.GetSupply(s.Ctx, baseDenom)
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			if tc.epochEnd {
+				err := bankutil.FundModuleAccount(s.App.BankKeeper, s.Ctx, types.ModuleName, sdk.NewCoins(fee))
+				s.Require().NoError(err)
+				supplyBefore = supplyBefore.Add(fee)
+				err = s.App.TxFeesKeeper.AfterEpochEnd(s.Ctx, params.EpochIdentifier, 1)
+				s.Require().NoError(err)
+			} else {
+				s.FundAcc(accs[0], sdk.NewCoins(fee))
+				supplyBefore = supplyBefore.Add(fee)
+				err := s.App.TxFeesKeeper.ChargeFeesFromPayer(s.Ctx, accs[0], fee, tc.beneficiary)
+				s.Require().NoError(err)
+			}
+
+			// Verify charge fee event
+			eventName := proto.MessageName(new(types.EventChargeFee))
+			event := s.extractChargeFeeEvent(s.Ctx.EventManager().Events(), eventName)
+			s.Require().Equal(tc.expBurned.String(), event.TakerFee)
+			s.Require().Equal(tc.expCommunityRev.String(), event.CommunityPoolRevenue)
+			s.Require().Equal(tc.expFeeCollectorRev.String(), event.FeeCollectorRevenue)
+			s.Require().Equal(tc.expBeneficiaryRev.String(), event.BeneficiaryRevenue)
+
+			// Verify the portions reached their recipients
+			supplyAfter := s.App.BankKeeper.GetSupply(s.Ctx, baseDenom)
+			s.Require().Equal(supplyBefore.Sub(tc.expBurned[0]), supplyAfter)
+			s.Require().Equal(sdk.NewDecCoinsFromCoins(tc.expCommunityRev...), s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx))
+			feeCollectorAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, feeCollectorAddr)
+			s.Require().Equal(feeCollectorBefore.Add(tc.expFeeCollectorRev...), feeCollectorAfter)
+			if tc.beneficiary != nil {
+				s.Require().Equal(tc.expBeneficiaryRev, s.App.BankKeeper.GetAllBalances(s.Ctx, *tc.beneficiary))
+			}
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, s.App.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+		})
+	}
+}
+
 func (s *KeeperTestSuite) extractChargeFeeEvent(events []sdk.Event, eventName string) types.EventChargeFee {
 	event, found := s.FindLastEventOfType(events, eventName)
 	s.Require().True(found)
@@ -167,6 +254,10 @@ func (s *KeeperTestSuite) extractChargeFeeEvent(events []sdk.Event, eventName st
 			chargeFee.Beneficiary = value
 		case "beneficiary_revenue":
 			chargeFee.BeneficiaryRevenue = value
+		case "community_pool_revenue":
+			chargeFee.CommunityPoolRevenue = value
+		case "fee_collector_revenue":
+			chargeFee.FeeCollectorRevenue = value
 		}
 	}
 	return chargeFee
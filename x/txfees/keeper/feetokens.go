@@ -1,8 +1,14 @@
 package keeper
 
 import (
+	"errors"
+
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v15/x/twap/types"
 	"github.com/osmosis-labs/osmosis/v15/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,12 +31,69 @@ func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin
 		return sdk.Coin{}, err
 	}
 
-	spotPrice, err := k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, baseDenom, feeToken.Denom)
+	price, err := k.getFeeTokenPrice(ctx, feeToken, baseDenom)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
 
-	return sdk.NewCoin(baseDenom, spotPrice.MulInt(inputFee.Amount).RoundInt()), nil
+	return sdk.NewCoin(baseDenom, price.MulInt(inputFee.Amount).RoundInt()), nil
+}
+
+// getFeeTokenPrice returns the price of a fee token in terms of the base denom, chaining the
+// prices of the hops of its swap route.
+func (k Keeper) getFeeTokenPrice(ctx sdk.Context, feeToken types.FeeToken, baseDenom string) (sdk.Dec, error) {
+	return k.getRoutePrice(ctx, feeToken, baseDenom, k.getPoolPrice)
+}
+
+// getFeeTokenSpotPrice returns the spot price of a fee token in terms of the base denom, chaining
+// the spot prices of the hops of its swap route.
+func (k Keeper) getFeeTokenSpotPrice(ctx sdk.Context, feeToken types.FeeToken, baseDenom string) (sdk.Dec, error) {
+	return k.getRoutePrice(ctx, feeToken, baseDenom, k.getPoolSpotPrice)
+}
+
+// getRoutePrice multiplies the prices of the hops of the swap route of a fee token, each
+// returned by poolPrice.
+func (k Keeper) getRoutePrice(
+	ctx sdk.Context,
+	feeToken types.FeeToken,
+	baseDenom string,
+	poolPrice func(ctx sdk.Context, poolId uint64, baseAsset, quoteAsset string) (sdk.Dec, error),
+) (sdk.Dec, error) {
+	price := sdk.OneDec()
+	tokenInDenom := feeToken.Denom
+	for _, hop := range feeToken.SwapRoutes(baseDenom) {
+		hopPrice, err := poolPrice(ctx, hop.PoolId, tokenInDenom, hop.TokenOutDenom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		price = price.Mul(hopPrice)
+		tokenInDenom = hop.TokenOutDenom
+	}
+	return price, nil
+}
+
+// getPoolPrice returns the price of the base asset in terms of the quote asset in a pool.
+// If a twap window is set, the arithmetic twap over it is used, falling back to the
+// spot price while the twap records of the pool do not cover the window yet.
+func (k Keeper) getPoolPrice(ctx sdk.Context, poolId uint64, baseAsset, quoteAsset string) (sdk.Dec, error) {
+	twapWindow := k.GetParams(ctx).TwapWindow
+	if twapWindow > 0 {
+		startTime := ctx.BlockTime().Add(-twapWindow)
+		twap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, poolId, baseAsset, quoteAsset, startTime)
+		if err == nil {
+			return twap, nil
+		}
+		if !errors.Is(err, twaptypes.ErrRecordNotFound) {
+			return sdk.Dec{}, err
+		}
+	}
+
+	return k.getPoolSpotPrice(ctx, poolId, baseAsset, quoteAsset)
+}
+
+// getPoolSpotPrice returns the spot price of the base asset in terms of the quote asset in a pool.
+func (k Keeper) getPoolSpotPrice(ctx sdk.Context, poolId uint64, baseAsset, quoteAsset string) (sdk.Dec, error) {
+	return k.spotPriceCalculator.CalculateSpotPrice(ctx, poolId, quoteAsset, baseAsset)
 }
 
 // GetFeeToken returns the fee token record for a specific denom,
@@ -66,6 +129,8 @@ func (k Keeper) SetBaseDenom(ctx sdk.Context, denom string) error {
 // - The denom is not the base denom
 // - The gamm pool exists
 // - The gamm pool includes the base token and fee token.
+// If the fee token has routes, it checks instead that they start with its pool, end with the
+// base denom, and that each pool of the route includes the tokens in and out of its hop.
 func (k Keeper) ValidateFeeToken(ctx sdk.Context, feeToken types.FeeToken) error {
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
@@ -74,13 +139,36 @@ func (k Keeper) ValidateFeeToken(ctx sdk.Context, feeToken types.FeeToken) error
 	if baseDenom == feeToken.Denom {
 		return sdkerrors.Wrap(types.ErrInvalidFeeToken, "cannot add basedenom as a whitelisted fee token")
 	}
-	// This not returning an error implies that:
-	// - feeToken.Denom exists
-	// - feeToken.PoolID exists
-	// - feeToken.PoolID has both feeToken.Denom and baseDenom
-	_, err = k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, feeToken.Denom, baseDenom)
 
-	return err
+	routes := feeToken.SwapRoutes(baseDenom)
+	if err := poolmanagertypes.SwapAmountInRoutes(routes).Validate(); err != nil {
+		return err
+	}
+	if routes[0].PoolId != feeToken.PoolID {
+		return sdkerrors.Wrapf(types.ErrInvalidFeeToken, "routes must start with pool %d, got %d", feeToken.PoolID, routes[0].PoolId)
+	}
+	if lastDenom := routes[len(routes)-1].TokenOutDenom; lastDenom != baseDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidFeeToken, "routes must end with base denom %s, got %s", baseDenom, lastDenom)
+	}
+
+	tokenInDenom := feeToken.Denom
+	for _, hop := range routes {
+		denoms, err := k.spotPriceCalculator.GetPoolDenoms(ctx, hop.PoolId)
+		if err != nil {
+			return err
+		}
+		if !contains(denoms, tokenInDenom) || !contains(denoms, hop.TokenOutDenom) {
+			return sdkerrors.Wrapf(types.ErrInvalidFeeToken, "pool %d must hold both %s and %s", hop.PoolId, tokenInDenom, hop.TokenOutDenom)
+		}
+		// This not returning an error implies that the pool can price the hop
+		_, err = k.spotPriceCalculator.CalculateSpotPrice(ctx, hop.PoolId, tokenInDenom, hop.TokenOutDenom)
+		if err != nil {
+			return err
+		}
+		tokenInDenom = hop.TokenOutDenom
+	}
+
+	return nil
 }
 
 // GetFeeToken returns a unique fee token record for a specific denom.
@@ -107,14 +195,18 @@ func (k Keeper) GetFeeToken(ctx sdk.Context, denom string) (types.FeeToken, erro
 func (k Keeper) setFeeToken(ctx sdk.Context, feeToken types.FeeToken) error {
 	prefixStore := k.GetFeeTokensStore(ctx)
 
+	current, err := k.GetFeeToken(ctx, feeToken.Denom)
+	repointed := err == nil && !current.Equal(feeToken)
+
 	if feeToken.PoolID == 0 {
 		if prefixStore.Has([]byte(feeToken.Denom)) {
 			prefixStore.Delete([]byte(feeToken.Denom))
 		}
+		k.deleteFeeTokenPriceObservation(ctx, feeToken.Denom)
 		return nil
 	}
 
-	err := k.ValidateFeeToken(ctx, feeToken)
+	err = k.ValidateFeeToken(ctx, feeToken)
 	if err != nil {
 		return err
 	}
@@ -125,6 +217,10 @@ func (k Keeper) setFeeToken(ctx sdk.Context, feeToken types.FeeToken) error {
 	}
 
 	prefixStore.Set([]byte(feeToken.Denom), bz)
+
+	if repointed {
+		return k.reseedFeeTokenPriceObservation(ctx, feeToken)
+	}
 	return nil
 }
 
@@ -159,3 +255,188 @@ func (k Keeper) SetFeeTokens(ctx sdk.Context, feetokens []types.FeeToken) error
 	}
 	return nil
 }
+
+// UpdateFeeToken registers the denom as a fee token priced through the given pool, or re-points
+// the existing fee token of the denom to the pool. The fee token is marked as set by governance,
+// so that the epoch refresh and pool creation leave its pool and routes alone.
+func (k Keeper) UpdateFeeToken(ctx sdk.Context, feeToken types.FeeToken) error {
+	if feeToken.PoolID == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidFeeToken, "pool id must be positive")
+	}
+	feeToken.SetByGovernance = true
+
+	current, err := k.GetFeeToken(ctx, feeToken.Denom)
+	listed := err == nil
+
+	err = k.setFeeToken(ctx, feeToken)
+	if err != nil {
+		return err
+	}
+
+	if !listed {
+		return uevent.EmitTypedEvent(ctx, &types.EventFeeTokenAdded{
+			Denom:  feeToken.Denom,
+			PoolId: feeToken.PoolID,
+		})
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventFeeTokenPoolUpdated{
+		Denom:     feeToken.Denom,
+		OldPoolId: current.PoolID,
+		NewPoolId: feeToken.PoolID,
+	})
+}
+
+// RemoveFeeToken delists the fee token of the denom. Returns an error if the denom is not a fee token.
+func (k Keeper) RemoveFeeToken(ctx sdk.Context, denom string) error {
+	feeToken, err := k.GetFeeToken(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	err = k.setFeeToken(ctx, types.FeeToken{Denom: denom, PoolID: 0})
+	if err != nil {
+		return err
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventFeeTokenRemoved{
+		Denom:  denom,
+		PoolId: feeToken.PoolID,
+	})
+}
+
+// getDeepestFeeTokenPool returns the pool holding the most base denom among the current pool of
+// the fee token and the 2-asset pools pairing the denom with the base denom. Pools holding less
+// base denom than the min pool liquidity param are not considered. A qualifying current pool is
+// kept unless another pool holds more than its base denom scaled up by the pool switch margin
+// param, so that a deposit made just before the end of an epoch cannot cheaply move the fee
+// token. Returns false if none qualifies.
+func (k Keeper) getDeepestFeeTokenPool(ctx sdk.Context, denom, baseDenom string, currentPoolId uint64) (uint64, bool) {
+	params := k.GetParams(ctx)
+	minPoolLiquidity := params.MinPoolLiquidity
+
+	candidates := k.spotPriceCalculator.GetDenomPairPoolIds(ctx, denom, baseDenom)
+	if currentPoolId != 0 {
+		// the current pool comes first, so that it is kept on ties
+		candidates = append([]uint64{currentPoolId}, candidates...)
+	}
+
+	var (
+		deepestPoolId    uint64
+		deepestLiquidity sdk.Int
+		found            bool
+	)
+	for _, poolId := range candidates {
+		pool, err := k.spotPriceCalculator.GetPool(ctx, poolId)
+		if err != nil {
+			continue
+		}
+		liquidity := pool.GetTotalPoolLiquidity(ctx)
+		if poolId != currentPoolId && len(liquidity) != 2 {
+			continue
+		}
+		baseLiquidity := liquidity.AmountOf(baseDenom)
+		if baseLiquidity.LT(minPoolLiquidity) {
+			continue
+		}
+		if found {
+			requiredLiquidity := deepestLiquidity
+			if deepestPoolId == currentPoolId {
+				requiredLiquidity = sdk.OneDec().Add(params.PoolSwitchMargin).MulInt(deepestLiquidity).TruncateInt()
+			}
+			if baseLiquidity.LTE(requiredLiquidity) {
+				continue
+			}
+		}
+		if err := k.ValidateFeeToken(ctx, types.FeeToken{Denom: denom, PoolID: poolId}); err != nil {
+			continue
+		}
+		deepestPoolId, deepestLiquidity, found = poolId, baseLiquidity, true
+	}
+	return deepestPoolId, found
+}
+
+// multihopFeeTokenQualifies returns true if the last pool of the routes of the fee token holds at
+// least the min pool liquidity of base denom, and the routes are still valid.
+func (k Keeper) multihopFeeTokenQualifies(ctx sdk.Context, feeToken types.FeeToken, baseDenom string) bool {
+	routes := feeToken.SwapRoutes(baseDenom)
+	pool, err := k.spotPriceCalculator.GetPool(ctx, routes[len(routes)-1].PoolId)
+	if err != nil {
+		return false
+	}
+	baseLiquidity := pool.GetTotalPoolLiquidity(ctx).AmountOf(baseDenom)
+	if baseLiquidity.LT(k.GetParams(ctx).MinPoolLiquidity) {
+		return false
+	}
+	return k.ValidateFeeToken(ctx, feeToken) == nil
+}
+
+// refreshFeeToken points the fee token of the denom to its deepest qualifying pool. The denom is
+// registered if it is not a fee token yet, and delisted if no pool qualifies anymore.
+// A multihop fee token is replaced by a qualifying pool paired with the base denom if one exists,
+// and otherwise kept as long as its routes qualify. Fee tokens set by governance are left alone.
+func (k Keeper) refreshFeeToken(ctx sdk.Context, denom, baseDenom string) error {
+	feeToken, err := k.GetFeeToken(ctx, denom)
+	listed := err == nil
+	if listed && feeToken.SetByGovernance {
+		return nil
+	}
+
+	currentPoolId := feeToken.PoolID
+	if feeToken.IsMultihop() {
+		// the first pool of the routes does not hold the base denom
+		currentPoolId = 0
+	}
+
+	poolId, found := k.getDeepestFeeTokenPool(ctx, denom, baseDenom, currentPoolId)
+	switch {
+	case !found && !listed:
+		return nil
+	case !found && feeToken.IsMultihop() && k.multihopFeeTokenQualifies(ctx, feeToken, baseDenom):
+		return nil
+	case !found:
+		err = k.setFeeToken(ctx, types.FeeToken{Denom: denom, PoolID: 0})
+		if err != nil {
+			return err
+		}
+		return uevent.EmitTypedEvent(ctx, &types.EventFeeTokenRemoved{
+			Denom:  denom,
+			PoolId: feeToken.PoolID,
+		})
+	case !listed:
+		err = k.setFeeToken(ctx, types.FeeToken{Denom: denom, PoolID: poolId})
+		if err != nil {
+			return err
+		}
+		return uevent.EmitTypedEvent(ctx, &types.EventFeeTokenAdded{
+			Denom:  denom,
+			PoolId: poolId,
+		})
+	case poolId != feeToken.PoolID || feeToken.IsMultihop():
+		err = k.setFeeToken(ctx, types.FeeToken{Denom: denom, PoolID: poolId})
+		if err != nil {
+			return err
+		}
+		return uevent.EmitTypedEvent(ctx, &types.EventFeeTokenPoolUpdated{
+			Denom:     denom,
+			OldPoolId: feeToken.PoolID,
+			NewPoolId: poolId,
+		})
+	default:
+		return nil
+	}
+}
+
+// refreshFeeTokens re-checks the pool of every fee token against the min pool liquidity param,
+// delisting the tokens without a qualifying pool and re-pointing the others to their deepest pool.
+// Fee tokens set by governance are left alone.
+func (k Keeper) refreshFeeTokens(ctx sdk.Context, baseDenom string) {
+	for _, feeToken := range k.GetFeeTokens(ctx) {
+		denom := feeToken.Denom
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.refreshFeeToken(ctx, denom, baseDenom)
+		})
+		if err != nil {
+			k.Logger(ctx).Error("failed to refresh fee token", "denom", denom, "error", err)
+		}
+	}
+}
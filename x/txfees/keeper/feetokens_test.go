@@ -1,7 +1,13 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v15/x/txfees/types"
 )
 
 func (suite *KeeperTestSuite) TestBaseDenom() {
@@ -82,3 +88,110 @@ func (suite *KeeperTestSuite) TestFeeTokenConversions() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestFeeTokenConversionsWithTwap() {
+	suite.SetupTest()
+	baseDenom := sdk.DefaultBondDenom
+	twapWindow := time.Minute
+
+	params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+	params.TwapWindow = twapWindow
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+
+	poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1000000), sdk.NewInt64Coin("uion", 1000000))
+	inputFee := sdk.NewInt64Coin("uion", 1000000)
+
+	// the twap records do not cover the window yet, the spot price is used
+	converted, err := suite.App.TxFeesKeeper.ConvertToBaseToken(suite.Ctx, inputFee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(baseDenom, 1000000), converted)
+
+	// the price of uion halves for the second half of the window
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(twapWindow / 2))
+	_, err = suite.App.PoolManagerKeeper.RouteExactAmountIn(suite.Ctx, suite.TestAccs[0],
+		[]poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: baseDenom}}, sdk.NewInt64Coin("uion", 414214), sdk.ZeroInt())
+	suite.Require().NoError(err)
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(twapWindow / 2))
+
+	spotPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, baseDenom, "uion")
+	suite.Require().NoError(err)
+	twap, err := suite.App.TwapKeeper.GetArithmeticTwapToNow(suite.Ctx, poolId, "uion", baseDenom, suite.Ctx.BlockTime().Add(-twapWindow))
+	suite.Require().NoError(err)
+	suite.Require().True(twap.GT(spotPrice))
+
+	converted, err = suite.App.TxFeesKeeper.ConvertToBaseToken(suite.Ctx, inputFee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(baseDenom, twap.MulInt(inputFee.Amount).RoundInt()), converted)
+
+	// without a window, the spot price is used
+	params.TwapWindow = 0
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+	converted, err = suite.App.TxFeesKeeper.ConvertToBaseToken(suite.Ctx, inputFee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(baseDenom, spotPrice.MulInt(inputFee.Amount).RoundInt()), converted)
+}
+
+func (suite *KeeperTestSuite) TestMultihopFeeToken() {
+	suite.SetupTest()
+	baseDenom := sdk.DefaultBondDenom
+
+	ustPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1000000), sdk.NewInt64Coin("ust", 2000000))
+	uionPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uion", 1000000), sdk.NewInt64Coin("ust", 1000000))
+	routes := []poolmanagertypes.SwapAmountInRoute{
+		{PoolId: uionPoolId, TokenOutDenom: "ust"},
+		{PoolId: ustPoolId, TokenOutDenom: baseDenom},
+	}
+
+	// uion has no pool paired with the base denom
+	_, err := suite.App.TxFeesKeeper.GetFeeToken(suite.Ctx, "uion")
+	suite.Require().ErrorIs(err, types.ErrInvalidFeeToken)
+
+	invalidFeeTokens := map[string]types.FeeToken{
+		"no routes":                     {Denom: "uion", PoolID: uionPoolId},
+		"routes not ending in base":     {Denom: "uion", PoolID: uionPoolId, Routes: routes[:1]},
+		"routes not starting with pool": {Denom: "uion", PoolID: ustPoolId, Routes: routes},
+		"routes not holding denom":      {Denom: "foo", PoolID: uionPoolId, Routes: routes},
+	}
+	for name, feeToken := range invalidFeeTokens {
+		err = suite.App.TxFeesKeeper.UpdateFeeToken(suite.Ctx, feeToken)
+		suite.Require().ErrorIs(err, types.ErrInvalidFeeToken, name)
+	}
+
+	err = suite.App.TxFeesKeeper.UpdateFeeToken(suite.Ctx, types.FeeToken{Denom: "uion", PoolID: uionPoolId, Routes: routes})
+	suite.Require().NoError(err)
+
+	// 1 uion = 1 ust = 0.5 base denom
+	converted, err := suite.App.TxFeesKeeper.ConvertToBaseToken(suite.Ctx, sdk.NewInt64Coin("uion", 1000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(baseDenom, 500), converted)
+
+	// the fees are swapped through the routes at the end of the epoch, and the fee token is kept
+	err = bankutil.FundModuleAccount(suite.App.BankKeeper, suite.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uion", 1000)))
+	suite.Require().NoError(err)
+	err = suite.App.TxFeesKeeper.AfterEpochEnd(suite.Ctx, "day", 1)
+	suite.Require().NoError(err)
+	moduleAddr := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, moduleAddr).IsZero())
+	uionPool, err := suite.App.GAMMKeeper.GetPool(suite.Ctx, uionPoolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1001000), uionPool.GetTotalPoolLiquidity(suite.Ctx).AmountOf("uion"))
+	feeToken, err := suite.App.TxFeesKeeper.GetFeeToken(suite.Ctx, "uion")
+	suite.Require().NoError(err)
+	suite.Require().Equal(routes, feeToken.Routes)
+
+	// a pool paired with the base denom does not replace the routes set by governance
+	directPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1000000), sdk.NewInt64Coin("uion", 1000000))
+	feeToken, err = suite.App.TxFeesKeeper.GetFeeToken(suite.Ctx, "uion")
+	suite.Require().NoError(err)
+	suite.Require().Equal(routes, feeToken.Routes)
+
+	// it replaces routes not set by governance at the end of the epoch
+	err = suite.App.TxFeesKeeper.SetFeeTokens(suite.Ctx, []types.FeeToken{{Denom: "uion", PoolID: uionPoolId, Routes: routes}})
+	suite.Require().NoError(err)
+	err = suite.App.TxFeesKeeper.AfterEpochEnd(suite.Ctx, "day", 2)
+	suite.Require().NoError(err)
+	feeToken, err = suite.App.TxFeesKeeper.GetFeeToken(suite.Ctx, "uion")
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FeeToken{Denom: "uion", PoolID: directPoolId}, feeToken)
+}
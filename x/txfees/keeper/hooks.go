@@ -4,11 +4,11 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
 	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 	epochstypes "github.com/osmosis-labs/osmosis/v15/x/epochs/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
-	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v15/x/txfees/types"
 )
 
@@ -32,7 +32,7 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 	return nil
 }
 
-// at the end of each epoch, swap all non-DYM fees into DYM and burn them
+// at the end of each epoch, swap all non-DYM fees into DYM and split them according to the fee split param
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	if epochIdentifier != k.GetParams(ctx).EpochIdentifier {
 		return nil
@@ -64,12 +64,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		}
 
 		// Do the swap of this fee token denom to base denom.
-		route := []poolmanagertypes.SwapAmountInRoute{
-			{
-				PoolId:        feetoken.PoolID,
-				TokenOutDenom: baseDenom,
-			},
-		}
+		route := feetoken.SwapRoutes(baseDenom)
 		wrappedRouteExactAmountInFn := func(ctx sdk.Context) error {
 			_, err := k.poolManager.RouteExactAmountIn(ctx, moduleAddr, route, coinBalance, sdk.ZeroInt())
 			return err
@@ -84,12 +79,18 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		}
 	}
 
-	// Get all of the txfee payout denom in the module account
-	baseDenomCoins := sdk.NewCoins(k.bankKeeper.GetBalance(ctx, moduleAddr, baseDenom))
-	err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, baseDenomCoins)
+	// Split all of the txfee payout denom in the module account between its recipients, and burn the rest
+	baseDenomCoin := k.bankKeeper.GetBalance(ctx, moduleAddr, baseDenom)
+	event, err := k.distributeFee(ctx, baseDenomCoin, nil)
 	if err != nil {
 		return err
 	}
+	if err := uevent.EmitTypedEvent(ctx, &event); err != nil {
+		k.Logger(ctx).Error("Failed to emit event", "event", "EventChargeFee", "error", err)
+	}
+
+	// re-check the fee token pools against the min pool liquidity
+	k.refreshFeeTokens(ctx, baseDenom)
 
 	return nil
 }
@@ -106,7 +107,8 @@ func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumbe
 /*                                 pool hooks                                 */
 /* -------------------------------------------------------------------------- */
 
-// AfterPoolCreated creates a gauge for each pool’s lockable duration.
+// AfterPoolCreated registers the non-base denom of a 2-asset pool paired with the base denom as a
+// fee token, provided the pool holds at least the min pool liquidity of base denom.
 func (h Hooks) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
 	//check if base denom included in the pool
 	baseDenom, err := h.k.GetBaseDenom(ctx)
@@ -137,19 +139,13 @@ func (h Hooks) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId u
 		nonNativeDenom = denoms[0]
 	}
 
-	_, err = h.k.GetFeeToken(ctx, nonNativeDenom)
-	if err == nil {
-		h.k.Logger(ctx).Error("fee token already exists", "denom", nonNativeDenom)
-		return
-	}
-
-	feeToken := types.FeeToken{
-		PoolID: poolId,
-		Denom:  nonNativeDenom,
-	}
-	err = h.k.setFeeToken(ctx, feeToken)
+	// registers the denom if the pool holds enough base denom, or re-points the existing fee token
+	// if the pool is deeper than its current one
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return h.k.refreshFeeToken(ctx, nonNativeDenom, baseDenom)
+	})
 	if err != nil {
-		h.k.Logger(ctx).Error("failed to set fee token", "error", err)
+		h.k.Logger(ctx).Error("failed to refresh fee token", "denom", nonNativeDenom, "error", err)
 		return
 	}
 }
@@ -5,7 +5,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/gogoproto/proto"
 
+	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v15/x/txfees/types"
 )
 
@@ -70,4 +72,108 @@ func (suite *KeeperTestSuite) TestTxFeesAfterEpochEnd() {
 	}
 }
 
-//TODO: pool hooks
+func (suite *KeeperTestSuite) TestFeeTokenPoolLiquidity() {
+	suite.SetupTest()
+	baseDenom := sdk.DefaultBondDenom
+	uion := "uion"
+
+	params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+	params.MinPoolLiquidity = sdk.NewInt(1000000)
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+
+	resetEvents := func() {
+		suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	}
+	requireFeeTokenPool := func(expectedPoolId uint64) {
+		feeToken, err := suite.App.TxFeesKeeper.GetFeeToken(suite.Ctx, uion)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expectedPoolId, feeToken.PoolID)
+	}
+
+	// a pool seeded with dust is not registered
+	resetEvents()
+	suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1000), sdk.NewInt64Coin(uion, 1000))
+	_, err := suite.App.TxFeesKeeper.GetFeeToken(suite.Ctx, uion)
+	suite.Require().ErrorIs(err, types.ErrInvalidFeeToken)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventFeeTokenAdded{}), 0)
+
+	// a pool above the threshold is registered
+	resetEvents()
+	deepPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 2000000), sdk.NewInt64Coin(uion, 2000000))
+	requireFeeTokenPool(deepPoolId)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventFeeTokenAdded{}), 1)
+
+	// a deeper pool takes over
+	resetEvents()
+	deeperPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 5000000), sdk.NewInt64Coin(uion, 1000000))
+	requireFeeTokenPool(deeperPoolId)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventFeeTokenPoolUpdated{}), 1)
+
+	// the first pool becomes the deepest by less than the pool switch margin, the fee token stays
+	resetEvents()
+	_, _, err = suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[0], deepPoolId,
+		sdk.NewIntFromBigInt(gammtypes.InitPoolSharesSupply.MulRaw(2).BigInt()), sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 4000000), sdk.NewInt64Coin(uion, 4000000)))
+	suite.Require().NoError(err)
+	err = suite.App.TxFeesKeeper.AfterEpochEnd(suite.Ctx, params.EpochIdentifier, 1)
+	suite.Require().NoError(err)
+	requireFeeTokenPool(deeperPoolId)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventFeeTokenPoolUpdated{}), 0)
+
+	// the first pool exceeds the margin, the fee token is re-pointed at the end of the epoch
+	resetEvents()
+	_, _, err = suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[0], deepPoolId,
+		sdk.NewIntFromBigInt(gammtypes.InitPoolSharesSupply.BigInt()), sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 2000000), sdk.NewInt64Coin(uion, 2000000)))
+	suite.Require().NoError(err)
+	requireFeeTokenPool(deeperPoolId)
+	err = suite.App.TxFeesKeeper.AfterEpochEnd(suite.Ctx, params.EpochIdentifier, 2)
+	suite.Require().NoError(err)
+	requireFeeTokenPool(deepPoolId)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventFeeTokenPoolUpdated{}), 1)
+
+	// no pool qualifies anymore, the fee token is delisted at the end of the epoch
+	resetEvents()
+	params.MinPoolLiquidity = sdk.NewInt(100000000)
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+	err = suite.App.TxFeesKeeper.AfterEpochEnd(suite.Ctx, params.EpochIdentifier, 3)
+	suite.Require().NoError(err)
+	_, err = suite.App.TxFeesKeeper.GetFeeToken(suite.Ctx, uion)
+	suite.Require().ErrorIs(err, types.ErrInvalidFeeToken)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventFeeTokenRemoved{}), 1)
+}
+
+func (suite *KeeperTestSuite) TestGovernanceFeeTokenPoolLiquidity() {
+	suite.SetupTest()
+	baseDenom := sdk.DefaultBondDenom
+	uion := "uion"
+
+	params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+	params.MinPoolLiquidity = sdk.NewInt(1000000)
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+
+	deepPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 2000000), sdk.NewInt64Coin(uion, 2000000))
+	shallowPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1000), sdk.NewInt64Coin(uion, 1000))
+	feeToken, err := suite.App.TxFeesKeeper.GetFeeToken(suite.Ctx, uion)
+	suite.Require().NoError(err)
+	suite.Require().Equal(deepPoolId, feeToken.PoolID)
+	suite.Require().False(feeToken.SetByGovernance)
+
+	// governance points the fee token to a pool below the threshold
+	err = suite.App.TxFeesKeeper.UpdateFeeToken(suite.Ctx, types.FeeToken{Denom: uion, PoolID: shallowPoolId})
+	suite.Require().NoError(err)
+
+	// the end of the epoch neither re-points it to the deeper pool nor delists it
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	err = suite.App.TxFeesKeeper.AfterEpochEnd(suite.Ctx, params.EpochIdentifier, 1)
+	suite.Require().NoError(err)
+	feeToken, err = suite.App.TxFeesKeeper.GetFeeToken(suite.Ctx, uion)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FeeToken{Denom: uion, PoolID: shallowPoolId, SetByGovernance: true}, feeToken)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventFeeTokenPoolUpdated{}), 0)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventFeeTokenRemoved{}), 0)
+
+	// nor does a new deeper pool
+	suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 5000000), sdk.NewInt64Coin(uion, 1000000))
+	feeToken, err = suite.App.TxFeesKeeper.GetFeeToken(suite.Ctx, uion)
+	suite.Require().NoError(err)
+	suite.Require().Equal(shallowPoolId, feeToken.PoolID)
+}
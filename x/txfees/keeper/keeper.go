@@ -23,6 +23,10 @@ type Keeper struct {
 	poolManager         types.PoolManager
 	spotPriceCalculator types.SpotPriceCalculator
 	communityPool       types.CommunityPoolKeeper
+	twapKeeper          types.TwapKeeper
+
+	// the address capable of executing governance gated messages, i.e. the gov module account
+	authority string
 }
 
 var _ types.TxFeesKeeper = (*Keeper)(nil)
@@ -36,6 +40,8 @@ func NewKeeper(
 	poolManager types.PoolManager,
 	spotPriceCalculator types.SpotPriceCalculator,
 	communityPool types.CommunityPoolKeeper,
+	twapKeeper types.TwapKeeper,
+	authority string,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -50,9 +56,16 @@ func NewKeeper(
 		poolManager:         poolManager,
 		spotPriceCalculator: spotPriceCalculator,
 		communityPool:       communityPool,
+		twapKeeper:          twapKeeper,
+		authority:           authority,
 	}
 }
 
+// GetAuthority returns the address capable of executing governance gated messages.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
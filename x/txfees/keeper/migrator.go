@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/txfees/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from version 1 to 2, setting the params added since version 1 to
+// their defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaultParams := types.DefaultParams()
+	m.keeper.paramSpace.Set(ctx, types.KeyTwapWindow, defaultParams.TwapWindow)
+	m.keeper.paramSpace.Set(ctx, types.KeyMinPoolLiquidity, defaultParams.MinPoolLiquidity)
+	m.keeper.paramSpace.Set(ctx, types.KeyPoolSwitchMargin, defaultParams.PoolSwitchMargin)
+	m.keeper.paramSpace.Set(ctx, types.KeyPriceObservationWindow, defaultParams.PriceObservationWindow)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxPriceDeviation, defaultParams.MaxPriceDeviation)
+	m.keeper.paramSpace.Set(ctx, types.KeyFeeSplit, defaultParams.FeeSplit)
+	return nil
+}
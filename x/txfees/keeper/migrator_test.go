@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/txfees/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/txfees/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	suite.SetupTest()
+
+	// Drop the params added after version 1, as on a chain that has not been migrated yet.
+	paramStore := prefix.NewStore(suite.Ctx.KVStore(suite.App.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramStore.Delete(types.KeyTwapWindow)
+	paramStore.Delete(types.KeyMinPoolLiquidity)
+	paramStore.Delete(types.KeyPoolSwitchMargin)
+	paramStore.Delete(types.KeyPriceObservationWindow)
+	paramStore.Delete(types.KeyMaxPriceDeviation)
+	paramStore.Delete(types.KeyFeeSplit)
+	suite.Require().Panics(func() { suite.App.TxFeesKeeper.GetParams(suite.Ctx) })
+
+	err := keeper.NewMigrator(*suite.App.TxFeesKeeper).Migrate1to2(suite.Ctx)
+	suite.Require().NoError(err)
+
+	params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+	defaultParams := types.DefaultParams()
+	suite.Require().Equal(defaultParams.TwapWindow, params.TwapWindow)
+	suite.Require().True(defaultParams.MinPoolLiquidity.Equal(params.MinPoolLiquidity))
+	suite.Require().True(defaultParams.PoolSwitchMargin.Equal(params.PoolSwitchMargin))
+	suite.Require().Equal(defaultParams.PriceObservationWindow, params.PriceObservationWindow)
+	suite.Require().True(defaultParams.MaxPriceDeviation.Equal(params.MaxPriceDeviation))
+	suite.Require().Equal(defaultParams.FeeSplit, params.FeeSplit)
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/txfees/types"
+)
+
+type msgServer struct {
+	keeper Keeper
+}
+
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// UpdateFeeToken registers a fee token or changes its pool.
+func (server msgServer) UpdateFeeToken(goCtx context.Context, msg *types.MsgUpdateFeeToken) (*types.MsgUpdateFeeTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if server.keeper.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", server.keeper.authority, msg.Authority)
+	}
+
+	err := server.keeper.UpdateFeeToken(ctx, types.FeeToken{Denom: msg.Denom, PoolID: msg.PoolId, Routes: msg.Routes})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateFeeTokenResponse{}, nil
+}
+
+// RemoveFeeToken delists a fee token.
+func (server msgServer) RemoveFeeToken(goCtx context.Context, msg *types.MsgRemoveFeeToken) (*types.MsgRemoveFeeTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if server.keeper.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", server.keeper.authority, msg.Authority)
+	}
+
+	err := server.keeper.RemoveFeeToken(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFeeTokenResponse{}, nil
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/v15/x/txfees/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/txfees/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateAndRemoveFeeToken() {
+	suite.SetupTest()
+	baseDenom := sdk.DefaultBondDenom
+	txFeesKeeper := *suite.App.TxFeesKeeper
+	msgServer := keeper.NewMsgServerImpl(txFeesKeeper)
+	authority := txFeesKeeper.GetAuthority()
+
+	uionPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1000000), sdk.NewInt64Coin("uion", 1000000))
+	noBasePoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("atom", 1000000), sdk.NewInt64Coin("uion", 1000000))
+	shallowUionPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1000), sdk.NewInt64Coin("uion", 1000))
+	requireFeeTokenPool := func(expectedPoolId uint64) {
+		feeToken, err := txFeesKeeper.GetFeeToken(suite.Ctx, "uion")
+		suite.Require().NoError(err)
+		suite.Require().Equal(expectedPoolId, feeToken.PoolID)
+	}
+	requireFeeTokenPool(uionPoolId)
+
+	// only the authority can update fee tokens
+	updateMsg := types.MsgUpdateFeeToken{Authority: suite.TestAccs[0].String(), Denom: "uion", PoolId: shallowUionPoolId}
+	_, err := msgServer.UpdateFeeToken(sdk.WrapSDKContext(suite.Ctx), &updateMsg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the pool must hold the base denom
+	updateMsg.Authority = authority
+	updateMsg.PoolId = noBasePoolId
+	_, err = msgServer.UpdateFeeToken(sdk.WrapSDKContext(suite.Ctx), &updateMsg)
+	suite.Require().ErrorIs(err, types.ErrInvalidFeeToken)
+	requireFeeTokenPool(uionPoolId)
+
+	// the pool must hold the fee token
+	_, err = msgServer.UpdateFeeToken(sdk.WrapSDKContext(suite.Ctx), &types.MsgUpdateFeeToken{Authority: authority, Denom: "foo", PoolId: uionPoolId})
+	suite.Require().ErrorIs(err, types.ErrInvalidFeeToken)
+
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	updateMsg.PoolId = shallowUionPoolId
+	_, err = msgServer.UpdateFeeToken(sdk.WrapSDKContext(suite.Ctx), &updateMsg)
+	suite.Require().NoError(err)
+	requireFeeTokenPool(shallowUionPoolId)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventFeeTokenPoolUpdated{}), 1)
+
+	// only the authority can remove fee tokens
+	removeMsg := types.MsgRemoveFeeToken{Authority: suite.TestAccs[0].String(), Denom: "uion"}
+	_, err = msgServer.RemoveFeeToken(sdk.WrapSDKContext(suite.Ctx), &removeMsg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the denom must be a fee token
+	_, err = msgServer.RemoveFeeToken(sdk.WrapSDKContext(suite.Ctx), &types.MsgRemoveFeeToken{Authority: authority, Denom: "atom"})
+	suite.Require().ErrorIs(err, types.ErrInvalidFeeToken)
+
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	removeMsg.Authority = authority
+	_, err = msgServer.RemoveFeeToken(sdk.WrapSDKContext(suite.Ctx), &removeMsg)
+	suite.Require().NoError(err)
+	_, err = txFeesKeeper.GetFeeToken(suite.Ctx, "uion")
+	suite.Require().ErrorIs(err, types.ErrInvalidFeeToken)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventFeeTokenRemoved{}), 1)
+
+	// a removed fee token can be registered again
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	updateMsg.PoolId = uionPoolId
+	_, err = msgServer.UpdateFeeToken(sdk.WrapSDKContext(suite.Ctx), &updateMsg)
+	suite.Require().NoError(err)
+	requireFeeTokenPool(uionPoolId)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventFeeTokenAdded{}), 1)
+}
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+
+	twaptypes "github.com/osmosis-labs/osmosis/v15/x/twap/types"
+	"github.com/osmosis-labs/osmosis/v15/x/txfees/types"
+)
+
+// ConvertToBaseTokenConservative converts a fee amount in a whitelisted fee token to the base fee
+// token amount, at the lowest of the spot price, the twap over the twap window and the average
+// price observed over the price observation window. Returns an error if the spot price deviates
+// from the average price by more than the max price deviation param. The twap is skipped while the
+// twap records do not cover the window, and the observed average until a price is observed.
+func (k Keeper) ConvertToBaseTokenConservative(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin, error) {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if inputFee.Denom == baseDenom {
+		return inputFee, nil
+	}
+
+	feeToken, err := k.GetFeeToken(ctx, inputFee.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	spotPrice, err := k.getFeeTokenSpotPrice(ctx, feeToken, baseDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	price := spotPrice
+
+	params := k.GetParams(ctx)
+	if params.TwapWindow > 0 {
+		twapPrice, err := k.getFeeTokenPrice(ctx, feeToken, baseDenom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		price = sdk.MinDec(price, twapPrice)
+	}
+
+	observation, found := k.GetFeeTokenPriceObservation(ctx, inputFee.Denom)
+	if params.PriceObservationWindow > 0 && found {
+		maxDeviation := params.MaxPriceDeviation
+		if maxDeviation.IsPositive() {
+			// a tiny price can truncate to a zero average, from which no deviation can be measured
+			if !observation.AveragePrice.IsPositive() {
+				return sdk.Coin{}, sdkerrors.Wrapf(types.ErrFeeTokenPriceDeviation,
+					"%s average price %s is not positive", inputFee.Denom, observation.AveragePrice)
+			}
+			deviation := spotPrice.Sub(observation.AveragePrice).Abs().Quo(observation.AveragePrice)
+			if deviation.GT(maxDeviation) {
+				return sdk.Coin{}, sdkerrors.Wrapf(types.ErrFeeTokenPriceDeviation,
+					"%s spot price %s deviates by %s from its average price %s, max %s",
+					inputFee.Denom, spotPrice, deviation, observation.AveragePrice, maxDeviation)
+			}
+		}
+		price = sdk.MinDec(price, observation.AveragePrice)
+	}
+
+	return sdk.NewCoin(baseDenom, price.MulInt(inputFee.Amount).RoundInt()), nil
+}
+
+// RecordFeeTokenPrices observes the spot price of each fee token, and updates its time weighted
+// moving average over the price observation window. It is a no-op if the window is zero.
+func (k Keeper) RecordFeeTokenPrices(ctx sdk.Context) {
+	window := k.GetParams(ctx).PriceObservationWindow
+	if window <= 0 {
+		return
+	}
+
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("failed to get base denom", "error", err)
+		return
+	}
+
+	for _, feeToken := range k.GetFeeTokens(ctx) {
+		spotPrice, err := k.getFeeTokenSpotPrice(ctx, feeToken, baseDenom)
+		if err != nil {
+			k.Logger(ctx).Error("failed to get fee token spot price", "denom", feeToken.Denom, "error", err)
+			continue
+		}
+
+		observation, found := k.GetFeeTokenPriceObservation(ctx, feeToken.Denom)
+		elapsed := ctx.BlockTime().Sub(observation.LastObservationTime)
+		switch {
+		case !found || elapsed >= window:
+			// the previous observation, if any, does not cover the window anymore
+			observation.AveragePrice = spotPrice
+		case elapsed > 0:
+			// the previous spot price held since the previous observation, weigh it by the time elapsed
+			weight := sdk.NewDec(elapsed.Nanoseconds()).QuoInt64(window.Nanoseconds())
+			observation.AveragePrice = observation.AveragePrice.Add(observation.SpotPrice.Sub(observation.AveragePrice).Mul(weight))
+		}
+		observation.Denom = feeToken.Denom
+		observation.SpotPrice = spotPrice
+		observation.LastObservationTime = ctx.BlockTime()
+
+		k.setFeeTokenPriceObservation(ctx, observation)
+	}
+}
+
+// reseedFeeTokenPriceObservation restarts the observation of a fee token re-pointed to another
+// pool from the twap of its new route over the price observation window, so that re-pointing the
+// fee token does not drop its observed average. The previous observation, if any, is kept while
+// the twap records of the new route do not cover the window.
+func (k Keeper) reseedFeeTokenPriceObservation(ctx sdk.Context, feeToken types.FeeToken) error {
+	window := k.GetParams(ctx).PriceObservationWindow
+	if window <= 0 {
+		return nil
+	}
+
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	startTime := ctx.BlockTime().Add(-window)
+	averagePrice, err := k.getRoutePrice(ctx, feeToken, baseDenom,
+		func(ctx sdk.Context, poolId uint64, baseAsset, quoteAsset string) (sdk.Dec, error) {
+			return k.twapKeeper.GetArithmeticTwapToNow(ctx, poolId, baseAsset, quoteAsset, startTime)
+		})
+	if errors.Is(err, twaptypes.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	spotPrice, err := k.getFeeTokenSpotPrice(ctx, feeToken, baseDenom)
+	if err != nil {
+		return err
+	}
+
+	k.setFeeTokenPriceObservation(ctx, types.FeeTokenPriceObservation{
+		Denom:               feeToken.Denom,
+		SpotPrice:           spotPrice,
+		AveragePrice:        averagePrice,
+		LastObservationTime: ctx.BlockTime(),
+	})
+	return nil
+}
+
+func (k Keeper) getFeeTokenPricesStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeTokenPricesStorePrefix)
+}
+
+// GetFeeTokenPriceObservation returns the last price observation of a fee token, and false if
+// its price has not been observed yet.
+func (k Keeper) GetFeeTokenPriceObservation(ctx sdk.Context, denom string) (types.FeeTokenPriceObservation, bool) {
+	bz := k.getFeeTokenPricesStore(ctx).Get([]byte(denom))
+	if bz == nil {
+		return types.FeeTokenPriceObservation{}, false
+	}
+
+	observation := types.FeeTokenPriceObservation{}
+	err := proto.Unmarshal(bz, &observation)
+	if err != nil {
+		panic(err)
+	}
+	return observation, true
+}
+
+func (k Keeper) setFeeTokenPriceObservation(ctx sdk.Context, observation types.FeeTokenPriceObservation) {
+	bz, err := proto.Marshal(&observation)
+	if err != nil {
+		panic(err)
+	}
+	k.getFeeTokenPricesStore(ctx).Set([]byte(observation.Denom), bz)
+}
+
+func (k Keeper) deleteFeeTokenPriceObservation(ctx sdk.Context, denom string) {
+	k.getFeeTokenPricesStore(ctx).Delete([]byte(denom))
+}
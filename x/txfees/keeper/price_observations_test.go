@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v15/x/txfees/types"
+)
+
+func (suite *KeeperTestSuite) TestConvertToBaseTokenConservative() {
+	suite.SetupTest()
+	baseDenom := sdk.DefaultBondDenom
+	window := 10 * time.Minute
+	inputFee := sdk.NewInt64Coin("uion", 1000)
+
+	params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+	params.PriceObservationWindow = window
+	params.MaxPriceDeviation = sdk.MustNewDecFromStr("0.2")
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+
+	poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1000000), sdk.NewInt64Coin("uion", 1000000))
+	swap := func(tokenIn sdk.Coin, tokenOutDenom string) sdk.Dec {
+		_, err := suite.App.PoolManagerKeeper.RouteExactAmountIn(suite.Ctx, suite.TestAccs[0],
+			[]poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}, tokenIn, sdk.ZeroInt())
+		suite.Require().NoError(err)
+		spotPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, baseDenom, "uion")
+		suite.Require().NoError(err)
+		return spotPrice
+	}
+	recordAfter := func(elapsed time.Duration) types.FeeTokenPriceObservation {
+		suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(elapsed))
+		suite.App.TxFeesKeeper.RecordFeeTokenPrices(suite.Ctx)
+		observation, found := suite.App.TxFeesKeeper.GetFeeTokenPriceObservation(suite.Ctx, "uion")
+		suite.Require().True(found)
+		return observation
+	}
+
+	// the spot price is used until a price is observed
+	_, found := suite.App.TxFeesKeeper.GetFeeTokenPriceObservation(suite.Ctx, "uion")
+	suite.Require().False(found)
+	converted, err := suite.App.TxFeesKeeper.ConvertToBaseTokenConservative(suite.Ctx, inputFee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(baseDenom, 1000), converted)
+
+	observation := recordAfter(0)
+	suite.Require().Equal(sdk.OneDec(), observation.SpotPrice)
+	suite.Require().Equal(sdk.OneDec(), observation.AveragePrice)
+
+	// uion is pumped by more than the max deviation
+	pumpedPrice := swap(sdk.NewInt64Coin(baseDenom, 100000), "uion")
+	_, err = suite.App.TxFeesKeeper.ConvertToBaseTokenConservative(suite.Ctx, inputFee)
+	suite.Require().ErrorIs(err, types.ErrFeeTokenPriceDeviation)
+
+	// within the max deviation, the lower average price is used
+	params.MaxPriceDeviation = sdk.MustNewDecFromStr("0.5")
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+	converted, err = suite.App.TxFeesKeeper.ConvertToBaseTokenConservative(suite.Ctx, inputFee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(baseDenom, 1000), converted)
+
+	// the pumped price only weighs in the average for the time it held
+	observation = recordAfter(window / 2)
+	suite.Require().Equal(pumpedPrice, observation.SpotPrice)
+	suite.Require().Equal(sdk.OneDec(), observation.AveragePrice)
+	observation = recordAfter(window / 2)
+	expectedAverage := sdk.OneDec().Add(pumpedPrice.Sub(sdk.OneDec()).QuoInt64(2))
+	suite.Require().Equal(expectedAverage, observation.AveragePrice)
+
+	// the lower spot price is used once uion is dumped
+	dumpedPrice := swap(sdk.NewInt64Coin("uion", 200000), baseDenom)
+	suite.Require().True(dumpedPrice.LT(expectedAverage))
+	converted, err = suite.App.TxFeesKeeper.ConvertToBaseTokenConservative(suite.Ctx, inputFee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(baseDenom, dumpedPrice.MulInt(inputFee.Amount).RoundInt()), converted)
+
+	// an observation older than the window is restarted from the spot price
+	observation = recordAfter(window)
+	suite.Require().Equal(dumpedPrice, observation.AveragePrice)
+
+	// re-pointing the fee token to a pool whose twap records do not cover the window keeps its observation
+	otherPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1000), sdk.NewInt64Coin("uion", 1000))
+	err = suite.App.TxFeesKeeper.UpdateFeeToken(suite.Ctx, types.FeeToken{Denom: "uion", PoolID: otherPoolId})
+	suite.Require().NoError(err)
+	reseeded, found := suite.App.TxFeesKeeper.GetFeeTokenPriceObservation(suite.Ctx, "uion")
+	suite.Require().True(found)
+	suite.Require().Equal(observation, reseeded)
+
+	// re-pointing it to a pool whose twap records cover the window restarts its observation from the twap
+	err = suite.App.TxFeesKeeper.UpdateFeeToken(suite.Ctx, types.FeeToken{Denom: "uion", PoolID: poolId})
+	suite.Require().NoError(err)
+	twap, err := suite.App.TwapKeeper.GetArithmeticTwapToNow(suite.Ctx, poolId, "uion", baseDenom, suite.Ctx.BlockTime().Add(-window))
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(observation.AveragePrice, twap)
+	reseeded, found = suite.App.TxFeesKeeper.GetFeeTokenPriceObservation(suite.Ctx, "uion")
+	suite.Require().True(found)
+	suite.Require().Equal(twap, reseeded.AveragePrice)
+	suite.Require().Equal(dumpedPrice, reseeded.SpotPrice)
+
+	// delisting the fee token discards its observation
+	err = suite.App.TxFeesKeeper.RemoveFeeToken(suite.Ctx, "uion")
+	suite.Require().NoError(err)
+	_, found = suite.App.TxFeesKeeper.GetFeeTokenPriceObservation(suite.Ctx, "uion")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestConvertToBaseTokenConservativeZeroAverage() {
+	suite.SetupTest()
+	baseDenom := sdk.DefaultBondDenom
+	inputFee := sdk.NewInt64Coin("uion", 1000)
+
+	params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+	params.PriceObservationWindow = 10 * time.Minute
+	params.MaxPriceDeviation = sdk.MustNewDecFromStr("0.2")
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+	suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1000000), sdk.NewInt64Coin("uion", 1000000))
+
+	// a price too small for the decimal precision is observed as zero
+	observation := types.FeeTokenPriceObservation{
+		Denom:               "uion",
+		SpotPrice:           sdk.ZeroDec(),
+		AveragePrice:        sdk.ZeroDec(),
+		LastObservationTime: suite.Ctx.BlockTime(),
+	}
+	bz, err := proto.Marshal(&observation)
+	suite.Require().NoError(err)
+	store := prefix.NewStore(suite.Ctx.KVStore(suite.App.GetKey(types.StoreKey)), types.FeeTokenPricesStorePrefix)
+	store.Set([]byte(observation.Denom), bz)
+
+	_, err = suite.App.TxFeesKeeper.ConvertToBaseTokenConservative(suite.Ctx, inputFee)
+	suite.Require().ErrorIs(err, types.ErrFeeTokenPriceDeviation)
+}
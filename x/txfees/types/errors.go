@@ -11,4 +11,6 @@ var (
 	ErrNoBaseDenom     = sdkerrors.Register(ModuleName, 1, "no base denom was set")
 	ErrTooManyFeeCoins = sdkerrors.Register(ModuleName, 2, "too many fee coins. only accepts fees in one denom")
 	ErrInvalidFeeToken = sdkerrors.Register(ModuleName, 3, "invalid fee token")
+
+	ErrFeeTokenPriceDeviation = sdkerrors.Register(ModuleName, 4, "fee token spot price deviates too much from its average price")
 )
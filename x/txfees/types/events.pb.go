@@ -25,12 +25,20 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type EventChargeFee struct {
-	Payer    string `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	Payer string `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	// TakerFee is the portion of the fee burned, or the whole fee if it is sent
+	// to the community pool as is.
 	TakerFee string `protobuf:"bytes,2,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
 	// Beneficiary is the address that will receive the fee. Optional: may be empty.
 	Beneficiary        string `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
 	BeneficiaryRevenue string `protobuf:"bytes,4,opt,name=beneficiary_revenue,json=beneficiaryRevenue,proto3" json:"beneficiary_revenue,omitempty"`
-	CommunityPool      bool   `protobuf:"varint,5,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty"`
+	// CommunityPool is true if the fee could not be converted to the base denom
+	// and was sent to the community pool as is.
+	CommunityPool bool `protobuf:"varint,5,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty"`
+	// CommunityPoolRevenue is the portion of the fee sent to the community pool.
+	CommunityPoolRevenue string `protobuf:"bytes,6,opt,name=community_pool_revenue,json=communityPoolRevenue,proto3" json:"community_pool_revenue,omitempty"`
+	// FeeCollectorRevenue is the portion of the fee sent to the fee collector.
+	FeeCollectorRevenue string `protobuf:"bytes,7,opt,name=fee_collector_revenue,json=feeCollectorRevenue,proto3" json:"fee_collector_revenue,omitempty"`
 }
 
 func (m *EventChargeFee) Reset()         { *m = EventChargeFee{} }
@@ -101,8 +109,193 @@ func (m *EventChargeFee) GetCommunityPool() bool {
 	return false
 }
 
+func (m *EventChargeFee) GetCommunityPoolRevenue() string {
+	if m != nil {
+		return m.CommunityPoolRevenue
+	}
+	return ""
+}
+
+func (m *EventChargeFee) GetFeeCollectorRevenue() string {
+	if m != nil {
+		return m.FeeCollectorRevenue
+	}
+	return ""
+}
+
+// EventFeeTokenAdded is emitted when a denom is registered as a fee token.
+type EventFeeTokenAdded struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *EventFeeTokenAdded) Reset()         { *m = EventFeeTokenAdded{} }
+func (m *EventFeeTokenAdded) String() string { return proto.CompactTextString(m) }
+func (*EventFeeTokenAdded) ProtoMessage()    {}
+func (*EventFeeTokenAdded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdb570c08d9ae603, []int{1}
+}
+func (m *EventFeeTokenAdded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeTokenAdded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeTokenAdded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeTokenAdded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeTokenAdded.Merge(m, src)
+}
+func (m *EventFeeTokenAdded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeTokenAdded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeTokenAdded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeTokenAdded proto.InternalMessageInfo
+
+func (m *EventFeeTokenAdded) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventFeeTokenAdded) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// EventFeeTokenRemoved is emitted when a fee token is delisted.
+type EventFeeTokenRemoved struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *EventFeeTokenRemoved) Reset()         { *m = EventFeeTokenRemoved{} }
+func (m *EventFeeTokenRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventFeeTokenRemoved) ProtoMessage()    {}
+func (*EventFeeTokenRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdb570c08d9ae603, []int{2}
+}
+func (m *EventFeeTokenRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeTokenRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeTokenRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeTokenRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeTokenRemoved.Merge(m, src)
+}
+func (m *EventFeeTokenRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeTokenRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeTokenRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeTokenRemoved proto.InternalMessageInfo
+
+func (m *EventFeeTokenRemoved) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventFeeTokenRemoved) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// EventFeeTokenPoolUpdated is emitted when a fee token is re-pointed to
+// another pool.
+type EventFeeTokenPoolUpdated struct {
+	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	OldPoolId uint64 `protobuf:"varint,2,opt,name=old_pool_id,json=oldPoolId,proto3" json:"old_pool_id,omitempty"`
+	NewPoolId uint64 `protobuf:"varint,3,opt,name=new_pool_id,json=newPoolId,proto3" json:"new_pool_id,omitempty"`
+}
+
+func (m *EventFeeTokenPoolUpdated) Reset()         { *m = EventFeeTokenPoolUpdated{} }
+func (m *EventFeeTokenPoolUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventFeeTokenPoolUpdated) ProtoMessage()    {}
+func (*EventFeeTokenPoolUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdb570c08d9ae603, []int{3}
+}
+func (m *EventFeeTokenPoolUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeTokenPoolUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeTokenPoolUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeTokenPoolUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeTokenPoolUpdated.Merge(m, src)
+}
+func (m *EventFeeTokenPoolUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeTokenPoolUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeTokenPoolUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeTokenPoolUpdated proto.InternalMessageInfo
+
+func (m *EventFeeTokenPoolUpdated) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventFeeTokenPoolUpdated) GetOldPoolId() uint64 {
+	if m != nil {
+		return m.OldPoolId
+	}
+	return 0
+}
+
+func (m *EventFeeTokenPoolUpdated) GetNewPoolId() uint64 {
+	if m != nil {
+		return m.NewPoolId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventChargeFee)(nil), "dymensionxyz.dymension.txfees.v1beta1.EventChargeFee")
+	proto.RegisterType((*EventFeeTokenAdded)(nil), "dymensionxyz.dymension.txfees.v1beta1.EventFeeTokenAdded")
+	proto.RegisterType((*EventFeeTokenRemoved)(nil), "dymensionxyz.dymension.txfees.v1beta1.EventFeeTokenRemoved")
+	proto.RegisterType((*EventFeeTokenPoolUpdated)(nil), "dymensionxyz.dymension.txfees.v1beta1.EventFeeTokenPoolUpdated")
 }
 
 func init() {
@@ -110,27 +303,35 @@ func init() {
 }
 
 var fileDescriptor_fdb570c08d9ae603 = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x4e, 0xeb, 0x30,
-	0x18, 0x85, 0xeb, 0x7b, 0x29, 0x6a, 0x8d, 0xe8, 0x10, 0x3a, 0x44, 0x45, 0xb2, 0x2a, 0xa4, 0x4a,
-	0x5d, 0x88, 0xd5, 0x22, 0x5e, 0x00, 0x04, 0x13, 0x03, 0xea, 0xc8, 0x52, 0xd9, 0xe1, 0x6f, 0x6a,
-	0xd1, 0xf8, 0x8f, 0x6c, 0xb7, 0xaa, 0x79, 0x0a, 0x5e, 0x88, 0x9d, 0xb1, 0x23, 0x23, 0x6a, 0x5f,
-	0x04, 0xc5, 0xa1, 0x51, 0xb6, 0x9c, 0xf3, 0xe5, 0xb3, 0xe5, 0x43, 0xa7, 0xaf, 0x3e, 0x07, 0x6d,
-	0x15, 0xea, 0xad, 0x7f, 0xe7, 0x75, 0xe0, 0x6e, 0xbb, 0x00, 0xb0, 0x7c, 0x33, 0x91, 0xe0, 0xc4,
-	0x84, 0xc3, 0x06, 0xb4, 0xb3, 0x49, 0x61, 0xd0, 0x61, 0x34, 0x6a, 0x3a, 0x49, 0x1d, 0x92, 0xca,
-	0x49, 0xfe, 0x9c, 0x41, 0x3f, 0xc3, 0x0c, 0x83, 0xc1, 0xcb, 0xaf, 0x4a, 0x1e, 0xb0, 0x14, 0x6d,
-	0x8e, 0x96, 0x4b, 0x61, 0xa1, 0x3e, 0x3e, 0x45, 0xa5, 0x2b, 0x7e, 0xf5, 0x49, 0x68, 0xef, 0xa1,
-	0xbc, 0xed, 0x7e, 0x29, 0x4c, 0x06, 0x8f, 0x00, 0x51, 0x9f, 0xb6, 0x0b, 0xe1, 0xc1, 0xc4, 0x64,
-	0x48, 0xc6, 0xdd, 0x59, 0x15, 0xa2, 0x4b, 0xda, 0x75, 0xe2, 0x0d, 0xcc, 0x7c, 0x01, 0x10, 0xff,
-	0x0b, 0xa4, 0x13, 0x8a, 0x52, 0x19, 0xd2, 0x33, 0x09, 0x1a, 0x16, 0x2a, 0x55, 0xc2, 0xf8, 0xf8,
-	0x7f, 0xc0, 0xcd, 0x2a, 0xe2, 0xf4, 0xa2, 0x11, 0xe7, 0xa6, 0x7c, 0xe1, 0x1a, 0xe2, 0x93, 0xf0,
-	0x67, 0xd4, 0x40, 0xb3, 0x8a, 0x44, 0x23, 0xda, 0x4b, 0x31, 0xcf, 0xd7, 0x5a, 0x39, 0x3f, 0x2f,
-	0x10, 0x57, 0x71, 0x7b, 0x48, 0xc6, 0x9d, 0xd9, 0x79, 0xdd, 0x3e, 0x23, 0xae, 0xee, 0x9e, 0xbe,
-	0xf6, 0x8c, 0xec, 0xf6, 0x8c, 0xfc, 0xec, 0x19, 0xf9, 0x38, 0xb0, 0xd6, 0xee, 0xc0, 0x5a, 0xdf,
-	0x07, 0xd6, 0x7a, 0x99, 0x66, 0xca, 0x2d, 0xd7, 0x32, 0x49, 0x31, 0xe7, 0x61, 0x03, 0x65, 0xaf,
-	0x57, 0x42, 0xda, 0x63, 0xe0, 0x9b, 0xc9, 0x2d, 0xdf, 0x1e, 0x97, 0x77, 0xbe, 0x00, 0x2b, 0x4f,
-	0xc3, 0x28, 0x37, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbb, 0x92, 0x02, 0x64, 0xa7, 0x01, 0x00,
-	0x00,
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x6a, 0xdb, 0x40,
+	0x10, 0xc6, 0xad, 0xfc, 0x71, 0xe2, 0x0d, 0xcd, 0x61, 0xe3, 0xb6, 0x22, 0x05, 0x61, 0x0c, 0x81,
+	0x5c, 0xaa, 0xc5, 0x6e, 0xfb, 0x00, 0xad, 0x49, 0xa0, 0xd0, 0x43, 0x10, 0xed, 0xa5, 0x17, 0x21,
+	0x69, 0x47, 0xce, 0x12, 0x69, 0x47, 0x68, 0xd7, 0x8e, 0xd5, 0xa7, 0xe8, 0x5b, 0xf4, 0x55, 0x7a,
+	0xcc, 0xb1, 0xc7, 0x62, 0xbf, 0x48, 0xd9, 0x95, 0x25, 0xa4, 0x43, 0x0f, 0xb9, 0xe9, 0x9b, 0x6f,
+	0xbe, 0x9f, 0x76, 0x86, 0x21, 0x73, 0x5e, 0xe5, 0x20, 0x95, 0x40, 0xb9, 0xa9, 0x7e, 0xb0, 0x56,
+	0x30, 0xbd, 0x49, 0x01, 0x14, 0x5b, 0xcf, 0x62, 0xd0, 0xd1, 0x8c, 0xc1, 0x1a, 0xa4, 0x56, 0x7e,
+	0x51, 0xa2, 0x46, 0x7a, 0xd5, 0xcd, 0xf8, 0xad, 0xf0, 0xeb, 0x8c, 0xbf, 0xcf, 0x5c, 0x8e, 0x97,
+	0xb8, 0x44, 0x9b, 0x60, 0xe6, 0xab, 0x0e, 0x5f, 0x7a, 0x09, 0xaa, 0x1c, 0x15, 0x8b, 0x23, 0x05,
+	0x2d, 0x3e, 0x41, 0x21, 0x6b, 0x7f, 0xfa, 0xeb, 0x80, 0x9c, 0xdf, 0x98, 0xbf, 0x2d, 0xee, 0xa3,
+	0x72, 0x09, 0xb7, 0x00, 0x74, 0x4c, 0x8e, 0x8b, 0xa8, 0x82, 0xd2, 0x75, 0x26, 0xce, 0xf5, 0x28,
+	0xa8, 0x05, 0x7d, 0x43, 0x46, 0x3a, 0x7a, 0x80, 0x32, 0x4c, 0x01, 0xdc, 0x03, 0xeb, 0x9c, 0xda,
+	0x82, 0x89, 0x4c, 0xc8, 0x59, 0x0c, 0x12, 0x52, 0x91, 0x88, 0xa8, 0xac, 0xdc, 0x43, 0x6b, 0x77,
+	0x4b, 0x94, 0x91, 0x8b, 0x8e, 0x0c, 0x4b, 0x33, 0xe1, 0x0a, 0xdc, 0x23, 0xdb, 0x49, 0x3b, 0x56,
+	0x50, 0x3b, 0xf4, 0x8a, 0x9c, 0x27, 0x98, 0xe7, 0x2b, 0x29, 0x74, 0x15, 0x16, 0x88, 0x99, 0x7b,
+	0x3c, 0x71, 0xae, 0x4f, 0x83, 0x17, 0x6d, 0xf5, 0x0e, 0x31, 0xa3, 0xef, 0xc9, 0xab, 0x7e, 0x5b,
+	0x8b, 0x1e, 0x5a, 0xf4, 0xb8, 0xd7, 0xde, 0xc0, 0xe7, 0xe4, 0x65, 0x0a, 0x10, 0x26, 0x98, 0x65,
+	0x90, 0x68, 0x2c, 0xdb, 0xd0, 0x89, 0x0d, 0x5d, 0xa4, 0x00, 0x8b, 0xc6, 0xdb, 0x67, 0xa6, 0x0b,
+	0x42, 0xed, 0xa2, 0x6e, 0x01, 0xbe, 0xe2, 0x03, 0xc8, 0x8f, 0x9c, 0x03, 0x37, 0xcb, 0xe2, 0x20,
+	0x31, 0x6f, 0x96, 0x65, 0x05, 0x7d, 0x4d, 0x4e, 0xec, 0x5b, 0x04, 0xb7, 0xab, 0x3a, 0x0a, 0x86,
+	0x46, 0x7e, 0xe6, 0xd3, 0x1b, 0x32, 0xee, 0x41, 0x02, 0xc8, 0x71, 0xfd, 0x7c, 0x4c, 0x41, 0xdc,
+	0x1e, 0xc6, 0xcc, 0xf6, 0xad, 0xe0, 0x91, 0xfe, 0x2f, 0xca, 0x23, 0x67, 0x98, 0xf1, 0xb0, 0x8f,
+	0x1b, 0x61, 0xc6, 0xef, 0x2c, 0xd1, 0xf8, 0x12, 0x1e, 0x5b, 0xff, 0xb0, 0xf6, 0x25, 0x3c, 0xd6,
+	0xfe, 0xa7, 0x2f, 0xbf, 0xb7, 0x9e, 0xf3, 0xb4, 0xf5, 0x9c, 0xbf, 0x5b, 0xcf, 0xf9, 0xb9, 0xf3,
+	0x06, 0x4f, 0x3b, 0x6f, 0xf0, 0x67, 0xe7, 0x0d, 0xbe, 0xcf, 0x97, 0x42, 0xdf, 0xaf, 0x62, 0x3f,
+	0xc1, 0x9c, 0xd9, 0x5b, 0x13, 0xea, 0x6d, 0x16, 0xc5, 0xaa, 0x11, 0x6c, 0x3d, 0xfb, 0xc0, 0x36,
+	0xcd, 0x85, 0xeb, 0xaa, 0x00, 0x15, 0x0f, 0xed, 0xf1, 0xbd, 0xfb, 0x37, 0x00, 0x3c, 0x8a, 0x04,
+	0x08, 0x0f, 0x03, 0x00, 0x00,
 }
 
 func (m *EventChargeFee) Marshal() (dAtA []byte, err error) {
@@ -153,6 +354,20 @@ func (m *EventChargeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeCollectorRevenue) > 0 {
+		i -= len(m.FeeCollectorRevenue)
+		copy(dAtA[i:], m.FeeCollectorRevenue)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FeeCollectorRevenue)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CommunityPoolRevenue) > 0 {
+		i -= len(m.CommunityPoolRevenue)
+		copy(dAtA[i:], m.CommunityPoolRevenue)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CommunityPoolRevenue)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.CommunityPool {
 		i--
 		if m.CommunityPool {
@@ -194,6 +409,116 @@ func (m *EventChargeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventFeeTokenAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeTokenAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeTokenAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeeTokenRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeTokenRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeTokenRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeeTokenPoolUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeTokenPoolUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeTokenPoolUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewPoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OldPoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldPoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -230,6 +555,65 @@ func (m *EventChargeFee) Size() (n int) {
 	if m.CommunityPool {
 		n += 2
 	}
+	l = len(m.CommunityPoolRevenue)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FeeCollectorRevenue)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFeeTokenAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *EventFeeTokenRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *EventFeeTokenPoolUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OldPoolId != 0 {
+		n += 1 + sovEvents(uint64(m.OldPoolId))
+	}
+	if m.NewPoolId != 0 {
+		n += 1 + sovEvents(uint64(m.NewPoolId))
+	}
 	return n
 }
 
@@ -416,6 +800,392 @@ func (m *EventChargeFee) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CommunityPool = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRevenue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPoolRevenue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorRevenue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollectorRevenue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFeeTokenAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeeTokenAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeeTokenAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFeeTokenRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeeTokenRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeeTokenRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFeeTokenPoolUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeeTokenPoolUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeeTokenPoolUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPoolId", wireType)
+			}
+			m.OldPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPoolId", wireType)
+			}
+			m.NewPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
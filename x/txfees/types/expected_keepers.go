@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -13,6 +15,14 @@ import (
 type SpotPriceCalculator interface {
 	CalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteDenom, baseDenom string) (sdk.Dec, error)
 	GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
+	GetPool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error)
+	GetDenomPoolIds(ctx sdk.Context, denom string) []uint64
+	GetDenomPairPoolIds(ctx sdk.Context, denomA, denomB string) []uint64
+}
+
+// TwapKeeper defines the contract needed to convert fees with time weighted average prices.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAsset, quoteAsset string, startTime time.Time) (sdk.Dec, error)
 }
 
 // PoolManager defines the contract needed for swap related APIs.
@@ -49,6 +59,7 @@ type BankKeeper interface {
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 }
 
 // TxFeesKeeper defines the expected transaction fee keeper
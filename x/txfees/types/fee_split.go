@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultFeeSplit burns half of the fees, and sends the other half to their beneficiary if any.
+func DefaultFeeSplit() FeeSplit {
+	return FeeSplit{
+		Burn:          sdk.NewDecWithPrec(5, 1),
+		CommunityPool: sdk.ZeroDec(),
+		FeeCollector:  sdk.ZeroDec(),
+		Beneficiary:   sdk.NewDecWithPrec(5, 1),
+	}
+}
+
+// Validate checks that the weights are not negative and sum to one.
+func (s FeeSplit) Validate() error {
+	weights := []struct {
+		name   string
+		weight sdk.Dec
+	}{
+		{"burn", s.Burn},
+		{"community pool", s.CommunityPool},
+		{"fee collector", s.FeeCollector},
+		{"beneficiary", s.Beneficiary},
+	}
+	for _, w := range weights {
+		if w.weight.IsNil() || w.weight.IsNegative() {
+			return fmt.Errorf("%s weight must not be negative, got %s", w.name, w.weight)
+		}
+	}
+
+	sum := s.Burn.Add(s.CommunityPool).Add(s.FeeCollector).Add(s.Beneficiary)
+	if !sum.Equal(sdk.OneDec()) {
+		return fmt.Errorf("fee split weights must sum to one, got %s", sum)
+	}
+	return nil
+}
+
+// Split splits a fee into its portions. Each portion but the burned one is rounded down, and the
+// burned portion gets the remainder. The beneficiary portion is burned if there is no beneficiary.
+func (s FeeSplit) Split(fee sdk.Coin, hasBeneficiary bool) (burn, communityPool, feeCollector, beneficiary sdk.Coin) {
+	portion := func(weight sdk.Dec) sdk.Coin {
+		return sdk.NewCoin(fee.Denom, weight.MulInt(fee.Amount).TruncateInt())
+	}
+
+	communityPool = portion(s.CommunityPool)
+	feeCollector = portion(s.FeeCollector)
+	beneficiary = sdk.NewCoin(fee.Denom, sdk.ZeroInt())
+	if hasBeneficiary {
+		beneficiary = portion(s.Beneficiary)
+	}
+	burn = fee.Sub(communityPool).Sub(feeCollector).Sub(beneficiary)
+	return burn, communityPool, feeCollector, beneficiary
+}
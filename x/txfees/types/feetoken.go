@@ -0,0 +1,17 @@
+package types
+
+import poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+
+// IsMultihop returns true if the fee token is swapped to the base denom through a route of pools.
+func (feeToken FeeToken) IsMultihop() bool {
+	return len(feeToken.Routes) > 0
+}
+
+// SwapRoutes returns the route swapping the fee token to the base denom: its routes if set,
+// or else a single hop through its pool.
+func (feeToken FeeToken) SwapRoutes(baseDenom string) []poolmanagertypes.SwapAmountInRoute {
+	if feeToken.IsMultihop() {
+		return feeToken.Routes
+	}
+	return []poolmanagertypes.SwapAmountInRoute{{PoolId: feeToken.PoolID, TokenOutDenom: baseDenom}}
+}
@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,10 +32,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // FeeToken is a struct that specifies a coin denom, and pool ID pair.
 // This marks the token as eligible for use as a tx fee asset in Osmosis.
 // Its price in osmo is derived through looking at the provided pool ID.
-// The pool ID must have base denom as one of its assets.
+// The pool ID must have base denom as one of its assets, unless routes are set.
 type FeeToken struct {
 	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	PoolID uint64 `protobuf:"varint,2,opt,name=poolID,proto3" json:"poolID,omitempty" yaml:"pool_id"`
+	// routes is an optional swap path from the denom to the base denom, for
+	// tokens without a pool paired with the base denom. If set, its first pool
+	// must be poolID and its last token out must be the base denom.
+	Routes []types.SwapAmountInRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	// set_by_governance is true if the fee token was set through governance. The
+	// epoch refresh and pool creation then leave its pool and routes alone.
+	SetByGovernance bool `protobuf:"varint,4,opt,name=set_by_governance,json=setByGovernance,proto3" json:"set_by_governance,omitempty" yaml:"set_by_governance"`
 }
 
 func (m *FeeToken) Reset()         { *m = FeeToken{} }
@@ -79,8 +92,82 @@ func (m *FeeToken) GetPoolID() uint64 {
 	return 0
 }
 
+func (m *FeeToken) GetRoutes() []types.SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *FeeToken) GetSetByGovernance() bool {
+	if m != nil {
+		return m.SetByGovernance
+	}
+	return false
+}
+
+// FeeTokenPriceObservation is the price of a fee token in terms of the base
+// denom, as observed by the module at the end of each block.
+type FeeTokenPriceObservation struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// spot_price is the spot price at the last observation.
+	SpotPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=spot_price,json=spotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price" yaml:"spot_price"`
+	// average_price is the time weighted moving average of the spot price over
+	// the price observation window.
+	AveragePrice        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=average_price,json=averagePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_price" yaml:"average_price"`
+	LastObservationTime time.Time                              `protobuf:"bytes,4,opt,name=last_observation_time,json=lastObservationTime,proto3,stdtime" json:"last_observation_time" yaml:"last_observation_time"`
+}
+
+func (m *FeeTokenPriceObservation) Reset()         { *m = FeeTokenPriceObservation{} }
+func (m *FeeTokenPriceObservation) String() string { return proto.CompactTextString(m) }
+func (*FeeTokenPriceObservation) ProtoMessage()    {}
+func (*FeeTokenPriceObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca4a790beba5662b, []int{1}
+}
+func (m *FeeTokenPriceObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTokenPriceObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTokenPriceObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTokenPriceObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTokenPriceObservation.Merge(m, src)
+}
+func (m *FeeTokenPriceObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTokenPriceObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTokenPriceObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTokenPriceObservation proto.InternalMessageInfo
+
+func (m *FeeTokenPriceObservation) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeTokenPriceObservation) GetLastObservationTime() time.Time {
+	if m != nil {
+		return m.LastObservationTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*FeeToken)(nil), "dymensionxyz.dymension.txfees.v1beta1.FeeToken")
+	proto.RegisterType((*FeeTokenPriceObservation)(nil), "dymensionxyz.dymension.txfees.v1beta1.FeeTokenPriceObservation")
 }
 
 func init() {
@@ -88,23 +175,42 @@ func init() {
 }
 
 var fileDescriptor_ca4a790beba5662b = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x49, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x4b, 0x2a, 0xd2, 0x52,
-	0x53, 0x8b, 0xf5, 0xcb, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0xd3, 0x52, 0x53, 0x4b, 0xf2,
-	0xb3, 0x53, 0xf3, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x54, 0x91, 0x75, 0xe9, 0xc1, 0x39,
-	0x7a, 0x10, 0x5d, 0x7a, 0x50, 0x5d, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x1d, 0xfa, 0x20,
-	0x16, 0x44, 0xb3, 0x52, 0x0a, 0x17, 0x87, 0x5b, 0x6a, 0x6a, 0x08, 0xc8, 0x38, 0x21, 0x35, 0x2e,
-	0xd6, 0x94, 0xd4, 0xbc, 0xfc, 0x5c, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0x81, 0x4f, 0xf7,
-	0xe4, 0x79, 0x2a, 0x13, 0x73, 0x73, 0xac, 0x94, 0xc0, 0xc2, 0x4a, 0x41, 0x10, 0x69, 0x21, 0x2d,
-	0x2e, 0xb6, 0x82, 0xfc, 0xfc, 0x1c, 0x4f, 0x17, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x16, 0x27, 0xa1,
-	0x4f, 0xf7, 0xe4, 0xf9, 0x20, 0x0a, 0x41, 0xe2, 0xf1, 0x99, 0x29, 0x4a, 0x41, 0x50, 0x15, 0x56,
-	0x2c, 0x2f, 0x16, 0xc8, 0x33, 0x3a, 0xf9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
-	0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c,
-	0x43, 0x94, 0x51, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x7e, 0x71,
-	0x6e, 0x7e, 0x71, 0x66, 0xb1, 0x6e, 0x4e, 0x62, 0x52, 0x31, 0x8c, 0xa3, 0x5f, 0x66, 0x68, 0xaa,
-	0x5f, 0x01, 0x0b, 0x81, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0xd3, 0x8d, 0x01, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x7b, 0x93, 0xa7, 0x35, 0x2f, 0x01, 0x00, 0x00,
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x9b, 0x10, 0x35, 0x6e, 0x0b, 0xd4, 0xb4, 0x92, 0x15, 0x55, 0x76, 0x64, 0x89, 0x2a,
+	0x42, 0xaa, 0x57, 0x09, 0x70, 0x29, 0xe2, 0x80, 0xa9, 0x0a, 0x95, 0x90, 0x40, 0xa6, 0x27, 0x2e,
+	0xd6, 0x3a, 0x99, 0x18, 0x2b, 0xb6, 0xd7, 0xf2, 0x6e, 0xd2, 0x98, 0x77, 0x40, 0xea, 0x03, 0x70,
+	0xe0, 0x71, 0x7a, 0xec, 0x11, 0x71, 0x30, 0x28, 0xb9, 0x70, 0xce, 0x13, 0xa0, 0xdd, 0xb5, 0x43,
+	0x10, 0x54, 0x82, 0x93, 0x3d, 0xbb, 0xf3, 0xfd, 0xcc, 0xe7, 0xb1, 0xfa, 0x68, 0x98, 0xc7, 0x90,
+	0xd0, 0x90, 0x24, 0xb3, 0xfc, 0x03, 0x5a, 0x15, 0x88, 0xcd, 0x46, 0x00, 0x14, 0x4d, 0x7b, 0x3e,
+	0x30, 0xdc, 0x43, 0x23, 0x00, 0x46, 0xc6, 0x90, 0xd8, 0x69, 0x46, 0x18, 0xd1, 0xee, 0xaf, 0xa3,
+	0xec, 0x55, 0x61, 0x4b, 0x94, 0x5d, 0xa2, 0xda, 0x7b, 0x01, 0x09, 0x88, 0x40, 0x20, 0xfe, 0x26,
+	0xc1, 0x6d, 0x33, 0x20, 0x24, 0x88, 0x00, 0x89, 0xca, 0x9f, 0x8c, 0x10, 0x0b, 0x63, 0xa0, 0x0c,
+	0xc7, 0x69, 0xd9, 0xf0, 0xe4, 0x06, 0x4f, 0x29, 0x21, 0x51, 0x8c, 0x13, 0x1c, 0x40, 0xb6, 0x32,
+	0x46, 0x2f, 0x70, 0xea, 0x65, 0x64, 0xc2, 0x40, 0x82, 0xad, 0x4f, 0x1b, 0xea, 0xe6, 0x29, 0xc0,
+	0x39, 0x77, 0xab, 0x1d, 0xaa, 0xb7, 0x86, 0x90, 0x90, 0x58, 0x57, 0x3a, 0x4a, 0xb7, 0xe5, 0xdc,
+	0x5d, 0x16, 0xe6, 0x76, 0x8e, 0xe3, 0xe8, 0xd8, 0x12, 0xc7, 0x96, 0x2b, 0xaf, 0xb5, 0x07, 0x6a,
+	0x93, 0x93, 0x9f, 0x9d, 0xe8, 0x1b, 0x1d, 0xa5, 0xdb, 0x70, 0xb4, 0x65, 0x61, 0xde, 0x96, 0x8d,
+	0xfc, 0xdc, 0x0b, 0x87, 0x96, 0x5b, 0x76, 0x68, 0x91, 0xda, 0x14, 0x7a, 0x54, 0xaf, 0x77, 0xea,
+	0xdd, 0xad, 0xfe, 0x53, 0xfb, 0x86, 0x30, 0xd6, 0xec, 0x56, 0x89, 0xd8, 0x6f, 0x2f, 0x70, 0xfa,
+	0x2c, 0x26, 0x93, 0x84, 0x9d, 0x25, 0x2e, 0x67, 0x71, 0xf6, 0xaf, 0x0a, 0xb3, 0xb6, 0x2c, 0xcc,
+	0x1d, 0x29, 0x27, 0xa9, 0x2d, 0xb7, 0xd4, 0xd0, 0x5e, 0xaa, 0xbb, 0x14, 0x98, 0xe7, 0xe7, 0x5e,
+	0x40, 0xa6, 0x90, 0x25, 0x38, 0x19, 0x80, 0xde, 0xe8, 0x28, 0xdd, 0x4d, 0xe7, 0x60, 0x59, 0x98,
+	0xba, 0x44, 0xfd, 0xd1, 0x62, 0xb9, 0x77, 0x28, 0x30, 0x27, 0x7f, 0xb1, 0x3a, 0x39, 0x6e, 0xfc,
+	0xf8, 0x6c, 0x2a, 0xd6, 0xc7, 0xba, 0xaa, 0x57, 0xf1, 0xbc, 0xc9, 0xc2, 0x01, 0xbc, 0xf6, 0x29,
+	0x64, 0x53, 0xcc, 0x42, 0xf2, 0xef, 0x71, 0xf9, 0xaa, 0x4a, 0x53, 0xc2, 0xbc, 0x94, 0x13, 0x88,
+	0xc8, 0x5a, 0xce, 0x73, 0x3e, 0xc7, 0xd7, 0xc2, 0x3c, 0x0c, 0x42, 0xf6, 0x7e, 0xe2, 0xdb, 0x03,
+	0x12, 0xa3, 0x01, 0xa1, 0x31, 0xa1, 0xe5, 0xe3, 0x88, 0x0e, 0xc7, 0x88, 0xe5, 0x29, 0x50, 0xfb,
+	0x04, 0x06, 0xcb, 0xc2, 0xdc, 0x2d, 0xbd, 0xaf, 0x98, 0x2c, 0xb7, 0xc5, 0x0b, 0x61, 0x4b, 0x1b,
+	0xab, 0x3b, 0x78, 0x0a, 0x19, 0x0e, 0xa0, 0x94, 0xa9, 0x0b, 0x99, 0xd3, 0xff, 0x96, 0xd9, 0x93,
+	0x32, 0xbf, 0x91, 0x59, 0xee, 0x76, 0x59, 0x4b, 0xb1, 0x99, 0xba, 0x1f, 0x61, 0xca, 0x3c, 0xf2,
+	0x2b, 0x0c, 0x8f, 0x6f, 0xa5, 0x48, 0x7a, 0xab, 0xdf, 0xb6, 0xe5, 0xca, 0xda, 0xd5, 0xca, 0xda,
+	0xe7, 0xd5, 0xca, 0x3a, 0xdd, 0xf2, 0xfb, 0x1d, 0x48, 0x99, 0xbf, 0xd2, 0x58, 0x97, 0xdf, 0x4c,
+	0xc5, 0xbd, 0xc7, 0xef, 0xd6, 0xe2, 0xe6, 0x1c, 0xce, 0xab, 0xab, 0xb9, 0xa1, 0x5c, 0xcf, 0x0d,
+	0xe5, 0xfb, 0xdc, 0x50, 0x2e, 0x17, 0x46, 0xed, 0x7a, 0x61, 0xd4, 0xbe, 0x2c, 0x8c, 0xda, 0xbb,
+	0xfe, 0xda, 0x84, 0x62, 0xb2, 0x90, 0x1e, 0x45, 0xd8, 0xa7, 0x55, 0x81, 0xa6, 0xbd, 0xc7, 0x68,
+	0x56, 0xfd, 0xa8, 0x62, 0x62, 0xbf, 0x29, 0x0c, 0x3e, 0xfc, 0x39, 0x00, 0xee, 0x32, 0x08, 0xa5,
+	0xd6, 0x03, 0x00, 0x00,
 }
 
 func (this *FeeToken) Equal(that interface{}) bool {
@@ -132,6 +238,17 @@ func (this *FeeToken) Equal(that interface{}) bool {
 	if this.PoolID != that1.PoolID {
 		return false
 	}
+	if len(this.Routes) != len(that1.Routes) {
+		return false
+	}
+	for i := range this.Routes {
+		if !this.Routes[i].Equal(&that1.Routes[i]) {
+			return false
+		}
+	}
+	if this.SetByGovernance != that1.SetByGovernance {
+		return false
+	}
 	return true
 }
 func (m *FeeToken) Marshal() (dAtA []byte, err error) {
@@ -154,6 +271,30 @@ func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SetByGovernance {
+		i--
+		if m.SetByGovernance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeetoken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.PoolID != 0 {
 		i = encodeVarintFeetoken(dAtA, i, uint64(m.PoolID))
 		i--
@@ -169,6 +310,64 @@ func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeTokenPriceObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTokenPriceObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTokenPriceObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastObservationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastObservationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFeetoken(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AveragePrice.Size()
+		i -= size
+		if _, err := m.AveragePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeetoken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeetoken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeetoken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeetoken(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeetoken(v)
 	base := offset
@@ -193,6 +392,34 @@ func (m *FeeToken) Size() (n int) {
 	if m.PoolID != 0 {
 		n += 1 + sovFeetoken(uint64(m.PoolID))
 	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovFeetoken(uint64(l))
+		}
+	}
+	if m.SetByGovernance {
+		n += 2
+	}
+	return n
+}
+
+func (m *FeeTokenPriceObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeetoken(uint64(l))
+	}
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovFeetoken(uint64(l))
+	l = m.AveragePrice.Size()
+	n += 1 + l + sovFeetoken(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastObservationTime)
+	n += 1 + l + sovFeetoken(uint64(l))
 	return n
 }
 
@@ -282,6 +509,243 @@ func (m *FeeToken) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetByGovernance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetByGovernance = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeetoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTokenPriceObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeetoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTokenPriceObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTokenPriceObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AveragePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AveragePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastObservationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeetoken(dAtA[iNdEx:])
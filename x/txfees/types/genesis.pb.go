@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -90,6 +95,25 @@ type Params struct {
 	// epoch_identifier is what epoch type swap and burn will be triggered by
 	// (day, week, etc.)
 	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
+	// twap_window is the window over which the arithmetic twap of a fee token
+	// is used to convert fees to the base denom. Zero uses the spot price.
+	TwapWindow time.Duration `protobuf:"bytes,2,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window" yaml:"twap_window"`
+	// min_pool_liquidity is the minimum amount of base denom a pool must hold
+	// to be used as the pool of a fee token. Zero disables the check.
+	MinPoolLiquidity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_pool_liquidity,json=minPoolLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_pool_liquidity" yaml:"min_pool_liquidity"`
+	// price_observation_window is the window of the moving average of the fee
+	// token prices observed each block. Fees are deemed sufficient at the lower
+	// of the spot and average prices. Zero disables the observations.
+	PriceObservationWindow time.Duration `protobuf:"bytes,4,opt,name=price_observation_window,json=priceObservationWindow,proto3,stdduration" json:"price_observation_window" yaml:"price_observation_window"`
+	// max_price_deviation is the maximum relative deviation of the spot price of
+	// a fee token from its average price for its fees to be accepted. Zero
+	// disables the check.
+	MaxPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_deviation" yaml:"max_price_deviation"`
+	// fee_split is how the fees are split once converted to the base denom.
+	FeeSplit FeeSplit `protobuf:"bytes,6,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split" yaml:"fee_split"`
+	// pool_switch_margin is the relative margin by which a pool must hold more
+	// base denom than the current pool of a fee token to replace it.
+	PoolSwitchMargin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=pool_switch_margin,json=poolSwitchMargin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pool_switch_margin" yaml:"pool_switch_margin"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -132,9 +156,79 @@ func (m *Params) GetEpochIdentifier() string {
 	return ""
 }
 
+func (m *Params) GetTwapWindow() time.Duration {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+func (m *Params) GetPriceObservationWindow() time.Duration {
+	if m != nil {
+		return m.PriceObservationWindow
+	}
+	return 0
+}
+
+func (m *Params) GetFeeSplit() FeeSplit {
+	if m != nil {
+		return m.FeeSplit
+	}
+	return FeeSplit{}
+}
+
+// FeeSplit holds the weights of the portions of the fees in base denom, which
+// must sum to one.
+type FeeSplit struct {
+	// burn is the portion burned.
+	Burn github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=burn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn" yaml:"burn"`
+	// community_pool is the portion sent to the community pool.
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool" yaml:"community_pool"`
+	// fee_collector is the portion sent to the fee collector, i.e. to the
+	// stakers.
+	FeeCollector github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fee_collector,json=feeCollector,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_collector" yaml:"fee_collector"`
+	// beneficiary is the portion sent to the beneficiary of the fee, e.g. the
+	// RollApp owner. It is burned if the fee has no beneficiary.
+	Beneficiary github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=beneficiary,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"beneficiary" yaml:"beneficiary"`
+}
+
+func (m *FeeSplit) Reset()         { *m = FeeSplit{} }
+func (m *FeeSplit) String() string { return proto.CompactTextString(m) }
+func (*FeeSplit) ProtoMessage()    {}
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10fbfcc9b5bd5ce3, []int{2}
+}
+func (m *FeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplit.Merge(m, src)
+}
+func (m *FeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplit proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.txfees.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.txfees.v1beta1.Params")
+	proto.RegisterType((*FeeSplit)(nil), "dymensionxyz.dymension.txfees.v1beta1.FeeSplit")
 }
 
 func init() {
@@ -142,28 +236,53 @@ func init() {
 }
 
 var fileDescriptor_10fbfcc9b5bd5ce3 = []byte{
-	// 332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcf, 0x6a, 0xf2, 0x40,
-	0x14, 0xc5, 0x33, 0x9f, 0x1f, 0x42, 0xc6, 0x42, 0x4b, 0x28, 0x54, 0x6c, 0x89, 0x12, 0x28, 0xb8,
-	0x71, 0x06, 0xb5, 0xdd, 0x74, 0xe9, 0xc2, 0x52, 0xda, 0x85, 0x68, 0x57, 0xdd, 0x94, 0x89, 0x5e,
-	0xe3, 0x50, 0x33, 0x13, 0xbc, 0x53, 0x31, 0x7d, 0x8a, 0x3e, 0x96, 0xab, 0xe2, 0xb2, 0x2b, 0x29,
-	0xfa, 0x06, 0x7d, 0x82, 0x62, 0xe2, 0x9f, 0xd2, 0x55, 0x76, 0x73, 0x0e, 0xf3, 0xbb, 0xe7, 0xcc,
-	0x5c, 0xda, 0x1c, 0xc4, 0x21, 0x28, 0x94, 0x5a, 0xcd, 0xe2, 0x37, 0xbe, 0x17, 0xdc, 0xcc, 0x86,
-	0x00, 0xc8, 0xa7, 0x75, 0x1f, 0x8c, 0xa8, 0xf3, 0x00, 0x14, 0xa0, 0x44, 0x16, 0x4d, 0xb4, 0xd1,
-	0xce, 0xe5, 0x6f, 0x88, 0xed, 0x05, 0x4b, 0x21, 0xb6, 0x85, 0x4a, 0xa7, 0x81, 0x0e, 0x74, 0x42,
-	0xf0, 0xcd, 0x29, 0x85, 0x4b, 0x57, 0xd9, 0x12, 0x87, 0x00, 0x46, 0xbf, 0x80, 0x4a, 0x29, 0xef,
-	0x83, 0xd0, 0xa3, 0xdb, 0xb4, 0x44, 0xcf, 0x08, 0x03, 0xce, 0x3d, 0xcd, 0x47, 0x62, 0x22, 0x42,
-	0x2c, 0x92, 0x0a, 0xa9, 0x16, 0x1a, 0x35, 0x96, 0xa9, 0x14, 0xeb, 0x24, 0x50, 0xeb, 0xff, 0x7c,
-	0x59, 0xb6, 0xba, 0xdb, 0x11, 0xce, 0x05, 0xb5, 0x7d, 0x81, 0x30, 0x00, 0xa5, 0xc3, 0xe2, 0xbf,
-	0x0a, 0xa9, 0xda, 0xdd, 0x83, 0xe1, 0xf4, 0xa8, 0xbd, 0x6b, 0x83, 0xc5, 0x5c, 0x25, 0x57, 0x2d,
-	0x34, 0x78, 0xc6, 0xb4, 0x36, 0xc0, 0xe3, 0x86, 0xdb, 0xe6, 0x1d, 0xe6, 0x78, 0x1d, 0x9a, 0x4f,
-	0xab, 0x38, 0x6d, 0x7a, 0x02, 0x91, 0xee, 0x8f, 0x9e, 0xe5, 0x00, 0x94, 0x91, 0x43, 0x09, 0x93,
-	0xe4, 0x4d, 0x76, 0xeb, 0xfc, 0x7b, 0x59, 0x3e, 0x8b, 0x45, 0x38, 0xbe, 0xf1, 0xfe, 0xde, 0xf0,
-	0xba, 0xc7, 0x89, 0x75, 0xb7, 0x77, 0x5a, 0x0f, 0xf3, 0x95, 0x4b, 0x16, 0x2b, 0x97, 0x7c, 0xad,
-	0x5c, 0xf2, 0xbe, 0x76, 0xad, 0xc5, 0xda, 0xb5, 0x3e, 0xd7, 0xae, 0xf5, 0xd4, 0x08, 0xa4, 0x19,
-	0xbd, 0xfa, 0xac, 0xaf, 0x43, 0xae, 0x31, 0xd4, 0x28, 0xb1, 0x36, 0x16, 0x3e, 0xee, 0x04, 0x9f,
-	0xd6, 0xaf, 0xf9, 0x6c, 0xb7, 0x01, 0x13, 0x47, 0x80, 0x7e, 0x3e, 0xf9, 0xf7, 0xe6, 0x4f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x8b, 0xc8, 0xec, 0xb9, 0x21, 0x02, 0x00, 0x00,
+	// 727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6f, 0xd3, 0x48,
+	0x18, 0x8d, 0x37, 0xdd, 0x6c, 0x33, 0x69, 0x77, 0xb3, 0xb3, 0xdd, 0x5d, 0xb7, 0xbb, 0xb2, 0x23,
+	0x4b, 0xbb, 0xaa, 0x84, 0x6a, 0xab, 0x29, 0x5c, 0x90, 0xb8, 0x84, 0xa8, 0x55, 0xd5, 0x22, 0x8a,
+	0x83, 0x84, 0xd4, 0x8b, 0xe5, 0x1f, 0xe3, 0x74, 0x54, 0xdb, 0x63, 0x3c, 0x93, 0x1f, 0x46, 0x1c,
+	0xb8, 0x73, 0xe1, 0xc8, 0x99, 0xbf, 0xa6, 0x27, 0xd4, 0x23, 0xe2, 0x10, 0x50, 0xfb, 0x1f, 0xe4,
+	0x2f, 0x40, 0x9e, 0x19, 0x27, 0x81, 0x0a, 0xd1, 0x9c, 0x92, 0xef, 0x8d, 0xdf, 0x7b, 0xdf, 0xf3,
+	0x37, 0x9f, 0xc1, 0x5e, 0x90, 0xc7, 0x28, 0xa1, 0x98, 0x24, 0xe3, 0xfc, 0x85, 0x35, 0x2b, 0x2c,
+	0x36, 0x0e, 0x11, 0xa2, 0xd6, 0x70, 0xd7, 0x43, 0xcc, 0xdd, 0xb5, 0xfa, 0x28, 0x41, 0x14, 0x53,
+	0x33, 0xcd, 0x08, 0x23, 0xf0, 0xbf, 0x45, 0x92, 0x39, 0x2b, 0x4c, 0x41, 0x32, 0x25, 0x69, 0x6b,
+	0xa3, 0x4f, 0xfa, 0x84, 0x33, 0xac, 0xe2, 0x9f, 0x20, 0x6f, 0x69, 0x7d, 0x42, 0xfa, 0x11, 0xb2,
+	0x78, 0xe5, 0x0d, 0x42, 0x2b, 0x18, 0x64, 0x2e, 0x2b, 0xe8, 0xe2, 0xfc, 0xee, 0xed, 0x3a, 0x0a,
+	0x11, 0x62, 0xe4, 0x1c, 0x49, 0x96, 0xf1, 0x5e, 0x01, 0x6b, 0x07, 0xa2, 0xc9, 0x1e, 0x73, 0x19,
+	0x82, 0x47, 0xa0, 0x96, 0xba, 0x99, 0x1b, 0x53, 0x55, 0x69, 0x29, 0xdb, 0x8d, 0xf6, 0x8e, 0x79,
+	0xab, 0xa6, 0xcd, 0x13, 0x4e, 0xea, 0xac, 0x5c, 0x4c, 0xf4, 0x8a, 0x2d, 0x25, 0xe0, 0xbf, 0xa0,
+	0xee, 0xb9, 0x14, 0x05, 0x28, 0x21, 0xb1, 0xfa, 0x53, 0x4b, 0xd9, 0xae, 0xdb, 0x73, 0x00, 0xf6,
+	0x40, 0xbd, 0xec, 0x86, 0xaa, 0xd5, 0x56, 0x75, 0xbb, 0xd1, 0xb6, 0x6e, 0xe9, 0xb6, 0x8f, 0xd0,
+	0xd3, 0x82, 0x27, 0xfd, 0xe6, 0x3a, 0xc6, 0xeb, 0x1a, 0xa8, 0x89, 0x5e, 0xe0, 0x3e, 0x68, 0xa2,
+	0x94, 0xf8, 0x67, 0x0e, 0x0e, 0x50, 0xc2, 0x70, 0x88, 0x51, 0xc6, 0x43, 0xd5, 0x3b, 0xff, 0x4c,
+	0x27, 0xfa, 0xdf, 0xb9, 0x1b, 0x47, 0xf7, 0x8d, 0x6f, 0x9f, 0x30, 0xec, 0xdf, 0x38, 0x74, 0x38,
+	0x43, 0xe0, 0x29, 0x68, 0xb0, 0x91, 0x9b, 0x3a, 0x23, 0x9c, 0x04, 0x64, 0xc4, 0x73, 0x34, 0xda,
+	0x9b, 0xa6, 0x98, 0x87, 0x59, 0xce, 0xc3, 0xec, 0xca, 0x79, 0x74, 0xb4, 0xa2, 0xa7, 0xe9, 0x44,
+	0x87, 0xc2, 0x61, 0x81, 0x6b, 0xbc, 0xfd, 0xa4, 0x2b, 0x36, 0x28, 0x90, 0x67, 0x1c, 0x80, 0x39,
+	0x80, 0x31, 0x4e, 0x9c, 0x94, 0x90, 0xc8, 0x89, 0xf0, 0xf3, 0x01, 0x0e, 0x30, 0xcb, 0xd5, 0x2a,
+	0xef, 0xf2, 0xa8, 0xd0, 0xf9, 0x38, 0xd1, 0xff, 0xef, 0x63, 0x76, 0x36, 0xf0, 0x4c, 0x9f, 0xc4,
+	0x96, 0x4f, 0x68, 0x4c, 0xa8, 0xfc, 0xd9, 0xa1, 0xc1, 0xb9, 0xc5, 0xf2, 0x14, 0x51, 0xf3, 0x30,
+	0x61, 0xd3, 0x89, 0xbe, 0x29, 0x1c, 0x6f, 0x2a, 0x1a, 0x76, 0x33, 0xc6, 0xc9, 0x09, 0x21, 0xd1,
+	0x71, 0x09, 0xc1, 0x57, 0x0a, 0x50, 0xd3, 0x0c, 0xfb, 0xc8, 0x21, 0x1e, 0x45, 0xd9, 0x90, 0x37,
+	0x5f, 0x86, 0x5c, 0xf9, 0x51, 0xc8, 0x3b, 0x32, 0xa4, 0x2e, 0x2c, 0xbf, 0x27, 0x24, 0x12, 0xff,
+	0xc5, 0x8f, 0x1f, 0xcf, 0x4f, 0x65, 0xfa, 0x97, 0xe0, 0x8f, 0xd8, 0x1d, 0x3b, 0x82, 0x1c, 0xa0,
+	0x21, 0xe6, 0x87, 0xea, 0xcf, 0x3c, 0xfe, 0xf1, 0x12, 0xf1, 0xbb, 0xc8, 0x9f, 0x4e, 0xf4, 0x2d,
+	0x19, 0xff, 0xa6, 0xa4, 0x61, 0xff, 0x1e, 0xbb, 0xe3, 0x93, 0x02, 0xec, 0x96, 0x18, 0x0c, 0xf9,
+	0xfd, 0x73, 0x68, 0x1a, 0x61, 0xa6, 0xd6, 0x5a, 0xca, 0x72, 0xf7, 0xaf, 0x57, 0xd0, 0x3a, 0xaa,
+	0x7c, 0x0d, 0x4d, 0x61, 0x3d, 0xd3, 0x33, 0xec, 0xd5, 0x50, 0x3e, 0x53, 0xcc, 0x98, 0x4f, 0x83,
+	0x8e, 0x30, 0xf3, 0xcf, 0x9c, 0xd8, 0xcd, 0xfa, 0x38, 0x51, 0x7f, 0x59, 0x7a, 0xc6, 0x22, 0xa4,
+	0x9c, 0xf1, 0x4d, 0x45, 0xc3, 0x6e, 0x16, 0x60, 0x8f, 0x63, 0x8f, 0x04, 0xf4, 0xae, 0x0a, 0x56,
+	0xcb, 0x5e, 0xe1, 0x13, 0xb0, 0xe2, 0x0d, 0xb2, 0x44, 0xee, 0xc0, 0x83, 0xa5, 0x9d, 0x1b, 0xc2,
+	0xb9, 0xd0, 0x30, 0x6c, 0x2e, 0x05, 0x13, 0xf0, 0xab, 0x4f, 0xe2, 0x78, 0x90, 0x60, 0x96, 0xf3,
+	0x2b, 0x27, 0xb6, 0xbc, 0x73, 0xb0, 0xb4, 0xf8, 0x9f, 0x42, 0xfc, 0x6b, 0x35, 0xc3, 0x5e, 0x9f,
+	0x01, 0xc5, 0xe5, 0x85, 0xe7, 0x60, 0xbd, 0x78, 0xc5, 0x3e, 0x89, 0x22, 0xe4, 0x33, 0x92, 0xc9,
+	0x4d, 0xd9, 0x5f, 0xda, 0x6e, 0x63, 0x3e, 0xaf, 0x99, 0x98, 0x61, 0xaf, 0x85, 0x08, 0x3d, 0x2c,
+	0x4b, 0x18, 0x82, 0x86, 0x87, 0x12, 0x14, 0x62, 0x1f, 0xbb, 0x59, 0xce, 0x57, 0xa2, 0xde, 0xe9,
+	0x2e, 0x6d, 0x25, 0x3f, 0x03, 0x0b, 0x52, 0x86, 0xbd, 0x28, 0xdc, 0x39, 0xbe, 0xb8, 0xd2, 0x94,
+	0xcb, 0x2b, 0x4d, 0xf9, 0x7c, 0xa5, 0x29, 0x6f, 0xae, 0xb5, 0xca, 0xe5, 0xb5, 0x56, 0xf9, 0x70,
+	0xad, 0x55, 0x4e, 0xdb, 0x0b, 0x26, 0x5c, 0x1c, 0xd3, 0x9d, 0xc8, 0xf5, 0x68, 0x59, 0x58, 0xc3,
+	0xdd, 0x7b, 0xd6, 0xb8, 0xfc, 0xc4, 0x73, 0x53, 0xaf, 0xc6, 0x77, 0x75, 0xef, 0xcb, 0x00, 0x1c,
+	0x4e, 0x54, 0x44, 0xa2, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -240,6 +359,62 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PoolSwitchMargin.Size()
+		i -= size
+		if _, err := m.PoolSwitchMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PriceObservationWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PriceObservationWindow):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinPoolLiquidity.Size()
+		i -= size
+		if _, err := m.MinPoolLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
 	if len(m.EpochIdentifier) > 0 {
 		i -= len(m.EpochIdentifier)
 		copy(dAtA[i:], m.EpochIdentifier)
@@ -250,6 +425,69 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Beneficiary.Size()
+		i -= size
+		if _, err := m.Beneficiary.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FeeCollector.Size()
+		i -= size
+		if _, err := m.FeeCollector.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -292,6 +530,35 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MinPoolLiquidity.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PriceObservationWindow)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeSplit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PoolSwitchMargin.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *FeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Burn.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeCollector.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Beneficiary.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -511,6 +778,393 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPoolLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceObservationWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PriceObservationWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSwitchMargin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolSwitchMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCollector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Beneficiary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
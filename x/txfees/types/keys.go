@@ -20,4 +20,6 @@ const (
 var (
 	BaseDenomKey         = []byte("base_denom")
 	FeeTokensStorePrefix = []byte("fee_tokens")
+
+	FeeTokenPricesStorePrefix = []byte("fee_token_prices")
 )
@@ -0,0 +1,83 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// constants.
+const (
+	TypeMsgUpdateFeeToken = "update_fee_token"
+	TypeMsgRemoveFeeToken = "remove_fee_token"
+)
+
+var _ sdk.Msg = &MsgUpdateFeeToken{}
+
+func (msg MsgUpdateFeeToken) Route() string { return RouterKey }
+func (msg MsgUpdateFeeToken) Type() string  { return TypeMsgUpdateFeeToken }
+func (msg MsgUpdateFeeToken) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	err = sdk.ValidateDenom(msg.Denom)
+	if err != nil {
+		return err
+	}
+
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(ErrInvalidFeeToken, "pool id must be positive")
+	}
+
+	if len(msg.Routes) > 0 {
+		err = poolmanagertypes.SwapAmountInRoutes(msg.Routes).Validate()
+		if err != nil {
+			return err
+		}
+		if msg.Routes[0].PoolId != msg.PoolId {
+			return sdkerrors.Wrapf(ErrInvalidFeeToken, "routes must start with pool %d, got %d", msg.PoolId, msg.Routes[0].PoolId)
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgUpdateFeeToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateFeeToken) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgRemoveFeeToken{}
+
+func (msg MsgRemoveFeeToken) Route() string { return RouterKey }
+func (msg MsgRemoveFeeToken) Type() string  { return TypeMsgRemoveFeeToken }
+func (msg MsgRemoveFeeToken) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+func (msg MsgRemoveFeeToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveFeeToken) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
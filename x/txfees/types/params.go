@@ -2,13 +2,24 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter store keys.
 var (
-	KeyEpochIdentifier = []byte("EpochIdentifier")
+	KeyEpochIdentifier  = []byte("EpochIdentifier")
+	KeyTwapWindow       = []byte("TwapWindow")
+	KeyMinPoolLiquidity = []byte("MinPoolLiquidity")
+
+	KeyPriceObservationWindow = []byte("PriceObservationWindow")
+	KeyMaxPriceDeviation      = []byte("MaxPriceDeviation")
+
+	KeyFeeSplit = []byte("FeeSplit")
+
+	KeyPoolSwitchMargin = []byte("PoolSwitchMargin")
 )
 
 // ParamTable for gamm module.
@@ -16,28 +27,72 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(epochIdentifier string) Params {
+func NewParams(
+	epochIdentifier string,
+	twapWindow time.Duration,
+	minPoolLiquidity sdk.Int,
+	priceObservationWindow time.Duration,
+	maxPriceDeviation sdk.Dec,
+	feeSplit FeeSplit,
+	poolSwitchMargin sdk.Dec,
+) Params {
 	return Params{
-		EpochIdentifier: epochIdentifier,
+		EpochIdentifier:        epochIdentifier,
+		TwapWindow:             twapWindow,
+		MinPoolLiquidity:       minPoolLiquidity,
+		PriceObservationWindow: priceObservationWindow,
+		MaxPriceDeviation:      maxPriceDeviation,
+		FeeSplit:               feeSplit,
+		PoolSwitchMargin:       poolSwitchMargin,
 	}
 }
 
 // default gamm module parameters.
 func DefaultParams() Params {
 	return Params{
-		EpochIdentifier: "day",
+		EpochIdentifier:        "day",
+		TwapWindow:             0,
+		MinPoolLiquidity:       sdk.ZeroInt(),
+		PriceObservationWindow: 0,
+		MaxPriceDeviation:      sdk.ZeroDec(),
+		FeeSplit:               DefaultFeeSplit(),
+		PoolSwitchMargin:       sdk.NewDecWithPrec(5, 1),
 	}
 }
 
 // validate params.
 func (p Params) Validate() error {
-	return validateString(p.EpochIdentifier)
+	if err := validateString(p.EpochIdentifier); err != nil {
+		return err
+	}
+	if err := validateTwapWindow(p.TwapWindow); err != nil {
+		return err
+	}
+	if err := validateMinPoolLiquidity(p.MinPoolLiquidity); err != nil {
+		return err
+	}
+	if err := validatePriceObservationWindow(p.PriceObservationWindow); err != nil {
+		return err
+	}
+	if err := validateMaxPriceDeviation(p.MaxPriceDeviation); err != nil {
+		return err
+	}
+	if err := validateFeeSplit(p.FeeSplit); err != nil {
+		return err
+	}
+	return validatePoolSwitchMargin(p.PoolSwitchMargin)
 }
 
 // Implements params.ParamSet.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyEpochIdentifier, &p.EpochIdentifier, validateString),
+		paramtypes.NewParamSetPair(KeyTwapWindow, &p.TwapWindow, validateTwapWindow),
+		paramtypes.NewParamSetPair(KeyMinPoolLiquidity, &p.MinPoolLiquidity, validateMinPoolLiquidity),
+		paramtypes.NewParamSetPair(KeyPriceObservationWindow, &p.PriceObservationWindow, validatePriceObservationWindow),
+		paramtypes.NewParamSetPair(KeyMaxPriceDeviation, &p.MaxPriceDeviation, validateMaxPriceDeviation),
+		paramtypes.NewParamSetPair(KeyFeeSplit, &p.FeeSplit, validateFeeSplit),
+		paramtypes.NewParamSetPair(KeyPoolSwitchMargin, &p.PoolSwitchMargin, validatePoolSwitchMargin),
 	}
 }
 
@@ -51,3 +106,66 @@ func validateString(i interface{}) error {
 	}
 	return nil
 }
+
+func validateTwapWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("twap window must not be negative, got %s", v)
+	}
+	return nil
+}
+
+func validateMinPoolLiquidity(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min pool liquidity must not be negative, got %s", v)
+	}
+	return nil
+}
+
+func validatePriceObservationWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("price observation window must not be negative, got %s", v)
+	}
+	return nil
+}
+
+func validateMaxPriceDeviation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("max price deviation must not be negative, got %s", v)
+	}
+	return nil
+}
+
+func validateFeeSplit(i interface{}) error {
+	v, ok := i.(FeeSplit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
+
+func validatePoolSwitchMargin(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("pool switch margin must not be negative, got %s", v)
+	}
+	return nil
+}